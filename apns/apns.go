@@ -0,0 +1,269 @@
+// Package apns is a provider client for the Apple Push Notification service
+// (APNs) HTTP/2 API, for alert, background, Live Activity and the other push
+// types apps receive. MDM wake-up pushes, which authenticate with the MDM
+// push certificate instead, are sent by device_management/mdm/push.
+//
+// The Client authenticates with token-based provider authentication: an
+// ES256 JWT signed by an APNs key (.p8), reused across requests until it is
+// due for replacement. It holds one pooled HTTP/2 transport; APNs
+// multiplexes concurrent pushes over each connection, so a single Client
+// should be shared across goroutines.
+package apns
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/es256"
+)
+
+// APNs endpoints.
+const (
+	ProductionURL  = "https://api.push.apple.com"
+	DevelopmentURL = "https://api.sandbox.push.apple.com"
+)
+
+// Payload size limits in bytes.
+const (
+	MaxPayloadSize     = 4096
+	MaxVoIPPayloadSize = 5120
+)
+
+const (
+	defaultTimeout     = 30 * time.Second
+	defaultConcurrency = 16
+	maxCollapseIDSize  = 64
+)
+
+// Client sends notifications to one app's devices.
+// A Client is safe for concurrent use by multiple goroutines.
+type Client struct {
+	httpClient  *http.Client
+	baseURL     string
+	bundleID    string
+	signer      *es256.Signer
+	concurrency int
+}
+
+// Option customizes a Client.
+type Option func(*Client)
+
+// WithBaseURL overrides the APNs endpoint, e.g. DevelopmentURL or a test
+// server.
+func WithBaseURL(url string) Option {
+	return func(c *Client) { c.baseURL = strings.TrimSuffix(url, "/") }
+}
+
+// WithDevelopment sends to the development environment, which serves apps
+// signed with a development provisioning profile.
+func WithDevelopment() Option {
+	return WithBaseURL(DevelopmentURL)
+}
+
+// WithHTTPClient replaces the HTTP/2 client.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) { c.httpClient = hc }
+}
+
+// WithConcurrency caps the number of pushes PushMany keeps in flight.
+func WithConcurrency(n int) Option {
+	return func(c *Client) {
+		if n > 0 {
+			c.concurrency = n
+		}
+	}
+}
+
+// NewClient builds a Client for the app with the given bundle ID,
+// authenticated with the APNs key keyID of team teamID.
+func NewClient(keyID, teamID, bundleID string, key *ecdsa.PrivateKey, opts ...Option) (*Client, error) {
+	if keyID == "" || teamID == "" {
+		return nil, fmt.Errorf("apns: key ID and team ID are required")
+	}
+	if bundleID == "" {
+		return nil, fmt.Errorf("apns: bundle ID is required")
+	}
+	if err := es256.ValidateKey(key); err != nil {
+		return nil, fmt.Errorf("apns: %w", err)
+	}
+
+	c := &Client{
+		baseURL:     ProductionURL,
+		bundleID:    bundleID,
+		signer:      es256.NewSigner(keyID, teamID, key, es256.DefaultRefreshAfter),
+		concurrency: defaultConcurrency,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.httpClient == nil {
+		c.httpClient = &http.Client{
+			Timeout: defaultTimeout,
+			Transport: &http.Transport{
+				TLSClientConfig:     &tls.Config{MinVersion: tls.VersionTLS12},
+				ForceAttemptHTTP2:   true,
+				MaxIdleConnsPerHost: c.concurrency,
+				IdleConnTimeout:     5 * time.Minute,
+			},
+		}
+	}
+	return c, nil
+}
+
+// Push sends one notification. A rejected push returns an *Error carrying
+// the APNs reason. When APNs reports the provider token expired, a new token
+// is signed and the push is sent once more.
+func (c *Client) Push(ctx context.Context, n *Notification) (*Response, error) {
+	req, err := c.prepare(n)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, token, err := c.send(ctx, req)
+	var apnsErr *Error
+	if err != nil && errors.As(err, &apnsErr) && apnsErr.Reason == ReasonExpiredProviderToken {
+		c.signer.Invalidate(token)
+		resp, _, err = c.send(ctx, req)
+	}
+	return resp, err
+}
+
+// PushMany sends every notification concurrently, bounded by
+// WithConcurrency, and returns one Result per notification in input order.
+func (c *Client) PushMany(ctx context.Context, notifications []*Notification) []Result {
+	results := make([]Result, len(notifications))
+	sem := make(chan struct{}, c.concurrency)
+	var wg sync.WaitGroup
+	for i, n := range notifications {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() { <-sem; wg.Done() }()
+			resp, err := c.Push(ctx, n)
+			results[i] = Result{Notification: n, Response: resp, Err: err}
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+// request is a validated notification ready to send.
+type request struct {
+	path    string
+	headers http.Header
+	body    []byte
+}
+
+// prepare validates n, applies the defaults and encodes the payload.
+func (c *Client) prepare(n *Notification) (*request, error) {
+	if n == nil {
+		return nil, fmt.Errorf("apns: notification is nil")
+	}
+	if n.DeviceToken == "" {
+		return nil, fmt.Errorf("apns: notification has no device token")
+	}
+	if len(n.CollapseID) > maxCollapseIDSize {
+		return nil, fmt.Errorf("apns: collapse ID is %d bytes, at most %d allowed", len(n.CollapseID), maxCollapseIDSize)
+	}
+
+	pushType := n.PushType
+	if pushType == "" {
+		pushType = PushTypeAlert
+	}
+	priority := n.Priority
+	if priority == 0 {
+		priority = PriorityImmediate
+		if pushType == PushTypeBackground {
+			priority = PriorityConsiderate
+		}
+	}
+	if pushType == PushTypeBackground && priority == PriorityImmediate {
+		return nil, fmt.Errorf("apns: background pushes must not use priority %d", PriorityImmediate)
+	}
+	topic := n.Topic
+	if topic == "" {
+		topic = Topic(c.bundleID, pushType)
+	}
+
+	var body []byte
+	switch p := n.Payload.(type) {
+	case nil:
+		return nil, fmt.Errorf("apns: notification has no payload")
+	case []byte:
+		body = p
+	case json.RawMessage:
+		body = p
+	default:
+		var err error
+		if body, err = json.Marshal(p); err != nil {
+			return nil, fmt.Errorf("apns: encode payload: %w", err)
+		}
+	}
+	limit := MaxPayloadSize
+	if pushType == PushTypeVoIP {
+		limit = MaxVoIPPayloadSize
+	}
+	if len(body) > limit {
+		return nil, fmt.Errorf("apns: payload is %d bytes, at most %d allowed", len(body), limit)
+	}
+
+	headers := http.Header{}
+	headers.Set("Content-Type", "application/json")
+	headers.Set("apns-push-type", pushType)
+	headers.Set("apns-topic", topic)
+	headers.Set("apns-priority", strconv.Itoa(priority))
+	if n.Expiration.IsZero() {
+		headers.Set("apns-expiration", "0")
+	} else {
+		headers.Set("apns-expiration", strconv.FormatInt(n.Expiration.Unix(), 10))
+	}
+	if n.CollapseID != "" {
+		headers.Set("apns-collapse-id", n.CollapseID)
+	}
+	if n.ID != "" {
+		headers.Set("apns-id", n.ID)
+	}
+
+	return &request{path: "/3/device/" + n.DeviceToken, headers: headers, body: body}, nil
+}
+
+// send posts a prepared request with the current provider token, which it
+// returns alongside the result.
+func (c *Client) send(ctx context.Context, r *request) (*Response, string, error) {
+	token, err := c.signer.Token()
+	if err != nil {
+		return nil, "", fmt.Errorf("apns: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+r.path, bytes.NewReader(r.body))
+	if err != nil {
+		return nil, token, fmt.Errorf("apns: %w", err)
+	}
+	req.Header = r.headers.Clone()
+	req.Header.Set("Authorization", "bearer "+token)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, token, fmt.Errorf("apns: %w", err)
+	}
+	defer resp.Body.Close()
+
+	id := resp.Header.Get("apns-id")
+	if resp.StatusCode == http.StatusOK {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return &Response{ID: id, UniqueID: resp.Header.Get("apns-unique-id"), StatusCode: resp.StatusCode}, token, nil
+	}
+	return nil, token, newError(resp.StatusCode, id, resp.Body)
+}
@@ -0,0 +1,304 @@
+package apns
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const testBundleID = "com.example.app"
+
+func testKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+// apnsServer starts an HTTP/2 TLS server and a Client pointed at it.
+func apnsServer(t *testing.T, key *ecdsa.PrivateKey, handler http.HandlerFunc, opts ...Option) *Client {
+	t.Helper()
+	srv := httptest.NewUnstartedServer(handler)
+	srv.EnableHTTP2 = true
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+
+	opts = append([]Option{WithBaseURL(srv.URL), WithHTTPClient(srv.Client())}, opts...)
+	c, err := NewClient("ABC123DEFG", "TEAM123456", testBundleID, key, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestNewClient_Validation(t *testing.T) {
+	key := testKey(t)
+	if _, err := NewClient("", "TEAM123456", testBundleID, key); err == nil {
+		t.Error("expected missing key ID to be rejected")
+	}
+	if _, err := NewClient("kid", "TEAM123456", "", key); err == nil {
+		t.Error("expected missing bundle ID to be rejected")
+	}
+	p384, _ := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if _, err := NewClient("kid", "TEAM123456", testBundleID, p384); err == nil || !strings.Contains(err.Error(), "P-256") {
+		t.Errorf("err = %v", err)
+	}
+}
+
+func TestPush_Alert(t *testing.T) {
+	key := testKey(t)
+	c := apnsServer(t, key, func(w http.ResponseWriter, r *http.Request) {
+		if r.ProtoMajor != 2 {
+			t.Errorf("proto = %s", r.Proto)
+		}
+		if r.Method != http.MethodPost || r.URL.Path != "/3/device/deadbeef" {
+			t.Errorf("request = %s %s", r.Method, r.URL.Path)
+		}
+		for header, want := range map[string]string{
+			"apns-topic":       testBundleID,
+			"apns-push-type":   "alert",
+			"apns-priority":    "10",
+			"apns-expiration":  "1767225600",
+			"apns-collapse-id": "score",
+			"Content-Type":     "application/json",
+		} {
+			if got := r.Header.Get(header); got != want {
+				t.Errorf("%s = %q, want %q", header, got, want)
+			}
+		}
+
+		auth := r.Header.Get("Authorization")
+		if !strings.HasPrefix(auth, "bearer ") {
+			t.Fatalf("Authorization = %q", auth)
+		}
+		token, err := jwt.Parse(strings.TrimPrefix(auth, "bearer "),
+			func(*jwt.Token) (any, error) { return &key.PublicKey, nil },
+			jwt.WithValidMethods([]string{"ES256"}))
+		if err != nil {
+			t.Fatalf("provider token: %v", err)
+		}
+		if token.Header["kid"] != "ABC123DEFG" {
+			t.Errorf("kid = %v", token.Header["kid"])
+		}
+		if iss, _ := token.Claims.GetIssuer(); iss != "TEAM123456" {
+			t.Errorf("iss = %v", iss)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"aps":{"alert":{"title":"Goal","body":"2-1"}},"match":7}` {
+			t.Errorf("body = %s", body)
+		}
+		w.Header().Set("apns-id", "A-1")
+		w.Header().Set("apns-unique-id", "U-1")
+	})
+
+	payload := NewAlert("Goal", "2-1")
+	payload.Custom = map[string]any{"match": 7}
+	resp, err := c.Push(context.Background(), &Notification{
+		DeviceToken: "deadbeef",
+		Expiration:  time.Unix(1767225600, 0),
+		CollapseID:  "score",
+		Payload:     payload,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.ID != "A-1" || resp.UniqueID != "U-1" || resp.StatusCode != http.StatusOK {
+		t.Errorf("resp = %+v", resp)
+	}
+}
+
+func TestPush_TypeDefaults(t *testing.T) {
+	tests := []struct {
+		pushType                string
+		payload                 any
+		wantTopic, wantPriority string
+	}{
+		{PushTypeBackground, NewBackground(), testBundleID, "5"},
+		{PushTypeLiveActivity, NewLiveActivityEvent(LiveActivityEventUpdate, map[string]int{"score": 2}, 1767225600), testBundleID + ".push-type.liveactivity", "10"},
+		{PushTypeVoIP, []byte(`{"caller":"Ann"}`), testBundleID + ".voip", "10"},
+		{PushTypeLocation, json.RawMessage(`{}`), testBundleID + ".location-query", "10"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pushType, func(t *testing.T) {
+			c := apnsServer(t, testKey(t), func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Header.Get("apns-push-type"); got != tt.pushType {
+					t.Errorf("apns-push-type = %q", got)
+				}
+				if got := r.Header.Get("apns-topic"); got != tt.wantTopic {
+					t.Errorf("apns-topic = %q, want %q", got, tt.wantTopic)
+				}
+				if got := r.Header.Get("apns-priority"); got != tt.wantPriority {
+					t.Errorf("apns-priority = %q, want %q", got, tt.wantPriority)
+				}
+			})
+			if _, err := c.Push(context.Background(), &Notification{DeviceToken: "ab", PushType: tt.pushType, Payload: tt.payload}); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestPush_Validation(t *testing.T) {
+	c, err := NewClient("kid", "TEAM123456", testBundleID, testKey(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		n       *Notification
+		wantErr string
+	}{
+		{"no token", &Notification{Payload: NewAlert("a", "b")}, "no device token"},
+		{"no payload", &Notification{DeviceToken: "ab"}, "no payload"},
+		{"background at priority 10", &Notification{DeviceToken: "ab", PushType: PushTypeBackground, Priority: PriorityImmediate, Payload: NewBackground()}, "background pushes"},
+		{"payload too large", &Notification{DeviceToken: "ab", Payload: NewAlert("a", strings.Repeat("x", MaxPayloadSize))}, "at most 4096"},
+		{"collapse ID too long", &Notification{DeviceToken: "ab", CollapseID: strings.Repeat("c", 65), Payload: NewAlert("a", "b")}, "collapse ID"},
+		{"reserved custom key", &Notification{DeviceToken: "ab", Payload: &Payload{Custom: map[string]any{"aps": 1}}}, "reserved"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := c.Push(context.Background(), tt.n)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("err = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPush_Rejected(t *testing.T) {
+	c := apnsServer(t, testKey(t), func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("apns-id", "A-2")
+		w.WriteHeader(http.StatusGone)
+		_, _ = w.Write([]byte(`{"reason":"Unregistered","timestamp":1767225600000}`))
+	})
+
+	_, err := c.Push(context.Background(), &Notification{DeviceToken: "ab", Payload: NewAlert("a", "b")})
+
+	var apnsErr *Error
+	if !errors.As(err, &apnsErr) {
+		t.Fatalf("err = %v", err)
+	}
+	if apnsErr.Reason != ReasonUnregistered || apnsErr.ID != "A-2" || !apnsErr.Timestamp.Equal(time.UnixMilli(1767225600000)) {
+		t.Errorf("err = %+v", apnsErr)
+	}
+	if !IsTokenInvalid(err) || apnsErr.Retryable() {
+		t.Error("Unregistered should be a permanent token error")
+	}
+}
+
+func TestPush_ExpiredProviderTokenIsRefreshed(t *testing.T) {
+	var calls atomic.Int32
+	var tokens []string
+	c := apnsServer(t, testKey(t), func(w http.ResponseWriter, r *http.Request) {
+		tokens = append(tokens, r.Header.Get("Authorization"))
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"reason":"ExpiredProviderToken"}`))
+		}
+	})
+
+	if _, err := c.Push(context.Background(), &Notification{DeviceToken: "ab", Payload: NewAlert("a", "b")}); err != nil {
+		t.Fatal(err)
+	}
+	if calls.Load() != 2 || tokens[0] == tokens[1] {
+		t.Errorf("calls = %d, expected a retry with a new token", calls.Load())
+	}
+}
+
+func TestPushMany_ExpiredProviderTokenIsReplacedOnce(t *testing.T) {
+	var mu sync.Mutex
+	var tokens []string
+	c := apnsServer(t, testKey(t), func(w http.ResponseWriter, r *http.Request) {
+		token := r.Header.Get("Authorization")
+		mu.Lock()
+		if !slices.Contains(tokens, token) {
+			tokens = append(tokens, token)
+		}
+		expired := token == tokens[0]
+		mu.Unlock()
+		if expired {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"reason":"ExpiredProviderToken"}`))
+		}
+	}, WithConcurrency(8))
+
+	notifications := make([]*Notification, 20)
+	for i := range notifications {
+		notifications[i] = &Notification{DeviceToken: "ab", Payload: NewAlert("a", "b")}
+	}
+	for i, r := range c.PushMany(context.Background(), notifications) {
+		if r.Err != nil {
+			t.Errorf("result %d: %v", i, r.Err)
+		}
+	}
+
+	// APNs rejects providers that replace their token too often, so pushes
+	// rejected together must share one new token.
+	if len(tokens) != 2 {
+		t.Errorf("distinct tokens = %d, want 2", len(tokens))
+	}
+}
+
+func TestPushMany(t *testing.T) {
+	c := apnsServer(t, testKey(t), func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/bad") {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"reason":"BadDeviceToken"}`))
+		}
+	}, WithConcurrency(2))
+
+	notifications := []*Notification{
+		{DeviceToken: "aa", Payload: NewAlert("a", "b")},
+		{DeviceToken: "bad", Payload: NewAlert("a", "b")},
+		{DeviceToken: "cc", Payload: NewAlert("a", "b")},
+	}
+	results := c.PushMany(context.Background(), notifications)
+
+	for i, r := range results {
+		if r.Notification != notifications[i] {
+			t.Errorf("result %d is out of order", i)
+		}
+	}
+	if results[0].Err != nil || results[2].Err != nil {
+		t.Errorf("unexpected errors: %v %v", results[0].Err, results[2].Err)
+	}
+	if !IsTokenInvalid(results[1].Err) {
+		t.Errorf("results[1].Err = %v", results[1].Err)
+	}
+}
+
+func TestTopic(t *testing.T) {
+	for pushType, want := range map[string]string{
+		PushTypeAlert:        "com.example.app",
+		PushTypeComplication: "com.example.app.complication",
+		PushTypeFileProvider: "com.example.app.pushkit.fileprovider",
+		PushTypePushToTalk:   "com.example.app.voip-ptt",
+	} {
+		if got := Topic(testBundleID, pushType); got != want {
+			t.Errorf("Topic(%s) = %q, want %q", pushType, got, want)
+		}
+	}
+	if got := Topic("com.example.app.voip", PushTypeVoIP); got != "com.example.app.voip" {
+		t.Errorf("suffix applied twice: %q", got)
+	}
+}
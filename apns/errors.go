@@ -0,0 +1,105 @@
+package apns
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// APNs rejection reasons.
+const (
+	ReasonBadCollapseID               = "BadCollapseId"
+	ReasonBadDeviceToken              = "BadDeviceToken"
+	ReasonBadExpirationDate           = "BadExpirationDate"
+	ReasonBadMessageID                = "BadMessageId"
+	ReasonBadPriority                 = "BadPriority"
+	ReasonBadTopic                    = "BadTopic"
+	ReasonDeviceTokenNotForTopic      = "DeviceTokenNotForTopic"
+	ReasonDuplicateHeaders            = "DuplicateHeaders"
+	ReasonIdleTimeout                 = "IdleTimeout"
+	ReasonInvalidPushType             = "InvalidPushType"
+	ReasonMissingDeviceToken          = "MissingDeviceToken"
+	ReasonMissingTopic                = "MissingTopic"
+	ReasonPayloadEmpty                = "PayloadEmpty"
+	ReasonTopicDisallowed             = "TopicDisallowed"
+	ReasonBadCertificate              = "BadCertificate"
+	ReasonBadCertificateEnvironment   = "BadCertificateEnvironment"
+	ReasonExpiredProviderToken        = "ExpiredProviderToken"
+	ReasonForbidden                   = "Forbidden"
+	ReasonInvalidProviderToken        = "InvalidProviderToken"
+	ReasonMissingProviderToken        = "MissingProviderToken"
+	ReasonBadPath                     = "BadPath"
+	ReasonMethodNotAllowed            = "MethodNotAllowed"
+	ReasonExpiredToken                = "ExpiredToken"
+	ReasonUnregistered                = "Unregistered"
+	ReasonPayloadTooLarge             = "PayloadTooLarge"
+	ReasonTooManyProviderTokenUpdates = "TooManyProviderTokenUpdates"
+	ReasonTooManyRequests             = "TooManyRequests"
+	ReasonInternalServerError         = "InternalServerError"
+	ReasonServiceUnavailable          = "ServiceUnavailable"
+	ReasonShutdown                    = "Shutdown"
+)
+
+// Error is an APNs rejection.
+type Error struct {
+	// StatusCode is the HTTP status APNs returned.
+	StatusCode int
+	// Reason is one of the Reason constants.
+	Reason string
+	// ID is the apns-id of the rejected notification.
+	ID string
+	// Timestamp is when APNs last confirmed the token was invalid; set only
+	// for 410 Unregistered / ExpiredToken responses.
+	Timestamp time.Time
+}
+
+func (e *Error) Error() string {
+	if e.Reason == "" {
+		return fmt.Sprintf("apns: APNs returned %d", e.StatusCode)
+	}
+	return fmt.Sprintf("apns: APNs returned %d %s", e.StatusCode, e.Reason)
+}
+
+// TokenInvalid reports whether the device token will never accept pushes
+// again on this topic; stop sending to it until the app registers again.
+func (e *Error) TokenInvalid() bool {
+	switch e.Reason {
+	case ReasonBadDeviceToken, ReasonDeviceTokenNotForTopic, ReasonUnregistered, ReasonExpiredToken:
+		return true
+	}
+	return e.StatusCode == http.StatusGone
+}
+
+// Retryable reports whether the same push may succeed if retried later.
+func (e *Error) Retryable() bool {
+	switch e.StatusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusServiceUnavailable:
+		return true
+	}
+	return e.Reason == ReasonIdleTimeout || e.Reason == ReasonShutdown || e.Reason == ReasonExpiredProviderToken
+}
+
+// IsTokenInvalid reports whether err is an *Error whose device token is
+// permanently invalid.
+func IsTokenInvalid(err error) bool {
+	var e *Error
+	return errors.As(err, &e) && e.TokenInvalid()
+}
+
+func newError(status int, id string, body io.Reader) *Error {
+	e := &Error{StatusCode: status, ID: id}
+	var payload struct {
+		Reason    string `json:"reason"`
+		Timestamp int64  `json:"timestamp"`
+	}
+	if err := json.NewDecoder(io.LimitReader(body, 4096)).Decode(&payload); err == nil {
+		e.Reason = payload.Reason
+		if payload.Timestamp > 0 {
+			e.Timestamp = time.UnixMilli(payload.Timestamp).UTC()
+		}
+	}
+	return e
+}
@@ -0,0 +1,100 @@
+package apns
+
+import (
+	"strings"
+	"time"
+)
+
+// Push types sent in the apns-push-type header. The type must match the
+// payload: an alert push must display something, a background push must only
+// set content-available.
+const (
+	PushTypeAlert        = "alert"
+	PushTypeBackground   = "background"
+	PushTypeLocation     = "location"
+	PushTypeVoIP         = "voip"
+	PushTypeComplication = "complication"
+	PushTypeFileProvider = "fileprovider"
+	PushTypeMDM          = "mdm"
+	PushTypeLiveActivity = "liveactivity"
+	PushTypePushToTalk   = "pushtotalk"
+	PushTypeWidgets      = "widgets"
+)
+
+// Priorities sent in the apns-priority header.
+const (
+	// PriorityImmediate delivers the notification right away. Not allowed
+	// for background pushes.
+	PriorityImmediate = 10
+	// PriorityConsiderate lets the device batch delivery to save power.
+	PriorityConsiderate = 5
+	// PriorityLow delivers the notification when the device is otherwise
+	// awake, and may drop it.
+	PriorityLow = 1
+)
+
+// topicSuffixes maps push types whose topic is not the plain bundle ID to the
+// suffix APNs expects after it.
+var topicSuffixes = map[string]string{
+	PushTypeVoIP:         ".voip",
+	PushTypeComplication: ".complication",
+	PushTypeFileProvider: ".pushkit.fileprovider",
+	PushTypeLiveActivity: ".push-type.liveactivity",
+	PushTypeLocation:     ".location-query",
+	PushTypePushToTalk:   ".voip-ptt",
+}
+
+// Topic returns the apns-topic for sending pushType notifications to the app
+// with the given bundle ID, e.g. "com.example.app.push-type.liveactivity" for
+// Live Activity updates.
+func Topic(bundleID, pushType string) string {
+	if suffix, ok := topicSuffixes[pushType]; ok && !strings.HasSuffix(bundleID, suffix) {
+		return bundleID + suffix
+	}
+	return bundleID
+}
+
+// Notification is one push to one device.
+type Notification struct {
+	// DeviceToken is the hex-encoded device token (or Live Activity push
+	// token) the app registered.
+	DeviceToken string
+	// Topic is the apns-topic. Defaults to the client's bundle ID with the
+	// suffix the push type requires; see Topic.
+	Topic string
+	// PushType is one of the PushType constants. Defaults to PushTypeAlert.
+	PushType string
+	// Priority is one of the Priority constants. Defaults to
+	// PriorityImmediate, or PriorityConsiderate for background pushes.
+	Priority int
+	// Expiration asks APNs to store the notification and retry delivery
+	// until then. The zero value means APNs attempts delivery once.
+	Expiration time.Time
+	// CollapseID replaces an earlier notification with the same ID that is
+	// still displayed. At most 64 bytes.
+	CollapseID string
+	// ID is the apns-id, a canonical UUID. APNs assigns one when empty.
+	ID string
+	// Payload is the JSON body: a *Payload, any value that marshals to a JSON
+	// object, or pre-encoded JSON as []byte or json.RawMessage.
+	Payload any
+}
+
+// Response describes an accepted push.
+type Response struct {
+	// ID is the apns-id of the notification.
+	ID string
+	// UniqueID is the apns-unique-id APNs returns in the development
+	// environment, for looking the notification up in the Push Notifications
+	// Console.
+	UniqueID string
+	// StatusCode is the HTTP status, 200 for an accepted push.
+	StatusCode int
+}
+
+// Result pairs a notification with the outcome of sending it.
+type Result struct {
+	Notification *Notification
+	Response     *Response
+	Err          error
+}
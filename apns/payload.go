@@ -0,0 +1,112 @@
+package apns
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Interruption levels for Aps.InterruptionLevel.
+const (
+	InterruptionLevelPassive       = "passive"
+	InterruptionLevelActive        = "active"
+	InterruptionLevelTimeSensitive = "time-sensitive"
+	InterruptionLevelCritical      = "critical"
+)
+
+// Live Activity events for Aps.Event.
+const (
+	LiveActivityEventStart  = "start"
+	LiveActivityEventUpdate = "update"
+	LiveActivityEventEnd    = "end"
+)
+
+// Payload is a notification payload: the aps dictionary Apple defines plus
+// any custom keys the app reads.
+type Payload struct {
+	Aps Aps
+	// Custom holds app-specific top-level keys. The "aps" key is reserved.
+	Custom map[string]any
+}
+
+// MarshalJSON encodes the payload as one JSON object.
+func (p *Payload) MarshalJSON() ([]byte, error) {
+	out := make(map[string]any, len(p.Custom)+1)
+	for k, v := range p.Custom {
+		if k == "aps" {
+			return nil, fmt.Errorf("apns: custom payload key %q is reserved", k)
+		}
+		out[k] = v
+	}
+	out["aps"] = p.Aps
+	return json.Marshal(out)
+}
+
+// Aps is the aps dictionary of a payload.
+type Aps struct {
+	Alert *Alert `json:"alert,omitempty"`
+	Badge *int   `json:"badge,omitempty"`
+	// Sound is a sound file name, or a *CriticalSound for critical alerts.
+	Sound    any    `json:"sound,omitempty"`
+	ThreadID string `json:"thread-id,omitempty"`
+	Category string `json:"category,omitempty"`
+	// ContentAvailable set to 1 wakes the app in the background.
+	ContentAvailable int `json:"content-available,omitempty"`
+	// MutableContent set to 1 lets a notification service extension modify
+	// the notification before it is displayed.
+	MutableContent    int     `json:"mutable-content,omitempty"`
+	TargetContentID   string  `json:"target-content-id,omitempty"`
+	InterruptionLevel string  `json:"interruption-level,omitempty"`
+	RelevanceScore    float64 `json:"relevance-score,omitempty"`
+	FilterCriteria    string  `json:"filter-criteria,omitempty"`
+
+	// Live Activity keys.
+	Timestamp      int64  `json:"timestamp,omitempty"`
+	Event          string `json:"event,omitempty"`
+	ContentState   any    `json:"content-state,omitempty"`
+	StaleDate      int64  `json:"stale-date,omitempty"`
+	DismissalDate  int64  `json:"dismissal-date,omitempty"`
+	AttributesType string `json:"attributes-type,omitempty"`
+	Attributes     any    `json:"attributes,omitempty"`
+}
+
+// Alert is the text of an alert notification. Set either the literal strings
+// or the localization keys the app's Localizable.strings resolves.
+type Alert struct {
+	Title           string   `json:"title,omitempty"`
+	Subtitle        string   `json:"subtitle,omitempty"`
+	Body            string   `json:"body,omitempty"`
+	LaunchImage     string   `json:"launch-image,omitempty"`
+	TitleLocKey     string   `json:"title-loc-key,omitempty"`
+	TitleLocArgs    []string `json:"title-loc-args,omitempty"`
+	SubtitleLocKey  string   `json:"subtitle-loc-key,omitempty"`
+	SubtitleLocArgs []string `json:"subtitle-loc-args,omitempty"`
+	LocKey          string   `json:"loc-key,omitempty"`
+	LocArgs         []string `json:"loc-args,omitempty"`
+}
+
+// CriticalSound is the sound of a critical alert.
+type CriticalSound struct {
+	Critical int    `json:"critical"`
+	Name     string `json:"name"`
+	// Volume is between 0 and 1.
+	Volume float64 `json:"volume"`
+}
+
+// NewAlert returns a payload that displays title and body.
+func NewAlert(title, body string) *Payload {
+	return &Payload{Aps: Aps{Alert: &Alert{Title: title, Body: body}}}
+}
+
+// NewBackground returns a payload that wakes the app in the background
+// without displaying anything. Send it with PushTypeBackground.
+func NewBackground() *Payload {
+	return &Payload{Aps: Aps{ContentAvailable: 1}}
+}
+
+// NewLiveActivityEvent returns a payload that starts, updates or ends a Live
+// Activity with contentState, which must match the activity's
+// ContentState type. timestamp orders updates; the device drops updates
+// older than the one it shows. Send it with PushTypeLiveActivity.
+func NewLiveActivityEvent(event string, contentState any, timestamp int64) *Payload {
+	return &Payload{Aps: Aps{Event: event, ContentState: contentState, Timestamp: timestamp}}
+}
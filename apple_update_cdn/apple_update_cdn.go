@@ -4,14 +4,18 @@ import (
 	"github.com/deploymenttheory/go-api-sdk-apple/apple_update_cdn/apple_update_cdn_api/cdn"
 	"github.com/deploymenttheory/go-api-sdk-apple/apple_update_cdn/apple_update_cdn_api/firmware"
 	"github.com/deploymenttheory/go-api-sdk-apple/apple_update_cdn/apple_update_cdn_api/gdmf"
+	"github.com/deploymenttheory/go-api-sdk-apple/apple_update_cdn/apple_update_cdn_api/sofa"
+	"github.com/deploymenttheory/go-api-sdk-apple/apple_update_cdn/apple_update_cdn_api/sucatalog"
 	"github.com/deploymenttheory/go-api-sdk-apple/apple_update_cdn/client"
 )
 
 // Client is the main entry point for the Apple Update CDN SDK.
-// It provides access to three services:
+// It provides access to five services:
 //   - Firmware: discovers macOS IPSW restore firmware via the ipsw.me API
 //   - GDMF: queries Apple's official signed-version feed (gdmf.apple.com)
 //   - CDN: parses Apple CDN URLs and resolves file metadata via HEAD requests
+//   - SUCatalog: parses Apple's software update catalogs (swscan.apple.com)
+//   - SOFA: reads the macadmins SOFA security-release feeds (sofafeed.macadmins.io)
 type Client struct {
 	transport         *client.Transport
 	AppleUpdateCDNAPI *AppleUpdateCDNAPIClient
@@ -19,9 +23,11 @@ type Client struct {
 
 // AppleUpdateCDNAPIClient groups all Apple Update CDN services.
 type AppleUpdateCDNAPIClient struct {
-	Firmware *firmware.FirmwareService
-	GDMF     *gdmf.GDMFService
-	CDN      *cdn.CDNService
+	Firmware  *firmware.FirmwareService
+	GDMF      *gdmf.GDMFService
+	CDN       *cdn.CDNService
+	SUCatalog *sucatalog.SUCatalogService
+	SOFA      *sofa.SOFAService
 }
 
 // NewClient creates a new Apple Update CDN client with optional configuration.
@@ -41,9 +47,11 @@ func NewClient(options ...client.ClientOption) (*Client, error) {
 	return &Client{
 		transport: transport,
 		AppleUpdateCDNAPI: &AppleUpdateCDNAPIClient{
-			Firmware: firmware.NewService(transport),
-			GDMF:     gdmf.NewService(transport),
-			CDN:      cdn.NewService(transport),
+			Firmware:  firmware.NewService(transport),
+			GDMF:      gdmf.NewService(transport),
+			CDN:       cdn.NewService(transport),
+			SUCatalog: sucatalog.NewService(transport),
+			SOFA:      sofa.NewService(transport),
 		},
 	}, nil
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/deploymenttheory/go-api-sdk-apple/apple_update_cdn/client"
 	"github.com/deploymenttheory/go-api-sdk-apple/apple_update_cdn/constants"
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/version"
 	"resty.dev/v3"
 )
 
@@ -188,6 +189,53 @@ func (s *FirmwareService) GetByDeviceV4(ctx context.Context, identifier string)
 	return &result, resp, nil
 }
 
+// GetSignedFirmwareV4 returns the newest IPSW that Apple currently signs for
+// a device model, with its download URL and checksums. osVersion narrows
+// the search to an OS version: it matches exactly or as a dotted prefix, so
+// "15" selects the newest signed 15.x restore image and "15.3" matches 15.3
+// and 15.3.1. Empty osVersion selects the newest signed image of any version.
+//
+// identifier is the Apple model identifier, e.g. "Mac14,3", "iPhone15,2", "iPad14,4".
+//
+// GET https://api.ipsw.me/v4/device/{identifier}?type=ipsw
+func (s *FirmwareService) GetSignedFirmwareV4(ctx context.Context, identifier, osVersion string) (*FirmwareV4, *resty.Response, error) {
+	device, resp, err := s.GetByDeviceV4(ctx, identifier)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var latest *FirmwareV4
+	for _, fw := range device.Firmwares {
+		if fw == nil || !fw.Signed {
+			continue
+		}
+		if osVersion != "" && fw.Version != osVersion && !strings.HasPrefix(fw.Version, osVersion+".") {
+			continue
+		}
+		if latest == nil || version.Compare(fw.Version, latest.Version) > 0 ||
+			(fw.Version == latest.Version && fw.ReleaseDate.After(latest.ReleaseDate)) {
+			latest = fw
+		}
+	}
+
+	if latest == nil {
+		if osVersion != "" {
+			return nil, resp, fmt.Errorf("no signed %s firmware for %s", osVersion, identifier)
+		}
+		return nil, resp, fmt.Errorf("no signed firmware for %s", identifier)
+	}
+
+	return latest, resp, nil
+}
+
+// GetLatestSignedFirmwareV4 returns the newest IPSW that Apple currently signs
+// for a device model. It is GetSignedFirmwareV4 with no version filter.
+//
+// GET https://api.ipsw.me/v4/device/{identifier}?type=ipsw
+func (s *FirmwareService) GetLatestSignedFirmwareV4(ctx context.Context, identifier string) (*FirmwareV4, *resty.Response, error) {
+	return s.GetSignedFirmwareV4(ctx, identifier, "")
+}
+
 // uniqueVersionsSorted deduplicates firmware entries by BuildID across all
 // devices and returns them sorted newest-first by ReleaseDate.
 func uniqueVersionsSorted(devices map[string]*MacDevice) []*FirmwareV3 {
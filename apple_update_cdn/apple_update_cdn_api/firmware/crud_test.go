@@ -339,6 +339,98 @@ func TestGetByDeviceV4_HTTPError(t *testing.T) {
 	assert.Contains(t, err.Error(), "404")
 }
 
+// =============================================================================
+// GetSignedFirmwareV4 / GetLatestSignedFirmwareV4
+// =============================================================================
+
+const signedDeviceV4JSON = `{
+  "name": "Mac mini (M2, 2023)",
+  "identifier": "Mac14,3",
+  "firmwares": [
+    {
+      "identifier": "Mac14,3",
+      "version": "14.7.3",
+      "buildid": "23H417",
+      "sha256sum": "1111111111111111111111111111111111111111111111111111111111111111",
+      "filesize": 17000000000,
+      "url": "https://updates.cdn-apple.com/2025WinterFCS/fullrestores/072-11111/11111111-1111-1111-1111-111111111111/UniversalMac_14.7.3_23H417_Restore.ipsw",
+      "releasedate": "2025-01-27T00:00:00Z",
+      "signed": true
+    },
+    {
+      "identifier": "Mac14,3",
+      "version": "15.3",
+      "buildid": "24D60",
+      "sha1sum": "abc123def456abc123def456abc123def456abc1",
+      "sha256sum": "abcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890ab",
+      "filesize": 19734779897,
+      "url": "https://updates.cdn-apple.com/2025WinterFCS/fullrestores/122-12345/AAAAAAAA-BBBB-CCCC-DDDD-EEEEEEEEEEEE/UniversalMac_15.3_24D60_Restore.ipsw",
+      "releasedate": "2025-01-27T00:00:00Z",
+      "signed": true
+    },
+    {
+      "identifier": "Mac14,3",
+      "version": "15.2",
+      "buildid": "24C101",
+      "url": "https://updates.cdn-apple.com/2024FallFCS/fullrestores/122-11111/BBBBBBBB-CCCC-DDDD-EEEE-FFFFFFFFFFFF/UniversalMac_15.2_24C101_Restore.ipsw",
+      "releasedate": "2024-12-11T00:00:00Z",
+      "signed": false
+    }
+  ]
+}`
+
+func TestGetLatestSignedFirmwareV4_Success(t *testing.T) {
+	svc := setupMockClient(t)
+
+	httpmock.RegisterResponder("GET", "https://api.ipsw.me/v4/device/Mac14,3",
+		jsonResponder(200, signedDeviceV4JSON))
+
+	fw, resp, err := svc.GetLatestSignedFirmwareV4(context.Background(), "Mac14,3")
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.NotNil(t, fw)
+	assert.Equal(t, "15.3", fw.Version)
+	assert.Equal(t, "24D60", fw.BuildID)
+	assert.Equal(t, "abcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890ab", fw.SHA256Sum)
+	assert.Contains(t, fw.URL, "UniversalMac_15.3_24D60_Restore.ipsw")
+}
+
+func TestGetSignedFirmwareV4_MajorVersion(t *testing.T) {
+	svc := setupMockClient(t)
+
+	httpmock.RegisterResponder("GET", "https://api.ipsw.me/v4/device/Mac14,3",
+		jsonResponder(200, signedDeviceV4JSON))
+
+	fw, _, err := svc.GetSignedFirmwareV4(context.Background(), "Mac14,3", "14")
+
+	require.NoError(t, err)
+	assert.Equal(t, "23H417", fw.BuildID)
+}
+
+func TestGetSignedFirmwareV4_UnsignedVersion(t *testing.T) {
+	svc := setupMockClient(t)
+
+	httpmock.RegisterResponder("GET", "https://api.ipsw.me/v4/device/Mac14,3",
+		jsonResponder(200, signedDeviceV4JSON))
+
+	fw, resp, err := svc.GetSignedFirmwareV4(context.Background(), "Mac14,3", "15.2")
+
+	require.Error(t, err)
+	require.NotNil(t, resp)
+	assert.Nil(t, fw)
+	assert.Contains(t, err.Error(), "no signed 15.2 firmware for Mac14,3")
+}
+
+func TestGetSignedFirmwareV4_EmptyIdentifierError(t *testing.T) {
+	svc := setupMockClient(t)
+
+	_, _, err := svc.GetSignedFirmwareV4(context.Background(), "", "15")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "device identifier is required")
+}
+
 // =============================================================================
 // ListAllFirmwareV3 (unfiltered)
 // =============================================================================
@@ -10,3 +10,20 @@ const (
 	// PlatformVisionOS is the visionOS platform key in the GDMF response.
 	PlatformVisionOS = "visionOS"
 )
+
+// Asset set names, as reported in OSVersion.AssetSet.
+const (
+	// AssetSetPublic is the PublicAssetSets feed of publicly released versions.
+	AssetSetPublic = "PublicAssetSets"
+
+	// AssetSetAll is the AssetSets feed, which may include versions only
+	// offered to devices enrolled in seed programmes.
+	AssetSetAll = "AssetSets"
+
+	// AssetSetBackgroundSecurityImprovements is the
+	// PublicBackgroundSecurityImprovements feed of rapid security responses.
+	AssetSetBackgroundSecurityImprovements = "PublicBackgroundSecurityImprovements"
+)
+
+// feedDateLayout is the layout of PostingDate and ExpirationDate in the feed.
+const feedDateLayout = "2006-01-02"
@@ -2,6 +2,8 @@ package gdmf
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-apple/apple_update_cdn/client"
 	"github.com/deploymenttheory/go-api-sdk-apple/apple_update_cdn/constants"
@@ -49,3 +51,45 @@ func (s *GDMFService) GetPublicVersionsV2(ctx context.Context) (*GDMFResponse, *
 
 	return &result, resp, nil
 }
+
+// GetAvailableOSVersionsV2 fetches the GDMF feed and returns the available OS
+// versions matching opts, with their build numbers and supported device
+// identifiers, sorted by platform and newest version first.
+//
+// GET https://gdmf.apple.com/v2/pmv
+func (s *GDMFService) GetAvailableOSVersionsV2(ctx context.Context, opts *AvailableVersionsOptions) ([]OSVersion, *resty.Response, error) {
+	result, resp, err := s.GetPublicVersionsV2(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.AvailableVersions(opts), resp, nil
+}
+
+// GetLatestOSVersionForDeviceV2 returns the newest unexpired public version of
+// platform that supports deviceID, suitable as a DDM software update
+// enforcement target.
+//
+// GET https://gdmf.apple.com/v2/pmv
+func (s *GDMFService) GetLatestOSVersionForDeviceV2(ctx context.Context, platform, deviceID string) (*OSVersion, *resty.Response, error) {
+	if platform == "" {
+		return nil, nil, fmt.Errorf("platform is required")
+	}
+	if deviceID == "" {
+		return nil, nil, fmt.Errorf("device ID is required")
+	}
+
+	versions, resp, err := s.GetAvailableOSVersionsV2(ctx, &AvailableVersionsOptions{
+		Platform:         platform,
+		DeviceID:         deviceID,
+		ExcludeExpiredAt: time.Now(),
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+	if len(versions) == 0 {
+		return nil, resp, fmt.Errorf("no available %s version supports device %s", platform, deviceID)
+	}
+
+	return &versions[0], resp, nil
+}
@@ -31,3 +31,10 @@ func RegisterGetPublicVersions() {
 	httpmock.RegisterResponder("GET", "https://gdmf.apple.com/v2/pmv",
 		jsonResponder(200, loadFixture("validate_get_public_versions.json")))
 }
+
+// RegisterGetAvailableVersions registers a GDMF v2 pmv responder whose feed
+// lists several versions per platform, including seed and expired entries.
+func RegisterGetAvailableVersions() {
+	httpmock.RegisterResponder("GET", "https://gdmf.apple.com/v2/pmv",
+		jsonResponder(200, loadFixture("validate_get_available_versions.json")))
+}
@@ -1,5 +1,10 @@
 package gdmf
 
+import (
+	"strings"
+	"time"
+)
+
 // GDMFResponse is the top-level response from the Apple GDMF API
 // (https://gdmf.apple.com/v2/pmv).
 //
@@ -39,3 +44,58 @@ type AssetEntry struct {
 	// Mac model identifiers (e.g. "Mac-1E7E29AD0135F9BC") that support this version.
 	SupportedDevices []string `json:"SupportedDevices"`
 }
+
+// OSVersion is a typed, flattened view of an AssetEntry, annotated with the
+// platform and asset set it was listed under. ProductVersion and Build map
+// directly onto the TargetOSVersion and TargetBuildVersion of a DDM
+// softwareupdate.enforcement.specific declaration.
+type OSVersion struct {
+	Platform         string
+	AssetSet         string
+	ProductVersion   string
+	Build            string
+	PostingDate      time.Time
+	ExpirationDate   time.Time
+	SupportedDevices []string
+}
+
+// Supports reports whether deviceID (a board configuration such as "J473AP",
+// a Mac model identifier such as "Mac-1E7E29AD0135F9BC", or a product type such
+// as "iPhone15,2") is listed as supported by this version. Matching is
+// case-insensitive.
+func (v *OSVersion) Supports(deviceID string) bool {
+	for _, supported := range v.SupportedDevices {
+		if strings.EqualFold(supported, deviceID) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsExpired reports whether the version's signing window ended before now.
+// Versions without an expiration date never expire.
+func (v *OSVersion) IsExpired(now time.Time) bool {
+	return !v.ExpirationDate.IsZero() && v.ExpirationDate.Before(now)
+}
+
+// AvailableVersionsOptions filters the versions returned by
+// GetAvailableOSVersionsV2 and GDMFResponse.AvailableVersions.
+type AvailableVersionsOptions struct {
+	// Platform limits results to PlatformMacOS, PlatformIOS or PlatformVisionOS.
+	// Empty returns all platforms.
+	Platform string
+
+	// DeviceID limits results to versions that support this identifier.
+	DeviceID string
+
+	// IncludeAssetSets adds versions only listed in AssetSets, such as those
+	// offered to seed programme devices.
+	IncludeAssetSets bool
+
+	// IncludeBackgroundSecurityImprovements adds rapid security responses.
+	IncludeBackgroundSecurityImprovements bool
+
+	// ExcludeExpiredAt drops versions whose expiration date is before this
+	// time. The zero value keeps all versions.
+	ExcludeExpiredAt time.Time
+}
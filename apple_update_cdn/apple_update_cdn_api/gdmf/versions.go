@@ -0,0 +1,115 @@
+package gdmf
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/version"
+)
+
+// namedAssetSet pairs an asset set with its feed name.
+type namedAssetSet struct {
+	name   string
+	assets *PlatformAssetSets
+}
+
+// AvailableVersions flattens the feed into OSVersion values matching opts,
+// sorted by platform and then newest version first. A build listed in more
+// than one asset set is returned once, attributed to the first set it
+// appears in (public, then all, then background security improvements).
+func (r *GDMFResponse) AvailableVersions(opts *AvailableVersionsOptions) []OSVersion {
+	if opts == nil {
+		opts = &AvailableVersionsOptions{}
+	}
+
+	sets := []namedAssetSet{{AssetSetPublic, r.PublicAssetSets}}
+	if opts.IncludeAssetSets {
+		sets = append(sets, namedAssetSet{AssetSetAll, r.AssetSets})
+	}
+	if opts.IncludeBackgroundSecurityImprovements {
+		sets = append(sets, namedAssetSet{AssetSetBackgroundSecurityImprovements, r.PublicBackgroundSecurityImprovements})
+	}
+
+	seen := make(map[string]bool)
+	var versions []OSVersion
+
+	for _, set := range sets {
+		if set.assets == nil {
+			continue
+		}
+		for _, platform := range []string{PlatformMacOS, PlatformIOS, PlatformVisionOS} {
+			if opts.Platform != "" && opts.Platform != platform {
+				continue
+			}
+			for _, entry := range set.assets.entries(platform) {
+				if entry == nil {
+					continue
+				}
+				key := platform + "/" + entry.Build
+				if seen[key] {
+					continue
+				}
+
+				version := newOSVersion(platform, set.name, entry)
+				if opts.DeviceID != "" && !version.Supports(opts.DeviceID) {
+					continue
+				}
+				if !opts.ExcludeExpiredAt.IsZero() && version.IsExpired(opts.ExcludeExpiredAt) {
+					continue
+				}
+
+				seen[key] = true
+				versions = append(versions, version)
+			}
+		}
+	}
+
+	slices.SortStableFunc(versions, func(a, b OSVersion) int {
+		if a.Platform != b.Platform {
+			return strings.Compare(a.Platform, b.Platform)
+		}
+		return CompareVersions(b.ProductVersion, a.ProductVersion)
+	})
+
+	return versions
+}
+
+// entries returns the asset entries listed for platform.
+func (p *PlatformAssetSets) entries(platform string) []*AssetEntry {
+	switch platform {
+	case PlatformMacOS:
+		return p.MacOS
+	case PlatformIOS:
+		return p.IOS
+	case PlatformVisionOS:
+		return p.VisionOS
+	}
+	return nil
+}
+
+// newOSVersion converts a feed entry into an OSVersion. Unparseable dates are
+// left as the zero time.
+func newOSVersion(platform, assetSet string, entry *AssetEntry) OSVersion {
+	posted, _ := time.Parse(feedDateLayout, entry.PostingDate)
+	expires, _ := time.Parse(feedDateLayout, entry.ExpirationDate)
+
+	return OSVersion{
+		Platform:         platform,
+		AssetSet:         assetSet,
+		ProductVersion:   entry.ProductVersion,
+		Build:            entry.Build,
+		PostingDate:      posted,
+		ExpirationDate:   expires,
+		SupportedDevices: entry.SupportedDevices,
+	}
+}
+
+// CompareVersions compares dotted OS version strings numerically, returning
+// -1 if a < b, 0 if they are equal and +1 if a > b. Missing components count
+// as zero, so "15.3" equals "15.3.0". A suffix after the dotted version, such
+// as the "(a)" of a background security improvement, orders after the bare
+// version, so "15.3 (a)" sorts between "15.3" and "15.3.1".
+func CompareVersions(a, b string) int {
+	return version.Compare(a, b)
+}
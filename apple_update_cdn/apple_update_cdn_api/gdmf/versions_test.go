@@ -0,0 +1,176 @@
+package gdmf
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/apple_update_cdn/apple_update_cdn_api/gdmf/mocks"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func builds(versions []OSVersion) []string {
+	out := make([]string, 0, len(versions))
+	for _, v := range versions {
+		out = append(out, v.Build)
+	}
+	return out
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"15.3", "15.3", 0},
+		{"15.3", "15.3.0", 0},
+		{"15.3.1", "15.3", 1},
+		{"15.2", "15.10", -1},
+		{"14.7.3", "15.0", -1},
+		{"18.3", "17.7.4", 1},
+		{"15.3 (a)", "15.3", 1},
+		{"15.3 (a)", "15.3.1", -1},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, CompareVersions(tt.a, tt.b), "%s vs %s", tt.a, tt.b)
+	}
+}
+
+func TestGetAvailableOSVersionsV2_PublicOnly(t *testing.T) {
+	svc := setupMockClient(t)
+	mocks.RegisterGetAvailableVersions()
+
+	versions, resp, err := svc.GetAvailableOSVersionsV2(context.Background(), nil)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, 200, resp.StatusCode())
+	assert.Equal(t, []string{"22D60", "21H420", "24D60", "24C101", "23H417", "22N330"}, builds(versions))
+
+	for _, v := range versions {
+		assert.Equal(t, AssetSetPublic, v.AssetSet)
+	}
+
+	assert.Equal(t, PlatformIOS, versions[0].Platform)
+	assert.Equal(t, "18.3", versions[0].ProductVersion)
+	assert.Equal(t, time.Date(2025, 1, 27, 0, 0, 0, 0, time.UTC), versions[0].PostingDate)
+	assert.Equal(t, time.Date(2099, 5, 1, 0, 0, 0, 0, time.UTC), versions[0].ExpirationDate)
+}
+
+func TestGetAvailableOSVersionsV2_FilterPlatformAndDevice(t *testing.T) {
+	svc := setupMockClient(t)
+	mocks.RegisterGetAvailableVersions()
+
+	versions, _, err := svc.GetAvailableOSVersionsV2(context.Background(), &AvailableVersionsOptions{
+		Platform: PlatformMacOS,
+		DeviceID: "j132ap",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"23H417"}, builds(versions))
+}
+
+func TestGetAvailableOSVersionsV2_ExcludeExpired(t *testing.T) {
+	svc := setupMockClient(t)
+	mocks.RegisterGetAvailableVersions()
+
+	versions, _, err := svc.GetAvailableOSVersionsV2(context.Background(), &AvailableVersionsOptions{
+		Platform:         PlatformMacOS,
+		ExcludeExpiredAt: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"24D60", "23H417"}, builds(versions))
+}
+
+func TestGetAvailableOSVersionsV2_IncludeAllAssetSets(t *testing.T) {
+	svc := setupMockClient(t)
+	mocks.RegisterGetAvailableVersions()
+
+	versions, _, err := svc.GetAvailableOSVersionsV2(context.Background(), &AvailableVersionsOptions{
+		Platform:                              PlatformMacOS,
+		IncludeAssetSets:                      true,
+		IncludeBackgroundSecurityImprovements: true,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"24E5206s", "24D60a", "24D60", "24C101", "23H417"}, builds(versions))
+
+	sets := make(map[string]string)
+	for _, v := range versions {
+		sets[v.Build] = v.AssetSet
+	}
+	assert.Equal(t, AssetSetPublic, sets["24D60"], "builds in both sets are attributed to the public set")
+	assert.Equal(t, AssetSetAll, sets["24E5206s"])
+	assert.Equal(t, AssetSetBackgroundSecurityImprovements, sets["24D60a"])
+}
+
+func TestGetAvailableOSVersionsV2_HTTPError(t *testing.T) {
+	svc := setupMockClient(t)
+
+	httpmock.RegisterResponder("GET", "https://gdmf.apple.com/v2/pmv",
+		httpmock.NewStringResponder(503, "Service Unavailable"))
+
+	versions, resp, err := svc.GetAvailableOSVersionsV2(context.Background(), nil)
+
+	require.Error(t, err)
+	require.NotNil(t, resp)
+	assert.Nil(t, versions)
+}
+
+func TestGetLatestOSVersionForDeviceV2_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mocks.RegisterGetAvailableVersions()
+
+	version, _, err := svc.GetLatestOSVersionForDeviceV2(context.Background(), PlatformIOS, "iPhone12,1")
+
+	require.NoError(t, err)
+	require.NotNil(t, version)
+	assert.Equal(t, "17.7.4", version.ProductVersion)
+	assert.Equal(t, "21H420", version.Build)
+}
+
+func TestGetLatestOSVersionForDeviceV2_SkipsSeedBuilds(t *testing.T) {
+	svc := setupMockClient(t)
+	mocks.RegisterGetAvailableVersions()
+
+	version, _, err := svc.GetLatestOSVersionForDeviceV2(context.Background(), PlatformMacOS, "J473AP")
+
+	require.NoError(t, err)
+	assert.Equal(t, "15.3", version.ProductVersion)
+}
+
+func TestGetLatestOSVersionForDeviceV2_NoMatch(t *testing.T) {
+	svc := setupMockClient(t)
+	mocks.RegisterGetAvailableVersions()
+
+	version, _, err := svc.GetLatestOSVersionForDeviceV2(context.Background(), PlatformIOS, "iPhone8,1")
+
+	require.Error(t, err)
+	assert.Nil(t, version)
+	assert.Contains(t, err.Error(), "iPhone8,1")
+}
+
+func TestGetLatestOSVersionForDeviceV2_Validation(t *testing.T) {
+	svc := setupMockClient(t)
+
+	_, _, err := svc.GetLatestOSVersionForDeviceV2(context.Background(), "", "J473AP")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "platform is required")
+
+	_, _, err = svc.GetLatestOSVersionForDeviceV2(context.Background(), PlatformMacOS, "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "device ID is required")
+}
+
+func TestOSVersion_IsExpired(t *testing.T) {
+	v := OSVersion{ExpirationDate: time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC)}
+
+	assert.False(t, v.IsExpired(time.Date(2025, 2, 9, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, v.IsExpired(time.Date(2025, 2, 11, 0, 0, 0, 0, time.UTC)))
+	var undated OSVersion
+	assert.False(t, undated.IsExpired(time.Now()), "a missing expiration date never expires")
+}
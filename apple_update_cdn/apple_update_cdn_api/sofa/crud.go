@@ -0,0 +1,59 @@
+package sofa
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/apple_update_cdn/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/apple_update_cdn/constants"
+	"resty.dev/v3"
+)
+
+// SOFAService handles the SOFA (Simple Organized Feed for Apple software
+// updates) feeds published by the macadmins community.
+//
+// SOFA combines Apple's security notes, GDMF and catalog data into one JSON
+// feed per platform, listing every release with the CVEs it fixes, whether
+// they are actively exploited and which hardware it supports.
+//
+// SOFA: https://sofa.macadmins.io
+type SOFAService struct {
+	client client.Client
+}
+
+// NewService creates a new SOFA service.
+func NewService(c client.Client) *SOFAService {
+	return &SOFAService{client: c}
+}
+
+// getFeed fetches and decodes the SOFA feed at endpoint.
+func (s *SOFAService) getFeed(ctx context.Context, endpoint string) (*Feed, *resty.Response, error) {
+	var result Feed
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetResult(&result).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// GetMacOSFeedV1 fetches the SOFA macOS feed: every macOS family with its
+// security releases and CVEs, the hardware model map, installer links and
+// XProtect versions.
+//
+// GET https://sofafeed.macadmins.io/v1/macos_data_feed.json
+func (s *SOFAService) GetMacOSFeedV1(ctx context.Context) (*Feed, *resty.Response, error) {
+	return s.getFeed(ctx, constants.EndpointSOFAMacOSFeed)
+}
+
+// GetIOSFeedV1 fetches the SOFA iOS/iPadOS feed: every iOS family with its
+// security releases, CVEs and supported devices.
+//
+// GET https://sofafeed.macadmins.io/v1/ios_data_feed.json
+func (s *SOFAService) GetIOSFeedV1(ctx context.Context) (*Feed, *resty.Response, error) {
+	return s.getFeed(ctx, constants.EndpointSOFAIOSFeed)
+}
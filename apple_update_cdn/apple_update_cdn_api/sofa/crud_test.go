@@ -0,0 +1,179 @@
+package sofa
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/apple_update_cdn/apple_update_cdn_api/sofa/mocks"
+	"github.com/deploymenttheory/go-api-sdk-apple/apple_update_cdn/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/apple_update_cdn/constants"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// setupMockClient creates a SOFA transport with httpmock enabled.
+func setupMockClient(t *testing.T) *SOFAService {
+	t.Helper()
+
+	transport, err := client.NewTransport(
+		client.WithLogger(zap.NewNop()),
+		client.WithRetryCount(0),
+	)
+	require.NoError(t, err)
+
+	httpmock.ActivateNonDefault(transport.GetHTTPClient().Client())
+	t.Cleanup(func() {
+		httpmock.DeactivateAndReset()
+	})
+
+	return NewService(transport)
+}
+
+// macOSFeed fetches the mocked macOS feed.
+func macOSFeed(t *testing.T) *Feed {
+	t.Helper()
+
+	svc := setupMockClient(t)
+	mocks.RegisterGetMacOSFeed()
+
+	feed, _, err := svc.GetMacOSFeedV1(context.Background())
+	require.NoError(t, err)
+	return feed
+}
+
+// =============================================================================
+// GetMacOSFeedV1 / GetIOSFeedV1
+// =============================================================================
+
+func TestGetMacOSFeedV1_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mocks.RegisterGetMacOSFeed()
+
+	feed, resp, err := svc.GetMacOSFeedV1(context.Background())
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, 200, resp.StatusCode())
+	assert.Equal(t, "b1946ac92492d2347c6235b4d2611184", feed.UpdateHash)
+	require.Len(t, feed.OSVersions, 2)
+
+	sequoia := feed.OSVersions[0]
+	assert.Equal(t, "Sequoia 15", sequoia.OSVersion)
+	assert.Equal(t, "15.3", sequoia.Latest.ProductVersion)
+	assert.Equal(t, "24D60", sequoia.Latest.Build)
+	assert.Equal(t, time.Date(2025, 1, 27, 0, 0, 0, 0, time.UTC), sequoia.Latest.ReleaseDate)
+	assert.True(t, sequoia.Latest.CVEs["CVE-2025-24085"])
+	assert.Equal(t, []string{"CVE-2025-24085"}, sequoia.Latest.ActivelyExploitedCVEs)
+	require.Len(t, sequoia.SecurityReleases, 3)
+	assert.Equal(t, "OS", sequoia.SecurityReleases[0].ReleaseType)
+	assert.Equal(t, 46, sequoia.SecurityReleases[0].DaysSincePreviousRelease)
+	assert.Equal(t, "Mac mini (2023)", sequoia.SupportedModels[0].Identifiers["Mac14,3"])
+
+	assert.Empty(t, feed.OSVersions[1].Latest.ExpirationDate)
+}
+
+func TestGetMacOSFeedV1_MacOSOnlySections(t *testing.T) {
+	feed := macOSFeed(t)
+
+	assert.Equal(t, []int{15, 14}, feed.Models["Mac14,3"].OSVersions)
+
+	require.NotNil(t, feed.InstallationApps)
+	require.NotNil(t, feed.InstallationApps.LatestUMA)
+	assert.Equal(t, "15.3", feed.InstallationApps.LatestUMA.Version)
+	require.Len(t, feed.InstallationApps.AllPreviousUMA, 1)
+	require.NotNil(t, feed.InstallationApps.LatestMacIPSW)
+	assert.Equal(t, "24D60", feed.InstallationApps.LatestMacIPSW.Build)
+
+	assert.Equal(t, "5287", feed.XProtectPlistConfigData["com.apple.XProtect"])
+	assert.Equal(t, "149", feed.XProtectPayloads["com.apple.XProtectFramework.XProtect"])
+}
+
+func TestGetIOSFeedV1_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mocks.RegisterGetIOSFeed()
+
+	feed, _, err := svc.GetIOSFeedV1(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, feed.OSVersions, 1)
+	assert.Equal(t, "18", feed.OSVersions[0].OSVersion)
+	assert.Equal(t, "22D60", feed.OSVersions[0].Latest.Build)
+	assert.Nil(t, feed.InstallationApps)
+	assert.Empty(t, feed.Models)
+}
+
+func TestGetMacOSFeedV1_HTTPError(t *testing.T) {
+	svc := setupMockClient(t)
+
+	httpmock.RegisterResponder("GET", constants.EndpointSOFAMacOSFeed,
+		httpmock.NewStringResponder(503, "Service Unavailable"))
+
+	feed, resp, err := svc.GetMacOSFeedV1(context.Background())
+
+	require.Error(t, err)
+	require.NotNil(t, resp)
+	assert.Nil(t, feed)
+	assert.Contains(t, err.Error(), "503")
+}
+
+// =============================================================================
+// Feed lookups
+// =============================================================================
+
+func TestFeed_FindFamilyAndRelease(t *testing.T) {
+	feed := macOSFeed(t)
+
+	family := feed.FindFamily("14.6.1")
+	require.NotNil(t, family)
+	assert.Equal(t, "Sonoma 14", family.OSVersion)
+
+	assert.Nil(t, feed.FindFamily("13.7"))
+
+	release := feed.FindRelease("15.2")
+	require.NotNil(t, release)
+	assert.Equal(t, "macOS Sequoia 15.2", release.UpdateName)
+
+	assert.Nil(t, feed.FindRelease("15.2.1"))
+}
+
+func TestFeed_SecurityStatus_Outdated(t *testing.T) {
+	feed := macOSFeed(t)
+
+	status, err := feed.SecurityStatus("15.1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Sequoia 15", status.OSVersion)
+	assert.False(t, status.IsLatest)
+	assert.Equal(t, "15.3", status.Latest.ProductVersion)
+	require.Len(t, status.MissingReleases, 2)
+	assert.Equal(t, "15.3", status.MissingReleases[0].ProductVersion)
+	assert.Equal(t, "15.2", status.MissingReleases[1].ProductVersion)
+	assert.Equal(t, []string{"CVE-2024-45490", "CVE-2024-54526", "CVE-2025-24085", "CVE-2025-24107"}, status.ExposedCVEs)
+	assert.Equal(t, []string{"CVE-2025-24085"}, status.ActivelyExploitedCVEs)
+}
+
+func TestFeed_SecurityStatus_Latest(t *testing.T) {
+	feed := macOSFeed(t)
+
+	status, err := feed.SecurityStatus("14.7.3")
+
+	require.NoError(t, err)
+	assert.True(t, status.IsLatest, "the latest release of an older family is up to date")
+	assert.Empty(t, status.MissingReleases)
+	assert.Empty(t, status.ExposedCVEs)
+}
+
+func TestFeed_SecurityStatus_Errors(t *testing.T) {
+	feed := macOSFeed(t)
+
+	_, err := feed.SecurityStatus("")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "product version is required")
+
+	_, err = feed.SecurityStatus("12.7.6")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no OS family in feed for version 12.7.6")
+}
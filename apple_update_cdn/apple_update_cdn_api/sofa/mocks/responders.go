@@ -0,0 +1,39 @@
+package mocks
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/jarcoal/httpmock"
+)
+
+// loadFixture reads a JSON fixture file from the mocks directory.
+func loadFixture(filename string) string {
+	data, err := os.ReadFile(filepath.Join("mocks", filename))
+	if err != nil {
+		return `{}`
+	}
+	return string(data)
+}
+
+// jsonResponder returns an httpmock.Responder that serves JSON with the given status code.
+func jsonResponder(status int, body string) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		resp := httpmock.NewStringResponse(status, body)
+		resp.Header.Set("Content-Type", "application/json")
+		return resp, nil
+	}
+}
+
+// RegisterGetMacOSFeed registers the mock responder for the SOFA macOS feed.
+func RegisterGetMacOSFeed() {
+	httpmock.RegisterResponder("GET", "https://sofafeed.macadmins.io/v1/macos_data_feed.json",
+		jsonResponder(200, loadFixture("validate_get_macos_feed.json")))
+}
+
+// RegisterGetIOSFeed registers the mock responder for the SOFA iOS feed.
+func RegisterGetIOSFeed() {
+	httpmock.RegisterResponder("GET", "https://sofafeed.macadmins.io/v1/ios_data_feed.json",
+		jsonResponder(200, loadFixture("validate_get_ios_feed.json")))
+}
@@ -0,0 +1,142 @@
+package sofa
+
+import "time"
+
+// Feed is a SOFA data feed for one platform (macOS or iOS/iPadOS).
+type Feed struct {
+	// UpdateHash changes whenever the feed content changes; compare it to
+	// skip reprocessing an unchanged feed.
+	UpdateHash string `json:"UpdateHash"`
+	// OSVersions lists each major release family, newest first.
+	OSVersions []OSVersionFamily `json:"OSVersions"`
+	// Models maps model identifiers (e.g. "Mac16,1") to their supported OS
+	// families. Populated in the macOS feed only.
+	Models map[string]Model `json:"Models,omitempty"`
+	// InstallationApps lists the latest installers and IPSW. Populated in the
+	// macOS feed only.
+	InstallationApps *InstallationApps `json:"InstallationApps,omitempty"`
+	// XProtectPayloads maps XProtect bundle identifiers to their current
+	// version, plus a "ReleaseDate" entry. Populated in the macOS feed only.
+	XProtectPayloads map[string]string `json:"XProtectPayloads,omitempty"`
+	// XProtectPlistConfigData maps XProtect config data identifiers to their
+	// current version, plus a "ReleaseDate" entry. Populated in the macOS feed
+	// only.
+	XProtectPlistConfigData map[string]string `json:"XProtectPlistConfigData,omitempty"`
+}
+
+// OSVersionFamily is one major OS release (e.g. "Sequoia 15" or "18") with
+// its latest build and security release history.
+type OSVersionFamily struct {
+	// OSVersion is the family name, e.g. "Sequoia 15" for macOS or "18" for iOS.
+	OSVersion string `json:"OSVersion"`
+	// Latest is the newest release in the family.
+	Latest Release `json:"Latest"`
+	// SecurityReleases lists the family's releases, newest first.
+	SecurityReleases []SecurityRelease `json:"SecurityReleases"`
+	// SupportedModels lists the hardware the family supports.
+	SupportedModels []SupportedModel `json:"SupportedModels,omitempty"`
+}
+
+// Release is the latest release of an OS family.
+type Release struct {
+	ProductVersion string `json:"ProductVersion"`
+	Build          string `json:"Build"`
+	// ReleaseDate is when Apple published the release.
+	ReleaseDate time.Time `json:"ReleaseDate"`
+	// ExpirationDate is when Apple stops signing the release, as published
+	// by SOFA. It may be empty.
+	ExpirationDate   string   `json:"ExpirationDate,omitempty"`
+	SupportedDevices []string `json:"SupportedDevices,omitempty"`
+	// SecurityInfo is the URL of Apple's security content note.
+	SecurityInfo string `json:"SecurityInfo,omitempty"`
+	// CVEs maps each CVE fixed by the release to whether it is actively
+	// exploited.
+	CVEs                  map[string]bool `json:"CVEs,omitempty"`
+	ActivelyExploitedCVEs []string        `json:"ActivelyExploitedCVEs,omitempty"`
+	UniqueCVEsCount       int             `json:"UniqueCVEsCount"`
+}
+
+// SecurityRelease is a single release in an OS family's history.
+type SecurityRelease struct {
+	// UpdateName is the release name, e.g. "macOS Sequoia 15.3".
+	UpdateName string `json:"UpdateName"`
+	// ProductName is the product name, e.g. "macOS Sequoia".
+	ProductName    string    `json:"ProductName"`
+	ProductVersion string    `json:"ProductVersion"`
+	ReleaseDate    time.Time `json:"ReleaseDate"`
+	// ReleaseType is "OS" for a full OS release or "RSR" for a rapid security
+	// response.
+	ReleaseType      string   `json:"ReleaseType"`
+	SecurityInfo     string   `json:"SecurityInfo,omitempty"`
+	SupportedDevices []string `json:"SupportedDevices,omitempty"`
+	// CVEs maps each CVE fixed by the release to whether it is actively
+	// exploited.
+	CVEs                     map[string]bool `json:"CVEs,omitempty"`
+	ActivelyExploitedCVEs    []string        `json:"ActivelyExploitedCVEs,omitempty"`
+	UniqueCVEsCount          int             `json:"UniqueCVEsCount"`
+	DaysSincePreviousRelease int             `json:"DaysSincePreviousRelease"`
+}
+
+// SupportedModel is a hardware model supported by an OS family.
+type SupportedModel struct {
+	// Model is the marketing name, e.g. "MacBook Pro (14-inch, M4, 2024)".
+	Model string `json:"Model"`
+	// URL is Apple's identification page for the model.
+	URL string `json:"URL,omitempty"`
+	// Identifiers maps model identifiers to marketing names.
+	Identifiers map[string]string `json:"Identifiers,omitempty"`
+}
+
+// Model is a hardware model entry in the macOS feed's Models map.
+type Model struct {
+	MarketingName string `json:"MarketingName"`
+	// SupportedOS lists the OS family names the model supports.
+	SupportedOS []string `json:"SupportedOS"`
+	// OSVersions lists the major versions the model supports.
+	OSVersions []int `json:"OSVersions"`
+}
+
+// InstallationApps lists the macOS installers and IPSW published in the feed.
+type InstallationApps struct {
+	LatestUMA      *UMAInstaller  `json:"LatestUMA,omitempty"`
+	AllPreviousUMA []UMAInstaller `json:"AllPreviousUMA,omitempty"`
+	LatestMacIPSW  *MacIPSW       `json:"LatestMacIPSW,omitempty"`
+}
+
+// UMAInstaller is a universal macOS installer application package.
+type UMAInstaller struct {
+	Title     string `json:"title"`
+	Version   string `json:"version"`
+	Build     string `json:"build"`
+	AppleSlug string `json:"apple_slug"`
+	URL       string `json:"url"`
+}
+
+// MacIPSW is the latest Apple silicon restore image.
+type MacIPSW struct {
+	URL       string `json:"macos_ipsw_url"`
+	Build     string `json:"macos_ipsw_build"`
+	Version   string `json:"macos_ipsw_version"`
+	AppleSlug string `json:"macos_ipsw_apple_slug"`
+}
+
+// SecurityStatus describes how a device OS version compares against the
+// feed, as returned by Feed.SecurityStatus.
+type SecurityStatus struct {
+	// ProductVersion is the device version that was evaluated.
+	ProductVersion string
+	// OSVersion is the family the version belongs to, e.g. "Sequoia 15".
+	OSVersion string
+	// Latest is the newest release in the family.
+	Latest Release
+	// IsLatest is true when ProductVersion is at or above Latest.
+	IsLatest bool
+	// MissingReleases are the family's security releases newer than
+	// ProductVersion, newest first.
+	MissingReleases []SecurityRelease
+	// ExposedCVEs are the CVEs fixed by MissingReleases, sorted.
+	ExposedCVEs []string
+	// ActivelyExploitedCVEs is the subset of ExposedCVEs known to be
+	// actively exploited, sorted.
+	ActivelyExploitedCVEs []string
+}
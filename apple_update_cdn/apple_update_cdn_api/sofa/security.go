@@ -0,0 +1,91 @@
+package sofa
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/version"
+)
+
+// FindFamily returns the OS family whose major version matches that of
+// productVersion, or nil if the feed doesn't list it.
+func (f *Feed) FindFamily(productVersion string) *OSVersionFamily {
+	major := majorVersion(productVersion)
+	for i := range f.OSVersions {
+		family := &f.OSVersions[i]
+		if majorVersion(family.Latest.ProductVersion) == major {
+			return family
+		}
+	}
+	return nil
+}
+
+// FindRelease returns the security release with exactly productVersion, or
+// nil if the feed doesn't list it.
+func (f *Feed) FindRelease(productVersion string) *SecurityRelease {
+	family := f.FindFamily(productVersion)
+	if family == nil {
+		return nil
+	}
+	for i := range family.SecurityReleases {
+		if family.SecurityReleases[i].ProductVersion == productVersion {
+			return &family.SecurityReleases[i]
+		}
+	}
+	return nil
+}
+
+// SecurityStatus compares a device's OS version against its family in the
+// feed, returning the releases it is missing and the CVEs those releases
+// fix. Only releases within the same major version are considered, so a
+// device on the latest macOS 14 release is reported as up to date even when
+// macOS 15 exists.
+func (f *Feed) SecurityStatus(productVersion string) (*SecurityStatus, error) {
+	if productVersion == "" {
+		return nil, fmt.Errorf("product version is required")
+	}
+
+	family := f.FindFamily(productVersion)
+	if family == nil {
+		return nil, fmt.Errorf("no OS family in feed for version %s", productVersion)
+	}
+
+	status := &SecurityStatus{
+		ProductVersion: productVersion,
+		OSVersion:      family.OSVersion,
+		Latest:         family.Latest,
+		IsLatest:       version.Compare(productVersion, family.Latest.ProductVersion) >= 0,
+	}
+
+	exposed := make(map[string]bool)
+	for _, release := range family.SecurityReleases {
+		if version.Compare(release.ProductVersion, productVersion) <= 0 {
+			continue
+		}
+		status.MissingReleases = append(status.MissingReleases, release)
+		for cve, exploited := range release.CVEs {
+			exposed[cve] = exposed[cve] || exploited
+		}
+		for _, cve := range release.ActivelyExploitedCVEs {
+			exposed[cve] = true
+		}
+	}
+
+	for cve, exploited := range exposed {
+		status.ExposedCVEs = append(status.ExposedCVEs, cve)
+		if exploited {
+			status.ActivelyExploitedCVEs = append(status.ActivelyExploitedCVEs, cve)
+		}
+	}
+	slices.Sort(status.ExposedCVEs)
+	slices.Sort(status.ActivelyExploitedCVEs)
+
+	return status, nil
+}
+
+// majorVersion returns the first dotted component of version.
+func majorVersion(version string) string {
+	major, _, _ := strings.Cut(version, ".")
+	return major
+}
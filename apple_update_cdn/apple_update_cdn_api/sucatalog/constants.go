@@ -0,0 +1,43 @@
+package sucatalog
+
+const (
+	// ProductTypeMacOSInstaller identifies a full "Install macOS" application,
+	// delivered as an InstallAssistant package.
+	ProductTypeMacOSInstaller = "macOSInstaller"
+
+	// ProductTypeBridgeOS identifies a bridgeOS firmware update for Macs with a
+	// T2 security chip.
+	ProductTypeBridgeOS = "bridgeOS"
+
+	// ProductTypeConfigData identifies a background configuration data update,
+	// such as XProtect or Gatekeeper definitions.
+	ProductTypeConfigData = "ConfigData"
+
+	// ProductTypeOther identifies any product that isn't classified more
+	// specifically, such as printer drivers and legacy updates.
+	ProductTypeOther = "other"
+
+	// DefaultDistributionLanguage is the distribution fetched when resolving a
+	// product's title, version and build.
+	DefaultDistributionLanguage = "English"
+
+	// fallbackDistributionLanguage is tried when a product has no English
+	// distribution.
+	fallbackDistributionLanguage = "en"
+
+	// installAssistantKey is the ExtendedMetaInfo key present on full macOS
+	// installer products.
+	installAssistantKey = "InstallAssistantPackageIdentifiers"
+
+	// bridgeOSOrderingKey is the ExtendedMetaInfo key present on bridgeOS
+	// firmware products.
+	bridgeOSOrderingKey = "BridgeOSPredicateProductOrdering"
+
+	// bridgeOSPackageName is part of the package filename of bridgeOS
+	// firmware products that carry no bridgeOS ExtendedMetaInfo.
+	bridgeOSPackageName = "BridgeOSUpdateCustomer"
+
+	// suStringPrefix marks a distribution title that is a key into the
+	// distribution's localized strings table rather than literal text.
+	suStringPrefix = "SU_"
+)
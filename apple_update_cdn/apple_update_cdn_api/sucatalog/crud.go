@@ -0,0 +1,159 @@
+package sucatalog
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/apple_update_cdn/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/apple_update_cdn/constants"
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/version"
+	"resty.dev/v3"
+)
+
+// SUCatalogService handles Apple's software update catalogs.
+//
+// A catalog (.sucatalog) is the XML property list softwareupdate(8) reads to
+// discover products: full macOS installers, bridgeOS firmware, configuration
+// data and legacy updates. Each product lists its packages and links to
+// per-language distribution (.dist) files that carry its title, version and
+// build.
+//
+// Catalog: https://swscan.apple.com/content/catalogs/others/
+type SUCatalogService struct {
+	client client.Client
+}
+
+// NewService creates a new software update catalog service.
+func NewService(c client.Client) *SUCatalogService {
+	return &SUCatalogService{client: c}
+}
+
+// GetCatalogV1 fetches and parses a software update catalog. catalogURL may
+// be one of the EndpointSUCatalog constants or a mirror; empty uses the
+// production catalog.
+//
+// GET https://swscan.apple.com/content/catalogs/others/index-...merged-1.sucatalog
+func (s *SUCatalogService) GetCatalogV1(ctx context.Context, catalogURL string) (*Catalog, *resty.Response, error) {
+	if catalogURL == "" {
+		catalogURL = constants.EndpointSUCatalog
+	}
+
+	resp, body, err := s.client.NewRequest(ctx).GetBytes(catalogURL)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	catalog, err := ParseCatalog(body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to parse catalog: %w", err)
+	}
+
+	return catalog, resp, nil
+}
+
+// GetDistributionV1 fetches and parses a product's distribution file for
+// language, falling back to "en" when the language isn't published. Empty
+// language uses DefaultDistributionLanguage. On success the product's Title,
+// Version and Build are updated from the distribution.
+//
+// GET {product.Distributions[language]}
+func (s *SUCatalogService) GetDistributionV1(ctx context.Context, product *Product, language string) (*Distribution, *resty.Response, error) {
+	if product == nil {
+		return nil, nil, fmt.Errorf("product is required")
+	}
+	if language == "" {
+		language = DefaultDistributionLanguage
+	}
+
+	distURL := product.Distributions[language]
+	if distURL == "" {
+		distURL = product.Distributions[fallbackDistributionLanguage]
+	}
+	if distURL == "" {
+		return nil, nil, fmt.Errorf("product %s has no %s distribution", product.ID, language)
+	}
+
+	resp, body, err := s.client.NewRequest(ctx).GetBytes(distURL)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	dist, err := ParseDistribution(body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("product %s: %w", product.ID, err)
+	}
+	dist.URL = distURL
+
+	product.Title = dist.Title
+	if dist.Version != "" {
+		product.Version = dist.Version
+	}
+	product.Build = dist.Build
+
+	return dist, resp, nil
+}
+
+// ListProductsV1 fetches a catalog and returns the products matching opts,
+// newest first. Filtering on Version or Build fetches the distribution of
+// every product that passes the other filters, so combine them with Type to
+// keep the number of requests down.
+//
+// GET https://swscan.apple.com/content/catalogs/others/index-...merged-1.sucatalog
+func (s *SUCatalogService) ListProductsV1(ctx context.Context, opts *ListProductsOptions) ([]*Product, *resty.Response, error) {
+	if opts == nil {
+		opts = &ListProductsOptions{}
+	}
+
+	catalog, resp, err := s.GetCatalogV1(ctx, opts.CatalogURL)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	products := catalog.FilterProducts(opts.Type, opts.PostedAfter)
+
+	if opts.ResolveDistributions || opts.Version != "" || opts.Build != "" {
+		for _, product := range products {
+			if len(product.Distributions) == 0 {
+				continue
+			}
+			if _, distResp, err := s.GetDistributionV1(ctx, product, ""); err != nil {
+				return nil, distResp, err
+			}
+		}
+	}
+
+	products = slices.DeleteFunc(products, func(p *Product) bool {
+		if opts.Version != "" && !matchesVersion(p.Version, opts.Version) {
+			return true
+		}
+		return opts.Build != "" && p.Build != opts.Build
+	})
+
+	if opts.Version != "" || opts.Build != "" || opts.ResolveDistributions {
+		slices.SortStableFunc(products, func(a, b *Product) int {
+			return version.Compare(b.Version, a.Version)
+		})
+	}
+
+	return products, resp, nil
+}
+
+// ListMacOSInstallersV1 returns the full macOS installers in the production
+// catalog with their titles, versions and builds resolved, newest version
+// first.
+//
+// GET https://swscan.apple.com/content/catalogs/others/index-...merged-1.sucatalog
+func (s *SUCatalogService) ListMacOSInstallersV1(ctx context.Context) ([]*Product, *resty.Response, error) {
+	return s.ListProductsV1(ctx, &ListProductsOptions{
+		Type:                 ProductTypeMacOSInstaller,
+		ResolveDistributions: true,
+	})
+}
+
+// matchesVersion reports whether version equals want or is a more specific
+// version within it ("15.3.1" is within "15" and "15.3").
+func matchesVersion(version, want string) bool {
+	return version == want || strings.HasPrefix(version, want+".")
+}
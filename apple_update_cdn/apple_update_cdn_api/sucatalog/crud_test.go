@@ -0,0 +1,333 @@
+package sucatalog
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/apple_update_cdn/apple_update_cdn_api/sucatalog/mocks"
+	"github.com/deploymenttheory/go-api-sdk-apple/apple_update_cdn/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/apple_update_cdn/constants"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// setupMockClient creates a software update catalog transport with httpmock enabled.
+func setupMockClient(t *testing.T) *SUCatalogService {
+	t.Helper()
+
+	transport, err := client.NewTransport(
+		client.WithLogger(zap.NewNop()),
+		client.WithRetryCount(0),
+	)
+	require.NoError(t, err)
+
+	httpmock.ActivateNonDefault(transport.GetHTTPClient().Client())
+	t.Cleanup(func() {
+		httpmock.DeactivateAndReset()
+	})
+
+	return NewService(transport)
+}
+
+func productIDs(products []*Product) []string {
+	ids := make([]string, 0, len(products))
+	for _, p := range products {
+		ids = append(ids, p.ID)
+	}
+	return ids
+}
+
+// =============================================================================
+// GetCatalogV1
+// =============================================================================
+
+func TestGetCatalogV1_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mocks.RegisterGetCatalog(constants.EndpointSUCatalog)
+
+	catalog, resp, err := svc.GetCatalogV1(context.Background(), "")
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, 200, resp.StatusCode())
+	assert.Equal(t, int64(2), catalog.CatalogVersion)
+	assert.Equal(t, "http://swpost.apple.com/stats", catalog.ApplePostURL)
+	assert.Equal(t, time.Date(2025, 2, 12, 18, 4, 11, 0, time.UTC), catalog.IndexDate)
+	assert.Len(t, catalog.Products, 5)
+}
+
+func TestGetCatalogV1_Installer(t *testing.T) {
+	svc := setupMockClient(t)
+	mocks.RegisterGetCatalog(constants.EndpointSUCatalog)
+
+	catalog, _, err := svc.GetCatalogV1(context.Background(), "")
+	require.NoError(t, err)
+
+	product := catalog.Products["072-56789"]
+	require.NotNil(t, product)
+	assert.Equal(t, "072-56789", product.ID)
+	assert.Equal(t, ProductTypeMacOSInstaller, product.Type)
+	assert.Equal(t, time.Date(2025, 1, 27, 18, 12, 45, 0, time.UTC), product.PostDate)
+	assert.Equal(t, mocks.SequoiaDistributionURL, product.Distributions["English"])
+	assert.Equal(t, "com.apple.pkg.InstallAssistant.macOSSequoia",
+		product.ExtendedMetaInfo.InstallAssistantPackageIdentifiers["SharedSupport"])
+	assert.Contains(t, product.ServerMetadataURL, "InstallAssistantAuto.smd")
+
+	require.Len(t, product.Packages, 2)
+	pkg := product.Packages[0]
+	assert.Contains(t, pkg.URL, "InstallAssistant.pkg")
+	assert.Equal(t, int64(15092305212), pkg.Size)
+	assert.Equal(t, "f2a1c9d8e7b6a5f4e3d2c1b0a9f8e7d6c5b4a392", pkg.Digest)
+	assert.Equal(t, int64(42032), pkg.IntegrityDataSize)
+	assert.Empty(t, product.Version, "installer versions come from the distribution")
+}
+
+func TestGetCatalogV1_ProductTypes(t *testing.T) {
+	svc := setupMockClient(t)
+	mocks.RegisterGetCatalog(constants.EndpointSUCatalog)
+
+	catalog, _, err := svc.GetCatalogV1(context.Background(), "")
+	require.NoError(t, err)
+
+	assert.Equal(t, ProductTypeMacOSInstaller, catalog.Products["062-11111"].Type)
+	assert.Equal(t, ProductTypeBridgeOS, catalog.Products["072-22222"].Type)
+	assert.Equal(t, ProductTypeConfigData, catalog.Products["052-33333"].Type)
+	assert.Equal(t, "5287", catalog.Products["052-33333"].Version)
+	assert.Equal(t, ProductTypeOther, catalog.Products["041-44444"].Type)
+}
+
+func TestGetCatalogV1_SeedCatalog(t *testing.T) {
+	svc := setupMockClient(t)
+	mocks.RegisterGetCatalog(constants.EndpointSUCatalogDeveloperSeed)
+
+	catalog, _, err := svc.GetCatalogV1(context.Background(), constants.EndpointSUCatalogDeveloperSeed)
+
+	require.NoError(t, err)
+	assert.Len(t, catalog.Products, 5)
+}
+
+func TestGetCatalogV1_HTTPError(t *testing.T) {
+	svc := setupMockClient(t)
+
+	httpmock.RegisterResponder("GET", constants.EndpointSUCatalog,
+		httpmock.NewStringResponder(503, "Service Unavailable"))
+
+	catalog, resp, err := svc.GetCatalogV1(context.Background(), "")
+
+	require.Error(t, err)
+	require.NotNil(t, resp)
+	assert.Nil(t, catalog)
+	assert.Contains(t, err.Error(), "503")
+}
+
+func TestGetCatalogV1_InvalidPlist(t *testing.T) {
+	svc := setupMockClient(t)
+
+	httpmock.RegisterResponder("GET", constants.EndpointSUCatalog,
+		httpmock.NewStringResponder(200, `<plist><array><string>x</string></array></plist>`))
+
+	_, _, err := svc.GetCatalogV1(context.Background(), "")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "catalog root is not a dict")
+}
+
+// =============================================================================
+// Catalog.FilterProducts
+// =============================================================================
+
+func TestCatalog_FilterProducts(t *testing.T) {
+	svc := setupMockClient(t)
+	mocks.RegisterGetCatalog(constants.EndpointSUCatalog)
+
+	catalog, _, err := svc.GetCatalogV1(context.Background(), "")
+	require.NoError(t, err)
+
+	all := catalog.FilterProducts("", time.Time{})
+	assert.Equal(t, []string{"052-33333", "072-56789", "062-11111", "072-22222", "041-44444"}, productIDs(all))
+
+	installers := catalog.FilterProducts(ProductTypeMacOSInstaller, time.Time{})
+	assert.Equal(t, []string{"072-56789", "062-11111"}, productIDs(installers))
+
+	recent := catalog.FilterProducts("", time.Date(2025, 1, 27, 18, 11, 0, 0, time.UTC))
+	assert.Equal(t, []string{"052-33333", "072-56789"}, productIDs(recent))
+}
+
+// =============================================================================
+// GetDistributionV1
+// =============================================================================
+
+func TestGetDistributionV1_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mocks.RegisterGetDistributions()
+
+	product := &Product{ID: "072-56789", Distributions: map[string]string{"English": mocks.SequoiaDistributionURL}}
+
+	dist, resp, err := svc.GetDistributionV1(context.Background(), product, "")
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, mocks.SequoiaDistributionURL, dist.URL)
+	assert.Equal(t, "macOS Sequoia", dist.Title)
+	assert.Equal(t, "15.3", dist.Version)
+	assert.Equal(t, "24D60", dist.Build)
+
+	assert.Equal(t, "macOS Sequoia", product.Title)
+	assert.Equal(t, "15.3", product.Version)
+	assert.Equal(t, "24D60", product.Build)
+}
+
+func TestGetDistributionV1_LiteralTitle(t *testing.T) {
+	svc := setupMockClient(t)
+	mocks.RegisterGetDistributions()
+
+	product := &Product{ID: "072-22222", Distributions: map[string]string{"English": mocks.BridgeOSDistributionURL}}
+
+	dist, _, err := svc.GetDistributionV1(context.Background(), product, "")
+
+	require.NoError(t, err)
+	assert.Equal(t, "bridgeOS Update", dist.Title)
+	assert.Equal(t, "9.3", dist.Version)
+}
+
+func TestGetDistributionV1_FallbackLanguage(t *testing.T) {
+	svc := setupMockClient(t)
+	mocks.RegisterGetDistributions()
+
+	product := &Product{ID: "062-11111", Distributions: map[string]string{"en": mocks.SonomaDistributionURL}}
+
+	dist, _, err := svc.GetDistributionV1(context.Background(), product, "French")
+
+	require.NoError(t, err)
+	assert.Equal(t, "14.7.3", dist.Version)
+}
+
+func TestGetDistributionV1_Validation(t *testing.T) {
+	svc := setupMockClient(t)
+
+	_, _, err := svc.GetDistributionV1(context.Background(), nil, "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "product is required")
+
+	_, _, err = svc.GetDistributionV1(context.Background(), &Product{ID: "041-44444"}, "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "has no English distribution")
+}
+
+// =============================================================================
+// ListProductsV1 / ListMacOSInstallersV1
+// =============================================================================
+
+func TestListProductsV1_TypeFilter(t *testing.T) {
+	svc := setupMockClient(t)
+	mocks.RegisterGetCatalog(constants.EndpointSUCatalog)
+
+	products, _, err := svc.ListProductsV1(context.Background(), &ListProductsOptions{
+		Type: ProductTypeBridgeOS,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"072-22222"}, productIDs(products))
+	assert.Equal(t, 1, httpmock.GetTotalCallCount(), "no distributions are fetched without a version filter")
+}
+
+func TestListProductsV1_VersionFilter(t *testing.T) {
+	svc := setupMockClient(t)
+	mocks.RegisterGetCatalog(constants.EndpointSUCatalog)
+	mocks.RegisterGetDistributions()
+
+	products, _, err := svc.ListProductsV1(context.Background(), &ListProductsOptions{
+		Type:    ProductTypeMacOSInstaller,
+		Version: "15",
+	})
+
+	require.NoError(t, err)
+	require.Len(t, products, 1)
+	assert.Equal(t, "072-56789", products[0].ID)
+	assert.Equal(t, "24D60", products[0].Build)
+}
+
+func TestListProductsV1_BuildFilter(t *testing.T) {
+	svc := setupMockClient(t)
+	mocks.RegisterGetCatalog(constants.EndpointSUCatalog)
+	mocks.RegisterGetDistributions()
+
+	products, _, err := svc.ListProductsV1(context.Background(), &ListProductsOptions{
+		Type:  ProductTypeMacOSInstaller,
+		Build: "23H417",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"062-11111"}, productIDs(products))
+}
+
+func TestListProductsV1_DistributionError(t *testing.T) {
+	svc := setupMockClient(t)
+	mocks.RegisterGetCatalog(constants.EndpointSUCatalog)
+
+	httpmock.RegisterResponder("GET", mocks.SequoiaDistributionURL,
+		httpmock.NewStringResponder(404, "Not Found"))
+	httpmock.RegisterResponder("GET", mocks.SonomaDistributionURL,
+		httpmock.NewStringResponder(404, "Not Found"))
+
+	products, resp, err := svc.ListProductsV1(context.Background(), &ListProductsOptions{
+		Type:    ProductTypeMacOSInstaller,
+		Version: "15",
+	})
+
+	require.Error(t, err)
+	require.NotNil(t, resp)
+	assert.Nil(t, products)
+}
+
+func TestListMacOSInstallersV1_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mocks.RegisterGetCatalog(constants.EndpointSUCatalog)
+	mocks.RegisterGetDistributions()
+
+	installers, _, err := svc.ListMacOSInstallersV1(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, installers, 2)
+	assert.Equal(t, "macOS Sequoia", installers[0].Title)
+	assert.Equal(t, "15.3", installers[0].Version)
+	assert.Equal(t, "macOS Sonoma", installers[1].Title)
+	assert.Equal(t, "14.7.3", installers[1].Version)
+}
+
+// =============================================================================
+// Plist decoding
+// =============================================================================
+
+func TestDecodePlist_ScalarTypes(t *testing.T) {
+	value, err := decodePlist([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<plist version="1.0"><dict>
+	<key>Bool</key><true/>
+	<key>Off</key><false/>
+	<key>Real</key><real>1.5</real>
+	<key>Data</key><data>
+	aGVsbG8=
+	</data>
+	<key>List</key><array><integer>1</integer><string>two</string></array>
+</dict></plist>`))
+
+	require.NoError(t, err)
+	dict := value.(map[string]any)
+	assert.Equal(t, true, dict["Bool"])
+	assert.Equal(t, false, dict["Off"])
+	assert.Equal(t, 1.5, dict["Real"])
+	assert.Equal(t, []byte("hello"), dict["Data"])
+	assert.Equal(t, []any{int64(1), "two"}, dict["List"])
+}
+
+func TestDecodePlist_Invalid(t *testing.T) {
+	_, err := decodePlist([]byte(`<plist><dict><key>N</key><integer>x</integer></dict></plist>`))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid plist integer")
+
+	_, err = decodePlist([]byte(``))
+	require.Error(t, err)
+}
@@ -0,0 +1,51 @@
+package mocks
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/jarcoal/httpmock"
+)
+
+// Fixture URLs referenced by validate_get_catalog.sucatalog.
+const (
+	SequoiaDistributionURL  = "https://swdist.apple.com/content/downloads/12/34/072-56789/abcdef/072-56789.English.dist"
+	SonomaDistributionURL   = "https://swdist.apple.com/content/downloads/56/78/062-11111/fedcba/062-11111.English.dist"
+	BridgeOSDistributionURL = "https://swdist.apple.com/content/downloads/90/12/072-22222/112233/072-22222.English.dist"
+)
+
+// loadFixture reads a fixture file from the mocks directory.
+func loadFixture(filename string) string {
+	data, err := os.ReadFile(filepath.Join("mocks", filename))
+	if err != nil {
+		return ``
+	}
+	return string(data)
+}
+
+// xmlResponder returns an httpmock.Responder that serves XML with the given status code.
+func xmlResponder(status int, body string) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		resp := httpmock.NewStringResponse(status, body)
+		resp.Header.Set("Content-Type", "text/xml")
+		return resp, nil
+	}
+}
+
+// RegisterGetCatalog registers the mock responder for catalogURL.
+func RegisterGetCatalog(catalogURL string) {
+	httpmock.RegisterResponder("GET", catalogURL,
+		xmlResponder(200, loadFixture("validate_get_catalog.sucatalog")))
+}
+
+// RegisterGetDistributions registers mock responders for the English
+// distributions of the catalog fixture's macOS installers and bridgeOS update.
+func RegisterGetDistributions() {
+	httpmock.RegisterResponder("GET", SequoiaDistributionURL,
+		xmlResponder(200, loadFixture("validate_get_distribution_sequoia.dist")))
+	httpmock.RegisterResponder("GET", SonomaDistributionURL,
+		xmlResponder(200, loadFixture("validate_get_distribution_sonoma.dist")))
+	httpmock.RegisterResponder("GET", BridgeOSDistributionURL,
+		xmlResponder(200, loadFixture("validate_get_distribution_bridgeos.dist")))
+}
@@ -0,0 +1,102 @@
+package sucatalog
+
+import "time"
+
+// Catalog is a parsed Apple software update catalog (.sucatalog).
+type Catalog struct {
+	// CatalogVersion is the catalog format version, currently 2.
+	CatalogVersion int64
+	// ApplePostURL is the URL softwareupdate reports install events to.
+	ApplePostURL string
+	// IndexDate is when Apple generated the catalog.
+	IndexDate time.Time
+	// Products maps product keys (e.g. "072-12345") to products.
+	Products map[string]*Product
+}
+
+// Product is a single software update catalog product.
+type Product struct {
+	// ID is the product key, e.g. "072-12345".
+	ID string
+	// Type is the product classification: ProductTypeMacOSInstaller,
+	// ProductTypeBridgeOS, ProductTypeConfigData or ProductTypeOther.
+	Type string
+	// PostDate is when the product was posted to the catalog.
+	PostDate time.Time
+	// ServerMetadataURL is the product's .smd metadata URL, if any.
+	ServerMetadataURL string
+	// Packages are the installer packages that make up the product.
+	Packages []Package
+	// Distributions maps language names (e.g. "English", "en") to .dist URLs.
+	Distributions map[string]string
+	// ExtendedMetaInfo holds the product's extended metadata.
+	ExtendedMetaInfo ExtendedMetaInfo
+
+	// Title, Version and Build come from the product's distribution file.
+	// They are empty until the distribution is resolved, either with
+	// GetDistributionV1 or by ListProductsV1.
+	Title   string
+	Version string
+	Build   string
+}
+
+// Package is an installer package belonging to a product.
+type Package struct {
+	// URL is the package download URL.
+	URL string
+	// Size is the package size in bytes.
+	Size int64
+	// Digest is the package SHA-1 digest, when published.
+	Digest string
+	// MetadataURL is the package's .pkm metadata URL, if any.
+	MetadataURL string
+	// IntegrityDataURL is the package's chunklist URL, if any.
+	IntegrityDataURL string
+	// IntegrityDataSize is the chunklist size in bytes.
+	IntegrityDataSize int64
+}
+
+// ExtendedMetaInfo is the typed subset of a product's ExtendedMetaInfo dict.
+type ExtendedMetaInfo struct {
+	// ProductType is the catalog's own product type, e.g. "ConfigData".
+	ProductType string
+	// ProductVersion is the catalog's own product version, when published.
+	ProductVersion string
+	// InstallAssistantPackageIdentifiers maps installer roles (e.g.
+	// "OSInstall", "SharedSupport") to package identifiers. It is set only on
+	// full macOS installers.
+	InstallAssistantPackageIdentifiers map[string]string
+	// Raw is the complete ExtendedMetaInfo dict.
+	Raw map[string]any
+}
+
+// Distribution is the information read from a product's .dist file.
+type Distribution struct {
+	// URL is the .dist URL that was fetched.
+	URL string
+	// Title is the localized product title, e.g. "macOS Sequoia".
+	Title string
+	// Version is the product version, e.g. "15.3".
+	Version string
+	// Build is the product build, e.g. "24D60".
+	Build string
+}
+
+// ListProductsOptions selects the catalog to read and the products to return
+// from ListProductsV1. All filters are optional and combine with AND.
+type ListProductsOptions struct {
+	// CatalogURL is the catalog to read. Empty uses constants.EndpointSUCatalog.
+	CatalogURL string
+	// Type limits results to one of the ProductType constants.
+	Type string
+	// Version matches products whose version equals Version or starts with
+	// Version followed by a dot, so "15" matches "15.3.1".
+	Version string
+	// Build matches products with exactly this build.
+	Build string
+	// PostedAfter excludes products posted before this time.
+	PostedAfter time.Time
+	// ResolveDistributions fetches each matching product's distribution to
+	// populate Title, Version and Build. It is implied by Version and Build.
+	ResolveDistributions bool
+}
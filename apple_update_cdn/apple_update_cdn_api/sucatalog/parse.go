@@ -0,0 +1,192 @@
+package sucatalog
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// suStringRe matches a `"KEY" = "value";` line in a distribution's localized
+// strings table.
+var suStringRe = regexp.MustCompile(`"([^"]+)"\s*=\s*"((?:[^"\\]|\\.)*)"\s*;`)
+
+// ParseCatalog parses the raw XML property list of a software update catalog.
+func ParseCatalog(data []byte) (*Catalog, error) {
+	root, err := decodePlist(data)
+	if err != nil {
+		return nil, err
+	}
+	dict, ok := root.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("catalog root is not a dict")
+	}
+
+	catalog := &Catalog{
+		CatalogVersion: plistInt(dict, "CatalogVersion"),
+		ApplePostURL:   plistString(dict, "ApplePostURL"),
+		IndexDate:      plistDate(dict, "IndexDate"),
+		Products:       make(map[string]*Product),
+	}
+
+	for id, value := range plistDict(dict, "Products") {
+		entry, ok := value.(map[string]any)
+		if !ok {
+			continue
+		}
+		catalog.Products[id] = newProduct(id, entry)
+	}
+
+	return catalog, nil
+}
+
+// FilterProducts returns the catalog's products of productType posted at or
+// after postedAfter, newest first. Empty productType and zero postedAfter
+// match every product.
+func (c *Catalog) FilterProducts(productType string, postedAfter time.Time) []*Product {
+	var products []*Product
+	for _, product := range c.Products {
+		if productType != "" && product.Type != productType {
+			continue
+		}
+		if !postedAfter.IsZero() && product.PostDate.Before(postedAfter) {
+			continue
+		}
+		products = append(products, product)
+	}
+
+	slices.SortFunc(products, func(a, b *Product) int {
+		if byDate := b.PostDate.Compare(a.PostDate); byDate != 0 {
+			return byDate
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return products
+}
+
+// newProduct converts a catalog product dict into a Product.
+func newProduct(id string, entry map[string]any) *Product {
+	product := &Product{
+		ID:                id,
+		PostDate:          plistDate(entry, "PostDate"),
+		ServerMetadataURL: plistString(entry, "ServerMetadataURL"),
+		Distributions:     plistStringMap(entry, "Distributions"),
+	}
+
+	packages, _ := entry["Packages"].([]any)
+	for _, value := range packages {
+		pkg, ok := value.(map[string]any)
+		if !ok {
+			continue
+		}
+		product.Packages = append(product.Packages, Package{
+			URL:               plistString(pkg, "URL"),
+			Size:              plistInt(pkg, "Size"),
+			Digest:            plistString(pkg, "Digest"),
+			MetadataURL:       plistString(pkg, "MetadataURL"),
+			IntegrityDataURL:  plistString(pkg, "IntegrityDataURL"),
+			IntegrityDataSize: plistInt(pkg, "IntegrityDataSize"),
+		})
+	}
+
+	if meta := plistDict(entry, "ExtendedMetaInfo"); meta != nil {
+		product.ExtendedMetaInfo = ExtendedMetaInfo{
+			ProductType:                        plistString(meta, "ProductType"),
+			ProductVersion:                     plistString(meta, "ProductVersion"),
+			InstallAssistantPackageIdentifiers: plistStringMap(meta, installAssistantKey),
+			Raw:                                meta,
+		}
+	}
+
+	product.Version = product.ExtendedMetaInfo.ProductVersion
+	product.Type = classifyProduct(product)
+
+	return product
+}
+
+// classifyProduct returns the ProductType constant describing product.
+func classifyProduct(product *Product) string {
+	meta := product.ExtendedMetaInfo
+	if len(meta.InstallAssistantPackageIdentifiers) > 0 {
+		return ProductTypeMacOSInstaller
+	}
+	if _, ok := meta.Raw[bridgeOSOrderingKey]; ok {
+		return ProductTypeBridgeOS
+	}
+	for _, pkg := range product.Packages {
+		if strings.Contains(pkg.URL, bridgeOSPackageName) {
+			return ProductTypeBridgeOS
+		}
+	}
+	if meta.ProductType == ProductTypeConfigData {
+		return ProductTypeConfigData
+	}
+	return ProductTypeOther
+}
+
+// distributionDocument is the subset of a .dist installer script read by
+// ParseDistribution.
+type distributionDocument struct {
+	Title   string `xml:"title"`
+	AuxInfo struct {
+		Inner []byte `xml:",innerxml"`
+	} `xml:"auxinfo"`
+	Localization struct {
+		Strings []struct {
+			Text string `xml:",chardata"`
+		} `xml:"strings"`
+	} `xml:"localization"`
+}
+
+// ParseDistribution parses a product .dist file, reading the version and build
+// from its auxinfo dict and resolving an SU_ title key against its localized
+// strings.
+func ParseDistribution(data []byte) (*Distribution, error) {
+	var doc distributionDocument
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse distribution: %w", err)
+	}
+
+	dist := &Distribution{Title: strings.TrimSpace(doc.Title)}
+
+	if aux := bytes.TrimSpace(doc.AuxInfo.Inner); len(aux) > 0 {
+		value, err := decodePlist(aux)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse distribution auxinfo: %w", err)
+		}
+		if dict, ok := value.(map[string]any); ok {
+			dist.Version = plistString(dict, "VERSION")
+			dist.Build = plistString(dict, "BUILD")
+		}
+	}
+
+	if strings.HasPrefix(dist.Title, suStringPrefix) {
+		if title, ok := doc.localizedString(dist.Title); ok {
+			dist.Title = title
+		}
+	}
+
+	return dist, nil
+}
+
+// localizedString looks key up in the distribution's localized strings
+// tables, returning the first match.
+func (doc *distributionDocument) localizedString(key string) (string, bool) {
+	for _, table := range doc.Localization.Strings {
+		for _, match := range suStringRe.FindAllStringSubmatch(table.Text, -1) {
+			if match[1] != key {
+				continue
+			}
+			if value, err := strconv.Unquote(`"` + match[2] + `"`); err == nil {
+				return value, true
+			}
+			return match[2], true
+		}
+	}
+	return "", false
+}
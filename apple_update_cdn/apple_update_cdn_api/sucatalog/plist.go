@@ -0,0 +1,186 @@
+package sucatalog
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// decodePlist decodes an XML property list into generic Go values: dicts
+// become map[string]any, arrays []any, strings string, integers int64, reals
+// float64, booleans bool, dates time.Time and data []byte.
+func decodePlist(data []byte) (any, error) {
+	return decodePlistFrom(xml.NewDecoder(bytes.NewReader(data)))
+}
+
+// decodePlistFrom decodes the first plist value read from d, skipping an
+// enclosing <plist> element if present.
+func decodePlistFrom(d *xml.Decoder) (any, error) {
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return nil, fmt.Errorf("plist contains no value")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read plist: %w", err)
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			if start.Name.Local == "plist" {
+				continue
+			}
+			return decodePlistValue(d, start)
+		}
+	}
+}
+
+// decodePlistValue decodes the value whose start element has just been read.
+func decodePlistValue(d *xml.Decoder, start xml.StartElement) (any, error) {
+	switch start.Name.Local {
+	case "dict":
+		return decodePlistDict(d)
+	case "array":
+		return decodePlistArray(d)
+	case "true", "false":
+		if err := d.Skip(); err != nil {
+			return nil, err
+		}
+		return start.Name.Local == "true", nil
+	}
+
+	var text string
+	if err := d.DecodeElement(&text, &start); err != nil {
+		return nil, fmt.Errorf("failed to read plist %s: %w", start.Name.Local, err)
+	}
+
+	switch start.Name.Local {
+	case "string", "key":
+		return text, nil
+	case "integer":
+		n, err := strconv.ParseInt(strings.TrimSpace(text), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid plist integer %q", text)
+		}
+		return n, nil
+	case "real":
+		f, err := strconv.ParseFloat(strings.TrimSpace(text), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid plist real %q", text)
+		}
+		return f, nil
+	case "date":
+		t, err := time.Parse(time.RFC3339, strings.TrimSpace(text))
+		if err != nil {
+			return nil, fmt.Errorf("invalid plist date %q", text)
+		}
+		return t, nil
+	case "data":
+		b, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(text), ""))
+		if err != nil {
+			return nil, fmt.Errorf("invalid plist data: %w", err)
+		}
+		return b, nil
+	}
+
+	return nil, fmt.Errorf("unsupported plist element <%s>", start.Name.Local)
+}
+
+// decodePlistDict decodes dict entries up to the closing </dict>.
+func decodePlistDict(d *xml.Decoder) (map[string]any, error) {
+	dict := make(map[string]any)
+	var key string
+	haveKey := false
+
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read plist dict: %w", err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if t.Name.Local == "key" {
+				if err := d.DecodeElement(&key, &t); err != nil {
+					return nil, fmt.Errorf("failed to read plist key: %w", err)
+				}
+				haveKey = true
+				continue
+			}
+			if !haveKey {
+				return nil, fmt.Errorf("plist dict value <%s> has no key", t.Name.Local)
+			}
+			value, err := decodePlistValue(d, t)
+			if err != nil {
+				return nil, err
+			}
+			dict[key] = value
+			haveKey = false
+		case xml.EndElement:
+			return dict, nil
+		}
+	}
+}
+
+// decodePlistArray decodes array elements up to the closing </array>.
+func decodePlistArray(d *xml.Decoder) ([]any, error) {
+	var array []any
+
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read plist array: %w", err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			value, err := decodePlistValue(d, t)
+			if err != nil {
+				return nil, err
+			}
+			array = append(array, value)
+		case xml.EndElement:
+			return array, nil
+		}
+	}
+}
+
+// plistString returns dict[key] if it is a string.
+func plistString(dict map[string]any, key string) string {
+	s, _ := dict[key].(string)
+	return s
+}
+
+// plistInt returns dict[key] if it is an integer.
+func plistInt(dict map[string]any, key string) int64 {
+	n, _ := dict[key].(int64)
+	return n
+}
+
+// plistDate returns dict[key] if it is a date.
+func plistDate(dict map[string]any, key string) time.Time {
+	t, _ := dict[key].(time.Time)
+	return t
+}
+
+// plistDict returns dict[key] if it is a dict.
+func plistDict(dict map[string]any, key string) map[string]any {
+	m, _ := dict[key].(map[string]any)
+	return m
+}
+
+// plistStringMap returns the string-valued entries of dict[key].
+func plistStringMap(dict map[string]any, key string) map[string]string {
+	m := plistDict(dict, key)
+	if m == nil {
+		return nil
+	}
+	out := make(map[string]string, len(m))
+	for k, v := range m {
+		if s, ok := v.(string); ok {
+			out[k] = s
+		}
+	}
+	return out
+}
@@ -3,7 +3,7 @@ package constants
 const (
 	// ipsw.me API — third-party aggregator of Apple CDN firmware metadata.
 	// All endpoint constants are full absolute URLs because this SDK spans
-	// three distinct external hosts.
+	// several distinct external hosts.
 
 	// EndpointFirmwaresAllV3 returns all Mac device models and their complete
 	// firmware history in a single condensed JSON response.
@@ -22,6 +22,35 @@ const (
 	// GET https://gdmf.apple.com/v2/pmv
 	EndpointGDMFVersions = "https://gdmf.apple.com/v2/pmv"
 
+	// SOFA — the macadmins Simple Organized Feed for Apple software updates.
+
+	// EndpointSOFAMacOSFeed returns every macOS release family with its security
+	// releases, CVEs, supported models and installer links.
+	// GET https://sofafeed.macadmins.io/v1/macos_data_feed.json
+	EndpointSOFAMacOSFeed = "https://sofafeed.macadmins.io/v1/macos_data_feed.json"
+
+	// EndpointSOFAIOSFeed returns every iOS/iPadOS release family with its
+	// security releases, CVEs and supported devices.
+	// GET https://sofafeed.macadmins.io/v1/ios_data_feed.json
+	EndpointSOFAIOSFeed = "https://sofafeed.macadmins.io/v1/ios_data_feed.json"
+
+	// Apple Software Update catalog — the product feed read by softwareupdate(8).
+
+	// EndpointSUCatalog is the production software update catalog listing macOS
+	// installers, bridgeOS firmware, configuration data and other products.
+	// GET https://swscan.apple.com/content/catalogs/others/index-26-15-...-leopard.merged-1.sucatalog
+	EndpointSUCatalog = "https://swscan.apple.com/content/catalogs/others/index-26-15-14-13-12-10.16-10.15-10.14-10.13-10.12-10.11-10.10-10.9-mountainlion-lion-snowleopard-leopard.merged-1.sucatalog"
+
+	// EndpointSUCatalogCustomerSeed is the AppleSeed for IT catalog, which adds
+	// pre-release builds to the production catalog.
+	EndpointSUCatalogCustomerSeed = "https://swscan.apple.com/content/catalogs/others/index-26customerseed-26-15-14-13-12-10.16-10.15-10.14-10.13-10.12-10.11-10.10-10.9-mountainlion-lion-snowleopard-leopard.merged-1.sucatalog"
+
+	// EndpointSUCatalogDeveloperSeed is the developer beta catalog.
+	EndpointSUCatalogDeveloperSeed = "https://swscan.apple.com/content/catalogs/others/index-26seed-26-15-14-13-12-10.16-10.15-10.14-10.13-10.12-10.11-10.10-10.9-mountainlion-lion-snowleopard-leopard.merged-1.sucatalog"
+
+	// EndpointSUCatalogPublicSeed is the public beta catalog.
+	EndpointSUCatalogPublicSeed = "https://swscan.apple.com/content/catalogs/others/index-26beta-26-15-14-13-12-10.16-10.15-10.14-10.13-10.12-10.11-10.10-10.9-mountainlion-lion-snowleopard-leopard.merged-1.sucatalog"
+
 	// Apple CDN — firmware file delivery.
 
 	// AppleCDNBaseURL is the base URL for Apple's firmware CDN.
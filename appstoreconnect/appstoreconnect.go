@@ -0,0 +1,117 @@
+package appstoreconnect
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/appstoreconnect_api/appinfos"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/appstoreconnect_api/apps"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/appstoreconnect_api/betaappreviewsubmissions"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/appstoreconnect_api/betagroups"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/appstoreconnect_api/betatesters"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/appstoreconnect_api/builds"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/appstoreconnect_api/bundleids"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/appstoreconnect_api/certificates"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/appstoreconnect_api/devices"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/appstoreconnect_api/profiles"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/appstoreconnect_api/userinvitations"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/appstoreconnect_api/users"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/client"
+)
+
+// Client is the main entry point for the App Store Connect API SDK.
+// A Client is safe for concurrent use by multiple goroutines.
+type Client struct {
+	transport          *client.Transport
+	AppStoreConnectAPI *AppStoreConnectAPIClient
+}
+
+// AppStoreConnectAPIClient groups all App Store Connect API services.
+type AppStoreConnectAPIClient struct {
+	Apps     *apps.Apps
+	AppInfos *appinfos.AppInfos
+	Builds   *builds.Builds
+
+	// TestFlight
+	BetaGroups               *betagroups.BetaGroups
+	BetaTesters              *betatesters.BetaTesters
+	BetaAppReviewSubmissions *betaappreviewsubmissions.BetaAppReviewSubmissions
+
+	// Certificates, identifiers and profiles
+	Certificates *certificates.Certificates
+	BundleIDs    *bundleids.BundleIDs
+	Devices      *devices.Devices
+	Profiles     *profiles.Profiles
+
+	// Users and access
+	Users           *users.Users
+	UserInvitations *userinvitations.UserInvitations
+}
+
+// NewClient creates a new App Store Connect API client.
+// Parameters:
+//   - keyID: Your App Store Connect API Key ID
+//   - issuerID: Your App Store Connect Issuer ID
+//   - privateKey: Your App Store Connect private key (*ecdsa.PrivateKey, P-256)
+//   - options: Optional configuration options (WithLogger, WithTimeout, etc.)
+func NewClient(keyID, issuerID string, privateKey any, options ...client.ClientOption) (*Client, error) {
+	transport, err := client.NewTransport(keyID, issuerID, privateKey, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return newClient(transport), nil
+}
+
+// NewClientFromFile creates a client using a private key from file.
+// Parameters:
+//   - keyID: Your App Store Connect API Key ID
+//   - issuerID: Your App Store Connect Issuer ID
+//   - privateKeyPath: Path to your App Store Connect private key file (.p8)
+//   - options: Optional configuration options (WithLogger, WithTimeout, etc.)
+func NewClientFromFile(keyID, issuerID, privateKeyPath string, options ...client.ClientOption) (*Client, error) {
+	privateKey, err := client.LoadPrivateKeyFromFile(privateKeyPath)
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(keyID, issuerID, privateKey, options...)
+}
+
+// NewClientFromEnv creates a client using environment variables.
+// Expects: APPLE_KEY_ID, APPLE_ISSUER_ID, and one of APPLE_PRIVATE_KEY_PEM or APPLE_PRIVATE_KEY_PATH.
+// Parameters:
+//   - options: Optional configuration options (WithLogger, WithTimeout, etc.)
+func NewClientFromEnv(options ...client.ClientOption) (*Client, error) {
+	transport, err := client.NewTransportFromEnv(options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return newClient(transport), nil
+}
+
+// newClient wires every service to the shared transport.
+func newClient(transport *client.Transport) *Client {
+	return &Client{
+		transport: transport,
+		AppStoreConnectAPI: &AppStoreConnectAPIClient{
+			Apps:     apps.NewService(transport),
+			AppInfos: appinfos.NewService(transport),
+			Builds:   builds.NewService(transport),
+
+			BetaGroups:               betagroups.NewService(transport),
+			BetaTesters:              betatesters.NewService(transport),
+			BetaAppReviewSubmissions: betaappreviewsubmissions.NewService(transport),
+
+			Certificates: certificates.NewService(transport),
+			BundleIDs:    bundleids.NewService(transport),
+			Devices:      devices.NewService(transport),
+			Profiles:     profiles.NewService(transport),
+
+			Users:           users.NewService(transport),
+			UserInvitations: userinvitations.NewService(transport),
+		},
+	}
+}
+
+// Close closes the underlying HTTP client and releases resources.
+func (c *Client) Close() error {
+	return c.transport.Close()
+}
@@ -0,0 +1,45 @@
+package appinfos
+
+// AppInfo field constants for field selection
+const (
+	FieldAppStoreState      = "appStoreState"
+	FieldState              = "state"
+	FieldAppStoreAgeRating  = "appStoreAgeRating"
+	FieldAustraliaAgeRating = "australiaAgeRating"
+	FieldBrazilAgeRating    = "brazilAgeRating"
+	FieldKidsAgeBand        = "kidsAgeBand"
+	FieldApp                = "app"
+)
+
+// App info state constants
+const (
+	StateAccepted             = "ACCEPTED"
+	StateDeveloperRejected    = "DEVELOPER_REJECTED"
+	StateInReview             = "IN_REVIEW"
+	StatePendingRelease       = "PENDING_RELEASE"
+	StatePrepareForSubmission = "PREPARE_FOR_SUBMISSION"
+	StateReadyForDistribution = "READY_FOR_DISTRIBUTION"
+	StateReadyForReview       = "READY_FOR_REVIEW"
+	StateRejected             = "REJECTED"
+	StateReplacedWithNewInfo  = "REPLACED_WITH_NEW_INFO"
+	StateWaitingForReview     = "WAITING_FOR_REVIEW"
+)
+
+// App Store age rating constants
+const (
+	AgeRatingFourPlus      = "FOUR_PLUS"
+	AgeRatingNinePlus      = "NINE_PLUS"
+	AgeRatingTwelvePlus    = "TWELVE_PLUS"
+	AgeRatingSeventeenPlus = "SEVENTEEN_PLUS"
+	AgeRatingUnrated       = "UNRATED"
+)
+
+// Kids age band constants
+const (
+	KidsAgeBandFiveAndUnder = "FIVE_AND_UNDER"
+	KidsAgeBandSixToEight   = "SIX_TO_EIGHT"
+	KidsAgeBandNineToEleven = "NINE_TO_ELEVEN"
+)
+
+// MaxLimit is the maximum page size accepted by the app info endpoints.
+const MaxLimit = 200
@@ -0,0 +1,116 @@
+package appinfos
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/constants"
+	"resty.dev/v3"
+)
+
+// AppInfos handles communication with the app info
+// related methods of the App Store Connect API.
+//
+// App Store Connect API docs: https://developer.apple.com/documentation/appstoreconnectapi/app-infos
+type (
+	AppInfos struct {
+		client client.Client
+	}
+)
+
+// NewService creates a new app infos service.
+func NewService(c client.Client) *AppInfos {
+	return &AppInfos{client: c}
+}
+
+// GetByAppIDV1 retrieves the app infos for a specific app, following all pages.
+// URL: GET https://api.appstoreconnect.apple.com/v1/apps/{id}/appInfos
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-apps-_id_-appinfos
+func (s *AppInfos) GetByAppIDV1(ctx context.Context, appID string, opts *RequestQueryOptions) (*AppInfosResponse, *resty.Response, error) {
+	if appID == "" {
+		return nil, nil, fmt.Errorf("app ID is required")
+	}
+
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	params := s.client.QueryBuilder()
+
+	if len(opts.Fields) > 0 {
+		params.AddStringSlice("fields[appInfos]", opts.Fields)
+	}
+	if opts.Limit > 0 {
+		if opts.Limit > MaxLimit {
+			opts.Limit = MaxLimit // Enforce API maximum
+		}
+		params.AddInt("limit", opts.Limit)
+	}
+
+	endpoint := constants.EndpointApps + "/" + appID + "/appInfos"
+
+	var allAppInfos []AppInfo
+	var lastMeta *Meta
+	var lastLinks *Links
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParams(params.Build()).
+		GetPaginated(endpoint, func(pageData []byte) error {
+			var pageResponse AppInfosResponse
+			if err := json.Unmarshal(pageData, &pageResponse); err != nil {
+				return fmt.Errorf("failed to unmarshal page: %w", err)
+			}
+			allAppInfos = append(allAppInfos, pageResponse.Data...)
+			lastMeta = pageResponse.Meta
+			lastLinks = pageResponse.Links
+			return nil
+		})
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &AppInfosResponse{
+		Data:  allAppInfos,
+		Meta:  lastMeta,
+		Links: lastLinks,
+	}, resp, nil
+}
+
+// GetByAppInfoIDV1 retrieves a specific app info.
+// URL: GET https://api.appstoreconnect.apple.com/v1/appInfos/{id}
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-appinfos-_id_
+func (s *AppInfos) GetByAppInfoIDV1(ctx context.Context, appInfoID string, opts *RequestQueryOptions) (*AppInfoResponse, *resty.Response, error) {
+	if appInfoID == "" {
+		return nil, nil, fmt.Errorf("app info ID is required")
+	}
+
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	params := s.client.QueryBuilder()
+
+	if len(opts.Fields) > 0 {
+		params.AddStringSlice("fields[appInfos]", opts.Fields)
+	}
+
+	endpoint := constants.EndpointAppInfos + "/" + appInfoID
+
+	var result AppInfoResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParams(params.Build()).
+		SetResult(&result).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
@@ -0,0 +1,83 @@
+package appinfos
+
+import (
+	"context"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/appstoreconnect_api/appinfos/mocks"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/client"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+// setupMockClient creates a client with httpmock enabled.
+func setupMockClient(t *testing.T) *AppInfos {
+	mockAuth := &MockAuthProvider{}
+
+	coreClient, err := client.NewTransport(
+		"test-key-id",
+		"test-issuer-id",
+		"dummy-key",
+		client.WithAuth(mockAuth),
+		client.WithLogger(zap.NewNop()),
+		client.WithRetryCount(0),
+	)
+	require.NoError(t, err)
+
+	httpmock.ActivateNonDefault(coreClient.GetHTTPClient().Client())
+
+	t.Cleanup(func() {
+		httpmock.DeactivateAndReset()
+	})
+
+	return NewService(coreClient)
+}
+
+// MockAuthProvider implements the AuthProvider interface for testing.
+type MockAuthProvider struct{}
+
+func (m *MockAuthProvider) ApplyAuth(req *resty.Request) error {
+	return nil
+}
+
+func TestGetAppInfosByAppID_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.AppInfosMock{}
+	mockHandler.RegisterMocks()
+
+	result, resp, err := svc.GetByAppIDV1(context.Background(), "1234567890", &RequestQueryOptions{
+		Fields: []string{FieldState, FieldAppStoreAgeRating},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	require.Len(t, result.Data, 2)
+	assert.Equal(t, StateReadyForDistribution, result.Data[0].Attributes.State)
+	assert.Equal(t, StatePrepareForSubmission, result.Data[1].Attributes.State)
+	assert.Equal(t, AgeRatingFourPlus, result.Data[0].Attributes.AppStoreAgeRating)
+}
+
+func TestGetAppInfoByID_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.AppInfosMock{}
+	mockHandler.RegisterMocks()
+
+	result, _, err := svc.GetByAppInfoIDV1(context.Background(), "a1b2c3d4-0001", nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, "a1b2c3d4-0001", result.Data.ID)
+	assert.Equal(t, "L", result.Data.Attributes.BrazilAgeRating)
+}
+
+func TestAppInfos_EmptyID(t *testing.T) {
+	svc := setupMockClient(t)
+
+	_, _, err := svc.GetByAppIDV1(context.Background(), "", nil)
+	assert.ErrorContains(t, err, "app ID is required")
+
+	_, _, err = svc.GetByAppInfoIDV1(context.Background(), "", nil)
+	assert.ErrorContains(t, err, "app info ID is required")
+}
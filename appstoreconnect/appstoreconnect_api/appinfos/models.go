@@ -0,0 +1,67 @@
+package appinfos
+
+// Shared types for pagination and links
+type Meta struct {
+	Paging *Paging `json:"paging,omitempty"`
+}
+
+type Paging struct {
+	Total int `json:"total,omitempty"`
+	Limit int `json:"limit,omitempty"`
+}
+
+type Links struct {
+	Self  string `json:"self,omitempty"`
+	First string `json:"first,omitempty"`
+	Next  string `json:"next,omitempty"`
+}
+
+// AppInfo represents the App Store information of an app, such as its
+// review state and age rating. An app has one app info per App Store version
+// in flight.
+type AppInfo struct {
+	ID         string             `json:"id"`
+	Type       string             `json:"type"`
+	Attributes *AppInfoAttributes `json:"attributes,omitempty"`
+	Links      *ResourceLinks     `json:"links,omitempty"`
+}
+
+// AppInfoAttributes contains the app info attributes
+type AppInfoAttributes struct {
+	// AppStoreState is deprecated by Apple in favour of State.
+	AppStoreState      string `json:"appStoreState,omitempty"`
+	State              string `json:"state,omitempty"`
+	AppStoreAgeRating  string `json:"appStoreAgeRating,omitempty"`
+	AustraliaAgeRating string `json:"australiaAgeRating,omitempty"`
+	BrazilAgeRating    string `json:"brazilAgeRating,omitempty"`
+	KidsAgeBand        string `json:"kidsAgeBand,omitempty"`
+}
+
+// ResourceLinks contains the self link of a resource
+type ResourceLinks struct {
+	Self string `json:"self,omitempty"`
+}
+
+// AppInfoResponse represents the response for a single app info
+type AppInfoResponse struct {
+	Data  AppInfo        `json:"data"`
+	Links *ResourceLinks `json:"links,omitempty"`
+}
+
+// AppInfosResponse represents the response for listing app infos
+type AppInfosResponse struct {
+	Data  []AppInfo `json:"data"`
+	Meta  *Meta     `json:"meta,omitempty"`
+	Links *Links    `json:"links,omitempty"`
+}
+
+// RequestQueryOptions represents the query parameters for app info requests
+type RequestQueryOptions struct {
+	// Field selection - fields to return for appInfos
+	// Possible values: appStoreState, state, appStoreAgeRating, australiaAgeRating,
+	// brazilAgeRating, kidsAgeBand, app
+	Fields []string `json:"fields,omitempty"`
+
+	// Limit the number of included results per page (maximum 200)
+	Limit int `json:"limit,omitempty"`
+}
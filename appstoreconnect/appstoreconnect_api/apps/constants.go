@@ -0,0 +1,32 @@
+package apps
+
+// App field constants for field selection
+const (
+	FieldName                            = "name"
+	FieldBundleID                        = "bundleId"
+	FieldSKU                             = "sku"
+	FieldPrimaryLocale                   = "primaryLocale"
+	FieldIsOrEverWasMadeForKids          = "isOrEverWasMadeForKids"
+	FieldSubscriptionStatusURL           = "subscriptionStatusUrl"
+	FieldSubscriptionStatusURLVersion    = "subscriptionStatusUrlVersion"
+	FieldSubscriptionStatusURLForSandbox = "subscriptionStatusUrlForSandbox"
+	FieldContentRightsDeclaration        = "contentRightsDeclaration"
+	FieldAppInfos                        = "appInfos"
+	FieldBuilds                          = "builds"
+)
+
+// Sort constants for listing apps. Prefix with "-" for descending order.
+const (
+	SortName     = "name"
+	SortBundleID = "bundleId"
+	SortSKU      = "sku"
+)
+
+// Content rights declaration constants
+const (
+	ContentRightsDoesNotUseThirdPartyContent = "DOES_NOT_USE_THIRD_PARTY_CONTENT"
+	ContentRightsUsesThirdPartyContent       = "USES_THIRD_PARTY_CONTENT"
+)
+
+// MaxLimit is the maximum page size accepted by the apps endpoints.
+const MaxLimit = 200
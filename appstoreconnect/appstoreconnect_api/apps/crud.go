@@ -0,0 +1,125 @@
+package apps
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/constants"
+	"resty.dev/v3"
+)
+
+// Apps handles communication with the app
+// related methods of the App Store Connect API.
+//
+// App Store Connect API docs: https://developer.apple.com/documentation/appstoreconnectapi/apps
+type (
+	Apps struct {
+		client client.Client
+	}
+)
+
+// NewService creates a new apps service.
+func NewService(c client.Client) *Apps {
+	return &Apps{client: c}
+}
+
+// GetV1 retrieves the apps associated with the team, following all pages.
+// URL: GET https://api.appstoreconnect.apple.com/v1/apps
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-apps
+func (s *Apps) GetV1(ctx context.Context, opts *RequestQueryOptions) (*AppsResponse, *resty.Response, error) {
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	params := s.client.QueryBuilder()
+
+	if len(opts.Fields) > 0 {
+		params.AddStringSlice("fields[apps]", opts.Fields)
+	}
+	if len(opts.FilterBundleID) > 0 {
+		params.AddStringSlice("filter[bundleId]", opts.FilterBundleID)
+	}
+	if len(opts.FilterName) > 0 {
+		params.AddStringSlice("filter[name]", opts.FilterName)
+	}
+	if len(opts.FilterSKU) > 0 {
+		params.AddStringSlice("filter[sku]", opts.FilterSKU)
+	}
+	if len(opts.FilterID) > 0 {
+		params.AddStringSlice("filter[id]", opts.FilterID)
+	}
+	if len(opts.Sort) > 0 {
+		params.AddStringSlice("sort", opts.Sort)
+	}
+	if opts.Limit > 0 {
+		if opts.Limit > MaxLimit {
+			opts.Limit = MaxLimit // Enforce API maximum
+		}
+		params.AddInt("limit", opts.Limit)
+	}
+
+	var allApps []App
+	var lastMeta *Meta
+	var lastLinks *Links
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParams(params.Build()).
+		GetPaginated(constants.EndpointApps, func(pageData []byte) error {
+			var pageResponse AppsResponse
+			if err := json.Unmarshal(pageData, &pageResponse); err != nil {
+				return fmt.Errorf("failed to unmarshal page: %w", err)
+			}
+			allApps = append(allApps, pageResponse.Data...)
+			lastMeta = pageResponse.Meta
+			lastLinks = pageResponse.Links
+			return nil
+		})
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &AppsResponse{
+		Data:  allApps,
+		Meta:  lastMeta,
+		Links: lastLinks,
+	}, resp, nil
+}
+
+// GetByAppIDV1 retrieves information about a specific app.
+// URL: GET https://api.appstoreconnect.apple.com/v1/apps/{id}
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-apps-_id_
+func (s *Apps) GetByAppIDV1(ctx context.Context, appID string, opts *RequestQueryOptions) (*AppResponse, *resty.Response, error) {
+	if appID == "" {
+		return nil, nil, fmt.Errorf("app ID is required")
+	}
+
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	params := s.client.QueryBuilder()
+
+	if len(opts.Fields) > 0 {
+		params.AddStringSlice("fields[apps]", opts.Fields)
+	}
+
+	endpoint := constants.EndpointApps + "/" + appID
+
+	var result AppResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParams(params.Build()).
+		SetResult(&result).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
@@ -0,0 +1,123 @@
+package apps
+
+import (
+	"context"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/appstoreconnect_api/apps/mocks"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/client"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+// setupMockClient creates a client with httpmock enabled.
+func setupMockClient(t *testing.T) *Apps {
+	mockAuth := &MockAuthProvider{}
+
+	coreClient, err := client.NewTransport(
+		"test-key-id",
+		"test-issuer-id",
+		"dummy-key",
+		client.WithAuth(mockAuth),
+		client.WithLogger(zap.NewNop()),
+		client.WithRetryCount(0),
+	)
+	require.NoError(t, err)
+
+	httpmock.ActivateNonDefault(coreClient.GetHTTPClient().Client())
+
+	t.Cleanup(func() {
+		httpmock.DeactivateAndReset()
+	})
+
+	return NewService(coreClient)
+}
+
+// MockAuthProvider implements the AuthProvider interface for testing.
+type MockAuthProvider struct{}
+
+func (m *MockAuthProvider) ApplyAuth(req *resty.Request) error {
+	return nil
+}
+
+func TestGetApps_FollowsPagination(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.AppsMock{}
+	mockHandler.RegisterMocks()
+	defer mockHandler.CleanupMockState()
+
+	opts := &RequestQueryOptions{
+		Fields:         []string{FieldName, FieldBundleID},
+		FilterBundleID: []string{"com.example.fieldnotes", "com.example.fieldnotes.pro"},
+		Sort:           []string{SortName},
+		Limit:          500,
+	}
+
+	result, resp, err := svc.GetV1(context.Background(), opts)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, 200, resp.StatusCode())
+	require.Len(t, result.Data, 2)
+	assert.Equal(t, "Field Notes", result.Data[0].Attributes.Name)
+	assert.Equal(t, "com.example.fieldnotes.pro", result.Data[1].Attributes.BundleID)
+	assert.Equal(t, MaxLimit, opts.Limit)
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+}
+
+func TestGetApps_Forbidden(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.AppsMock{}
+	mockHandler.RegisterErrorMocks()
+	defer mockHandler.CleanupMockState()
+
+	result, _, err := svc.GetV1(context.Background(), nil)
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+
+	var apiErr *client.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "FORBIDDEN_ERROR", apiErr.Code)
+}
+
+func TestGetAppByID_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.AppsMock{}
+	mockHandler.RegisterMocks()
+	defer mockHandler.CleanupMockState()
+
+	result, resp, err := svc.GetByAppIDV1(context.Background(), "1234567890", nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	assert.Equal(t, "1234567890", result.Data.ID)
+	assert.Equal(t, "FIELDNOTES01", result.Data.Attributes.SKU)
+	assert.Equal(t, ContentRightsDoesNotUseThirdPartyContent, result.Data.Attributes.ContentRightsDeclaration)
+}
+
+func TestGetAppByID_NotFound(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.AppsMock{}
+	mockHandler.RegisterMocks()
+	defer mockHandler.CleanupMockState()
+
+	result, _, err := svc.GetByAppIDV1(context.Background(), "unknown", nil)
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+}
+
+func TestGetAppByID_EmptyID(t *testing.T) {
+	svc := setupMockClient(t)
+
+	result, resp, err := svc.GetByAppIDV1(context.Background(), "", nil)
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.Nil(t, resp)
+	assert.Contains(t, err.Error(), "app ID is required")
+}
@@ -0,0 +1,122 @@
+package mocks
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/jarcoal/httpmock"
+)
+
+var mockState struct {
+	sync.Mutex
+	apps map[string]map[string]any
+}
+
+func init() {
+	mockState.apps = make(map[string]map[string]any)
+	httpmock.RegisterNoResponder(httpmock.NewStringResponder(404, `{"errors":[{"status":"404","code":"NOT_FOUND","title":"The specified resource does not exist","detail":"There is no resource of type 'apps' with id 'unknown'"}]}`))
+}
+
+// loadMockResponse loads JSON response from the mocks folder.
+func loadMockResponse(filename string) ([]byte, error) {
+	mockPath := filepath.Join("mocks", filename)
+	return os.ReadFile(mockPath)
+}
+
+// jsonFileResponder returns a responder that serves the given mock file.
+func jsonFileResponder(filename string) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		mockData, err := loadMockResponse(filename)
+		if err != nil {
+			return httpmock.NewStringResponse(500, `{"errors":[{"status":"500","code":"INTERNAL_ERROR","title":"Internal Server Error","detail":"Failed to load mock data"}]}`), nil
+		}
+
+		var responseObj map[string]any
+		if err := json.Unmarshal(mockData, &responseObj); err != nil {
+			return httpmock.NewStringResponse(500, `{"errors":[{"status":"500","code":"INTERNAL_ERROR","title":"Internal Server Error","detail":"Failed to parse mock data"}]}`), nil
+		}
+
+		return httpmock.NewJsonResponse(200, responseObj)
+	}
+}
+
+// errorResponse builds a JSON error response the transport can decode into an APIError.
+func errorResponse(status int, body string) *http.Response {
+	resp := httpmock.NewStringResponse(status, body)
+	resp.Header.Set("Content-Type", "application/json")
+	return resp
+}
+
+// AppsMock provides httpmock responders for app endpoints.
+type AppsMock struct{}
+
+// RegisterMocks registers all HTTP mock responders for apps.
+func (m *AppsMock) RegisterMocks() {
+	mockState.Lock()
+	mockState.apps = make(map[string]map[string]any)
+	mockState.Unlock()
+
+	m.seedTestApp()
+
+	// GET /v1/apps — list apps, two pages linked by cursor
+	httpmock.RegisterResponder("GET", "https://api.appstoreconnect.apple.com/v1/apps", func(req *http.Request) (*http.Response, error) {
+		if req.URL.Query().Get("cursor") == "MQ" {
+			return jsonFileResponder("validate_get_apps_page2.json")(req)
+		}
+		return jsonFileResponder("validate_get_apps_page1.json")(req)
+	})
+
+	// GET /v1/apps/{id} — get app by ID
+	httpmock.RegisterResponder("GET", `=~^https://api\.appstoreconnect\.apple\.com/v1/apps/[^/]+$`, func(req *http.Request) (*http.Response, error) {
+		parts := strings.Split(req.URL.Path, "/")
+		appID := parts[len(parts)-1]
+
+		mockState.Lock()
+		_, exists := mockState.apps[appID]
+		mockState.Unlock()
+
+		if !exists {
+			return httpmock.NewStringResponse(404, `{"errors":[{"status":"404","code":"NOT_FOUND","title":"The specified resource does not exist","detail":"There is no resource of type 'apps' with id '`+appID+`'"}]}`), nil
+		}
+
+		return jsonFileResponder("validate_get_app.json")(req)
+	})
+}
+
+// RegisterErrorMocks registers mock responders that return error responses.
+func (m *AppsMock) RegisterErrorMocks() {
+	mockState.Lock()
+	mockState.apps = make(map[string]map[string]any)
+	mockState.Unlock()
+
+	httpmock.RegisterResponder("GET", "https://api.appstoreconnect.apple.com/v1/apps", func(req *http.Request) (*http.Response, error) {
+		return errorResponse(403, `{"errors":[{"status":"403","code":"FORBIDDEN_ERROR","title":"This request is forbidden for security reasons","detail":"The API key in use does not allow this request"}]}`), nil
+	})
+
+	httpmock.RegisterResponder("GET", `=~^https://api\.appstoreconnect\.apple\.com/v1/apps/[^/]+$`, func(req *http.Request) (*http.Response, error) {
+		return httpmock.NewStringResponse(404, `{"errors":[{"status":"404","code":"NOT_FOUND","title":"The specified resource does not exist","detail":"There is no resource of type 'apps' with id 'unknown'"}]}`), nil
+	})
+}
+
+// CleanupMockState clears all mock state data.
+func (m *AppsMock) CleanupMockState() {
+	mockState.Lock()
+	defer mockState.Unlock()
+	for id := range mockState.apps {
+		delete(mockState.apps, id)
+	}
+}
+
+func (m *AppsMock) seedTestApp() {
+	testApp := map[string]any{
+		"type": "apps",
+		"id":   "1234567890",
+	}
+	mockState.Lock()
+	mockState.apps["1234567890"] = testApp
+	mockState.Unlock()
+}
@@ -0,0 +1,95 @@
+package apps
+
+// Shared types for pagination and links
+type Meta struct {
+	Paging *Paging `json:"paging,omitempty"`
+}
+
+type Paging struct {
+	Total int `json:"total,omitempty"`
+	Limit int `json:"limit,omitempty"`
+}
+
+type Links struct {
+	Self  string `json:"self,omitempty"`
+	First string `json:"first,omitempty"`
+	Next  string `json:"next,omitempty"`
+}
+
+// App represents an app in App Store Connect
+type App struct {
+	ID            string            `json:"id"`
+	Type          string            `json:"type"`
+	Attributes    *AppAttributes    `json:"attributes,omitempty"`
+	Relationships *AppRelationships `json:"relationships,omitempty"`
+	Links         *ResourceLinks    `json:"links,omitempty"`
+}
+
+// AppAttributes contains the app attributes
+type AppAttributes struct {
+	Name                            string `json:"name,omitempty"`
+	BundleID                        string `json:"bundleId,omitempty"`
+	SKU                             string `json:"sku,omitempty"`
+	PrimaryLocale                   string `json:"primaryLocale,omitempty"`
+	IsOrEverWasMadeForKids          bool   `json:"isOrEverWasMadeForKids,omitempty"`
+	SubscriptionStatusURL           string `json:"subscriptionStatusUrl,omitempty"`
+	SubscriptionStatusURLVersion    string `json:"subscriptionStatusUrlVersion,omitempty"`
+	SubscriptionStatusURLForSandbox string `json:"subscriptionStatusUrlForSandbox,omitempty"`
+	ContentRightsDeclaration        string `json:"contentRightsDeclaration,omitempty"`
+}
+
+// AppRelationships contains links to resources related to the app
+type AppRelationships struct {
+	AppInfos *Relationship `json:"appInfos,omitempty"`
+	Builds   *Relationship `json:"builds,omitempty"`
+}
+
+// Relationship represents a JSON:API relationship object
+type Relationship struct {
+	Links *RelationshipLinks `json:"links,omitempty"`
+}
+
+// RelationshipLinks contains the self and related links of a relationship
+type RelationshipLinks struct {
+	Self    string `json:"self,omitempty"`
+	Related string `json:"related,omitempty"`
+}
+
+// ResourceLinks contains the self link of a resource
+type ResourceLinks struct {
+	Self string `json:"self,omitempty"`
+}
+
+// AppResponse represents the response for a single app
+type AppResponse struct {
+	Data  App            `json:"data"`
+	Links *ResourceLinks `json:"links,omitempty"`
+}
+
+// AppsResponse represents the response for listing apps
+type AppsResponse struct {
+	Data  []App  `json:"data"`
+	Meta  *Meta  `json:"meta,omitempty"`
+	Links *Links `json:"links,omitempty"`
+}
+
+// RequestQueryOptions represents the query parameters for listing apps
+type RequestQueryOptions struct {
+	// Field selection - fields to return for apps
+	// Possible values: name, bundleId, sku, primaryLocale, isOrEverWasMadeForKids,
+	// subscriptionStatusUrl, subscriptionStatusUrlVersion, subscriptionStatusUrlForSandbox,
+	// contentRightsDeclaration, appInfos, builds
+	Fields []string `json:"fields,omitempty"`
+
+	// Filters
+	FilterBundleID []string `json:"filter_bundle_id,omitempty"`
+	FilterName     []string `json:"filter_name,omitempty"`
+	FilterSKU      []string `json:"filter_sku,omitempty"`
+	FilterID       []string `json:"filter_id,omitempty"`
+
+	// Sort order, e.g. SortName or "-" + SortName
+	Sort []string `json:"sort,omitempty"`
+
+	// Limit the number of included results per page (maximum 200)
+	Limit int `json:"limit,omitempty"`
+}
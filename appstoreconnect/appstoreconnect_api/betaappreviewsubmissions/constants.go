@@ -0,0 +1,25 @@
+package betaappreviewsubmissions
+
+// Beta app review submission field constants for field selection
+const (
+	FieldBetaReviewState = "betaReviewState"
+	FieldSubmittedDate   = "submittedDate"
+	FieldBuild           = "build"
+)
+
+// Beta review state constants
+const (
+	BetaReviewStateWaitingForReview = "WAITING_FOR_REVIEW"
+	BetaReviewStateInReview         = "IN_REVIEW"
+	BetaReviewStateRejected         = "REJECTED"
+	BetaReviewStateApproved         = "APPROVED"
+)
+
+// Resource type constants used in JSON:API request bodies.
+const (
+	TypeBetaAppReviewSubmissions = "betaAppReviewSubmissions"
+	TypeBuilds                   = "builds"
+)
+
+// MaxLimit is the maximum page size accepted by the submission endpoints.
+const MaxLimit = 200
@@ -0,0 +1,140 @@
+package betaappreviewsubmissions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/constants"
+	"resty.dev/v3"
+)
+
+// BetaAppReviewSubmissions handles communication with the TestFlight beta app
+// review submission related methods of the App Store Connect API. A build must
+// pass beta app review before it can be distributed to external testers.
+//
+// App Store Connect API docs: https://developer.apple.com/documentation/appstoreconnectapi/beta-app-review-submissions
+type (
+	BetaAppReviewSubmissions struct {
+		client client.Client
+	}
+)
+
+// NewService creates a new beta app review submissions service.
+func NewService(c client.Client) *BetaAppReviewSubmissions {
+	return &BetaAppReviewSubmissions{client: c}
+}
+
+// GetV1 retrieves beta app review submissions for one or more builds, following all pages.
+// URL: GET https://api.appstoreconnect.apple.com/v1/betaAppReviewSubmissions
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-betaappreviewsubmissions
+func (s *BetaAppReviewSubmissions) GetV1(ctx context.Context, opts *RequestQueryOptions) (*BetaAppReviewSubmissionsResponse, *resty.Response, error) {
+	if opts == nil || len(opts.FilterBuild) == 0 {
+		return nil, nil, fmt.Errorf("at least one build ID filter is required")
+	}
+
+	params := s.client.QueryBuilder()
+
+	if len(opts.Fields) > 0 {
+		params.AddStringSlice("fields[betaAppReviewSubmissions]", opts.Fields)
+	}
+	params.AddStringSlice("filter[build]", opts.FilterBuild)
+	if len(opts.FilterBetaReviewState) > 0 {
+		params.AddStringSlice("filter[betaReviewState]", opts.FilterBetaReviewState)
+	}
+	if opts.Limit > 0 {
+		if opts.Limit > MaxLimit {
+			opts.Limit = MaxLimit // Enforce API maximum
+		}
+		params.AddInt("limit", opts.Limit)
+	}
+
+	var allSubmissions []BetaAppReviewSubmission
+	var lastMeta *Meta
+	var lastLinks *Links
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParams(params.Build()).
+		GetPaginated(constants.EndpointBetaAppReviewSubmissions, func(pageData []byte) error {
+			var pageResponse BetaAppReviewSubmissionsResponse
+			if err := json.Unmarshal(pageData, &pageResponse); err != nil {
+				return fmt.Errorf("failed to unmarshal page: %w", err)
+			}
+			allSubmissions = append(allSubmissions, pageResponse.Data...)
+			lastMeta = pageResponse.Meta
+			lastLinks = pageResponse.Links
+			return nil
+		})
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &BetaAppReviewSubmissionsResponse{
+		Data:  allSubmissions,
+		Meta:  lastMeta,
+		Links: lastLinks,
+	}, resp, nil
+}
+
+// GetBySubmissionIDV1 retrieves a specific beta app review submission.
+// URL: GET https://api.appstoreconnect.apple.com/v1/betaAppReviewSubmissions/{id}
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-betaappreviewsubmissions-_id_
+func (s *BetaAppReviewSubmissions) GetBySubmissionIDV1(ctx context.Context, submissionID string) (*BetaAppReviewSubmissionResponse, *resty.Response, error) {
+	if submissionID == "" {
+		return nil, nil, fmt.Errorf("submission ID is required")
+	}
+
+	endpoint := constants.EndpointBetaAppReviewSubmissions + "/" + submissionID
+
+	var result BetaAppReviewSubmissionResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetResult(&result).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// SubmitBuildV1 submits a build for beta app review so it can be distributed
+// to external testers.
+// URL: POST https://api.appstoreconnect.apple.com/v1/betaAppReviewSubmissions
+// https://developer.apple.com/documentation/appstoreconnectapi/post-v1-betaappreviewsubmissions
+func (s *BetaAppReviewSubmissions) SubmitBuildV1(ctx context.Context, buildID string) (*BetaAppReviewSubmissionResponse, *resty.Response, error) {
+	if buildID == "" {
+		return nil, nil, fmt.Errorf("build ID is required")
+	}
+
+	request := &CreateBetaAppReviewSubmissionRequest{
+		Data: CreateBetaAppReviewSubmissionData{
+			Type: TypeBetaAppReviewSubmissions,
+			Relationships: CreateBetaAppReviewSubmissionRelationships{
+				Build: ToOneRelationship{
+					Data: ResourceIdentifier{Type: TypeBuilds, ID: buildID},
+				},
+			},
+		},
+	}
+
+	var result BetaAppReviewSubmissionResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetBody(request).
+		SetResult(&result).
+		Post(constants.EndpointBetaAppReviewSubmissions)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
@@ -0,0 +1,109 @@
+package betaappreviewsubmissions
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/appstoreconnect_api/betaappreviewsubmissions/mocks"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/client"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+// setupMockClient creates a client with httpmock enabled.
+func setupMockClient(t *testing.T) *BetaAppReviewSubmissions {
+	mockAuth := &MockAuthProvider{}
+
+	coreClient, err := client.NewTransport(
+		"test-key-id",
+		"test-issuer-id",
+		"dummy-key",
+		client.WithAuth(mockAuth),
+		client.WithLogger(zap.NewNop()),
+		client.WithRetryCount(0),
+	)
+	require.NoError(t, err)
+
+	httpmock.ActivateNonDefault(coreClient.GetHTTPClient().Client())
+
+	t.Cleanup(func() {
+		httpmock.DeactivateAndReset()
+	})
+
+	return NewService(coreClient)
+}
+
+// MockAuthProvider implements the AuthProvider interface for testing.
+type MockAuthProvider struct{}
+
+func (m *MockAuthProvider) ApplyAuth(req *resty.Request) error {
+	return nil
+}
+
+func TestGetSubmissions_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.BetaAppReviewSubmissionsMock{}
+	mockHandler.RegisterMocks()
+
+	result, resp, err := svc.GetV1(context.Background(), &RequestQueryOptions{
+		FilterBuild: []string{"b0e1f2a3-1001"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	require.Len(t, result.Data, 1)
+	assert.Equal(t, BetaReviewStateInReview, result.Data[0].Attributes.BetaReviewState)
+	assert.True(t, result.Data[0].IsPending())
+}
+
+func TestGetSubmissions_RequiresBuildFilter(t *testing.T) {
+	svc := setupMockClient(t)
+
+	_, _, err := svc.GetV1(context.Background(), nil)
+	assert.ErrorContains(t, err, "build ID filter is required")
+}
+
+func TestGetSubmissionByID_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.BetaAppReviewSubmissionsMock{}
+	mockHandler.RegisterMocks()
+
+	result, _, err := svc.GetBySubmissionIDV1(context.Background(), "bars-0001")
+
+	require.NoError(t, err)
+	assert.Equal(t, BetaReviewStateApproved, result.Data.Attributes.BetaReviewState)
+	assert.False(t, result.Data.IsPending())
+}
+
+func TestSubmitBuild_Success(t *testing.T) {
+	svc := setupMockClient(t)
+
+	var received CreateBetaAppReviewSubmissionRequest
+	httpmock.RegisterResponder("POST", "https://api.appstoreconnect.apple.com/v1/betaAppReviewSubmissions",
+		func(req *http.Request) (*http.Response, error) {
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&received))
+			return httpmock.NewJsonResponse(201, map[string]any{
+				"data": map[string]any{
+					"type":       "betaAppReviewSubmissions",
+					"id":         "bars-0002",
+					"attributes": map[string]any{"betaReviewState": "WAITING_FOR_REVIEW"},
+				},
+			})
+		})
+
+	result, resp, err := svc.SubmitBuildV1(context.Background(), "b0e1f2a3-1001")
+
+	require.NoError(t, err)
+	assert.Equal(t, 201, resp.StatusCode())
+	assert.Equal(t, "bars-0002", result.Data.ID)
+	assert.Equal(t, TypeBetaAppReviewSubmissions, received.Data.Type)
+	assert.Equal(t, ResourceIdentifier{Type: TypeBuilds, ID: "b0e1f2a3-1001"}, received.Data.Relationships.Build.Data)
+
+	_, _, err = svc.SubmitBuildV1(context.Background(), "")
+	assert.ErrorContains(t, err, "build ID is required")
+}
@@ -0,0 +1,49 @@
+package mocks
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func init() {
+	httpmock.RegisterNoResponder(httpmock.NewStringResponder(404, `{"errors":[{"status":"404","code":"NOT_FOUND","title":"The specified resource does not exist","detail":"The requested resource was not found"}]}`))
+}
+
+// loadMockResponse loads JSON response from the mocks folder.
+func loadMockResponse(filename string) ([]byte, error) {
+	mockPath := filepath.Join("mocks", filename)
+	return os.ReadFile(mockPath)
+}
+
+// jsonFileResponder returns a responder that serves the given mock file.
+func jsonFileResponder(filename string) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		mockData, err := loadMockResponse(filename)
+		if err != nil {
+			return httpmock.NewStringResponse(500, `{"errors":[{"status":"500","code":"INTERNAL_ERROR","title":"Internal Server Error","detail":"Failed to load mock data"}]}`), nil
+		}
+
+		var responseObj map[string]any
+		if err := json.Unmarshal(mockData, &responseObj); err != nil {
+			return httpmock.NewStringResponse(500, `{"errors":[{"status":"500","code":"INTERNAL_ERROR","title":"Internal Server Error","detail":"Failed to parse mock data"}]}`), nil
+		}
+
+		return httpmock.NewJsonResponse(200, responseObj)
+	}
+}
+
+// BetaAppReviewSubmissionsMock provides httpmock responders for beta app review submission endpoints.
+type BetaAppReviewSubmissionsMock struct{}
+
+// RegisterMocks registers all HTTP mock responders for beta app review submissions.
+func (m *BetaAppReviewSubmissionsMock) RegisterMocks() {
+	// GET /v1/betaAppReviewSubmissions — list submissions for builds
+	httpmock.RegisterResponder("GET", "https://api.appstoreconnect.apple.com/v1/betaAppReviewSubmissions", jsonFileResponder("validate_get_submissions.json"))
+
+	// GET /v1/betaAppReviewSubmissions/{id} — get submission by ID
+	httpmock.RegisterResponder("GET", `=~^https://api\.appstoreconnect\.apple\.com/v1/betaAppReviewSubmissions/[^/]+$`, jsonFileResponder("validate_submission.json"))
+}
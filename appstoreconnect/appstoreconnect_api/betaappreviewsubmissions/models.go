@@ -0,0 +1,101 @@
+package betaappreviewsubmissions
+
+import "time"
+
+// Shared types for pagination and links
+type Meta struct {
+	Paging *Paging `json:"paging,omitempty"`
+}
+
+type Paging struct {
+	Total int `json:"total,omitempty"`
+	Limit int `json:"limit,omitempty"`
+}
+
+type Links struct {
+	Self  string `json:"self,omitempty"`
+	First string `json:"first,omitempty"`
+	Next  string `json:"next,omitempty"`
+}
+
+// ResourceLinks contains the self link of a resource
+type ResourceLinks struct {
+	Self string `json:"self,omitempty"`
+}
+
+// ResourceIdentifier identifies a related resource by type and ID
+type ResourceIdentifier struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// BetaAppReviewSubmission represents the submission of a build for TestFlight
+// external testing review
+type BetaAppReviewSubmission struct {
+	ID         string                             `json:"id"`
+	Type       string                             `json:"type"`
+	Attributes *BetaAppReviewSubmissionAttributes `json:"attributes,omitempty"`
+	Links      *ResourceLinks                     `json:"links,omitempty"`
+}
+
+// BetaAppReviewSubmissionAttributes contains the submission attributes
+type BetaAppReviewSubmissionAttributes struct {
+	BetaReviewState string     `json:"betaReviewState,omitempty"`
+	SubmittedDate   *time.Time `json:"submittedDate,omitempty"`
+}
+
+// IsPending reports whether the submission is still waiting for or in review.
+func (s *BetaAppReviewSubmission) IsPending() bool {
+	if s.Attributes == nil {
+		return true
+	}
+	state := s.Attributes.BetaReviewState
+	return state == BetaReviewStateWaitingForReview || state == BetaReviewStateInReview
+}
+
+// BetaAppReviewSubmissionResponse represents the response for a single submission
+type BetaAppReviewSubmissionResponse struct {
+	Data  BetaAppReviewSubmission `json:"data"`
+	Links *ResourceLinks          `json:"links,omitempty"`
+}
+
+// BetaAppReviewSubmissionsResponse represents the response for listing submissions
+type BetaAppReviewSubmissionsResponse struct {
+	Data  []BetaAppReviewSubmission `json:"data"`
+	Meta  *Meta                     `json:"meta,omitempty"`
+	Links *Links                    `json:"links,omitempty"`
+}
+
+// CreateBetaAppReviewSubmissionRequest is the request body for submitting a build for review
+type CreateBetaAppReviewSubmissionRequest struct {
+	Data CreateBetaAppReviewSubmissionData `json:"data"`
+}
+
+// CreateBetaAppReviewSubmissionData is the data object of a submission create request
+type CreateBetaAppReviewSubmissionData struct {
+	Type          string                                     `json:"type"`
+	Relationships CreateBetaAppReviewSubmissionRelationships `json:"relationships"`
+}
+
+// CreateBetaAppReviewSubmissionRelationships links the submission to its build
+type CreateBetaAppReviewSubmissionRelationships struct {
+	Build ToOneRelationship `json:"build"`
+}
+
+// ToOneRelationship is a JSON:API to-one relationship linkage
+type ToOneRelationship struct {
+	Data ResourceIdentifier `json:"data"`
+}
+
+// RequestQueryOptions represents the query parameters for submission requests
+type RequestQueryOptions struct {
+	// Field selection - fields to return for betaAppReviewSubmissions
+	Fields []string `json:"fields,omitempty"`
+
+	// Filters. FilterBuild is required when listing submissions.
+	FilterBuild           []string `json:"filter_build,omitempty"`
+	FilterBetaReviewState []string `json:"filter_beta_review_state,omitempty"`
+
+	// Limit the number of included results per page (maximum 200)
+	Limit int `json:"limit,omitempty"`
+}
@@ -0,0 +1,41 @@
+package betagroups
+
+// Beta group field constants for field selection
+const (
+	FieldName                   = "name"
+	FieldCreatedDate            = "createdDate"
+	FieldIsInternalGroup        = "isInternalGroup"
+	FieldHasAccessToAllBuilds   = "hasAccessToAllBuilds"
+	FieldPublicLinkEnabled      = "publicLinkEnabled"
+	FieldPublicLinkID           = "publicLinkId"
+	FieldPublicLinkLimitEnabled = "publicLinkLimitEnabled"
+	FieldPublicLinkLimit        = "publicLinkLimit"
+	FieldPublicLink             = "publicLink"
+	FieldFeedbackEnabled        = "feedbackEnabled"
+	FieldApp                    = "app"
+	FieldBuilds                 = "builds"
+	FieldBetaTesters            = "betaTesters"
+)
+
+// Sort constants for listing beta groups. Prefix with "-" for descending order.
+const (
+	SortName              = "name"
+	SortCreatedDate       = "createdDate"
+	SortPublicLinkEnabled = "publicLinkEnabled"
+	SortPublicLinkLimit   = "publicLinkLimit"
+)
+
+// Resource type constants used in JSON:API request bodies.
+const (
+	TypeBetaGroups  = "betaGroups"
+	TypeApps        = "apps"
+	TypeBuilds      = "builds"
+	TypeBetaTesters = "betaTesters"
+)
+
+// Limits enforced by the beta group endpoints.
+const (
+	MaxLimit            = 200
+	MaxPublicLinkLimit  = 10000
+	MaxRelationshipSize = 1000
+)
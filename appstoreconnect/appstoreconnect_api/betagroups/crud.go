@@ -0,0 +1,320 @@
+package betagroups
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/constants"
+	"resty.dev/v3"
+)
+
+// BetaGroups handles communication with the TestFlight beta group
+// related methods of the App Store Connect API.
+//
+// App Store Connect API docs: https://developer.apple.com/documentation/appstoreconnectapi/prerelease-versions-and-beta-testers
+type (
+	BetaGroups struct {
+		client client.Client
+	}
+)
+
+// NewService creates a new beta groups service.
+func NewService(c client.Client) *BetaGroups {
+	return &BetaGroups{client: c}
+}
+
+// GetV1 retrieves beta groups for the team's apps, following all pages.
+// URL: GET https://api.appstoreconnect.apple.com/v1/betaGroups
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-betagroups
+func (s *BetaGroups) GetV1(ctx context.Context, opts *RequestQueryOptions) (*BetaGroupsResponse, *resty.Response, error) {
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	params := s.client.QueryBuilder()
+
+	if len(opts.Fields) > 0 {
+		params.AddStringSlice("fields[betaGroups]", opts.Fields)
+	}
+	if len(opts.FilterApp) > 0 {
+		params.AddStringSlice("filter[app]", opts.FilterApp)
+	}
+	if len(opts.FilterName) > 0 {
+		params.AddStringSlice("filter[name]", opts.FilterName)
+	}
+	if len(opts.FilterBuilds) > 0 {
+		params.AddStringSlice("filter[builds]", opts.FilterBuilds)
+	}
+	if opts.FilterIsInternalGroup != nil {
+		params.AddBool("filter[isInternalGroup]", *opts.FilterIsInternalGroup)
+	}
+	if opts.FilterPublicLinkEnabled != nil {
+		params.AddBool("filter[publicLinkEnabled]", *opts.FilterPublicLinkEnabled)
+	}
+	if len(opts.Sort) > 0 {
+		params.AddStringSlice("sort", opts.Sort)
+	}
+	if opts.Limit > 0 {
+		if opts.Limit > MaxLimit {
+			opts.Limit = MaxLimit // Enforce API maximum
+		}
+		params.AddInt("limit", opts.Limit)
+	}
+
+	var allGroups []BetaGroup
+	var lastMeta *Meta
+	var lastLinks *Links
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParams(params.Build()).
+		GetPaginated(constants.EndpointBetaGroups, func(pageData []byte) error {
+			var pageResponse BetaGroupsResponse
+			if err := json.Unmarshal(pageData, &pageResponse); err != nil {
+				return fmt.Errorf("failed to unmarshal page: %w", err)
+			}
+			allGroups = append(allGroups, pageResponse.Data...)
+			lastMeta = pageResponse.Meta
+			lastLinks = pageResponse.Links
+			return nil
+		})
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &BetaGroupsResponse{
+		Data:  allGroups,
+		Meta:  lastMeta,
+		Links: lastLinks,
+	}, resp, nil
+}
+
+// GetByBetaGroupIDV1 retrieves a specific beta group.
+// URL: GET https://api.appstoreconnect.apple.com/v1/betaGroups/{id}
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-betagroups-_id_
+func (s *BetaGroups) GetByBetaGroupIDV1(ctx context.Context, betaGroupID string, opts *RequestQueryOptions) (*BetaGroupResponse, *resty.Response, error) {
+	if betaGroupID == "" {
+		return nil, nil, fmt.Errorf("beta group ID is required")
+	}
+
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	params := s.client.QueryBuilder()
+
+	if len(opts.Fields) > 0 {
+		params.AddStringSlice("fields[betaGroups]", opts.Fields)
+	}
+
+	endpoint := constants.EndpointBetaGroups + "/" + betaGroupID
+
+	var result BetaGroupResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParams(params.Build()).
+		SetResult(&result).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// CreateV1 creates a beta group for an app. Use NewCreateBetaGroupRequest to
+// build the request body.
+// URL: POST https://api.appstoreconnect.apple.com/v1/betaGroups
+// https://developer.apple.com/documentation/appstoreconnectapi/post-v1-betagroups
+func (s *BetaGroups) CreateV1(ctx context.Context, req *CreateBetaGroupRequest) (*BetaGroupResponse, *resty.Response, error) {
+	if req == nil {
+		return nil, nil, fmt.Errorf("request is required")
+	}
+	if req.Data.Attributes.Name == "" {
+		return nil, nil, fmt.Errorf("beta group name is required")
+	}
+	if req.Data.Relationships.App.Data.ID == "" {
+		return nil, nil, fmt.Errorf("app ID is required")
+	}
+	if req.Data.Attributes.PublicLinkLimit > MaxPublicLinkLimit {
+		return nil, nil, fmt.Errorf("public link limit must not exceed %d", MaxPublicLinkLimit)
+	}
+
+	var result BetaGroupResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetBody(req).
+		SetResult(&result).
+		Post(constants.EndpointBetaGroups)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// UpdateByBetaGroupIDV1 modifies the attributes of a beta group. Only non-nil
+// attributes are changed.
+// URL: PATCH https://api.appstoreconnect.apple.com/v1/betaGroups/{id}
+// https://developer.apple.com/documentation/appstoreconnectapi/patch-v1-betagroups-_id_
+func (s *BetaGroups) UpdateByBetaGroupIDV1(ctx context.Context, betaGroupID string, attributes *UpdateBetaGroupAttributes) (*BetaGroupResponse, *resty.Response, error) {
+	if betaGroupID == "" {
+		return nil, nil, fmt.Errorf("beta group ID is required")
+	}
+	if attributes == nil {
+		return nil, nil, fmt.Errorf("attributes are required")
+	}
+	if attributes.PublicLinkLimit != nil && *attributes.PublicLinkLimit > MaxPublicLinkLimit {
+		return nil, nil, fmt.Errorf("public link limit must not exceed %d", MaxPublicLinkLimit)
+	}
+
+	request := &UpdateBetaGroupRequest{
+		Data: UpdateBetaGroupData{
+			Type:       TypeBetaGroups,
+			ID:         betaGroupID,
+			Attributes: *attributes,
+		},
+	}
+
+	endpoint := constants.EndpointBetaGroups + "/" + betaGroupID
+
+	var result BetaGroupResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetBody(request).
+		SetResult(&result).
+		Patch(endpoint)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// DeleteByBetaGroupIDV1 deletes a beta group. Testers in the group lose
+// access to builds granted only through it.
+// URL: DELETE https://api.appstoreconnect.apple.com/v1/betaGroups/{id}
+// https://developer.apple.com/documentation/appstoreconnectapi/delete-v1-betagroups-_id_
+//
+// Returns 204 No Content on success.
+func (s *BetaGroups) DeleteByBetaGroupIDV1(ctx context.Context, betaGroupID string) (*resty.Response, error) {
+	if betaGroupID == "" {
+		return nil, fmt.Errorf("beta group ID is required")
+	}
+
+	endpoint := constants.EndpointBetaGroups + "/" + betaGroupID
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		Delete(endpoint)
+
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// AddBuildsV1 makes builds available to the testers in a beta group.
+// URL: POST https://api.appstoreconnect.apple.com/v1/betaGroups/{id}/relationships/builds
+// https://developer.apple.com/documentation/appstoreconnectapi/post-v1-betagroups-_id_-relationships-builds
+func (s *BetaGroups) AddBuildsV1(ctx context.Context, betaGroupID string, buildIDs []string) (*resty.Response, error) {
+	return s.modifyRelationship(ctx, "POST", betaGroupID, "builds", TypeBuilds, buildIDs)
+}
+
+// RemoveBuildsV1 removes builds from a beta group.
+// URL: DELETE https://api.appstoreconnect.apple.com/v1/betaGroups/{id}/relationships/builds
+// https://developer.apple.com/documentation/appstoreconnectapi/delete-v1-betagroups-_id_-relationships-builds
+func (s *BetaGroups) RemoveBuildsV1(ctx context.Context, betaGroupID string, buildIDs []string) (*resty.Response, error) {
+	return s.modifyRelationship(ctx, "DELETE", betaGroupID, "builds", TypeBuilds, buildIDs)
+}
+
+// AddBetaTestersV1 adds existing beta testers to a beta group.
+// URL: POST https://api.appstoreconnect.apple.com/v1/betaGroups/{id}/relationships/betaTesters
+// https://developer.apple.com/documentation/appstoreconnectapi/post-v1-betagroups-_id_-relationships-betatesters
+func (s *BetaGroups) AddBetaTestersV1(ctx context.Context, betaGroupID string, betaTesterIDs []string) (*resty.Response, error) {
+	return s.modifyRelationship(ctx, "POST", betaGroupID, "betaTesters", TypeBetaTesters, betaTesterIDs)
+}
+
+// RemoveBetaTestersV1 removes beta testers from a beta group.
+// URL: DELETE https://api.appstoreconnect.apple.com/v1/betaGroups/{id}/relationships/betaTesters
+// https://developer.apple.com/documentation/appstoreconnectapi/delete-v1-betagroups-_id_-relationships-betatesters
+func (s *BetaGroups) RemoveBetaTestersV1(ctx context.Context, betaGroupID string, betaTesterIDs []string) (*resty.Response, error) {
+	return s.modifyRelationship(ctx, "DELETE", betaGroupID, "betaTesters", TypeBetaTesters, betaTesterIDs)
+}
+
+// modifyRelationship adds (POST) or removes (DELETE) to-many relationship linkages of a beta group.
+func (s *BetaGroups) modifyRelationship(ctx context.Context, method, betaGroupID, relationship, resourceType string, ids []string) (*resty.Response, error) {
+	if betaGroupID == "" {
+		return nil, fmt.Errorf("beta group ID is required")
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("at least one %s ID is required", resourceType)
+	}
+	if len(ids) > MaxRelationshipSize {
+		return nil, fmt.Errorf("at most %d %s can be modified per request, got %d", MaxRelationshipSize, resourceType, len(ids))
+	}
+
+	endpoint := constants.EndpointBetaGroups + "/" + betaGroupID + "/relationships/" + relationship
+
+	builder := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetBody(newToManyRelationship(resourceType, ids))
+
+	if method == "DELETE" {
+		return builder.Delete(endpoint)
+	}
+	return builder.Post(endpoint)
+}
+
+// NewCreateBetaGroupRequest builds a create request for a beta group named
+// name under the app appID. Set optional attributes and relationships on the
+// returned value before passing it to CreateV1.
+func NewCreateBetaGroupRequest(appID, name string) *CreateBetaGroupRequest {
+	return &CreateBetaGroupRequest{
+		Data: CreateBetaGroupData{
+			Type: TypeBetaGroups,
+			Attributes: CreateBetaGroupAttributes{
+				Name: name,
+			},
+			Relationships: CreateBetaGroupRelationships{
+				App: ToOneRelationship{
+					Data: ResourceIdentifier{Type: TypeApps, ID: appID},
+				},
+			},
+		},
+	}
+}
+
+// newToManyRelationship builds a to-many linkage of resourceType for ids.
+func newToManyRelationship(resourceType string, ids []string) *ToManyRelationship {
+	linkages := make([]ResourceIdentifier, len(ids))
+	for i, id := range ids {
+		linkages[i] = ResourceIdentifier{Type: resourceType, ID: id}
+	}
+	return &ToManyRelationship{Data: linkages}
+}
+
+// WithBuilds grants the new beta group access to the given builds.
+func (r *CreateBetaGroupRequest) WithBuilds(buildIDs ...string) *CreateBetaGroupRequest {
+	r.Data.Relationships.Builds = newToManyRelationship(TypeBuilds, buildIDs)
+	return r
+}
+
+// WithBetaTesters adds existing beta testers to the new beta group.
+func (r *CreateBetaGroupRequest) WithBetaTesters(betaTesterIDs ...string) *CreateBetaGroupRequest {
+	r.Data.Relationships.BetaTesters = newToManyRelationship(TypeBetaTesters, betaTesterIDs)
+	return r
+}
@@ -0,0 +1,180 @@
+package betagroups
+
+import (
+	"context"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/appstoreconnect_api/betagroups/mocks"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/mockhttp"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+// setupMockClient creates a client with httpmock enabled.
+func setupMockClient(t *testing.T) *BetaGroups {
+	mockAuth := &MockAuthProvider{}
+
+	coreClient, err := client.NewTransport(
+		"test-key-id",
+		"test-issuer-id",
+		"dummy-key",
+		client.WithAuth(mockAuth),
+		client.WithLogger(zap.NewNop()),
+		client.WithRetryCount(0),
+	)
+	require.NoError(t, err)
+
+	httpmock.ActivateNonDefault(coreClient.GetHTTPClient().Client())
+
+	t.Cleanup(func() {
+		httpmock.DeactivateAndReset()
+	})
+
+	return NewService(coreClient)
+}
+
+// MockAuthProvider implements the AuthProvider interface for testing.
+type MockAuthProvider struct{}
+
+func (m *MockAuthProvider) ApplyAuth(req *resty.Request) error {
+	return nil
+}
+
+func TestGetBetaGroups_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.BetaGroupsMock{}
+	mockHandler.RegisterMocks()
+
+	internal := false
+	result, resp, err := svc.GetV1(context.Background(), &RequestQueryOptions{
+		FilterApp:             []string{"1234567890"},
+		FilterIsInternalGroup: &internal,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	require.Len(t, result.Data, 2)
+	assert.True(t, result.Data[0].Attributes.IsInternalGroup)
+	assert.Equal(t, "https://testflight.apple.com/join/AbCdEf12", result.Data[1].Attributes.PublicLink)
+}
+
+func TestCreateBetaGroup_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.BetaGroupsMock{}
+	mockHandler.RegisterMocks()
+
+	var received map[string]any
+	httpmock.RegisterResponder("POST", "https://api.appstoreconnect.apple.com/v1/betaGroups",
+		mockhttp.CaptureBody(t, &received, mockhttp.JSONResponder(201, `{"data":{"type":"betaGroups","id":"bg-0003","attributes":{"name":"Public Beta"}}}`)))
+
+	enabled := true
+	req := NewCreateBetaGroupRequest("1234567890", "Public Beta").WithBuilds("b0e1f2a3-1001")
+	req.Data.Attributes.PublicLinkEnabled = &enabled
+	req.Data.Attributes.PublicLinkLimit = 500
+
+	result, resp, err := svc.CreateV1(context.Background(), req)
+
+	require.NoError(t, err)
+	assert.Equal(t, 201, resp.StatusCode())
+	assert.Equal(t, "bg-0003", result.Data.ID)
+
+	data := received["data"].(map[string]any)
+	assert.Equal(t, TypeBetaGroups, data["type"])
+	relationships := data["relationships"].(map[string]any)
+	assert.Equal(t, map[string]any{"data": map[string]any{"type": "apps", "id": "1234567890"}}, relationships["app"])
+	assert.Equal(t, map[string]any{"data": []any{map[string]any{"type": "builds", "id": "b0e1f2a3-1001"}}}, relationships["builds"])
+	assert.NotContains(t, relationships, "betaTesters")
+}
+
+func TestCreateBetaGroup_Validation(t *testing.T) {
+	svc := setupMockClient(t)
+
+	_, _, err := svc.CreateV1(context.Background(), nil)
+	assert.Error(t, err)
+
+	_, _, err = svc.CreateV1(context.Background(), NewCreateBetaGroupRequest("1234567890", ""))
+	assert.ErrorContains(t, err, "name is required")
+
+	_, _, err = svc.CreateV1(context.Background(), NewCreateBetaGroupRequest("", "QA"))
+	assert.ErrorContains(t, err, "app ID is required")
+
+	req := NewCreateBetaGroupRequest("1234567890", "QA")
+	req.Data.Attributes.PublicLinkLimit = MaxPublicLinkLimit + 1
+	_, _, err = svc.CreateV1(context.Background(), req)
+	assert.ErrorContains(t, err, "public link limit")
+}
+
+func TestUpdateBetaGroup_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.BetaGroupsMock{}
+	mockHandler.RegisterMocks()
+
+	var received UpdateBetaGroupRequest
+	httpmock.RegisterResponder("PATCH", "https://api.appstoreconnect.apple.com/v1/betaGroups/bg-0002",
+		mockhttp.CaptureBody(t, &received, mockhttp.JSONResponder(200, `{"data":{"type":"betaGroups","id":"bg-0002"}}`)))
+
+	limit := 1000
+	_, _, err := svc.UpdateByBetaGroupIDV1(context.Background(), "bg-0002", &UpdateBetaGroupAttributes{PublicLinkLimit: &limit})
+
+	require.NoError(t, err)
+	assert.Equal(t, "bg-0002", received.Data.ID)
+	assert.Equal(t, TypeBetaGroups, received.Data.Type)
+	require.NotNil(t, received.Data.Attributes.PublicLinkLimit)
+	assert.Equal(t, 1000, *received.Data.Attributes.PublicLinkLimit)
+	assert.Nil(t, received.Data.Attributes.Name)
+}
+
+func TestDeleteBetaGroup_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.BetaGroupsMock{}
+	mockHandler.RegisterMocks()
+
+	resp, err := svc.DeleteByBetaGroupIDV1(context.Background(), "bg-0002")
+
+	require.NoError(t, err)
+	assert.Equal(t, 204, resp.StatusCode())
+}
+
+func TestAddBuilds_Success(t *testing.T) {
+	svc := setupMockClient(t)
+
+	var received ToManyRelationship
+	httpmock.RegisterResponder("POST", "https://api.appstoreconnect.apple.com/v1/betaGroups/bg-0002/relationships/builds",
+		mockhttp.CaptureBody(t, &received, httpmock.NewStringResponder(204, "")))
+
+	resp, err := svc.AddBuildsV1(context.Background(), "bg-0002", []string{"b1", "b2"})
+
+	require.NoError(t, err)
+	assert.Equal(t, 204, resp.StatusCode())
+	assert.Equal(t, []ResourceIdentifier{{Type: TypeBuilds, ID: "b1"}, {Type: TypeBuilds, ID: "b2"}}, received.Data)
+}
+
+func TestRemoveBetaTesters_SendsBody(t *testing.T) {
+	svc := setupMockClient(t)
+
+	var received ToManyRelationship
+	httpmock.RegisterResponder("DELETE", "https://api.appstoreconnect.apple.com/v1/betaGroups/bg-0002/relationships/betaTesters",
+		mockhttp.CaptureBody(t, &received, httpmock.NewStringResponder(204, "")))
+
+	_, err := svc.RemoveBetaTestersV1(context.Background(), "bg-0002", []string{"bt-1"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []ResourceIdentifier{{Type: TypeBetaTesters, ID: "bt-1"}}, received.Data)
+}
+
+func TestModifyRelationship_Validation(t *testing.T) {
+	svc := setupMockClient(t)
+
+	_, err := svc.AddBuildsV1(context.Background(), "", []string{"b1"})
+	assert.ErrorContains(t, err, "beta group ID is required")
+
+	_, err = svc.AddBetaTestersV1(context.Background(), "bg-0002", nil)
+	assert.ErrorContains(t, err, "betaTesters ID is required")
+
+	_, err = svc.RemoveBuildsV1(context.Background(), "bg-0002", make([]string, MaxRelationshipSize+1))
+	assert.ErrorContains(t, err, "at most")
+}
@@ -0,0 +1,63 @@
+package mocks
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func init() {
+	httpmock.RegisterNoResponder(httpmock.NewStringResponder(404, `{"errors":[{"status":"404","code":"NOT_FOUND","title":"The specified resource does not exist","detail":"The requested resource was not found"}]}`))
+}
+
+// loadMockResponse loads JSON response from the mocks folder.
+func loadMockResponse(filename string) ([]byte, error) {
+	mockPath := filepath.Join("mocks", filename)
+	return os.ReadFile(mockPath)
+}
+
+// jsonFileResponder returns a responder that serves the given mock file.
+func jsonFileResponder(filename string) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		mockData, err := loadMockResponse(filename)
+		if err != nil {
+			return httpmock.NewStringResponse(500, `{"errors":[{"status":"500","code":"INTERNAL_ERROR","title":"Internal Server Error","detail":"Failed to load mock data"}]}`), nil
+		}
+
+		var responseObj map[string]any
+		if err := json.Unmarshal(mockData, &responseObj); err != nil {
+			return httpmock.NewStringResponse(500, `{"errors":[{"status":"500","code":"INTERNAL_ERROR","title":"Internal Server Error","detail":"Failed to parse mock data"}]}`), nil
+		}
+
+		return httpmock.NewJsonResponse(200, responseObj)
+	}
+}
+
+// BetaGroupsMock provides httpmock responders for beta group endpoints.
+type BetaGroupsMock struct{}
+
+// RegisterMocks registers all HTTP mock responders for beta groups.
+func (m *BetaGroupsMock) RegisterMocks() {
+	// GET /v1/betaGroups — list beta groups
+	httpmock.RegisterResponder("GET", "https://api.appstoreconnect.apple.com/v1/betaGroups", jsonFileResponder("validate_get_beta_groups.json"))
+
+	// GET /v1/betaGroups/{id} — get beta group by ID
+	httpmock.RegisterResponder("GET", `=~^https://api\.appstoreconnect\.apple\.com/v1/betaGroups/[^/]+$`, jsonFileResponder("validate_beta_group.json"))
+
+	// POST /v1/betaGroups — create beta group
+	httpmock.RegisterResponder("POST", "https://api.appstoreconnect.apple.com/v1/betaGroups", jsonFileResponder("validate_beta_group.json"))
+
+	// PATCH /v1/betaGroups/{id} — update beta group
+	httpmock.RegisterResponder("PATCH", `=~^https://api\.appstoreconnect\.apple\.com/v1/betaGroups/[^/]+$`, jsonFileResponder("validate_beta_group.json"))
+
+	// DELETE /v1/betaGroups/{id} — delete beta group
+	httpmock.RegisterResponder("DELETE", `=~^https://api\.appstoreconnect\.apple\.com/v1/betaGroups/[^/]+$`, httpmock.NewStringResponder(204, ""))
+
+	// POST|DELETE /v1/betaGroups/{id}/relationships/{builds|betaTesters} — modify membership
+	for _, method := range []string{"POST", "DELETE"} {
+		httpmock.RegisterResponder(method, `=~^https://api\.appstoreconnect\.apple\.com/v1/betaGroups/[^/]+/relationships/(builds|betaTesters)$`, httpmock.NewStringResponder(204, ""))
+	}
+}
@@ -0,0 +1,148 @@
+package betagroups
+
+import "time"
+
+// Shared types for pagination and links
+type Meta struct {
+	Paging *Paging `json:"paging,omitempty"`
+}
+
+type Paging struct {
+	Total int `json:"total,omitempty"`
+	Limit int `json:"limit,omitempty"`
+}
+
+type Links struct {
+	Self  string `json:"self,omitempty"`
+	First string `json:"first,omitempty"`
+	Next  string `json:"next,omitempty"`
+}
+
+// ResourceLinks contains the self link of a resource
+type ResourceLinks struct {
+	Self string `json:"self,omitempty"`
+}
+
+// ResourceIdentifier identifies a related resource by type and ID
+type ResourceIdentifier struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// BetaGroup represents a TestFlight beta group
+type BetaGroup struct {
+	ID         string               `json:"id"`
+	Type       string               `json:"type"`
+	Attributes *BetaGroupAttributes `json:"attributes,omitempty"`
+	Links      *ResourceLinks       `json:"links,omitempty"`
+}
+
+// BetaGroupAttributes contains the beta group attributes
+type BetaGroupAttributes struct {
+	Name                   string     `json:"name,omitempty"`
+	CreatedDate            *time.Time `json:"createdDate,omitempty"`
+	IsInternalGroup        bool       `json:"isInternalGroup,omitempty"`
+	HasAccessToAllBuilds   bool       `json:"hasAccessToAllBuilds,omitempty"`
+	PublicLinkEnabled      bool       `json:"publicLinkEnabled,omitempty"`
+	PublicLinkID           string     `json:"publicLinkId,omitempty"`
+	PublicLinkLimitEnabled bool       `json:"publicLinkLimitEnabled,omitempty"`
+	PublicLinkLimit        int        `json:"publicLinkLimit,omitempty"`
+	PublicLink             string     `json:"publicLink,omitempty"`
+	FeedbackEnabled        bool       `json:"feedbackEnabled,omitempty"`
+}
+
+// BetaGroupResponse represents the response for a single beta group
+type BetaGroupResponse struct {
+	Data  BetaGroup      `json:"data"`
+	Links *ResourceLinks `json:"links,omitempty"`
+}
+
+// BetaGroupsResponse represents the response for listing beta groups
+type BetaGroupsResponse struct {
+	Data  []BetaGroup `json:"data"`
+	Meta  *Meta       `json:"meta,omitempty"`
+	Links *Links      `json:"links,omitempty"`
+}
+
+// CreateBetaGroupRequest is the request body for creating a beta group
+type CreateBetaGroupRequest struct {
+	Data CreateBetaGroupData `json:"data"`
+}
+
+// CreateBetaGroupData is the data object of a beta group create request
+type CreateBetaGroupData struct {
+	Type          string                       `json:"type"`
+	Attributes    CreateBetaGroupAttributes    `json:"attributes"`
+	Relationships CreateBetaGroupRelationships `json:"relationships"`
+}
+
+// CreateBetaGroupAttributes are the attributes accepted when creating a beta group
+type CreateBetaGroupAttributes struct {
+	Name                   string `json:"name"`
+	IsInternalGroup        *bool  `json:"isInternalGroup,omitempty"`
+	HasAccessToAllBuilds   *bool  `json:"hasAccessToAllBuilds,omitempty"`
+	PublicLinkEnabled      *bool  `json:"publicLinkEnabled,omitempty"`
+	PublicLinkLimitEnabled *bool  `json:"publicLinkLimitEnabled,omitempty"`
+	PublicLinkLimit        int    `json:"publicLinkLimit,omitempty"`
+	FeedbackEnabled        *bool  `json:"feedbackEnabled,omitempty"`
+}
+
+// CreateBetaGroupRelationships links a new beta group to its app and, optionally,
+// to initial builds and testers
+type CreateBetaGroupRelationships struct {
+	App         ToOneRelationship   `json:"app"`
+	Builds      *ToManyRelationship `json:"builds,omitempty"`
+	BetaTesters *ToManyRelationship `json:"betaTesters,omitempty"`
+}
+
+// UpdateBetaGroupRequest is the request body for modifying a beta group
+type UpdateBetaGroupRequest struct {
+	Data UpdateBetaGroupData `json:"data"`
+}
+
+// UpdateBetaGroupData is the data object of a beta group update request
+type UpdateBetaGroupData struct {
+	Type       string                    `json:"type"`
+	ID         string                    `json:"id"`
+	Attributes UpdateBetaGroupAttributes `json:"attributes"`
+}
+
+// UpdateBetaGroupAttributes are the attributes that can be modified on a beta group.
+// Nil fields are left unchanged.
+type UpdateBetaGroupAttributes struct {
+	Name                   *string `json:"name,omitempty"`
+	PublicLinkEnabled      *bool   `json:"publicLinkEnabled,omitempty"`
+	PublicLinkLimitEnabled *bool   `json:"publicLinkLimitEnabled,omitempty"`
+	PublicLinkLimit        *int    `json:"publicLinkLimit,omitempty"`
+	FeedbackEnabled        *bool   `json:"feedbackEnabled,omitempty"`
+}
+
+// ToOneRelationship is a JSON:API to-one relationship linkage
+type ToOneRelationship struct {
+	Data ResourceIdentifier `json:"data"`
+}
+
+// ToManyRelationship is a JSON:API to-many relationship linkage, also used as the
+// request body when adding or removing related resources
+type ToManyRelationship struct {
+	Data []ResourceIdentifier `json:"data"`
+}
+
+// RequestQueryOptions represents the query parameters for beta group requests
+type RequestQueryOptions struct {
+	// Field selection - fields to return for betaGroups
+	Fields []string `json:"fields,omitempty"`
+
+	// Filters
+	FilterApp               []string `json:"filter_app,omitempty"`
+	FilterName              []string `json:"filter_name,omitempty"`
+	FilterBuilds            []string `json:"filter_builds,omitempty"`
+	FilterIsInternalGroup   *bool    `json:"filter_is_internal_group,omitempty"`
+	FilterPublicLinkEnabled *bool    `json:"filter_public_link_enabled,omitempty"`
+
+	// Sort order, e.g. SortName or "-" + SortCreatedDate
+	Sort []string `json:"sort,omitempty"`
+
+	// Limit the number of included results per page (maximum 200)
+	Limit int `json:"limit,omitempty"`
+}
@@ -0,0 +1,46 @@
+package betatesters
+
+// Beta tester field constants for field selection
+const (
+	FieldFirstName  = "firstName"
+	FieldLastName   = "lastName"
+	FieldEmail      = "email"
+	FieldInviteType = "inviteType"
+	FieldState      = "state"
+	FieldApps       = "apps"
+	FieldBetaGroups = "betaGroups"
+	FieldBuilds     = "builds"
+)
+
+// Invite type constants
+const (
+	InviteTypeEmail      = "EMAIL"
+	InviteTypePublicLink = "PUBLIC_LINK"
+)
+
+// Beta tester state constants
+const (
+	StateNotInvited = "NOT_INVITED"
+	StateInvited    = "INVITED"
+	StateAccepted   = "ACCEPTED"
+	StateInstalled  = "INSTALLED"
+	StateRevoked    = "REVOKED"
+)
+
+// Sort constants for listing beta testers. Prefix with "-" for descending order.
+const (
+	SortEmail      = "email"
+	SortFirstName  = "firstName"
+	SortLastName   = "lastName"
+	SortInviteType = "inviteType"
+)
+
+// Resource type constants used in JSON:API request bodies.
+const (
+	TypeBetaTesters = "betaTesters"
+	TypeBetaGroups  = "betaGroups"
+	TypeBuilds      = "builds"
+)
+
+// MaxLimit is the maximum page size accepted by the beta tester endpoints.
+const MaxLimit = 200
@@ -0,0 +1,231 @@
+package betatesters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/mail"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/constants"
+	"resty.dev/v3"
+)
+
+// BetaTesters handles communication with the TestFlight beta tester
+// related methods of the App Store Connect API.
+//
+// App Store Connect API docs: https://developer.apple.com/documentation/appstoreconnectapi/beta-testers
+type (
+	BetaTesters struct {
+		client client.Client
+	}
+)
+
+// NewService creates a new beta testers service.
+func NewService(c client.Client) *BetaTesters {
+	return &BetaTesters{client: c}
+}
+
+// GetV1 retrieves beta testers, following all pages.
+// URL: GET https://api.appstoreconnect.apple.com/v1/betaTesters
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-betatesters
+func (s *BetaTesters) GetV1(ctx context.Context, opts *RequestQueryOptions) (*BetaTestersResponse, *resty.Response, error) {
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	params := s.client.QueryBuilder()
+
+	if len(opts.Fields) > 0 {
+		params.AddStringSlice("fields[betaTesters]", opts.Fields)
+	}
+	if len(opts.FilterEmail) > 0 {
+		params.AddStringSlice("filter[email]", opts.FilterEmail)
+	}
+	if len(opts.FilterFirstName) > 0 {
+		params.AddStringSlice("filter[firstName]", opts.FilterFirstName)
+	}
+	if len(opts.FilterLastName) > 0 {
+		params.AddStringSlice("filter[lastName]", opts.FilterLastName)
+	}
+	if len(opts.FilterInviteType) > 0 {
+		params.AddStringSlice("filter[inviteType]", opts.FilterInviteType)
+	}
+	if len(opts.FilterApps) > 0 {
+		params.AddStringSlice("filter[apps]", opts.FilterApps)
+	}
+	if len(opts.FilterBetaGroups) > 0 {
+		params.AddStringSlice("filter[betaGroups]", opts.FilterBetaGroups)
+	}
+	if len(opts.FilterBuilds) > 0 {
+		params.AddStringSlice("filter[builds]", opts.FilterBuilds)
+	}
+	if len(opts.Sort) > 0 {
+		params.AddStringSlice("sort", opts.Sort)
+	}
+	if opts.Limit > 0 {
+		if opts.Limit > MaxLimit {
+			opts.Limit = MaxLimit // Enforce API maximum
+		}
+		params.AddInt("limit", opts.Limit)
+	}
+
+	var allTesters []BetaTester
+	var lastMeta *Meta
+	var lastLinks *Links
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParams(params.Build()).
+		GetPaginated(constants.EndpointBetaTesters, func(pageData []byte) error {
+			var pageResponse BetaTestersResponse
+			if err := json.Unmarshal(pageData, &pageResponse); err != nil {
+				return fmt.Errorf("failed to unmarshal page: %w", err)
+			}
+			allTesters = append(allTesters, pageResponse.Data...)
+			lastMeta = pageResponse.Meta
+			lastLinks = pageResponse.Links
+			return nil
+		})
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &BetaTestersResponse{
+		Data:  allTesters,
+		Meta:  lastMeta,
+		Links: lastLinks,
+	}, resp, nil
+}
+
+// GetByBetaTesterIDV1 retrieves a specific beta tester.
+// URL: GET https://api.appstoreconnect.apple.com/v1/betaTesters/{id}
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-betatesters-_id_
+func (s *BetaTesters) GetByBetaTesterIDV1(ctx context.Context, betaTesterID string, opts *RequestQueryOptions) (*BetaTesterResponse, *resty.Response, error) {
+	if betaTesterID == "" {
+		return nil, nil, fmt.Errorf("beta tester ID is required")
+	}
+
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	params := s.client.QueryBuilder()
+
+	if len(opts.Fields) > 0 {
+		params.AddStringSlice("fields[betaTesters]", opts.Fields)
+	}
+
+	endpoint := constants.EndpointBetaTesters + "/" + betaTesterID
+
+	var result BetaTesterResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParams(params.Build()).
+		SetResult(&result).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// CreateV1 creates a beta tester and invites them to the given beta groups or
+// builds. Use NewCreateBetaTesterRequest to build the request body.
+// URL: POST https://api.appstoreconnect.apple.com/v1/betaTesters
+// https://developer.apple.com/documentation/appstoreconnectapi/post-v1-betatesters
+func (s *BetaTesters) CreateV1(ctx context.Context, req *CreateBetaTesterRequest) (*BetaTesterResponse, *resty.Response, error) {
+	if req == nil {
+		return nil, nil, fmt.Errorf("request is required")
+	}
+	if req.Data.Attributes.Email == "" {
+		return nil, nil, fmt.Errorf("beta tester email is required")
+	}
+	if _, err := mail.ParseAddress(req.Data.Attributes.Email); err != nil {
+		return nil, nil, fmt.Errorf("invalid beta tester email %q: %w", req.Data.Attributes.Email, err)
+	}
+	relationships := req.Data.Relationships
+	if (relationships.BetaGroups == nil || len(relationships.BetaGroups.Data) == 0) &&
+		(relationships.Builds == nil || len(relationships.Builds.Data) == 0) {
+		return nil, nil, fmt.Errorf("at least one beta group or build is required")
+	}
+
+	var result BetaTesterResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetBody(req).
+		SetResult(&result).
+		Post(constants.EndpointBetaTesters)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// DeleteByBetaTesterIDV1 removes a beta tester's ability to test all apps.
+// URL: DELETE https://api.appstoreconnect.apple.com/v1/betaTesters/{id}
+// https://developer.apple.com/documentation/appstoreconnectapi/delete-v1-betatesters-_id_
+//
+// Returns 204 No Content on success.
+func (s *BetaTesters) DeleteByBetaTesterIDV1(ctx context.Context, betaTesterID string) (*resty.Response, error) {
+	if betaTesterID == "" {
+		return nil, fmt.Errorf("beta tester ID is required")
+	}
+
+	endpoint := constants.EndpointBetaTesters + "/" + betaTesterID
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		Delete(endpoint)
+
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// NewCreateBetaTesterRequest builds a create request for a tester with the
+// given email and name. Add beta groups or builds with WithBetaGroups or
+// WithBuilds before passing it to CreateV1.
+func NewCreateBetaTesterRequest(email, firstName, lastName string) *CreateBetaTesterRequest {
+	return &CreateBetaTesterRequest{
+		Data: CreateBetaTesterData{
+			Type: TypeBetaTesters,
+			Attributes: CreateBetaTesterAttributes{
+				Email:     email,
+				FirstName: firstName,
+				LastName:  lastName,
+			},
+		},
+	}
+}
+
+// WithBetaGroups adds the new tester to the given beta groups.
+func (r *CreateBetaTesterRequest) WithBetaGroups(betaGroupIDs ...string) *CreateBetaTesterRequest {
+	r.Data.Relationships.BetaGroups = newToManyRelationship(TypeBetaGroups, betaGroupIDs)
+	return r
+}
+
+// WithBuilds gives the new tester access to the given builds individually.
+func (r *CreateBetaTesterRequest) WithBuilds(buildIDs ...string) *CreateBetaTesterRequest {
+	r.Data.Relationships.Builds = newToManyRelationship(TypeBuilds, buildIDs)
+	return r
+}
+
+// newToManyRelationship builds a to-many linkage of resourceType for ids.
+func newToManyRelationship(resourceType string, ids []string) *ToManyRelationship {
+	linkages := make([]ResourceIdentifier, len(ids))
+	for i, id := range ids {
+		linkages[i] = ResourceIdentifier{Type: resourceType, ID: id}
+	}
+	return &ToManyRelationship{Data: linkages}
+}
@@ -0,0 +1,120 @@
+package betatesters
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/appstoreconnect_api/betatesters/mocks"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/client"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+// setupMockClient creates a client with httpmock enabled.
+func setupMockClient(t *testing.T) *BetaTesters {
+	mockAuth := &MockAuthProvider{}
+
+	coreClient, err := client.NewTransport(
+		"test-key-id",
+		"test-issuer-id",
+		"dummy-key",
+		client.WithAuth(mockAuth),
+		client.WithLogger(zap.NewNop()),
+		client.WithRetryCount(0),
+	)
+	require.NoError(t, err)
+
+	httpmock.ActivateNonDefault(coreClient.GetHTTPClient().Client())
+
+	t.Cleanup(func() {
+		httpmock.DeactivateAndReset()
+	})
+
+	return NewService(coreClient)
+}
+
+// MockAuthProvider implements the AuthProvider interface for testing.
+type MockAuthProvider struct{}
+
+func (m *MockAuthProvider) ApplyAuth(req *resty.Request) error {
+	return nil
+}
+
+func TestGetBetaTesters_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.BetaTestersMock{}
+	mockHandler.RegisterMocks()
+
+	result, resp, err := svc.GetV1(context.Background(), &RequestQueryOptions{
+		FilterBetaGroups: []string{"bg-0001"},
+		Sort:             []string{SortEmail},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	require.Len(t, result.Data, 2)
+	assert.Equal(t, StateInstalled, result.Data[0].Attributes.State)
+	assert.Equal(t, InviteTypePublicLink, result.Data[1].Attributes.InviteType)
+}
+
+func TestCreateBetaTester_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.BetaTestersMock{}
+	mockHandler.RegisterMocks()
+
+	var received CreateBetaTesterRequest
+	httpmock.RegisterResponder("POST", "https://api.appstoreconnect.apple.com/v1/betaTesters",
+		func(req *http.Request) (*http.Response, error) {
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&received))
+			return httpmock.NewJsonResponse(201, map[string]any{
+				"data": map[string]any{"type": "betaTesters", "id": "bt-0003"},
+			})
+		})
+
+	req := NewCreateBetaTesterRequest("casey@example.com", "Casey", "Lee").WithBetaGroups("bg-0001", "bg-0002")
+
+	result, resp, err := svc.CreateV1(context.Background(), req)
+
+	require.NoError(t, err)
+	assert.Equal(t, 201, resp.StatusCode())
+	assert.Equal(t, "bt-0003", result.Data.ID)
+	assert.Equal(t, "casey@example.com", received.Data.Attributes.Email)
+	require.NotNil(t, received.Data.Relationships.BetaGroups)
+	assert.Len(t, received.Data.Relationships.BetaGroups.Data, 2)
+	assert.Nil(t, received.Data.Relationships.Builds)
+}
+
+func TestCreateBetaTester_Validation(t *testing.T) {
+	svc := setupMockClient(t)
+
+	_, _, err := svc.CreateV1(context.Background(), nil)
+	assert.Error(t, err)
+
+	_, _, err = svc.CreateV1(context.Background(), NewCreateBetaTesterRequest("", "", "").WithBuilds("b1"))
+	assert.ErrorContains(t, err, "email is required")
+
+	_, _, err = svc.CreateV1(context.Background(), NewCreateBetaTesterRequest("not-an-email", "", "").WithBuilds("b1"))
+	assert.ErrorContains(t, err, "invalid beta tester email")
+
+	_, _, err = svc.CreateV1(context.Background(), NewCreateBetaTesterRequest("casey@example.com", "", ""))
+	assert.ErrorContains(t, err, "at least one beta group or build")
+}
+
+func TestDeleteBetaTester_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.BetaTestersMock{}
+	mockHandler.RegisterMocks()
+
+	resp, err := svc.DeleteByBetaTesterIDV1(context.Background(), "bt-0001")
+
+	require.NoError(t, err)
+	assert.Equal(t, 204, resp.StatusCode())
+
+	_, err = svc.DeleteByBetaTesterIDV1(context.Background(), "")
+	assert.ErrorContains(t, err, "beta tester ID is required")
+}
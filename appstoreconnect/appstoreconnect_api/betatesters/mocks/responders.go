@@ -0,0 +1,55 @@
+package mocks
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func init() {
+	httpmock.RegisterNoResponder(httpmock.NewStringResponder(404, `{"errors":[{"status":"404","code":"NOT_FOUND","title":"The specified resource does not exist","detail":"The requested resource was not found"}]}`))
+}
+
+// loadMockResponse loads JSON response from the mocks folder.
+func loadMockResponse(filename string) ([]byte, error) {
+	mockPath := filepath.Join("mocks", filename)
+	return os.ReadFile(mockPath)
+}
+
+// jsonFileResponder returns a responder that serves the given mock file.
+func jsonFileResponder(filename string) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		mockData, err := loadMockResponse(filename)
+		if err != nil {
+			return httpmock.NewStringResponse(500, `{"errors":[{"status":"500","code":"INTERNAL_ERROR","title":"Internal Server Error","detail":"Failed to load mock data"}]}`), nil
+		}
+
+		var responseObj map[string]any
+		if err := json.Unmarshal(mockData, &responseObj); err != nil {
+			return httpmock.NewStringResponse(500, `{"errors":[{"status":"500","code":"INTERNAL_ERROR","title":"Internal Server Error","detail":"Failed to parse mock data"}]}`), nil
+		}
+
+		return httpmock.NewJsonResponse(200, responseObj)
+	}
+}
+
+// BetaTestersMock provides httpmock responders for beta tester endpoints.
+type BetaTestersMock struct{}
+
+// RegisterMocks registers all HTTP mock responders for beta testers.
+func (m *BetaTestersMock) RegisterMocks() {
+	// GET /v1/betaTesters — list beta testers
+	httpmock.RegisterResponder("GET", "https://api.appstoreconnect.apple.com/v1/betaTesters", jsonFileResponder("validate_get_beta_testers.json"))
+
+	// GET /v1/betaTesters/{id} — get beta tester by ID
+	httpmock.RegisterResponder("GET", `=~^https://api\.appstoreconnect\.apple\.com/v1/betaTesters/[^/]+$`, jsonFileResponder("validate_beta_tester.json"))
+
+	// POST /v1/betaTesters — create beta tester
+	httpmock.RegisterResponder("POST", "https://api.appstoreconnect.apple.com/v1/betaTesters", jsonFileResponder("validate_beta_tester.json"))
+
+	// DELETE /v1/betaTesters/{id} — delete beta tester
+	httpmock.RegisterResponder("DELETE", `=~^https://api\.appstoreconnect\.apple\.com/v1/betaTesters/[^/]+$`, httpmock.NewStringResponder(204, ""))
+}
@@ -0,0 +1,110 @@
+package betatesters
+
+// Shared types for pagination and links
+type Meta struct {
+	Paging *Paging `json:"paging,omitempty"`
+}
+
+type Paging struct {
+	Total int `json:"total,omitempty"`
+	Limit int `json:"limit,omitempty"`
+}
+
+type Links struct {
+	Self  string `json:"self,omitempty"`
+	First string `json:"first,omitempty"`
+	Next  string `json:"next,omitempty"`
+}
+
+// ResourceLinks contains the self link of a resource
+type ResourceLinks struct {
+	Self string `json:"self,omitempty"`
+}
+
+// ResourceIdentifier identifies a related resource by type and ID
+type ResourceIdentifier struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// BetaTester represents a TestFlight beta tester
+type BetaTester struct {
+	ID         string                `json:"id"`
+	Type       string                `json:"type"`
+	Attributes *BetaTesterAttributes `json:"attributes,omitempty"`
+	Links      *ResourceLinks        `json:"links,omitempty"`
+}
+
+// BetaTesterAttributes contains the beta tester attributes
+type BetaTesterAttributes struct {
+	FirstName  string `json:"firstName,omitempty"`
+	LastName   string `json:"lastName,omitempty"`
+	Email      string `json:"email,omitempty"`
+	InviteType string `json:"inviteType,omitempty"`
+	State      string `json:"state,omitempty"`
+}
+
+// BetaTesterResponse represents the response for a single beta tester
+type BetaTesterResponse struct {
+	Data  BetaTester     `json:"data"`
+	Links *ResourceLinks `json:"links,omitempty"`
+}
+
+// BetaTestersResponse represents the response for listing beta testers
+type BetaTestersResponse struct {
+	Data  []BetaTester `json:"data"`
+	Meta  *Meta        `json:"meta,omitempty"`
+	Links *Links       `json:"links,omitempty"`
+}
+
+// CreateBetaTesterRequest is the request body for creating a beta tester
+type CreateBetaTesterRequest struct {
+	Data CreateBetaTesterData `json:"data"`
+}
+
+// CreateBetaTesterData is the data object of a beta tester create request
+type CreateBetaTesterData struct {
+	Type          string                        `json:"type"`
+	Attributes    CreateBetaTesterAttributes    `json:"attributes"`
+	Relationships CreateBetaTesterRelationships `json:"relationships"`
+}
+
+// CreateBetaTesterAttributes are the attributes accepted when creating a beta tester
+type CreateBetaTesterAttributes struct {
+	Email     string `json:"email"`
+	FirstName string `json:"firstName,omitempty"`
+	LastName  string `json:"lastName,omitempty"`
+}
+
+// CreateBetaTesterRelationships places a new tester in beta groups or gives
+// them access to individual builds. At least one must be set.
+type CreateBetaTesterRelationships struct {
+	BetaGroups *ToManyRelationship `json:"betaGroups,omitempty"`
+	Builds     *ToManyRelationship `json:"builds,omitempty"`
+}
+
+// ToManyRelationship is a JSON:API to-many relationship linkage
+type ToManyRelationship struct {
+	Data []ResourceIdentifier `json:"data"`
+}
+
+// RequestQueryOptions represents the query parameters for beta tester requests
+type RequestQueryOptions struct {
+	// Field selection - fields to return for betaTesters
+	Fields []string `json:"fields,omitempty"`
+
+	// Filters
+	FilterEmail      []string `json:"filter_email,omitempty"`
+	FilterFirstName  []string `json:"filter_first_name,omitempty"`
+	FilterLastName   []string `json:"filter_last_name,omitempty"`
+	FilterInviteType []string `json:"filter_invite_type,omitempty"`
+	FilterApps       []string `json:"filter_apps,omitempty"`
+	FilterBetaGroups []string `json:"filter_beta_groups,omitempty"`
+	FilterBuilds     []string `json:"filter_builds,omitempty"`
+
+	// Sort order, e.g. SortEmail or "-" + SortLastName
+	Sort []string `json:"sort,omitempty"`
+
+	// Limit the number of included results per page (maximum 200)
+	Limit int `json:"limit,omitempty"`
+}
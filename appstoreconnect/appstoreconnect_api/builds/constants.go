@@ -0,0 +1,48 @@
+package builds
+
+// Build field constants for field selection
+const (
+	FieldVersion                 = "version"
+	FieldUploadedDate            = "uploadedDate"
+	FieldExpirationDate          = "expirationDate"
+	FieldExpired                 = "expired"
+	FieldMinOsVersion            = "minOsVersion"
+	FieldLsMinimumSystemVersion  = "lsMinimumSystemVersion"
+	FieldComputedMinMacOsVersion = "computedMinMacOsVersion"
+	FieldIconAssetToken          = "iconAssetToken"
+	FieldProcessingState         = "processingState"
+	FieldBuildAudienceType       = "buildAudienceType"
+	FieldUsesNonExemptEncryption = "usesNonExemptEncryption"
+	FieldApp                     = "app"
+)
+
+// Processing state constants
+const (
+	ProcessingStateProcessing = "PROCESSING"
+	ProcessingStateFailed     = "FAILED"
+	ProcessingStateInvalid    = "INVALID"
+	ProcessingStateValid      = "VALID"
+)
+
+// Build audience type constants
+const (
+	BuildAudienceInternalOnly     = "INTERNAL_ONLY"
+	BuildAudienceAppStoreEligible = "APP_STORE_ELIGIBLE"
+)
+
+// Platform constants for the preReleaseVersion.platform filter
+const (
+	PlatformIOS      = "IOS"
+	PlatformMacOS    = "MAC_OS"
+	PlatformTVOS     = "TV_OS"
+	PlatformVisionOS = "VISION_OS"
+)
+
+// Sort constants for listing builds. Prefix with "-" for descending order.
+const (
+	SortVersion      = "version"
+	SortUploadedDate = "uploadedDate"
+)
+
+// MaxLimit is the maximum page size accepted by the builds endpoints.
+const MaxLimit = 200
@@ -0,0 +1,134 @@
+package builds
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/constants"
+	"resty.dev/v3"
+)
+
+// Builds handles communication with the build
+// related methods of the App Store Connect API.
+//
+// App Store Connect API docs: https://developer.apple.com/documentation/appstoreconnectapi/builds
+type (
+	Builds struct {
+		client client.Client
+	}
+)
+
+// NewService creates a new builds service.
+func NewService(c client.Client) *Builds {
+	return &Builds{client: c}
+}
+
+// GetV1 retrieves builds for the team's apps, following all pages.
+// URL: GET https://api.appstoreconnect.apple.com/v1/builds
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-builds
+func (s *Builds) GetV1(ctx context.Context, opts *RequestQueryOptions) (*BuildsResponse, *resty.Response, error) {
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	params := s.client.QueryBuilder()
+
+	if len(opts.Fields) > 0 {
+		params.AddStringSlice("fields[builds]", opts.Fields)
+	}
+	if len(opts.FilterApp) > 0 {
+		params.AddStringSlice("filter[app]", opts.FilterApp)
+	}
+	if len(opts.FilterVersion) > 0 {
+		params.AddStringSlice("filter[version]", opts.FilterVersion)
+	}
+	if len(opts.FilterPreReleaseVersionVersion) > 0 {
+		params.AddStringSlice("filter[preReleaseVersion.version]", opts.FilterPreReleaseVersionVersion)
+	}
+	if len(opts.FilterPreReleaseVersionPlatform) > 0 {
+		params.AddStringSlice("filter[preReleaseVersion.platform]", opts.FilterPreReleaseVersionPlatform)
+	}
+	if len(opts.FilterProcessingState) > 0 {
+		params.AddStringSlice("filter[processingState]", opts.FilterProcessingState)
+	}
+	if len(opts.FilterBuildAudienceType) > 0 {
+		params.AddStringSlice("filter[buildAudienceType]", opts.FilterBuildAudienceType)
+	}
+	if opts.FilterExpired != nil {
+		params.AddBool("filter[expired]", *opts.FilterExpired)
+	}
+	if len(opts.Sort) > 0 {
+		params.AddStringSlice("sort", opts.Sort)
+	}
+	if opts.Limit > 0 {
+		if opts.Limit > MaxLimit {
+			opts.Limit = MaxLimit // Enforce API maximum
+		}
+		params.AddInt("limit", opts.Limit)
+	}
+
+	var allBuilds []Build
+	var lastMeta *Meta
+	var lastLinks *Links
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParams(params.Build()).
+		GetPaginated(constants.EndpointBuilds, func(pageData []byte) error {
+			var pageResponse BuildsResponse
+			if err := json.Unmarshal(pageData, &pageResponse); err != nil {
+				return fmt.Errorf("failed to unmarshal page: %w", err)
+			}
+			allBuilds = append(allBuilds, pageResponse.Data...)
+			lastMeta = pageResponse.Meta
+			lastLinks = pageResponse.Links
+			return nil
+		})
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &BuildsResponse{
+		Data:  allBuilds,
+		Meta:  lastMeta,
+		Links: lastLinks,
+	}, resp, nil
+}
+
+// GetByBuildIDV1 retrieves information about a specific build.
+// URL: GET https://api.appstoreconnect.apple.com/v1/builds/{id}
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-builds-_id_
+func (s *Builds) GetByBuildIDV1(ctx context.Context, buildID string, opts *RequestQueryOptions) (*BuildResponse, *resty.Response, error) {
+	if buildID == "" {
+		return nil, nil, fmt.Errorf("build ID is required")
+	}
+
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	params := s.client.QueryBuilder()
+
+	if len(opts.Fields) > 0 {
+		params.AddStringSlice("fields[builds]", opts.Fields)
+	}
+
+	endpoint := constants.EndpointBuilds + "/" + buildID
+
+	var result BuildResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParams(params.Build()).
+		SetResult(&result).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
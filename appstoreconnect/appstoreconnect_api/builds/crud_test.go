@@ -0,0 +1,99 @@
+package builds
+
+import (
+	"context"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/appstoreconnect_api/builds/mocks"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/client"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+// setupMockClient creates a client with httpmock enabled.
+func setupMockClient(t *testing.T) *Builds {
+	mockAuth := &MockAuthProvider{}
+
+	coreClient, err := client.NewTransport(
+		"test-key-id",
+		"test-issuer-id",
+		"dummy-key",
+		client.WithAuth(mockAuth),
+		client.WithLogger(zap.NewNop()),
+		client.WithRetryCount(0),
+	)
+	require.NoError(t, err)
+
+	httpmock.ActivateNonDefault(coreClient.GetHTTPClient().Client())
+
+	t.Cleanup(func() {
+		httpmock.DeactivateAndReset()
+	})
+
+	return NewService(coreClient)
+}
+
+// MockAuthProvider implements the AuthProvider interface for testing.
+type MockAuthProvider struct{}
+
+func (m *MockAuthProvider) ApplyAuth(req *resty.Request) error {
+	return nil
+}
+
+func TestGetBuilds_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.BuildsMock{}
+	mockHandler.RegisterMocks()
+
+	expired := false
+	result, resp, err := svc.GetV1(context.Background(), &RequestQueryOptions{
+		FilterApp:             []string{"1234567890"},
+		FilterProcessingState: []string{ProcessingStateValid},
+		FilterExpired:         &expired,
+		Sort:                  []string{"-" + SortUploadedDate},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	require.Len(t, result.Data, 1)
+
+	build := result.Data[0]
+	assert.Equal(t, "42", build.Attributes.Version)
+	assert.Equal(t, ProcessingStateValid, build.Attributes.ProcessingState)
+	require.NotNil(t, build.Attributes.UploadedDate)
+	require.NotNil(t, build.Attributes.IconAssetToken)
+	assert.Equal(t, 1024, build.Attributes.IconAssetToken.Width)
+	require.NotNil(t, build.Attributes.UsesNonExemptEncryption)
+	assert.False(t, *build.Attributes.UsesNonExemptEncryption)
+	require.NotNil(t, build.Relationships.App.Data)
+	assert.Equal(t, "1234567890", build.Relationships.App.Data.ID)
+
+	info := httpmock.GetCallCountInfo()
+	assert.Equal(t, 1, info["GET https://api.appstoreconnect.apple.com/v1/builds"])
+}
+
+func TestGetBuildByID_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.BuildsMock{}
+	mockHandler.RegisterMocks()
+
+	result, _, err := svc.GetByBuildIDV1(context.Background(), "b0e1f2a3-1001", nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, "b0e1f2a3-1001", result.Data.ID)
+	assert.Equal(t, ProcessingStateProcessing, result.Data.Attributes.ProcessingState)
+}
+
+func TestGetBuildByID_EmptyID(t *testing.T) {
+	svc := setupMockClient(t)
+
+	result, resp, err := svc.GetByBuildIDV1(context.Background(), "", nil)
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.Nil(t, resp)
+	assert.Contains(t, err.Error(), "build ID is required")
+}
@@ -0,0 +1,118 @@
+package builds
+
+import "time"
+
+// Shared types for pagination and links
+type Meta struct {
+	Paging *Paging `json:"paging,omitempty"`
+}
+
+type Paging struct {
+	Total int `json:"total,omitempty"`
+	Limit int `json:"limit,omitempty"`
+}
+
+type Links struct {
+	Self  string `json:"self,omitempty"`
+	First string `json:"first,omitempty"`
+	Next  string `json:"next,omitempty"`
+}
+
+// Build represents a build uploaded to App Store Connect
+type Build struct {
+	ID            string              `json:"id"`
+	Type          string              `json:"type"`
+	Attributes    *BuildAttributes    `json:"attributes,omitempty"`
+	Relationships *BuildRelationships `json:"relationships,omitempty"`
+	Links         *ResourceLinks      `json:"links,omitempty"`
+}
+
+// BuildAttributes contains the build attributes
+type BuildAttributes struct {
+	Version                 string      `json:"version,omitempty"`
+	UploadedDate            *time.Time  `json:"uploadedDate,omitempty"`
+	ExpirationDate          *time.Time  `json:"expirationDate,omitempty"`
+	Expired                 bool        `json:"expired,omitempty"`
+	MinOsVersion            string      `json:"minOsVersion,omitempty"`
+	LsMinimumSystemVersion  string      `json:"lsMinimumSystemVersion,omitempty"`
+	ComputedMinMacOsVersion string      `json:"computedMinMacOsVersion,omitempty"`
+	IconAssetToken          *ImageAsset `json:"iconAssetToken,omitempty"`
+	ProcessingState         string      `json:"processingState,omitempty"`
+	BuildAudienceType       string      `json:"buildAudienceType,omitempty"`
+	UsesNonExemptEncryption *bool       `json:"usesNonExemptEncryption,omitempty"`
+}
+
+// ImageAsset describes an image hosted by Apple. Substitute {w}, {h} and {f}
+// in TemplateURL with the desired width, height and format.
+type ImageAsset struct {
+	TemplateURL string `json:"templateUrl,omitempty"`
+	Width       int    `json:"width,omitempty"`
+	Height      int    `json:"height,omitempty"`
+}
+
+// BuildRelationships contains resources related to the build
+type BuildRelationships struct {
+	App *AppRelationship `json:"app,omitempty"`
+}
+
+// AppRelationship links a build to its app
+type AppRelationship struct {
+	Data  *ResourceIdentifier `json:"data,omitempty"`
+	Links *RelationshipLinks  `json:"links,omitempty"`
+}
+
+// ResourceIdentifier identifies a related resource by type and ID
+type ResourceIdentifier struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// RelationshipLinks contains the self and related links of a relationship
+type RelationshipLinks struct {
+	Self    string `json:"self,omitempty"`
+	Related string `json:"related,omitempty"`
+}
+
+// ResourceLinks contains the self link of a resource
+type ResourceLinks struct {
+	Self string `json:"self,omitempty"`
+}
+
+// BuildResponse represents the response for a single build
+type BuildResponse struct {
+	Data  Build          `json:"data"`
+	Links *ResourceLinks `json:"links,omitempty"`
+}
+
+// BuildsResponse represents the response for listing builds
+type BuildsResponse struct {
+	Data  []Build `json:"data"`
+	Meta  *Meta   `json:"meta,omitempty"`
+	Links *Links  `json:"links,omitempty"`
+}
+
+// RequestQueryOptions represents the query parameters for build requests
+type RequestQueryOptions struct {
+	// Field selection - fields to return for builds
+	// Possible values: version, uploadedDate, expirationDate, expired, minOsVersion,
+	// lsMinimumSystemVersion, computedMinMacOsVersion, iconAssetToken, processingState,
+	// buildAudienceType, usesNonExemptEncryption, app
+	Fields []string `json:"fields,omitempty"`
+
+	// Filters
+	FilterApp                       []string `json:"filter_app,omitempty"`
+	FilterVersion                   []string `json:"filter_version,omitempty"`
+	FilterPreReleaseVersionVersion  []string `json:"filter_pre_release_version_version,omitempty"`
+	FilterPreReleaseVersionPlatform []string `json:"filter_pre_release_version_platform,omitempty"`
+	FilterProcessingState           []string `json:"filter_processing_state,omitempty"`
+	FilterBuildAudienceType         []string `json:"filter_build_audience_type,omitempty"`
+
+	// FilterExpired restricts results to expired (true) or unexpired (false) builds when set.
+	FilterExpired *bool `json:"filter_expired,omitempty"`
+
+	// Sort order, e.g. "-" + SortUploadedDate for the newest builds first
+	Sort []string `json:"sort,omitempty"`
+
+	// Limit the number of included results per page (maximum 200)
+	Limit int `json:"limit,omitempty"`
+}
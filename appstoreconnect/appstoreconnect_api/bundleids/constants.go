@@ -0,0 +1,33 @@
+package bundleids
+
+// Bundle ID field constants for field selection
+const (
+	FieldName       = "name"
+	FieldPlatform   = "platform"
+	FieldIdentifier = "identifier"
+	FieldSeedID     = "seedId"
+)
+
+// Bundle ID platform constants
+const (
+	PlatformIOS       = "IOS"
+	PlatformMacOS     = "MAC_OS"
+	PlatformUniversal = "UNIVERSAL"
+)
+
+// Sort constants for listing bundle IDs. Prefix with "-" for descending order.
+const (
+	SortName       = "name"
+	SortPlatform   = "platform"
+	SortIdentifier = "identifier"
+	SortSeedID     = "seedId"
+	SortID         = "id"
+)
+
+// Resource type constants used in JSON:API request bodies.
+const (
+	TypeBundleIDs = "bundleIds"
+)
+
+// MaxLimit is the maximum page size accepted by the bundle ID endpoints.
+const MaxLimit = 200
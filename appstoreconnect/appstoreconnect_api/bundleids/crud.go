@@ -0,0 +1,229 @@
+package bundleids
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/constants"
+	"resty.dev/v3"
+)
+
+// BundleIDs handles communication with the bundle identifier
+// related methods of the App Store Connect API.
+//
+// App Store Connect API docs: https://developer.apple.com/documentation/appstoreconnectapi/bundle-ids
+type (
+	BundleIDs struct {
+		client client.Client
+	}
+)
+
+// NewService creates a new bundle IDs service.
+func NewService(c client.Client) *BundleIDs {
+	return &BundleIDs{client: c}
+}
+
+// GetV1 retrieves the team's registered bundle IDs, following all pages.
+// URL: GET https://api.appstoreconnect.apple.com/v1/bundleIds
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-bundleids
+func (s *BundleIDs) GetV1(ctx context.Context, opts *RequestQueryOptions) (*BundleIDsResponse, *resty.Response, error) {
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	params := s.client.QueryBuilder()
+
+	if len(opts.Fields) > 0 {
+		params.AddStringSlice("fields[bundleIds]", opts.Fields)
+	}
+	if len(opts.FilterID) > 0 {
+		params.AddStringSlice("filter[id]", opts.FilterID)
+	}
+	if len(opts.FilterIdentifier) > 0 {
+		params.AddStringSlice("filter[identifier]", opts.FilterIdentifier)
+	}
+	if len(opts.FilterName) > 0 {
+		params.AddStringSlice("filter[name]", opts.FilterName)
+	}
+	if len(opts.FilterPlatform) > 0 {
+		params.AddStringSlice("filter[platform]", opts.FilterPlatform)
+	}
+	if len(opts.FilterSeedID) > 0 {
+		params.AddStringSlice("filter[seedId]", opts.FilterSeedID)
+	}
+	if len(opts.Sort) > 0 {
+		params.AddStringSlice("sort", opts.Sort)
+	}
+	if opts.Limit > 0 {
+		if opts.Limit > MaxLimit {
+			opts.Limit = MaxLimit // Enforce API maximum
+		}
+		params.AddInt("limit", opts.Limit)
+	}
+
+	var allBundleIDs []BundleID
+	var lastMeta *Meta
+	var lastLinks *Links
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParams(params.Build()).
+		GetPaginated(constants.EndpointBundleIDs, func(pageData []byte) error {
+			var pageResponse BundleIDsResponse
+			if err := json.Unmarshal(pageData, &pageResponse); err != nil {
+				return fmt.Errorf("failed to unmarshal page: %w", err)
+			}
+			allBundleIDs = append(allBundleIDs, pageResponse.Data...)
+			lastMeta = pageResponse.Meta
+			lastLinks = pageResponse.Links
+			return nil
+		})
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &BundleIDsResponse{
+		Data:  allBundleIDs,
+		Meta:  lastMeta,
+		Links: lastLinks,
+	}, resp, nil
+}
+
+// GetByBundleIDIDV1 retrieves a specific bundle ID.
+// URL: GET https://api.appstoreconnect.apple.com/v1/bundleIds/{id}
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-bundleids-_id_
+func (s *BundleIDs) GetByBundleIDIDV1(ctx context.Context, bundleIDID string, opts *RequestQueryOptions) (*BundleIDResponse, *resty.Response, error) {
+	if bundleIDID == "" {
+		return nil, nil, fmt.Errorf("bundle ID is required")
+	}
+
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	params := s.client.QueryBuilder()
+
+	if len(opts.Fields) > 0 {
+		params.AddStringSlice("fields[bundleIds]", opts.Fields)
+	}
+
+	endpoint := constants.EndpointBundleIDs + "/" + bundleIDID
+
+	var result BundleIDResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParams(params.Build()).
+		SetResult(&result).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// CreateV1 registers a new bundle ID. SeedID may be left empty to use the
+// team's default App ID prefix.
+// URL: POST https://api.appstoreconnect.apple.com/v1/bundleIds
+// https://developer.apple.com/documentation/appstoreconnectapi/post-v1-bundleids
+func (s *BundleIDs) CreateV1(ctx context.Context, attributes *CreateBundleIDAttributes) (*BundleIDResponse, *resty.Response, error) {
+	if attributes == nil {
+		return nil, nil, fmt.Errorf("attributes are required")
+	}
+	if attributes.Identifier == "" {
+		return nil, nil, fmt.Errorf("bundle identifier is required")
+	}
+	if attributes.Name == "" {
+		return nil, nil, fmt.Errorf("bundle ID name is required")
+	}
+	if attributes.Platform == "" {
+		return nil, nil, fmt.Errorf("platform is required")
+	}
+
+	request := &CreateBundleIDRequest{
+		Data: CreateBundleIDData{
+			Type:       TypeBundleIDs,
+			Attributes: *attributes,
+		},
+	}
+
+	var result BundleIDResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetBody(request).
+		SetResult(&result).
+		Post(constants.EndpointBundleIDs)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// UpdateByBundleIDIDV1 renames a bundle ID. The identifier itself cannot be changed.
+// URL: PATCH https://api.appstoreconnect.apple.com/v1/bundleIds/{id}
+// https://developer.apple.com/documentation/appstoreconnectapi/patch-v1-bundleids-_id_
+func (s *BundleIDs) UpdateByBundleIDIDV1(ctx context.Context, bundleIDID, name string) (*BundleIDResponse, *resty.Response, error) {
+	if bundleIDID == "" {
+		return nil, nil, fmt.Errorf("bundle ID is required")
+	}
+	if name == "" {
+		return nil, nil, fmt.Errorf("bundle ID name is required")
+	}
+
+	request := &UpdateBundleIDRequest{
+		Data: UpdateBundleIDData{
+			Type:       TypeBundleIDs,
+			ID:         bundleIDID,
+			Attributes: UpdateBundleIDAttributes{Name: name},
+		},
+	}
+
+	endpoint := constants.EndpointBundleIDs + "/" + bundleIDID
+
+	var result BundleIDResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetBody(request).
+		SetResult(&result).
+		Patch(endpoint)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// DeleteByBundleIDIDV1 deletes a bundle ID that is not used by an app.
+// URL: DELETE https://api.appstoreconnect.apple.com/v1/bundleIds/{id}
+// https://developer.apple.com/documentation/appstoreconnectapi/delete-v1-bundleids-_id_
+//
+// Returns 204 No Content on success.
+func (s *BundleIDs) DeleteByBundleIDIDV1(ctx context.Context, bundleIDID string) (*resty.Response, error) {
+	if bundleIDID == "" {
+		return nil, fmt.Errorf("bundle ID is required")
+	}
+
+	endpoint := constants.EndpointBundleIDs + "/" + bundleIDID
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		Delete(endpoint)
+
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,152 @@
+package bundleids
+
+import (
+	"context"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/appstoreconnect_api/bundleids/mocks"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/mockhttp"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+// setupMockClient creates a client with httpmock enabled.
+func setupMockClient(t *testing.T) *BundleIDs {
+	mockAuth := &MockAuthProvider{}
+
+	coreClient, err := client.NewTransport(
+		"test-key-id",
+		"test-issuer-id",
+		"dummy-key",
+		client.WithAuth(mockAuth),
+		client.WithLogger(zap.NewNop()),
+		client.WithRetryCount(0),
+	)
+	require.NoError(t, err)
+
+	httpmock.ActivateNonDefault(coreClient.GetHTTPClient().Client())
+
+	t.Cleanup(func() {
+		httpmock.DeactivateAndReset()
+	})
+
+	return NewService(coreClient)
+}
+
+// MockAuthProvider implements the AuthProvider interface for testing.
+type MockAuthProvider struct{}
+
+func (m *MockAuthProvider) ApplyAuth(req *resty.Request) error {
+	return nil
+}
+
+func TestGetBundleIDs_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.BundleIDsMock{}
+	mockHandler.RegisterMocks()
+
+	result, resp, err := svc.GetV1(context.Background(), &RequestQueryOptions{
+		FilterIdentifier: []string{"com.example.app", "com.example.mac"},
+		FilterPlatform:   []string{PlatformIOS, PlatformMacOS},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	require.Len(t, result.Data, 2)
+	assert.Equal(t, "com.example.app", result.Data[0].Attributes.Identifier)
+	assert.Equal(t, PlatformMacOS, result.Data[1].Attributes.Platform)
+
+	query := resp.Request.RawRequest.URL.Query()
+	assert.Equal(t, "com.example.app,com.example.mac", query.Get("filter[identifier]"))
+	assert.Equal(t, "IOS,MAC_OS", query.Get("filter[platform]"))
+}
+
+func TestGetBundleIDByID_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.BundleIDsMock{}
+	mockHandler.RegisterMocks()
+
+	result, resp, err := svc.GetByBundleIDIDV1(context.Background(), "BNDL000001", nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	assert.Equal(t, "ABCDE12345", result.Data.Attributes.SeedID)
+}
+
+func TestCreateBundleID_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.BundleIDsMock{}
+	mockHandler.RegisterMocks()
+
+	var received map[string]any
+	httpmock.RegisterResponder("POST", "https://api.appstoreconnect.apple.com/v1/bundleIds",
+		mockhttp.CaptureBody(t, &received, mockhttp.JSONResponder(201, `{"data":{"type":"bundleIds","id":"BNDL000003","attributes":{"identifier":"com.example.widget"}}}`)))
+
+	result, resp, err := svc.CreateV1(context.Background(), &CreateBundleIDAttributes{
+		Identifier: "com.example.widget",
+		Name:       "Example Widget",
+		Platform:   PlatformIOS,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 201, resp.StatusCode())
+	assert.Equal(t, "BNDL000003", result.Data.ID)
+
+	data := received["data"].(map[string]any)
+	assert.Equal(t, TypeBundleIDs, data["type"])
+	attributes := data["attributes"].(map[string]any)
+	assert.Equal(t, "com.example.widget", attributes["identifier"])
+	assert.NotContains(t, attributes, "seedId")
+}
+
+func TestCreateBundleID_Validation(t *testing.T) {
+	svc := setupMockClient(t)
+
+	_, _, err := svc.CreateV1(context.Background(), nil)
+	assert.Error(t, err)
+
+	_, _, err = svc.CreateV1(context.Background(), &CreateBundleIDAttributes{Name: "App", Platform: PlatformIOS})
+	assert.ErrorContains(t, err, "bundle identifier is required")
+
+	_, _, err = svc.CreateV1(context.Background(), &CreateBundleIDAttributes{Identifier: "com.example.app", Platform: PlatformIOS})
+	assert.ErrorContains(t, err, "name is required")
+
+	_, _, err = svc.CreateV1(context.Background(), &CreateBundleIDAttributes{Identifier: "com.example.app", Name: "App"})
+	assert.ErrorContains(t, err, "platform is required")
+}
+
+func TestUpdateBundleID_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.BundleIDsMock{}
+	mockHandler.RegisterMocks()
+
+	var received map[string]any
+	httpmock.RegisterResponder("PATCH", "https://api.appstoreconnect.apple.com/v1/bundleIds/BNDL000001",
+		mockhttp.CaptureBody(t, &received, mockhttp.JSONResponder(200, `{"data":{"type":"bundleIds","id":"BNDL000001","attributes":{"name":"Renamed"}}}`)))
+
+	result, _, err := svc.UpdateByBundleIDIDV1(context.Background(), "BNDL000001", "Renamed")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Renamed", result.Data.Attributes.Name)
+	data := received["data"].(map[string]any)
+	assert.Equal(t, "BNDL000001", data["id"])
+	assert.Equal(t, map[string]any{"name": "Renamed"}, data["attributes"])
+
+	_, _, err = svc.UpdateByBundleIDIDV1(context.Background(), "", "Renamed")
+	assert.ErrorContains(t, err, "bundle ID is required")
+}
+
+func TestDeleteBundleID_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.BundleIDsMock{}
+	mockHandler.RegisterMocks()
+
+	resp, err := svc.DeleteByBundleIDIDV1(context.Background(), "BNDL000001")
+
+	require.NoError(t, err)
+	assert.Equal(t, 204, resp.StatusCode())
+}
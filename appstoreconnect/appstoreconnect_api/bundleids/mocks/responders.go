@@ -0,0 +1,58 @@
+package mocks
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func init() {
+	httpmock.RegisterNoResponder(httpmock.NewStringResponder(404, `{"errors":[{"status":"404","code":"NOT_FOUND","title":"The specified resource does not exist","detail":"The requested resource was not found"}]}`))
+}
+
+// loadMockResponse loads JSON response from the mocks folder.
+func loadMockResponse(filename string) ([]byte, error) {
+	mockPath := filepath.Join("mocks", filename)
+	return os.ReadFile(mockPath)
+}
+
+// jsonFileResponder returns a responder that serves the given mock file.
+func jsonFileResponder(filename string) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		mockData, err := loadMockResponse(filename)
+		if err != nil {
+			return httpmock.NewStringResponse(500, `{"errors":[{"status":"500","code":"INTERNAL_ERROR","title":"Internal Server Error","detail":"Failed to load mock data"}]}`), nil
+		}
+
+		var responseObj map[string]any
+		if err := json.Unmarshal(mockData, &responseObj); err != nil {
+			return httpmock.NewStringResponse(500, `{"errors":[{"status":"500","code":"INTERNAL_ERROR","title":"Internal Server Error","detail":"Failed to parse mock data"}]}`), nil
+		}
+
+		return httpmock.NewJsonResponse(200, responseObj)
+	}
+}
+
+// BundleIDsMock provides httpmock responders for bundle ID endpoints.
+type BundleIDsMock struct{}
+
+// RegisterMocks registers all HTTP mock responders for bundle IDs.
+func (m *BundleIDsMock) RegisterMocks() {
+	// GET /v1/bundleIds — list bundle IDs
+	httpmock.RegisterResponder("GET", "https://api.appstoreconnect.apple.com/v1/bundleIds", jsonFileResponder("validate_get_bundle_ids.json"))
+
+	// GET /v1/bundleIds/{id} — get bundle ID by ID
+	httpmock.RegisterResponder("GET", `=~^https://api\.appstoreconnect\.apple\.com/v1/bundleIds/[^/]+$`, jsonFileResponder("validate_bundle_id.json"))
+
+	// POST /v1/bundleIds — register bundle ID
+	httpmock.RegisterResponder("POST", "https://api.appstoreconnect.apple.com/v1/bundleIds", jsonFileResponder("validate_bundle_id.json"))
+
+	// PATCH /v1/bundleIds/{id} — rename bundle ID
+	httpmock.RegisterResponder("PATCH", `=~^https://api\.appstoreconnect\.apple\.com/v1/bundleIds/[^/]+$`, jsonFileResponder("validate_bundle_id.json"))
+
+	// DELETE /v1/bundleIds/{id} — delete bundle ID
+	httpmock.RegisterResponder("DELETE", `=~^https://api\.appstoreconnect\.apple\.com/v1/bundleIds/[^/]+$`, httpmock.NewStringResponder(204, ""))
+}
@@ -0,0 +1,106 @@
+package bundleids
+
+// Shared types for pagination and links
+type Meta struct {
+	Paging *Paging `json:"paging,omitempty"`
+}
+
+type Paging struct {
+	Total int `json:"total,omitempty"`
+	Limit int `json:"limit,omitempty"`
+}
+
+type Links struct {
+	Self  string `json:"self,omitempty"`
+	First string `json:"first,omitempty"`
+	Next  string `json:"next,omitempty"`
+}
+
+// ResourceLinks contains the self link of a resource
+type ResourceLinks struct {
+	Self string `json:"self,omitempty"`
+}
+
+// BundleID represents a registered bundle identifier
+type BundleID struct {
+	ID         string              `json:"id"`
+	Type       string              `json:"type"`
+	Attributes *BundleIDAttributes `json:"attributes,omitempty"`
+	Links      *ResourceLinks      `json:"links,omitempty"`
+}
+
+// BundleIDAttributes contains the bundle ID attributes
+type BundleIDAttributes struct {
+	Name       string `json:"name,omitempty"`
+	Platform   string `json:"platform,omitempty"`
+	Identifier string `json:"identifier,omitempty"`
+	SeedID     string `json:"seedId,omitempty"`
+}
+
+// BundleIDResponse represents the response for a single bundle ID
+type BundleIDResponse struct {
+	Data  BundleID       `json:"data"`
+	Links *ResourceLinks `json:"links,omitempty"`
+}
+
+// BundleIDsResponse represents the response for listing bundle IDs
+type BundleIDsResponse struct {
+	Data  []BundleID `json:"data"`
+	Meta  *Meta      `json:"meta,omitempty"`
+	Links *Links     `json:"links,omitempty"`
+}
+
+// CreateBundleIDRequest is the request body for registering a bundle ID
+type CreateBundleIDRequest struct {
+	Data CreateBundleIDData `json:"data"`
+}
+
+// CreateBundleIDData is the data object of a bundle ID create request
+type CreateBundleIDData struct {
+	Type       string                   `json:"type"`
+	Attributes CreateBundleIDAttributes `json:"attributes"`
+}
+
+// CreateBundleIDAttributes are the attributes accepted when registering a bundle ID
+type CreateBundleIDAttributes struct {
+	Identifier string `json:"identifier"`
+	Name       string `json:"name"`
+	Platform   string `json:"platform"`
+	SeedID     string `json:"seedId,omitempty"`
+}
+
+// UpdateBundleIDRequest is the request body for modifying a bundle ID
+type UpdateBundleIDRequest struct {
+	Data UpdateBundleIDData `json:"data"`
+}
+
+// UpdateBundleIDData is the data object of a bundle ID update request
+type UpdateBundleIDData struct {
+	Type       string                   `json:"type"`
+	ID         string                   `json:"id"`
+	Attributes UpdateBundleIDAttributes `json:"attributes"`
+}
+
+// UpdateBundleIDAttributes are the attributes that can be modified on a bundle ID
+type UpdateBundleIDAttributes struct {
+	Name string `json:"name"`
+}
+
+// RequestQueryOptions represents the query parameters for bundle ID requests
+type RequestQueryOptions struct {
+	// Field selection - fields to return for bundle IDs
+	Fields []string `json:"fields,omitempty"`
+
+	// Filters
+	FilterID         []string `json:"filter_id,omitempty"`
+	FilterIdentifier []string `json:"filter_identifier,omitempty"`
+	FilterName       []string `json:"filter_name,omitempty"`
+	FilterPlatform   []string `json:"filter_platform,omitempty"`
+	FilterSeedID     []string `json:"filter_seed_id,omitempty"`
+
+	// Sort order, e.g. SortIdentifier or "-" + SortName
+	Sort []string `json:"sort,omitempty"`
+
+	// Limit the number of included results per page (maximum 200)
+	Limit int `json:"limit,omitempty"`
+}
@@ -0,0 +1,47 @@
+package certificates
+
+// Certificate field constants for field selection
+const (
+	FieldName               = "name"
+	FieldCertificateType    = "certificateType"
+	FieldDisplayName        = "displayName"
+	FieldSerialNumber       = "serialNumber"
+	FieldPlatform           = "platform"
+	FieldExpirationDate     = "expirationDate"
+	FieldCertificateContent = "certificateContent"
+	FieldActivated          = "activated"
+)
+
+// Certificate type constants
+const (
+	CertificateTypeDevelopment              = "DEVELOPMENT"
+	CertificateTypeDistribution             = "DISTRIBUTION"
+	CertificateTypeIOSDevelopment           = "IOS_DEVELOPMENT"
+	CertificateTypeIOSDistribution          = "IOS_DISTRIBUTION"
+	CertificateTypeMacAppDevelopment        = "MAC_APP_DEVELOPMENT"
+	CertificateTypeMacAppDistribution       = "MAC_APP_DISTRIBUTION"
+	CertificateTypeMacInstallerDistribution = "MAC_INSTALLER_DISTRIBUTION"
+	CertificateTypeDeveloperIDApplication   = "DEVELOPER_ID_APPLICATION"
+	CertificateTypeDeveloperIDApplicationG2 = "DEVELOPER_ID_APPLICATION_G2"
+	CertificateTypeDeveloperIDKext          = "DEVELOPER_ID_KEXT"
+	CertificateTypeDeveloperIDKextG2        = "DEVELOPER_ID_KEXT_G2"
+	CertificateTypeDeveloperIDInstaller     = "DEVELOPER_ID_INSTALLER"
+	CertificateTypePassTypeID               = "PASS_TYPE_ID"
+	CertificateTypePassTypeIDWithNFC        = "PASS_TYPE_ID_WITH_NFC"
+)
+
+// Sort constants for listing certificates. Prefix with "-" for descending order.
+const (
+	SortCertificateType = "certificateType"
+	SortDisplayName     = "displayName"
+	SortSerialNumber    = "serialNumber"
+	SortID              = "id"
+)
+
+// Resource type constants used in JSON:API request bodies.
+const (
+	TypeCertificates = "certificates"
+)
+
+// MaxLimit is the maximum page size accepted by the certificate endpoints.
+const MaxLimit = 200
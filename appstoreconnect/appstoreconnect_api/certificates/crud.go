@@ -0,0 +1,213 @@
+package certificates
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/constants"
+	"resty.dev/v3"
+)
+
+// Certificates handles communication with the signing certificate
+// related methods of the App Store Connect API.
+//
+// App Store Connect API docs: https://developer.apple.com/documentation/appstoreconnectapi/certificates
+type (
+	Certificates struct {
+		client client.Client
+	}
+)
+
+// NewService creates a new certificates service.
+func NewService(c client.Client) *Certificates {
+	return &Certificates{client: c}
+}
+
+// GetV1 retrieves the team's signing certificates, following all pages.
+// URL: GET https://api.appstoreconnect.apple.com/v1/certificates
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-certificates
+func (s *Certificates) GetV1(ctx context.Context, opts *RequestQueryOptions) (*CertificatesResponse, *resty.Response, error) {
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	params := s.client.QueryBuilder()
+
+	if len(opts.Fields) > 0 {
+		params.AddStringSlice("fields[certificates]", opts.Fields)
+	}
+	if len(opts.FilterID) > 0 {
+		params.AddStringSlice("filter[id]", opts.FilterID)
+	}
+	if len(opts.FilterCertificateType) > 0 {
+		params.AddStringSlice("filter[certificateType]", opts.FilterCertificateType)
+	}
+	if len(opts.FilterDisplayName) > 0 {
+		params.AddStringSlice("filter[displayName]", opts.FilterDisplayName)
+	}
+	if len(opts.FilterSerialNumber) > 0 {
+		params.AddStringSlice("filter[serialNumber]", opts.FilterSerialNumber)
+	}
+	if len(opts.Sort) > 0 {
+		params.AddStringSlice("sort", opts.Sort)
+	}
+	if opts.Limit > 0 {
+		if opts.Limit > MaxLimit {
+			opts.Limit = MaxLimit // Enforce API maximum
+		}
+		params.AddInt("limit", opts.Limit)
+	}
+
+	var allCertificates []Certificate
+	var lastMeta *Meta
+	var lastLinks *Links
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParams(params.Build()).
+		GetPaginated(constants.EndpointCertificates, func(pageData []byte) error {
+			var pageResponse CertificatesResponse
+			if err := json.Unmarshal(pageData, &pageResponse); err != nil {
+				return fmt.Errorf("failed to unmarshal page: %w", err)
+			}
+			allCertificates = append(allCertificates, pageResponse.Data...)
+			lastMeta = pageResponse.Meta
+			lastLinks = pageResponse.Links
+			return nil
+		})
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &CertificatesResponse{
+		Data:  allCertificates,
+		Meta:  lastMeta,
+		Links: lastLinks,
+	}, resp, nil
+}
+
+// GetByCertificateIDV1 retrieves a specific signing certificate.
+// URL: GET https://api.appstoreconnect.apple.com/v1/certificates/{id}
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-certificates-_id_
+func (s *Certificates) GetByCertificateIDV1(ctx context.Context, certificateID string, opts *RequestQueryOptions) (*CertificateResponse, *resty.Response, error) {
+	if certificateID == "" {
+		return nil, nil, fmt.Errorf("certificate ID is required")
+	}
+
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	params := s.client.QueryBuilder()
+
+	if len(opts.Fields) > 0 {
+		params.AddStringSlice("fields[certificates]", opts.Fields)
+	}
+
+	endpoint := constants.EndpointCertificates + "/" + certificateID
+
+	var result CertificateResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParams(params.Build()).
+		SetResult(&result).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// CreateV1 issues a new signing certificate from a certificate signing request.
+// csrContent may be PEM encoded or the bare base64 body; PEM armour is stripped
+// before the request is sent.
+// URL: POST https://api.appstoreconnect.apple.com/v1/certificates
+// https://developer.apple.com/documentation/appstoreconnectapi/post-v1-certificates
+func (s *Certificates) CreateV1(ctx context.Context, certificateType, csrContent string) (*CertificateResponse, *resty.Response, error) {
+	if certificateType == "" {
+		return nil, nil, fmt.Errorf("certificate type is required")
+	}
+
+	csr, err := normalizeCSR(csrContent)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request := &CreateCertificateRequest{
+		Data: CreateCertificateData{
+			Type: TypeCertificates,
+			Attributes: CreateCertificateAttributes{
+				CSRContent:      csr,
+				CertificateType: certificateType,
+			},
+		},
+	}
+
+	var result CertificateResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetBody(request).
+		SetResult(&result).
+		Post(constants.EndpointCertificates)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// RevokeByCertificateIDV1 revokes a signing certificate. Provisioning profiles
+// that include the certificate become invalid.
+// URL: DELETE https://api.appstoreconnect.apple.com/v1/certificates/{id}
+// https://developer.apple.com/documentation/appstoreconnectapi/delete-v1-certificates-_id_
+//
+// Returns 204 No Content on success.
+func (s *Certificates) RevokeByCertificateIDV1(ctx context.Context, certificateID string) (*resty.Response, error) {
+	if certificateID == "" {
+		return nil, fmt.Errorf("certificate ID is required")
+	}
+
+	endpoint := constants.EndpointCertificates + "/" + certificateID
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		Delete(endpoint)
+
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// normalizeCSR returns the base64 body of a certificate signing request,
+// accepting either PEM or bare base64 input.
+func normalizeCSR(csrContent string) (string, error) {
+	csrContent = strings.TrimSpace(csrContent)
+	if csrContent == "" {
+		return "", fmt.Errorf("CSR content is required")
+	}
+
+	if !strings.HasPrefix(csrContent, "-----BEGIN") {
+		return csrContent, nil
+	}
+
+	block, _ := pem.Decode([]byte(csrContent))
+	if block == nil || !strings.Contains(block.Type, "CERTIFICATE REQUEST") {
+		return "", fmt.Errorf("CSR content is not a PEM encoded certificate request")
+	}
+
+	return base64.StdEncoding.EncodeToString(block.Bytes), nil
+}
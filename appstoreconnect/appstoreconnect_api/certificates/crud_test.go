@@ -0,0 +1,181 @@
+package certificates
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/appstoreconnect_api/certificates/mocks"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/mockhttp"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+// setupMockClient creates a client with httpmock enabled.
+func setupMockClient(t *testing.T) *Certificates {
+	mockAuth := &MockAuthProvider{}
+
+	coreClient, err := client.NewTransport(
+		"test-key-id",
+		"test-issuer-id",
+		"dummy-key",
+		client.WithAuth(mockAuth),
+		client.WithLogger(zap.NewNop()),
+		client.WithRetryCount(0),
+	)
+	require.NoError(t, err)
+
+	httpmock.ActivateNonDefault(coreClient.GetHTTPClient().Client())
+
+	t.Cleanup(func() {
+		httpmock.DeactivateAndReset()
+	})
+
+	return NewService(coreClient)
+}
+
+// MockAuthProvider implements the AuthProvider interface for testing.
+type MockAuthProvider struct{}
+
+func (m *MockAuthProvider) ApplyAuth(req *resty.Request) error {
+	return nil
+}
+
+func TestGetCertificates_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.CertificatesMock{}
+	mockHandler.RegisterMocks()
+
+	result, resp, err := svc.GetV1(context.Background(), &RequestQueryOptions{
+		FilterCertificateType: []string{CertificateTypeDistribution, CertificateTypeDevelopment},
+		Sort:                  []string{"-" + SortSerialNumber},
+		Limit:                 500,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	require.Len(t, result.Data, 2)
+	assert.Equal(t, CertificateTypeDistribution, result.Data[0].Attributes.CertificateType)
+	assert.Equal(t, "7F6E5D4C3B2A1908", result.Data[1].Attributes.SerialNumber)
+	require.NotNil(t, result.Data[0].Attributes.ExpirationDate)
+	assert.Equal(t, 2027, result.Data[0].Attributes.ExpirationDate.Year())
+
+	query := resp.Request.RawRequest.URL.Query()
+	assert.Equal(t, "DISTRIBUTION,DEVELOPMENT", query.Get("filter[certificateType]"))
+	assert.Equal(t, "-serialNumber", query.Get("sort"))
+	assert.Equal(t, "200", query.Get("limit"))
+}
+
+func TestGetCertificateByID_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.CertificatesMock{}
+	mockHandler.RegisterMocks()
+
+	result, resp, err := svc.GetByCertificateIDV1(context.Background(), "CERT000001", nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	assert.Equal(t, "CERT000001", result.Data.ID)
+	assert.Equal(t, "Example Corp", result.Data.Attributes.DisplayName)
+
+	_, _, err = svc.GetByCertificateIDV1(context.Background(), "", nil)
+	assert.ErrorContains(t, err, "certificate ID is required")
+}
+
+func TestCreateCertificate_StripsPEM(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.CertificatesMock{}
+	mockHandler.RegisterMocks()
+
+	csrDER := generateCSR(t)
+
+	var received map[string]any
+	httpmock.RegisterResponder("POST", "https://api.appstoreconnect.apple.com/v1/certificates",
+		mockhttp.CaptureBody(t, &received, mockhttp.JSONResponder(201, `{"data":{"type":"certificates","id":"CERT000003","attributes":{"certificateType":"DISTRIBUTION"}}}`)))
+
+	csrPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER}))
+	result, resp, err := svc.CreateV1(context.Background(), CertificateTypeDistribution, csrPEM)
+
+	require.NoError(t, err)
+	assert.Equal(t, 201, resp.StatusCode())
+	assert.Equal(t, "CERT000003", result.Data.ID)
+
+	data := received["data"].(map[string]any)
+	assert.Equal(t, TypeCertificates, data["type"])
+	attributes := data["attributes"].(map[string]any)
+	assert.Equal(t, CertificateTypeDistribution, attributes["certificateType"])
+	assert.Equal(t, base64.StdEncoding.EncodeToString(csrDER), attributes["csrContent"])
+}
+
+func TestCreateCertificate_Validation(t *testing.T) {
+	svc := setupMockClient(t)
+
+	_, _, err := svc.CreateV1(context.Background(), "", "MIIB")
+	assert.ErrorContains(t, err, "certificate type is required")
+
+	_, _, err = svc.CreateV1(context.Background(), CertificateTypeDevelopment, "  ")
+	assert.ErrorContains(t, err, "CSR content is required")
+
+	_, _, err = svc.CreateV1(context.Background(), CertificateTypeDevelopment, "-----BEGIN PUBLIC KEY-----\nMIIB\n-----END PUBLIC KEY-----")
+	assert.ErrorContains(t, err, "not a PEM encoded certificate request")
+}
+
+func TestRevokeCertificate_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.CertificatesMock{}
+	mockHandler.RegisterMocks()
+
+	resp, err := svc.RevokeByCertificateIDV1(context.Background(), "CERT000001")
+
+	require.NoError(t, err)
+	assert.Equal(t, 204, resp.StatusCode())
+
+	_, err = svc.RevokeByCertificateIDV1(context.Background(), "")
+	assert.ErrorContains(t, err, "certificate ID is required")
+}
+
+func TestCertificateX509(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(0x3A1B),
+		Subject:      pkix.Name{CommonName: "Apple Distribution: Example Corp"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert := &Certificate{Attributes: &CertificateAttributes{CertificateContent: base64.StdEncoding.EncodeToString(der)}}
+	parsed, err := cert.X509()
+	require.NoError(t, err)
+	assert.Equal(t, "Apple Distribution: Example Corp", parsed.Subject.CommonName)
+
+	_, err = (&Certificate{}).X509()
+	assert.ErrorContains(t, err, "certificate content is empty")
+}
+
+// generateCSR returns a DER encoded certificate signing request.
+func generateCSR(t *testing.T) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: pkix.Name{CommonName: "Example Corp"},
+	}, key)
+	require.NoError(t, err)
+	return csr
+}
@@ -0,0 +1,55 @@
+package mocks
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func init() {
+	httpmock.RegisterNoResponder(httpmock.NewStringResponder(404, `{"errors":[{"status":"404","code":"NOT_FOUND","title":"The specified resource does not exist","detail":"The requested resource was not found"}]}`))
+}
+
+// loadMockResponse loads JSON response from the mocks folder.
+func loadMockResponse(filename string) ([]byte, error) {
+	mockPath := filepath.Join("mocks", filename)
+	return os.ReadFile(mockPath)
+}
+
+// jsonFileResponder returns a responder that serves the given mock file.
+func jsonFileResponder(filename string) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		mockData, err := loadMockResponse(filename)
+		if err != nil {
+			return httpmock.NewStringResponse(500, `{"errors":[{"status":"500","code":"INTERNAL_ERROR","title":"Internal Server Error","detail":"Failed to load mock data"}]}`), nil
+		}
+
+		var responseObj map[string]any
+		if err := json.Unmarshal(mockData, &responseObj); err != nil {
+			return httpmock.NewStringResponse(500, `{"errors":[{"status":"500","code":"INTERNAL_ERROR","title":"Internal Server Error","detail":"Failed to parse mock data"}]}`), nil
+		}
+
+		return httpmock.NewJsonResponse(200, responseObj)
+	}
+}
+
+// CertificatesMock provides httpmock responders for certificate endpoints.
+type CertificatesMock struct{}
+
+// RegisterMocks registers all HTTP mock responders for certificates.
+func (m *CertificatesMock) RegisterMocks() {
+	// GET /v1/certificates — list certificates
+	httpmock.RegisterResponder("GET", "https://api.appstoreconnect.apple.com/v1/certificates", jsonFileResponder("validate_get_certificates.json"))
+
+	// GET /v1/certificates/{id} — get certificate by ID
+	httpmock.RegisterResponder("GET", `=~^https://api\.appstoreconnect\.apple\.com/v1/certificates/[^/]+$`, jsonFileResponder("validate_certificate.json"))
+
+	// POST /v1/certificates — create certificate
+	httpmock.RegisterResponder("POST", "https://api.appstoreconnect.apple.com/v1/certificates", jsonFileResponder("validate_certificate.json"))
+
+	// DELETE /v1/certificates/{id} — revoke certificate
+	httpmock.RegisterResponder("DELETE", `=~^https://api\.appstoreconnect\.apple\.com/v1/certificates/[^/]+$`, httpmock.NewStringResponder(204, ""))
+}
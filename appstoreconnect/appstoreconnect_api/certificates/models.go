@@ -0,0 +1,109 @@
+package certificates
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"time"
+)
+
+// Shared types for pagination and links
+type Meta struct {
+	Paging *Paging `json:"paging,omitempty"`
+}
+
+type Paging struct {
+	Total int `json:"total,omitempty"`
+	Limit int `json:"limit,omitempty"`
+}
+
+type Links struct {
+	Self  string `json:"self,omitempty"`
+	First string `json:"first,omitempty"`
+	Next  string `json:"next,omitempty"`
+}
+
+// ResourceLinks contains the self link of a resource
+type ResourceLinks struct {
+	Self string `json:"self,omitempty"`
+}
+
+// Certificate represents a signing certificate
+type Certificate struct {
+	ID         string                 `json:"id"`
+	Type       string                 `json:"type"`
+	Attributes *CertificateAttributes `json:"attributes,omitempty"`
+	Links      *ResourceLinks         `json:"links,omitempty"`
+}
+
+// CertificateAttributes contains the certificate attributes
+type CertificateAttributes struct {
+	Name               string     `json:"name,omitempty"`
+	CertificateType    string     `json:"certificateType,omitempty"`
+	DisplayName        string     `json:"displayName,omitempty"`
+	SerialNumber       string     `json:"serialNumber,omitempty"`
+	Platform           string     `json:"platform,omitempty"`
+	ExpirationDate     *time.Time `json:"expirationDate,omitempty"`
+	CertificateContent string     `json:"certificateContent,omitempty"`
+	Activated          *bool      `json:"activated,omitempty"`
+}
+
+// X509 decodes the base64 DER certificate content into an x509 certificate.
+func (c *Certificate) X509() (*x509.Certificate, error) {
+	if c.Attributes == nil || c.Attributes.CertificateContent == "" {
+		return nil, fmt.Errorf("certificate content is empty; request the %s field", FieldCertificateContent)
+	}
+	der, err := base64.StdEncoding.DecodeString(c.Attributes.CertificateContent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode certificate content: %w", err)
+	}
+	return x509.ParseCertificate(der)
+}
+
+// CertificateResponse represents the response for a single certificate
+type CertificateResponse struct {
+	Data  Certificate    `json:"data"`
+	Links *ResourceLinks `json:"links,omitempty"`
+}
+
+// CertificatesResponse represents the response for listing certificates
+type CertificatesResponse struct {
+	Data  []Certificate `json:"data"`
+	Meta  *Meta         `json:"meta,omitempty"`
+	Links *Links        `json:"links,omitempty"`
+}
+
+// CreateCertificateRequest is the request body for creating a certificate
+type CreateCertificateRequest struct {
+	Data CreateCertificateData `json:"data"`
+}
+
+// CreateCertificateData is the data object of a certificate create request
+type CreateCertificateData struct {
+	Type       string                      `json:"type"`
+	Attributes CreateCertificateAttributes `json:"attributes"`
+}
+
+// CreateCertificateAttributes are the attributes accepted when creating a certificate
+type CreateCertificateAttributes struct {
+	CSRContent      string `json:"csrContent"`
+	CertificateType string `json:"certificateType"`
+}
+
+// RequestQueryOptions represents the query parameters for certificate requests
+type RequestQueryOptions struct {
+	// Field selection - fields to return for certificates
+	Fields []string `json:"fields,omitempty"`
+
+	// Filters
+	FilterID              []string `json:"filter_id,omitempty"`
+	FilterCertificateType []string `json:"filter_certificate_type,omitempty"`
+	FilterDisplayName     []string `json:"filter_display_name,omitempty"`
+	FilterSerialNumber    []string `json:"filter_serial_number,omitempty"`
+
+	// Sort order, e.g. SortDisplayName or "-" + SortSerialNumber
+	Sort []string `json:"sort,omitempty"`
+
+	// Limit the number of included results per page (maximum 200)
+	Limit int `json:"limit,omitempty"`
+}
@@ -0,0 +1,53 @@
+package devices
+
+// Device field constants for field selection
+const (
+	FieldName        = "name"
+	FieldPlatform    = "platform"
+	FieldUDID        = "udid"
+	FieldDeviceClass = "deviceClass"
+	FieldStatus      = "status"
+	FieldModel       = "model"
+	FieldAddedDate   = "addedDate"
+)
+
+// Device platform constants
+const (
+	PlatformIOS       = "IOS"
+	PlatformMacOS     = "MAC_OS"
+	PlatformUniversal = "UNIVERSAL"
+)
+
+// Device status constants
+const (
+	StatusEnabled  = "ENABLED"
+	StatusDisabled = "DISABLED"
+)
+
+// Device class constants
+const (
+	DeviceClassAppleWatch     = "APPLE_WATCH"
+	DeviceClassIPad           = "IPAD"
+	DeviceClassIPhone         = "IPHONE"
+	DeviceClassIPod           = "IPOD"
+	DeviceClassAppleTV        = "APPLE_TV"
+	DeviceClassMac            = "MAC"
+	DeviceClassAppleVisionPro = "APPLE_VISION_PRO"
+)
+
+// Sort constants for listing devices. Prefix with "-" for descending order.
+const (
+	SortName     = "name"
+	SortPlatform = "platform"
+	SortUDID     = "udid"
+	SortStatus   = "status"
+	SortID       = "id"
+)
+
+// Resource type constants used in JSON:API request bodies.
+const (
+	TypeDevices = "devices"
+)
+
+// MaxLimit is the maximum page size accepted by the device endpoints.
+const MaxLimit = 200
@@ -0,0 +1,209 @@
+package devices
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/constants"
+	"resty.dev/v3"
+)
+
+// Devices handles communication with the registered device
+// related methods of the App Store Connect API.
+//
+// App Store Connect API docs: https://developer.apple.com/documentation/appstoreconnectapi/devices
+type (
+	Devices struct {
+		client client.Client
+	}
+)
+
+// NewService creates a new devices service.
+func NewService(c client.Client) *Devices {
+	return &Devices{client: c}
+}
+
+// GetV1 retrieves the devices registered to the team, following all pages.
+// URL: GET https://api.appstoreconnect.apple.com/v1/devices
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-devices
+func (s *Devices) GetV1(ctx context.Context, opts *RequestQueryOptions) (*DevicesResponse, *resty.Response, error) {
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	params := s.client.QueryBuilder()
+
+	if len(opts.Fields) > 0 {
+		params.AddStringSlice("fields[devices]", opts.Fields)
+	}
+	if len(opts.FilterID) > 0 {
+		params.AddStringSlice("filter[id]", opts.FilterID)
+	}
+	if len(opts.FilterName) > 0 {
+		params.AddStringSlice("filter[name]", opts.FilterName)
+	}
+	if len(opts.FilterPlatform) > 0 {
+		params.AddStringSlice("filter[platform]", opts.FilterPlatform)
+	}
+	if len(opts.FilterStatus) > 0 {
+		params.AddStringSlice("filter[status]", opts.FilterStatus)
+	}
+	if len(opts.FilterUDID) > 0 {
+		params.AddStringSlice("filter[udid]", opts.FilterUDID)
+	}
+	if len(opts.Sort) > 0 {
+		params.AddStringSlice("sort", opts.Sort)
+	}
+	if opts.Limit > 0 {
+		if opts.Limit > MaxLimit {
+			opts.Limit = MaxLimit // Enforce API maximum
+		}
+		params.AddInt("limit", opts.Limit)
+	}
+
+	var allDevices []Device
+	var lastMeta *Meta
+	var lastLinks *Links
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParams(params.Build()).
+		GetPaginated(constants.EndpointDevices, func(pageData []byte) error {
+			var pageResponse DevicesResponse
+			if err := json.Unmarshal(pageData, &pageResponse); err != nil {
+				return fmt.Errorf("failed to unmarshal page: %w", err)
+			}
+			allDevices = append(allDevices, pageResponse.Data...)
+			lastMeta = pageResponse.Meta
+			lastLinks = pageResponse.Links
+			return nil
+		})
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &DevicesResponse{
+		Data:  allDevices,
+		Meta:  lastMeta,
+		Links: lastLinks,
+	}, resp, nil
+}
+
+// GetByDeviceIDV1 retrieves a specific registered device.
+// URL: GET https://api.appstoreconnect.apple.com/v1/devices/{id}
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-devices-_id_
+func (s *Devices) GetByDeviceIDV1(ctx context.Context, deviceID string, opts *RequestQueryOptions) (*DeviceResponse, *resty.Response, error) {
+	if deviceID == "" {
+		return nil, nil, fmt.Errorf("device ID is required")
+	}
+
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	params := s.client.QueryBuilder()
+
+	if len(opts.Fields) > 0 {
+		params.AddStringSlice("fields[devices]", opts.Fields)
+	}
+
+	endpoint := constants.EndpointDevices + "/" + deviceID
+
+	var result DeviceResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParams(params.Build()).
+		SetResult(&result).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// CreateV1 registers a new device for development and ad hoc distribution.
+// URL: POST https://api.appstoreconnect.apple.com/v1/devices
+// https://developer.apple.com/documentation/appstoreconnectapi/post-v1-devices
+func (s *Devices) CreateV1(ctx context.Context, attributes *CreateDeviceAttributes) (*DeviceResponse, *resty.Response, error) {
+	if attributes == nil {
+		return nil, nil, fmt.Errorf("attributes are required")
+	}
+	if attributes.Name == "" {
+		return nil, nil, fmt.Errorf("device name is required")
+	}
+	if attributes.Platform == "" {
+		return nil, nil, fmt.Errorf("platform is required")
+	}
+	if attributes.UDID == "" {
+		return nil, nil, fmt.Errorf("device UDID is required")
+	}
+
+	request := &CreateDeviceRequest{
+		Data: CreateDeviceData{
+			Type:       TypeDevices,
+			Attributes: *attributes,
+		},
+	}
+
+	var result DeviceResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetBody(request).
+		SetResult(&result).
+		Post(constants.EndpointDevices)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// UpdateByDeviceIDV1 renames, enables or disables a registered device. Devices
+// cannot be deleted; disable them instead.
+// URL: PATCH https://api.appstoreconnect.apple.com/v1/devices/{id}
+// https://developer.apple.com/documentation/appstoreconnectapi/patch-v1-devices-_id_
+func (s *Devices) UpdateByDeviceIDV1(ctx context.Context, deviceID string, attributes *UpdateDeviceAttributes) (*DeviceResponse, *resty.Response, error) {
+	if deviceID == "" {
+		return nil, nil, fmt.Errorf("device ID is required")
+	}
+	if attributes == nil || (attributes.Name == "" && attributes.Status == "") {
+		return nil, nil, fmt.Errorf("at least one attribute is required")
+	}
+	if attributes.Status != "" && attributes.Status != StatusEnabled && attributes.Status != StatusDisabled {
+		return nil, nil, fmt.Errorf("status must be %s or %s", StatusEnabled, StatusDisabled)
+	}
+
+	request := &UpdateDeviceRequest{
+		Data: UpdateDeviceData{
+			Type:       TypeDevices,
+			ID:         deviceID,
+			Attributes: *attributes,
+		},
+	}
+
+	endpoint := constants.EndpointDevices + "/" + deviceID
+
+	var result DeviceResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetBody(request).
+		SetResult(&result).
+		Patch(endpoint)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
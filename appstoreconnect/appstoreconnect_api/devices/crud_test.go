@@ -0,0 +1,153 @@
+package devices
+
+import (
+	"context"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/appstoreconnect_api/devices/mocks"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/mockhttp"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+// setupMockClient creates a client with httpmock enabled.
+func setupMockClient(t *testing.T) *Devices {
+	mockAuth := &MockAuthProvider{}
+
+	coreClient, err := client.NewTransport(
+		"test-key-id",
+		"test-issuer-id",
+		"dummy-key",
+		client.WithAuth(mockAuth),
+		client.WithLogger(zap.NewNop()),
+		client.WithRetryCount(0),
+	)
+	require.NoError(t, err)
+
+	httpmock.ActivateNonDefault(coreClient.GetHTTPClient().Client())
+
+	t.Cleanup(func() {
+		httpmock.DeactivateAndReset()
+	})
+
+	return NewService(coreClient)
+}
+
+// MockAuthProvider implements the AuthProvider interface for testing.
+type MockAuthProvider struct{}
+
+func (m *MockAuthProvider) ApplyAuth(req *resty.Request) error {
+	return nil
+}
+
+func TestGetDevices_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.DevicesMock{}
+	mockHandler.RegisterMocks()
+
+	result, resp, err := svc.GetV1(context.Background(), &RequestQueryOptions{
+		FilterStatus: []string{StatusEnabled},
+		Fields:       []string{FieldName, FieldUDID, FieldStatus},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	require.Len(t, result.Data, 2)
+	assert.Equal(t, DeviceClassIPhone, result.Data[0].Attributes.DeviceClass)
+	assert.Equal(t, StatusDisabled, result.Data[1].Attributes.Status)
+
+	query := resp.Request.RawRequest.URL.Query()
+	assert.Equal(t, "ENABLED", query.Get("filter[status]"))
+	assert.Equal(t, "name,udid,status", query.Get("fields[devices]"))
+}
+
+func TestGetDeviceByID_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.DevicesMock{}
+	mockHandler.RegisterMocks()
+
+	result, resp, err := svc.GetByDeviceIDV1(context.Background(), "DEV0000001", nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	assert.Equal(t, "00008120-001A2B3C4D5E6F70", result.Data.Attributes.UDID)
+	require.NotNil(t, result.Data.Attributes.AddedDate)
+
+	_, _, err = svc.GetByDeviceIDV1(context.Background(), "", nil)
+	assert.ErrorContains(t, err, "device ID is required")
+}
+
+func TestCreateDevice_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.DevicesMock{}
+	mockHandler.RegisterMocks()
+
+	var received map[string]any
+	httpmock.RegisterResponder("POST", "https://api.appstoreconnect.apple.com/v1/devices",
+		mockhttp.CaptureBody(t, &received, mockhttp.JSONResponder(201, `{"data":{"type":"devices","id":"DEV0000003","attributes":{"name":"QA iPad"}}}`)))
+
+	result, resp, err := svc.CreateV1(context.Background(), &CreateDeviceAttributes{
+		Name:     "QA iPad",
+		Platform: PlatformIOS,
+		UDID:     "00008103-000A1B2C3D4E5F60",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 201, resp.StatusCode())
+	assert.Equal(t, "DEV0000003", result.Data.ID)
+
+	data := received["data"].(map[string]any)
+	assert.Equal(t, TypeDevices, data["type"])
+	assert.Equal(t, map[string]any{"name": "QA iPad", "platform": "IOS", "udid": "00008103-000A1B2C3D4E5F60"}, data["attributes"])
+}
+
+func TestCreateDevice_Validation(t *testing.T) {
+	svc := setupMockClient(t)
+
+	_, _, err := svc.CreateV1(context.Background(), nil)
+	assert.Error(t, err)
+
+	_, _, err = svc.CreateV1(context.Background(), &CreateDeviceAttributes{Platform: PlatformIOS, UDID: "abc"})
+	assert.ErrorContains(t, err, "device name is required")
+
+	_, _, err = svc.CreateV1(context.Background(), &CreateDeviceAttributes{Name: "QA", UDID: "abc"})
+	assert.ErrorContains(t, err, "platform is required")
+
+	_, _, err = svc.CreateV1(context.Background(), &CreateDeviceAttributes{Name: "QA", Platform: PlatformIOS})
+	assert.ErrorContains(t, err, "UDID is required")
+}
+
+func TestUpdateDevice_Disable(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.DevicesMock{}
+	mockHandler.RegisterMocks()
+
+	var received map[string]any
+	httpmock.RegisterResponder("PATCH", "https://api.appstoreconnect.apple.com/v1/devices/DEV0000001",
+		mockhttp.CaptureBody(t, &received, mockhttp.JSONResponder(200, `{"data":{"type":"devices","id":"DEV0000001","attributes":{"status":"DISABLED"}}}`)))
+
+	result, _, err := svc.UpdateByDeviceIDV1(context.Background(), "DEV0000001", &UpdateDeviceAttributes{Status: StatusDisabled})
+
+	require.NoError(t, err)
+	assert.Equal(t, StatusDisabled, result.Data.Attributes.Status)
+	data := received["data"].(map[string]any)
+	assert.Equal(t, "DEV0000001", data["id"])
+	assert.Equal(t, map[string]any{"status": "DISABLED"}, data["attributes"])
+}
+
+func TestUpdateDevice_Validation(t *testing.T) {
+	svc := setupMockClient(t)
+
+	_, _, err := svc.UpdateByDeviceIDV1(context.Background(), "", &UpdateDeviceAttributes{Name: "QA"})
+	assert.ErrorContains(t, err, "device ID is required")
+
+	_, _, err = svc.UpdateByDeviceIDV1(context.Background(), "DEV0000001", &UpdateDeviceAttributes{})
+	assert.ErrorContains(t, err, "at least one attribute is required")
+
+	_, _, err = svc.UpdateByDeviceIDV1(context.Background(), "DEV0000001", &UpdateDeviceAttributes{Status: "PROCESSING"})
+	assert.ErrorContains(t, err, "status must be")
+}
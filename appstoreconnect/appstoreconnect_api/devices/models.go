@@ -0,0 +1,112 @@
+package devices
+
+import "time"
+
+// Shared types for pagination and links
+type Meta struct {
+	Paging *Paging `json:"paging,omitempty"`
+}
+
+type Paging struct {
+	Total int `json:"total,omitempty"`
+	Limit int `json:"limit,omitempty"`
+}
+
+type Links struct {
+	Self  string `json:"self,omitempty"`
+	First string `json:"first,omitempty"`
+	Next  string `json:"next,omitempty"`
+}
+
+// ResourceLinks contains the self link of a resource
+type ResourceLinks struct {
+	Self string `json:"self,omitempty"`
+}
+
+// Device represents a device registered for development and ad hoc distribution
+type Device struct {
+	ID         string            `json:"id"`
+	Type       string            `json:"type"`
+	Attributes *DeviceAttributes `json:"attributes,omitempty"`
+	Links      *ResourceLinks    `json:"links,omitempty"`
+}
+
+// DeviceAttributes contains the device attributes
+type DeviceAttributes struct {
+	Name        string     `json:"name,omitempty"`
+	Platform    string     `json:"platform,omitempty"`
+	UDID        string     `json:"udid,omitempty"`
+	DeviceClass string     `json:"deviceClass,omitempty"`
+	Status      string     `json:"status,omitempty"`
+	Model       string     `json:"model,omitempty"`
+	AddedDate   *time.Time `json:"addedDate,omitempty"`
+}
+
+// DeviceResponse represents the response for a single device
+type DeviceResponse struct {
+	Data  Device         `json:"data"`
+	Links *ResourceLinks `json:"links,omitempty"`
+}
+
+// DevicesResponse represents the response for listing devices
+type DevicesResponse struct {
+	Data  []Device `json:"data"`
+	Meta  *Meta    `json:"meta,omitempty"`
+	Links *Links   `json:"links,omitempty"`
+}
+
+// CreateDeviceRequest is the request body for registering a device
+type CreateDeviceRequest struct {
+	Data CreateDeviceData `json:"data"`
+}
+
+// CreateDeviceData is the data object of a device create request
+type CreateDeviceData struct {
+	Type       string                 `json:"type"`
+	Attributes CreateDeviceAttributes `json:"attributes"`
+}
+
+// CreateDeviceAttributes are the attributes accepted when registering a device
+type CreateDeviceAttributes struct {
+	Name     string `json:"name"`
+	Platform string `json:"platform"`
+	UDID     string `json:"udid"`
+}
+
+// UpdateDeviceRequest is the request body for modifying a device
+type UpdateDeviceRequest struct {
+	Data UpdateDeviceData `json:"data"`
+}
+
+// UpdateDeviceData is the data object of a device update request
+type UpdateDeviceData struct {
+	Type       string                 `json:"type"`
+	ID         string                 `json:"id"`
+	Attributes UpdateDeviceAttributes `json:"attributes"`
+}
+
+// UpdateDeviceAttributes are the attributes that can be modified on a device.
+// Only non-empty attributes are sent.
+type UpdateDeviceAttributes struct {
+	Name   string `json:"name,omitempty"`
+	Status string `json:"status,omitempty"`
+}
+
+// RequestQueryOptions represents the query parameters for device requests
+type RequestQueryOptions struct {
+	// Field selection - fields to return for devices
+	Fields []string `json:"fields,omitempty"`
+
+	// Filters
+	FilterID       []string `json:"filter_id,omitempty"`
+	FilterName     []string `json:"filter_name,omitempty"`
+	FilterPlatform []string `json:"filter_platform,omitempty"`
+	FilterStatus   []string `json:"filter_status,omitempty"`
+	FilterUDID     []string `json:"filter_udid,omitempty"`
+
+	// Sort order, e.g. SortName or "-" + SortStatus
+	Sort []string `json:"sort,omitempty"`
+
+	// Limit the number of included results per page (maximum 200)
+	Limit int `json:"limit,omitempty"`
+}
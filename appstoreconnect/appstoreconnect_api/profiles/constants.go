@@ -0,0 +1,56 @@
+package profiles
+
+// Profile field constants for field selection
+const (
+	FieldName           = "name"
+	FieldPlatform       = "platform"
+	FieldProfileType    = "profileType"
+	FieldProfileState   = "profileState"
+	FieldProfileContent = "profileContent"
+	FieldUUID           = "uuid"
+	FieldCreatedDate    = "createdDate"
+	FieldExpirationDate = "expirationDate"
+)
+
+// Profile type constants
+const (
+	ProfileTypeIOSAppDevelopment         = "IOS_APP_DEVELOPMENT"
+	ProfileTypeIOSAppStore               = "IOS_APP_STORE"
+	ProfileTypeIOSAppAdhoc               = "IOS_APP_ADHOC"
+	ProfileTypeIOSAppInHouse             = "IOS_APP_INHOUSE"
+	ProfileTypeMacAppDevelopment         = "MAC_APP_DEVELOPMENT"
+	ProfileTypeMacAppStore               = "MAC_APP_STORE"
+	ProfileTypeMacAppDirect              = "MAC_APP_DIRECT"
+	ProfileTypeTVOSAppDevelopment        = "TVOS_APP_DEVELOPMENT"
+	ProfileTypeTVOSAppStore              = "TVOS_APP_STORE"
+	ProfileTypeTVOSAppAdhoc              = "TVOS_APP_ADHOC"
+	ProfileTypeTVOSAppInHouse            = "TVOS_APP_INHOUSE"
+	ProfileTypeMacCatalystAppDevelopment = "MAC_CATALYST_APP_DEVELOPMENT"
+	ProfileTypeMacCatalystAppStore       = "MAC_CATALYST_APP_STORE"
+	ProfileTypeMacCatalystAppDirect      = "MAC_CATALYST_APP_DIRECT"
+)
+
+// Profile state constants
+const (
+	ProfileStateActive  = "ACTIVE"
+	ProfileStateInvalid = "INVALID"
+)
+
+// Sort constants for listing profiles. Prefix with "-" for descending order.
+const (
+	SortName         = "name"
+	SortProfileType  = "profileType"
+	SortProfileState = "profileState"
+	SortID           = "id"
+)
+
+// Resource type constants used in JSON:API request bodies.
+const (
+	TypeProfiles     = "profiles"
+	TypeBundleIDs    = "bundleIds"
+	TypeCertificates = "certificates"
+	TypeDevices      = "devices"
+)
+
+// MaxLimit is the maximum page size accepted by the profile endpoints.
+const MaxLimit = 200
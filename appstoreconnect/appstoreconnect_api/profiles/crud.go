@@ -0,0 +1,221 @@
+package profiles
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/constants"
+	"resty.dev/v3"
+)
+
+// Profiles handles communication with the provisioning profile
+// related methods of the App Store Connect API.
+//
+// App Store Connect API docs: https://developer.apple.com/documentation/appstoreconnectapi/profiles
+type (
+	Profiles struct {
+		client client.Client
+	}
+)
+
+// NewService creates a new provisioning profiles service.
+func NewService(c client.Client) *Profiles {
+	return &Profiles{client: c}
+}
+
+// GetV1 retrieves the team's provisioning profiles, following all pages.
+// URL: GET https://api.appstoreconnect.apple.com/v1/profiles
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-profiles
+func (s *Profiles) GetV1(ctx context.Context, opts *RequestQueryOptions) (*ProfilesResponse, *resty.Response, error) {
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	params := s.client.QueryBuilder()
+
+	if len(opts.Fields) > 0 {
+		params.AddStringSlice("fields[profiles]", opts.Fields)
+	}
+	if len(opts.FilterID) > 0 {
+		params.AddStringSlice("filter[id]", opts.FilterID)
+	}
+	if len(opts.FilterName) > 0 {
+		params.AddStringSlice("filter[name]", opts.FilterName)
+	}
+	if len(opts.FilterProfileType) > 0 {
+		params.AddStringSlice("filter[profileType]", opts.FilterProfileType)
+	}
+	if len(opts.FilterProfileState) > 0 {
+		params.AddStringSlice("filter[profileState]", opts.FilterProfileState)
+	}
+	if len(opts.Sort) > 0 {
+		params.AddStringSlice("sort", opts.Sort)
+	}
+	if opts.Limit > 0 {
+		if opts.Limit > MaxLimit {
+			opts.Limit = MaxLimit // Enforce API maximum
+		}
+		params.AddInt("limit", opts.Limit)
+	}
+
+	var allProfiles []Profile
+	var lastMeta *Meta
+	var lastLinks *Links
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParams(params.Build()).
+		GetPaginated(constants.EndpointProfiles, func(pageData []byte) error {
+			var pageResponse ProfilesResponse
+			if err := json.Unmarshal(pageData, &pageResponse); err != nil {
+				return fmt.Errorf("failed to unmarshal page: %w", err)
+			}
+			allProfiles = append(allProfiles, pageResponse.Data...)
+			lastMeta = pageResponse.Meta
+			lastLinks = pageResponse.Links
+			return nil
+		})
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &ProfilesResponse{
+		Data:  allProfiles,
+		Meta:  lastMeta,
+		Links: lastLinks,
+	}, resp, nil
+}
+
+// GetByProfileIDV1 retrieves a specific provisioning profile. Request
+// FieldProfileContent to download the profile itself.
+// URL: GET https://api.appstoreconnect.apple.com/v1/profiles/{id}
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-profiles-_id_
+func (s *Profiles) GetByProfileIDV1(ctx context.Context, profileID string, opts *RequestQueryOptions) (*ProfileResponse, *resty.Response, error) {
+	if profileID == "" {
+		return nil, nil, fmt.Errorf("profile ID is required")
+	}
+
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	params := s.client.QueryBuilder()
+
+	if len(opts.Fields) > 0 {
+		params.AddStringSlice("fields[profiles]", opts.Fields)
+	}
+
+	endpoint := constants.EndpointProfiles + "/" + profileID
+
+	var result ProfileResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParams(params.Build()).
+		SetResult(&result).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// CreateV1 creates a provisioning profile. Use NewCreateProfileRequest to build
+// the request body; development and ad hoc profiles also need WithDevices.
+// URL: POST https://api.appstoreconnect.apple.com/v1/profiles
+// https://developer.apple.com/documentation/appstoreconnectapi/post-v1-profiles
+func (s *Profiles) CreateV1(ctx context.Context, req *CreateProfileRequest) (*ProfileResponse, *resty.Response, error) {
+	if req == nil {
+		return nil, nil, fmt.Errorf("request is required")
+	}
+	if req.Data.Attributes.Name == "" {
+		return nil, nil, fmt.Errorf("profile name is required")
+	}
+	if req.Data.Attributes.ProfileType == "" {
+		return nil, nil, fmt.Errorf("profile type is required")
+	}
+	if req.Data.Relationships.BundleID.Data.ID == "" {
+		return nil, nil, fmt.Errorf("bundle ID is required")
+	}
+	if len(req.Data.Relationships.Certificates.Data) == 0 {
+		return nil, nil, fmt.Errorf("at least one certificate ID is required")
+	}
+
+	var result ProfileResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetBody(req).
+		SetResult(&result).
+		Post(constants.EndpointProfiles)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// DeleteByProfileIDV1 deletes a provisioning profile.
+// URL: DELETE https://api.appstoreconnect.apple.com/v1/profiles/{id}
+// https://developer.apple.com/documentation/appstoreconnectapi/delete-v1-profiles-_id_
+//
+// Returns 204 No Content on success.
+func (s *Profiles) DeleteByProfileIDV1(ctx context.Context, profileID string) (*resty.Response, error) {
+	if profileID == "" {
+		return nil, fmt.Errorf("profile ID is required")
+	}
+
+	endpoint := constants.EndpointProfiles + "/" + profileID
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		Delete(endpoint)
+
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// NewCreateProfileRequest builds a request to create a provisioning profile of
+// profileType for the given bundle ID, signed by the given certificates.
+func NewCreateProfileRequest(name, profileType, bundleIDID string, certificateIDs ...string) *CreateProfileRequest {
+	return &CreateProfileRequest{
+		Data: CreateProfileData{
+			Type: TypeProfiles,
+			Attributes: CreateProfileAttributes{
+				Name:        name,
+				ProfileType: profileType,
+			},
+			Relationships: CreateProfileRelationships{
+				BundleID: ToOneRelationship{
+					Data: ResourceIdentifier{Type: TypeBundleIDs, ID: bundleIDID},
+				},
+				Certificates: *newToManyRelationship(TypeCertificates, certificateIDs),
+			},
+		},
+	}
+}
+
+// newToManyRelationship builds a to-many linkage of resourceType for ids.
+func newToManyRelationship(resourceType string, ids []string) *ToManyRelationship {
+	linkages := make([]ResourceIdentifier, len(ids))
+	for i, id := range ids {
+		linkages[i] = ResourceIdentifier{Type: resourceType, ID: id}
+	}
+	return &ToManyRelationship{Data: linkages}
+}
+
+// WithDevices includes the given registered devices in the new profile.
+func (r *CreateProfileRequest) WithDevices(deviceIDs ...string) *CreateProfileRequest {
+	r.Data.Relationships.Devices = newToManyRelationship(TypeDevices, deviceIDs)
+	return r
+}
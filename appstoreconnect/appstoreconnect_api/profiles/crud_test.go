@@ -0,0 +1,142 @@
+package profiles
+
+import (
+	"context"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/appstoreconnect_api/profiles/mocks"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/mockhttp"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+// setupMockClient creates a client with httpmock enabled.
+func setupMockClient(t *testing.T) *Profiles {
+	mockAuth := &MockAuthProvider{}
+
+	coreClient, err := client.NewTransport(
+		"test-key-id",
+		"test-issuer-id",
+		"dummy-key",
+		client.WithAuth(mockAuth),
+		client.WithLogger(zap.NewNop()),
+		client.WithRetryCount(0),
+	)
+	require.NoError(t, err)
+
+	httpmock.ActivateNonDefault(coreClient.GetHTTPClient().Client())
+
+	t.Cleanup(func() {
+		httpmock.DeactivateAndReset()
+	})
+
+	return NewService(coreClient)
+}
+
+// MockAuthProvider implements the AuthProvider interface for testing.
+type MockAuthProvider struct{}
+
+func (m *MockAuthProvider) ApplyAuth(req *resty.Request) error {
+	return nil
+}
+
+func TestGetProfiles_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.ProfilesMock{}
+	mockHandler.RegisterMocks()
+
+	result, resp, err := svc.GetV1(context.Background(), &RequestQueryOptions{
+		FilterProfileType: []string{ProfileTypeIOSAppStore, ProfileTypeIOSAppAdhoc},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	require.Len(t, result.Data, 2)
+	assert.True(t, result.Data[0].IsActive())
+	assert.False(t, result.Data[1].IsActive())
+	assert.Equal(t, "IOS_APP_STORE,IOS_APP_ADHOC", resp.Request.RawRequest.URL.Query().Get("filter[profileType]"))
+}
+
+func TestGetProfileByID_Content(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.ProfilesMock{}
+	mockHandler.RegisterMocks()
+
+	result, resp, err := svc.GetByProfileIDV1(context.Background(), "PROF000001", &RequestQueryOptions{
+		Fields: []string{FieldName, FieldProfileContent},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	assert.Equal(t, "name,profileContent", resp.Request.RawRequest.URL.Query().Get("fields[profiles]"))
+
+	content, err := result.Data.Content()
+	require.NoError(t, err)
+	assert.Equal(t, "example provisioning profile", string(content))
+
+	_, err = (&Profile{Attributes: &ProfileAttributes{}}).Content()
+	assert.ErrorContains(t, err, "profile content is empty")
+}
+
+func TestCreateProfile_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.ProfilesMock{}
+	mockHandler.RegisterMocks()
+
+	var received map[string]any
+	httpmock.RegisterResponder("POST", "https://api.appstoreconnect.apple.com/v1/profiles",
+		mockhttp.CaptureBody(t, &received, mockhttp.JSONResponder(201, `{"data":{"type":"profiles","id":"PROF000003","attributes":{"profileType":"IOS_APP_ADHOC"}}}`)))
+
+	req := NewCreateProfileRequest("QA Ad Hoc", ProfileTypeIOSAppAdhoc, "BNDL000001", "CERT000001").
+		WithDevices("DEV0000001", "DEV0000002")
+
+	result, resp, err := svc.CreateV1(context.Background(), req)
+
+	require.NoError(t, err)
+	assert.Equal(t, 201, resp.StatusCode())
+	assert.Equal(t, "PROF000003", result.Data.ID)
+
+	data := received["data"].(map[string]any)
+	assert.Equal(t, TypeProfiles, data["type"])
+	relationships := data["relationships"].(map[string]any)
+	assert.Equal(t, map[string]any{"data": map[string]any{"type": "bundleIds", "id": "BNDL000001"}}, relationships["bundleId"])
+	assert.Equal(t, map[string]any{"data": []any{map[string]any{"type": "certificates", "id": "CERT000001"}}}, relationships["certificates"])
+	assert.Len(t, relationships["devices"].(map[string]any)["data"], 2)
+}
+
+func TestCreateProfile_Validation(t *testing.T) {
+	svc := setupMockClient(t)
+
+	_, _, err := svc.CreateV1(context.Background(), nil)
+	assert.Error(t, err)
+
+	_, _, err = svc.CreateV1(context.Background(), NewCreateProfileRequest("", ProfileTypeIOSAppStore, "BNDL000001", "CERT000001"))
+	assert.ErrorContains(t, err, "profile name is required")
+
+	_, _, err = svc.CreateV1(context.Background(), NewCreateProfileRequest("Store", "", "BNDL000001", "CERT000001"))
+	assert.ErrorContains(t, err, "profile type is required")
+
+	_, _, err = svc.CreateV1(context.Background(), NewCreateProfileRequest("Store", ProfileTypeIOSAppStore, "", "CERT000001"))
+	assert.ErrorContains(t, err, "bundle ID is required")
+
+	_, _, err = svc.CreateV1(context.Background(), NewCreateProfileRequest("Store", ProfileTypeIOSAppStore, "BNDL000001"))
+	assert.ErrorContains(t, err, "at least one certificate ID is required")
+}
+
+func TestDeleteProfile_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.ProfilesMock{}
+	mockHandler.RegisterMocks()
+
+	resp, err := svc.DeleteByProfileIDV1(context.Background(), "PROF000001")
+
+	require.NoError(t, err)
+	assert.Equal(t, 204, resp.StatusCode())
+
+	_, err = svc.DeleteByProfileIDV1(context.Background(), "")
+	assert.ErrorContains(t, err, "profile ID is required")
+}
@@ -0,0 +1,138 @@
+package profiles
+
+import (
+	"encoding/base64"
+	"fmt"
+	"time"
+)
+
+// Shared types for pagination and links
+type Meta struct {
+	Paging *Paging `json:"paging,omitempty"`
+}
+
+type Paging struct {
+	Total int `json:"total,omitempty"`
+	Limit int `json:"limit,omitempty"`
+}
+
+type Links struct {
+	Self  string `json:"self,omitempty"`
+	First string `json:"first,omitempty"`
+	Next  string `json:"next,omitempty"`
+}
+
+// ResourceLinks contains the self link of a resource
+type ResourceLinks struct {
+	Self string `json:"self,omitempty"`
+}
+
+// ResourceIdentifier identifies a related resource by type and ID
+type ResourceIdentifier struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// Profile represents a provisioning profile
+type Profile struct {
+	ID         string             `json:"id"`
+	Type       string             `json:"type"`
+	Attributes *ProfileAttributes `json:"attributes,omitempty"`
+	Links      *ResourceLinks     `json:"links,omitempty"`
+}
+
+// ProfileAttributes contains the provisioning profile attributes
+type ProfileAttributes struct {
+	Name           string     `json:"name,omitempty"`
+	Platform       string     `json:"platform,omitempty"`
+	ProfileType    string     `json:"profileType,omitempty"`
+	ProfileState   string     `json:"profileState,omitempty"`
+	ProfileContent string     `json:"profileContent,omitempty"`
+	UUID           string     `json:"uuid,omitempty"`
+	CreatedDate    *time.Time `json:"createdDate,omitempty"`
+	ExpirationDate *time.Time `json:"expirationDate,omitempty"`
+}
+
+// IsActive reports whether the profile can currently be used for signing.
+func (p *Profile) IsActive() bool {
+	return p.Attributes != nil && p.Attributes.ProfileState == ProfileStateActive
+}
+
+// Content decodes the base64 profile content into the raw .mobileprovision
+// (or .provisionprofile) bytes, ready to be written to disk.
+func (p *Profile) Content() ([]byte, error) {
+	if p.Attributes == nil || p.Attributes.ProfileContent == "" {
+		return nil, fmt.Errorf("profile content is empty; request the %s field", FieldProfileContent)
+	}
+	content, err := base64.StdEncoding.DecodeString(p.Attributes.ProfileContent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode profile content: %w", err)
+	}
+	return content, nil
+}
+
+// ProfileResponse represents the response for a single provisioning profile
+type ProfileResponse struct {
+	Data  Profile        `json:"data"`
+	Links *ResourceLinks `json:"links,omitempty"`
+}
+
+// ProfilesResponse represents the response for listing provisioning profiles
+type ProfilesResponse struct {
+	Data  []Profile `json:"data"`
+	Meta  *Meta     `json:"meta,omitempty"`
+	Links *Links    `json:"links,omitempty"`
+}
+
+// CreateProfileRequest is the request body for creating a provisioning profile
+type CreateProfileRequest struct {
+	Data CreateProfileData `json:"data"`
+}
+
+// CreateProfileData is the data object of a provisioning profile create request
+type CreateProfileData struct {
+	Type          string                     `json:"type"`
+	Attributes    CreateProfileAttributes    `json:"attributes"`
+	Relationships CreateProfileRelationships `json:"relationships"`
+}
+
+// CreateProfileAttributes are the attributes accepted when creating a provisioning profile
+type CreateProfileAttributes struct {
+	Name        string `json:"name"`
+	ProfileType string `json:"profileType"`
+}
+
+// CreateProfileRelationships links the bundle ID, certificates and devices of a new profile
+type CreateProfileRelationships struct {
+	BundleID     ToOneRelationship   `json:"bundleId"`
+	Certificates ToManyRelationship  `json:"certificates"`
+	Devices      *ToManyRelationship `json:"devices,omitempty"`
+}
+
+// ToOneRelationship is a JSON:API to-one relationship linkage
+type ToOneRelationship struct {
+	Data ResourceIdentifier `json:"data"`
+}
+
+// ToManyRelationship is a JSON:API to-many relationship linkage
+type ToManyRelationship struct {
+	Data []ResourceIdentifier `json:"data"`
+}
+
+// RequestQueryOptions represents the query parameters for provisioning profile requests
+type RequestQueryOptions struct {
+	// Field selection - fields to return for profiles
+	Fields []string `json:"fields,omitempty"`
+
+	// Filters
+	FilterID           []string `json:"filter_id,omitempty"`
+	FilterName         []string `json:"filter_name,omitempty"`
+	FilterProfileType  []string `json:"filter_profile_type,omitempty"`
+	FilterProfileState []string `json:"filter_profile_state,omitempty"`
+
+	// Sort order, e.g. SortName or "-" + SortProfileType
+	Sort []string `json:"sort,omitempty"`
+
+	// Limit the number of included results per page (maximum 200)
+	Limit int `json:"limit,omitempty"`
+}
@@ -0,0 +1,45 @@
+package userinvitations
+
+// User invitation field constants for field selection
+const (
+	FieldEmail               = "email"
+	FieldFirstName           = "firstName"
+	FieldLastName            = "lastName"
+	FieldExpirationDate      = "expirationDate"
+	FieldRoles               = "roles"
+	FieldAllAppsVisible      = "allAppsVisible"
+	FieldProvisioningAllowed = "provisioningAllowed"
+	FieldVisibleApps         = "visibleApps"
+)
+
+// User role constants
+const (
+	RoleAdmin                       = "ADMIN"
+	RoleFinance                     = "FINANCE"
+	RoleAccountHolder               = "ACCOUNT_HOLDER"
+	RoleSales                       = "SALES"
+	RoleMarketing                   = "MARKETING"
+	RoleAppManager                  = "APP_MANAGER"
+	RoleDeveloper                   = "DEVELOPER"
+	RoleAccessToReports             = "ACCESS_TO_REPORTS"
+	RoleCustomerSupport             = "CUSTOMER_SUPPORT"
+	RoleCreateApps                  = "CREATE_APPS"
+	RoleCloudManagedDeveloperID     = "CLOUD_MANAGED_DEVELOPER_ID"
+	RoleCloudManagedAppDistribution = "CLOUD_MANAGED_APP_DISTRIBUTION"
+	RoleGenerateIndividualKeys      = "GENERATE_INDIVIDUAL_KEYS"
+)
+
+// Sort constants for listing user invitations. Prefix with "-" for descending order.
+const (
+	SortEmail    = "email"
+	SortLastName = "lastName"
+)
+
+// Resource type constants used in JSON:API request bodies.
+const (
+	TypeUserInvitations = "userInvitations"
+	TypeApps            = "apps"
+)
+
+// MaxLimit is the maximum page size accepted by the user invitation endpoints.
+const MaxLimit = 200
@@ -0,0 +1,228 @@
+package userinvitations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/mail"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/constants"
+	"resty.dev/v3"
+)
+
+// UserInvitations handles communication with the team invitation
+// related methods of the App Store Connect API.
+//
+// App Store Connect API docs: https://developer.apple.com/documentation/appstoreconnectapi/user-invitations
+type (
+	UserInvitations struct {
+		client client.Client
+	}
+)
+
+// NewService creates a new user invitations service.
+func NewService(c client.Client) *UserInvitations {
+	return &UserInvitations{client: c}
+}
+
+// GetV1 retrieves the pending invitations to the App Store Connect team, following all pages.
+// URL: GET https://api.appstoreconnect.apple.com/v1/userInvitations
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-userinvitations
+func (s *UserInvitations) GetV1(ctx context.Context, opts *RequestQueryOptions) (*UserInvitationsResponse, *resty.Response, error) {
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	params := s.client.QueryBuilder()
+
+	if len(opts.Fields) > 0 {
+		params.AddStringSlice("fields[userInvitations]", opts.Fields)
+	}
+	if len(opts.FilterEmail) > 0 {
+		params.AddStringSlice("filter[email]", opts.FilterEmail)
+	}
+	if len(opts.FilterRoles) > 0 {
+		params.AddStringSlice("filter[roles]", opts.FilterRoles)
+	}
+	if len(opts.FilterVisibleApps) > 0 {
+		params.AddStringSlice("filter[visibleApps]", opts.FilterVisibleApps)
+	}
+	if len(opts.Sort) > 0 {
+		params.AddStringSlice("sort", opts.Sort)
+	}
+	if opts.Limit > 0 {
+		if opts.Limit > MaxLimit {
+			opts.Limit = MaxLimit // Enforce API maximum
+		}
+		params.AddInt("limit", opts.Limit)
+	}
+
+	var allInvitations []UserInvitation
+	var lastMeta *Meta
+	var lastLinks *Links
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParams(params.Build()).
+		GetPaginated(constants.EndpointUserInvitations, func(pageData []byte) error {
+			var pageResponse UserInvitationsResponse
+			if err := json.Unmarshal(pageData, &pageResponse); err != nil {
+				return fmt.Errorf("failed to unmarshal page: %w", err)
+			}
+			allInvitations = append(allInvitations, pageResponse.Data...)
+			lastMeta = pageResponse.Meta
+			lastLinks = pageResponse.Links
+			return nil
+		})
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &UserInvitationsResponse{
+		Data:  allInvitations,
+		Meta:  lastMeta,
+		Links: lastLinks,
+	}, resp, nil
+}
+
+// GetByUserInvitationIDV1 retrieves a specific user invitation.
+// URL: GET https://api.appstoreconnect.apple.com/v1/userInvitations/{id}
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-userinvitations-_id_
+func (s *UserInvitations) GetByUserInvitationIDV1(ctx context.Context, userInvitationID string, opts *RequestQueryOptions) (*UserInvitationResponse, *resty.Response, error) {
+	if userInvitationID == "" {
+		return nil, nil, fmt.Errorf("user invitation ID is required")
+	}
+
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	params := s.client.QueryBuilder()
+
+	if len(opts.Fields) > 0 {
+		params.AddStringSlice("fields[userInvitations]", opts.Fields)
+	}
+
+	endpoint := constants.EndpointUserInvitations + "/" + userInvitationID
+
+	var result UserInvitationResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParams(params.Build()).
+		SetResult(&result).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// CreateV1 invites a user to the team. Use NewCreateUserInvitationRequest to
+// build the request body.
+// URL: POST https://api.appstoreconnect.apple.com/v1/userInvitations
+// https://developer.apple.com/documentation/appstoreconnectapi/post-v1-userinvitations
+func (s *UserInvitations) CreateV1(ctx context.Context, req *CreateUserInvitationRequest) (*UserInvitationResponse, *resty.Response, error) {
+	if req == nil {
+		return nil, nil, fmt.Errorf("request is required")
+	}
+	attributes := req.Data.Attributes
+	if attributes.Email == "" {
+		return nil, nil, fmt.Errorf("invitation email is required")
+	}
+	if _, err := mail.ParseAddress(attributes.Email); err != nil {
+		return nil, nil, fmt.Errorf("invalid invitation email %q: %w", attributes.Email, err)
+	}
+	if attributes.FirstName == "" || attributes.LastName == "" {
+		return nil, nil, fmt.Errorf("first and last name are required")
+	}
+	if len(attributes.Roles) == 0 {
+		return nil, nil, fmt.Errorf("at least one role is required")
+	}
+	if !attributes.AllAppsVisible && (req.Data.Relationships == nil || req.Data.Relationships.VisibleApps == nil || len(req.Data.Relationships.VisibleApps.Data) == 0) {
+		return nil, nil, fmt.Errorf("visible apps are required when all apps visible is false")
+	}
+
+	var result UserInvitationResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetBody(req).
+		SetResult(&result).
+		Post(constants.EndpointUserInvitations)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// DeleteByUserInvitationIDV1 cancels a pending user invitation.
+// URL: DELETE https://api.appstoreconnect.apple.com/v1/userInvitations/{id}
+// https://developer.apple.com/documentation/appstoreconnectapi/delete-v1-userinvitations-_id_
+//
+// Returns 204 No Content on success.
+func (s *UserInvitations) DeleteByUserInvitationIDV1(ctx context.Context, userInvitationID string) (*resty.Response, error) {
+	if userInvitationID == "" {
+		return nil, fmt.Errorf("user invitation ID is required")
+	}
+
+	endpoint := constants.EndpointUserInvitations + "/" + userInvitationID
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		Delete(endpoint)
+
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// NewCreateUserInvitationRequest builds an invitation for a user with the given
+// email, name and roles. The user can see all apps unless WithVisibleApps is used.
+func NewCreateUserInvitationRequest(email, firstName, lastName string, roles ...string) *CreateUserInvitationRequest {
+	return &CreateUserInvitationRequest{
+		Data: CreateUserInvitationData{
+			Type: TypeUserInvitations,
+			Attributes: CreateUserInvitationAttributes{
+				Email:          email,
+				FirstName:      firstName,
+				LastName:       lastName,
+				Roles:          roles,
+				AllAppsVisible: true,
+			},
+		},
+	}
+}
+
+// WithVisibleApps limits the invited user to the given apps.
+func (r *CreateUserInvitationRequest) WithVisibleApps(appIDs ...string) *CreateUserInvitationRequest {
+	r.Data.Attributes.AllAppsVisible = false
+	r.Data.Relationships = &CreateUserInvitationRelationships{
+		VisibleApps: newToManyRelationship(TypeApps, appIDs),
+	}
+	return r
+}
+
+// WithProvisioningAllowed lets the invited user create and manage signing assets.
+func (r *CreateUserInvitationRequest) WithProvisioningAllowed() *CreateUserInvitationRequest {
+	r.Data.Attributes.ProvisioningAllowed = true
+	return r
+}
+
+// newToManyRelationship builds a to-many linkage of resourceType for ids.
+func newToManyRelationship(resourceType string, ids []string) *ToManyRelationship {
+	linkages := make([]ResourceIdentifier, len(ids))
+	for i, id := range ids {
+		linkages[i] = ResourceIdentifier{Type: resourceType, ID: id}
+	}
+	return &ToManyRelationship{Data: linkages}
+}
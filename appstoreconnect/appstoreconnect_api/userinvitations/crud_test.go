@@ -0,0 +1,157 @@
+package userinvitations
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/appstoreconnect_api/userinvitations/mocks"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/mockhttp"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+// setupMockClient creates a client with httpmock enabled.
+func setupMockClient(t *testing.T) *UserInvitations {
+	mockAuth := &MockAuthProvider{}
+
+	coreClient, err := client.NewTransport(
+		"test-key-id",
+		"test-issuer-id",
+		"dummy-key",
+		client.WithAuth(mockAuth),
+		client.WithLogger(zap.NewNop()),
+		client.WithRetryCount(0),
+	)
+	require.NoError(t, err)
+
+	httpmock.ActivateNonDefault(coreClient.GetHTTPClient().Client())
+
+	t.Cleanup(func() {
+		httpmock.DeactivateAndReset()
+	})
+
+	return NewService(coreClient)
+}
+
+// MockAuthProvider implements the AuthProvider interface for testing.
+type MockAuthProvider struct{}
+
+func (m *MockAuthProvider) ApplyAuth(req *resty.Request) error {
+	return nil
+}
+
+func TestGetUserInvitations_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.UserInvitationsMock{}
+	mockHandler.RegisterMocks()
+
+	result, resp, err := svc.GetV1(context.Background(), &RequestQueryOptions{
+		FilterEmail: []string{"new.dev@example.com", "finance@example.com"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	require.Len(t, result.Data, 2)
+	assert.Equal(t, "new.dev@example.com,finance@example.com", resp.Request.RawRequest.URL.Query().Get("filter[email]"))
+
+	now := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+	assert.False(t, result.Data[0].IsExpired(now))
+	assert.True(t, result.Data[1].IsExpired(now))
+}
+
+func TestGetUserInvitationByID_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.UserInvitationsMock{}
+	mockHandler.RegisterMocks()
+
+	result, resp, err := svc.GetByUserInvitationIDV1(context.Background(), "INVITE0001", nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	assert.Equal(t, []string{RoleDeveloper}, result.Data.Attributes.Roles)
+
+	_, _, err = svc.GetByUserInvitationIDV1(context.Background(), "", nil)
+	assert.ErrorContains(t, err, "user invitation ID is required")
+}
+
+func TestCreateUserInvitation_VisibleApps(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.UserInvitationsMock{}
+	mockHandler.RegisterMocks()
+
+	var received map[string]any
+	httpmock.RegisterResponder("POST", "https://api.appstoreconnect.apple.com/v1/userInvitations",
+		mockhttp.CaptureBody(t, &received, mockhttp.JSONResponder(201, `{"data":{"type":"userInvitations","id":"INVITE0003","attributes":{"email":"new.dev@example.com"}}}`)))
+
+	req := NewCreateUserInvitationRequest("new.dev@example.com", "Alex", "Rivera", RoleDeveloper, RoleAppManager).
+		WithVisibleApps("1234567890").
+		WithProvisioningAllowed()
+
+	result, resp, err := svc.CreateV1(context.Background(), req)
+
+	require.NoError(t, err)
+	assert.Equal(t, 201, resp.StatusCode())
+	assert.Equal(t, "INVITE0003", result.Data.ID)
+
+	data := received["data"].(map[string]any)
+	assert.Equal(t, TypeUserInvitations, data["type"])
+	attributes := data["attributes"].(map[string]any)
+	assert.Equal(t, false, attributes["allAppsVisible"])
+	assert.Equal(t, true, attributes["provisioningAllowed"])
+	assert.Equal(t, []any{"DEVELOPER", "APP_MANAGER"}, attributes["roles"])
+	assert.Equal(t, map[string]any{"visibleApps": map[string]any{"data": []any{map[string]any{"type": "apps", "id": "1234567890"}}}}, data["relationships"])
+}
+
+func TestCreateUserInvitation_AllAppsVisibleOmitsRelationships(t *testing.T) {
+	svc := setupMockClient(t)
+
+	var received map[string]any
+	httpmock.RegisterResponder("POST", "https://api.appstoreconnect.apple.com/v1/userInvitations",
+		mockhttp.CaptureBody(t, &received, mockhttp.JSONResponder(201, `{"data":{"type":"userInvitations","id":"INVITE0004"}}`)))
+
+	_, _, err := svc.CreateV1(context.Background(), NewCreateUserInvitationRequest("finance@example.com", "Sam", "Lee", RoleFinance))
+
+	require.NoError(t, err)
+	data := received["data"].(map[string]any)
+	assert.Equal(t, true, data["attributes"].(map[string]any)["allAppsVisible"])
+	assert.NotContains(t, data, "relationships")
+}
+
+func TestCreateUserInvitation_Validation(t *testing.T) {
+	svc := setupMockClient(t)
+	ctx := context.Background()
+
+	_, _, err := svc.CreateV1(ctx, nil)
+	assert.Error(t, err)
+
+	_, _, err = svc.CreateV1(ctx, NewCreateUserInvitationRequest("", "Alex", "Rivera", RoleDeveloper))
+	assert.ErrorContains(t, err, "invitation email is required")
+
+	_, _, err = svc.CreateV1(ctx, NewCreateUserInvitationRequest("not-an-email", "Alex", "Rivera", RoleDeveloper))
+	assert.ErrorContains(t, err, "invalid invitation email")
+
+	_, _, err = svc.CreateV1(ctx, NewCreateUserInvitationRequest("new.dev@example.com", "", "Rivera", RoleDeveloper))
+	assert.ErrorContains(t, err, "first and last name are required")
+
+	_, _, err = svc.CreateV1(ctx, NewCreateUserInvitationRequest("new.dev@example.com", "Alex", "Rivera"))
+	assert.ErrorContains(t, err, "at least one role is required")
+
+	_, _, err = svc.CreateV1(ctx, NewCreateUserInvitationRequest("new.dev@example.com", "Alex", "Rivera", RoleDeveloper).WithVisibleApps())
+	assert.ErrorContains(t, err, "visible apps are required")
+}
+
+func TestDeleteUserInvitation_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.UserInvitationsMock{}
+	mockHandler.RegisterMocks()
+
+	resp, err := svc.DeleteByUserInvitationIDV1(context.Background(), "INVITE0001")
+
+	require.NoError(t, err)
+	assert.Equal(t, 204, resp.StatusCode())
+}
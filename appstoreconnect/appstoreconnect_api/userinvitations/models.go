@@ -0,0 +1,116 @@
+package userinvitations
+
+import "time"
+
+// Shared types for pagination and links
+type Meta struct {
+	Paging *Paging `json:"paging,omitempty"`
+}
+
+type Paging struct {
+	Total int `json:"total,omitempty"`
+	Limit int `json:"limit,omitempty"`
+}
+
+type Links struct {
+	Self  string `json:"self,omitempty"`
+	First string `json:"first,omitempty"`
+	Next  string `json:"next,omitempty"`
+}
+
+// ResourceLinks contains the self link of a resource
+type ResourceLinks struct {
+	Self string `json:"self,omitempty"`
+}
+
+// ResourceIdentifier identifies a related resource by type and ID
+type ResourceIdentifier struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// UserInvitation represents a pending invitation to join the App Store Connect team
+type UserInvitation struct {
+	ID         string                    `json:"id"`
+	Type       string                    `json:"type"`
+	Attributes *UserInvitationAttributes `json:"attributes,omitempty"`
+	Links      *ResourceLinks            `json:"links,omitempty"`
+}
+
+// UserInvitationAttributes contains the user invitation attributes
+type UserInvitationAttributes struct {
+	Email               string     `json:"email,omitempty"`
+	FirstName           string     `json:"firstName,omitempty"`
+	LastName            string     `json:"lastName,omitempty"`
+	ExpirationDate      *time.Time `json:"expirationDate,omitempty"`
+	Roles               []string   `json:"roles,omitempty"`
+	AllAppsVisible      bool       `json:"allAppsVisible,omitempty"`
+	ProvisioningAllowed bool       `json:"provisioningAllowed,omitempty"`
+}
+
+// IsExpired reports whether the invitation expired before now.
+func (i *UserInvitation) IsExpired(now time.Time) bool {
+	return i.Attributes != nil && i.Attributes.ExpirationDate != nil && i.Attributes.ExpirationDate.Before(now)
+}
+
+// UserInvitationResponse represents the response for a single user invitation
+type UserInvitationResponse struct {
+	Data  UserInvitation `json:"data"`
+	Links *ResourceLinks `json:"links,omitempty"`
+}
+
+// UserInvitationsResponse represents the response for listing user invitations
+type UserInvitationsResponse struct {
+	Data  []UserInvitation `json:"data"`
+	Meta  *Meta            `json:"meta,omitempty"`
+	Links *Links           `json:"links,omitempty"`
+}
+
+// CreateUserInvitationRequest is the request body for inviting a user
+type CreateUserInvitationRequest struct {
+	Data CreateUserInvitationData `json:"data"`
+}
+
+// CreateUserInvitationData is the data object of a user invitation create request
+type CreateUserInvitationData struct {
+	Type          string                             `json:"type"`
+	Attributes    CreateUserInvitationAttributes     `json:"attributes"`
+	Relationships *CreateUserInvitationRelationships `json:"relationships,omitempty"`
+}
+
+// CreateUserInvitationAttributes are the attributes accepted when inviting a user
+type CreateUserInvitationAttributes struct {
+	Email               string   `json:"email"`
+	FirstName           string   `json:"firstName"`
+	LastName            string   `json:"lastName"`
+	Roles               []string `json:"roles"`
+	AllAppsVisible      bool     `json:"allAppsVisible"`
+	ProvisioningAllowed bool     `json:"provisioningAllowed,omitempty"`
+}
+
+// CreateUserInvitationRelationships limits the invited user to specific apps
+type CreateUserInvitationRelationships struct {
+	VisibleApps *ToManyRelationship `json:"visibleApps,omitempty"`
+}
+
+// ToManyRelationship is a JSON:API to-many relationship linkage
+type ToManyRelationship struct {
+	Data []ResourceIdentifier `json:"data"`
+}
+
+// RequestQueryOptions represents the query parameters for user invitation requests
+type RequestQueryOptions struct {
+	// Field selection - fields to return for user invitations
+	Fields []string `json:"fields,omitempty"`
+
+	// Filters
+	FilterEmail       []string `json:"filter_email,omitempty"`
+	FilterRoles       []string `json:"filter_roles,omitempty"`
+	FilterVisibleApps []string `json:"filter_visible_apps,omitempty"`
+
+	// Sort order, e.g. SortEmail or "-" + SortLastName
+	Sort []string `json:"sort,omitempty"`
+
+	// Limit the number of included results per page (maximum 200)
+	Limit int `json:"limit,omitempty"`
+}
@@ -0,0 +1,44 @@
+package users
+
+// User field constants for field selection
+const (
+	FieldUsername            = "username"
+	FieldFirstName           = "firstName"
+	FieldLastName            = "lastName"
+	FieldRoles               = "roles"
+	FieldAllAppsVisible      = "allAppsVisible"
+	FieldProvisioningAllowed = "provisioningAllowed"
+	FieldVisibleApps         = "visibleApps"
+)
+
+// User role constants
+const (
+	RoleAdmin                       = "ADMIN"
+	RoleFinance                     = "FINANCE"
+	RoleAccountHolder               = "ACCOUNT_HOLDER"
+	RoleSales                       = "SALES"
+	RoleMarketing                   = "MARKETING"
+	RoleAppManager                  = "APP_MANAGER"
+	RoleDeveloper                   = "DEVELOPER"
+	RoleAccessToReports             = "ACCESS_TO_REPORTS"
+	RoleCustomerSupport             = "CUSTOMER_SUPPORT"
+	RoleCreateApps                  = "CREATE_APPS"
+	RoleCloudManagedDeveloperID     = "CLOUD_MANAGED_DEVELOPER_ID"
+	RoleCloudManagedAppDistribution = "CLOUD_MANAGED_APP_DISTRIBUTION"
+	RoleGenerateIndividualKeys      = "GENERATE_INDIVIDUAL_KEYS"
+)
+
+// Sort constants for listing users. Prefix with "-" for descending order.
+const (
+	SortUsername = "username"
+	SortLastName = "lastName"
+)
+
+// Resource type constants used in JSON:API request bodies.
+const (
+	TypeUsers = "users"
+	TypeApps  = "apps"
+)
+
+// MaxLimit is the maximum page size accepted by the user endpoints.
+const MaxLimit = 200
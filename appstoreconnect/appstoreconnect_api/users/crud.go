@@ -0,0 +1,291 @@
+package users
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/constants"
+	"resty.dev/v3"
+)
+
+// Users handles communication with the team user
+// related methods of the App Store Connect API.
+//
+// App Store Connect API docs: https://developer.apple.com/documentation/appstoreconnectapi/users
+type (
+	Users struct {
+		client client.Client
+	}
+)
+
+// NewService creates a new users service.
+func NewService(c client.Client) *Users {
+	return &Users{client: c}
+}
+
+// GetV1 retrieves the users on the App Store Connect team, following all pages.
+// URL: GET https://api.appstoreconnect.apple.com/v1/users
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-users
+func (s *Users) GetV1(ctx context.Context, opts *RequestQueryOptions) (*UsersResponse, *resty.Response, error) {
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	params := s.client.QueryBuilder()
+
+	if len(opts.Fields) > 0 {
+		params.AddStringSlice("fields[users]", opts.Fields)
+	}
+	if len(opts.FilterUsername) > 0 {
+		params.AddStringSlice("filter[username]", opts.FilterUsername)
+	}
+	if len(opts.FilterRoles) > 0 {
+		params.AddStringSlice("filter[roles]", opts.FilterRoles)
+	}
+	if len(opts.FilterVisibleApps) > 0 {
+		params.AddStringSlice("filter[visibleApps]", opts.FilterVisibleApps)
+	}
+	if len(opts.Sort) > 0 {
+		params.AddStringSlice("sort", opts.Sort)
+	}
+	if opts.Limit > 0 {
+		if opts.Limit > MaxLimit {
+			opts.Limit = MaxLimit // Enforce API maximum
+		}
+		params.AddInt("limit", opts.Limit)
+	}
+
+	var allUsers []User
+	var lastMeta *Meta
+	var lastLinks *Links
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParams(params.Build()).
+		GetPaginated(constants.EndpointUsers, func(pageData []byte) error {
+			var pageResponse UsersResponse
+			if err := json.Unmarshal(pageData, &pageResponse); err != nil {
+				return fmt.Errorf("failed to unmarshal page: %w", err)
+			}
+			allUsers = append(allUsers, pageResponse.Data...)
+			lastMeta = pageResponse.Meta
+			lastLinks = pageResponse.Links
+			return nil
+		})
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &UsersResponse{
+		Data:  allUsers,
+		Meta:  lastMeta,
+		Links: lastLinks,
+	}, resp, nil
+}
+
+// GetByUserIDV1 retrieves a specific user.
+// URL: GET https://api.appstoreconnect.apple.com/v1/users/{id}
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-users-_id_
+func (s *Users) GetByUserIDV1(ctx context.Context, userID string, opts *RequestQueryOptions) (*UserResponse, *resty.Response, error) {
+	if userID == "" {
+		return nil, nil, fmt.Errorf("user ID is required")
+	}
+
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	params := s.client.QueryBuilder()
+
+	if len(opts.Fields) > 0 {
+		params.AddStringSlice("fields[users]", opts.Fields)
+	}
+
+	endpoint := constants.EndpointUsers + "/" + userID
+
+	var result UserResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParams(params.Build()).
+		SetResult(&result).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// UpdateByUserIDV1 changes a user's roles, app visibility or provisioning
+// access. Only non-nil attributes are changed.
+// URL: PATCH https://api.appstoreconnect.apple.com/v1/users/{id}
+// https://developer.apple.com/documentation/appstoreconnectapi/patch-v1-users-_id_
+func (s *Users) UpdateByUserIDV1(ctx context.Context, userID string, attributes *UpdateUserAttributes) (*UserResponse, *resty.Response, error) {
+	if userID == "" {
+		return nil, nil, fmt.Errorf("user ID is required")
+	}
+	if attributes == nil {
+		return nil, nil, fmt.Errorf("attributes are required")
+	}
+	if attributes.Roles != nil && len(attributes.Roles) == 0 {
+		return nil, nil, fmt.Errorf("roles cannot be empty; delete the user to remove all access")
+	}
+
+	request := &UpdateUserRequest{
+		Data: UpdateUserData{
+			Type:       TypeUsers,
+			ID:         userID,
+			Attributes: *attributes,
+		},
+	}
+
+	endpoint := constants.EndpointUsers + "/" + userID
+
+	var result UserResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetBody(request).
+		SetResult(&result).
+		Patch(endpoint)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// DeleteByUserIDV1 removes a user from the team.
+// URL: DELETE https://api.appstoreconnect.apple.com/v1/users/{id}
+// https://developer.apple.com/documentation/appstoreconnectapi/delete-v1-users-_id_
+//
+// Returns 204 No Content on success.
+func (s *Users) DeleteByUserIDV1(ctx context.Context, userID string) (*resty.Response, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("user ID is required")
+	}
+
+	endpoint := constants.EndpointUsers + "/" + userID
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		Delete(endpoint)
+
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// GetVisibleAppIDsV1 retrieves the IDs of the apps a user can see, following
+// all pages. The list is empty for users with all apps visible.
+// URL: GET https://api.appstoreconnect.apple.com/v1/users/{id}/relationships/visibleApps
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-users-_id_-relationships-visibleapps
+func (s *Users) GetVisibleAppIDsV1(ctx context.Context, userID string, limit int) (*VisibleAppsLinkagesResponse, *resty.Response, error) {
+	if userID == "" {
+		return nil, nil, fmt.Errorf("user ID is required")
+	}
+
+	params := s.client.QueryBuilder()
+
+	if limit > 0 {
+		if limit > MaxLimit {
+			limit = MaxLimit // Enforce API maximum
+		}
+		params.AddInt("limit", limit)
+	}
+
+	endpoint := constants.EndpointUsers + "/" + userID + "/relationships/visibleApps"
+
+	var allApps []ResourceIdentifier
+	var lastMeta *Meta
+	var lastLinks *Links
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParams(params.Build()).
+		GetPaginated(endpoint, func(pageData []byte) error {
+			var pageResponse VisibleAppsLinkagesResponse
+			if err := json.Unmarshal(pageData, &pageResponse); err != nil {
+				return fmt.Errorf("failed to unmarshal page: %w", err)
+			}
+			allApps = append(allApps, pageResponse.Data...)
+			lastMeta = pageResponse.Meta
+			lastLinks = pageResponse.Links
+			return nil
+		})
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &VisibleAppsLinkagesResponse{
+		Data:  allApps,
+		Meta:  lastMeta,
+		Links: lastLinks,
+	}, resp, nil
+}
+
+// AddVisibleAppsV1 gives a user access to additional apps.
+// URL: POST https://api.appstoreconnect.apple.com/v1/users/{id}/relationships/visibleApps
+// https://developer.apple.com/documentation/appstoreconnectapi/post-v1-users-_id_-relationships-visibleapps
+func (s *Users) AddVisibleAppsV1(ctx context.Context, userID string, appIDs []string) (*resty.Response, error) {
+	return s.modifyVisibleApps(ctx, "POST", userID, appIDs)
+}
+
+// ReplaceVisibleAppsV1 replaces the full list of apps a user can see.
+// URL: PATCH https://api.appstoreconnect.apple.com/v1/users/{id}/relationships/visibleApps
+// https://developer.apple.com/documentation/appstoreconnectapi/patch-v1-users-_id_-relationships-visibleapps
+func (s *Users) ReplaceVisibleAppsV1(ctx context.Context, userID string, appIDs []string) (*resty.Response, error) {
+	return s.modifyVisibleApps(ctx, "PATCH", userID, appIDs)
+}
+
+// RemoveVisibleAppsV1 removes a user's access to apps.
+// URL: DELETE https://api.appstoreconnect.apple.com/v1/users/{id}/relationships/visibleApps
+// https://developer.apple.com/documentation/appstoreconnectapi/delete-v1-users-_id_-relationships-visibleapps
+func (s *Users) RemoveVisibleAppsV1(ctx context.Context, userID string, appIDs []string) (*resty.Response, error) {
+	return s.modifyVisibleApps(ctx, "DELETE", userID, appIDs)
+}
+
+// modifyVisibleApps adds (POST), replaces (PATCH) or removes (DELETE) the visible app linkages of a user.
+func (s *Users) modifyVisibleApps(ctx context.Context, method, userID string, appIDs []string) (*resty.Response, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("user ID is required")
+	}
+	if len(appIDs) == 0 {
+		return nil, fmt.Errorf("at least one app ID is required")
+	}
+
+	endpoint := constants.EndpointUsers + "/" + userID + "/relationships/visibleApps"
+
+	builder := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetBody(newToManyRelationship(TypeApps, appIDs))
+
+	switch method {
+	case "DELETE":
+		return builder.Delete(endpoint)
+	case "PATCH":
+		return builder.Patch(endpoint)
+	default:
+		return builder.Post(endpoint)
+	}
+}
+
+// newToManyRelationship builds a to-many linkage of resourceType for ids.
+func newToManyRelationship(resourceType string, ids []string) *ToManyRelationship {
+	linkages := make([]ResourceIdentifier, len(ids))
+	for i, id := range ids {
+		linkages[i] = ResourceIdentifier{Type: resourceType, ID: id}
+	}
+	return &ToManyRelationship{Data: linkages}
+}
@@ -0,0 +1,177 @@
+package users
+
+import (
+	"context"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/appstoreconnect_api/users/mocks"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreconnect/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/mockhttp"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+// setupMockClient creates a client with httpmock enabled.
+func setupMockClient(t *testing.T) *Users {
+	mockAuth := &MockAuthProvider{}
+
+	coreClient, err := client.NewTransport(
+		"test-key-id",
+		"test-issuer-id",
+		"dummy-key",
+		client.WithAuth(mockAuth),
+		client.WithLogger(zap.NewNop()),
+		client.WithRetryCount(0),
+	)
+	require.NoError(t, err)
+
+	httpmock.ActivateNonDefault(coreClient.GetHTTPClient().Client())
+
+	t.Cleanup(func() {
+		httpmock.DeactivateAndReset()
+	})
+
+	return NewService(coreClient)
+}
+
+// MockAuthProvider implements the AuthProvider interface for testing.
+type MockAuthProvider struct{}
+
+func (m *MockAuthProvider) ApplyAuth(req *resty.Request) error {
+	return nil
+}
+
+func TestGetUsers_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.UsersMock{}
+	mockHandler.RegisterMocks()
+
+	result, resp, err := svc.GetV1(context.Background(), &RequestQueryOptions{
+		FilterRoles: []string{RoleAdmin, RoleDeveloper},
+		Sort:        []string{SortLastName},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	require.Len(t, result.Data, 2)
+	assert.True(t, result.Data[0].HasRole(RoleAdmin))
+	assert.True(t, result.Data[1].HasRole(RoleCustomerSupport))
+	assert.False(t, result.Data[1].HasRole(RoleAdmin))
+
+	query := resp.Request.RawRequest.URL.Query()
+	assert.Equal(t, "ADMIN,DEVELOPER", query.Get("filter[roles]"))
+	assert.Equal(t, "lastName", query.Get("sort"))
+}
+
+func TestGetUserByID_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.UsersMock{}
+	mockHandler.RegisterMocks()
+
+	result, resp, err := svc.GetByUserIDV1(context.Background(), "USER000002", nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	assert.Equal(t, "john.doe@example.com", result.Data.Attributes.Username)
+
+	_, _, err = svc.GetByUserIDV1(context.Background(), "", nil)
+	assert.ErrorContains(t, err, "user ID is required")
+}
+
+func TestUpdateUser_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.UsersMock{}
+	mockHandler.RegisterMocks()
+
+	var received map[string]any
+	httpmock.RegisterResponder("PATCH", "https://api.appstoreconnect.apple.com/v1/users/USER000002",
+		mockhttp.CaptureBody(t, &received, mockhttp.JSONResponder(200, `{"data":{"type":"users","id":"USER000002","attributes":{"roles":["APP_MANAGER"],"allAppsVisible":true}}}`)))
+
+	allApps := true
+	result, _, err := svc.UpdateByUserIDV1(context.Background(), "USER000002", &UpdateUserAttributes{
+		Roles:          []string{RoleAppManager},
+		AllAppsVisible: &allApps,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, result.Data.HasRole(RoleAppManager))
+
+	data := received["data"].(map[string]any)
+	assert.Equal(t, TypeUsers, data["type"])
+	assert.Equal(t, map[string]any{"roles": []any{"APP_MANAGER"}, "allAppsVisible": true}, data["attributes"])
+}
+
+func TestUpdateUser_Validation(t *testing.T) {
+	svc := setupMockClient(t)
+
+	_, _, err := svc.UpdateByUserIDV1(context.Background(), "", &UpdateUserAttributes{})
+	assert.ErrorContains(t, err, "user ID is required")
+
+	_, _, err = svc.UpdateByUserIDV1(context.Background(), "USER000002", nil)
+	assert.ErrorContains(t, err, "attributes are required")
+
+	_, _, err = svc.UpdateByUserIDV1(context.Background(), "USER000002", &UpdateUserAttributes{Roles: []string{}})
+	assert.ErrorContains(t, err, "roles cannot be empty")
+}
+
+func TestDeleteUser_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.UsersMock{}
+	mockHandler.RegisterMocks()
+
+	resp, err := svc.DeleteByUserIDV1(context.Background(), "USER000002")
+
+	require.NoError(t, err)
+	assert.Equal(t, 204, resp.StatusCode())
+}
+
+func TestGetVisibleAppIDs_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.UsersMock{}
+	mockHandler.RegisterMocks()
+
+	result, resp, err := svc.GetVisibleAppIDsV1(context.Background(), "USER000002", 500)
+
+	require.NoError(t, err)
+	assert.Equal(t, "200", resp.Request.RawRequest.URL.Query().Get("limit"))
+	require.Len(t, result.Data, 2)
+	assert.Equal(t, ResourceIdentifier{Type: TypeApps, ID: "9876543210"}, result.Data[1])
+}
+
+func TestModifyVisibleApps_Methods(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.UsersMock{}
+	mockHandler.RegisterMocks()
+
+	ctx := context.Background()
+	endpoint := "https://api.appstoreconnect.apple.com/v1/users/USER000002/relationships/visibleApps"
+
+	for method, call := range map[string]func() (*resty.Response, error){
+		"POST": func() (*resty.Response, error) {
+			return svc.AddVisibleAppsV1(ctx, "USER000002", []string{"1234567890"})
+		},
+		"PATCH": func() (*resty.Response, error) {
+			return svc.ReplaceVisibleAppsV1(ctx, "USER000002", []string{"1234567890"})
+		},
+		"DELETE": func() (*resty.Response, error) {
+			return svc.RemoveVisibleAppsV1(ctx, "USER000002", []string{"1234567890"})
+		},
+	} {
+		var received ToManyRelationship
+		httpmock.RegisterResponder(method, endpoint, mockhttp.CaptureBody(t, &received, httpmock.NewStringResponder(204, "")))
+
+		resp, err := call()
+		require.NoError(t, err, method)
+		assert.Equal(t, 204, resp.StatusCode(), method)
+		assert.Equal(t, []ResourceIdentifier{{Type: TypeApps, ID: "1234567890"}}, received.Data, method)
+	}
+
+	_, err := svc.AddVisibleAppsV1(ctx, "", []string{"1234567890"})
+	assert.ErrorContains(t, err, "user ID is required")
+
+	_, err = svc.ReplaceVisibleAppsV1(ctx, "USER000002", nil)
+	assert.ErrorContains(t, err, "at least one app ID is required")
+}
@@ -0,0 +1,63 @@
+package mocks
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func init() {
+	httpmock.RegisterNoResponder(httpmock.NewStringResponder(404, `{"errors":[{"status":"404","code":"NOT_FOUND","title":"The specified resource does not exist","detail":"The requested resource was not found"}]}`))
+}
+
+// loadMockResponse loads JSON response from the mocks folder.
+func loadMockResponse(filename string) ([]byte, error) {
+	mockPath := filepath.Join("mocks", filename)
+	return os.ReadFile(mockPath)
+}
+
+// jsonFileResponder returns a responder that serves the given mock file.
+func jsonFileResponder(filename string) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		mockData, err := loadMockResponse(filename)
+		if err != nil {
+			return httpmock.NewStringResponse(500, `{"errors":[{"status":"500","code":"INTERNAL_ERROR","title":"Internal Server Error","detail":"Failed to load mock data"}]}`), nil
+		}
+
+		var responseObj map[string]any
+		if err := json.Unmarshal(mockData, &responseObj); err != nil {
+			return httpmock.NewStringResponse(500, `{"errors":[{"status":"500","code":"INTERNAL_ERROR","title":"Internal Server Error","detail":"Failed to parse mock data"}]}`), nil
+		}
+
+		return httpmock.NewJsonResponse(200, responseObj)
+	}
+}
+
+// UsersMock provides httpmock responders for user endpoints.
+type UsersMock struct{}
+
+// RegisterMocks registers all HTTP mock responders for users.
+func (m *UsersMock) RegisterMocks() {
+	// GET /v1/users — list users
+	httpmock.RegisterResponder("GET", "https://api.appstoreconnect.apple.com/v1/users", jsonFileResponder("validate_get_users.json"))
+
+	// GET /v1/users/{id} — get user by ID
+	httpmock.RegisterResponder("GET", `=~^https://api\.appstoreconnect\.apple\.com/v1/users/[^/]+$`, jsonFileResponder("validate_user.json"))
+
+	// PATCH /v1/users/{id} — modify user
+	httpmock.RegisterResponder("PATCH", `=~^https://api\.appstoreconnect\.apple\.com/v1/users/[^/]+$`, jsonFileResponder("validate_user.json"))
+
+	// DELETE /v1/users/{id} — remove user
+	httpmock.RegisterResponder("DELETE", `=~^https://api\.appstoreconnect\.apple\.com/v1/users/[^/]+$`, httpmock.NewStringResponder(204, ""))
+
+	// GET /v1/users/{id}/relationships/visibleApps — visible app IDs
+	httpmock.RegisterResponder("GET", `=~^https://api\.appstoreconnect\.apple\.com/v1/users/[^/]+/relationships/visibleApps$`, jsonFileResponder("validate_get_visible_apps.json"))
+
+	// POST|PATCH|DELETE /v1/users/{id}/relationships/visibleApps — modify visible apps
+	for _, method := range []string{"POST", "PATCH", "DELETE"} {
+		httpmock.RegisterResponder(method, `=~^https://api\.appstoreconnect\.apple\.com/v1/users/[^/]+/relationships/visibleApps$`, httpmock.NewStringResponder(204, ""))
+	}
+}
@@ -0,0 +1,116 @@
+package users
+
+import "slices"
+
+// Shared types for pagination and links
+type Meta struct {
+	Paging *Paging `json:"paging,omitempty"`
+}
+
+type Paging struct {
+	Total int `json:"total,omitempty"`
+	Limit int `json:"limit,omitempty"`
+}
+
+type Links struct {
+	Self  string `json:"self,omitempty"`
+	First string `json:"first,omitempty"`
+	Next  string `json:"next,omitempty"`
+}
+
+// ResourceLinks contains the self link of a resource
+type ResourceLinks struct {
+	Self string `json:"self,omitempty"`
+}
+
+// ResourceIdentifier identifies a related resource by type and ID
+type ResourceIdentifier struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// User represents a member of the App Store Connect team
+type User struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	Attributes *UserAttributes `json:"attributes,omitempty"`
+	Links      *ResourceLinks  `json:"links,omitempty"`
+}
+
+// UserAttributes contains the user attributes
+type UserAttributes struct {
+	Username            string   `json:"username,omitempty"`
+	FirstName           string   `json:"firstName,omitempty"`
+	LastName            string   `json:"lastName,omitempty"`
+	Roles               []string `json:"roles,omitempty"`
+	AllAppsVisible      bool     `json:"allAppsVisible,omitempty"`
+	ProvisioningAllowed bool     `json:"provisioningAllowed,omitempty"`
+}
+
+// HasRole reports whether the user has been granted role.
+func (u *User) HasRole(role string) bool {
+	return u.Attributes != nil && slices.Contains(u.Attributes.Roles, role)
+}
+
+// UserResponse represents the response for a single user
+type UserResponse struct {
+	Data  User           `json:"data"`
+	Links *ResourceLinks `json:"links,omitempty"`
+}
+
+// UsersResponse represents the response for listing users
+type UsersResponse struct {
+	Data  []User `json:"data"`
+	Meta  *Meta  `json:"meta,omitempty"`
+	Links *Links `json:"links,omitempty"`
+}
+
+// UpdateUserRequest is the request body for modifying a user
+type UpdateUserRequest struct {
+	Data UpdateUserData `json:"data"`
+}
+
+// UpdateUserData is the data object of a user update request
+type UpdateUserData struct {
+	Type       string               `json:"type"`
+	ID         string               `json:"id"`
+	Attributes UpdateUserAttributes `json:"attributes"`
+}
+
+// UpdateUserAttributes are the attributes that can be modified on a user.
+// Only non-nil attributes are changed; Roles replaces the user's full role list.
+type UpdateUserAttributes struct {
+	Roles               []string `json:"roles,omitempty"`
+	AllAppsVisible      *bool    `json:"allAppsVisible,omitempty"`
+	ProvisioningAllowed *bool    `json:"provisioningAllowed,omitempty"`
+}
+
+// VisibleAppsLinkagesResponse represents the IDs of the apps visible to a user
+type VisibleAppsLinkagesResponse struct {
+	Data  []ResourceIdentifier `json:"data"`
+	Meta  *Meta                `json:"meta,omitempty"`
+	Links *Links               `json:"links,omitempty"`
+}
+
+// ToManyRelationship is a JSON:API to-many relationship linkage, also used as the
+// request body when adding, replacing or removing visible apps
+type ToManyRelationship struct {
+	Data []ResourceIdentifier `json:"data"`
+}
+
+// RequestQueryOptions represents the query parameters for user requests
+type RequestQueryOptions struct {
+	// Field selection - fields to return for users
+	Fields []string `json:"fields,omitempty"`
+
+	// Filters
+	FilterUsername    []string `json:"filter_username,omitempty"`
+	FilterRoles       []string `json:"filter_roles,omitempty"`
+	FilterVisibleApps []string `json:"filter_visible_apps,omitempty"`
+
+	// Sort order, e.g. SortUsername or "-" + SortLastName
+	Sort []string `json:"sort,omitempty"`
+
+	// Limit the number of included results per page (maximum 200)
+	Limit int `json:"limit,omitempty"`
+}
@@ -0,0 +1,39 @@
+package client
+
+import "github.com/deploymenttheory/go-api-sdk-apple/internal/apiclient"
+
+// Meta contains pagination metadata matching Apple's API format.
+type Meta = apiclient.Meta
+
+// Paging contains pagination information matching Apple's API format.
+type Paging = apiclient.Paging
+
+// Links contains pagination navigation links matching Apple's API format.
+type Links = apiclient.Links
+
+// PaginationOptions represents common pagination parameters for Apple's API.
+type PaginationOptions struct {
+	Limit  int    `json:"limit,omitempty"`
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// AddToQueryBuilder adds pagination options to a query builder.
+func (opts *PaginationOptions) AddToQueryBuilder(qb *QueryBuilder) *QueryBuilder {
+	if opts == nil {
+		return qb
+	}
+
+	return qb.
+		AddInt("limit", opts.Limit).
+		AddString("cursor", opts.Cursor)
+}
+
+// HasNextPage checks if there is a next page available.
+func HasNextPage(links *Links) bool {
+	return apiclient.HasNextPage(links)
+}
+
+// HasPrevPage checks if there is a previous page available.
+func HasPrevPage(links *Links) bool {
+	return apiclient.HasPrevPage(links)
+}
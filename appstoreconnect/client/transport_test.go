@@ -0,0 +1,186 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jarcoal/httpmock"
+	"go.uber.org/zap"
+)
+
+func newTestTransport(t *testing.T, options ...ClientOption) *Transport {
+	t.Helper()
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+
+	options = append([]ClientOption{WithLogger(zap.NewNop()), WithRetryCount(0)}, options...)
+	c, err := NewTransport("test-key-id", "test-issuer-id", privateKey, options...)
+	if err != nil {
+		t.Fatalf("NewTransport failed: %v", err)
+	}
+
+	httpmock.ActivateNonDefault(c.httpClient.Client())
+	t.Cleanup(httpmock.DeactivateAndReset)
+
+	return c
+}
+
+func TestNewTransport_RequiresCredentials(t *testing.T) {
+	if _, err := NewTransport("", "issuer", "key"); err == nil {
+		t.Error("expected error for empty keyID")
+	}
+	if _, err := NewTransport("key", "", "key"); err == nil {
+		t.Error("expected error for empty issuerID")
+	}
+	if _, err := NewTransport("key", "issuer", nil); err == nil {
+		t.Error("expected error for nil privateKey")
+	}
+}
+
+func TestNewTransport_RejectsNonP256Keys(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	if _, err := NewTransport("kid", "issuer", rsaKey); err == nil {
+		t.Error("expected RSA key to be rejected")
+	}
+
+	p384, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	if _, err := NewTransport("kid", "issuer", p384); err == nil {
+		t.Error("expected P-384 key to be rejected")
+	}
+}
+
+func TestTransport_SignsES256BearerToken(t *testing.T) {
+	c := newTestTransport(t)
+
+	var authHeader string
+	httpmock.RegisterResponder("GET", "https://api.appstoreconnect.apple.com/v1/apps",
+		func(req *http.Request) (*http.Response, error) {
+			authHeader = req.Header.Get("Authorization")
+			return httpmock.NewStringResponse(200, `{"data":[]}`), nil
+		})
+
+	if _, err := c.NewRequest(context.Background()).Get("/v1/apps"); err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+
+	tokenString, ok := strings.CutPrefix(authHeader, "Bearer ")
+	if !ok {
+		t.Fatalf("Authorization = %q, want Bearer token", authHeader)
+	}
+
+	token, _, err := jwt.NewParser().ParseUnverified(tokenString, jwt.MapClaims{})
+	if err != nil {
+		t.Fatalf("failed to parse token: %v", err)
+	}
+	if token.Method.Alg() != "ES256" {
+		t.Errorf("alg = %v, want ES256", token.Method.Alg())
+	}
+	if token.Header["kid"] != "test-key-id" {
+		t.Errorf("kid = %v, want test-key-id", token.Header["kid"])
+	}
+	claims := token.Claims.(jwt.MapClaims)
+	if claims["iss"] != "test-issuer-id" {
+		t.Errorf("iss = %v, want test-issuer-id", claims["iss"])
+	}
+	if claims["aud"] != DefaultJWTAudience {
+		t.Errorf("aud = %v, want %v", claims["aud"], DefaultJWTAudience)
+	}
+}
+
+func TestTransport_WithBaseURL(t *testing.T) {
+	c := newTestTransport(t, WithBaseURL("https://asc.example.com"))
+
+	httpmock.RegisterResponder("GET", "https://asc.example.com/v1/builds",
+		httpmock.NewStringResponder(200, `{"data":[]}`))
+
+	if _, err := c.NewRequest(context.Background()).Get("/v1/builds"); err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if got := httpmock.GetTotalCallCount(); got != 1 {
+		t.Errorf("call count = %d, want 1", got)
+	}
+}
+
+func TestTransport_DecodesJSONAPIError(t *testing.T) {
+	c := newTestTransport(t)
+
+	httpmock.RegisterResponder("GET", "https://api.appstoreconnect.apple.com/v1/builds/missing",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(404, `{"errors":[{"id":"e1","status":"404","code":"NOT_FOUND","title":"The specified resource does not exist","detail":"There is no resource of type 'builds' with id 'missing'"}]}`)
+			resp.Header.Set("Content-Type", "application/json")
+			return resp, nil
+		})
+
+	_, err := c.NewRequest(context.Background()).Get("/v1/builds/missing")
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("error = %v, want *APIError", err)
+	}
+	if apiErr.Status != "404" || apiErr.Code != "NOT_FOUND" {
+		t.Errorf("APIError = %+v, want status 404 code NOT_FOUND", apiErr)
+	}
+}
+
+func TestTransport_ConcurrentTokenRefresh(t *testing.T) {
+	c := newTestTransport(t)
+
+	server := &rejectFirstToken{}
+	httpmock.RegisterResponder("GET", DefaultBaseURL+"/v1/apps", server.respond)
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Go(func() {
+			_, _ = c.NewRequest(context.Background()).Get("/v1/apps")
+		})
+	}
+	wg.Wait()
+	if _, err := c.NewRequest(context.Background()).Get("/v1/apps"); err != nil {
+		t.Fatalf("request after refresh failed: %v", err)
+	}
+
+	// Requests rejected with the first token share one replacement rather
+	// than each discarding the one another has just signed.
+	if len(server.tokens) != 2 {
+		t.Errorf("distinct tokens = %d, want 2", len(server.tokens))
+	}
+}
+
+// rejectFirstToken answers 401 to requests bearing the first token it sees
+// and 200 to any other, recording each distinct token.
+type rejectFirstToken struct {
+	mu     sync.Mutex
+	tokens []string
+}
+
+func (r *rejectFirstToken) respond(req *http.Request) (*http.Response, error) {
+	token := req.Header.Get("Authorization")
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !slices.Contains(r.tokens, token) {
+		r.tokens = append(r.tokens, token)
+	}
+	if token == r.tokens[0] {
+		return httpmock.NewStringResponse(401, `{"errors":[{"status":"401","code":"NOT_AUTHORIZED"}]}`), nil
+	}
+	return httpmock.NewStringResponse(200, `{}`), nil
+}
@@ -0,0 +1,6 @@
+package constants
+
+// JWT authentication configuration
+const (
+	DefaultJWTAudience = "appstoreconnect-v1"
+)
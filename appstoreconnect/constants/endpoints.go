@@ -0,0 +1,33 @@
+package constants
+
+// API base URL
+const (
+	DefaultBaseURL = "https://api.appstoreconnect.apple.com"
+)
+
+// API version prefix
+const (
+	APIVersionV1 = "/v1"
+)
+
+// Endpoint path constants for the App Store Connect API
+const (
+	EndpointApps     = APIVersionV1 + "/apps"
+	EndpointAppInfos = APIVersionV1 + "/appInfos"
+	EndpointBuilds   = APIVersionV1 + "/builds"
+
+	// TestFlight
+	EndpointBetaGroups               = APIVersionV1 + "/betaGroups"
+	EndpointBetaTesters              = APIVersionV1 + "/betaTesters"
+	EndpointBetaAppReviewSubmissions = APIVersionV1 + "/betaAppReviewSubmissions"
+
+	// Certificates, identifiers and profiles
+	EndpointCertificates = APIVersionV1 + "/certificates"
+	EndpointBundleIDs    = APIVersionV1 + "/bundleIds"
+	EndpointDevices      = APIVersionV1 + "/devices"
+	EndpointProfiles     = APIVersionV1 + "/profiles"
+
+	// Users and access
+	EndpointUsers           = APIVersionV1 + "/users"
+	EndpointUserInvitations = APIVersionV1 + "/userInvitations"
+)
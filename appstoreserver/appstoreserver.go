@@ -0,0 +1,85 @@
+package appstoreserver
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreserver/appstoreserver_api/subscriptions"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreserver/appstoreserver_api/transactions"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreserver/client"
+)
+
+// Client is the main entry point for the App Store Server API SDK.
+// A Client is safe for concurrent use by multiple goroutines.
+type Client struct {
+	transport         *client.Transport
+	AppStoreServerAPI *AppStoreServerAPIClient
+}
+
+// AppStoreServerAPIClient groups all App Store Server API services.
+type AppStoreServerAPIClient struct {
+	Transactions  *transactions.Transactions
+	Subscriptions *subscriptions.Subscriptions
+}
+
+// NewClient creates a new App Store Server API client for one app.
+// Parameters:
+//   - keyID: Your In-App Purchase key ID from App Store Connect
+//   - issuerID: Your App Store Connect issuer ID
+//   - bundleID: The bundle ID of the app whose purchases are queried
+//   - privateKey: The In-App Purchase private key (*ecdsa.PrivateKey, P-256)
+//   - options: Optional configuration options (WithSandbox, WithLogger, etc.)
+//
+// Example:
+//
+//	c, err := appstoreserver.NewClientFromFile(keyID, issuerID, "com.example.app", "SubscriptionKey.p8",
+//	    appstoreserver.WithSandbox(),
+//	)
+//	history, _, err := c.AppStoreServerAPI.Transactions.GetTransactionHistoryV2(ctx, transactionID, nil)
+func NewClient(keyID, issuerID, bundleID string, privateKey any, options ...client.ClientOption) (*Client, error) {
+	transport, err := client.NewTransport(keyID, issuerID, bundleID, privateKey, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return newClient(transport), nil
+}
+
+// NewClientFromFile creates a client using a private key from file.
+// Parameters:
+//   - keyID: Your In-App Purchase key ID from App Store Connect
+//   - issuerID: Your App Store Connect issuer ID
+//   - bundleID: The bundle ID of the app whose purchases are queried
+//   - privateKeyPath: Path to your In-App Purchase private key file (.p8)
+//   - options: Optional configuration options (WithSandbox, WithLogger, etc.)
+func NewClientFromFile(keyID, issuerID, bundleID, privateKeyPath string, options ...client.ClientOption) (*Client, error) {
+	privateKey, err := client.LoadPrivateKeyFromFile(privateKeyPath)
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(keyID, issuerID, bundleID, privateKey, options...)
+}
+
+// NewClientFromEnv creates a client using environment variables.
+// Expects: APPLE_KEY_ID, APPLE_ISSUER_ID, APPLE_BUNDLE_ID, and one of
+// APPLE_PRIVATE_KEY_PEM or APPLE_PRIVATE_KEY_PATH.
+func NewClientFromEnv(options ...client.ClientOption) (*Client, error) {
+	transport, err := client.NewTransportFromEnv(options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return newClient(transport), nil
+}
+
+func newClient(transport *client.Transport) *Client {
+	return &Client{
+		transport: transport,
+		AppStoreServerAPI: &AppStoreServerAPIClient{
+			Transactions:  transactions.NewService(transport),
+			Subscriptions: subscriptions.NewService(transport),
+		},
+	}
+}
+
+// Close releases resources held by the client.
+func (c *Client) Close() error {
+	return c.transport.Close()
+}
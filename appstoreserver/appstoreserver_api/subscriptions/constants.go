@@ -0,0 +1,11 @@
+package subscriptions
+
+// Subscription status values for LastTransaction.Status and the statuses
+// filter of GetAllSubscriptionStatusesV1.
+const (
+	StatusActive             = 1
+	StatusExpired            = 2
+	StatusBillingRetry       = 3
+	StatusBillingGracePeriod = 4
+	StatusRevoked            = 5
+)
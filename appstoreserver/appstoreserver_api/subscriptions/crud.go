@@ -0,0 +1,55 @@
+package subscriptions
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreserver/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreserver/constants"
+	"resty.dev/v3"
+)
+
+// Subscriptions handles communication with the subscription status
+// methods of the App Store Server API.
+//
+// App Store Server API docs: https://developer.apple.com/documentation/appstoreserverapi
+type (
+	Subscriptions struct {
+		client client.Client
+	}
+)
+
+// NewService creates a new subscriptions service.
+func NewService(c client.Client) *Subscriptions {
+	return &Subscriptions{client: c}
+}
+
+// GetAllSubscriptionStatusesV1 retrieves the status of every auto-renewable
+// subscription of the customer who made transactionID. Pass Status
+// constants to return only subscriptions in those states.
+// URL: GET https://api.storekit.itunes.apple.com/inApps/v1/subscriptions/{transactionId}
+// https://developer.apple.com/documentation/appstoreserverapi/get-all-subscription-statuses
+func (s *Subscriptions) GetAllSubscriptionStatusesV1(ctx context.Context, transactionID string, statuses ...int) (*StatusResponse, *resty.Response, error) {
+	if transactionID == "" {
+		return nil, nil, fmt.Errorf("transaction ID is required")
+	}
+
+	var result StatusResponse
+
+	req := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetResult(&result)
+	for _, status := range statuses {
+		req.AddQueryParam("status", strconv.Itoa(status))
+	}
+
+	resp, err := req.Get(constants.EndpointSubscriptionsV1 + "/" + url.PathEscape(transactionID))
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
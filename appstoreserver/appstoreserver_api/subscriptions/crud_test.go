@@ -0,0 +1,109 @@
+package subscriptions
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreserver/client"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+// setupMockClient creates a client with httpmock enabled.
+func setupMockClient(t *testing.T) *Subscriptions {
+	coreClient, err := client.NewTransport(
+		"test-key-id",
+		"test-issuer-id",
+		"com.example.app",
+		"dummy-key",
+		client.WithAuth(&MockAuthProvider{}),
+		client.WithLogger(zap.NewNop()),
+		client.WithRetryCount(0),
+	)
+	require.NoError(t, err)
+
+	httpmock.ActivateNonDefault(coreClient.GetHTTPClient().Client())
+
+	t.Cleanup(func() {
+		httpmock.DeactivateAndReset()
+	})
+
+	return NewService(coreClient)
+}
+
+// MockAuthProvider implements the AuthProvider interface for testing.
+type MockAuthProvider struct{}
+
+func (m *MockAuthProvider) ApplyAuth(req *resty.Request) error {
+	return nil
+}
+
+// compact builds an unsigned compact JWS carrying payload.
+func compact(payload string) string {
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString([]byte(`{"alg":"ES256"}`)) + "." + enc.EncodeToString([]byte(payload)) + ".c2ln"
+}
+
+func TestGetAllSubscriptionStatusesV1_Success(t *testing.T) {
+	svc := setupMockClient(t)
+
+	var query map[string][]string
+	httpmock.RegisterResponder("GET", `=~^https://api\.storekit\.itunes\.apple\.com/inApps/v1/subscriptions/2000000012345678`,
+		func(req *http.Request) (*http.Response, error) {
+			query = req.URL.Query()
+			resp := httpmock.NewStringResponse(200, `{
+				"environment": "Production",
+				"bundleId": "com.example.app",
+				"data": [{
+					"subscriptionGroupIdentifier": "21345678",
+					"lastTransactions": [{
+						"originalTransactionId": "2000000012345678",
+						"status": 4,
+						"signedTransactionInfo": "`+compact(`{"transactionId":"2000000123456789","productId":"com.example.app.monthly"}`)+`",
+						"signedRenewalInfo": "`+compact(`{"autoRenewStatus":1,"isInBillingRetryPeriod":true}`)+`"
+					}]
+				}]
+			}`)
+			resp.Header.Set("Content-Type", "application/json")
+			return resp, nil
+		})
+
+	result, resp, err := svc.GetAllSubscriptionStatusesV1(context.Background(), "2000000012345678", StatusActive, StatusBillingGracePeriod)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, []string{"1", "4"}, query["status"])
+	require.Len(t, result.Data, 1)
+	last := result.Data[0].LastTransactions[0]
+	assert.True(t, last.Entitled())
+
+	tx, err := last.Transaction()
+	require.NoError(t, err)
+	assert.Equal(t, "com.example.app.monthly", tx.ProductID)
+
+	renewal, err := last.RenewalInfo()
+	require.NoError(t, err)
+	assert.True(t, renewal.AutoRenews())
+	assert.True(t, renewal.IsInBillingRetryPeriod)
+}
+
+func TestGetAllSubscriptionStatusesV1_EmptyID(t *testing.T) {
+	svc := setupMockClient(t)
+
+	_, _, err := svc.GetAllSubscriptionStatusesV1(context.Background(), "")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "transaction ID is required")
+}
+
+func TestLastTransaction_Entitled(t *testing.T) {
+	assert.True(t, (&LastTransaction{Status: StatusActive}).Entitled())
+	assert.False(t, (&LastTransaction{Status: StatusExpired}).Entitled())
+	assert.False(t, (&LastTransaction{Status: StatusBillingRetry}).Entitled())
+	assert.False(t, (&LastTransaction{Status: StatusRevoked}).Entitled())
+}
@@ -0,0 +1,45 @@
+package subscriptions
+
+import "github.com/deploymenttheory/go-api-sdk-apple/appstoreserver/jws"
+
+// StatusResponse holds the status of every auto-renewable subscription of a
+// customer, grouped by subscription group.
+type StatusResponse struct {
+	Environment string                  `json:"environment"`
+	BundleID    string                  `json:"bundleId"`
+	AppAppleID  int64                   `json:"appAppleId,omitempty"`
+	Data        []SubscriptionGroupItem `json:"data"`
+}
+
+// SubscriptionGroupItem is the latest state of the subscriptions in one
+// subscription group.
+type SubscriptionGroupItem struct {
+	SubscriptionGroupIdentifier string            `json:"subscriptionGroupIdentifier"`
+	LastTransactions            []LastTransaction `json:"lastTransactions"`
+}
+
+// LastTransaction is the most recent transaction and renewal info of one
+// subscription.
+type LastTransaction struct {
+	OriginalTransactionID string `json:"originalTransactionId"`
+	// Status is one of the Status constants.
+	Status                int    `json:"status"`
+	SignedTransactionInfo string `json:"signedTransactionInfo"`
+	SignedRenewalInfo     string `json:"signedRenewalInfo"`
+}
+
+// Transaction decodes SignedTransactionInfo.
+func (l *LastTransaction) Transaction() (*jws.Transaction, error) {
+	return jws.DecodeTransaction(l.SignedTransactionInfo)
+}
+
+// RenewalInfo decodes SignedRenewalInfo.
+func (l *LastTransaction) RenewalInfo() (*jws.RenewalInfo, error) {
+	return jws.DecodeRenewalInfo(l.SignedRenewalInfo)
+}
+
+// Entitled reports whether the customer currently has access to the
+// subscription: it is active or in the billing grace period.
+func (l *LastTransaction) Entitled() bool {
+	return l.Status == StatusActive || l.Status == StatusBillingGracePeriod
+}
@@ -0,0 +1,27 @@
+package transactions
+
+// Sort orders for HistoryQueryOptions.Sort.
+const (
+	SortAscending  = "ASCENDING"
+	SortDescending = "DESCENDING"
+)
+
+// Product types for HistoryQueryOptions.ProductTypes.
+const (
+	ProductTypeAutoRenewable = "AUTO_RENEWABLE"
+	ProductTypeNonRenewable  = "NON_RENEWABLE"
+	ProductTypeConsumable    = "CONSUMABLE"
+	ProductTypeNonConsumable = "NON_CONSUMABLE"
+)
+
+// Ownership types for HistoryQueryOptions.InAppOwnershipType.
+const (
+	OwnershipPurchased    = "PURCHASED"
+	OwnershipFamilyShared = "FAMILY_SHARED"
+)
+
+// Order lookup status values for OrderLookupResponse.Status.
+const (
+	OrderLookupStatusValid   = 0
+	OrderLookupStatusInvalid = 1
+)
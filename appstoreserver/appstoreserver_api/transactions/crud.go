@@ -0,0 +1,141 @@
+package transactions
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreserver/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreserver/constants"
+	"resty.dev/v3"
+)
+
+// Transactions handles communication with the transaction related
+// methods of the App Store Server API.
+//
+// App Store Server API docs: https://developer.apple.com/documentation/appstoreserverapi
+type (
+	Transactions struct {
+		client client.Client
+	}
+)
+
+// NewService creates a new transactions service.
+func NewService(c client.Client) *Transactions {
+	return &Transactions{client: c}
+}
+
+// GetTransactionInfoV1 retrieves a single transaction by any transaction ID
+// the customer's purchases carry.
+// URL: GET https://api.storekit.itunes.apple.com/inApps/v1/transactions/{transactionId}
+// https://developer.apple.com/documentation/appstoreserverapi/get-transaction-info
+func (s *Transactions) GetTransactionInfoV1(ctx context.Context, transactionID string) (*TransactionInfoResponse, *resty.Response, error) {
+	if transactionID == "" {
+		return nil, nil, fmt.Errorf("transaction ID is required")
+	}
+
+	var result TransactionInfoResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetResult(&result).
+		Get(constants.EndpointTransactionsV1 + "/" + url.PathEscape(transactionID))
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// GetTransactionHistoryV2 retrieves the in-app purchase history of the
+// customer who made transactionID, following every page. The returned
+// Revision can be stored to resume the history later.
+// URL: GET https://api.storekit.itunes.apple.com/inApps/v2/history/{transactionId}
+// https://developer.apple.com/documentation/appstoreserverapi/get-transaction-history
+func (s *Transactions) GetTransactionHistoryV2(ctx context.Context, transactionID string, opts *HistoryQueryOptions) (*HistoryResponse, *resty.Response, error) {
+	if transactionID == "" {
+		return nil, nil, fmt.Errorf("transaction ID is required")
+	}
+	if opts == nil {
+		opts = &HistoryQueryOptions{}
+	}
+
+	path := constants.EndpointTransactionHistoryV2 + "/" + url.PathEscape(transactionID)
+
+	var (
+		all      HistoryResponse
+		lastResp *resty.Response
+		revision string
+	)
+	for {
+		var page HistoryResponse
+
+		req := s.client.NewRequest(ctx).
+			SetHeader("Accept", constants.ApplicationJSON).
+			SetQueryParam("revision", revision).
+			SetQueryParam("sort", opts.Sort).
+			SetQueryParam("inAppOwnershipType", opts.InAppOwnershipType).
+			SetResult(&page)
+
+		if !opts.StartDate.IsZero() {
+			req.SetQueryParam("startDate", strconv.FormatInt(opts.StartDate.UnixMilli(), 10))
+		}
+		if !opts.EndDate.IsZero() {
+			req.SetQueryParam("endDate", strconv.FormatInt(opts.EndDate.UnixMilli(), 10))
+		}
+		if opts.Revoked != nil {
+			req.SetQueryParam("revoked", strconv.FormatBool(*opts.Revoked))
+		}
+		for _, id := range opts.ProductIDs {
+			req.AddQueryParam("productId", id)
+		}
+		for _, t := range opts.ProductTypes {
+			req.AddQueryParam("productType", t)
+		}
+		for _, g := range opts.SubscriptionGroupIdentifiers {
+			req.AddQueryParam("subscriptionGroupIdentifier", g)
+		}
+
+		resp, err := req.Get(path)
+		lastResp = resp
+		if err != nil {
+			return nil, resp, err
+		}
+
+		signed := append(all.SignedTransactions, page.SignedTransactions...)
+		all = page
+		all.SignedTransactions = signed
+
+		if !page.HasMore || page.Revision == "" {
+			break
+		}
+		revision = page.Revision
+	}
+
+	return &all, lastResp, nil
+}
+
+// LookUpOrderIDV1 retrieves the transactions of an order, using the order
+// ID from the customer's App Store purchase receipt email.
+// URL: GET https://api.storekit.itunes.apple.com/inApps/v1/lookup/{orderId}
+// https://developer.apple.com/documentation/appstoreserverapi/look-up-order-id
+func (s *Transactions) LookUpOrderIDV1(ctx context.Context, orderID string) (*OrderLookupResponse, *resty.Response, error) {
+	if orderID == "" {
+		return nil, nil, fmt.Errorf("order ID is required")
+	}
+
+	var result OrderLookupResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetResult(&result).
+		Get(constants.EndpointOrderLookupV1 + "/" + url.PathEscape(orderID))
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
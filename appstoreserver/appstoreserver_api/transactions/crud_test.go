@@ -0,0 +1,198 @@
+package transactions
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreserver/client"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+const baseURL = "https://api.storekit.itunes.apple.com"
+
+// setupMockClient creates a client with httpmock enabled.
+func setupMockClient(t *testing.T) *Transactions {
+	coreClient, err := client.NewTransport(
+		"test-key-id",
+		"test-issuer-id",
+		"com.example.app",
+		"dummy-key",
+		client.WithAuth(&MockAuthProvider{}),
+		client.WithLogger(zap.NewNop()),
+		client.WithRetryCount(0),
+	)
+	require.NoError(t, err)
+
+	httpmock.ActivateNonDefault(coreClient.GetHTTPClient().Client())
+
+	t.Cleanup(func() {
+		httpmock.DeactivateAndReset()
+	})
+
+	return NewService(coreClient)
+}
+
+// MockAuthProvider implements the AuthProvider interface for testing.
+type MockAuthProvider struct{}
+
+func (m *MockAuthProvider) ApplyAuth(req *resty.Request) error {
+	return nil
+}
+
+// jsonResponder answers with body as application/json.
+func jsonResponder(status int, body string) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		resp := httpmock.NewStringResponse(status, body)
+		resp.Header.Set("Content-Type", "application/json")
+		return resp, nil
+	}
+}
+
+// signedTransaction builds an unsigned compact JWS for a transaction ID.
+func signedTransaction(id string) string {
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString([]byte(`{"alg":"ES256"}`)) + "." +
+		enc.EncodeToString([]byte(`{"transactionId":"`+id+`","bundleId":"com.example.app","productId":"com.example.app.coins"}`)) + ".c2ln"
+}
+
+// --- GetTransactionInfoV1 tests ---
+
+func TestGetTransactionInfoV1_Success(t *testing.T) {
+	svc := setupMockClient(t)
+
+	httpmock.RegisterResponder("GET", baseURL+"/inApps/v1/transactions/2000000123456789",
+		jsonResponder(200, `{"signedTransactionInfo": "`+signedTransaction("2000000123456789")+`"}`))
+
+	result, resp, err := svc.GetTransactionInfoV1(context.Background(), "2000000123456789")
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, 200, resp.StatusCode())
+
+	tx, err := result.Transaction()
+	require.NoError(t, err)
+	assert.Equal(t, "2000000123456789", tx.TransactionID)
+	assert.Equal(t, "com.example.app.coins", tx.ProductID)
+}
+
+func TestGetTransactionInfoV1_NotFound(t *testing.T) {
+	svc := setupMockClient(t)
+
+	httpmock.RegisterResponder("GET", baseURL+"/inApps/v1/transactions/1",
+		jsonResponder(404, `{"errorCode": 4040010, "errorMessage": "Transaction id not found."}`))
+
+	result, _, err := svc.GetTransactionInfoV1(context.Background(), "1")
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.True(t, client.IsAPIError(err, client.ErrorCodeTransactionIDNotFound))
+}
+
+func TestGetTransactionInfoV1_EmptyID(t *testing.T) {
+	svc := setupMockClient(t)
+
+	_, _, err := svc.GetTransactionInfoV1(context.Background(), "")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "transaction ID is required")
+}
+
+// --- GetTransactionHistoryV2 tests ---
+
+func TestGetTransactionHistoryV2_FollowsRevisions(t *testing.T) {
+	svc := setupMockClient(t)
+
+	var queries []map[string][]string
+	httpmock.RegisterResponder("GET", `=~^`+baseURL+`/inApps/v2/history/2000000012345678`,
+		func(req *http.Request) (*http.Response, error) {
+			q := req.URL.Query()
+			queries = append(queries, q)
+
+			body := `{"revision": "rev-1", "hasMore": true, "bundleId": "com.example.app", "environment": "Production",
+				"signedTransactions": ["` + signedTransaction("1") + `", "` + signedTransaction("2") + `"]}`
+			if q.Get("revision") == "rev-1" {
+				body = `{"revision": "rev-2", "hasMore": false, "bundleId": "com.example.app", "environment": "Production",
+					"signedTransactions": ["` + signedTransaction("3") + `"]}`
+			}
+			resp := httpmock.NewStringResponse(200, body)
+			resp.Header.Set("Content-Type", "application/json")
+			return resp, nil
+		})
+
+	revoked := false
+	result, _, err := svc.GetTransactionHistoryV2(context.Background(), "2000000012345678", &HistoryQueryOptions{
+		StartDate:    time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		ProductIDs:   []string{"com.example.app.coins", "com.example.app.gems"},
+		ProductTypes: []string{ProductTypeConsumable},
+		Sort:         SortDescending,
+		Revoked:      &revoked,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, queries, 2)
+	assert.Equal(t, []string{"com.example.app.coins", "com.example.app.gems"}, queries[0]["productId"])
+	assert.Equal(t, []string{"CONSUMABLE"}, queries[0]["productType"])
+	assert.Equal(t, []string{"1704067200000"}, queries[0]["startDate"])
+	assert.Equal(t, []string{"DESCENDING"}, queries[0]["sort"])
+	assert.Equal(t, []string{"false"}, queries[0]["revoked"])
+	assert.Empty(t, queries[0]["revision"])
+	assert.Equal(t, []string{"rev-1"}, queries[1]["revision"])
+	assert.Equal(t, []string{"com.example.app.coins", "com.example.app.gems"}, queries[1]["productId"])
+
+	assert.Equal(t, "rev-2", result.Revision)
+	assert.False(t, result.HasMore)
+	txs, err := result.Transactions()
+	require.NoError(t, err)
+	require.Len(t, txs, 3)
+	assert.Equal(t, "3", txs[2].TransactionID)
+}
+
+// --- LookUpOrderIDV1 tests ---
+
+func TestLookUpOrderIDV1_Valid(t *testing.T) {
+	svc := setupMockClient(t)
+
+	httpmock.RegisterResponder("GET", baseURL+"/inApps/v1/lookup/MK5TTTVWJH",
+		jsonResponder(200, `{"status": 0, "signedTransactions": ["`+signedTransaction("42")+`"]}`))
+
+	result, _, err := svc.LookUpOrderIDV1(context.Background(), "MK5TTTVWJH")
+
+	require.NoError(t, err)
+	assert.True(t, result.Valid())
+	txs, err := result.Transactions()
+	require.NoError(t, err)
+	assert.Equal(t, "42", txs[0].TransactionID)
+}
+
+func TestLookUpOrderIDV1_Invalid(t *testing.T) {
+	svc := setupMockClient(t)
+
+	httpmock.RegisterResponder("GET", baseURL+"/inApps/v1/lookup/NOPE",
+		jsonResponder(200, `{"status": 1}`))
+
+	result, _, err := svc.LookUpOrderIDV1(context.Background(), "NOPE")
+
+	require.NoError(t, err)
+	assert.False(t, result.Valid())
+}
+
+func TestLookUpOrderIDV1_BadSignedTransaction(t *testing.T) {
+	svc := setupMockClient(t)
+
+	httpmock.RegisterResponder("GET", baseURL+"/inApps/v1/lookup/MK5TTTVWJH",
+		jsonResponder(200, `{"status": 0, "signedTransactions": ["garbage"]}`))
+
+	result, _, err := svc.LookUpOrderIDV1(context.Background(), "MK5TTTVWJH")
+	require.NoError(t, err)
+
+	_, err = result.Transactions()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "signed transaction 0")
+}
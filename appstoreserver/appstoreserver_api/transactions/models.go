@@ -0,0 +1,86 @@
+package transactions
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreserver/jws"
+)
+
+// HistoryQueryOptions filters a transaction history request. All fields are
+// optional.
+type HistoryQueryOptions struct {
+	// StartDate and EndDate bound the purchase dates returned.
+	StartDate time.Time
+	EndDate   time.Time
+	// ProductIDs limits the history to these product identifiers.
+	ProductIDs []string
+	// ProductTypes limits the history to these ProductType constants.
+	ProductTypes []string
+	// Sort is SortAscending (default) or SortDescending by modification date.
+	Sort string
+	// SubscriptionGroupIdentifiers limits the history to these groups.
+	SubscriptionGroupIdentifiers []string
+	// InAppOwnershipType is OwnershipPurchased or OwnershipFamilyShared.
+	InAppOwnershipType string
+	// Revoked, when set, returns only revoked (true) or only unrevoked
+	// (false) transactions.
+	Revoked *bool
+}
+
+// HistoryResponse is a customer's in-app purchase transaction history.
+type HistoryResponse struct {
+	// Revision is the token that continues the history from where this
+	// response ended.
+	Revision    string `json:"revision"`
+	HasMore     bool   `json:"hasMore"`
+	BundleID    string `json:"bundleId"`
+	AppAppleID  int64  `json:"appAppleId,omitempty"`
+	Environment string `json:"environment"`
+	// SignedTransactions are JWS-signed transactions; see Transactions.
+	SignedTransactions []string `json:"signedTransactions"`
+}
+
+// Transactions decodes SignedTransactions.
+func (r *HistoryResponse) Transactions() ([]jws.Transaction, error) {
+	return decodeTransactions(r.SignedTransactions)
+}
+
+// TransactionInfoResponse holds a single signed transaction.
+type TransactionInfoResponse struct {
+	SignedTransactionInfo string `json:"signedTransactionInfo"`
+}
+
+// Transaction decodes SignedTransactionInfo.
+func (r *TransactionInfoResponse) Transaction() (*jws.Transaction, error) {
+	return jws.DecodeTransaction(r.SignedTransactionInfo)
+}
+
+// OrderLookupResponse holds the transactions of a customer's order.
+type OrderLookupResponse struct {
+	// Status is OrderLookupStatusValid or OrderLookupStatusInvalid.
+	Status             int      `json:"status"`
+	SignedTransactions []string `json:"signedTransactions"`
+}
+
+// Valid reports whether the order ID was found.
+func (r *OrderLookupResponse) Valid() bool {
+	return r.Status == OrderLookupStatusValid
+}
+
+// Transactions decodes SignedTransactions.
+func (r *OrderLookupResponse) Transactions() ([]jws.Transaction, error) {
+	return decodeTransactions(r.SignedTransactions)
+}
+
+func decodeTransactions(signed []string) ([]jws.Transaction, error) {
+	out := make([]jws.Transaction, 0, len(signed))
+	for i, s := range signed {
+		t, err := jws.DecodeTransaction(s)
+		if err != nil {
+			return nil, fmt.Errorf("signed transaction %d: %w", i, err)
+		}
+		out = append(out, *t)
+	}
+	return out, nil
+}
@@ -0,0 +1,83 @@
+package client
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/es256"
+	"resty.dev/v3"
+)
+
+// AuthProvider interface for different authentication methods
+type AuthProvider interface {
+	ApplyAuth(req *resty.Request) error
+}
+
+// JWTAuth implements JWT Bearer authentication for the App Store Server API.
+// Requests are authorized with an ES256 token signed by an In-App Purchase
+// key from App Store Connect; the token carries the app's bundle ID in the
+// "bid" claim, so each client serves a single app. It is safe for concurrent
+// use.
+type JWTAuth struct {
+	config JWTAuthConfig
+	signer *es256.Signer
+}
+
+// JWTAuthConfig holds configuration for JWT authentication
+type JWTAuthConfig struct {
+	KeyID      string
+	IssuerID   string
+	BundleID   string
+	PrivateKey *ecdsa.PrivateKey
+	Audience   string // Usually "appstoreconnect-v1"
+}
+
+// Token lifetimes. Apple rejects tokens that expire more than 60 minutes
+// after issue; a token is replaced 5 minutes before it expires.
+const (
+	tokenLifetime     = 20 * time.Minute
+	tokenRefreshAfter = 15 * time.Minute
+)
+
+// NewJWTAuth creates a new JWT authentication provider
+func NewJWTAuth(config JWTAuthConfig) *JWTAuth {
+	if config.Audience == "" {
+		config.Audience = DefaultJWTAudience
+	}
+
+	j := &JWTAuth{}
+	j.configure(config)
+	return j
+}
+
+// configure replaces the token configuration, discarding the current token.
+func (j *JWTAuth) configure(config JWTAuthConfig) {
+	j.config = config
+	j.signer = es256.NewSigner(config.KeyID, config.IssuerID, config.PrivateKey, tokenRefreshAfter,
+		es256.WithClaims(map[string]any{"aud": config.Audience, "bid": config.BundleID}),
+		es256.WithExpiry(tokenLifetime))
+}
+
+// ApplyAuth applies JWT Bearer authentication to the request
+func (j *JWTAuth) ApplyAuth(req *resty.Request) error {
+	token, err := j.signer.Token()
+	if err != nil {
+		return fmt.Errorf("failed to get JWT token: %w", err)
+	}
+
+	req.SetAuthToken(token)
+	return nil
+}
+
+// ForceRefresh forces a token refresh on the next request
+func (j *JWTAuth) ForceRefresh() {
+	j.signer.Refresh()
+}
+
+// invalidate discards token if it is still the current token and reports
+// whether it did, so concurrent requests rejected with the same token sign
+// one replacement between them.
+func (j *JWTAuth) invalidate(token string) bool {
+	return j.signer.Invalidate(token)
+}
@@ -0,0 +1,16 @@
+package client
+
+// DefaultUserAgent is the default User-Agent header value for all requests.
+const (
+	DefaultUserAgent = "go-api-sdk-apple/1.0.0"
+	Version          = "1.0.0"
+)
+
+// The following constants are re-exported from the constants package so that
+// code and tests in the client package can reference them without importing
+// the constants package directly.
+const (
+	DefaultBaseURL     = "https://api.storekit.itunes.apple.com"
+	SandboxBaseURL     = "https://api.storekit-sandbox.itunes.apple.com"
+	DefaultJWTAudience = "appstoreconnect-v1"
+)
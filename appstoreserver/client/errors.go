@@ -0,0 +1,94 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+// Error codes returned by the App Store Server API in ErrorResponse.ErrorCode.
+const (
+	ErrorCodeGeneralBadRequest             int64 = 4000000
+	ErrorCodeInvalidTransactionID          int64 = 4000006
+	ErrorCodeAccountNotFound               int64 = 4040001
+	ErrorCodeAccountNotFoundRetryable      int64 = 4040002
+	ErrorCodeAppNotFound                   int64 = 4040003
+	ErrorCodeOriginalTransactionIDNotFound int64 = 4040005
+	ErrorCodeTransactionIDNotFound         int64 = 4040010
+	ErrorCodeRateLimitExceeded             int64 = 4290000
+	ErrorCodeGeneralInternal               int64 = 5000000
+	ErrorCodeGeneralInternalRetryable      int64 = 5000001
+)
+
+// APIError represents an error from the App Store Server API.
+// The API returns errors as { "errorCode": 4040010, "errorMessage": "..." }.
+type APIError struct {
+	ErrorCode    int64
+	ErrorMessage string
+	StatusCode   int
+}
+
+func (e *APIError) Error() string {
+	if e.ErrorCode != 0 {
+		return fmt.Sprintf("API error %d: %d - %s", e.StatusCode, e.ErrorCode, e.ErrorMessage)
+	}
+	return fmt.Sprintf("API error %d: %s", e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+// ErrorResponse represents the error response structure returned by the App
+// Store Server API.
+type ErrorResponse struct {
+	ErrorCode    int64  `json:"errorCode"`
+	ErrorMessage string `json:"errorMessage"`
+}
+
+// IsAPIError reports whether err wraps an *APIError carrying the given error code.
+func IsAPIError(err error, code int64) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.ErrorCode == code
+}
+
+// ErrorHandler centralizes error handling for all API requests
+type ErrorHandler struct {
+	logger *zap.Logger
+}
+
+// NewErrorHandler creates a new error handler
+func NewErrorHandler(logger *zap.Logger) *ErrorHandler {
+	return &ErrorHandler{
+		logger: logger,
+	}
+}
+
+// HandleError processes App Store Server API error responses and returns structured errors
+func (eh *ErrorHandler) HandleError(resp *resty.Response, errorResp *ErrorResponse) error {
+	statusCode := resp.StatusCode()
+
+	if errorResp != nil && errorResp.ErrorCode != 0 {
+		eh.logger.Error("API request failed",
+			zap.Int("status_code", statusCode),
+			zap.Int64("error_code", errorResp.ErrorCode),
+			zap.String("error_message", errorResp.ErrorMessage),
+			zap.String("url", resp.Request.URL),
+			zap.String("method", resp.Request.Method),
+		)
+
+		return &APIError{
+			ErrorCode:    errorResp.ErrorCode,
+			ErrorMessage: errorResp.ErrorMessage,
+			StatusCode:   statusCode,
+		}
+	}
+
+	eh.logger.Error("API request failed (no structured error)",
+		zap.Int("status_code", statusCode),
+		zap.String("url", resp.Request.URL),
+		zap.String("method", resp.Request.Method),
+		zap.String("response_body", resp.String()),
+	)
+
+	return &APIError{StatusCode: statusCode}
+}
@@ -0,0 +1,250 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/appstoreserver/constants"
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+// Transport represents the main App Store Server API transport layer.
+type Transport struct {
+	httpClient   *resty.Client
+	logger       *zap.Logger
+	auth         AuthProvider
+	errorHandler *ErrorHandler
+	baseURL      string
+}
+
+// Ensure Transport implements Client interface.
+var _ Client = (*Transport)(nil)
+
+// NewTransport creates a new HTTP transport for the App Store Server API.
+// This is an internal function - users should use appstoreserver.NewClient() instead.
+func NewTransport(keyID, issuerID, bundleID string, privateKey any, options ...ClientOption) (*Transport, error) {
+	if keyID == "" {
+		return nil, fmt.Errorf("keyID is required")
+	}
+	if issuerID == "" {
+		return nil, fmt.Errorf("issuerID is required")
+	}
+	if bundleID == "" {
+		return nil, fmt.Errorf("bundleID is required")
+	}
+	if privateKey == nil {
+		return nil, fmt.Errorf("privateKey is required")
+	}
+
+	logger := zap.NewNop()
+
+	ecKey, _ := privateKey.(*ecdsa.PrivateKey)
+	auth := NewJWTAuth(JWTAuthConfig{
+		KeyID:      keyID,
+		IssuerID:   issuerID,
+		BundleID:   bundleID,
+		PrivateKey: ecKey,
+		Audience:   constants.DefaultJWTAudience,
+	})
+
+	httpClient := resty.New()
+	httpClient.
+		SetBaseURL(constants.DefaultBaseURL).
+		SetTimeout(30*time.Second).
+		SetRetryCount(3).
+		SetRetryWaitTime(1*time.Second).
+		SetRetryMaxWaitTime(10*time.Second).
+		SetHeader("User-Agent", DefaultUserAgent)
+
+	errorHandler := NewErrorHandler(logger)
+
+	transport := &Transport{
+		httpClient:   httpClient,
+		logger:       logger,
+		auth:         auth,
+		errorHandler: errorHandler,
+		baseURL:      constants.DefaultBaseURL,
+	}
+
+	for _, option := range options {
+		if err := option(transport); err != nil {
+			return nil, fmt.Errorf("failed to apply client option: %w", err)
+		}
+	}
+
+	// The key only matters when the built-in JWT auth is in use; WithAuth
+	// replaces it entirely.
+	if transport.auth == AuthProvider(auth) {
+		if err := ValidatePrivateKey(privateKey); err != nil {
+			return nil, err
+		}
+	}
+
+	httpClient.AddRequestMiddleware(func(c *resty.Client, req *resty.Request) error {
+		if err := transport.auth.ApplyAuth(req); err != nil {
+			return fmt.Errorf("auth failed: %w", err)
+		}
+
+		transport.logger.Info("API request",
+			zap.String("method", req.Method),
+			zap.String("url", req.URL),
+		)
+
+		return nil
+	})
+
+	httpClient.AddResponseMiddleware(func(c *resty.Client, resp *resty.Response) error {
+		transport.logger.Info("API response",
+			zap.String("method", resp.Request.Method),
+			zap.String("url", resp.Request.URL),
+			zap.Int("status_code", resp.StatusCode()),
+			zap.String("status", resp.Status()),
+		)
+
+		if resp.StatusCode() == 401 {
+			if jwtAuth, ok := transport.auth.(*JWTAuth); ok && jwtAuth.invalidate(resp.Request.AuthToken) {
+				transport.logger.Info("Received 401 response, forcing JWT token refresh")
+			}
+		}
+
+		return nil
+	})
+
+	transport.logger.Info("App Store Server API client created",
+		zap.String("issuer_id", issuerID),
+		zap.String("bundle_id", bundleID),
+		zap.String("base_url", transport.baseURL))
+
+	return transport, nil
+}
+
+// NewRequest returns a new RequestBuilder for constructing API requests.
+func (t *Transport) NewRequest(ctx context.Context) *RequestBuilder {
+	return &RequestBuilder{
+		req:      t.httpClient.R().SetContext(ctx),
+		executor: t,
+	}
+}
+
+// QueryBuilder returns a new query builder instance.
+func (t *Transport) QueryBuilder() *QueryBuilder {
+	return NewQueryBuilder()
+}
+
+// GetLogger returns the configured logger.
+func (t *Transport) GetLogger() *zap.Logger {
+	return t.logger
+}
+
+// GetHTTPClient returns the underlying HTTP client for testing purposes.
+func (t *Transport) GetHTTPClient() *resty.Client {
+	return t.httpClient
+}
+
+// Close closes the HTTP client and cleans up resources.
+func (t *Transport) Close() error {
+	if t.httpClient != nil {
+		t.httpClient.Close()
+	}
+	return nil
+}
+
+// execute implements requestExecutor — handles all HTTP method routing and error processing.
+func (t *Transport) execute(req *resty.Request, method, path string, result any) (*resty.Response, error) {
+	var apiErr ErrorResponse
+	req.SetResultError(&apiErr)
+
+	if result != nil {
+		req.SetResult(result)
+	}
+
+	var resp *resty.Response
+	var err error
+
+	switch method {
+	case "GET":
+		resp, err = req.Get(path)
+	case "POST":
+		resp, err = req.Post(path)
+	case "PUT":
+		resp, err = req.Put(path)
+	default:
+		return nil, fmt.Errorf("unsupported HTTP method: %s", method)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	if resp.IsStatusFailure() {
+		return resp, t.errorHandler.HandleError(resp, &apiErr)
+	}
+
+	return resp, nil
+}
+
+// NewTransportFromEnv creates a transport using environment variables.
+// Requires APPLE_KEY_ID, APPLE_ISSUER_ID and APPLE_BUNDLE_ID plus exactly one of:
+//   - APPLE_PRIVATE_KEY_PEM  — PEM-encoded private key supplied inline
+//   - APPLE_PRIVATE_KEY_PATH — path to a PEM private key file
+func NewTransportFromEnv(options ...ClientOption) (*Transport, error) {
+	keyID := os.Getenv("APPLE_KEY_ID")
+	issuerID := os.Getenv("APPLE_ISSUER_ID")
+	bundleID := os.Getenv("APPLE_BUNDLE_ID")
+	privateKeyPEM := os.Getenv("APPLE_PRIVATE_KEY_PEM")
+	privateKeyPath := os.Getenv("APPLE_PRIVATE_KEY_PATH")
+
+	if keyID == "" {
+		return nil, fmt.Errorf("APPLE_KEY_ID environment variable is required")
+	}
+	if issuerID == "" {
+		return nil, fmt.Errorf("APPLE_ISSUER_ID environment variable is required")
+	}
+	if bundleID == "" {
+		return nil, fmt.Errorf("APPLE_BUNDLE_ID environment variable is required")
+	}
+
+	var privateKey any
+	var err error
+
+	switch {
+	case privateKeyPEM != "":
+		privateKey, err = ParsePrivateKey([]byte(privateKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse APPLE_PRIVATE_KEY_PEM: %w", err)
+		}
+	case privateKeyPath != "":
+		privateKey, err = LoadPrivateKeyFromFile(privateKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load private key from APPLE_PRIVATE_KEY_PATH: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("either APPLE_PRIVATE_KEY_PEM or APPLE_PRIVATE_KEY_PATH environment variable is required")
+	}
+
+	return NewTransport(keyID, issuerID, bundleID, privateKey, options...)
+}
+
+// NewTransportFromFile creates a transport using credentials from files.
+func NewTransportFromFile(keyID, issuerID, bundleID, privateKeyPath string, options ...ClientOption) (*Transport, error) {
+	if keyID == "" {
+		return nil, fmt.Errorf("keyID is required")
+	}
+	if issuerID == "" {
+		return nil, fmt.Errorf("issuerID is required")
+	}
+	if privateKeyPath == "" {
+		return nil, fmt.Errorf("privateKeyPath is required")
+	}
+
+	privateKey, err := LoadPrivateKeyFromFile(privateKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load private key: %w", err)
+	}
+
+	return NewTransport(keyID, issuerID, bundleID, privateKey, options...)
+}
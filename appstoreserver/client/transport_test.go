@@ -0,0 +1,227 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jarcoal/httpmock"
+	"go.uber.org/zap"
+)
+
+func newTestKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	return key
+}
+
+func TestNewTransport_Success(t *testing.T) {
+	c, err := NewTransport("test-key-id", "test-issuer-id", "com.example.app", newTestKey(t))
+	if err != nil {
+		t.Fatalf("NewTransport failed: %v", err)
+	}
+
+	if c.baseURL != DefaultBaseURL {
+		t.Errorf("baseURL = %v, want %v", c.baseURL, DefaultBaseURL)
+	}
+	if c.httpClient.BaseURL() != DefaultBaseURL {
+		t.Errorf("http client base URL = %v, want %v", c.httpClient.BaseURL(), DefaultBaseURL)
+	}
+}
+
+func TestNewTransport_RequiredArguments(t *testing.T) {
+	key := newTestKey(t)
+
+	tests := []struct {
+		name                      string
+		keyID, issuerID, bundleID string
+		privateKey                any
+		wantErr                   string
+	}{
+		{"missing key ID", "", "issuer", "com.example.app", key, "keyID is required"},
+		{"missing issuer ID", "kid", "", "com.example.app", key, "issuerID is required"},
+		{"missing bundle ID", "kid", "issuer", "", key, "bundleID is required"},
+		{"missing key", "kid", "issuer", "com.example.app", nil, "privateKey is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewTransport(tt.keyID, tt.issuerID, tt.bundleID, tt.privateKey)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewTransport_RejectsNonP256Keys(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	if _, err := NewTransport("kid", "issuer", "com.example.app", rsaKey); err == nil {
+		t.Error("expected RSA key to be rejected")
+	}
+
+	p384, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	if _, err := NewTransport("kid", "issuer", "com.example.app", p384); err == nil {
+		t.Error("expected P-384 key to be rejected")
+	}
+}
+
+func TestWithSandbox(t *testing.T) {
+	c, err := NewTransport("kid", "issuer", "com.example.app", newTestKey(t), WithSandbox())
+	if err != nil {
+		t.Fatalf("NewTransport failed: %v", err)
+	}
+
+	if c.httpClient.BaseURL() != SandboxBaseURL {
+		t.Errorf("http client base URL = %v, want %v", c.httpClient.BaseURL(), SandboxBaseURL)
+	}
+}
+
+func TestJWTAuth_Claims(t *testing.T) {
+	key := newTestKey(t)
+
+	c, err := NewTransport("ABC123DEFG", "57246542-96fe-1a63-e053-0824d011072a", "com.example.app", key,
+		WithLogger(zap.NewNop()),
+		WithRetryCount(0),
+	)
+	if err != nil {
+		t.Fatalf("NewTransport failed: %v", err)
+	}
+
+	httpmock.ActivateNonDefault(c.GetHTTPClient().Client())
+	defer httpmock.DeactivateAndReset()
+
+	var authHeader string
+	httpmock.RegisterResponder("GET", DefaultBaseURL+"/inApps/v1/transactions/1",
+		func(req *http.Request) (*http.Response, error) {
+			authHeader = req.Header.Get("Authorization")
+			resp := httpmock.NewStringResponse(200, `{}`)
+			resp.Header.Set("Content-Type", "application/json")
+			return resp, nil
+		})
+
+	if _, err := c.NewRequest(context.Background()).Get("/inApps/v1/transactions/1"); err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+
+	raw, ok := strings.CutPrefix(authHeader, "Bearer ")
+	if !ok {
+		t.Fatalf("Authorization = %q, want a bearer token", authHeader)
+	}
+
+	claims := jwt.MapClaims{}
+	token, err := jwt.ParseWithClaims(raw, claims, func(*jwt.Token) (any, error) { return &key.PublicKey, nil })
+	if err != nil {
+		t.Fatalf("token does not verify: %v", err)
+	}
+
+	if token.Method != jwt.SigningMethodES256 {
+		t.Errorf("alg = %v, want ES256", token.Method.Alg())
+	}
+	if token.Header["kid"] != "ABC123DEFG" {
+		t.Errorf("kid = %v", token.Header["kid"])
+	}
+	if claims["bid"] != "com.example.app" {
+		t.Errorf("bid = %v", claims["bid"])
+	}
+	if claims["aud"] != DefaultJWTAudience {
+		t.Errorf("aud = %v", claims["aud"])
+	}
+	if claims["iss"] != "57246542-96fe-1a63-e053-0824d011072a" {
+		t.Errorf("iss = %v", claims["iss"])
+	}
+}
+
+func TestHandleError_StructuredError(t *testing.T) {
+	c, err := NewTransport("kid", "issuer", "com.example.app", newTestKey(t), WithRetryCount(0))
+	if err != nil {
+		t.Fatalf("NewTransport failed: %v", err)
+	}
+
+	httpmock.ActivateNonDefault(c.GetHTTPClient().Client())
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", DefaultBaseURL+"/inApps/v1/transactions/404",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(404, `{"errorCode": 4040010, "errorMessage": "Transaction id not found."}`)
+			resp.Header.Set("Content-Type", "application/json")
+			return resp, nil
+		})
+
+	_, err = c.NewRequest(context.Background()).Get("/inApps/v1/transactions/404")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if !IsAPIError(err, ErrorCodeTransactionIDNotFound) {
+		t.Errorf("IsAPIError(%v, TransactionIDNotFound) = false", err)
+	}
+	if !strings.Contains(err.Error(), "Transaction id not found.") {
+		t.Errorf("error = %v", err)
+	}
+}
+
+func TestTransport_ConcurrentTokenRefresh(t *testing.T) {
+	c, err := NewTransport("ABC123DEFG", "issuer", "com.example.app", newTestKey(t), WithLogger(zap.NewNop()), WithRetryCount(0))
+	if err != nil {
+		t.Fatalf("NewTransport failed: %v", err)
+	}
+	httpmock.ActivateNonDefault(c.GetHTTPClient().Client())
+	t.Cleanup(httpmock.DeactivateAndReset)
+
+	server := &rejectFirstToken{}
+	httpmock.RegisterResponder("GET", DefaultBaseURL+"/inApps/v1/transactions/1", server.respond)
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Go(func() {
+			_, _ = c.NewRequest(context.Background()).Get("/inApps/v1/transactions/1")
+		})
+	}
+	wg.Wait()
+	if _, err := c.NewRequest(context.Background()).Get("/inApps/v1/transactions/1"); err != nil {
+		t.Fatalf("request after refresh failed: %v", err)
+	}
+
+	// Requests rejected with the first token share one replacement rather
+	// than each discarding the one another has just signed.
+	if len(server.tokens) != 2 {
+		t.Errorf("distinct tokens = %d, want 2", len(server.tokens))
+	}
+}
+
+// rejectFirstToken answers 401 to requests bearing the first token it sees
+// and 200 to any other, recording each distinct token.
+type rejectFirstToken struct {
+	mu     sync.Mutex
+	tokens []string
+}
+
+func (r *rejectFirstToken) respond(req *http.Request) (*http.Response, error) {
+	token := req.Header.Get("Authorization")
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !slices.Contains(r.tokens, token) {
+		r.tokens = append(r.tokens, token)
+	}
+	if token == r.tokens[0] {
+		return httpmock.NewStringResponse(401, `{"errors":[{"status":"401","code":"NOT_AUTHORIZED"}]}`), nil
+	}
+	return httpmock.NewStringResponse(200, `{}`), nil
+}
@@ -0,0 +1,6 @@
+package constants
+
+// JWT configuration for App Store Server API requests
+const (
+	DefaultJWTAudience = "appstoreconnect-v1"
+)
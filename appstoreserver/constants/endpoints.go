@@ -0,0 +1,17 @@
+package constants
+
+// API base URLs
+const (
+	// DefaultBaseURL is the base URL for the production App Store Server API.
+	DefaultBaseURL = "https://api.storekit.itunes.apple.com"
+	// SandboxBaseURL is the base URL for sandbox and TestFlight purchases.
+	SandboxBaseURL = "https://api.storekit-sandbox.itunes.apple.com"
+)
+
+// Endpoint path constants for the App Store Server API
+const (
+	EndpointTransactionHistoryV2 = "/inApps/v2/history"
+	EndpointTransactionsV1       = "/inApps/v1/transactions"
+	EndpointSubscriptionsV1      = "/inApps/v1/subscriptions"
+	EndpointOrderLookupV1        = "/inApps/v1/lookup"
+)
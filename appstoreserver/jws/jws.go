@@ -0,0 +1,68 @@
+// Package jws decodes the JSON Web Signature (JWS) values the App Store
+// signs — transactions, subscription renewal info and server notifications —
+// into typed structs.
+//
+// Decode functions only parse the payload; they do not check the signature.
+// Use them on data received directly from the App Store Server API over
+// TLS, where the transport already authenticates Apple as the sender.
+// Data that reaches the server any other way, such as App Store Server
+// Notifications or transactions forwarded by the app, must go through a
+// Verifier, which checks the signature and certificate chain against the
+// Apple root certificates first.
+package jws
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Timestamp is a time in milliseconds since the Unix epoch, as used
+// throughout App Store signed data.
+type Timestamp int64
+
+// Time returns t as a time.Time in UTC, or the zero time when t is 0.
+func (t Timestamp) Time() time.Time {
+	if t == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(int64(t)).UTC()
+}
+
+// DecodeTransaction decodes a signedTransactionInfo value without verifying
+// its signature.
+func DecodeTransaction(signed string) (*Transaction, error) {
+	var t Transaction
+	if err := decodePayload(signed, &t); err != nil {
+		return nil, fmt.Errorf("jws: transaction: %w", err)
+	}
+	return &t, nil
+}
+
+// DecodeRenewalInfo decodes a signedRenewalInfo value without verifying its
+// signature.
+func DecodeRenewalInfo(signed string) (*RenewalInfo, error) {
+	var r RenewalInfo
+	if err := decodePayload(signed, &r); err != nil {
+		return nil, fmt.Errorf("jws: renewal info: %w", err)
+	}
+	return &r, nil
+}
+
+// decodePayload unmarshals the payload segment of a compact JWS into v.
+func decodePayload(signed string, v any) error {
+	parts := strings.Split(signed, ".")
+	if len(parts) != 3 {
+		return fmt.Errorf("expected 3 segments, have %d", len(parts))
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return fmt.Errorf("invalid payload encoding: %w", err)
+	}
+	if err := json.Unmarshal(payload, v); err != nil {
+		return fmt.Errorf("invalid payload: %w", err)
+	}
+	return nil
+}
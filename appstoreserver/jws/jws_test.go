@@ -0,0 +1,76 @@
+package jws
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// compact builds an unsigned compact JWS carrying payload.
+func compact(payload string) string {
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString([]byte(`{"alg":"ES256"}`)) + "." + enc.EncodeToString([]byte(payload)) + ".c2ln"
+}
+
+func TestDecodeTransaction(t *testing.T) {
+	signed := compact(`{
+		"transactionId": "2000000123456789",
+		"originalTransactionId": "2000000012345678",
+		"bundleId": "com.example.app",
+		"productId": "com.example.app.monthly",
+		"subscriptionGroupIdentifier": "21345678",
+		"purchaseDate": 1727740800000,
+		"expiresDate": 1730419200000,
+		"quantity": 1,
+		"type": "Auto-Renewable Subscription",
+		"inAppOwnershipType": "PURCHASED",
+		"environment": "Sandbox",
+		"transactionReason": "RENEWAL",
+		"currency": "USD",
+		"price": 4990
+	}`)
+
+	tx, err := DecodeTransaction(signed)
+
+	require.NoError(t, err)
+	assert.Equal(t, "2000000123456789", tx.TransactionID)
+	assert.Equal(t, TypeAutoRenewableSubscription, tx.Type)
+	assert.Equal(t, EnvironmentSandbox, tx.Environment)
+	assert.Equal(t, time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC), tx.PurchaseDate.Time())
+	assert.Equal(t, int64(4990), tx.Price)
+	assert.False(t, tx.Revoked())
+	assert.True(t, tx.RevocationDate.Time().IsZero())
+}
+
+func TestDecodeRenewalInfo(t *testing.T) {
+	info, err := DecodeRenewalInfo(compact(`{
+		"originalTransactionId": "2000000012345678",
+		"productId": "com.example.app.monthly",
+		"autoRenewProductId": "com.example.app.yearly",
+		"autoRenewStatus": 1,
+		"isInBillingRetryPeriod": false,
+		"eligibleWinBackOfferIds": ["winback1"]
+	}`))
+
+	require.NoError(t, err)
+	assert.True(t, info.AutoRenews())
+	assert.Equal(t, "com.example.app.yearly", info.AutoRenewProductID)
+	assert.Equal(t, []string{"winback1"}, info.EligibleWinBackOfferIDs)
+}
+
+func TestDecode_Malformed(t *testing.T) {
+	_, err := DecodeTransaction("not-a-jws")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "expected 3 segments")
+
+	_, err = DecodeTransaction("a.!!!.c")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid payload encoding")
+
+	_, err = DecodeRenewalInfo(compact(`[]`))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "jws: renewal info: invalid payload")
+}
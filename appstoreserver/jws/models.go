@@ -0,0 +1,110 @@
+package jws
+
+// Environment values reported in signed data.
+const (
+	EnvironmentProduction = "Production"
+	EnvironmentSandbox    = "Sandbox"
+)
+
+// Transaction types reported in Transaction.Type.
+const (
+	TypeAutoRenewableSubscription = "Auto-Renewable Subscription"
+	TypeNonConsumable             = "Non-Consumable"
+	TypeConsumable                = "Consumable"
+	TypeNonRenewingSubscription   = "Non-Renewing Subscription"
+)
+
+// Ownership types reported in Transaction.InAppOwnershipType.
+const (
+	OwnershipPurchased    = "PURCHASED"
+	OwnershipFamilyShared = "FAMILY_SHARED"
+)
+
+// Transaction reasons reported in Transaction.TransactionReason.
+const (
+	TransactionReasonPurchase = "PURCHASE"
+	TransactionReasonRenewal  = "RENEWAL"
+)
+
+// Transaction is the decoded payload of a signed transaction
+// (JWSTransactionDecodedPayload).
+type Transaction struct {
+	TransactionID               string    `json:"transactionId"`
+	OriginalTransactionID       string    `json:"originalTransactionId"`
+	WebOrderLineItemID          string    `json:"webOrderLineItemId,omitempty"`
+	BundleID                    string    `json:"bundleId"`
+	ProductID                   string    `json:"productId"`
+	SubscriptionGroupIdentifier string    `json:"subscriptionGroupIdentifier,omitempty"`
+	PurchaseDate                Timestamp `json:"purchaseDate"`
+	OriginalPurchaseDate        Timestamp `json:"originalPurchaseDate"`
+	// ExpiresDate is set for auto-renewable subscriptions.
+	ExpiresDate Timestamp `json:"expiresDate,omitempty"`
+	Quantity    int       `json:"quantity"`
+	// Type is one of the Type constants.
+	Type string `json:"type"`
+	// AppAccountToken is the UUID the app associated with the purchase, if any.
+	AppAccountToken string `json:"appAccountToken,omitempty"`
+	// InAppOwnershipType is OwnershipPurchased or OwnershipFamilyShared.
+	InAppOwnershipType string    `json:"inAppOwnershipType"`
+	SignedDate         Timestamp `json:"signedDate"`
+	// RevocationReason and RevocationDate are set when Apple refunded or
+	// revoked the transaction.
+	RevocationReason *int      `json:"revocationReason,omitempty"`
+	RevocationDate   Timestamp `json:"revocationDate,omitempty"`
+	IsUpgraded       bool      `json:"isUpgraded,omitempty"`
+	OfferType        int       `json:"offerType,omitempty"`
+	OfferIdentifier  string    `json:"offerIdentifier,omitempty"`
+	// Environment is EnvironmentProduction or EnvironmentSandbox.
+	Environment       string `json:"environment"`
+	Storefront        string `json:"storefront,omitempty"`
+	StorefrontID      string `json:"storefrontId,omitempty"`
+	TransactionReason string `json:"transactionReason,omitempty"`
+	Currency          string `json:"currency,omitempty"`
+	// Price is in milliunits of Currency, e.g. 990 for 0.99.
+	Price             int64  `json:"price,omitempty"`
+	OfferDiscountType string `json:"offerDiscountType,omitempty"`
+	AppTransactionID  string `json:"appTransactionId,omitempty"`
+	OfferPeriod       string `json:"offerPeriod,omitempty"`
+}
+
+// Revoked reports whether Apple refunded or revoked the transaction.
+func (t *Transaction) Revoked() bool {
+	return t.RevocationDate != 0
+}
+
+// RenewalInfo is the decoded payload of signed subscription renewal info
+// (JWSRenewalInfoDecodedPayload).
+type RenewalInfo struct {
+	OriginalTransactionID string `json:"originalTransactionId"`
+	// ProductID is the product of the current period; AutoRenewProductID the
+	// product the subscription renews to.
+	ProductID          string `json:"productId"`
+	AutoRenewProductID string `json:"autoRenewProductId"`
+	// AutoRenewStatus is 1 when the subscription renews automatically.
+	AutoRenewStatus int `json:"autoRenewStatus"`
+	// ExpirationIntent is the reason the subscription expired, if it did.
+	ExpirationIntent       int       `json:"expirationIntent,omitempty"`
+	IsInBillingRetryPeriod bool      `json:"isInBillingRetryPeriod,omitempty"`
+	PriceIncreaseStatus    *int      `json:"priceIncreaseStatus,omitempty"`
+	GracePeriodExpiresDate Timestamp `json:"gracePeriodExpiresDate,omitempty"`
+	OfferType              int       `json:"offerType,omitempty"`
+	OfferIdentifier        string    `json:"offerIdentifier,omitempty"`
+	SignedDate             Timestamp `json:"signedDate"`
+	// Environment is EnvironmentProduction or EnvironmentSandbox.
+	Environment                 string    `json:"environment"`
+	RecentSubscriptionStartDate Timestamp `json:"recentSubscriptionStartDate,omitempty"`
+	RenewalDate                 Timestamp `json:"renewalDate,omitempty"`
+	Currency                    string    `json:"currency,omitempty"`
+	// RenewalPrice is in milliunits of Currency.
+	RenewalPrice            int64    `json:"renewalPrice,omitempty"`
+	OfferDiscountType       string   `json:"offerDiscountType,omitempty"`
+	EligibleWinBackOfferIDs []string `json:"eligibleWinBackOfferIds,omitempty"`
+	AppTransactionID        string   `json:"appTransactionId,omitempty"`
+	OfferPeriod             string   `json:"offerPeriod,omitempty"`
+	AppAccountToken         string   `json:"appAccountToken,omitempty"`
+}
+
+// AutoRenews reports whether the subscription is set to renew.
+func (r *RenewalInfo) AutoRenews() bool {
+	return r.AutoRenewStatus == 1
+}
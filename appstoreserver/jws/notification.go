@@ -0,0 +1,130 @@
+package jws
+
+// Notification types reported in Notification.NotificationType.
+const (
+	NotificationTypeConsumptionRequest     = "CONSUMPTION_REQUEST"
+	NotificationTypeDidChangeRenewalPref   = "DID_CHANGE_RENEWAL_PREF"
+	NotificationTypeDidChangeRenewalStatus = "DID_CHANGE_RENEWAL_STATUS"
+	NotificationTypeDidFailToRenew         = "DID_FAIL_TO_RENEW"
+	NotificationTypeDidRenew               = "DID_RENEW"
+	NotificationTypeExpired                = "EXPIRED"
+	NotificationTypeExternalPurchaseToken  = "EXTERNAL_PURCHASE_TOKEN"
+	NotificationTypeGracePeriodExpired     = "GRACE_PERIOD_EXPIRED"
+	NotificationTypeOfferRedeemed          = "OFFER_REDEEMED"
+	NotificationTypeOneTimeCharge          = "ONE_TIME_CHARGE"
+	NotificationTypePriceIncrease          = "PRICE_INCREASE"
+	NotificationTypeRefund                 = "REFUND"
+	NotificationTypeRefundDeclined         = "REFUND_DECLINED"
+	NotificationTypeRefundReversed         = "REFUND_REVERSED"
+	NotificationTypeRenewalExtended        = "RENEWAL_EXTENDED"
+	NotificationTypeRenewalExtension       = "RENEWAL_EXTENSION"
+	NotificationTypeRevoke                 = "REVOKE"
+	NotificationTypeSubscribed             = "SUBSCRIBED"
+	NotificationTypeTest                   = "TEST"
+)
+
+// Notification subtypes reported in Notification.Subtype.
+const (
+	SubtypeInitialBuy        = "INITIAL_BUY"
+	SubtypeResubscribe       = "RESUBSCRIBE"
+	SubtypeDowngrade         = "DOWNGRADE"
+	SubtypeUpgrade           = "UPGRADE"
+	SubtypeAutoRenewEnabled  = "AUTO_RENEW_ENABLED"
+	SubtypeAutoRenewDisabled = "AUTO_RENEW_DISABLED"
+	SubtypeVoluntary         = "VOLUNTARY"
+	SubtypeBillingRetry      = "BILLING_RETRY"
+	SubtypePriceIncrease     = "PRICE_INCREASE"
+	SubtypeGracePeriod       = "GRACE_PERIOD"
+	SubtypeBillingRecovery   = "BILLING_RECOVERY"
+	SubtypePending           = "PENDING"
+	SubtypeAccepted          = "ACCEPTED"
+	SubtypeProductNotForSale = "PRODUCT_NOT_FOR_SALE"
+	SubtypeSummary           = "SUMMARY"
+	SubtypeFailure           = "FAILURE"
+	SubtypeUnreported        = "UNREPORTED"
+)
+
+// NotificationRequest is the JSON body the App Store POSTs to the server
+// notification URL (responseBodyV2).
+type NotificationRequest struct {
+	SignedPayload string `json:"signedPayload"`
+}
+
+// Notification is the decoded payload of an App Store Server Notification
+// V2 (responseBodyV2DecodedPayload).
+//
+// The App Store retries delivery until the server answers with HTTP 200, and
+// does not guarantee order. Use NotificationUUID to drop redelivered
+// notifications and SignedDate to order them.
+type Notification struct {
+	// NotificationType is one of the NotificationType constants.
+	NotificationType string `json:"notificationType"`
+	// Subtype is one of the Subtype constants, or empty.
+	Subtype string `json:"subtype,omitempty"`
+	// NotificationUUID identifies the notification; it is the same on every
+	// delivery attempt.
+	NotificationUUID string `json:"notificationUUID"`
+	Version          string `json:"version"`
+	// SignedDate is when the App Store signed the notification.
+	SignedDate Timestamp `json:"signedDate"`
+	// Data is set for notifications about a transaction or subscription.
+	Data NotificationData `json:"data"`
+	// Summary is set instead of Data for RENEWAL_EXTENSION notifications with
+	// the SUMMARY subtype.
+	Summary *NotificationSummary `json:"summary,omitempty"`
+	// ExternalPurchaseToken is set for EXTERNAL_PURCHASE_TOKEN notifications.
+	ExternalPurchaseToken *ExternalPurchaseToken `json:"externalPurchaseToken,omitempty"`
+}
+
+// NotificationData is the app and transaction data of a notification.
+type NotificationData struct {
+	AppAppleID            int64  `json:"appAppleId,omitempty"`
+	BundleID              string `json:"bundleId"`
+	BundleVersion         string `json:"bundleVersion,omitempty"`
+	Environment           string `json:"environment"`
+	SignedTransactionInfo string `json:"signedTransactionInfo,omitempty"`
+	SignedRenewalInfo     string `json:"signedRenewalInfo,omitempty"`
+	// Status is the subscription status, as in the subscriptions service.
+	Status                   int    `json:"status,omitempty"`
+	ConsumptionRequestReason string `json:"consumptionRequestReason,omitempty"`
+}
+
+// Transaction decodes the notification's signed transaction. It is covered by
+// the notification's verified signature, so it is not verified again.
+// It returns nil when the notification carries no transaction.
+func (d *NotificationData) Transaction() (*Transaction, error) {
+	if d.SignedTransactionInfo == "" {
+		return nil, nil
+	}
+	return DecodeTransaction(d.SignedTransactionInfo)
+}
+
+// RenewalInfo decodes the notification's signed renewal info. It returns nil
+// when the notification carries none.
+func (d *NotificationData) RenewalInfo() (*RenewalInfo, error) {
+	if d.SignedRenewalInfo == "" {
+		return nil, nil
+	}
+	return DecodeRenewalInfo(d.SignedRenewalInfo)
+}
+
+// NotificationSummary describes the outcome of a mass subscription renewal
+// date extension.
+type NotificationSummary struct {
+	RequestIdentifier      string   `json:"requestIdentifier"`
+	Environment            string   `json:"environment"`
+	AppAppleID             int64    `json:"appAppleId,omitempty"`
+	BundleID               string   `json:"bundleId"`
+	ProductID              string   `json:"productId"`
+	StorefrontCountryCodes []string `json:"storefrontCountryCodes,omitempty"`
+	SucceededCount         int64    `json:"succeededCount"`
+	FailedCount            int64    `json:"failedCount"`
+}
+
+// ExternalPurchaseToken identifies a purchase made outside the App Store.
+type ExternalPurchaseToken struct {
+	ExternalPurchaseID string    `json:"externalPurchaseId"`
+	TokenCreationDate  Timestamp `json:"tokenCreationDate"`
+	AppAppleID         int64     `json:"appAppleId,omitempty"`
+	BundleID           string    `json:"bundleId"`
+}
@@ -0,0 +1,294 @@
+package jws
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	_ "embed"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// Marker extensions Apple sets on the certificates it uses to sign App Store
+// data. The leaf must carry oidAppStoreSigning and the intermediate
+// oidAppleWWDRIntermediate, so that a certificate merely issued under an
+// Apple root cannot sign notifications.
+var (
+	oidAppStoreSigning       = asn1.ObjectIdentifier{1, 2, 840, 113635, 100, 6, 11, 1}
+	oidAppleWWDRIntermediate = asn1.ObjectIdentifier{1, 2, 840, 113635, 100, 6, 2, 1}
+)
+
+// appleRootCAG3 is Apple Root CA - G3 in DER form, as published at
+// https://www.apple.com/certificateauthority/AppleRootCA-G3.cer. Its SHA-256
+// fingerprint is 63343ABFB89A6A03EBB57E9B3F5FA7BE7C4F5C756F3017B3A8C488C3653E9179.
+//
+//go:embed AppleRootCA-G3.cer
+var appleRootCAG3 []byte
+
+// AppleRootCAG3 returns the Apple Root CA - G3 certificate, under which App
+// Store data is signed. NewVerifier trusts it when given no roots.
+func AppleRootCAG3() *x509.Certificate {
+	cert, err := x509.ParseCertificate(appleRootCAG3)
+	if err != nil {
+		panic("jws: parse embedded Apple Root CA - G3: " + err.Error())
+	}
+	return cert
+}
+
+// ErrInvalidSignature is returned (wrapped) when a JWS fails verification.
+var ErrInvalidSignature = errors.New("jws: invalid signature")
+
+// header is the protected header of an App Store JWS.
+type header struct {
+	Alg string   `json:"alg"`
+	X5C []string `json:"x5c"`
+}
+
+// Verifier checks the signature and certificate chain of App Store signed
+// data against a set of trusted Apple root certificates, then decodes it.
+type Verifier struct {
+	roots       *x509.CertPool
+	bundleID    string
+	environment string
+	now         func() time.Time
+}
+
+// VerifierOption configures a Verifier.
+type VerifierOption func(*Verifier)
+
+// WithBundleID rejects data signed for any other bundle ID.
+func WithBundleID(bundleID string) VerifierOption {
+	return func(v *Verifier) { v.bundleID = bundleID }
+}
+
+// WithEnvironment rejects data from any other environment
+// (EnvironmentProduction or EnvironmentSandbox).
+func WithEnvironment(environment string) VerifierOption {
+	return func(v *Verifier) { v.environment = environment }
+}
+
+// WithClock sets the clock used when the signed data carries no signedDate.
+func WithClock(now func() time.Time) VerifierOption {
+	return func(v *Verifier) { v.now = now }
+}
+
+// NewVerifier returns a Verifier that trusts roots, or only Apple Root CA -
+// G3 (AppleRootCAG3) when roots is empty.
+func NewVerifier(roots []*x509.Certificate, opts ...VerifierOption) (*Verifier, error) {
+	if len(roots) == 0 {
+		roots = []*x509.Certificate{AppleRootCAG3()}
+	}
+	pool := x509.NewCertPool()
+	for _, root := range roots {
+		pool.AddCert(root)
+	}
+	v := &Verifier{roots: pool, now: time.Now}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v, nil
+}
+
+// ParseCertificates parses one or more certificates in DER or PEM form, such
+// as the AppleRootCA-G3.cer file.
+func ParseCertificates(data []byte) ([]*x509.Certificate, error) {
+	if !strings.Contains(string(data), "-----BEGIN") {
+		return x509.ParseCertificates(data)
+	}
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("jws: no certificates found")
+	}
+	return certs, nil
+}
+
+// VerifyNotification verifies a notification's signedPayload and decodes it.
+// The signed transaction and renewal info inside are covered by the same
+// signature and can be read with NotificationData.Transaction and
+// NotificationData.RenewalInfo.
+func (v *Verifier) VerifyNotification(signedPayload string) (*Notification, error) {
+	var n Notification
+	if err := v.verify(signedPayload, &n); err != nil {
+		return nil, fmt.Errorf("jws: notification: %w", err)
+	}
+	bundleID, environment := n.Data.BundleID, n.Data.Environment
+	switch {
+	case n.Summary != nil:
+		bundleID, environment = n.Summary.BundleID, n.Summary.Environment
+	case n.ExternalPurchaseToken != nil:
+		bundleID, environment = n.ExternalPurchaseToken.BundleID, EnvironmentProduction
+		if strings.HasPrefix(n.ExternalPurchaseToken.ExternalPurchaseID, "SANDBOX") {
+			environment = EnvironmentSandbox
+		}
+	}
+	if n.NotificationType == NotificationTypeTest && bundleID == "" {
+		return &n, nil
+	}
+	if err := v.checkScope(bundleID, environment); err != nil {
+		return nil, fmt.Errorf("jws: notification: %w", err)
+	}
+	return &n, nil
+}
+
+// VerifyTransaction verifies a signedTransactionInfo value and decodes it.
+func (v *Verifier) VerifyTransaction(signed string) (*Transaction, error) {
+	var t Transaction
+	if err := v.verify(signed, &t); err != nil {
+		return nil, fmt.Errorf("jws: transaction: %w", err)
+	}
+	if err := v.checkScope(t.BundleID, t.Environment); err != nil {
+		return nil, fmt.Errorf("jws: transaction: %w", err)
+	}
+	return &t, nil
+}
+
+// VerifyRenewalInfo verifies a signedRenewalInfo value and decodes it.
+func (v *Verifier) VerifyRenewalInfo(signed string) (*RenewalInfo, error) {
+	var r RenewalInfo
+	if err := v.verify(signed, &r); err != nil {
+		return nil, fmt.Errorf("jws: renewal info: %w", err)
+	}
+	if err := v.checkScope("", r.Environment); err != nil {
+		return nil, fmt.Errorf("jws: renewal info: %w", err)
+	}
+	return &r, nil
+}
+
+// checkScope compares the bundle ID and environment of verified data with
+// the ones the Verifier was restricted to. An empty bundleID is not checked.
+func (v *Verifier) checkScope(bundleID, environment string) error {
+	if v.bundleID != "" && bundleID != "" && bundleID != v.bundleID {
+		return fmt.Errorf("bundle ID %q does not match %q", bundleID, v.bundleID)
+	}
+	if v.environment != "" && environment != v.environment {
+		return fmt.Errorf("environment %q does not match %q", environment, v.environment)
+	}
+	return nil
+}
+
+// verify checks the ES256 signature of signed against the leaf of its x5c
+// chain, checks the chain against the trusted roots as of the payload's
+// signedDate, and unmarshals the payload into v.
+func (v *Verifier) verify(signed string, out any) error {
+	parts := strings.Split(signed, ".")
+	if len(parts) != 3 {
+		return fmt.Errorf("expected 3 segments, have %d", len(parts))
+	}
+
+	rawHeader, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return fmt.Errorf("invalid header encoding: %w", err)
+	}
+	var h header
+	if err := json.Unmarshal(rawHeader, &h); err != nil {
+		return fmt.Errorf("invalid header: %w", err)
+	}
+	if h.Alg != "ES256" {
+		return fmt.Errorf("%w: unsupported algorithm %q", ErrInvalidSignature, h.Alg)
+	}
+	if len(h.X5C) < 2 {
+		return fmt.Errorf("%w: x5c must hold the leaf and intermediate certificates", ErrInvalidSignature)
+	}
+
+	chain := make([]*x509.Certificate, len(h.X5C))
+	for i, encoded := range h.X5C {
+		der, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return fmt.Errorf("%w: x5c[%d]: %v", ErrInvalidSignature, i, err)
+		}
+		if chain[i], err = x509.ParseCertificate(der); err != nil {
+			return fmt.Errorf("%w: x5c[%d]: %v", ErrInvalidSignature, i, err)
+		}
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return fmt.Errorf("invalid payload encoding: %w", err)
+	}
+	var signedAt struct {
+		SignedDate Timestamp `json:"signedDate"`
+	}
+	if err := json.Unmarshal(payload, &signedAt); err != nil {
+		return fmt.Errorf("invalid payload: %w", err)
+	}
+	at := signedAt.SignedDate.Time()
+	if at.IsZero() {
+		at = v.now()
+	}
+
+	if err := v.verifyChain(chain, at); err != nil {
+		return err
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || len(sig) != 64 {
+		return fmt.Errorf("%w: malformed ES256 signature", ErrInvalidSignature)
+	}
+	key, ok := chain[0].PublicKey.(*ecdsa.PublicKey)
+	if !ok {
+		return fmt.Errorf("%w: leaf certificate key is not ECDSA", ErrInvalidSignature)
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	r, s := new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:])
+	if !ecdsa.Verify(key, digest[:], r, s) {
+		return ErrInvalidSignature
+	}
+
+	if err := json.Unmarshal(payload, out); err != nil {
+		return fmt.Errorf("invalid payload: %w", err)
+	}
+	return nil
+}
+
+// verifyChain checks that chain[0] chains to a trusted root through
+// chain[1], and that both carry Apple's App Store marker extensions.
+func (v *Verifier) verifyChain(chain []*x509.Certificate, at time.Time) error {
+	intermediates := x509.NewCertPool()
+	intermediates.AddCert(chain[1])
+	if _, err := chain[0].Verify(x509.VerifyOptions{
+		Roots:         v.roots,
+		Intermediates: intermediates,
+		CurrentTime:   at,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	if !hasExtension(chain[0], oidAppStoreSigning) {
+		return fmt.Errorf("%w: leaf certificate is not an App Store signing certificate", ErrInvalidSignature)
+	}
+	if !hasExtension(chain[1], oidAppleWWDRIntermediate) {
+		return fmt.Errorf("%w: intermediate certificate is not an Apple WWDR certificate", ErrInvalidSignature)
+	}
+	return nil
+}
+
+func hasExtension(cert *x509.Certificate, oid asn1.ObjectIdentifier) bool {
+	for _, ext := range cert.Extensions {
+		if ext.Id.Equal(oid) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,277 @@
+package jws
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testChain is a root, intermediate and leaf shaped like Apple's App Store
+// signing chain.
+type testChain struct {
+	root, intermediate, leaf *x509.Certificate
+	leafKey                  *ecdsa.PrivateKey
+}
+
+func newTestChain(t *testing.T, leafExt, intermediateExt bool) *testChain {
+	t.Helper()
+	notBefore := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	notAfter := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	issue := func(cn string, serial int64, ca bool, ext *asn1.ObjectIdentifier, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		tmpl := &x509.Certificate{
+			SerialNumber:          big.NewInt(serial),
+			Subject:               pkix.Name{CommonName: cn},
+			NotBefore:             notBefore,
+			NotAfter:              notAfter,
+			BasicConstraintsValid: true,
+			IsCA:                  ca,
+			KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		}
+		if ext != nil {
+			tmpl.ExtraExtensions = []pkix.Extension{{Id: *ext, Value: []byte{0x05, 0x00}}}
+		}
+		if parent == nil {
+			parent, parentKey = tmpl, key
+		}
+		der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+		require.NoError(t, err)
+		cert, err := x509.ParseCertificate(der)
+		require.NoError(t, err)
+		return cert, key
+	}
+
+	var leafOID, intermediateOID *asn1.ObjectIdentifier
+	if leafExt {
+		leafOID = &oidAppStoreSigning
+	}
+	if intermediateExt {
+		intermediateOID = &oidAppleWWDRIntermediate
+	}
+	root, rootKey := issue("Test Root CA", 1, true, nil, nil, nil)
+	intermediate, intermediateKey := issue("Test WWDR", 2, true, intermediateOID, root, rootKey)
+	leaf, leafKey := issue("Test App Store Signing", 3, false, leafOID, intermediate, intermediateKey)
+	return &testChain{root: root, intermediate: intermediate, leaf: leaf, leafKey: leafKey}
+}
+
+// sign produces a compact ES256 JWS over payload with the chain in x5c.
+func (c *testChain) sign(t *testing.T, payload any) string {
+	t.Helper()
+	enc := base64.RawURLEncoding
+	x5c := []string{
+		base64.StdEncoding.EncodeToString(c.leaf.Raw),
+		base64.StdEncoding.EncodeToString(c.intermediate.Raw),
+		base64.StdEncoding.EncodeToString(c.root.Raw),
+	}
+	rawHeader, err := json.Marshal(header{Alg: "ES256", X5C: x5c})
+	require.NoError(t, err)
+	rawPayload, err := json.Marshal(payload)
+	require.NoError(t, err)
+
+	signingInput := enc.EncodeToString(rawHeader) + "." + enc.EncodeToString(rawPayload)
+	digest := sha256.Sum256([]byte(signingInput))
+	r, s, err := ecdsa.Sign(rand.Reader, c.leafKey, digest[:])
+	require.NoError(t, err)
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+	return signingInput + "." + enc.EncodeToString(sig)
+}
+
+var signedDate = Timestamp(time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC).UnixMilli())
+
+func testNotification(t *testing.T, chain *testChain) map[string]any {
+	return map[string]any{
+		"notificationType": NotificationTypeDidRenew,
+		"notificationUUID": "002e14d5-51f5-4503-b5a8-c3a1af68eb20",
+		"version":          "2.0",
+		"signedDate":       signedDate,
+		"data": map[string]any{
+			"appAppleId":            1234567890,
+			"bundleId":              "com.example.app",
+			"bundleVersion":         "42",
+			"environment":           EnvironmentSandbox,
+			"status":                1,
+			"signedTransactionInfo": chain.sign(t, map[string]any{"transactionId": "2000000123456789", "bundleId": "com.example.app", "environment": EnvironmentSandbox, "signedDate": signedDate}),
+			"signedRenewalInfo":     chain.sign(t, map[string]any{"autoRenewStatus": 1, "environment": EnvironmentSandbox, "signedDate": signedDate}),
+		},
+	}
+}
+
+func TestVerifyNotification(t *testing.T) {
+	chain := newTestChain(t, true, true)
+	v, err := NewVerifier([]*x509.Certificate{chain.root}, WithBundleID("com.example.app"), WithEnvironment(EnvironmentSandbox))
+	require.NoError(t, err)
+
+	n, err := v.VerifyNotification(chain.sign(t, testNotification(t, chain)))
+
+	require.NoError(t, err)
+	assert.Equal(t, NotificationTypeDidRenew, n.NotificationType)
+	assert.Equal(t, "002e14d5-51f5-4503-b5a8-c3a1af68eb20", n.NotificationUUID)
+	assert.Equal(t, time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC), n.SignedDate.Time())
+	assert.Equal(t, int64(1234567890), n.Data.AppAppleID)
+
+	tx, err := n.Data.Transaction()
+	require.NoError(t, err)
+	assert.Equal(t, "2000000123456789", tx.TransactionID)
+	renewal, err := n.Data.RenewalInfo()
+	require.NoError(t, err)
+	assert.True(t, renewal.AutoRenews())
+
+	tx, err = v.VerifyTransaction(n.Data.SignedTransactionInfo)
+	require.NoError(t, err)
+	assert.Equal(t, "com.example.app", tx.BundleID)
+	_, err = v.VerifyRenewalInfo(n.Data.SignedRenewalInfo)
+	require.NoError(t, err)
+}
+
+func TestVerifyNotification_Rejects(t *testing.T) {
+	chain := newTestChain(t, true, true)
+	signed := chain.sign(t, testNotification(t, chain))
+
+	t.Run("untrusted root", func(t *testing.T) {
+		other := newTestChain(t, true, true)
+		v, err := NewVerifier([]*x509.Certificate{other.root})
+		require.NoError(t, err)
+		_, err = v.VerifyNotification(signed)
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("tampered payload", func(t *testing.T) {
+		v, err := NewVerifier([]*x509.Certificate{chain.root})
+		require.NoError(t, err)
+		parts := strings.Split(signed, ".")
+		other := strings.Split(chain.sign(t, map[string]any{"notificationType": NotificationTypeRefund, "signedDate": signedDate}), ".")
+		_, err = v.VerifyNotification(parts[0] + "." + other[1] + "." + parts[2])
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("leaf without App Store marker", func(t *testing.T) {
+		bad := newTestChain(t, false, true)
+		v, err := NewVerifier([]*x509.Certificate{bad.root})
+		require.NoError(t, err)
+		_, err = v.VerifyNotification(bad.sign(t, testNotification(t, bad)))
+		require.ErrorIs(t, err, ErrInvalidSignature)
+		assert.Contains(t, err.Error(), "not an App Store signing certificate")
+	})
+
+	t.Run("intermediate without WWDR marker", func(t *testing.T) {
+		bad := newTestChain(t, true, false)
+		v, err := NewVerifier([]*x509.Certificate{bad.root})
+		require.NoError(t, err)
+		_, err = v.VerifyNotification(bad.sign(t, testNotification(t, bad)))
+		require.ErrorIs(t, err, ErrInvalidSignature)
+		assert.Contains(t, err.Error(), "not an Apple WWDR certificate")
+	})
+
+	t.Run("signed outside certificate validity", func(t *testing.T) {
+		v, err := NewVerifier([]*x509.Certificate{chain.root})
+		require.NoError(t, err)
+		payload := testNotification(t, chain)
+		payload["signedDate"] = Timestamp(time.Date(2035, 1, 1, 0, 0, 0, 0, time.UTC).UnixMilli())
+		_, err = v.VerifyNotification(chain.sign(t, payload))
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("wrong bundle ID", func(t *testing.T) {
+		v, err := NewVerifier([]*x509.Certificate{chain.root}, WithBundleID("com.example.other"))
+		require.NoError(t, err)
+		_, err = v.VerifyNotification(signed)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "bundle ID")
+	})
+
+	t.Run("wrong environment", func(t *testing.T) {
+		v, err := NewVerifier([]*x509.Certificate{chain.root}, WithEnvironment(EnvironmentProduction))
+		require.NoError(t, err)
+		_, err = v.VerifyNotification(signed)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "environment")
+	})
+
+	t.Run("unsupported algorithm", func(t *testing.T) {
+		v, err := NewVerifier([]*x509.Certificate{chain.root})
+		require.NoError(t, err)
+		parts := strings.Split(signed, ".")
+		none := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`))
+		_, err = v.VerifyNotification(none + "." + parts[1] + ".")
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+}
+
+func TestVerifyNotification_ExternalPurchaseTokenEnvironment(t *testing.T) {
+	chain := newTestChain(t, true, true)
+	v, err := NewVerifier([]*x509.Certificate{chain.root}, WithBundleID("com.example.app"), WithEnvironment(EnvironmentSandbox))
+	require.NoError(t, err)
+
+	payload := map[string]any{
+		"notificationType": NotificationTypeExternalPurchaseToken,
+		"subtype":          SubtypeUnreported,
+		"notificationUUID": "b7b4b0a4-7c3a-4c4b-9d1e-0e1a2b3c4d5e",
+		"signedDate":       signedDate,
+		"externalPurchaseToken": map[string]any{
+			"externalPurchaseId": "SANDBOX_b2ec3a1c",
+			"bundleId":           "com.example.app",
+		},
+	}
+	n, err := v.VerifyNotification(chain.sign(t, payload))
+	require.NoError(t, err)
+	assert.Equal(t, "SANDBOX_b2ec3a1c", n.ExternalPurchaseToken.ExternalPurchaseID)
+
+	payload["externalPurchaseToken"] = map[string]any{"externalPurchaseId": "b2ec3a1c", "bundleId": "com.example.app"}
+	_, err = v.VerifyNotification(chain.sign(t, payload))
+	assert.Error(t, err)
+}
+
+func TestParseCertificates(t *testing.T) {
+	chain := newTestChain(t, true, true)
+
+	certs, err := ParseCertificates(chain.root.Raw)
+	require.NoError(t, err)
+	assert.Equal(t, "Test Root CA", certs[0].Subject.CommonName)
+
+	pemData := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: chain.root.Raw})
+	certs, err = ParseCertificates(pemData)
+	require.NoError(t, err)
+	assert.Len(t, certs, 1)
+}
+
+func TestAppleRootCAG3(t *testing.T) {
+	root := AppleRootCAG3()
+
+	sum := sha256.Sum256(root.Raw)
+	assert.Equal(t, "63343abfb89a6a03ebb57e9b3f5fa7be7c4f5c756f3017b3a8c488c3653e9179", hex.EncodeToString(sum[:]))
+	assert.Equal(t, "Apple Root CA - G3", root.Subject.CommonName)
+	assert.True(t, root.IsCA)
+	require.NoError(t, root.CheckSignatureFrom(root))
+}
+
+func TestNewVerifier_DefaultRoot(t *testing.T) {
+	v, err := NewVerifier(nil)
+	require.NoError(t, err)
+
+	want := x509.NewCertPool()
+	want.AddCert(AppleRootCAG3())
+	assert.True(t, v.roots.Equal(want), "roots should be Apple Root CA - G3 alone")
+
+	chain := newTestChain(t, true, true)
+	_, err = v.VerifyNotification(chain.sign(t, testNotification(t, chain)))
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+}
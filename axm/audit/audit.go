@@ -0,0 +1,341 @@
+// Package audit records every change the SDK makes in Apple Business
+// Manager — device assignments, MDM server and configuration edits, and any
+// other create, update or delete call — as change evidence for audits such
+// as SOC 2.
+//
+// A Recorder attached to a client turns each call into an Entry: who made
+// it, when, what it changed, the devices and MDM server involved, the
+// resulting activity, and whether Apple accepted it. Entries are appended to
+// a Log, either a JSON Lines file or a SQLite table, and Export writes them
+// out as JSON Lines or CSV for an auditor.
+//
+//	log := audit.NewFileLog("/var/log/axm-audit.jsonl")
+//	audit.NewRecorder(log, audit.Options{}).Attach(c)
+//	ctx = audit.WithActor(ctx, "jane@example.com")
+//	c.AXMAPI.DeviceManagement.AssignDevicesV1(ctx, serverID, serials)
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/client"
+)
+
+// Operations recorded for calls that are not device activities. Activity
+// calls record the activity type instead, such as ASSIGN_DEVICES.
+const (
+	OperationCreate  = "CREATE"
+	OperationReplace = "REPLACE"
+	OperationUpdate  = "UPDATE"
+	OperationDelete  = "DELETE"
+)
+
+// Entry records one create, update or delete call.
+type Entry struct {
+	// Time is when the call started, in UTC.
+	Time time.Time `json:"time"`
+
+	// Actor is the person or system the call was made for, from WithActor.
+	Actor string `json:"actor,omitempty"`
+
+	// KeyID is the API key the call was made with.
+	KeyID string `json:"keyId,omitempty"`
+
+	Method string `json:"method"`
+	Path   string `json:"path"`
+
+	// Operation is the activity type for device activities, such as
+	// ASSIGN_DEVICES, and otherwise one of the Operation constants.
+	Operation string `json:"operation"`
+
+	// ResourceType and ResourceID identify the resource that was created,
+	// changed or deleted, such as "mdmServers" and its ID.
+	ResourceType string `json:"resourceType"`
+	ResourceID   string `json:"resourceId,omitempty"`
+
+	// Relationship is the relationship changed, for calls to a
+	// relationships endpoint.
+	Relationship string `json:"relationship,omitempty"`
+
+	// MDMServerID is the MDM server the call assigned devices to or changed.
+	MDMServerID string `json:"mdmServerId,omitempty"`
+
+	// DeviceIDs are the devices the call named.
+	DeviceIDs []string `json:"deviceIds,omitempty"`
+
+	// ActivityID is the device activity the call created.
+	ActivityID string `json:"activityId,omitempty"`
+
+	// Fields are the names of the attributes the call set.
+	Fields []string `json:"fields,omitempty"`
+
+	// Attributes are the attribute values the call set, recorded only with
+	// Options.RecordAttributes.
+	Attributes json.RawMessage `json:"attributes,omitempty"`
+
+	// StatusCode is Apple's response status, or 0 if none was received.
+	StatusCode int `json:"statusCode"`
+
+	// Succeeded reports whether Apple accepted the call.
+	Succeeded bool `json:"succeeded"`
+
+	// Error is why the call failed.
+	Error string `json:"error,omitempty"`
+
+	Attempts   int   `json:"attempts"`
+	DurationMS int64 `json:"durationMs"`
+}
+
+// Filter selects entries. Zero-valued fields match every entry.
+type Filter struct {
+	// Since and Until bound Time; Since is inclusive, Until exclusive.
+	Since time.Time
+	Until time.Time
+
+	Actor       string
+	Operation   string
+	MDMServerID string
+
+	// DeviceID matches entries that named the device.
+	DeviceID string
+
+	// FailedOnly matches only calls Apple did not accept.
+	FailedOnly bool
+}
+
+// Matches reports whether e is selected by f.
+func (f Filter) Matches(e Entry) bool {
+	switch {
+	case !f.Since.IsZero() && e.Time.Before(f.Since):
+		return false
+	case !f.Until.IsZero() && !e.Time.Before(f.Until):
+		return false
+	case f.Actor != "" && e.Actor != f.Actor:
+		return false
+	case f.Operation != "" && e.Operation != f.Operation:
+		return false
+	case f.MDMServerID != "" && e.MDMServerID != f.MDMServerID:
+		return false
+	case f.DeviceID != "" && !slices.Contains(e.DeviceIDs, f.DeviceID):
+		return false
+	case f.FailedOnly && e.Succeeded:
+		return false
+	}
+	return true
+}
+
+// Log stores entries. Implementations only ever append, and must be safe
+// for concurrent use.
+type Log interface {
+	// Append adds e to the log.
+	Append(ctx context.Context, e Entry) error
+
+	// Entries returns the entries matched by f, oldest first.
+	Entries(ctx context.Context, f Filter) ([]Entry, error)
+}
+
+type actorKey struct{}
+
+// WithActor returns a context whose calls are recorded as made for actor,
+// such as the signed-in user or the job that triggered them.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// Actor returns the actor set on ctx by WithActor, or "".
+func Actor(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey{}).(string)
+	return actor
+}
+
+// Options configures a Recorder.
+type Options struct {
+	// RecordAttributes also records the attribute values each call set,
+	// not just their names. Values can include configuration payloads and
+	// certificates, so leave it off unless the log is protected
+	// accordingly.
+	RecordAttributes bool
+
+	// OnError, if set, is called when an entry cannot be appended. The call
+	// itself has already completed, so the error cannot be returned to the
+	// caller; use OnError to alert on gaps in the evidence.
+	OnError func(e Entry, err error)
+}
+
+// Recorder appends an Entry to a Log for every create, update or delete call
+// made through the clients it is attached to.
+type Recorder struct {
+	log  Log
+	opts Options
+}
+
+// NewRecorder returns a Recorder that appends to log.
+func NewRecorder(log Log, opts Options) *Recorder {
+	return &Recorder{log: log, opts: opts}
+}
+
+// Attach records every create, update or delete call made through c.
+func (r *Recorder) Attach(c *axm.Client) {
+	c.OnMutation(r.Record)
+}
+
+// Record appends the entry for a completed call. It is a client.MutationHook,
+// for use with a transport directly.
+func (r *Recorder) Record(ctx context.Context, info client.MutationInfo) {
+	e := NewEntry(ctx, info, r.opts.RecordAttributes)
+	if err := r.log.Append(context.WithoutCancel(ctx), e); err != nil && r.opts.OnError != nil {
+		r.opts.OnError(e, err)
+	}
+}
+
+// NewEntry builds the entry for a completed call, reading the resource,
+// devices, MDM server and activity from its path and JSON:API bodies. With
+// withAttributes, the attribute values sent are recorded as well.
+func NewEntry(ctx context.Context, info client.MutationInfo, withAttributes bool) Entry {
+	e := Entry{
+		Time:       info.StartedAt.UTC(),
+		Actor:      Actor(ctx),
+		KeyID:      info.KeyID,
+		Method:     info.Method,
+		Path:       info.Path,
+		StatusCode: info.StatusCode,
+		Succeeded:  info.Err == nil,
+		Attempts:   info.Attempts,
+		DurationMS: info.Duration.Milliseconds(),
+	}
+	if info.Err != nil {
+		e.Error = info.Err.Error()
+	}
+
+	switch info.Method {
+	case "POST":
+		e.Operation = OperationCreate
+	case "PUT":
+		e.Operation = OperationReplace
+	case "PATCH":
+		e.Operation = OperationUpdate
+	case "DELETE":
+		e.Operation = OperationDelete
+	}
+
+	// Paths are /v1/{type}[/{id}[/relationships/{name}]].
+	segments := strings.Split(strings.Trim(strings.SplitN(info.Path, "?", 2)[0], "/"), "/")
+	if len(segments) > 1 {
+		e.ResourceType = segments[1]
+	}
+	if len(segments) > 2 {
+		e.ResourceID = segments[2]
+	}
+	if len(segments) > 4 && segments[3] == "relationships" {
+		e.Relationship = segments[4]
+	}
+	if e.ResourceType == "mdmServers" {
+		e.MDMServerID = e.ResourceID
+	}
+
+	var req requestDocument
+	if json.Unmarshal(info.RequestBody, &req) == nil {
+		e.applyRequest(req, withAttributes)
+	}
+
+	var resp responseDocument
+	if json.Unmarshal(info.ResponseBody, &resp) == nil && resp.Data.ID != "" {
+		if e.ResourceID == "" {
+			e.ResourceID = resp.Data.ID
+		}
+		if resp.Data.Type == "orgDeviceActivities" {
+			e.ActivityID = resp.Data.ID
+		}
+	}
+	return e
+}
+
+// requestDocument is a JSON:API request body. Data is a resource object for
+// create and update calls, or an array of linkages for relationship calls.
+type requestDocument struct {
+	Data json.RawMessage `json:"data"`
+}
+
+type resourceObject struct {
+	Type          string                     `json:"type"`
+	ID            string                     `json:"id"`
+	Attributes    json.RawMessage            `json:"attributes"`
+	Relationships map[string]json.RawMessage `json:"relationships"`
+}
+
+type linkage struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+type responseDocument struct {
+	Data linkage `json:"data"`
+}
+
+// applyRequest copies what the request body says about the change onto e.
+func (e *Entry) applyRequest(req requestDocument, withAttributes bool) {
+	var linkages []linkage
+	if json.Unmarshal(req.Data, &linkages) == nil {
+		e.addLinkages(linkages)
+		return
+	}
+
+	var data resourceObject
+	if json.Unmarshal(req.Data, &data) != nil {
+		return
+	}
+	if data.ID != "" && e.ResourceID == "" {
+		e.ResourceID = data.ID
+	}
+
+	var attributes map[string]json.RawMessage
+	if json.Unmarshal(data.Attributes, &attributes) == nil {
+		for name := range attributes {
+			e.Fields = append(e.Fields, name)
+		}
+		slices.Sort(e.Fields)
+		var activityType string
+		if json.Unmarshal(attributes["activityType"], &activityType) == nil && activityType != "" {
+			e.Operation = activityType
+		}
+		if withAttributes {
+			e.Attributes = data.Attributes
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(data.Relationships)) {
+		var rel struct {
+			Data json.RawMessage `json:"data"`
+		}
+		if json.Unmarshal(data.Relationships[name], &rel) != nil {
+			continue
+		}
+		var one linkage
+		if json.Unmarshal(rel.Data, &one) == nil {
+			e.addLinkages([]linkage{one})
+			continue
+		}
+		var many []linkage
+		if json.Unmarshal(rel.Data, &many) == nil {
+			e.addLinkages(many)
+		}
+	}
+}
+
+// addLinkages records the devices and MDM server among linkages.
+func (e *Entry) addLinkages(linkages []linkage) {
+	for _, l := range linkages {
+		switch l.Type {
+		case "orgDevices":
+			e.DeviceIDs = append(e.DeviceIDs, l.ID)
+		case "mdmServers":
+			e.MDMServerID = l.ID
+		}
+	}
+}
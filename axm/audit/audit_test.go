@@ -0,0 +1,277 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devicemanagement"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/client"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"resty.dev/v3"
+)
+
+type noAuth struct{}
+
+func (noAuth) ApplyAuth(*resty.Request) error { return nil }
+
+func jsonResponder(status int, body string) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		resp := httpmock.NewStringResponse(status, body)
+		resp.Header.Set("Content-Type", "application/json")
+		return resp, nil
+	}
+}
+
+func setupClient(t *testing.T, mt *httpmock.MockTransport) *axm.Client {
+	t.Helper()
+	c, err := axm.NewClient("key-id", "issuer-id", "unused",
+		client.WithAuth(noAuth{}),
+		axm.WithTransport(mt),
+		axm.WithRetryCount(0),
+	)
+	require.NoError(t, err)
+	return c
+}
+
+func TestRecorder_AssignDevices(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	mt.RegisterResponder("POST", "https://api-business.apple.com/v1/orgDeviceActivities",
+		jsonResponder(201, `{"data":{"type":"orgDeviceActivities","id":"act-1","attributes":{"status":"IN_PROGRESS","activityType":"ASSIGN_DEVICES"}}}`))
+	c := setupClient(t, mt)
+	log := NewMemoryLog()
+	NewRecorder(log, Options{}).Attach(c)
+
+	ctx := WithActor(context.Background(), "jane@example.com")
+	_, _, err := c.AXMAPI.DeviceManagement.AssignDevicesV1(ctx, "srv-1", []string{"C02AAA", "C02BBB"})
+	require.NoError(t, err)
+
+	entries, err := log.Entries(context.Background(), Filter{})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	e := entries[0]
+	assert.Equal(t, "jane@example.com", e.Actor)
+	assert.Equal(t, "POST", e.Method)
+	assert.Equal(t, "/v1/orgDeviceActivities", e.Path)
+	assert.Equal(t, devicemanagement.ActivityTypeAssignDevices, e.Operation)
+	assert.Equal(t, "orgDeviceActivities", e.ResourceType)
+	assert.Equal(t, "act-1", e.ResourceID)
+	assert.Equal(t, "act-1", e.ActivityID)
+	assert.Equal(t, "srv-1", e.MDMServerID)
+	assert.Equal(t, []string{"C02AAA", "C02BBB"}, e.DeviceIDs)
+	assert.Equal(t, []string{"activityType"}, e.Fields)
+	assert.Nil(t, e.Attributes)
+	assert.Equal(t, 201, e.StatusCode)
+	assert.True(t, e.Succeeded)
+	assert.Equal(t, 1, e.Attempts)
+	assert.WithinDuration(t, time.Now(), e.Time, time.Minute)
+	assert.Equal(t, time.UTC, e.Time.Location())
+}
+
+func TestRecorder_UpdateMDMServer(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	mt.RegisterResponder("PATCH", "https://api-business.apple.com/v1/mdmServers/srv-1",
+		jsonResponder(200, `{"data":{"type":"mdmServers","id":"srv-1","attributes":{"serverName":"Renamed"}}}`))
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/mdmServers",
+		jsonResponder(200, `{"data":[]}`))
+	c := setupClient(t, mt)
+	log := NewMemoryLog()
+	NewRecorder(log, Options{RecordAttributes: true}).Attach(c)
+
+	_, _, err := c.AXMAPI.DeviceManagement.UpdateMDMServerByIDV1(context.Background(), "srv-1", &devicemanagement.MDMServerUpdateRequest{
+		Data: devicemanagement.MDMServerUpdateRequestData{
+			Type:       "mdmServers",
+			ID:         "srv-1",
+			Attributes: devicemanagement.MDMServerUpdateRequestAttributes{ServerName: "Renamed"},
+		},
+	})
+	require.NoError(t, err)
+	_, _, err = c.AXMAPI.DeviceManagement.GetV1(context.Background(), nil)
+	require.NoError(t, err)
+
+	entries, err := log.Entries(context.Background(), Filter{})
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "reads are not recorded")
+	e := entries[0]
+	assert.Equal(t, OperationUpdate, e.Operation)
+	assert.Equal(t, "mdmServers", e.ResourceType)
+	assert.Equal(t, "srv-1", e.ResourceID)
+	assert.Equal(t, "srv-1", e.MDMServerID)
+	assert.Equal(t, []string{"serverName"}, e.Fields)
+	assert.JSONEq(t, `{"serverName":"Renamed"}`, string(e.Attributes))
+	assert.Empty(t, e.Actor)
+}
+
+func TestRecorder_FailedCall(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	mt.RegisterResponder("DELETE", "https://api-business.apple.com/v1/mdmServers/srv-9",
+		jsonResponder(404, `{"errors":[{"status":"404","code":"NOT_FOUND","title":"Not found","detail":"No MDM server srv-9"}]}`))
+	c := setupClient(t, mt)
+	log := NewMemoryLog()
+	NewRecorder(log, Options{}).Attach(c)
+
+	_, err := c.AXMAPI.DeviceManagement.DeleteMDMServerByIDV1(context.Background(), "srv-9")
+	require.Error(t, err)
+
+	entries, err := log.Entries(context.Background(), Filter{FailedOnly: true})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	e := entries[0]
+	assert.Equal(t, OperationDelete, e.Operation)
+	assert.Equal(t, "srv-9", e.MDMServerID)
+	assert.Equal(t, 404, e.StatusCode)
+	assert.False(t, e.Succeeded)
+	assert.NotEmpty(t, e.Error)
+}
+
+type failingLog struct{ MemoryLog }
+
+func (*failingLog) Append(context.Context, Entry) error { return errors.New("disk full") }
+
+func TestRecorder_OnError(t *testing.T) {
+	var got []error
+	r := NewRecorder(&failingLog{}, Options{OnError: func(e Entry, err error) {
+		assert.Equal(t, "/v1/mdmServers/srv-1", e.Path)
+		got = append(got, err)
+	}})
+
+	r.Record(context.Background(), client.MutationInfo{Method: "DELETE", Path: "/v1/mdmServers/srv-1", StatusCode: 204})
+
+	require.Len(t, got, 1)
+	assert.EqualError(t, got[0], "disk full")
+}
+
+func TestNewEntry_Relationship(t *testing.T) {
+	e := NewEntry(context.Background(), client.MutationInfo{
+		Method:      "POST",
+		Path:        "/v1/blueprints/bp-1/relationships/orgDevices",
+		RequestBody: []byte(`{"data":[{"type":"orgDevices","id":"C02AAA"},{"type":"orgDevices","id":"C02BBB"}]}`),
+		StatusCode:  204,
+	}, false)
+
+	assert.Equal(t, OperationCreate, e.Operation)
+	assert.Equal(t, "blueprints", e.ResourceType)
+	assert.Equal(t, "bp-1", e.ResourceID)
+	assert.Equal(t, "orgDevices", e.Relationship)
+	assert.Equal(t, []string{"C02AAA", "C02BBB"}, e.DeviceIDs)
+}
+
+func testEntries() []Entry {
+	start := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	return []Entry{
+		{Time: start, Actor: "jane", Method: "POST", Path: "/v1/orgDeviceActivities", Operation: "ASSIGN_DEVICES",
+			ResourceType: "orgDeviceActivities", ResourceID: "act-1", ActivityID: "act-1", MDMServerID: "srv-1",
+			DeviceIDs: []string{"C02AAA", "C02BBB"}, Fields: []string{"activityType"}, StatusCode: 201, Succeeded: true, Attempts: 1, DurationMS: 120},
+		{Time: start.Add(time.Hour), Actor: "sync-job", Method: "DELETE", Path: "/v1/mdmServers/srv-2", Operation: OperationDelete,
+			ResourceType: "mdmServers", ResourceID: "srv-2", MDMServerID: "srv-2", StatusCode: 409, Error: "conflict, with a comma", Attempts: 1},
+		{Time: start.Add(2 * time.Hour), Actor: "jane", Method: "POST", Path: "/v1/orgDeviceActivities", Operation: "UNASSIGN_DEVICES",
+			ResourceType: "orgDeviceActivities", ResourceID: "act-2", ActivityID: "act-2", MDMServerID: "srv-1",
+			DeviceIDs: []string{"C02BBB"}, StatusCode: 201, Succeeded: true, Attempts: 2},
+	}
+}
+
+func TestFilter_Matches(t *testing.T) {
+	entries := testEntries()
+	ids := func(f Filter) []string {
+		var out []string
+		for _, e := range filterEntries(entries, f) {
+			out = append(out, e.ResourceID)
+		}
+		return out
+	}
+
+	assert.Equal(t, []string{"act-1", "srv-2", "act-2"}, ids(Filter{}))
+	assert.Equal(t, []string{"srv-2", "act-2"}, ids(Filter{Since: entries[1].Time}))
+	assert.Equal(t, []string{"act-1"}, ids(Filter{Until: entries[1].Time}))
+	assert.Equal(t, []string{"act-1", "act-2"}, ids(Filter{Actor: "jane"}))
+	assert.Equal(t, []string{"act-2"}, ids(Filter{Operation: "UNASSIGN_DEVICES"}))
+	assert.Equal(t, []string{"srv-2"}, ids(Filter{MDMServerID: "srv-2"}))
+	assert.Equal(t, []string{"act-1", "act-2"}, ids(Filter{DeviceID: "C02BBB"}))
+	assert.Equal(t, []string{"srv-2"}, ids(Filter{FailedOnly: true}))
+}
+
+func TestFileLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	log := NewFileLog(path)
+	ctx := context.Background()
+
+	empty, err := log.Entries(ctx, Filter{})
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+
+	// Append out of time order; Entries returns them oldest first.
+	entries := testEntries()
+	for _, i := range []int{1, 0, 2} {
+		require.NoError(t, log.Append(ctx, entries[i]))
+	}
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, 3, bytes.Count(data, []byte("\n")))
+
+	got, err := log.Entries(ctx, Filter{})
+	require.NoError(t, err)
+	assert.Equal(t, entries, got)
+
+	got, err = log.Entries(ctx, Filter{DeviceID: "C02AAA"})
+	require.NoError(t, err)
+	assert.Equal(t, entries[:1], got)
+
+	require.NoError(t, os.WriteFile(path, append(data, "not json\n"...), 0o600))
+	_, err = log.Entries(ctx, Filter{})
+	assert.ErrorContains(t, err, "line 4")
+}
+
+func TestExport(t *testing.T) {
+	log := NewMemoryLog()
+	ctx := context.Background()
+	for _, e := range testEntries() {
+		require.NoError(t, log.Append(ctx, e))
+	}
+
+	t.Run("jsonl", func(t *testing.T) {
+		var buf bytes.Buffer
+		n, err := Export(ctx, log, Filter{Actor: "jane"}, &buf, FormatJSONL)
+		require.NoError(t, err)
+		assert.Equal(t, 2, n)
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		require.Len(t, lines, 2)
+		assert.Contains(t, lines[0], `"activityId":"act-1"`)
+		assert.Contains(t, lines[0], `"deviceIds":["C02AAA","C02BBB"]`)
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		var buf bytes.Buffer
+		n, err := Export(ctx, log, Filter{}, &buf, FormatCSV)
+		require.NoError(t, err)
+		assert.Equal(t, 3, n)
+		records, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 4)
+		assert.Equal(t, csvHeader, records[0])
+		assert.Equal(t, []string{
+			"2026-03-01T09:00:00Z", "jane", "", "POST", "/v1/orgDeviceActivities", "ASSIGN_DEVICES",
+			"orgDeviceActivities", "act-1", "", "srv-1", "C02AAA;C02BBB", "act-1", "activityType",
+			"201", "true", "", "1", "120",
+		}, records[1])
+		assert.Equal(t, "conflict, with a comma", records[2][15])
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		_, err := Export(ctx, log, Filter{}, &bytes.Buffer{}, "xml")
+		assert.ErrorContains(t, err, `unknown export format "xml"`)
+	})
+}
@@ -0,0 +1,68 @@
+package audit
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Format is an export format.
+type Format string
+
+const (
+	// FormatJSONL writes one JSON entry per line, with every field.
+	FormatJSONL Format = "jsonl"
+
+	// FormatCSV writes a header row and one row per entry. List values are
+	// joined with ";", and attribute values are left out.
+	FormatCSV Format = "csv"
+)
+
+// csvHeader names the columns FormatCSV writes.
+var csvHeader = []string{
+	"time", "actor", "key_id", "method", "path", "operation",
+	"resource_type", "resource_id", "relationship", "mdm_server_id", "device_ids", "activity_id", "fields",
+	"status_code", "succeeded", "error", "attempts", "duration_ms",
+}
+
+// Export writes the entries of log matched by f to w in format, oldest
+// first, and returns how many it wrote.
+func Export(ctx context.Context, log Log, f Filter, w io.Writer, format Format) (int, error) {
+	if format != FormatJSONL && format != FormatCSV {
+		return 0, fmt.Errorf("unknown export format %q", format)
+	}
+	entries, err := log.Entries(ctx, f)
+	if err != nil {
+		return 0, err
+	}
+
+	if format == FormatJSONL {
+		enc := json.NewEncoder(w)
+		for i, e := range entries {
+			if err := enc.Encode(e); err != nil {
+				return i, fmt.Errorf("write audit export: %w", err)
+			}
+		}
+		return len(entries), nil
+	}
+
+	cw := csv.NewWriter(w)
+	cw.Write(csvHeader)
+	for _, e := range entries {
+		cw.Write([]string{
+			e.Time.UTC().Format(time.RFC3339Nano), e.Actor, e.KeyID, e.Method, e.Path, e.Operation,
+			e.ResourceType, e.ResourceID, e.Relationship, e.MDMServerID, strings.Join(e.DeviceIDs, ";"), e.ActivityID, strings.Join(e.Fields, ";"),
+			strconv.Itoa(e.StatusCode), strconv.FormatBool(e.Succeeded), e.Error, strconv.Itoa(e.Attempts), strconv.FormatInt(e.DurationMS, 10),
+		})
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return 0, fmt.Errorf("write audit export: %w", err)
+	}
+	return len(entries), nil
+}
@@ -0,0 +1,259 @@
+package audit
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// MemoryLog is a Log held in memory, for tests and short-lived processes.
+type MemoryLog struct {
+	mu      sync.Mutex
+	entries []Entry
+}
+
+// NewMemoryLog returns an empty MemoryLog.
+func NewMemoryLog() *MemoryLog {
+	return &MemoryLog{}
+}
+
+// Append implements Log.
+func (m *MemoryLog) Append(_ context.Context, e Entry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries = append(m.entries, e)
+	return nil
+}
+
+// Entries implements Log.
+func (m *MemoryLog) Entries(_ context.Context, f Filter) ([]Entry, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return filterEntries(m.entries, f), nil
+}
+
+// FileLog is a Log kept in a JSON Lines file, one entry per line. The file
+// is only ever opened for appending, and each entry is synced to disk before
+// Append returns. Reads scan the whole file.
+type FileLog struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileLog returns a log stored at path. The file is created, readable only
+// by its owner, on the first Append.
+func NewFileLog(path string) *FileLog {
+	return &FileLog{path: path}
+}
+
+// Append implements Log.
+func (f *FileLog) Append(_ context.Context, e Entry) error {
+	line, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	file, err := os.OpenFile(f.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return fmt.Errorf("open audit log: %w", err)
+	}
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		file.Close()
+		return fmt.Errorf("write audit log: %w", err)
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return fmt.Errorf("sync audit log: %w", err)
+	}
+	return file.Close()
+}
+
+// Entries implements Log.
+func (f *FileLog) Entries(_ context.Context, filter Filter) ([]Entry, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	file, err := os.Open(f.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("open audit log: %w", err)
+	}
+	defer file.Close()
+
+	var entries []Entry
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, 16<<20)
+	for n := 1; scanner.Scan(); n++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var e Entry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return nil, fmt.Errorf("audit log %s line %d: %w", f.path, n, err)
+		}
+		entries = append(entries, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read audit log: %w", err)
+	}
+	return filterEntries(entries, filter), nil
+}
+
+// SQLiteSchema creates the table SQLiteLog writes, if it does not exist.
+// Times are stored as fixed-width RFC 3339 text in UTC, so they sort and
+// compare as text, and device IDs as a JSON array, readable with json_each.
+// entry holds the full Entry as JSON.
+//
+// Triggers reject every UPDATE and DELETE, so rows can only be added. They
+// guard against mistakes, not a determined administrator, who can drop them;
+// keep the database where such changes are themselves audited.
+const SQLiteSchema = `
+CREATE TABLE IF NOT EXISTS audit_log (
+	seq           INTEGER PRIMARY KEY AUTOINCREMENT,
+	time          TEXT NOT NULL,
+	actor         TEXT,
+	key_id        TEXT,
+	method        TEXT NOT NULL,
+	path          TEXT NOT NULL,
+	operation     TEXT NOT NULL,
+	resource_type TEXT,
+	resource_id   TEXT,
+	mdm_server_id TEXT,
+	device_ids    TEXT,
+	activity_id   TEXT,
+	status_code   INTEGER NOT NULL,
+	succeeded     INTEGER NOT NULL,
+	error         TEXT,
+	entry         TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS audit_log_time ON audit_log (time);
+CREATE TRIGGER IF NOT EXISTS audit_log_no_update BEFORE UPDATE ON audit_log
+BEGIN
+	SELECT RAISE(ABORT, 'audit_log is append-only');
+END;
+CREATE TRIGGER IF NOT EXISTS audit_log_no_delete BEFORE DELETE ON audit_log
+BEGIN
+	SELECT RAISE(ABORT, 'audit_log is append-only');
+END;`
+
+// sqliteTimeLayout is RFC 3339 with a fixed number of fractional digits, so
+// stored times sort as text.
+const sqliteTimeLayout = "2006-01-02T15:04:05.000000000Z07:00"
+
+// SQLiteLog is a Log kept in the audit_log table of a SQLite database.
+type SQLiteLog struct {
+	db *sql.DB
+}
+
+// NewSQLiteLog returns a log stored in db, creating the table in SQLiteSchema
+// if needed. db must be opened with a SQLite driver, such as
+// modernc.org/sqlite or github.com/mattn/go-sqlite3; this package does not
+// import one.
+func NewSQLiteLog(ctx context.Context, db *sql.DB) (*SQLiteLog, error) {
+	if _, err := db.ExecContext(ctx, SQLiteSchema); err != nil {
+		return nil, fmt.Errorf("create audit log schema: %w", err)
+	}
+	return &SQLiteLog{db: db}, nil
+}
+
+// Append implements Log.
+func (l *SQLiteLog) Append(ctx context.Context, e Entry) error {
+	entry, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	var deviceIDs any
+	if len(e.DeviceIDs) > 0 {
+		ids, err := json.Marshal(e.DeviceIDs)
+		if err != nil {
+			return err
+		}
+		deviceIDs = string(ids)
+	}
+	_, err = l.db.ExecContext(ctx, `INSERT INTO audit_log
+		(time, actor, key_id, method, path, operation, resource_type, resource_id, mdm_server_id, device_ids, activity_id, status_code, succeeded, error, entry)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		e.Time.UTC().Format(sqliteTimeLayout), sqliteText(e.Actor), sqliteText(e.KeyID), e.Method, e.Path, e.Operation,
+		sqliteText(e.ResourceType), sqliteText(e.ResourceID), sqliteText(e.MDMServerID), deviceIDs, sqliteText(e.ActivityID),
+		e.StatusCode, e.Succeeded, sqliteText(e.Error), string(entry),
+	)
+	if err != nil {
+		return fmt.Errorf("write audit log: %w", err)
+	}
+	return nil
+}
+
+// Entries implements Log. The time bounds are applied in SQL and the rest of
+// the filter to the decoded entries.
+func (l *SQLiteLog) Entries(ctx context.Context, f Filter) ([]Entry, error) {
+	var where []string
+	var args []any
+	if !f.Since.IsZero() {
+		where, args = append(where, "time >= ?"), append(args, f.Since.UTC().Format(sqliteTimeLayout))
+	}
+	if !f.Until.IsZero() {
+		where, args = append(where, "time < ?"), append(args, f.Until.UTC().Format(sqliteTimeLayout))
+	}
+	query := "SELECT entry FROM audit_log"
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += " ORDER BY time, seq"
+
+	rows, err := l.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("read audit log: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []Entry
+	for rows.Next() {
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			return nil, fmt.Errorf("read audit log: %w", err)
+		}
+		var e Entry
+		if err := json.Unmarshal([]byte(data), &e); err != nil {
+			return nil, fmt.Errorf("decode audit log entry: %w", err)
+		}
+		if f.Matches(e) {
+			entries = append(entries, e)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read audit log: %w", err)
+	}
+	return entries, nil
+}
+
+// sqliteText stores an empty string as NULL.
+func sqliteText(s string) any {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+// filterEntries returns the entries matched by f, oldest first. Entries with
+// the same time keep the order they were appended in.
+func filterEntries(entries []Entry, f Filter) []Entry {
+	var out []Entry
+	for _, e := range entries {
+		if f.Matches(e) {
+			out = append(out, e)
+		}
+	}
+	slices.SortStableFunc(out, func(a, b Entry) int { return a.Time.Compare(b.Time) })
+	return out
+}
@@ -0,0 +1,151 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSQLite backs a database/sql driver that records the statements it
+// executes and answers SELECTs with the entry column of every INSERT, so
+// SQLiteLog can be tested without a SQLite driver. It does not evaluate WHERE
+// clauses.
+type fakeSQLite struct {
+	mu      sync.Mutex
+	execs   []fakeExec
+	queries []fakeExec
+}
+
+type fakeExec struct {
+	query string
+	args  []driver.Value
+}
+
+var (
+	fakesMu sync.Mutex
+	fakes   = map[string]*fakeSQLite{}
+)
+
+func init() {
+	sql.Register("audit-fake", fakeDriver{})
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) {
+	fakesMu.Lock()
+	defer fakesMu.Unlock()
+	return &fakeConn{d: fakes[name]}, nil
+}
+
+func openFakeDB(t *testing.T) (*sql.DB, *fakeSQLite) {
+	t.Helper()
+	fake := &fakeSQLite{}
+	fakesMu.Lock()
+	fakes[t.Name()] = fake
+	fakesMu.Unlock()
+	db, err := sql.Open("audit-fake", t.Name())
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db, fake
+}
+
+type fakeConn struct{ d *fakeSQLite }
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{d: c.d, query: query}, nil
+}
+func (c *fakeConn) Close() error { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+type fakeStmt struct {
+	d     *fakeSQLite
+	query string
+}
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+	s.d.execs = append(s.d.execs, fakeExec{query: s.query, args: args})
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+	s.d.queries = append(s.d.queries, fakeExec{query: s.query, args: args})
+	rows := &fakeRows{}
+	for _, exec := range s.d.execs {
+		if strings.HasPrefix(strings.TrimSpace(exec.query), "INSERT") {
+			rows.values = append(rows.values, exec.args[len(exec.args)-1])
+		}
+	}
+	return rows, nil
+}
+
+type fakeRows struct {
+	values []driver.Value
+}
+
+func (r *fakeRows) Columns() []string { return []string{"entry"} }
+func (r *fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	dest[0], r.values = r.values[0], r.values[1:]
+	return nil
+}
+
+func TestSQLiteLog(t *testing.T) {
+	db, fake := openFakeDB(t)
+	ctx := context.Background()
+
+	log, err := NewSQLiteLog(ctx, db)
+	require.NoError(t, err)
+	require.Len(t, fake.execs, 1)
+	assert.Equal(t, SQLiteSchema, fake.execs[0].query)
+	assert.Contains(t, SQLiteSchema, "BEFORE UPDATE ON audit_log")
+	assert.Contains(t, SQLiteSchema, "BEFORE DELETE ON audit_log")
+
+	entries := testEntries()
+	for _, e := range entries {
+		require.NoError(t, log.Append(ctx, e))
+	}
+	require.Len(t, fake.execs, 4)
+	for _, exec := range fake.execs[1:] {
+		assert.True(t, strings.HasPrefix(exec.query, "INSERT INTO audit_log"), "only inserts are executed: %s", exec.query)
+	}
+	first := fake.execs[1].args
+	assert.Equal(t, "2026-03-01T09:00:00.000000000Z", first[0])
+	assert.Equal(t, "jane", first[1])
+	assert.Nil(t, first[2], "an empty key ID is stored as NULL")
+	assert.Equal(t, `["C02AAA","C02BBB"]`, first[9])
+	assert.Equal(t, "act-1", first[10])
+	assert.Equal(t, true, first[12])
+	assert.Nil(t, fake.execs[2].args[9], "no devices is stored as NULL")
+
+	got, err := log.Entries(ctx, Filter{})
+	require.NoError(t, err)
+	assert.Equal(t, entries, got)
+	assert.Equal(t, "SELECT entry FROM audit_log ORDER BY time, seq", fake.queries[0].query)
+
+	got, err = log.Entries(ctx, Filter{Since: entries[0].Time, Until: entries[2].Time, Actor: "jane"})
+	require.NoError(t, err)
+	assert.Equal(t, entries[:1], got)
+	assert.Equal(t, "SELECT entry FROM audit_log WHERE time >= ? AND time < ? ORDER BY time, seq", fake.queries[1].query)
+	assert.Equal(t, []driver.Value{"2026-03-01T09:00:00.000000000Z", "2026-03-01T11:00:00.000000000Z"}, fake.queries[1].args)
+}
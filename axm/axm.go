@@ -1,20 +1,25 @@
 package axm
 
 import (
+	"context"
+
 	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/apps"
 	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/auditevents"
 	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/blueprints"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/classes"
 	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/configurations"
 	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devicemanagement"
 	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devices"
 	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/organizationalunits"
 	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/packages"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/people"
 	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/usergroups"
 	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/users"
 	"github.com/deploymenttheory/go-api-sdk-apple/axm/client"
 )
 
 // Client is the main entry point for the Apple Business Manager API SDK.
+// A Client is safe for concurrent use by multiple goroutines.
 type Client struct {
 	transport *client.Transport
 	AXMAPI    *AXMAPIClient
@@ -32,6 +37,8 @@ type AXMAPIClient struct {
 	Packages            *packages.Packages
 	Configurations      *configurations.Configurations
 	Blueprints          *blueprints.Blueprints
+	Classes             *classes.Classes
+	People              *people.People
 }
 
 // NewClient creates a new Apple Business Manager client.
@@ -59,6 +66,8 @@ func NewClient(keyID, issuerID string, privateKey any, options ...client.ClientO
 			Packages:            packages.NewService(transport),
 			Configurations:      configurations.NewService(transport),
 			Blueprints:          blueprints.NewService(transport),
+			Classes:             classes.NewService(transport),
+			People:              people.NewService(transport),
 		},
 	}, nil
 }
@@ -100,6 +109,51 @@ func NewClientFromEnv(options ...client.ClientOption) (*Client, error) {
 			Packages:            packages.NewService(transport),
 			Configurations:      configurations.NewService(transport),
 			Blueprints:          blueprints.NewService(transport),
+			Classes:             classes.NewService(transport),
+			People:              people.NewService(transport),
 		},
 	}, nil
 }
+
+// OnRequest registers fn to be called before every request attempt with the
+// method, URL and attempt number. Use it for lightweight logging or accounting.
+func (c *Client) OnRequest(fn client.RequestHook) {
+	c.transport.OnRequest(fn)
+}
+
+// OnResponse registers fn to be called after every response with the method,
+// URL, status code, duration and attempt number.
+func (c *Client) OnResponse(fn client.ResponseHook) {
+	c.transport.OnResponse(fn)
+}
+
+// OnMutation registers fn to be called once after every create, update or
+// delete call, with the call's context, request and response bodies and
+// outcome. The audit package uses it to record changes.
+func (c *Client) OnMutation(fn client.MutationHook) {
+	c.transport.OnMutation(fn)
+}
+
+// OnTokenRefresh registers fn to be called after every access token refresh,
+// with the error if Apple rejected it. It reports false when the client does
+// not use JWT authentication.
+func (c *Client) OnTokenRefresh(fn client.TokenRefreshHook) bool {
+	return c.transport.OnTokenRefresh(fn)
+}
+
+// Close closes the client at once, without waiting for requests in flight.
+func (c *Client) Close() error {
+	return c.transport.Close()
+}
+
+// Shutdown stops the client accepting requests and waits until those in
+// flight finish or ctx ends, then closes it. Use it when a service stops, for
+// example with a context.WithTimeout deadline; see client.Transport.Shutdown.
+func (c *Client) Shutdown(ctx context.Context) error {
+	return c.transport.Shutdown(ctx)
+}
+
+// Done returns a channel that is closed when Close or Shutdown is called.
+func (c *Client) Done() <-chan struct{} {
+	return c.transport.Done()
+}
@@ -0,0 +1,28 @@
+package classes
+
+// Field constants for fields[classes] query parameter.
+const (
+	FieldName            = "name"
+	FieldClassNumber     = "classNumber"
+	FieldCourseName      = "courseName"
+	FieldLocationId      = "locationId"
+	FieldSource          = "source"
+	FieldStatus          = "status"
+	FieldCreatedDateTime = "createdDateTime"
+	FieldUpdatedDateTime = "updatedDateTime"
+	FieldStudents        = "students"
+	FieldInstructors     = "instructors"
+)
+
+// ClassStatus constants for status field values.
+const (
+	ClassStatusActive   = "ACTIVE"
+	ClassStatusInactive = "INACTIVE"
+)
+
+// ClassSource constants for the roster source a class was created from.
+const (
+	ClassSourceManual = "MANUAL"
+	ClassSourceSFTP   = "SFTP"
+	ClassSourceSIS    = "SIS"
+)
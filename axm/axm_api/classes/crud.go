@@ -0,0 +1,187 @@
+package classes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/constants"
+	"resty.dev/v3"
+)
+
+// Classes handles communication with the class roster
+// related methods of the Apple School Manager API.
+//
+// Class resources are only available to Apple School Manager organizations;
+// configure the client with WithBaseURL(constants.SchoolBaseURL) and
+// WithScope(constants.ScopeSchoolAPI).
+//
+// Apple School Manager API docs: https://developer.apple.com/documentation/appleschoolmanagerapi/
+type (
+	Classes struct {
+		client client.Client
+	}
+)
+
+// NewService creates a new classes service.
+func NewService(c client.Client) *Classes {
+	return &Classes{client: c}
+}
+
+// GetV1 retrieves a list of classes in an organization.
+// URL: GET https://api-school.apple.com/v1/classes
+// https://developer.apple.com/documentation/appleschoolmanagerapi/get-classes
+func (s *Classes) GetV1(ctx context.Context, opts *RequestQueryOptions) (*ClassesResponse, *resty.Response, error) {
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	params := s.client.QueryBuilder()
+
+	if len(opts.Fields) > 0 {
+		params.AddStringSlice("fields[classes]", opts.Fields)
+	}
+	if opts.Limit > 0 {
+		if opts.Limit > 1000 {
+			opts.Limit = 1000
+		}
+		params.AddInt("limit", opts.Limit)
+	}
+
+	var allClasses []Class
+	var lastMeta *Meta
+	var lastLinks *Links
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetQueryParams(params.Build()).
+		GetPaginated(constants.EndpointClasses, func(pageData []byte) error {
+			var pageResponse ClassesResponse
+			if err := json.Unmarshal(pageData, &pageResponse); err != nil {
+				return fmt.Errorf("failed to unmarshal page: %w", err)
+			}
+			allClasses = append(allClasses, pageResponse.Data...)
+			lastMeta = pageResponse.Meta
+			lastLinks = pageResponse.Links
+			return nil
+		})
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &ClassesResponse{
+		Data:  allClasses,
+		Meta:  lastMeta,
+		Links: lastLinks,
+	}, resp, nil
+}
+
+// GetByClassIDV1 retrieves information about a specific class in an organization.
+// URL: GET https://api-school.apple.com/v1/classes/{id}
+// https://developer.apple.com/documentation/appleschoolmanagerapi/get-class-information
+func (s *Classes) GetByClassIDV1(ctx context.Context, classID string, opts *RequestQueryOptions) (*ClassResponse, *resty.Response, error) {
+	if classID == "" {
+		return nil, nil, fmt.Errorf("class ID is required")
+	}
+
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	endpoint := constants.EndpointClasses + "/" + classID
+
+	params := s.client.QueryBuilder()
+
+	if len(opts.Fields) > 0 {
+		params.AddStringSlice("fields[classes]", opts.Fields)
+	}
+
+	var result ClassResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetQueryParams(params.Build()).
+		SetResult(&result).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// GetStudentIDsByClassIDV1 retrieves a list of student IDs enrolled in a class.
+// URL: GET https://api-school.apple.com/v1/classes/{id}/relationships/students
+// https://developer.apple.com/documentation/appleschoolmanagerapi/get-all-student-ids-for-a-class
+func (s *Classes) GetStudentIDsByClassIDV1(ctx context.Context, classID string, opts *RequestQueryOptions) (*ClassMembersLinkagesResponse, *resty.Response, error) {
+	if classID == "" {
+		return nil, nil, fmt.Errorf("class ID is required")
+	}
+
+	endpoint := fmt.Sprintf(constants.EndpointClasses+"/%s/relationships/students", classID)
+
+	return s.getMemberLinkages(ctx, endpoint, opts)
+}
+
+// GetInstructorIDsByClassIDV1 retrieves a list of instructor IDs assigned to a class.
+// URL: GET https://api-school.apple.com/v1/classes/{id}/relationships/instructors
+// https://developer.apple.com/documentation/appleschoolmanagerapi/get-all-instructor-ids-for-a-class
+func (s *Classes) GetInstructorIDsByClassIDV1(ctx context.Context, classID string, opts *RequestQueryOptions) (*ClassMembersLinkagesResponse, *resty.Response, error) {
+	if classID == "" {
+		return nil, nil, fmt.Errorf("class ID is required")
+	}
+
+	endpoint := fmt.Sprintf(constants.EndpointClasses+"/%s/relationships/instructors", classID)
+
+	return s.getMemberLinkages(ctx, endpoint, opts)
+}
+
+// getMemberLinkages fetches all pages of a class membership relationship endpoint.
+func (s *Classes) getMemberLinkages(ctx context.Context, endpoint string, opts *RequestQueryOptions) (*ClassMembersLinkagesResponse, *resty.Response, error) {
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	params := s.client.QueryBuilder()
+
+	if opts.Limit > 0 {
+		if opts.Limit > 1000 {
+			opts.Limit = 1000
+		}
+		params.AddInt("limit", opts.Limit)
+	}
+
+	var allLinkages []ClassMemberLinkage
+	var lastMeta *Meta
+	var lastLinks *Links
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetQueryParams(params.Build()).
+		GetPaginated(endpoint, func(pageData []byte) error {
+			var pageResponse ClassMembersLinkagesResponse
+			if err := json.Unmarshal(pageData, &pageResponse); err != nil {
+				return fmt.Errorf("failed to unmarshal page: %w", err)
+			}
+			allLinkages = append(allLinkages, pageResponse.Data...)
+			lastMeta = pageResponse.Meta
+			lastLinks = pageResponse.Links
+			return nil
+		})
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &ClassMembersLinkagesResponse{
+		Data:  allLinkages,
+		Meta:  lastMeta,
+		Links: lastLinks,
+	}, resp, nil
+}
@@ -0,0 +1,260 @@
+package classes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/classes/mocks"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/constants"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+// setupMockClient creates a client with httpmock enabled.
+func setupMockClient(t *testing.T) *Classes {
+	mockAuth := &MockAuthProvider{}
+
+	coreClient, err := client.NewTransport(
+		"test-key-id",
+		"test-issuer-id",
+		"dummy-key",
+		client.WithAuth(mockAuth),
+		client.WithLogger(zap.NewNop()),
+		client.WithRetryCount(0),
+	)
+	require.NoError(t, err)
+
+	httpmock.ActivateNonDefault(coreClient.GetHTTPClient().Client())
+
+	t.Cleanup(func() {
+		httpmock.DeactivateAndReset()
+	})
+
+	return NewService(coreClient)
+}
+
+// MockAuthProvider implements the AuthProvider interface for testing.
+type MockAuthProvider struct{}
+
+func (m *MockAuthProvider) ApplyAuth(req *resty.Request) error {
+	return nil
+}
+
+func TestGetClasses_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.ClassesMock{}
+	mockHandler.RegisterMocks()
+	defer mockHandler.CleanupMockState()
+
+	opts := &RequestQueryOptions{
+		Fields: []string{FieldName, FieldCourseName, FieldStatus},
+		Limit:  100,
+	}
+
+	result, resp, err := svc.GetV1(context.Background(), opts)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, 200, resp.StatusCode())
+	require.NotNil(t, result)
+	require.Len(t, result.Data, 1)
+
+	class := result.Data[0]
+	assert.Equal(t, "classes", class.Type)
+	assert.Equal(t, "CLS-1001", class.ID)
+	require.NotNil(t, class.Attributes)
+	assert.Equal(t, "Year 7 Science", class.Attributes.Name)
+	assert.Equal(t, "7SCI-A", class.Attributes.ClassNumber)
+	assert.Equal(t, "Science", class.Attributes.CourseName)
+	assert.Equal(t, "LOC-01", class.Attributes.LocationId)
+	assert.Equal(t, ClassSourceSIS, class.Attributes.Source)
+	assert.Equal(t, ClassStatusActive, class.Attributes.Status)
+	require.NotNil(t, class.Attributes.CreatedDateTime)
+
+	require.NotNil(t, class.Relationships)
+	require.NotNil(t, class.Relationships.Students)
+	require.NotNil(t, class.Relationships.Instructors)
+
+	require.NotNil(t, result.Meta)
+	require.NotNil(t, result.Meta.Paging)
+	assert.Equal(t, 1, result.Meta.Paging.Total)
+}
+
+func TestGetClasses_WithLimitEnforcement(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.ClassesMock{}
+	mockHandler.RegisterMocks()
+	defer mockHandler.CleanupMockState()
+
+	opts := &RequestQueryOptions{Limit: 5000}
+
+	result, _, err := svc.GetV1(context.Background(), opts)
+
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, 1000, opts.Limit)
+}
+
+func TestGetClasses_HTTPError(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.ClassesMock{}
+	mockHandler.RegisterErrorMocks()
+	defer mockHandler.CleanupMockState()
+
+	result, resp, err := svc.GetV1(context.Background(), nil)
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	require.NotNil(t, resp)
+	assert.Equal(t, 500, resp.StatusCode())
+}
+
+func TestGetClasses_SchoolBaseURL(t *testing.T) {
+	coreClient, err := client.NewTransport(
+		"test-key-id",
+		"test-issuer-id",
+		"dummy-key",
+		client.WithAuth(&MockAuthProvider{}),
+		client.WithLogger(zap.NewNop()),
+		client.WithRetryCount(0),
+		client.WithBaseURL(constants.SchoolBaseURL),
+	)
+	require.NoError(t, err)
+	httpmock.ActivateNonDefault(coreClient.GetHTTPClient().Client())
+	t.Cleanup(httpmock.DeactivateAndReset)
+
+	schoolURL := constants.SchoolBaseURL + constants.EndpointClasses
+	httpmock.RegisterResponder("GET", schoolURL, httpmock.NewJsonResponderOrPanic(200, map[string]any{"data": []any{}}))
+
+	_, _, err = NewService(coreClient).GetV1(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, httpmock.GetCallCountInfo()["GET "+schoolURL], "requests go to the Apple School Manager host")
+}
+
+func TestGetClassInformation_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.ClassesMock{}
+	mockHandler.RegisterMocks()
+	defer mockHandler.CleanupMockState()
+
+	result, resp, err := svc.GetByClassIDV1(context.Background(), "CLS-1001", &RequestQueryOptions{
+		Fields: []string{FieldName, FieldStudents, FieldInstructors},
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, 200, resp.StatusCode())
+	require.NotNil(t, result)
+	assert.Equal(t, "CLS-1001", result.Data.ID)
+	require.NotNil(t, result.Data.Attributes)
+	assert.Equal(t, "Year 7 Science", result.Data.Attributes.Name)
+}
+
+func TestGetClassInformation_EmptyClassID(t *testing.T) {
+	svc := setupMockClient(t)
+
+	result, resp, err := svc.GetByClassIDV1(context.Background(), "", nil)
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Nil(t, resp)
+	assert.Contains(t, err.Error(), "class ID is required")
+}
+
+func TestGetClassInformation_NotFound(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.ClassesMock{}
+	mockHandler.RegisterMocks()
+	defer mockHandler.CleanupMockState()
+
+	result, resp, err := svc.GetByClassIDV1(context.Background(), "missing", nil)
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	require.NotNil(t, resp)
+	assert.Equal(t, 404, resp.StatusCode())
+}
+
+func TestGetStudentIDsByClassID_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.ClassesMock{}
+	mockHandler.RegisterMocks()
+	defer mockHandler.CleanupMockState()
+
+	result, resp, err := svc.GetStudentIDsByClassIDV1(context.Background(), "CLS-1001", &RequestQueryOptions{Limit: 100})
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, 200, resp.StatusCode())
+	require.NotNil(t, result)
+	require.Len(t, result.Data, 2)
+	assert.Equal(t, "students", result.Data[0].Type)
+	assert.Equal(t, "STU-2001", result.Data[0].ID)
+	assert.Equal(t, "STU-2002", result.Data[1].ID)
+}
+
+func TestGetStudentIDsByClassID_EmptyClassID(t *testing.T) {
+	svc := setupMockClient(t)
+
+	result, resp, err := svc.GetStudentIDsByClassIDV1(context.Background(), "", nil)
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Nil(t, resp)
+	assert.Contains(t, err.Error(), "class ID is required")
+}
+
+func TestGetStudentIDsByClassID_HTTPError(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.ClassesMock{}
+	mockHandler.RegisterErrorMocks()
+	defer mockHandler.CleanupMockState()
+
+	result, resp, err := svc.GetStudentIDsByClassIDV1(context.Background(), "CLS-1001", nil)
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	require.NotNil(t, resp)
+	assert.Equal(t, 500, resp.StatusCode())
+}
+
+func TestGetInstructorIDsByClassID_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.ClassesMock{}
+	mockHandler.RegisterMocks()
+	defer mockHandler.CleanupMockState()
+
+	result, resp, err := svc.GetInstructorIDsByClassIDV1(context.Background(), "CLS-1001", nil)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, 200, resp.StatusCode())
+	require.NotNil(t, result)
+	require.Len(t, result.Data, 1)
+	assert.Equal(t, "instructors", result.Data[0].Type)
+	assert.Equal(t, "INS-3001", result.Data[0].ID)
+}
+
+func TestGetInstructorIDsByClassID_EmptyClassID(t *testing.T) {
+	svc := setupMockClient(t)
+
+	result, resp, err := svc.GetInstructorIDsByClassIDV1(context.Background(), "", nil)
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Nil(t, resp)
+	assert.Contains(t, err.Error(), "class ID is required")
+}
+
+func TestClassFieldConstants(t *testing.T) {
+	assert.Equal(t, "name", FieldName)
+	assert.Equal(t, "classNumber", FieldClassNumber)
+	assert.Equal(t, "courseName", FieldCourseName)
+	assert.Equal(t, "locationId", FieldLocationId)
+	assert.Equal(t, "students", FieldStudents)
+	assert.Equal(t, "instructors", FieldInstructors)
+}
@@ -0,0 +1,120 @@
+package mocks
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/jarcoal/httpmock"
+)
+
+var mockState struct {
+	sync.Mutex
+	classes map[string]map[string]any
+}
+
+func init() {
+	mockState.classes = make(map[string]map[string]any)
+	httpmock.RegisterNoResponder(httpmock.NewStringResponder(404, `{"errors":[{"status":"404","code":"RESOURCE_NOT_FOUND","title":"Resource Not Found","detail":"The requested resource was not found"}]}`))
+}
+
+// loadMockResponse loads JSON response from the mocks folder.
+func loadMockResponse(filename string) ([]byte, error) {
+	mockPath := filepath.Join("mocks", filename)
+	return os.ReadFile(mockPath)
+}
+
+// jsonFileResponder returns a responder that serves the given mock file.
+func jsonFileResponder(filename string) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		mockData, err := loadMockResponse(filename)
+		if err != nil {
+			return httpmock.NewStringResponse(500, `{"errors":[{"status":"500","code":"INTERNAL_ERROR","title":"Internal Server Error","detail":"Failed to load mock data"}]}`), nil
+		}
+
+		var responseObj map[string]any
+		if err := json.Unmarshal(mockData, &responseObj); err != nil {
+			return httpmock.NewStringResponse(500, `{"errors":[{"status":"500","code":"INTERNAL_ERROR","title":"Internal Server Error","detail":"Failed to parse mock data"}]}`), nil
+		}
+
+		return httpmock.NewJsonResponse(200, responseObj)
+	}
+}
+
+// ClassesMock provides httpmock responders for class endpoints.
+type ClassesMock struct{}
+
+// RegisterMocks registers all HTTP mock responders for classes.
+func (m *ClassesMock) RegisterMocks() {
+	mockState.Lock()
+	mockState.classes = make(map[string]map[string]any)
+	mockState.Unlock()
+
+	m.seedTestClass()
+
+	// GET /classes — list classes
+	httpmock.RegisterResponder("GET", "https://api-business.apple.com/v1/classes", jsonFileResponder("validate_get_classes.json"))
+
+	// GET /classes/{id} — get class by ID
+	httpmock.RegisterResponder("GET", `=~^https://api-business\.apple\.com/v1/classes/[^/]+$`, func(req *http.Request) (*http.Response, error) {
+		parts := strings.Split(req.URL.Path, "/")
+		classID := parts[len(parts)-1]
+
+		mockState.Lock()
+		_, exists := mockState.classes[classID]
+		mockState.Unlock()
+
+		if !exists {
+			return httpmock.NewStringResponse(404, `{"errors":[{"status":"404","code":"RESOURCE_NOT_FOUND","title":"Class Not Found","detail":"The requested class was not found"}]}`), nil
+		}
+
+		return jsonFileResponder("validate_get_class_information.json")(req)
+	})
+
+	// GET /classes/{id}/relationships/students — get student IDs
+	httpmock.RegisterResponder("GET", `=~^https://api-business\.apple\.com/v1/classes/[^/]+/relationships/students$`, jsonFileResponder("validate_get_class_students.json"))
+
+	// GET /classes/{id}/relationships/instructors — get instructor IDs
+	httpmock.RegisterResponder("GET", `=~^https://api-business\.apple\.com/v1/classes/[^/]+/relationships/instructors$`, jsonFileResponder("validate_get_class_instructors.json"))
+}
+
+// RegisterErrorMocks registers mock responders that return error responses.
+func (m *ClassesMock) RegisterErrorMocks() {
+	mockState.Lock()
+	mockState.classes = make(map[string]map[string]any)
+	mockState.Unlock()
+
+	httpmock.RegisterResponder("GET", "https://api-business.apple.com/v1/classes", func(req *http.Request) (*http.Response, error) {
+		return httpmock.NewStringResponse(500, `{"errors":[{"status":"500","code":"INTERNAL_ERROR","title":"Internal Server Error","detail":"Mock error for testing"}]}`), nil
+	})
+
+	httpmock.RegisterResponder("GET", `=~^https://api-business\.apple\.com/v1/classes/[^/]+$`, func(req *http.Request) (*http.Response, error) {
+		return httpmock.NewStringResponse(404, `{"errors":[{"status":"404","code":"RESOURCE_NOT_FOUND","title":"Class Not Found","detail":"The requested class was not found"}]}`), nil
+	})
+
+	httpmock.RegisterResponder("GET", `=~^https://api-business\.apple\.com/v1/classes/[^/]+/relationships/(students|instructors)$`, func(req *http.Request) (*http.Response, error) {
+		return httpmock.NewStringResponse(500, `{"errors":[{"status":"500","code":"INTERNAL_ERROR","title":"Internal Server Error","detail":"Mock error for testing"}]}`), nil
+	})
+}
+
+// CleanupMockState clears all mock state data.
+func (m *ClassesMock) CleanupMockState() {
+	mockState.Lock()
+	defer mockState.Unlock()
+	for id := range mockState.classes {
+		delete(mockState.classes, id)
+	}
+}
+
+func (m *ClassesMock) seedTestClass() {
+	testClass := map[string]any{
+		"type": "classes",
+		"id":   "CLS-1001",
+	}
+	mockState.Lock()
+	mockState.classes["CLS-1001"] = testClass
+	mockState.Unlock()
+}
@@ -0,0 +1,95 @@
+package classes
+
+import "time"
+
+// Shared pagination types
+
+type Meta struct {
+	Paging *Paging `json:"paging,omitempty"`
+}
+
+type Paging struct {
+	Total      int    `json:"total,omitempty"`
+	Limit      int    `json:"limit,omitempty"`
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
+type Links struct {
+	Self  string `json:"self,omitempty"`
+	First string `json:"first,omitempty"`
+	Next  string `json:"next,omitempty"`
+	Prev  string `json:"prev,omitempty"`
+	Last  string `json:"last,omitempty"`
+}
+
+type ResourceLinks struct {
+	Self    string `json:"self,omitempty"`
+	Related string `json:"related,omitempty"`
+}
+
+// ClassesResponse is the response for a list of classes.
+type ClassesResponse struct {
+	Data  []Class `json:"data"`
+	Links *Links  `json:"links,omitempty"`
+	Meta  *Meta   `json:"meta,omitempty"`
+}
+
+// ClassResponse is the response for a single class.
+type ClassResponse struct {
+	Data  Class  `json:"data"`
+	Links *Links `json:"links,omitempty"`
+}
+
+// Class represents an Apple School Manager class resource.
+type Class struct {
+	ID            string              `json:"id"`
+	Type          string              `json:"type"`
+	Attributes    *ClassAttributes    `json:"attributes,omitempty"`
+	Relationships *ClassRelationships `json:"relationships,omitempty"`
+	Links         *ResourceLinks      `json:"links,omitempty"`
+}
+
+// ClassAttributes contains the attributes of a class.
+type ClassAttributes struct {
+	Name            string     `json:"name,omitempty"`
+	ClassNumber     string     `json:"classNumber,omitempty"`
+	CourseName      string     `json:"courseName,omitempty"`
+	LocationId      string     `json:"locationId,omitempty"`
+	Source          string     `json:"source,omitempty"`
+	Status          string     `json:"status,omitempty"`
+	CreatedDateTime *time.Time `json:"createdDateTime,omitempty"`
+	UpdatedDateTime *time.Time `json:"updatedDateTime,omitempty"`
+}
+
+// ClassRelationships contains relationship links for a class.
+type ClassRelationships struct {
+	Students    *RelationshipData `json:"students,omitempty"`
+	Instructors *RelationshipData `json:"instructors,omitempty"`
+}
+
+// RelationshipData holds the links for a relationship.
+type RelationshipData struct {
+	Links *ResourceLinks `json:"links,omitempty"`
+}
+
+// ClassMembersLinkagesResponse is the response for the student or instructor
+// ID linkages of a class.
+type ClassMembersLinkagesResponse struct {
+	Data  []ClassMemberLinkage `json:"data"`
+	Links *Links               `json:"links,omitempty"`
+	Meta  *Meta                `json:"meta,omitempty"`
+}
+
+// ClassMemberLinkage represents a person linkage (type + ID only).
+type ClassMemberLinkage struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// RequestQueryOptions represents query parameters for class endpoints.
+type RequestQueryOptions struct {
+	// Fields specifies which fields to return. Use Field* constants.
+	Fields []string
+	// Limit is the number of resources to return (max 1000).
+	Limit int
+}
@@ -0,0 +1,178 @@
+package devicemanagement
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/constants"
+	"resty.dev/v3"
+)
+
+// ActivityDeviceResult is one device row of an activity report.
+type ActivityDeviceResult struct {
+	SerialNumber string `json:"serialNumber"`
+
+	// Outcome is the row's status or result column, as reported.
+	Outcome string `json:"outcome,omitempty"`
+
+	// FailureReason explains why the device was not processed. It is empty
+	// for devices that succeeded.
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// Failed reports whether the device was not processed: it has a failure
+// reason, or an outcome other than a success value such as SUCCESS or
+// COMPLETED. A row with neither is treated as successful.
+func (r ActivityDeviceResult) Failed() bool {
+	if r.FailureReason != "" {
+		return true
+	}
+	return r.Outcome != "" && !slices.Contains(successOutcomes, normalizeReportToken(r.Outcome))
+}
+
+// ActivityReport is the per-device report of an org device activity, parsed
+// from the CSV at the activity's download URL.
+type ActivityReport struct {
+	Devices []ActivityDeviceResult `json:"devices"`
+}
+
+// FailedDevices returns the devices that were not processed, in report order.
+func (r *ActivityReport) FailedDevices() []ActivityDeviceResult {
+	var failed []ActivityDeviceResult
+	for _, d := range r.Devices {
+		if d.Failed() {
+			failed = append(failed, d)
+		}
+	}
+	return failed
+}
+
+// FailedSerialNumbers returns the serial numbers of FailedDevices, ready to be
+// resubmitted to AssignDevicesV1 or UnassignDevicesV1.
+func (r *ActivityReport) FailedSerialNumbers() []string {
+	var serials []string
+	for _, d := range r.FailedDevices() {
+		serials = append(serials, d.SerialNumber)
+	}
+	return serials
+}
+
+// Recognised activity report column headers, normalized by
+// normalizeReportToken. The report's column names are not documented, so
+// the common spellings of each are accepted.
+var (
+	serialReportHeaders  = []string{"serialnumber", "serial", "deviceserialnumber", "deviceid"}
+	outcomeReportHeaders = []string{"status", "result", "outcome"}
+	reasonReportHeaders  = []string{"failurereason", "reason", "error", "errormessage", "message", "details"}
+)
+
+// successOutcomes are the normalized outcome values that mean a device was
+// processed.
+var successOutcomes = []string{"success", "succeeded", "successful", "completed", "ok"}
+
+// ErrNoActivityReport is returned by GetActivityReportV1 when the activity has
+// no download URL, such as while it is still in progress.
+var ErrNoActivityReport = errors.New("activity has no report download URL")
+
+// ParseActivityReport parses an activity report CSV. The first row must be a
+// header naming a serial number column; outcome and failure reason columns are
+// optional. Header names are matched case-insensitively, ignoring spaces,
+// underscores and hyphens. Rows without a serial number are skipped.
+func ParseActivityReport(r io.Reader) (*ActivityReport, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err == io.EOF {
+		return &ActivityReport{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parse activity report: %w", err)
+	}
+
+	serialCol, outcomeCol, reasonCol := -1, -1, -1
+	for i, name := range header {
+		name = normalizeReportToken(strings.TrimPrefix(name, "\ufeff"))
+		switch {
+		case serialCol < 0 && slices.Contains(serialReportHeaders, name):
+			serialCol = i
+		case outcomeCol < 0 && slices.Contains(outcomeReportHeaders, name):
+			outcomeCol = i
+		case reasonCol < 0 && slices.Contains(reasonReportHeaders, name):
+			reasonCol = i
+		}
+	}
+	if serialCol < 0 {
+		return nil, fmt.Errorf("parse activity report: no serial number column in header %q", header)
+	}
+
+	report := &ActivityReport{}
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return report, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parse activity report: %w", err)
+		}
+		row := ActivityDeviceResult{
+			SerialNumber:  reportCell(record, serialCol),
+			Outcome:       reportCell(record, outcomeCol),
+			FailureReason: reportCell(record, reasonCol),
+		}
+		if row.SerialNumber == "" {
+			continue
+		}
+		report.Devices = append(report.Devices, row)
+	}
+}
+
+// GetActivityReportV1 downloads and parses the per-device report of a
+// finished activity from its download URL.
+// URL: GET {activity.attributes.downloadUrl}
+func (s *DeviceManagement) GetActivityReportV1(ctx context.Context, activity OrgDeviceActivity) (*ActivityReport, *resty.Response, error) {
+	if activity.Attributes == nil || activity.Attributes.DownloadURL == "" {
+		return nil, nil, ErrNoActivityReport
+	}
+
+	resp, body, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.TextCSV).
+		GetBytes(activity.Attributes.DownloadURL)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	report, err := ParseActivityReport(bytes.NewReader(body))
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return report, resp, nil
+}
+
+// reportCell returns the trimmed cell at col, or "" if col is absent.
+func reportCell(record []string, col int) string {
+	if col < 0 || col >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[col])
+}
+
+// normalizeReportToken lowercases s and drops spaces, underscores and hyphens.
+func normalizeReportToken(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '_', '-':
+			return -1
+		}
+		return r
+	}, strings.ToLower(strings.TrimSpace(s)))
+}
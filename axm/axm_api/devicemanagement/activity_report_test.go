@@ -0,0 +1,93 @@
+package devicemanagement
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseActivityReport(t *testing.T) {
+	csv := "\ufeffSerial Number,Status,Failure Reason\n" +
+		"XABC123X0ABC123X0,SUCCESS,\n" +
+		"YABC123X0ABC123X0,FAILED,Device not found in organization\n" +
+		",SUCCESS,\n" +
+		"ZABC123X0ABC123X0,Completed\n"
+
+	report, err := ParseActivityReport(strings.NewReader(csv))
+
+	require.NoError(t, err)
+	require.Len(t, report.Devices, 3)
+	assert.Equal(t, ActivityDeviceResult{SerialNumber: "XABC123X0ABC123X0", Outcome: "SUCCESS"}, report.Devices[0])
+	assert.Equal(t, "Device not found in organization", report.Devices[1].FailureReason)
+	assert.Equal(t, "Completed", report.Devices[2].Outcome)
+
+	failed := report.FailedDevices()
+	require.Len(t, failed, 1)
+	assert.Equal(t, "YABC123X0ABC123X0", failed[0].SerialNumber)
+	assert.Equal(t, []string{"YABC123X0ABC123X0"}, report.FailedSerialNumbers())
+}
+
+func TestParseActivityReport_AlternateHeaders(t *testing.T) {
+	csv := "result,serial_number,error_message\n" +
+		"ok,XABC123X0ABC123X0,\n" +
+		"error,YABC123X0ABC123X0,\n"
+
+	report, err := ParseActivityReport(strings.NewReader(csv))
+
+	require.NoError(t, err)
+	require.Len(t, report.Devices, 2)
+	assert.False(t, report.Devices[0].Failed())
+	assert.True(t, report.Devices[1].Failed())
+}
+
+func TestParseActivityReport_NoSerialColumn(t *testing.T) {
+	_, err := ParseActivityReport(strings.NewReader("status,reason\nSUCCESS,\n"))
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no serial number column")
+}
+
+func TestParseActivityReport_Empty(t *testing.T) {
+	report, err := ParseActivityReport(strings.NewReader(""))
+
+	require.NoError(t, err)
+	assert.Empty(t, report.Devices)
+	assert.Empty(t, report.FailedDevices())
+}
+
+func TestGetActivityReport_Success(t *testing.T) {
+	svc := setupMockClient(t)
+
+	downloadURL := "https://api-business.apple.com/v1/orgDeviceActivities/act-1/report.csv"
+	httpmock.RegisterResponder("GET", downloadURL,
+		httpmock.NewStringResponder(200, "Serial Number,Status,Failure Reason\nXABC123X0ABC123X0,FAILED,Device is not eligible\n"))
+
+	activity := OrgDeviceActivity{ID: "act-1", Attributes: &OrgDeviceActivityAttributes{
+		Status:      ActivityStatusCompleted,
+		DownloadURL: downloadURL,
+	}}
+	report, resp, err := svc.GetActivityReportV1(context.Background(), activity)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Len(t, report.FailedDevices(), 1)
+	assert.Equal(t, "Device is not eligible", report.FailedDevices()[0].FailureReason)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestGetActivityReport_NoDownloadURL(t *testing.T) {
+	svc := setupMockClient(t)
+
+	activity := OrgDeviceActivity{ID: "act-1", Attributes: &OrgDeviceActivityAttributes{Status: ActivityStatusInProgress}}
+	report, _, err := svc.GetActivityReportV1(context.Background(), activity)
+
+	require.ErrorIs(t, err, ErrNoActivityReport)
+	assert.Nil(t, report)
+
+	// No HTTP call should be made
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
@@ -0,0 +1,231 @@
+package devicemanagement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/client"
+	"resty.dev/v3"
+)
+
+// DefaultActivityPollInterval is the poll interval AssignDevicesWithRetryV1 and
+// UnassignDevicesWithRetryV1 use when AssignmentRetryOptions.Interval is not
+// set.
+const DefaultActivityPollInterval = 10 * time.Second
+
+// transientFailureHints are lowercase fragments of failure reasons that mark a
+// device failure as worth retrying. Apple does not document its failure
+// reasons, so this is a best-effort match; set
+// AssignmentRetryOptions.Retryable to decide precisely.
+var transientFailureHints = []string{
+	"try again",
+	"retry",
+	"temporar",
+	"timeout",
+	"timed out",
+	"unavailable",
+	"busy",
+	"rate limit",
+	"internal error",
+	"server error",
+}
+
+// IsTransientFailure reports whether a failed device looks worth retrying,
+// judged by its failure reason or outcome. It is the default
+// AssignmentRetryOptions.Retryable.
+func IsTransientFailure(r ActivityDeviceResult) bool {
+	text := strings.ToLower(r.FailureReason + " " + r.Outcome)
+	for _, hint := range transientFailureHints {
+		if strings.Contains(text, hint) {
+			return true
+		}
+	}
+	return false
+}
+
+// AssignmentRetryOptions configures AssignDevicesWithRetryV1 and
+// UnassignDevicesWithRetryV1.
+type AssignmentRetryOptions struct {
+	// MaxRetries is the number of times failed devices are resubmitted after
+	// the first activity. Zero submits once and reports the failures.
+	MaxRetries int
+
+	// Interval is the activity poll interval. Defaults to
+	// DefaultActivityPollInterval.
+	Interval time.Duration
+
+	// PollOptions are passed to WaitForActivityV1 for every activity.
+	PollOptions []PollOption
+
+	// Retryable decides which failed devices are resubmitted. Defaults to
+	// IsTransientFailure.
+	Retryable func(ActivityDeviceResult) bool
+}
+
+// DeviceAssignment is the outcome of AssignDevicesWithRetryV1 or
+// UnassignDevicesWithRetryV1 for one device.
+type DeviceAssignment struct {
+	// ActivityID is the last activity the device was submitted in.
+	ActivityID string `json:"activityId"`
+
+	// Attempts is the number of activities the device was submitted in.
+	Attempts int `json:"attempts"`
+
+	// Failure is the device's row from the last activity's report, or a
+	// synthesized row when the whole activity failed. It is nil when the
+	// device succeeded or was not resolved.
+	Failure *ActivityDeviceResult `json:"failure,omitempty"`
+}
+
+// AssignmentResult is the consolidated outcome of AssignDevicesWithRetryV1 or
+// UnassignDevicesWithRetryV1. Its BatchResult has one item per distinct
+// device ID, in the order given. A device that failed has a
+// *DeviceFailureError; a device left unresolved because the operation stopped
+// early has client.ErrNotProcessed. Err returns them as a *client.MultiError
+// keyed by device ID.
+type AssignmentResult struct {
+	*client.BatchResult[DeviceAssignment]
+
+	// ActivityIDs lists every activity created, in submission order.
+	ActivityIDs []string `json:"activityIds"`
+}
+
+// DeviceFailureError reports a device that failed in an activity.
+type DeviceFailureError struct {
+	Result ActivityDeviceResult
+}
+
+func (e *DeviceFailureError) Error() string {
+	msg := "device failed"
+	if e.Result.Outcome != "" {
+		msg += " with " + e.Result.Outcome
+	}
+	if e.Result.FailureReason != "" {
+		msg += ": " + e.Result.FailureReason
+	}
+	return msg
+}
+
+// AssignDevicesWithRetryV1 assigns devices to an MDM server, waits for the
+// activity and, when its report lists failed devices, resubmits those that
+// opts.Retryable accepts, up to opts.MaxRetries times. See
+// UnassignDevicesWithRetryV1 for the error behaviour.
+// URL: POST https://api-business.apple.com/v1/orgDeviceActivities
+func (s *DeviceManagement) AssignDevicesWithRetryV1(ctx context.Context, mdmServerID string, deviceIDs []string, opts *AssignmentRetryOptions) (*AssignmentResult, error) {
+	return s.submitWithRetry(ctx, s.AssignDevicesV1, mdmServerID, deviceIDs, opts)
+}
+
+// UnassignDevicesWithRetryV1 unassigns devices from an MDM server, waits for
+// the activity and, when its report lists failed devices, resubmits those that
+// opts.Retryable accepts, up to opts.MaxRetries times.
+//
+// If submitting, waiting or fetching a report fails, the result so far is
+// returned with the error; devices not yet resolved are reported as failed.
+// URL: POST https://api-business.apple.com/v1/orgDeviceActivities
+func (s *DeviceManagement) UnassignDevicesWithRetryV1(ctx context.Context, mdmServerID string, deviceIDs []string, opts *AssignmentRetryOptions) (*AssignmentResult, error) {
+	return s.submitWithRetry(ctx, s.UnassignDevicesV1, mdmServerID, deviceIDs, opts)
+}
+
+// activitySubmitter is AssignDevicesV1 or UnassignDevicesV1.
+type activitySubmitter func(ctx context.Context, mdmServerID string, deviceIDs []string) (*ResponseOrgDeviceActivity, *resty.Response, error)
+
+// submitWithRetry implements AssignDevicesWithRetryV1 and
+// UnassignDevicesWithRetryV1.
+func (s *DeviceManagement) submitWithRetry(ctx context.Context, submit activitySubmitter, mdmServerID string, deviceIDs []string, opts *AssignmentRetryOptions) (*AssignmentResult, error) {
+	if err := client.ValidateID("MDM server ID", mdmServerID); err != nil {
+		return nil, err
+	}
+	if err := client.ValidateIDs("device ID", deviceIDs); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = &AssignmentRetryOptions{}
+	}
+	if opts.MaxRetries < 0 {
+		return nil, fmt.Errorf("max retries cannot be negative")
+	}
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = DefaultActivityPollInterval
+	}
+	retryable := opts.Retryable
+	if retryable == nil {
+		retryable = IsTransientFailure
+	}
+
+	result := &AssignmentResult{BatchResult: client.NewBatchResult[DeviceAssignment](deviceIDs)}
+	pending := result.Keys()
+
+	for attempt := 0; len(pending) > 0 && attempt <= opts.MaxRetries; attempt++ {
+		created, _, err := submit(ctx, mdmServerID, pending)
+		if err != nil {
+			return result, fmt.Errorf("submit %d devices: %w", len(pending), err)
+		}
+		activityID := created.Data.ID
+		result.ActivityIDs = append(result.ActivityIDs, activityID)
+		for _, id := range pending {
+			item, _ := result.Get(id)
+			d := item.Value
+			d.ActivityID = activityID
+			d.Attempts++
+			d.Failure = nil
+			result.Set(id, d, client.ErrNotProcessed)
+		}
+
+		final, err := s.WaitForActivityV1(ctx, activityID, interval, opts.PollOptions...)
+		if err != nil {
+			return result, err
+		}
+		failures, err := s.activityFailures(ctx, final.Data, pending)
+		if err != nil {
+			return result, err
+		}
+
+		var retry []string
+		for _, id := range pending {
+			item, _ := result.Get(id)
+			d := item.Value
+			failure, failed := failures[strings.ToUpper(id)]
+			if !failed {
+				result.Set(id, d, nil)
+				continue
+			}
+			d.Failure = &failure
+			result.Set(id, d, &DeviceFailureError{Result: failure})
+			if retryable(failure) {
+				retry = append(retry, id)
+			}
+		}
+		pending = retry
+	}
+	return result, nil
+}
+
+// activityFailures returns the devices of a finished activity that failed,
+// keyed by upper-case device ID. They are read from the activity's report when
+// it has one; otherwise every device is failed if the activity did not
+// succeed.
+func (s *DeviceManagement) activityFailures(ctx context.Context, activity OrgDeviceActivity, deviceIDs []string) (map[string]ActivityDeviceResult, error) {
+	failures := make(map[string]ActivityDeviceResult)
+
+	report, _, err := s.GetActivityReportV1(ctx, activity)
+	switch {
+	case err == nil:
+		for _, d := range report.FailedDevices() {
+			failures[strings.ToUpper(d.SerialNumber)] = d
+		}
+		return failures, nil
+	case !errors.Is(err, ErrNoActivityReport):
+		return nil, fmt.Errorf("activity %s report: %w", activity.ID, err)
+	case activity.Succeeded():
+		return failures, nil
+	}
+
+	for _, id := range deviceIDs {
+		failures[strings.ToUpper(id)] = ActivityDeviceResult{SerialNumber: id, Outcome: string(activity.status())}
+	}
+	return failures, nil
+}
@@ -0,0 +1,171 @@
+package devicemanagement
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/client"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// registerRetryActivities serves one activity per POST, act-1, act-2 and so
+// on. Each completes immediately with the report reports[n-1], and the device
+// IDs of every submission are appended to submitted.
+func registerRetryActivities(t *testing.T, reports []string, submitted *[][]string) {
+	t.Helper()
+	httpmock.RegisterResponder("POST", "https://api-business.apple.com/v1/orgDeviceActivities",
+		func(req *http.Request) (*http.Response, error) {
+			var body OrgDeviceActivityCreateRequest
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				return nil, err
+			}
+			var ids []string
+			for _, d := range body.Data.Relationships.Devices.Data {
+				ids = append(ids, d.ID)
+			}
+			*submitted = append(*submitted, ids)
+			return httpmock.NewJsonResponse(201, map[string]any{
+				"data": map[string]any{"type": "orgDeviceActivities", "id": fmt.Sprintf("act-%d", len(*submitted)),
+					"attributes": map[string]any{"status": ActivityStatusInProgress}},
+			})
+		})
+	for i, report := range reports {
+		id := fmt.Sprintf("act-%d", i+1)
+		url := "https://api-business.apple.com/v1/orgDeviceActivities/" + id
+		httpmock.RegisterResponder("GET", url, func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewJsonResponse(200, map[string]any{
+				"data": map[string]any{"type": "orgDeviceActivities", "id": id,
+					"attributes": map[string]any{"status": ActivityStatusCompleted, "downloadUrl": url + "/report"}},
+			})
+		})
+		httpmock.RegisterResponder("GET", url+"/report", httpmock.NewStringResponder(200, report))
+	}
+}
+
+func TestAssignDevicesWithRetry_ResubmitsTransientFailures(t *testing.T) {
+	svc := setupMockClient(t)
+	var submitted [][]string
+	registerRetryActivities(t, []string{
+		"Serial Number,Status,Failure Reason\n" +
+			"D1,SUCCESS,\n" +
+			"D2,FAILED,Service temporarily unavailable\n" +
+			"D3,FAILED,Device not found in organization\n",
+		"Serial Number,Status,Failure Reason\nD2,SUCCESS,\n",
+	}, &submitted)
+
+	result, err := svc.AssignDevicesWithRetryV1(context.Background(), "SRV1", []string{"D1", "D2", "D3", "D1"},
+		&AssignmentRetryOptions{MaxRetries: 2, Interval: time.Millisecond})
+
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"D1", "D2", "D3"}, {"D2"}}, submitted)
+	assert.Equal(t, []string{"act-1", "act-2"}, result.ActivityIDs)
+	assert.Equal(t, []string{"D1", "D2", "D3"}, result.Keys())
+	assert.Equal(t, client.BatchItem[DeviceAssignment]{Key: "D1", Value: DeviceAssignment{ActivityID: "act-1", Attempts: 1}}, result.Items[0])
+	assert.Equal(t, client.BatchItem[DeviceAssignment]{Key: "D2", Value: DeviceAssignment{ActivityID: "act-2", Attempts: 2}}, result.Items[1])
+
+	assert.False(t, result.AllSucceeded())
+	failed := result.Failed()
+	require.Len(t, failed, 1)
+	assert.Equal(t, "D3", failed[0].Key)
+	assert.Equal(t, 1, failed[0].Value.Attempts)
+	require.NotNil(t, failed[0].Value.Failure)
+	assert.Equal(t, "Device not found in organization", failed[0].Value.Failure.FailureReason)
+
+	err = result.Err()
+	var multi *client.MultiError
+	require.ErrorAs(t, err, &multi)
+	assert.Equal(t, []string{"D3"}, multi.Keys())
+	var failure *DeviceFailureError
+	require.ErrorAs(t, err, &failure)
+	assert.Equal(t, "D3", failure.Result.SerialNumber)
+	assert.EqualError(t, err, "D3: device failed with FAILED: Device not found in organization")
+
+	data, err := json.Marshal(result)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"items":[
+		{"key":"D1","value":{"activityId":"act-1","attempts":1}},
+		{"key":"D2","value":{"activityId":"act-2","attempts":2}},
+		{"key":"D3","value":{"activityId":"act-1","attempts":1,"failure":{"serialNumber":"D3","outcome":"FAILED","failureReason":"Device not found in organization"}},
+		 "error":"device failed with FAILED: Device not found in organization"}
+	],"activityIds":["act-1","act-2"]}`, string(data))
+}
+
+func TestAssignDevicesWithRetry_StopsAtMaxRetries(t *testing.T) {
+	svc := setupMockClient(t)
+	var submitted [][]string
+	registerRetryActivities(t, []string{
+		"Serial Number,Status,Failure Reason\nD1,FAILED,Timed out\n",
+		"Serial Number,Status,Failure Reason\nD1,FAILED,Timed out\n",
+	}, &submitted)
+
+	result, err := svc.UnassignDevicesWithRetryV1(context.Background(), "SRV1", []string{"D1"},
+		&AssignmentRetryOptions{MaxRetries: 1, Interval: time.Millisecond})
+
+	require.NoError(t, err)
+	assert.Len(t, submitted, 2)
+	require.Len(t, result.Failed(), 1)
+	assert.Equal(t, 2, result.Failed()[0].Value.Attempts)
+	assert.Equal(t, "act-2", result.Failed()[0].Value.ActivityID)
+}
+
+func TestAssignDevicesWithRetry_FailedActivityWithoutReport(t *testing.T) {
+	svc := setupMockClient(t)
+	httpmock.RegisterResponder("POST", "https://api-business.apple.com/v1/orgDeviceActivities",
+		httpmock.NewJsonResponderOrPanic(201, map[string]any{
+			"data": map[string]any{"type": "orgDeviceActivities", "id": "act-1", "attributes": map[string]any{"status": ActivityStatusInProgress}},
+		}))
+	httpmock.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDeviceActivities/act-1",
+		httpmock.NewJsonResponderOrPanic(200, map[string]any{
+			"data": map[string]any{"type": "orgDeviceActivities", "id": "act-1", "attributes": map[string]any{"status": ActivityStatusFailed}},
+		}))
+
+	result, err := svc.AssignDevicesWithRetryV1(context.Background(), "SRV1", []string{"D1", "D2"},
+		&AssignmentRetryOptions{MaxRetries: 3, Interval: time.Millisecond})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, httpmock.GetCallCountInfo()["POST https://api-business.apple.com/v1/orgDeviceActivities"])
+	require.Len(t, result.Failed(), 2)
+	assert.Equal(t, "FAILED", result.Failed()[0].Value.Failure.Outcome)
+	assert.NotErrorIs(t, result.Err(), client.ErrNotProcessed)
+}
+
+func TestAssignDevicesWithRetry_SubmitErrorLeavesDevicesUnprocessed(t *testing.T) {
+	svc := setupMockClient(t)
+	httpmock.RegisterResponder("POST", "https://api-business.apple.com/v1/orgDeviceActivities",
+		httpmock.NewJsonResponderOrPanic(400, map[string]any{
+			"errors": []map[string]any{{"status": "400", "code": "BAD_REQUEST", "detail": "Bad request"}},
+		}))
+
+	result, err := svc.AssignDevicesWithRetryV1(context.Background(), "SRV1", []string{"D1", "D2"}, nil)
+
+	require.Error(t, err)
+	assert.ErrorIs(t, result.Err(), client.ErrNotProcessed)
+	var multi *client.MultiError
+	require.ErrorAs(t, result.Err(), &multi)
+	assert.Equal(t, []string{"D1", "D2"}, multi.Keys())
+}
+
+func TestAssignDevicesWithRetry_InvalidInput(t *testing.T) {
+	svc := setupMockClient(t)
+
+	_, err := svc.AssignDevicesWithRetryV1(context.Background(), "SRV1", nil, nil)
+	assert.ErrorContains(t, err, "at least one device ID is required")
+
+	_, err = svc.AssignDevicesWithRetryV1(context.Background(), "SRV1", []string{"D1"}, &AssignmentRetryOptions{MaxRetries: -1})
+	assert.ErrorContains(t, err, "max retries cannot be negative")
+
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
+
+func TestIsTransientFailure(t *testing.T) {
+	assert.True(t, IsTransientFailure(ActivityDeviceResult{FailureReason: "Internal error, please try again later"}))
+	assert.True(t, IsTransientFailure(ActivityDeviceResult{Outcome: "TIMEOUT"}))
+	assert.False(t, IsTransientFailure(ActivityDeviceResult{FailureReason: "Device not found"}))
+	assert.False(t, IsTransientFailure(ActivityDeviceResult{Outcome: "FAILED"}))
+}
@@ -6,19 +6,57 @@ const (
 	ActivityTypeUnassignDevices = "UNASSIGN_DEVICES"
 )
 
+// ActivityStatus is the status of an org device activity.
+type ActivityStatus string
+
 // Activity status constants
 const (
-	ActivityStatusInProgress = "IN_PROGRESS"
-	ActivityStatusCompleted  = "COMPLETED"
-	ActivityStatusFailed     = "FAILED"
+	ActivityStatusInProgress ActivityStatus = "IN_PROGRESS"
+	ActivityStatusCompleted  ActivityStatus = "COMPLETED"
+	ActivityStatusFailed     ActivityStatus = "FAILED"
 )
 
-// Activity sub-status constants
+// Terminal reports whether an activity with this status has finished. Any
+// status other than IN_PROGRESS is terminal; the empty status is not.
+func (s ActivityStatus) Terminal() bool {
+	return s != "" && s != ActivityStatusInProgress
+}
+
+// Succeeded reports whether the activity completed.
+func (s ActivityStatus) Succeeded() bool {
+	return s == ActivityStatusCompleted
+}
+
+// Failed reports whether the activity finished without completing.
+func (s ActivityStatus) Failed() bool {
+	return s.Terminal() && !s.Succeeded()
+}
+
+// ActivitySubStatus is the sub-status that details an activity's progress.
+type ActivitySubStatus string
+
+// Activity sub-status constants. SUBMITTED and PROCESSING accompany
+// IN_PROGRESS; COMPLETED_WITH_SUCCESS is reported once the activity has
+// finished.
 const (
-	ActivitySubStatusSubmitted  = "SUBMITTED"
-	ActivitySubStatusProcessing = "PROCESSING"
+	ActivitySubStatusSubmitted            ActivitySubStatus = "SUBMITTED"
+	ActivitySubStatusProcessing           ActivitySubStatus = "PROCESSING"
+	ActivitySubStatusCompletedWithSuccess ActivitySubStatus = "COMPLETED_WITH_SUCCESS"
 )
 
+// Terminal reports whether an activity with this sub-status has finished.
+// Like ActivityStatus.Terminal, any sub-status other than SUBMITTED and
+// PROCESSING is terminal, so values Apple adds later are not mistaken for
+// work still in progress; the empty sub-status is not.
+func (s ActivitySubStatus) Terminal() bool {
+	return s != "" && s != ActivitySubStatusSubmitted && s != ActivitySubStatusProcessing
+}
+
+// Succeeded reports whether the activity completed with success.
+func (s ActivitySubStatus) Succeeded() bool {
+	return s == ActivitySubStatusCompletedWithSuccess
+}
+
 // MDM Server field constants for field selection
 const (
 	FieldServerName             = "serverName"
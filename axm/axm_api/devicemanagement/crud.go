@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-apple/axm/client"
 	"github.com/deploymenttheory/go-api-sdk-apple/axm/constants"
@@ -17,6 +19,9 @@ import (
 type (
 	DeviceManagement struct {
 		client client.Client
+
+		journalMu sync.RWMutex
+		journal   ActivityJournal
 	}
 )
 
@@ -80,8 +85,8 @@ func (s *DeviceManagement) GetV1(ctx context.Context, opts *RequestQueryOptions)
 // URL: GET https://api-business.apple.com/v1/mdmServers/{id}
 // https://developer.apple.com/documentation/applebusinessmanagerapi/get-device-management-service-information
 func (s *DeviceManagement) GetByMDMServerIDV1(ctx context.Context, serverID string, opts *RequestQueryOptions) (*MDMServerResponse, *resty.Response, error) {
-	if serverID == "" {
-		return nil, nil, fmt.Errorf("MDM server ID is required")
+	if err := client.ValidateID("MDM server ID", serverID); err != nil {
+		return nil, nil, err
 	}
 
 	if opts == nil {
@@ -150,8 +155,8 @@ func (s *DeviceManagement) CreateMDMServerV1(ctx context.Context, req *MDMServer
 // URL: PATCH https://api-business.apple.com/v1/mdmServers/{id}
 // https://developer.apple.com/documentation/applebusinessmanagerapi/update-a-device-management-service
 func (s *DeviceManagement) UpdateMDMServerByIDV1(ctx context.Context, serverID string, req *MDMServerUpdateRequest) (*MDMServerResponse, *resty.Response, error) {
-	if serverID == "" {
-		return nil, nil, fmt.Errorf("MDM server ID is required")
+	if err := client.ValidateID("MDM server ID", serverID); err != nil {
+		return nil, nil, err
 	}
 	if req == nil {
 		return nil, nil, fmt.Errorf("request is required")
@@ -180,8 +185,8 @@ func (s *DeviceManagement) UpdateMDMServerByIDV1(ctx context.Context, serverID s
 // https://developer.apple.com/documentation/applebusinessmanagerapi/delete-a-device-management-service
 // Note: A server with devices assigned cannot be deleted. Returns 204 No Content on success.
 func (s *DeviceManagement) DeleteMDMServerByIDV1(ctx context.Context, serverID string) (*resty.Response, error) {
-	if serverID == "" {
-		return nil, fmt.Errorf("MDM server ID is required")
+	if err := client.ValidateID("MDM server ID", serverID); err != nil {
+		return nil, err
 	}
 
 	endpoint := fmt.Sprintf(constants.EndpointMDMServers+"/%s", serverID)
@@ -202,8 +207,8 @@ func (s *DeviceManagement) DeleteMDMServerByIDV1(ctx context.Context, serverID s
 // URL: GET https://api-business.apple.com/v1/mdmServers/{id}/relationships/devices
 // https://developer.apple.com/documentation/applebusinessmanagerapi/get-all-device-ids-for-a-device-management-service
 func (s *DeviceManagement) GetDeviceSerialNumbersByServerIDV1(ctx context.Context, mdmServerID string, opts *RequestQueryOptions) (*ResponseMDMServerDevicesLinkages, *resty.Response, error) {
-	if mdmServerID == "" {
-		return nil, nil, fmt.Errorf("MDM server ID is required")
+	if err := client.ValidateID("MDM server ID", mdmServerID); err != nil {
+		return nil, nil, err
 	}
 
 	if opts == nil {
@@ -255,8 +260,8 @@ func (s *DeviceManagement) GetDeviceSerialNumbersByServerIDV1(ctx context.Contex
 // URL: GET https://api-business.apple.com/v1/orgDevices/{id}/relationships/assignedServer
 // https://developer.apple.com/documentation/applebusinessmanagerapi/get-the-assigned-device-management-service-id-for-an-orgdevice
 func (s *DeviceManagement) GetAssignedServerIDByDeviceIDV1(ctx context.Context, deviceID string) (*ResponseOrgDeviceAssignedServerLinkage, *resty.Response, error) {
-	if deviceID == "" {
-		return nil, nil, fmt.Errorf("device ID is required")
+	if err := client.ValidateID("device ID", deviceID); err != nil {
+		return nil, nil, err
 	}
 
 	endpoint := fmt.Sprintf(constants.EndpointOrgDevices+"/%s/relationships/assignedServer", deviceID)
@@ -280,8 +285,8 @@ func (s *DeviceManagement) GetAssignedServerIDByDeviceIDV1(ctx context.Context,
 // URL: GET https://api-business.apple.com/v1/orgDevices/{id}/assignedServer
 // https://developer.apple.com/documentation/applebusinessmanagerapi/get-the-assigned-device-management-service-information-for-an-orgdevice
 func (s *DeviceManagement) GetAssignedServerInfoByDeviceIDV1(ctx context.Context, deviceID string, opts *RequestQueryOptions) (*MDMServerResponse, *resty.Response, error) {
-	if deviceID == "" {
-		return nil, nil, fmt.Errorf("device ID is required")
+	if err := client.ValidateID("device ID", deviceID); err != nil {
+		return nil, nil, err
 	}
 
 	if opts == nil {
@@ -316,11 +321,11 @@ func (s *DeviceManagement) GetAssignedServerInfoByDeviceIDV1(ctx context.Context
 // URL: POST https://api-business.apple.com/v1/orgDeviceActivities
 // https://developer.apple.com/documentation/applebusinessmanagerapi/create-an-orgdeviceactivity
 func (s *DeviceManagement) AssignDevicesV1(ctx context.Context, mdmServerID string, deviceIDs []string) (*ResponseOrgDeviceActivity, *resty.Response, error) {
-	if mdmServerID == "" {
-		return nil, nil, fmt.Errorf("MDM server ID is required")
+	if err := client.ValidateID("MDM server ID", mdmServerID); err != nil {
+		return nil, nil, err
 	}
-	if len(deviceIDs) == 0 {
-		return nil, nil, fmt.Errorf("at least one device ID is required")
+	if err := client.ValidateIDs("device ID", deviceIDs); err != nil {
+		return nil, nil, err
 	}
 
 	deviceLinkages := make([]OrgDeviceActivityDeviceLinkage, len(deviceIDs))
@@ -364,6 +369,8 @@ func (s *DeviceManagement) AssignDevicesV1(ctx context.Context, mdmServerID stri
 		return nil, resp, err
 	}
 
+	s.journalCreated(ctx, result.Data, mdmServerID, deviceIDs)
+
 	return &result, resp, nil
 }
 
@@ -371,11 +378,11 @@ func (s *DeviceManagement) AssignDevicesV1(ctx context.Context, mdmServerID stri
 // URL: POST https://api-business.apple.com/v1/orgDeviceActivities
 // https://developer.apple.com/documentation/applebusinessmanagerapi/create-an-orgdeviceactivity
 func (s *DeviceManagement) UnassignDevicesV1(ctx context.Context, mdmServerID string, deviceIDs []string) (*ResponseOrgDeviceActivity, *resty.Response, error) {
-	if mdmServerID == "" {
-		return nil, nil, fmt.Errorf("MDM server ID is required")
+	if err := client.ValidateID("MDM server ID", mdmServerID); err != nil {
+		return nil, nil, err
 	}
-	if len(deviceIDs) == 0 {
-		return nil, nil, fmt.Errorf("at least one device ID is required")
+	if err := client.ValidateIDs("device ID", deviceIDs); err != nil {
+		return nil, nil, err
 	}
 
 	deviceLinkages := make([]OrgDeviceActivityDeviceLinkage, len(deviceIDs))
@@ -419,5 +426,142 @@ func (s *DeviceManagement) UnassignDevicesV1(ctx context.Context, mdmServerID st
 		return nil, resp, err
 	}
 
+	s.journalCreated(ctx, result.Data, mdmServerID, deviceIDs)
+
 	return &result, resp, nil
 }
+
+// GetActivityByIDV1 retrieves the status of an org device activity, such as
+// one returned by AssignDevicesV1 or UnassignDevicesV1.
+// URL: GET https://api-business.apple.com/v1/orgDeviceActivities/{id}
+func (s *DeviceManagement) GetActivityByIDV1(ctx context.Context, activityID string) (*ResponseOrgDeviceActivity, *resty.Response, error) {
+	if err := client.ValidateID("activity ID", activityID); err != nil {
+		return nil, nil, err
+	}
+
+	endpoint := fmt.Sprintf(constants.EndpointOrgDeviceActivities+"/%s", activityID)
+
+	var result ResponseOrgDeviceActivity
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetResult(&result).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	s.journalFetched(ctx, result.Data)
+
+	return &result, resp, nil
+}
+
+// WaitForActivityV1 polls GetActivityByIDV1 every interval until the activity
+// leaves the IN_PROGRESS status, and returns its final state. opts adjust the
+// schedule; see WithInitialDelay, WithMaxInterval, WithMaxWait and
+// WithPollCallback. It stops early with ctx's error when ctx is cancelled or
+// its deadline passes, or with ErrActivityWaitTimeout when the WithMaxWait
+// limit is reached, returning the last observed state in both cases.
+// URL: GET https://api-business.apple.com/v1/orgDeviceActivities/{id}
+func (s *DeviceManagement) WaitForActivityV1(ctx context.Context, activityID string, interval time.Duration, opts ...PollOption) (*ResponseOrgDeviceActivity, error) {
+	cfg, err := newPollConfig(interval, opts)
+	if err != nil {
+		return nil, err
+	}
+	return s.waitForActivity(ctx, activityID, cfg)
+}
+
+// waitForActivity implements WaitForActivityV1 for a validated configuration.
+func (s *DeviceManagement) waitForActivity(ctx context.Context, activityID string, cfg *pollConfig) (*ResponseOrgDeviceActivity, error) {
+	if cfg.maxWait > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeoutCause(ctx, cfg.maxWait,
+			fmt.Errorf("activity %s: %w after %s: %w", activityID, ErrActivityWaitTimeout, cfg.maxWait, context.DeadlineExceeded))
+		defer cancel()
+	}
+
+	var last *ResponseOrgDeviceActivity
+	if cfg.initialDelay > 0 {
+		if err := sleepContext(ctx, cfg.initialDelay); err != nil {
+			return nil, err
+		}
+	}
+
+	wait := cfg.interval
+	for poll := 1; ; poll++ {
+		result, _, err := s.GetActivityByIDV1(ctx, activityID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return last, context.Cause(ctx)
+			}
+			return nil, err
+		}
+		last = result
+		if cfg.onPoll != nil {
+			cfg.onPoll(result.Data, poll)
+		}
+		if result.Data.Attributes == nil || result.Data.Attributes.Status != ActivityStatusInProgress {
+			return result, nil
+		}
+
+		if err := sleepContext(ctx, wait); err != nil {
+			return result, err
+		}
+		wait = cfg.next(wait)
+	}
+}
+
+// sleepContext waits for d, returning early with the cause of ctx's
+// cancellation.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return context.Cause(ctx)
+	case <-timer.C:
+		return nil
+	}
+}
+
+// WaitForActivitiesV1 waits concurrently for each of activityIDs to leave the
+// IN_PROGRESS status, polling every interval with opts applied to each
+// activity. The result has one item per distinct activity ID, in the order
+// given, whose Value is the activity's final state. An activity that finished
+// without completing keeps its final state and has an *ActivityFailedError,
+// so result.Err() lists the failed activities.
+//
+// If polling any activity fails, or ctx is cancelled, the remaining waits are
+// cancelled and the first error is returned.
+// URL: GET https://api-business.apple.com/v1/orgDeviceActivities/{id}
+func (s *DeviceManagement) WaitForActivitiesV1(ctx context.Context, activityIDs []string, interval time.Duration, opts ...PollOption) (*client.BatchResult[OrgDeviceActivity], error) {
+	if err := client.ValidateIDs("activity ID", activityIDs); err != nil {
+		return nil, err
+	}
+	cfg, err := newPollConfig(interval, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	result := client.RunBatch(activityIDs, len(activityIDs), func(id string) (OrgDeviceActivity, error) {
+		final, err := s.waitForActivity(ctx, id, cfg)
+		if err != nil {
+			cancel(fmt.Errorf("wait for activity %s: %w", id, err))
+			return OrgDeviceActivity{}, err
+		}
+		if !final.Data.Succeeded() {
+			return final.Data, &ActivityFailedError{Activity: final.Data}
+		}
+		return final.Data, nil
+	})
+
+	if err := context.Cause(ctx); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -2,6 +2,9 @@ package devicemanagement
 
 import (
 	"context"
+	"math"
+	"net/http"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -407,6 +410,28 @@ func TestAssignDevicesToServer_EmptyDeviceIDs(t *testing.T) {
 	assert.Equal(t, 0, httpmock.GetTotalCallCount())
 }
 
+func TestAssignDevicesToServer_InvalidDeviceID(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.DeviceManagementMock{}
+	mockHandler.RegisterMocks()
+	defer mockHandler.CleanupMockState()
+
+	ctx := context.Background()
+	serverID := "1F97349736CF4614A94F624E705841AD"
+	deviceIDs := []string{"XABC123X0ABC123X0", "XABC123X0/ABC"}
+
+	result, _, err := svc.AssignDevicesV1(ctx, serverID, deviceIDs)
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, client.ErrInvalidInput)
+	assert.Contains(t, err.Error(), `invalid device ID "XABC123X0/ABC"`)
+	assert.Contains(t, err.Error(), "(item 2 of 2)")
+
+	// No HTTP call should be made
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
+
 func TestUnassignDevicesFromServer_Success(t *testing.T) {
 	client := setupMockClient(t)
 	mockHandler := &mocks.DeviceManagementMock{}
@@ -545,13 +570,61 @@ func TestActivityConstants(t *testing.T) {
 	assert.Equal(t, "UNASSIGN_DEVICES", ActivityTypeUnassignDevices)
 
 	// Test activity status constants
-	assert.Equal(t, "IN_PROGRESS", ActivityStatusInProgress)
-	assert.Equal(t, "COMPLETED", ActivityStatusCompleted)
-	assert.Equal(t, "FAILED", ActivityStatusFailed)
+	assert.Equal(t, ActivityStatus("IN_PROGRESS"), ActivityStatusInProgress)
+	assert.Equal(t, ActivityStatus("COMPLETED"), ActivityStatusCompleted)
+	assert.Equal(t, ActivityStatus("FAILED"), ActivityStatusFailed)
 
 	// Test activity sub-status constants
-	assert.Equal(t, "SUBMITTED", ActivitySubStatusSubmitted)
-	assert.Equal(t, "PROCESSING", ActivitySubStatusProcessing)
+	assert.Equal(t, ActivitySubStatus("SUBMITTED"), ActivitySubStatusSubmitted)
+	assert.Equal(t, ActivitySubStatus("PROCESSING"), ActivitySubStatusProcessing)
+	assert.Equal(t, ActivitySubStatus("COMPLETED_WITH_SUCCESS"), ActivitySubStatusCompletedWithSuccess)
+}
+
+func TestActivitySubStatusHelpers(t *testing.T) {
+	tests := []struct {
+		subStatus           ActivitySubStatus
+		terminal, succeeded bool
+	}{
+		{"", false, false},
+		{ActivitySubStatusSubmitted, false, false},
+		{ActivitySubStatusProcessing, false, false},
+		{ActivitySubStatusCompletedWithSuccess, true, true},
+		{"SOME_FUTURE_FAILURE", true, false},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.subStatus), func(t *testing.T) {
+			assert.Equal(t, tt.terminal, tt.subStatus.Terminal())
+			assert.Equal(t, tt.succeeded, tt.subStatus.Succeeded())
+		})
+	}
+}
+
+func TestActivityStatusHelpers(t *testing.T) {
+	tests := []struct {
+		status                      ActivityStatus
+		terminal, succeeded, failed bool
+	}{
+		{"", false, false, false},
+		{ActivityStatusInProgress, false, false, false},
+		{ActivityStatusCompleted, true, true, false},
+		{ActivityStatusFailed, true, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			assert.Equal(t, tt.terminal, tt.status.Terminal())
+			assert.Equal(t, tt.succeeded, tt.status.Succeeded())
+			assert.Equal(t, tt.failed, tt.status.Failed())
+
+			activity := OrgDeviceActivity{Attributes: &OrgDeviceActivityAttributes{Status: tt.status}}
+			assert.Equal(t, tt.terminal, activity.Terminal())
+			assert.Equal(t, tt.succeeded, activity.Succeeded())
+			assert.Equal(t, tt.failed, activity.Failed())
+		})
+	}
+
+	// An activity without attributes has no status yet.
+	assert.False(t, OrgDeviceActivity{}.Terminal())
+	assert.False(t, OrgDeviceActivity{}.Succeeded())
 }
 
 func TestOptionsStructures(t *testing.T) {
@@ -1017,6 +1090,241 @@ func TestDeleteDeviceManagementService_NotFound(t *testing.T) {
 	assert.Equal(t, 1, httpmock.GetTotalCallCount())
 }
 
+func TestGetActivityByID_Success(t *testing.T) {
+	client := setupMockClient(t)
+	mockHandler := &mocks.DeviceManagementMock{}
+	mockHandler.RegisterMocks()
+	defer mockHandler.CleanupMockState()
+
+	ctx := context.Background()
+	activityID := "b1481656-b267-480d-b284-a809eed8b041"
+
+	result, resp, err := client.GetActivityByIDV1(ctx, activityID)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, 200, resp.StatusCode())
+	require.NotNil(t, result)
+
+	activity := result.Data
+	assert.Equal(t, activityID, activity.ID)
+	require.NotNil(t, activity.Attributes)
+	assert.Equal(t, ActivityStatusCompleted, activity.Attributes.Status)
+	assert.Equal(t, ActivityTypeAssignDevices, activity.Attributes.ActivityType)
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestGetActivityByID_EmptyID(t *testing.T) {
+	client := setupMockClient(t)
+	mockHandler := &mocks.DeviceManagementMock{}
+	mockHandler.RegisterMocks()
+	defer mockHandler.CleanupMockState()
+
+	result, _, err := client.GetActivityByIDV1(context.Background(), "")
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "activity ID is required")
+
+	// No HTTP call should be made
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
+
+func TestGetActivityByID_NotFound(t *testing.T) {
+	client := setupMockClient(t)
+	mockHandler := &mocks.DeviceManagementMock{}
+	mockHandler.RegisterErrorMocks()
+	defer mockHandler.CleanupMockState()
+
+	result, resp, err := client.GetActivityByIDV1(context.Background(), "NONEXISTENT")
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+	require.NotNil(t, resp)
+	assert.Equal(t, 404, resp.StatusCode())
+}
+
+func TestWaitForActivity_PollsUntilDone(t *testing.T) {
+	client := setupMockClient(t)
+	mockHandler := &mocks.DeviceManagementMock{}
+	mockHandler.RegisterMocks()
+	defer mockHandler.CleanupMockState()
+
+	var calls int
+	httpmock.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDeviceActivities/act-1",
+		func(req *http.Request) (*http.Response, error) {
+			calls++
+			status := ActivityStatusInProgress
+			if calls == 3 {
+				status = ActivityStatusCompleted
+			}
+			return httpmock.NewJsonResponse(200, map[string]any{
+				"data": map[string]any{"type": "orgDeviceActivities", "id": "act-1", "attributes": map[string]any{"status": status}},
+			})
+		})
+
+	result, err := client.WaitForActivityV1(context.Background(), "act-1", time.Millisecond)
+
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, ActivityStatusCompleted, result.Data.Attributes.Status)
+	assert.Equal(t, 3, calls)
+}
+
+func TestWaitForActivity_ContextCancelled(t *testing.T) {
+	client := setupMockClient(t)
+	mockHandler := &mocks.DeviceManagementMock{}
+	mockHandler.RegisterMocks()
+	defer mockHandler.CleanupMockState()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	httpmock.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDeviceActivities/act-1",
+		func(req *http.Request) (*http.Response, error) {
+			cancel() // the caller gives up while the activity is still running
+			return httpmock.NewJsonResponse(200, map[string]any{
+				"data": map[string]any{"type": "orgDeviceActivities", "id": "act-1", "attributes": map[string]any{"status": ActivityStatusInProgress}},
+			})
+		})
+
+	result, err := client.WaitForActivityV1(ctx, "act-1", time.Hour)
+
+	require.ErrorIs(t, err, context.Canceled)
+	require.NotNil(t, result, "the last observed state is returned")
+	assert.Equal(t, ActivityStatusInProgress, result.Data.Attributes.Status)
+
+	_, err = client.WaitForActivityV1(context.Background(), "act-1", 0)
+	assert.ErrorContains(t, err, "poll interval must be positive")
+}
+
+// activityResponder serves an activity that reports IN_PROGRESS until it has
+// been polled polls times, then status.
+func activityResponder(id string, polls int32, status ActivityStatus) httpmock.Responder {
+	var calls atomic.Int32
+	return func(req *http.Request) (*http.Response, error) {
+		current := ActivityStatusInProgress
+		if calls.Add(1) >= polls {
+			current = status
+		}
+		return httpmock.NewJsonResponse(200, map[string]any{
+			"data": map[string]any{"type": "orgDeviceActivities", "id": id, "attributes": map[string]any{"status": current, "subStatus": "PROCESSING"}},
+		})
+	}
+}
+
+func TestWaitForActivity_PollOptions(t *testing.T) {
+	client := setupMockClient(t)
+	httpmock.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDeviceActivities/act-1",
+		activityResponder("act-1", 4, ActivityStatusCompleted))
+
+	var polls []int
+	var times []time.Time
+	start := time.Now()
+	result, err := client.WaitForActivityV1(context.Background(), "act-1", 5*time.Millisecond,
+		WithInitialDelay(20*time.Millisecond),
+		WithMaxInterval(20*time.Millisecond),
+		WithPollCallback(func(activity OrgDeviceActivity, poll int) {
+			assert.Equal(t, "act-1", activity.ID)
+			polls = append(polls, poll)
+			times = append(times, time.Now())
+		}))
+
+	require.NoError(t, err)
+	assert.True(t, result.Data.Succeeded())
+	assert.Equal(t, []int{1, 2, 3, 4}, polls)
+
+	// The first poll waits for the initial delay; later waits double from
+	// 5ms up to the 20ms cap: 5ms, 10ms, 20ms.
+	assert.GreaterOrEqual(t, times[0].Sub(start), 20*time.Millisecond)
+	assert.GreaterOrEqual(t, times[1].Sub(times[0]), 5*time.Millisecond)
+	assert.GreaterOrEqual(t, times[2].Sub(times[1]), 10*time.Millisecond)
+	assert.GreaterOrEqual(t, times[3].Sub(times[2]), 20*time.Millisecond)
+}
+
+func TestWaitForActivity_MaxWait(t *testing.T) {
+	client := setupMockClient(t)
+	httpmock.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDeviceActivities/act-1",
+		activityResponder("act-1", math.MaxInt32, ActivityStatusCompleted))
+
+	result, err := client.WaitForActivityV1(context.Background(), "act-1", time.Millisecond, WithMaxWait(20*time.Millisecond))
+
+	require.ErrorIs(t, err, ErrActivityWaitTimeout)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	require.NotNil(t, result, "the last observed state is returned")
+	assert.Equal(t, ActivityStatusInProgress, result.Data.Attributes.Status)
+}
+
+func TestWaitForActivity_InvalidPollOptions(t *testing.T) {
+	client := setupMockClient(t)
+
+	for _, opt := range []PollOption{WithInitialDelay(-time.Second), WithMaxInterval(-time.Second), WithMaxWait(-time.Second)} {
+		_, err := client.WaitForActivityV1(context.Background(), "act-1", time.Second, opt)
+		assert.ErrorContains(t, err, "cannot be negative")
+	}
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
+
+func TestWaitForActivities_AggregatesOutcome(t *testing.T) {
+	client := setupMockClient(t)
+
+	httpmock.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDeviceActivities/act-1",
+		activityResponder("act-1", 3, ActivityStatusCompleted))
+	httpmock.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDeviceActivities/act-2",
+		activityResponder("act-2", 1, ActivityStatusFailed))
+	httpmock.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDeviceActivities/act-3",
+		activityResponder("act-3", 2, ActivityStatusCompleted))
+
+	outcome, err := client.WaitForActivitiesV1(context.Background(), []string{"act-1", "act-2", "act-3", "act-1"}, time.Millisecond)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"act-1", "act-2", "act-3"}, outcome.Keys())
+	assert.Equal(t, "act-3", outcome.Items[2].Value.ID)
+	assert.False(t, outcome.AllSucceeded())
+	assert.Len(t, outcome.Succeeded(), 2)
+	require.Len(t, outcome.Failed(), 1)
+	failed := outcome.Failed()[0]
+	assert.Equal(t, "act-2", failed.Key)
+	assert.Equal(t, ActivityStatusFailed, failed.Value.Attributes.Status)
+
+	var activityErr *ActivityFailedError
+	require.ErrorAs(t, outcome.Err(), &activityErr)
+	assert.Equal(t, "act-2", activityErr.Activity.ID)
+	assert.EqualError(t, outcome.Err(), "act-2: activity finished with status FAILED (PROCESSING)")
+}
+
+func TestWaitForActivities_ErrorCancelsOthers(t *testing.T) {
+	client := setupMockClient(t)
+
+	// act-1 never finishes; the failed lookup of act-2 must stop its wait.
+	httpmock.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDeviceActivities/act-1",
+		activityResponder("act-1", math.MaxInt32, ActivityStatusCompleted))
+	httpmock.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDeviceActivities/act-2",
+		httpmock.NewStringResponder(404, `{"errors":[{"status":"404","code":"NOT_FOUND","title":"Not Found","detail":"Activity not found"}]}`))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	outcome, err := client.WaitForActivitiesV1(ctx, []string{"act-1", "act-2"}, time.Millisecond)
+
+	require.Error(t, err)
+	assert.Nil(t, outcome)
+	assert.Contains(t, err.Error(), "wait for activity act-2")
+	assert.NoError(t, ctx.Err(), "the wait must end before the test deadline")
+}
+
+func TestWaitForActivities_InvalidInput(t *testing.T) {
+	client := setupMockClient(t)
+
+	_, err := client.WaitForActivitiesV1(context.Background(), nil, time.Second)
+	assert.ErrorContains(t, err, "at least one activity ID is required")
+
+	_, err = client.WaitForActivitiesV1(context.Background(), []string{"act-1"}, 0)
+	assert.ErrorContains(t, err, "poll interval must be positive")
+
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
+
 // ====== New field and status constants tests ======
 
 func TestMDMServerFieldConstants(t *testing.T) {
@@ -0,0 +1,309 @@
+package devicemanagement
+
+import (
+	"bufio"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// ActivityRecord is the journal entry for one org device activity created by
+// the SDK.
+type ActivityRecord struct {
+	ID           string   `json:"id"`
+	ActivityType string   `json:"activityType"`
+	MDMServerID  string   `json:"mdmServerId"`
+	DeviceIDs    []string `json:"deviceIds"`
+
+	// Status and SubStatus are the last values the SDK saw, from the create
+	// response or a later GetActivityByIDV1 or WaitForActivityV1 call.
+	Status    ActivityStatus    `json:"status,omitempty"`
+	SubStatus ActivitySubStatus `json:"subStatus,omitempty"`
+
+	// CreatedAt is Apple's creation time for the activity, or the time the
+	// SDK created it if Apple did not return one.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// UpdatedAt is when the SDK last saw the activity's status.
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// CompletedAt is when the SDK first saw the activity in a terminal
+	// status. It is nil while the activity is in progress.
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+}
+
+// Terminal reports whether the activity has left the IN_PROGRESS status.
+func (r ActivityRecord) Terminal() bool {
+	return r.Status.Terminal()
+}
+
+// ActivityFilter selects journal records. Zero-valued fields match every
+// record.
+type ActivityFilter struct {
+	// Since and Until bound CreatedAt; Since is inclusive, Until exclusive.
+	Since time.Time
+	Until time.Time
+
+	ActivityType string
+	MDMServerID  string
+
+	// DeviceID matches activities that included the device.
+	DeviceID string
+}
+
+// Matches reports whether r is selected by f.
+func (f ActivityFilter) Matches(r ActivityRecord) bool {
+	switch {
+	case !f.Since.IsZero() && r.CreatedAt.Before(f.Since):
+		return false
+	case !f.Until.IsZero() && !r.CreatedAt.Before(f.Until):
+		return false
+	case f.ActivityType != "" && r.ActivityType != f.ActivityType:
+		return false
+	case f.MDMServerID != "" && r.MDMServerID != f.MDMServerID:
+		return false
+	case f.DeviceID != "" && !slices.Contains(r.DeviceIDs, f.DeviceID):
+		return false
+	}
+	return true
+}
+
+// ActivityJournal stores ActivityRecords. Apple keeps activities for 30 days
+// and has no endpoint to list them, so a journal is the only record of older
+// assignments. Implementations must be safe for concurrent use.
+type ActivityJournal interface {
+	// Record saves r, replacing any record with the same ID.
+	Record(ctx context.Context, r ActivityRecord) error
+
+	// Get returns the record with the given ID, or nil if there is none.
+	Get(ctx context.Context, id string) (*ActivityRecord, error)
+
+	// List returns the records matched by f, oldest first.
+	List(ctx context.Context, f ActivityFilter) ([]ActivityRecord, error)
+}
+
+// SetActivityJournal records every activity created by AssignDevicesV1 and
+// UnassignDevicesV1 in j, and updates its status whenever GetActivityByIDV1 or
+// WaitForActivityV1 fetches it. Pass nil to stop journaling.
+//
+// Journal errors are logged rather than returned, so a failing store never
+// hides the outcome of a request Apple has already accepted.
+func (s *DeviceManagement) SetActivityJournal(j ActivityJournal) {
+	s.journalMu.Lock()
+	defer s.journalMu.Unlock()
+	s.journal = j
+}
+
+// activityJournal returns the configured journal, or nil.
+func (s *DeviceManagement) activityJournal() ActivityJournal {
+	s.journalMu.RLock()
+	defer s.journalMu.RUnlock()
+	return s.journal
+}
+
+// journalCreated records a newly created activity.
+func (s *DeviceManagement) journalCreated(ctx context.Context, activity OrgDeviceActivity, mdmServerID string, deviceIDs []string) {
+	j := s.activityJournal()
+	if j == nil {
+		return
+	}
+
+	now := time.Now().UTC()
+	r := ActivityRecord{
+		ID:          activity.ID,
+		MDMServerID: mdmServerID,
+		DeviceIDs:   slices.Clone(deviceIDs),
+		CreatedAt:   now,
+	}
+	applyActivityStatus(&r, activity, now)
+	if a := activity.Attributes; a != nil && a.CreatedDateTime != nil {
+		r.CreatedAt = a.CreatedDateTime.UTC()
+	}
+	if err := j.Record(ctx, r); err != nil {
+		s.client.GetLogger().Warn("Failed to journal activity", zap.String("activity_id", r.ID), zap.Error(err))
+	}
+}
+
+// journalFetched updates the journaled status of a fetched activity. Activities
+// the journal does not know about are ignored.
+func (s *DeviceManagement) journalFetched(ctx context.Context, activity OrgDeviceActivity) {
+	j := s.activityJournal()
+	if j == nil {
+		return
+	}
+
+	r, err := j.Get(ctx, activity.ID)
+	if err == nil && r != nil {
+		applyActivityStatus(r, activity, time.Now().UTC())
+		err = j.Record(ctx, *r)
+	}
+	if err != nil {
+		s.client.GetLogger().Warn("Failed to journal activity", zap.String("activity_id", activity.ID), zap.Error(err))
+	}
+}
+
+// applyActivityStatus copies activity's type and status onto r as seen at now.
+func applyActivityStatus(r *ActivityRecord, activity OrgDeviceActivity, now time.Time) {
+	r.UpdatedAt = now
+	a := activity.Attributes
+	if a == nil {
+		return
+	}
+	if a.ActivityType != "" {
+		r.ActivityType = a.ActivityType
+	}
+	r.Status, r.SubStatus = a.Status, a.SubStatus
+	if r.Terminal() && r.CompletedAt == nil {
+		r.CompletedAt = &now
+	}
+}
+
+// MemoryActivityJournal is an ActivityJournal held in memory, for tests and
+// short-lived processes.
+type MemoryActivityJournal struct {
+	mu      sync.Mutex
+	records map[string]ActivityRecord
+}
+
+// NewMemoryActivityJournal returns an empty MemoryActivityJournal.
+func NewMemoryActivityJournal() *MemoryActivityJournal {
+	return &MemoryActivityJournal{records: make(map[string]ActivityRecord)}
+}
+
+// Record implements ActivityJournal.
+func (m *MemoryActivityJournal) Record(_ context.Context, r ActivityRecord) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.records[r.ID] = r
+	return nil
+}
+
+// Get implements ActivityJournal.
+func (m *MemoryActivityJournal) Get(_ context.Context, id string) (*ActivityRecord, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	r, ok := m.records[id]
+	if !ok {
+		return nil, nil
+	}
+	return &r, nil
+}
+
+// List implements ActivityJournal.
+func (m *MemoryActivityJournal) List(_ context.Context, f ActivityFilter) ([]ActivityRecord, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return filterActivityRecords(m.records, f), nil
+}
+
+// FileActivityJournal is an ActivityJournal kept in a JSON Lines file. Every
+// Record appends a line, and the last line for an ID wins, so the file is
+// also a history of each activity's status changes. Reads scan the whole
+// file.
+type FileActivityJournal struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileActivityJournal returns a journal stored at path. The file is created
+// on the first Record.
+func NewFileActivityJournal(path string) *FileActivityJournal {
+	return &FileActivityJournal{path: path}
+}
+
+// Record implements ActivityJournal.
+func (f *FileActivityJournal) Record(_ context.Context, r ActivityRecord) error {
+	line, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	file, err := os.OpenFile(f.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return fmt.Errorf("open activity journal: %w", err)
+	}
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		file.Close()
+		return fmt.Errorf("write activity journal: %w", err)
+	}
+	return file.Close()
+}
+
+// Get implements ActivityJournal.
+func (f *FileActivityJournal) Get(_ context.Context, id string) (*ActivityRecord, error) {
+	records, err := f.load()
+	if err != nil {
+		return nil, err
+	}
+	r, ok := records[id]
+	if !ok {
+		return nil, nil
+	}
+	return &r, nil
+}
+
+// List implements ActivityJournal.
+func (f *FileActivityJournal) List(_ context.Context, filter ActivityFilter) ([]ActivityRecord, error) {
+	records, err := f.load()
+	if err != nil {
+		return nil, err
+	}
+	return filterActivityRecords(records, filter), nil
+}
+
+// load reads the latest record for every activity in the file.
+func (f *FileActivityJournal) load() (map[string]ActivityRecord, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	records := make(map[string]ActivityRecord)
+	file, err := os.Open(f.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return records, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("open activity journal: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, 16<<20)
+	for n := 1; scanner.Scan(); n++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var r ActivityRecord
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			return nil, fmt.Errorf("activity journal %s line %d: %w", f.path, n, err)
+		}
+		records[r.ID] = r
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read activity journal: %w", err)
+	}
+	return records, nil
+}
+
+// filterActivityRecords returns the records matched by f, oldest first.
+func filterActivityRecords(records map[string]ActivityRecord, f ActivityFilter) []ActivityRecord {
+	var out []ActivityRecord
+	for _, r := range records {
+		if f.Matches(r) {
+			out = append(out, r)
+		}
+	}
+	slices.SortFunc(out, func(a, b ActivityRecord) int {
+		return cmp.Or(a.CreatedAt.Compare(b.CreatedAt), cmp.Compare(a.ID, b.ID))
+	})
+	return out
+}
@@ -0,0 +1,112 @@
+package devicemanagement
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devicemanagement/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActivityJournal_RecordsAssignAndCompletion(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.DeviceManagementMock{}
+	mockHandler.RegisterMocks()
+	defer mockHandler.CleanupMockState()
+
+	journal := NewMemoryActivityJournal()
+	svc.SetActivityJournal(journal)
+
+	ctx := context.Background()
+	serverID := "1F97349736CF4614A94F624E705841AD"
+	deviceIDs := []string{"XABC123X0ABC123X0", "YABC123X0ABC123X0"}
+
+	created, _, err := svc.AssignDevicesV1(ctx, serverID, deviceIDs)
+	require.NoError(t, err)
+
+	record, err := journal.Get(ctx, created.Data.ID)
+	require.NoError(t, err)
+	require.NotNil(t, record)
+	assert.Equal(t, ActivityTypeAssignDevices, record.ActivityType)
+	assert.Equal(t, serverID, record.MDMServerID)
+	assert.Equal(t, deviceIDs, record.DeviceIDs)
+	assert.Equal(t, ActivityStatusInProgress, record.Status)
+	assert.Equal(t, time.Date(2025, 5, 5, 4, 15, 43, 282000000, time.UTC), record.CreatedAt)
+	assert.Nil(t, record.CompletedAt)
+
+	_, _, err = svc.GetActivityByIDV1(ctx, created.Data.ID)
+	require.NoError(t, err)
+
+	record, err = journal.Get(ctx, created.Data.ID)
+	require.NoError(t, err)
+	require.NotNil(t, record)
+	assert.Equal(t, ActivityStatusCompleted, record.Status)
+	assert.Equal(t, serverID, record.MDMServerID)
+	assert.NotNil(t, record.CompletedAt)
+	assert.True(t, record.Terminal())
+}
+
+func TestActivityJournal_IgnoresUnknownActivities(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.DeviceManagementMock{}
+	mockHandler.RegisterMocks()
+	defer mockHandler.CleanupMockState()
+
+	journal := NewMemoryActivityJournal()
+	svc.SetActivityJournal(journal)
+
+	_, _, err := svc.GetActivityByIDV1(context.Background(), "b1481656-b267-480d-b284-a809eed8b041")
+	require.NoError(t, err)
+
+	records, err := journal.List(context.Background(), ActivityFilter{})
+	require.NoError(t, err)
+	assert.Empty(t, records)
+}
+
+func TestFileActivityJournal_LastRecordWins(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "activities.jsonl")
+	journal := NewFileActivityJournal(path)
+
+	missing, err := journal.Get(ctx, "act-1")
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+
+	jan := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	apr := time.Date(2025, 4, 2, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, journal.Record(ctx, ActivityRecord{ID: "act-1", ActivityType: ActivityTypeAssignDevices, MDMServerID: "SRV1", DeviceIDs: []string{"D1", "D2"}, Status: ActivityStatusInProgress, CreatedAt: jan}))
+	require.NoError(t, journal.Record(ctx, ActivityRecord{ID: "act-2", ActivityType: ActivityTypeUnassignDevices, MDMServerID: "SRV1", DeviceIDs: []string{"D2"}, Status: ActivityStatusInProgress, CreatedAt: apr}))
+	require.NoError(t, journal.Record(ctx, ActivityRecord{ID: "act-1", ActivityType: ActivityTypeAssignDevices, MDMServerID: "SRV1", DeviceIDs: []string{"D1", "D2"}, Status: ActivityStatusCompleted, CreatedAt: jan}))
+
+	// A fresh journal on the same file sees the same records.
+	journal = NewFileActivityJournal(path)
+
+	record, err := journal.Get(ctx, "act-1")
+	require.NoError(t, err)
+	require.NotNil(t, record)
+	assert.Equal(t, ActivityStatusCompleted, record.Status)
+
+	all, err := journal.List(ctx, ActivityFilter{})
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	assert.Equal(t, "act-1", all[0].ID)
+	assert.Equal(t, "act-2", all[1].ID)
+
+	q2, err := journal.List(ctx, ActivityFilter{Since: time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), Until: time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)})
+	require.NoError(t, err)
+	require.Len(t, q2, 1)
+	assert.Equal(t, "act-2", q2[0].ID)
+
+	d1, err := journal.List(ctx, ActivityFilter{DeviceID: "D1"})
+	require.NoError(t, err)
+	require.Len(t, d1, 1)
+	assert.Equal(t, "act-1", d1[0].ID)
+
+	unassigns, err := journal.List(ctx, ActivityFilter{ActivityType: ActivityTypeUnassignDevices, MDMServerID: "SRV1"})
+	require.NoError(t, err)
+	require.Len(t, unassigns, 1)
+	assert.Equal(t, "act-2", unassigns[0].ID)
+}
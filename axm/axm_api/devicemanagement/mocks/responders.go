@@ -286,6 +286,17 @@ func (m *DeviceManagementMock) RegisterMocks() {
 
 		return httpmock.NewJsonResponse(201, responseObj)
 	})
+
+	// GET /orgDeviceActivities/{id} - Get activity status
+	httpmock.RegisterResponder("GET", `=~^https://api-business\.apple\.com/v1/orgDeviceActivities/[^/]+$`, func(req *http.Request) (*http.Response, error) {
+		mockData, err := loadMockResponse("validate_get_org_device_activity.json")
+		if err != nil {
+			return httpmock.NewStringResponse(500, `{"errors":[{"status":"500","code":"INTERNAL_ERROR","title":"Internal Server Error","detail":"Failed to load mock data"}]}`), nil
+		}
+		resp := httpmock.NewBytesResponse(200, mockData)
+		resp.Header.Set("Content-Type", "application/json")
+		return resp, nil
+	})
 }
 
 // RegisterErrorMocks registers mock responders that return error responses
@@ -339,6 +350,10 @@ func (m *DeviceManagementMock) RegisterErrorMocks() {
 	httpmock.RegisterResponder("POST", "https://api-business.apple.com/v1/orgDeviceActivities", func(req *http.Request) (*http.Response, error) {
 		return httpmock.NewStringResponse(400, `{"errors":[{"status":"400","code":"BAD_REQUEST","title":"Bad Request","detail":"Mock error for testing"}]}`), nil
 	})
+	// GET /orgDeviceActivities/{id} - Return not found error
+	httpmock.RegisterResponder("GET", `=~^https://api-business\.apple\.com/v1/orgDeviceActivities/[^/]+$`, func(req *http.Request) (*http.Response, error) {
+		return httpmock.NewStringResponse(404, `{"errors":[{"status":"404","code":"RESOURCE_NOT_FOUND","title":"Activity Not Found","detail":"The requested activity was not found"}]}`), nil
+	})
 }
 
 // CleanupMockState clears all mock state data
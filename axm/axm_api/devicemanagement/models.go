@@ -147,12 +147,33 @@ type OrgDeviceActivity struct {
 
 // OrgDeviceActivityAttributes contains the activity attributes
 type OrgDeviceActivityAttributes struct {
-	Status          string     `json:"status,omitempty"`
-	SubStatus       string     `json:"subStatus,omitempty"`
-	CreatedDateTime *time.Time `json:"createdDateTime,omitempty"`
-	ActivityType    string     `json:"activityType,omitempty"`
+	Status          ActivityStatus    `json:"status,omitempty"`
+	SubStatus       ActivitySubStatus `json:"subStatus,omitempty"`
+	CreatedDateTime *time.Time        `json:"createdDateTime,omitempty"`
+	ActivityType    string            `json:"activityType,omitempty"`
+
+	// DownloadURL links to the activity's per-device CSV report. Fetch and
+	// parse it with GetActivityReportV1.
+	DownloadURL string `json:"downloadUrl,omitempty"`
+}
+
+// status returns the activity's status, or "" if it has no attributes.
+func (a OrgDeviceActivity) status() ActivityStatus {
+	if a.Attributes == nil {
+		return ""
+	}
+	return a.Attributes.Status
 }
 
+// Terminal reports whether the activity has finished. See ActivityStatus.Terminal.
+func (a OrgDeviceActivity) Terminal() bool { return a.status().Terminal() }
+
+// Succeeded reports whether the activity completed.
+func (a OrgDeviceActivity) Succeeded() bool { return a.status().Succeeded() }
+
+// Failed reports whether the activity finished without completing.
+func (a OrgDeviceActivity) Failed() bool { return a.status().Failed() }
+
 // OrgDeviceActivityLinks contains activity navigation links
 type OrgDeviceActivityLinks struct {
 	Self string `json:"self,omitempty"`
@@ -164,6 +185,20 @@ type ResponseOrgDeviceActivity struct {
 	Links *Links            `json:"links,omitempty"`
 }
 
+// ActivityFailedError is the error of an activity that WaitForActivitiesV1
+// found finished without completing. Activity holds its final state.
+type ActivityFailedError struct {
+	Activity OrgDeviceActivity
+}
+
+func (e *ActivityFailedError) Error() string {
+	msg := "activity finished with status " + string(e.Activity.status())
+	if e.Activity.Attributes != nil && e.Activity.Attributes.SubStatus != "" {
+		msg += " (" + string(e.Activity.Attributes.SubStatus) + ")"
+	}
+	return msg
+}
+
 // ====== DEVICE ACTIVITY REQUEST TYPES ======
 
 // OrgDeviceActivityCreateRequest represents the request for creating a device activity
@@ -0,0 +1,99 @@
+package devicemanagement
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrActivityWaitTimeout is returned by WaitForActivityV1 and
+// WaitForActivitiesV1 when an activity is still in progress after the
+// duration set with WithMaxWait. The error also matches
+// context.DeadlineExceeded.
+var ErrActivityWaitTimeout = errors.New("activity still in progress")
+
+// PollOption configures how WaitForActivityV1 and WaitForActivitiesV1 poll an
+// activity.
+type PollOption func(*pollConfig) error
+
+// PollCallback is called after every poll of an activity with its current
+// state and the 1-based poll number. WaitForActivitiesV1 calls it from one
+// goroutine per activity, so it must be safe for concurrent use.
+type PollCallback func(activity OrgDeviceActivity, poll int)
+
+// pollConfig holds the settings applied by PollOptions.
+type pollConfig struct {
+	interval     time.Duration
+	initialDelay time.Duration
+	maxInterval  time.Duration
+	maxWait      time.Duration
+	onPoll       PollCallback
+}
+
+// newPollConfig returns the configuration for polling every interval with
+// opts applied.
+func newPollConfig(interval time.Duration, opts []PollOption) (*pollConfig, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("poll interval must be positive")
+	}
+	cfg := &pollConfig{interval: interval}
+	for _, opt := range opts {
+		if err := opt(cfg); err != nil {
+			return nil, err
+		}
+	}
+	return cfg, nil
+}
+
+// next returns the wait before the poll that follows one preceded by wait.
+// The wait doubles up to the maximum set with WithMaxInterval.
+func (c *pollConfig) next(wait time.Duration) time.Duration {
+	if c.maxInterval <= c.interval {
+		return c.interval
+	}
+	return min(2*wait, c.maxInterval)
+}
+
+// WithInitialDelay waits d before the first poll, for activities that are
+// known not to finish immediately.
+func WithInitialDelay(d time.Duration) PollOption {
+	return func(c *pollConfig) error {
+		if d < 0 {
+			return fmt.Errorf("initial delay cannot be negative")
+		}
+		c.initialDelay = d
+		return nil
+	}
+}
+
+// WithMaxInterval doubles the poll interval after every poll, up to d. Without
+// it the interval stays fixed.
+func WithMaxInterval(d time.Duration) PollOption {
+	return func(c *pollConfig) error {
+		if d < 0 {
+			return fmt.Errorf("max interval cannot be negative")
+		}
+		c.maxInterval = d
+		return nil
+	}
+}
+
+// WithMaxWait stops waiting after d, including any initial delay, and returns
+// the last observed state with ErrActivityWaitTimeout.
+func WithMaxWait(d time.Duration) PollOption {
+	return func(c *pollConfig) error {
+		if d < 0 {
+			return fmt.Errorf("max wait cannot be negative")
+		}
+		c.maxWait = d
+		return nil
+	}
+}
+
+// WithPollCallback calls fn after every poll, for progress reporting.
+func WithPollCallback(fn PollCallback) PollOption {
+	return func(c *pollConfig) error {
+		c.onPoll = fn
+		return nil
+	}
+}
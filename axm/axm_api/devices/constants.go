@@ -96,3 +96,7 @@ const (
 	PaymentTypeSubscription    = "SUBSCRIPTION"
 	PaymentTypeABESubscription = "ABE_SUBSCRIPTION"
 )
+
+// DefaultAppleCareConcurrency is the number of coverage requests
+// GetAppleCareByDeviceIDsV1 runs at once when no concurrency is given.
+const DefaultAppleCareConcurrency = 4
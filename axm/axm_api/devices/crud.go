@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-apple/axm/client"
 	"github.com/deploymenttheory/go-api-sdk-apple/axm/constants"
@@ -76,12 +78,258 @@ func (s *Devices) GetV1(ctx context.Context, opts *RequestQueryOptions) (*OrgDev
 	}, resp, nil
 }
 
+// GetUpdatedSinceV1 retrieves the devices in an organization whose
+// updatedDateTime is after since, for incremental syncs into an inventory
+// system. The orgDevices endpoint has no server-side filter on
+// updatedDateTime, so every page is fetched and devices are filtered as each
+// page arrives. Devices with no updatedDateTime are included, as they cannot
+// be ruled out. If opts.Fields is set, updatedDateTime is added to it.
+// URL: GET https://api-business.apple.com/v1/orgDevices
+// https://developer.apple.com/documentation/applebusinessmanagerapi/get-org-devices
+func (s *Devices) GetUpdatedSinceV1(ctx context.Context, since time.Time, opts *RequestQueryOptions) (*OrgDevicesResponse, *resty.Response, error) {
+	if since.IsZero() {
+		return nil, nil, fmt.Errorf("since timestamp is required")
+	}
+
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	params := s.client.QueryBuilder()
+
+	if len(opts.Fields) > 0 {
+		fields := opts.Fields
+		if !slices.Contains(fields, FieldUpdatedDateTime) {
+			fields = append(slices.Clone(fields), FieldUpdatedDateTime)
+		}
+		params.AddStringSlice("fields[orgDevices]", fields)
+	}
+
+	if opts.Limit > 0 {
+		if opts.Limit > 1000 {
+			opts.Limit = 1000 // Enforce API maximum
+		}
+		params.AddInt("limit", opts.Limit)
+	}
+
+	var updated []OrgDevice
+	var lastMeta *Meta
+	var lastLinks *Links
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetQueryParams(params.Build()).
+		GetPaginated(constants.EndpointOrgDevices, func(pageData []byte) error {
+			var pageResponse OrgDevicesResponse
+			if err := json.Unmarshal(pageData, &pageResponse); err != nil {
+				return fmt.Errorf("failed to unmarshal page: %w", err)
+			}
+			for _, device := range pageResponse.Data {
+				if device.UpdatedAfter(since) {
+					updated = append(updated, device)
+				}
+			}
+			lastMeta = pageResponse.Meta
+			lastLinks = pageResponse.Links
+			return nil
+		})
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &OrgDevicesResponse{
+		Data:  updated,
+		Meta:  lastMeta,
+		Links: lastLinks,
+	}, resp, nil
+}
+
+// GetIfModifiedV1 retrieves every device in an organization, like GetV1, but
+// only if the inventory changed since the pull that state was recorded from.
+// The first page is requested with an If-Modified-Since header built from
+// state; if Apple answers 304 Not Modified, it returns client.ErrNotModified
+// and no further requests are made. A zero state pulls unconditionally.
+//
+// The returned response is the first page's, so that client.NewSyncState(resp)
+// records the headers from before any later page was read; save it and pass
+// it to the next call. Apple does not document conditional requests for this
+// endpoint, and when it ignores the header every call is a full pull.
+// URL: GET https://api-business.apple.com/v1/orgDevices
+// https://developer.apple.com/documentation/applebusinessmanagerapi/get-org-devices
+func (s *Devices) GetIfModifiedV1(ctx context.Context, state client.SyncState, opts *RequestQueryOptions) (*OrgDevicesResponse, *resty.Response, error) {
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	params := s.client.QueryBuilder()
+
+	if len(opts.Fields) > 0 {
+		params.AddStringSlice("fields[orgDevices]", opts.Fields)
+	}
+
+	if opts.Limit > 0 {
+		if opts.Limit > 1000 {
+			opts.Limit = 1000 // Enforce API maximum
+		}
+		params.AddInt("limit", opts.Limit)
+	}
+
+	var first OrgDevicesResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetHeader("If-Modified-Since", state.IfModifiedSince()).
+		SetQueryParams(params.Build()).
+		SetResult(&first).
+		Get(constants.EndpointOrgDevices)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	allDevices := first.Data
+	lastMeta, lastLinks := first.Meta, first.Links
+
+	if cursor := first.NextCursor(); cursor != "" {
+		params.AddString("cursor", cursor)
+
+		pageResp, err := s.client.NewRequest(ctx).
+			SetHeader("Accept", constants.ApplicationJSON).
+			SetHeader("Content-Type", constants.ApplicationJSON).
+			SetQueryParams(params.Build()).
+			GetPaginated(constants.EndpointOrgDevices, func(pageData []byte) error {
+				var pageResponse OrgDevicesResponse
+				if err := json.Unmarshal(pageData, &pageResponse); err != nil {
+					return fmt.Errorf("failed to unmarshal page: %w", err)
+				}
+				allDevices = append(allDevices, pageResponse.Data...)
+				lastMeta = pageResponse.Meta
+				lastLinks = pageResponse.Links
+				return nil
+			})
+
+		if err != nil {
+			return nil, pageResp, err
+		}
+	}
+
+	return &OrgDevicesResponse{
+		Data:  allDevices,
+		Meta:  lastMeta,
+		Links: lastLinks,
+	}, resp, nil
+}
+
+// StreamV1 retrieves the devices in an organization page by page and yields
+// each device on the returned channel as its page arrives, instead of
+// materializing the full inventory in memory. The device channel is closed
+// once every page has been delivered; the error channel receives at most one
+// error and is closed afterwards. Cancel ctx to stop the stream early.
+// URL: GET https://api-business.apple.com/v1/orgDevices
+// https://developer.apple.com/documentation/applebusinessmanagerapi/get-org-devices
+func (s *Devices) StreamV1(ctx context.Context, opts *RequestQueryOptions) (<-chan OrgDevice, <-chan error) {
+	devicesCh := make(chan OrgDevice)
+	errCh := make(chan error, 1)
+
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	params := s.client.QueryBuilder()
+
+	if len(opts.Fields) > 0 {
+		params.AddStringSlice("fields[orgDevices]", opts.Fields)
+	}
+
+	if opts.Limit > 0 {
+		if opts.Limit > 1000 {
+			opts.Limit = 1000 // Enforce API maximum
+		}
+		params.AddInt("limit", opts.Limit)
+	}
+
+	go func() {
+		defer close(errCh)
+		defer close(devicesCh)
+
+		_, err := s.client.NewRequest(ctx).
+			SetHeader("Accept", constants.ApplicationJSON).
+			SetHeader("Content-Type", constants.ApplicationJSON).
+			SetQueryParams(params.Build()).
+			GetPaginated(constants.EndpointOrgDevices, func(pageData []byte) error {
+				var pageResponse OrgDevicesResponse
+				if err := json.Unmarshal(pageData, &pageResponse); err != nil {
+					return fmt.Errorf("failed to unmarshal page: %w", err)
+				}
+				for _, device := range pageResponse.Data {
+					select {
+					case devicesCh <- device:
+					case <-ctx.Done():
+						return ctx.Err()
+					}
+				}
+				return nil
+			})
+
+		if err != nil {
+			errCh <- err
+		}
+	}()
+
+	return devicesCh, errCh
+}
+
+// GetPageV1 retrieves a single page of devices in an organization, starting at
+// cursor, or at the first page when cursor is empty. Pass the response's
+// NextCursor to fetch the following page; it is empty on the last page.
+// Saving the cursor between pages lets a long listing resume where it stopped.
+// URL: GET https://api-business.apple.com/v1/orgDevices
+// https://developer.apple.com/documentation/applebusinessmanagerapi/get-org-devices
+func (s *Devices) GetPageV1(ctx context.Context, cursor string, opts *RequestQueryOptions) (*OrgDevicesResponse, *resty.Response, error) {
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	params := s.client.QueryBuilder()
+
+	if len(opts.Fields) > 0 {
+		params.AddStringSlice("fields[orgDevices]", opts.Fields)
+	}
+
+	if opts.Limit > 0 {
+		if opts.Limit > 1000 {
+			opts.Limit = 1000 // Enforce API maximum
+		}
+		params.AddInt("limit", opts.Limit)
+	}
+
+	params.AddString("cursor", cursor)
+
+	var result OrgDevicesResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetQueryParams(params.Build()).
+		SetResult(&result).
+		Get(constants.EndpointOrgDevices)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
 // GetByDeviceIDV1 retrieves information about a specific device in an organization.
 // URL: GET https://api-business.apple.com/v1/orgDevices/{id}
 // https://developer.apple.com/documentation/applebusinessmanagerapi/get-orgdevice-information
 func (s *Devices) GetByDeviceIDV1(ctx context.Context, deviceID string, opts *RequestQueryOptions) (*OrgDeviceResponse, *resty.Response, error) {
-	if deviceID == "" {
-		return nil, nil, fmt.Errorf("device ID is required")
+	if err := client.ValidateID("device ID", deviceID); err != nil {
+		return nil, nil, err
 	}
 
 	if opts == nil {
@@ -116,8 +364,8 @@ func (s *Devices) GetByDeviceIDV1(ctx context.Context, deviceID string, opts *Re
 // URL: GET https://api-business.apple.com/v1/orgDevices/{id}/appleCareCoverage
 // https://developer.apple.com/documentation/applebusinessmanagerapi/get-all-apple-care-coverage-for-an-orgdevice
 func (s *Devices) GetAppleCareByDeviceIDV1(ctx context.Context, deviceID string, opts *RequestQueryOptions) (*AppleCareCoverageResponse, *resty.Response, error) {
-	if deviceID == "" {
-		return nil, nil, fmt.Errorf("device ID is required")
+	if err := client.ValidateID("device ID", deviceID); err != nil {
+		return nil, nil, err
 	}
 
 	if opts == nil {
@@ -168,3 +416,26 @@ func (s *Devices) GetAppleCareByDeviceIDV1(ctx context.Context, deviceID string,
 		Links: lastLinks,
 	}, resp, nil
 }
+
+// GetAppleCareByDeviceIDsV1 retrieves AppleCare coverage for many devices,
+// running up to concurrency GetAppleCareByDeviceIDV1 calls at once
+// (DefaultAppleCareConcurrency when concurrency is not positive). The result
+// has one item per distinct device ID, keyed by the ID and holding either its
+// coverage or its error, so one failed lookup never hides the others. If ctx
+// is cancelled, devices not yet fetched are recorded with the context error.
+// URL: GET https://api-business.apple.com/v1/orgDevices/{id}/appleCareCoverage
+// https://developer.apple.com/documentation/applebusinessmanagerapi/get-all-apple-care-coverage-for-an-orgdevice
+func (s *Devices) GetAppleCareByDeviceIDsV1(ctx context.Context, deviceIDs []string, concurrency int, opts *RequestQueryOptions) *client.BatchResult[*AppleCareCoverageResponse] {
+	if concurrency <= 0 {
+		concurrency = DefaultAppleCareConcurrency
+	}
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	return client.RunBatch(deviceIDs, concurrency, func(id string) (*AppleCareCoverageResponse, error) {
+		callOpts := *opts
+		coverage, _, err := s.GetAppleCareByDeviceIDV1(ctx, id, &callOpts)
+		return coverage, err
+	})
+}
@@ -2,6 +2,9 @@ package devices
 
 import (
 	"context"
+	"net/http"
+	"regexp"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -205,6 +208,178 @@ func TestGetOrganizationDevices_HTTPError(t *testing.T) {
 	assert.Equal(t, 1, httpmock.GetTotalCallCount()) // retries disabled
 }
 
+func TestStreamOrganizationDevices_Success(t *testing.T) {
+	client := setupMockClient(t)
+	mockHandler := &mocks.OrgDevicesMock{}
+	mockHandler.RegisterMocks()
+	defer mockHandler.CleanupMockState()
+
+	ctx := context.Background()
+	devicesCh, errCh := client.StreamV1(ctx, &RequestQueryOptions{Limit: 100})
+
+	var streamed []OrgDevice
+	for device := range devicesCh {
+		streamed = append(streamed, device)
+	}
+
+	require.NoError(t, <-errCh)
+	require.NotEmpty(t, streamed)
+	assert.Equal(t, "orgDevices", streamed[0].Type)
+}
+
+func TestStreamOrganizationDevices_HTTPError(t *testing.T) {
+	client := setupMockClient(t)
+
+	httpmock.Reset()
+
+	mockHandler := &mocks.OrgDevicesMock{}
+	mockHandler.RegisterErrorMocks()
+	defer mockHandler.CleanupMockState()
+
+	devicesCh, errCh := client.StreamV1(context.Background(), nil)
+
+	for range devicesCh {
+		t.Fatal("expected no devices to be streamed")
+	}
+
+	require.Error(t, <-errCh)
+}
+
+func TestGetOrganizationDevicesUpdatedSince_FiltersByUpdatedDateTime(t *testing.T) {
+	client := setupMockClient(t)
+
+	var gotFields string
+	httpmock.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDevices",
+		func(req *http.Request) (*http.Response, error) {
+			gotFields = req.URL.Query().Get("fields[orgDevices]")
+			resp := httpmock.NewStringResponse(200, `{"data":[
+				{"type":"orgDevices","id":"OLD","attributes":{"serialNumber":"OLD","updatedDateTime":"2025-04-30T23:59:59Z"}},
+				{"type":"orgDevices","id":"NEW","attributes":{"serialNumber":"NEW","updatedDateTime":"2025-05-01T15:33:54.164Z"}},
+				{"type":"orgDevices","id":"UNKNOWN","attributes":{"serialNumber":"UNKNOWN"}}
+			]}`)
+			resp.Header.Set("Content-Type", "application/json")
+			return resp, nil
+		})
+
+	since := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+	result, resp, err := client.GetUpdatedSinceV1(context.Background(), since, &RequestQueryOptions{
+		Fields: []string{FieldSerialNumber},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	assert.Equal(t, "serialNumber,updatedDateTime", gotFields)
+	require.Len(t, result.Data, 2)
+	assert.Equal(t, "NEW", result.Data[0].ID)
+	assert.Equal(t, "UNKNOWN", result.Data[1].ID, "devices without updatedDateTime are kept")
+}
+
+func TestGetOrganizationDevicesUpdatedSince_ZeroSince(t *testing.T) {
+	client := setupMockClient(t)
+
+	result, _, err := client.GetUpdatedSinceV1(context.Background(), time.Time{}, nil)
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
+
+func TestGetOrganizationDevicesIfModified_FullPull(t *testing.T) {
+	svc := setupMockClient(t)
+
+	var conditional []string
+	httpmock.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDevices",
+		func(req *http.Request) (*http.Response, error) {
+			conditional = append(conditional, req.Header.Get("If-Modified-Since"))
+			body := `{"data":[{"type":"orgDevices","id":"D2"}]}`
+			if req.URL.Query().Get("cursor") == "" {
+				body = `{"data":[{"type":"orgDevices","id":"D1"}],"meta":{"paging":{"nextCursor":"p2"}}}`
+			}
+			resp := httpmock.NewStringResponse(200, body)
+			resp.Header.Set("Content-Type", "application/json")
+			resp.Header.Set("Last-Modified", "Tue, 06 May 2025 08:00:00 GMT")
+			resp.Header.Set("Date", "Wed, 07 May 2025 09:30:00 GMT")
+			return resp, nil
+		})
+
+	state := client.SyncState{Date: time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)}
+	result, resp, err := svc.GetIfModifiedV1(context.Background(), state, nil)
+
+	require.NoError(t, err)
+	require.Len(t, result.Data, 2)
+	assert.Equal(t, "D2", result.Data[1].ID)
+	assert.Equal(t, []string{"Thu, 01 May 2025 12:00:00 GMT", ""}, conditional, "only the first page is conditional")
+
+	next := client.NewSyncState(resp)
+	assert.Equal(t, time.Date(2025, 5, 6, 8, 0, 0, 0, time.UTC), next.LastModified)
+	assert.Equal(t, "Tue, 06 May 2025 08:00:00 GMT", next.IfModifiedSince())
+}
+
+func TestGetOrganizationDevicesIfModified_NotModified(t *testing.T) {
+	svc := setupMockClient(t)
+	httpmock.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDevices",
+		httpmock.NewStringResponder(http.StatusNotModified, ""))
+
+	state := client.SyncState{LastModified: time.Date(2025, 5, 6, 8, 0, 0, 0, time.UTC)}
+	result, resp, err := svc.GetIfModifiedV1(context.Background(), state, nil)
+
+	assert.ErrorIs(t, err, client.ErrNotModified)
+	assert.Nil(t, result)
+	assert.Equal(t, http.StatusNotModified, resp.StatusCode())
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestGetPageOfOrganizationDevices_Success(t *testing.T) {
+	client := setupMockClient(t)
+	mockHandler := &mocks.OrgDevicesMock{}
+	mockHandler.RegisterMocks()
+	defer mockHandler.CleanupMockState()
+
+	result, resp, err := client.GetPageV1(context.Background(), "", &RequestQueryOptions{Limit: 100})
+
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	assert.NotEmpty(t, result.Data)
+	assert.Empty(t, result.NextCursor(), "the mock inventory fits on one page")
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestGetPageOfOrganizationDevices_SendsCursor(t *testing.T) {
+	client := setupMockClient(t)
+
+	var gotCursor string
+	httpmock.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDevices",
+		func(req *http.Request) (*http.Response, error) {
+			gotCursor = req.URL.Query().Get("cursor")
+			resp := httpmock.NewStringResponse(200, `{"data":[],"meta":{"paging":{"limit":100,"nextCursor":"page-3"}}}`)
+			resp.Header.Set("Content-Type", "application/json")
+			return resp, nil
+		})
+
+	result, _, err := client.GetPageV1(context.Background(), "page-2", nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, "page-2", gotCursor)
+	assert.Equal(t, "page-3", result.NextCursor())
+}
+
+func TestOrgDevicesResponseNextCursor(t *testing.T) {
+	tests := []struct {
+		name string
+		resp OrgDevicesResponse
+		want string
+	}{
+		{name: "last page", resp: OrgDevicesResponse{}},
+		{name: "meta cursor", resp: OrgDevicesResponse{Meta: &Meta{Paging: &Paging{NextCursor: "abc"}}}, want: "abc"},
+		{name: "next link", resp: OrgDevicesResponse{Links: &Links{Next: "https://api-business.apple.com/v1/orgDevices?cursor=def&limit=100"}}, want: "def"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.resp.NextCursor())
+		})
+	}
+}
+
 func TestGetDeviceInformation_Success(t *testing.T) {
 	client := setupMockClient(t)
 	mockHandler := &mocks.OrgDevicesMock{}
@@ -567,6 +742,58 @@ func TestGetAppleCareInformation_Success(t *testing.T) {
 	assert.Equal(t, 1, httpmock.GetTotalCallCount())
 }
 
+func TestGetAppleCareInformationForDevices_BoundedConcurrency(t *testing.T) {
+	client := setupMockClient(t)
+
+	var inFlight, maxInFlight, calls atomic.Int32
+	httpmock.RegisterRegexpResponder("GET", regexp.MustCompile(`/v1/orgDevices/([^/]+)/appleCareCoverage$`),
+		func(req *http.Request) (*http.Response, error) {
+			calls.Add(1)
+			n := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				m := maxInFlight.Load()
+				if n <= m || maxInFlight.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+
+			id := httpmock.MustGetSubmatch(req, 1)
+			if id == "MISSING" {
+				return httpmock.NewStringResponse(404, `{"errors":[{"status":"404","code":"NOT_FOUND","title":"Not Found"}]}`), nil
+			}
+			resp := httpmock.NewStringResponse(200, `{"data":[{"type":"appleCareCoverage","id":"`+id+`-plan","attributes":{"status":"ACTIVE"}}]}`)
+			resp.Header.Set("Content-Type", "application/json")
+			return resp, nil
+		})
+
+	ids := []string{"D1", "D2", "D3", "D4", "D5", "D6", "MISSING", "D1"}
+	result := client.GetAppleCareByDeviceIDsV1(context.Background(), ids, 2, &RequestQueryOptions{Limit: 5000})
+
+	require.Len(t, result.Items, 7)
+	assert.Len(t, result.Succeeded(), 6)
+	d3, ok := result.Get("D3")
+	require.True(t, ok)
+	assert.Equal(t, "D3-plan", d3.Value.Data[0].ID)
+	require.Len(t, result.Failed(), 1)
+	assert.Equal(t, "MISSING", result.Failed()[0].Key)
+	assert.Error(t, result.Failed()[0].Err)
+	assert.Equal(t, int32(7), calls.Load(), "duplicate IDs are fetched once")
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(2))
+}
+
+func TestGetAppleCareInformationForDevices_EmptyID(t *testing.T) {
+	client := setupMockClient(t)
+
+	result := client.GetAppleCareByDeviceIDsV1(context.Background(), []string{""}, 0, nil)
+
+	assert.Empty(t, result.Succeeded())
+	require.Len(t, result.Failed(), 1)
+	assert.Equal(t, "", result.Failed()[0].Key)
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
+
 func TestGetAppleCareInformation_WithNilOptions(t *testing.T) {
 	client := setupMockClient(t)
 	mockHandler := &mocks.OrgDevicesMock{}
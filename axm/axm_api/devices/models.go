@@ -1,6 +1,9 @@
 package devices
 
-import "time"
+import (
+	"net/url"
+	"time"
+)
 
 // Shared types for pagination and links
 type Meta struct {
@@ -28,6 +31,15 @@ type OrgDevice struct {
 	Attributes *OrgDeviceAttributes `json:"attributes,omitempty"`
 }
 
+// UpdatedAfter reports whether the device was updated after t. A device with
+// no updatedDateTime is treated as updated, since it cannot be ruled out.
+func (d OrgDevice) UpdatedAfter(t time.Time) bool {
+	if d.Attributes == nil || d.Attributes.UpdatedDateTime == nil {
+		return true
+	}
+	return d.Attributes.UpdatedDateTime.After(t)
+}
+
 // OrgDeviceAttributes contains the device attributes
 type OrgDeviceAttributes struct {
 	SerialNumber        string     `json:"serialNumber,omitempty"`
@@ -74,6 +86,23 @@ type OrgDevicesResponse struct {
 	Links *Links      `json:"links,omitempty"`
 }
 
+// NextCursor returns the cursor of the page after this one, taken from
+// meta.paging.nextCursor or, failing that, the cursor parameter of
+// links.next. It is empty on the last page.
+func (r *OrgDevicesResponse) NextCursor() string {
+	if r.Meta != nil && r.Meta.Paging != nil && r.Meta.Paging.NextCursor != "" {
+		return r.Meta.Paging.NextCursor
+	}
+	if r.Links == nil || r.Links.Next == "" {
+		return ""
+	}
+	next, err := url.Parse(r.Links.Next)
+	if err != nil {
+		return ""
+	}
+	return next.Query().Get("cursor")
+}
+
 // RequestQueryOptions represents the query parameters for getting organization devices
 type RequestQueryOptions struct {
 	// Field selection - fields to return for orgDevices
@@ -0,0 +1,32 @@
+package people
+
+// Field constants for fields[students] and fields[instructors] query parameters.
+const (
+	FieldFirstName           = "firstName"
+	FieldMiddleName          = "middleName"
+	FieldLastName            = "lastName"
+	FieldManagedAppleAccount = "managedAppleAccount"
+	FieldPersonNumber        = "personNumber"
+	FieldEmail               = "email"
+	FieldGrade               = "grade"
+	FieldRoles               = "roles"
+	FieldLocationIds         = "locationIds"
+	FieldSource              = "source"
+	FieldStatus              = "status"
+	FieldCreatedDateTime     = "createdDateTime"
+	FieldUpdatedDateTime     = "updatedDateTime"
+	FieldClasses             = "classes"
+)
+
+// PersonStatus constants for status field values.
+const (
+	PersonStatusActive   = "ACTIVE"
+	PersonStatusInactive = "INACTIVE"
+)
+
+// Role constants for the roles field values.
+const (
+	RoleStudent    = "STUDENT"
+	RoleInstructor = "INSTRUCTOR"
+	RoleStaff      = "STAFF"
+)
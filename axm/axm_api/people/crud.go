@@ -0,0 +1,213 @@
+package people
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/constants"
+	"resty.dev/v3"
+)
+
+// People handles communication with the student and instructor
+// related methods of the Apple School Manager API.
+//
+// Student and instructor resources are only available to Apple School Manager
+// organizations; configure the client with WithBaseURL(constants.SchoolBaseURL)
+// and WithScope(constants.ScopeSchoolAPI).
+//
+// Apple School Manager API docs: https://developer.apple.com/documentation/appleschoolmanagerapi/
+type (
+	People struct {
+		client client.Client
+	}
+)
+
+// NewService creates a new people service.
+func NewService(c client.Client) *People {
+	return &People{client: c}
+}
+
+// GetStudentsV1 retrieves a list of students in an organization.
+// URL: GET https://api-school.apple.com/v1/students
+// https://developer.apple.com/documentation/appleschoolmanagerapi/get-students
+func (s *People) GetStudentsV1(ctx context.Context, opts *RequestQueryOptions) (*PeopleResponse, *resty.Response, error) {
+	return s.list(ctx, constants.EndpointStudents, "fields[students]", opts)
+}
+
+// GetStudentByIDV1 retrieves information about a specific student in an organization.
+// URL: GET https://api-school.apple.com/v1/students/{id}
+// https://developer.apple.com/documentation/appleschoolmanagerapi/get-student-information
+func (s *People) GetStudentByIDV1(ctx context.Context, studentID string, opts *RequestQueryOptions) (*PersonResponse, *resty.Response, error) {
+	if studentID == "" {
+		return nil, nil, fmt.Errorf("student ID is required")
+	}
+
+	return s.get(ctx, constants.EndpointStudents+"/"+studentID, "fields[students]", opts)
+}
+
+// GetClassIDsByStudentIDV1 retrieves a list of class IDs a student is enrolled in.
+// URL: GET https://api-school.apple.com/v1/students/{id}/relationships/classes
+// https://developer.apple.com/documentation/appleschoolmanagerapi/get-all-class-ids-for-a-student
+func (s *People) GetClassIDsByStudentIDV1(ctx context.Context, studentID string, opts *RequestQueryOptions) (*ClassLinkagesResponse, *resty.Response, error) {
+	if studentID == "" {
+		return nil, nil, fmt.Errorf("student ID is required")
+	}
+
+	endpoint := fmt.Sprintf(constants.EndpointStudents+"/%s/relationships/classes", studentID)
+
+	return s.classLinkages(ctx, endpoint, opts)
+}
+
+// GetInstructorsV1 retrieves a list of instructors in an organization.
+// URL: GET https://api-school.apple.com/v1/instructors
+// https://developer.apple.com/documentation/appleschoolmanagerapi/get-instructors
+func (s *People) GetInstructorsV1(ctx context.Context, opts *RequestQueryOptions) (*PeopleResponse, *resty.Response, error) {
+	return s.list(ctx, constants.EndpointInstructors, "fields[instructors]", opts)
+}
+
+// GetInstructorByIDV1 retrieves information about a specific instructor in an organization.
+// URL: GET https://api-school.apple.com/v1/instructors/{id}
+// https://developer.apple.com/documentation/appleschoolmanagerapi/get-instructor-information
+func (s *People) GetInstructorByIDV1(ctx context.Context, instructorID string, opts *RequestQueryOptions) (*PersonResponse, *resty.Response, error) {
+	if instructorID == "" {
+		return nil, nil, fmt.Errorf("instructor ID is required")
+	}
+
+	return s.get(ctx, constants.EndpointInstructors+"/"+instructorID, "fields[instructors]", opts)
+}
+
+// GetClassIDsByInstructorIDV1 retrieves a list of class IDs an instructor teaches.
+// URL: GET https://api-school.apple.com/v1/instructors/{id}/relationships/classes
+// https://developer.apple.com/documentation/appleschoolmanagerapi/get-all-class-ids-for-an-instructor
+func (s *People) GetClassIDsByInstructorIDV1(ctx context.Context, instructorID string, opts *RequestQueryOptions) (*ClassLinkagesResponse, *resty.Response, error) {
+	if instructorID == "" {
+		return nil, nil, fmt.Errorf("instructor ID is required")
+	}
+
+	endpoint := fmt.Sprintf(constants.EndpointInstructors+"/%s/relationships/classes", instructorID)
+
+	return s.classLinkages(ctx, endpoint, opts)
+}
+
+// list fetches all pages of a people collection endpoint.
+func (s *People) list(ctx context.Context, endpoint, fieldsParam string, opts *RequestQueryOptions) (*PeopleResponse, *resty.Response, error) {
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	params := s.client.QueryBuilder()
+
+	if len(opts.Fields) > 0 {
+		params.AddStringSlice(fieldsParam, opts.Fields)
+	}
+	if opts.Limit > 0 {
+		if opts.Limit > 1000 {
+			opts.Limit = 1000
+		}
+		params.AddInt("limit", opts.Limit)
+	}
+
+	var allPeople []Person
+	var lastMeta *Meta
+	var lastLinks *Links
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetQueryParams(params.Build()).
+		GetPaginated(endpoint, func(pageData []byte) error {
+			var pageResponse PeopleResponse
+			if err := json.Unmarshal(pageData, &pageResponse); err != nil {
+				return fmt.Errorf("failed to unmarshal page: %w", err)
+			}
+			allPeople = append(allPeople, pageResponse.Data...)
+			lastMeta = pageResponse.Meta
+			lastLinks = pageResponse.Links
+			return nil
+		})
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &PeopleResponse{
+		Data:  allPeople,
+		Meta:  lastMeta,
+		Links: lastLinks,
+	}, resp, nil
+}
+
+// get fetches a single student or instructor.
+func (s *People) get(ctx context.Context, endpoint, fieldsParam string, opts *RequestQueryOptions) (*PersonResponse, *resty.Response, error) {
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	params := s.client.QueryBuilder()
+
+	if len(opts.Fields) > 0 {
+		params.AddStringSlice(fieldsParam, opts.Fields)
+	}
+
+	var result PersonResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetQueryParams(params.Build()).
+		SetResult(&result).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// classLinkages fetches all pages of a person's class relationship endpoint.
+func (s *People) classLinkages(ctx context.Context, endpoint string, opts *RequestQueryOptions) (*ClassLinkagesResponse, *resty.Response, error) {
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	params := s.client.QueryBuilder()
+
+	if opts.Limit > 0 {
+		if opts.Limit > 1000 {
+			opts.Limit = 1000
+		}
+		params.AddInt("limit", opts.Limit)
+	}
+
+	var allLinkages []ClassLinkage
+	var lastMeta *Meta
+	var lastLinks *Links
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetQueryParams(params.Build()).
+		GetPaginated(endpoint, func(pageData []byte) error {
+			var pageResponse ClassLinkagesResponse
+			if err := json.Unmarshal(pageData, &pageResponse); err != nil {
+				return fmt.Errorf("failed to unmarshal page: %w", err)
+			}
+			allLinkages = append(allLinkages, pageResponse.Data...)
+			lastMeta = pageResponse.Meta
+			lastLinks = pageResponse.Links
+			return nil
+		})
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &ClassLinkagesResponse{
+		Data:  allLinkages,
+		Meta:  lastMeta,
+		Links: lastLinks,
+	}, resp, nil
+}
@@ -0,0 +1,219 @@
+package people
+
+import (
+	"context"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/people/mocks"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/client"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+// setupMockClient creates a client with httpmock enabled.
+func setupMockClient(t *testing.T) *People {
+	mockAuth := &MockAuthProvider{}
+
+	coreClient, err := client.NewTransport(
+		"test-key-id",
+		"test-issuer-id",
+		"dummy-key",
+		client.WithAuth(mockAuth),
+		client.WithLogger(zap.NewNop()),
+		client.WithRetryCount(0),
+	)
+	require.NoError(t, err)
+
+	httpmock.ActivateNonDefault(coreClient.GetHTTPClient().Client())
+
+	t.Cleanup(func() {
+		httpmock.DeactivateAndReset()
+	})
+
+	return NewService(coreClient)
+}
+
+// MockAuthProvider implements the AuthProvider interface for testing.
+type MockAuthProvider struct{}
+
+func (m *MockAuthProvider) ApplyAuth(req *resty.Request) error {
+	return nil
+}
+
+func TestGetStudents_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.PeopleMock{}
+	mockHandler.RegisterMocks()
+	defer mockHandler.CleanupMockState()
+
+	opts := &RequestQueryOptions{
+		Fields: []string{FieldFirstName, FieldLastName, FieldManagedAppleAccount, FieldRoles},
+		Limit:  1,
+	}
+
+	result, resp, err := svc.GetStudentsV1(context.Background(), opts)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, 200, resp.StatusCode())
+	require.NotNil(t, result)
+	require.Len(t, result.Data, 2, "both pages should be merged")
+
+	student := result.Data[0]
+	assert.Equal(t, "students", student.Type)
+	assert.Equal(t, "STU-2001", student.ID)
+	require.NotNil(t, student.Attributes)
+	assert.Equal(t, "Alex", student.Attributes.FirstName)
+	assert.Equal(t, "alex.smith@appleid.school.example.com", student.Attributes.ManagedAppleAccount)
+	assert.Equal(t, "7", student.Attributes.Grade)
+	assert.Equal(t, []string{RoleStudent}, student.Attributes.Roles)
+	assert.Equal(t, PersonStatusActive, student.Attributes.Status)
+	require.NotNil(t, student.Relationships)
+	require.NotNil(t, student.Relationships.Classes)
+
+	assert.Equal(t, "STU-2002", result.Data[1].ID)
+}
+
+func TestGetStudents_HTTPError(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.PeopleMock{}
+	mockHandler.RegisterErrorMocks()
+	defer mockHandler.CleanupMockState()
+
+	result, resp, err := svc.GetStudentsV1(context.Background(), nil)
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	require.NotNil(t, resp)
+	assert.Equal(t, 500, resp.StatusCode())
+}
+
+func TestGetStudentInformation_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.PeopleMock{}
+	mockHandler.RegisterMocks()
+	defer mockHandler.CleanupMockState()
+
+	result, resp, err := svc.GetStudentByIDV1(context.Background(), "STU-2001", nil)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, 200, resp.StatusCode())
+	require.NotNil(t, result)
+	assert.Equal(t, "STU-2001", result.Data.ID)
+	require.NotNil(t, result.Data.Attributes)
+	assert.Equal(t, "S2001", result.Data.Attributes.PersonNumber)
+}
+
+func TestGetStudentInformation_EmptyStudentID(t *testing.T) {
+	svc := setupMockClient(t)
+
+	result, resp, err := svc.GetStudentByIDV1(context.Background(), "", nil)
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Nil(t, resp)
+	assert.Contains(t, err.Error(), "student ID is required")
+}
+
+func TestGetStudentInformation_NotFound(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.PeopleMock{}
+	mockHandler.RegisterMocks()
+	defer mockHandler.CleanupMockState()
+
+	result, resp, err := svc.GetStudentByIDV1(context.Background(), "missing", nil)
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	require.NotNil(t, resp)
+	assert.Equal(t, 404, resp.StatusCode())
+}
+
+func TestGetClassIDsByStudentID_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.PeopleMock{}
+	mockHandler.RegisterMocks()
+	defer mockHandler.CleanupMockState()
+
+	result, _, err := svc.GetClassIDsByStudentIDV1(context.Background(), "STU-2001", &RequestQueryOptions{Limit: 100})
+
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Len(t, result.Data, 2)
+	assert.Equal(t, "classes", result.Data[0].Type)
+	assert.Equal(t, "CLS-1001", result.Data[0].ID)
+}
+
+func TestGetClassIDsByStudentID_EmptyStudentID(t *testing.T) {
+	svc := setupMockClient(t)
+
+	result, resp, err := svc.GetClassIDsByStudentIDV1(context.Background(), "", nil)
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Nil(t, resp)
+}
+
+func TestGetInstructors_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.PeopleMock{}
+	mockHandler.RegisterMocks()
+	defer mockHandler.CleanupMockState()
+
+	result, resp, err := svc.GetInstructorsV1(context.Background(), &RequestQueryOptions{Limit: 5000})
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, 200, resp.StatusCode())
+	require.Len(t, result.Data, 1)
+
+	instructor := result.Data[0]
+	assert.Equal(t, "instructors", instructor.Type)
+	assert.Equal(t, "INS-3001", instructor.ID)
+	require.NotNil(t, instructor.Attributes)
+	assert.Equal(t, "jordan.lee@school.example.com", instructor.Attributes.Email)
+	assert.Equal(t, []string{RoleInstructor}, instructor.Attributes.Roles)
+}
+
+func TestGetInstructorInformation_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.PeopleMock{}
+	mockHandler.RegisterMocks()
+	defer mockHandler.CleanupMockState()
+
+	result, _, err := svc.GetInstructorByIDV1(context.Background(), "INS-3001", &RequestQueryOptions{
+		Fields: []string{FieldFirstName, FieldEmail},
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "INS-3001", result.Data.ID)
+}
+
+func TestGetInstructorInformation_EmptyInstructorID(t *testing.T) {
+	svc := setupMockClient(t)
+
+	result, resp, err := svc.GetInstructorByIDV1(context.Background(), "", nil)
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Nil(t, resp)
+	assert.Contains(t, err.Error(), "instructor ID is required")
+}
+
+func TestGetClassIDsByInstructorID_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.PeopleMock{}
+	mockHandler.RegisterMocks()
+	defer mockHandler.CleanupMockState()
+
+	result, _, err := svc.GetClassIDsByInstructorIDV1(context.Background(), "INS-3001", nil)
+
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Len(t, result.Data, 2)
+}
@@ -0,0 +1,128 @@
+package mocks
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/jarcoal/httpmock"
+)
+
+var mockState struct {
+	sync.Mutex
+	people map[string]map[string]any
+}
+
+func init() {
+	mockState.people = make(map[string]map[string]any)
+	httpmock.RegisterNoResponder(httpmock.NewStringResponder(404, `{"errors":[{"status":"404","code":"RESOURCE_NOT_FOUND","title":"Resource Not Found","detail":"The requested resource was not found"}]}`))
+}
+
+// loadMockResponse loads JSON response from the mocks folder.
+func loadMockResponse(filename string) ([]byte, error) {
+	mockPath := filepath.Join("mocks", filename)
+	return os.ReadFile(mockPath)
+}
+
+// jsonFileResponse serves the given mock file as a 200 JSON response.
+func jsonFileResponse(filename string) (*http.Response, error) {
+	mockData, err := loadMockResponse(filename)
+	if err != nil {
+		return httpmock.NewStringResponse(500, `{"errors":[{"status":"500","code":"INTERNAL_ERROR","title":"Internal Server Error","detail":"Failed to load mock data"}]}`), nil
+	}
+
+	var responseObj map[string]any
+	if err := json.Unmarshal(mockData, &responseObj); err != nil {
+		return httpmock.NewStringResponse(500, `{"errors":[{"status":"500","code":"INTERNAL_ERROR","title":"Internal Server Error","detail":"Failed to parse mock data"}]}`), nil
+	}
+
+	return httpmock.NewJsonResponse(200, responseObj)
+}
+
+// personResponder returns the given mock file if the requested person ID was seeded.
+func personResponder(filename string) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		parts := strings.Split(req.URL.Path, "/")
+		personID := parts[len(parts)-1]
+
+		mockState.Lock()
+		_, exists := mockState.people[personID]
+		mockState.Unlock()
+
+		if !exists {
+			return httpmock.NewStringResponse(404, `{"errors":[{"status":"404","code":"RESOURCE_NOT_FOUND","title":"Person Not Found","detail":"The requested person was not found"}]}`), nil
+		}
+
+		return jsonFileResponse(filename)
+	}
+}
+
+// PeopleMock provides httpmock responders for student and instructor endpoints.
+type PeopleMock struct{}
+
+// RegisterMocks registers all HTTP mock responders for students and instructors.
+func (m *PeopleMock) RegisterMocks() {
+	mockState.Lock()
+	mockState.people = make(map[string]map[string]any)
+	mockState.Unlock()
+
+	m.seedTestPeople()
+
+	// GET /students — list students (two pages)
+	httpmock.RegisterResponder("GET", "https://api-business.apple.com/v1/students", func(req *http.Request) (*http.Response, error) {
+		if req.URL.Query().Get("cursor") == "page2" {
+			return jsonFileResponse("validate_get_students_page2.json")
+		}
+		return jsonFileResponse("validate_get_students_page1.json")
+	})
+
+	// GET /students/{id} — get student by ID
+	httpmock.RegisterResponder("GET", `=~^https://api-business\.apple\.com/v1/students/[^/]+$`, personResponder("validate_get_student_information.json"))
+
+	// GET /instructors — list instructors
+	httpmock.RegisterResponder("GET", "https://api-business.apple.com/v1/instructors", func(req *http.Request) (*http.Response, error) {
+		return jsonFileResponse("validate_get_instructors.json")
+	})
+
+	// GET /instructors/{id} — get instructor by ID
+	httpmock.RegisterResponder("GET", `=~^https://api-business\.apple\.com/v1/instructors/[^/]+$`, personResponder("validate_get_instructor_information.json"))
+
+	// GET /{students|instructors}/{id}/relationships/classes — get class IDs
+	httpmock.RegisterResponder("GET", `=~^https://api-business\.apple\.com/v1/(students|instructors)/[^/]+/relationships/classes$`, func(req *http.Request) (*http.Response, error) {
+		return jsonFileResponse("validate_get_person_classes.json")
+	})
+}
+
+// RegisterErrorMocks registers mock responders that return error responses.
+func (m *PeopleMock) RegisterErrorMocks() {
+	mockState.Lock()
+	mockState.people = make(map[string]map[string]any)
+	mockState.Unlock()
+
+	httpmock.RegisterResponder("GET", `=~^https://api-business\.apple\.com/v1/(students|instructors)$`, func(req *http.Request) (*http.Response, error) {
+		return httpmock.NewStringResponse(500, `{"errors":[{"status":"500","code":"INTERNAL_ERROR","title":"Internal Server Error","detail":"Mock error for testing"}]}`), nil
+	})
+
+	httpmock.RegisterResponder("GET", `=~^https://api-business\.apple\.com/v1/(students|instructors)/[^/]+$`, func(req *http.Request) (*http.Response, error) {
+		return httpmock.NewStringResponse(404, `{"errors":[{"status":"404","code":"RESOURCE_NOT_FOUND","title":"Person Not Found","detail":"The requested person was not found"}]}`), nil
+	})
+}
+
+// CleanupMockState clears all mock state data.
+func (m *PeopleMock) CleanupMockState() {
+	mockState.Lock()
+	defer mockState.Unlock()
+	for id := range mockState.people {
+		delete(mockState.people, id)
+	}
+}
+
+func (m *PeopleMock) seedTestPeople() {
+	mockState.Lock()
+	mockState.people["STU-2001"] = map[string]any{"type": "students", "id": "STU-2001"}
+	mockState.people["INS-3001"] = map[string]any{"type": "instructors", "id": "INS-3001"}
+	mockState.Unlock()
+}
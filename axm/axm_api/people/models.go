@@ -0,0 +1,99 @@
+package people
+
+import "time"
+
+// Shared pagination types
+
+type Meta struct {
+	Paging *Paging `json:"paging,omitempty"`
+}
+
+type Paging struct {
+	Total      int    `json:"total,omitempty"`
+	Limit      int    `json:"limit,omitempty"`
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
+type Links struct {
+	Self  string `json:"self,omitempty"`
+	First string `json:"first,omitempty"`
+	Next  string `json:"next,omitempty"`
+	Prev  string `json:"prev,omitempty"`
+	Last  string `json:"last,omitempty"`
+}
+
+type ResourceLinks struct {
+	Self    string `json:"self,omitempty"`
+	Related string `json:"related,omitempty"`
+}
+
+// PeopleResponse is the response for a list of students or instructors.
+type PeopleResponse struct {
+	Data  []Person `json:"data"`
+	Links *Links   `json:"links,omitempty"`
+	Meta  *Meta    `json:"meta,omitempty"`
+}
+
+// PersonResponse is the response for a single student or instructor.
+type PersonResponse struct {
+	Data  Person `json:"data"`
+	Links *Links `json:"links,omitempty"`
+}
+
+// Person represents an Apple School Manager student or instructor resource.
+// Type is "students" or "instructors".
+type Person struct {
+	ID            string               `json:"id"`
+	Type          string               `json:"type"`
+	Attributes    *PersonAttributes    `json:"attributes,omitempty"`
+	Relationships *PersonRelationships `json:"relationships,omitempty"`
+	Links         *ResourceLinks       `json:"links,omitempty"`
+}
+
+// PersonAttributes contains the attributes of a student or instructor.
+type PersonAttributes struct {
+	FirstName           string     `json:"firstName,omitempty"`
+	MiddleName          string     `json:"middleName,omitempty"`
+	LastName            string     `json:"lastName,omitempty"`
+	ManagedAppleAccount string     `json:"managedAppleAccount,omitempty"`
+	PersonNumber        string     `json:"personNumber,omitempty"`
+	Email               string     `json:"email,omitempty"`
+	Grade               string     `json:"grade,omitempty"`
+	Roles               []string   `json:"roles,omitempty"`
+	LocationIds         []string   `json:"locationIds,omitempty"`
+	Source              string     `json:"source,omitempty"`
+	Status              string     `json:"status,omitempty"`
+	CreatedDateTime     *time.Time `json:"createdDateTime,omitempty"`
+	UpdatedDateTime     *time.Time `json:"updatedDateTime,omitempty"`
+}
+
+// PersonRelationships contains relationship links for a student or instructor.
+type PersonRelationships struct {
+	Classes *RelationshipData `json:"classes,omitempty"`
+}
+
+// RelationshipData holds the links for a relationship.
+type RelationshipData struct {
+	Links *ResourceLinks `json:"links,omitempty"`
+}
+
+// ClassLinkagesResponse is the response for the class IDs linked to a person.
+type ClassLinkagesResponse struct {
+	Data  []ClassLinkage `json:"data"`
+	Links *Links         `json:"links,omitempty"`
+	Meta  *Meta          `json:"meta,omitempty"`
+}
+
+// ClassLinkage represents a class linkage (type + ID only).
+type ClassLinkage struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// RequestQueryOptions represents query parameters for student and instructor endpoints.
+type RequestQueryOptions struct {
+	// Fields specifies which fields to return. Use Field* constants.
+	Fields []string
+	// Limit is the number of resources to return (max 1000).
+	Limit int
+}
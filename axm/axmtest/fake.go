@@ -0,0 +1,310 @@
+// Package axmtest generates realistic Apple Business Manager models for
+// tests of code that uses the axm client.
+//
+// Each Fake function takes a seed and always returns the same value for the
+// same seed, on every platform and Go release, so generated fixtures can be
+// asserted on exactly and regenerated instead of checked in. Different seeds
+// give different identifiers. Values are plausible rather than real: model
+// identifiers and names come from the devicemodels table, IMEIs and EIDs
+// carry valid check digits, and timestamps are ordered as the API orders
+// them, but serial numbers and MAC addresses belong to no device.
+//
+// Generated models can be served from a mock transport or an httptest
+// server, or passed directly to the code under test:
+//
+//	page := devices.OrgDevicesResponse{Data: axmtest.FakeOrgDevices(1, 50)}
+package axmtest
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devicemanagement"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devices"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/constants"
+	"github.com/deploymenttheory/go-api-sdk-apple/devicemodels"
+)
+
+// Servers is the number of distinct MDM servers that fake devices are
+// assigned to: an assigned device's AssignedServer is the ID of
+// FakeMdmServer(n) for some n in [0, Servers).
+const Servers = 3
+
+// referenceTime stands in for the current time in generated data, so that
+// values which depend on it, such as whether coverage has expired, do not
+// change from run to run.
+var referenceTime = time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+// Streams keep the values for one seed independent between model kinds.
+const (
+	streamDevice uint64 = iota + 1
+	streamServer
+	streamActivity
+	streamCoverage
+)
+
+// serialAlphabet omits O and I, which are easily mistaken for 0 and 1.
+const serialAlphabet = "0123456789ABCDEFGHJKLMNPQRSTUVWXYZ"
+
+// source draws values from a PCG stream, whose output is fixed by its
+// specification. Values are derived from Uint64 alone so they do not depend
+// on how math/rand/v2 implements its other methods.
+type source struct {
+	pcg *rand.PCG
+}
+
+func newSource(seed int64, stream uint64) *source {
+	return &source{pcg: rand.NewPCG(uint64(seed), stream)}
+}
+
+// intn returns a value in [0, n).
+func (s *source) intn(n int) int {
+	return int(s.pcg.Uint64() % uint64(n))
+}
+
+func (s *source) chance(percent int) bool {
+	return s.intn(100) < percent
+}
+
+// chars returns n characters drawn from alphabet.
+func (s *source) chars(n int, alphabet string) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = alphabet[s.intn(len(alphabet))]
+	}
+	return string(b)
+}
+
+func (s *source) digits(n int) string {
+	return s.chars(n, "0123456789")
+}
+
+// after returns a time less than within after t, truncated to the
+// millisecond precision of API timestamps.
+func (s *source) after(t time.Time, within time.Duration) time.Time {
+	return t.Add(time.Duration(s.pcg.Uint64() % uint64(within))).Truncate(time.Millisecond)
+}
+
+func pick[T any](s *source, values []T) T {
+	return values[s.intn(len(values))]
+}
+
+// deviceModels are the Mac, iPhone and iPad models, the product families
+// Apple Business Manager reports.
+var deviceModels = func() []devicemodels.Model {
+	var out []devicemodels.Model
+	for _, m := range devicemodels.All() {
+		switch m.Family {
+		case devicemodels.FamilyMac, devicemodels.FamilyIPhone, devicemodels.FamilyIPad:
+			out = append(out, m)
+		}
+	}
+	return out
+}()
+
+var (
+	colors     = []string{"SILVER", "SPACE GRAY", "SPACE BLACK", "MIDNIGHT", "STARLIGHT", "BLUE"}
+	capacities = map[string][]string{
+		devicemodels.FamilyMac:    {"256GB", "512GB", "1TB", "2TB"},
+		devicemodels.FamilyIPhone: {"128GB", "256GB", "512GB"},
+		devicemodels.FamilyIPad:   {"64GB", "128GB", "256GB", "512GB"},
+	}
+	serverSites = []string{"Headquarters", "Retail", "Warehouse", "Field Staff", "Classroom", "Loaners"}
+)
+
+// FakeOrgDevice returns a device with every attribute Apple reports for its
+// product family. iPhones and about half of iPads have an IMEI and EID. Two
+// in three devices are assigned to one of Servers fake MDM servers.
+func FakeOrgDevice(seed int64) devices.OrgDevice {
+	s := newSource(seed, streamDevice)
+	model := pick(s, deviceModels)
+	serial := s.chars(10, serialAlphabet)
+
+	ordered := s.after(time.Date(model.Year, 1, 1, 0, 0, 0, 0, time.UTC), 365*24*time.Hour)
+	added := s.after(ordered, 30*24*time.Hour)
+	updated := s.after(added, 180*24*time.Hour)
+
+	attrs := &devices.OrgDeviceAttributes{
+		SerialNumber:        serial,
+		AddedToOrgDateTime:  &added,
+		UpdatedDateTime:     &updated,
+		DeviceModel:         model.MarketingName,
+		ProductFamily:       model.Family,
+		ProductType:         model.Identifier,
+		DeviceCapacity:      pick(s, capacities[model.Family]),
+		PartNumber:          "M" + s.chars(4, serialAlphabet) + "LL/A",
+		OrderNumber:         s.digits(10),
+		Color:               pick(s, colors),
+		Status:              "UNASSIGNED",
+		OrderDateTime:       &ordered,
+		WiFiMACAddress:      fakeMAC(s),
+		BluetoothMACAddress: fakeMAC(s),
+		PurchaseSourceId:    s.digits(7),
+		PurchaseSourceType:  pick(s, []string{"APPLE", "RESELLER"}),
+	}
+	if model.Family == devicemodels.FamilyIPhone || (model.Family == devicemodels.FamilyIPad && s.chance(50)) {
+		attrs.IMEI = []string{fakeIMEI(s)}
+		attrs.EID = fakeEID(s)
+	}
+	if s.chance(67) {
+		attrs.Status = "ASSIGNED"
+		attrs.AssignedServer = FakeMdmServer(int64(s.intn(Servers))).ID
+	}
+
+	return devices.OrgDevice{ID: serial, Type: "orgDevices", Attributes: attrs}
+}
+
+// FakeOrgDevices returns the devices for seeds seed through seed+n-1.
+func FakeOrgDevices(seed int64, n int) []devices.OrgDevice {
+	out := make([]devices.OrgDevice, n)
+	for i := range out {
+		out[i] = FakeOrgDevice(seed + int64(i))
+	}
+	return out
+}
+
+// FakeMdmServer returns an MDM server with a 32-character hexadecimal ID, as
+// Apple assigns, and its devices relationship link.
+func FakeMdmServer(seed int64) devicemanagement.MDMServer {
+	s := newSource(seed, streamServer)
+	id := s.chars(32, "0123456789ABCDEF")
+	created := s.after(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), 5*365*24*time.Hour)
+	updated := s.after(created, 365*24*time.Hour)
+
+	return devicemanagement.MDMServer{
+		ID:   id,
+		Type: "mdmServers",
+		Attributes: &devicemanagement.MDMServerAttributes{
+			ServerName:      pick(s, serverSites) + " MDM",
+			ServerType:      "MDM",
+			CreatedDateTime: &created,
+			UpdatedDateTime: &updated,
+		},
+		Relationships: &devicemanagement.MDMServerRelationships{
+			Devices: &devicemanagement.MDMServerDevicesRelationship{
+				Links: &devicemanagement.MDMServerDevicesLinks{
+					Self: constants.DefaultBaseURL + "/v1/mdmServers/" + id + "/relationships/devices",
+				},
+			},
+		},
+	}
+}
+
+// FakeOrgDeviceActivity returns a completed assign or unassign activity with
+// a UUID, as Apple assigns, and its self link.
+func FakeOrgDeviceActivity(seed int64) devicemanagement.OrgDeviceActivity {
+	s := newSource(seed, streamActivity)
+	id := fakeUUID(s)
+	created := s.after(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), 365*24*time.Hour)
+
+	return devicemanagement.OrgDeviceActivity{
+		ID:   id,
+		Type: "orgDeviceActivities",
+		Attributes: &devicemanagement.OrgDeviceActivityAttributes{
+			Status:          devicemanagement.ActivityStatusCompleted,
+			CreatedDateTime: &created,
+			ActivityType:    pick(s, []string{devicemanagement.ActivityTypeAssignDevices, devicemanagement.ActivityTypeUnassignDevices}),
+		},
+		Links: &devicemanagement.OrgDeviceActivityLinks{
+			Self: constants.DefaultBaseURL + "/v1/orgDeviceActivities/" + id,
+		},
+	}
+}
+
+// FakeAppleCareCoverage returns a coverage record for the device with the
+// given seed: the one-year Limited Warranty from its order date, or an
+// AppleCare+ agreement, which is renewable and carries an agreement number.
+// Coverage that ended before June 2025 is EXPIRED.
+func FakeAppleCareCoverage(seed int64) devices.AppleCareCoverage {
+	device := FakeOrgDevice(seed)
+	s := newSource(seed, streamCoverage)
+	start := device.Attributes.OrderDateTime.Truncate(24 * time.Hour)
+
+	attrs := &devices.AppleCareCoverageAttributes{
+		Status:        devices.AppleCareStatusActive,
+		PaymentType:   devices.PaymentTypeNone,
+		Description:   "Limited Warranty",
+		StartDateTime: &start,
+	}
+	id := device.ID
+	if s.chance(50) {
+		agreement := s.digits(10)
+		id = agreement
+		attrs.PaymentType = devices.PaymentTypeSubscription
+		attrs.Description = "AppleCare+"
+		attrs.AgreementNumber = &agreement
+		attrs.IsRenewable = true
+	}
+	end := start.AddDate(1, 0, 0)
+	attrs.EndDateTime = &end
+	if end.Before(referenceTime) {
+		attrs.Status = devices.AppleCareStatusExpired
+	}
+
+	return devices.AppleCareCoverage{ID: id, Type: "appleCareCoverage", Attributes: attrs}
+}
+
+// fakeMAC returns a locally administered unicast MAC address, which no
+// manufacturer assigns.
+func fakeMAC(s *source) string {
+	octets := make([]string, 6)
+	for i := range octets {
+		b := byte(s.intn(256))
+		if i == 0 {
+			b = b&^0x01 | 0x02
+		}
+		octets[i] = fmt.Sprintf("%02x", b)
+	}
+	return strings.Join(octets, ":")
+}
+
+// fakeIMEI returns a 15-digit IMEI whose last digit is its Luhn check digit.
+func fakeIMEI(s *source) string {
+	body := "35" + s.digits(12)
+	return body + string('0'+byte(luhnCheckDigit(body)))
+}
+
+// luhnCheckDigit returns the digit that makes digits+check pass the Luhn
+// check.
+func luhnCheckDigit(digits string) int {
+	sum := 0
+	for i := range len(digits) {
+		d := int(digits[len(digits)-1-i] - '0')
+		if i%2 == 0 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return (10 - sum%10) % 10
+}
+
+// fakeEID returns a 32-digit eUICC identifier whose last two digits are its
+// ISO 7064 MOD 97-10 check digits, so the whole number is 1 modulo 97.
+func fakeEID(s *source) string {
+	body := "89049032" + s.digits(22)
+	return body + fmt.Sprintf("%02d", 98-mod97(body+"00"))
+}
+
+func mod97(digits string) int {
+	r := 0
+	for i := range len(digits) {
+		r = (r*10 + int(digits[i]-'0')) % 97
+	}
+	return r
+}
+
+// fakeUUID returns a version 4 UUID in lower-case hexadecimal.
+func fakeUUID(s *source) string {
+	b := make([]byte, 16)
+	for i := range b {
+		b[i] = byte(s.intn(256))
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
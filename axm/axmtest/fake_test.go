@@ -0,0 +1,148 @@
+package axmtest
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devicemanagement"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devices"
+	"github.com/deploymenttheory/go-api-sdk-apple/devicemodels"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	serialPattern = regexp.MustCompile(`^[0-9A-HJ-NP-Z]{10}$`)
+	macPattern    = regexp.MustCompile(`^[0-9a-f]{2}(:[0-9a-f]{2}){5}$`)
+	uuidPattern   = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+	hexIDPattern  = regexp.MustCompile(`^[0-9A-F]{32}$`)
+)
+
+func TestFakeOrgDevice_Deterministic(t *testing.T) {
+	assert.Equal(t, FakeOrgDevice(42), FakeOrgDevice(42))
+	assert.NotEqual(t, FakeOrgDevice(42).ID, FakeOrgDevice(43).ID)
+
+	// Pinned so that a change to the generator, which would break tests
+	// asserting on generated values, is deliberate.
+	assert.Equal(t, "KYYH6QDFJZ", FakeOrgDevice(1).ID)
+}
+
+func TestFakeOrgDevice_Plausible(t *testing.T) {
+	servers := make(map[string]bool)
+	for n := range int64(Servers) {
+		servers[FakeMdmServer(n).ID] = true
+	}
+
+	serials := make(map[string]bool)
+	for _, d := range FakeOrgDevices(0, 500) {
+		a := d.Attributes
+		require.NotNil(t, a)
+		assert.Equal(t, "orgDevices", d.Type)
+		assert.Equal(t, d.ID, a.SerialNumber)
+		assert.Regexp(t, serialPattern, a.SerialNumber)
+		assert.False(t, serials[a.SerialNumber], "serial %s repeats", a.SerialNumber)
+		serials[a.SerialNumber] = true
+
+		model, ok := devicemodels.Lookup(a.ProductType)
+		require.True(t, ok, "unknown model %s", a.ProductType)
+		assert.Equal(t, model.MarketingName, a.DeviceModel)
+		assert.Equal(t, model.Family, a.ProductFamily)
+
+		assert.True(t, a.OrderDateTime.Before(*a.AddedToOrgDateTime) || a.OrderDateTime.Equal(*a.AddedToOrgDateTime))
+		assert.False(t, a.UpdatedDateTime.Before(*a.AddedToOrgDateTime))
+		assert.Equal(t, model.Year, a.OrderDateTime.Year())
+
+		assert.Regexp(t, macPattern, a.WiFiMACAddress)
+		assert.Regexp(t, macPattern, a.BluetoothMACAddress)
+
+		if a.ProductFamily == devicemodels.FamilyMac {
+			assert.Empty(t, a.IMEI)
+		}
+		if a.ProductFamily == devicemodels.FamilyIPhone {
+			require.Len(t, a.IMEI, 1)
+		}
+		for _, imei := range a.IMEI {
+			assert.Len(t, imei, 15)
+			assert.True(t, luhnValid(imei), "IMEI %s fails the Luhn check", imei)
+			assert.Len(t, a.EID, 32)
+			assert.Equal(t, 1, mod97(a.EID), "EID %s fails its check digits", a.EID)
+		}
+
+		switch a.Status {
+		case "ASSIGNED":
+			assert.True(t, servers[a.AssignedServer], "assigned to unknown server %s", a.AssignedServer)
+		case "UNASSIGNED":
+			assert.Empty(t, a.AssignedServer)
+		default:
+			t.Errorf("status %q", a.Status)
+		}
+	}
+}
+
+func TestFakeMdmServer(t *testing.T) {
+	s := FakeMdmServer(7)
+	assert.Equal(t, s, FakeMdmServer(7))
+	assert.NotEqual(t, s.ID, FakeMdmServer(8).ID)
+	assert.Regexp(t, hexIDPattern, s.ID)
+	assert.Equal(t, "mdmServers", s.Type)
+	assert.Equal(t, "MDM", s.Attributes.ServerType)
+	assert.False(t, s.Attributes.UpdatedDateTime.Before(*s.Attributes.CreatedDateTime))
+	assert.Equal(t, "https://api-business.apple.com/v1/mdmServers/"+s.ID+"/relationships/devices", s.Relationships.Devices.Links.Self)
+}
+
+func TestFakeOrgDeviceActivity(t *testing.T) {
+	a := FakeOrgDeviceActivity(7)
+	assert.Equal(t, a, FakeOrgDeviceActivity(7))
+	assert.Regexp(t, uuidPattern, a.ID)
+	assert.True(t, a.Succeeded())
+	assert.Contains(t, []string{devicemanagement.ActivityTypeAssignDevices, devicemanagement.ActivityTypeUnassignDevices}, a.Attributes.ActivityType)
+}
+
+func TestFakeAppleCareCoverage(t *testing.T) {
+	for seed := range int64(100) {
+		c := FakeAppleCareCoverage(seed)
+		a := c.Attributes
+		assert.Equal(t, c, FakeAppleCareCoverage(seed))
+		assert.Equal(t, a.StartDateTime.AddDate(1, 0, 0), *a.EndDateTime)
+		if a.EndDateTime.Before(referenceTime) {
+			assert.Equal(t, devices.AppleCareStatusExpired, a.Status)
+		} else {
+			assert.Equal(t, devices.AppleCareStatusActive, a.Status)
+		}
+		if a.PaymentType == devices.PaymentTypeSubscription {
+			require.NotNil(t, a.AgreementNumber)
+			assert.Equal(t, *a.AgreementNumber, c.ID)
+			assert.True(t, a.IsRenewable)
+		} else {
+			assert.Equal(t, FakeOrgDevice(seed).ID, c.ID)
+		}
+	}
+}
+
+// TestFakeModelsDecode checks that generated models survive the JSON the
+// client decodes them from.
+func TestFakeModelsDecode(t *testing.T) {
+	page := devices.OrgDevicesResponse{Data: FakeOrgDevices(0, 20)}
+	data, err := json.Marshal(page)
+	require.NoError(t, err)
+	var decoded devices.OrgDevicesResponse
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, page, decoded)
+}
+
+func luhnValid(number string) bool {
+	sum := 0
+	for i := range len(number) {
+		d, _ := strconv.Atoi(string(number[len(number)-1-i]))
+		if i%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
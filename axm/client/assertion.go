@@ -0,0 +1,146 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// MaxAssertionLifetime is the longest validity Apple accepts for a client
+// assertion, from iat to exp.
+const MaxAssertionLifetime = 180 * 24 * time.Hour
+
+// assertionClockSkew is how far in the future iat may be before it is flagged.
+const assertionClockSkew = time.Minute
+
+// AssertionInspection is the decoded content of a client assertion JWT,
+// with hints about anything the token endpoint is likely to reject. It is
+// meant for debugging "invalid_client" errors; the signature is not checked.
+type AssertionInspection struct {
+	Header map[string]any
+	Claims map[string]any
+
+	Algorithm string
+	KeyID     string
+	Issuer    string
+	Subject   string
+	Audience  []string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+
+	// Hints lists the problems found, one sentence each. It is empty when
+	// the assertion looks valid.
+	Hints []string
+}
+
+// OK reports whether no problems were found.
+func (i *AssertionInspection) OK() bool { return len(i.Hints) == 0 }
+
+// String formats the inspection as a short report for logs or a terminal.
+func (i *AssertionInspection) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "alg: %s\nkid: %s\niss: %s\nsub: %s\naud: %s\n",
+		i.Algorithm, i.KeyID, i.Issuer, i.Subject, strings.Join(i.Audience, ", "))
+	fmt.Fprintf(&b, "iat: %s\nexp: %s\n", formatClaimTime(i.IssuedAt), formatClaimTime(i.ExpiresAt))
+	if i.OK() {
+		b.WriteString("no problems found\n")
+	}
+	for _, hint := range i.Hints {
+		fmt.Fprintf(&b, "- %s\n", hint)
+	}
+	return b.String()
+}
+
+func formatClaimTime(t time.Time) string {
+	if t.IsZero() {
+		return "(missing)"
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// InspectAssertion decodes a client assertion without verifying its
+// signature and checks it against what this SDK sends to Apple's token
+// endpoint: an ES256 signature, a kid header, iss and sub both set to the
+// client ID, aud set to DefaultOAuthTokenEndpoint, and an iat/exp window that
+// has started, has not ended and is at most MaxAssertionLifetime long. It
+// returns an error only if token is not a JWT.
+func InspectAssertion(token string) (*AssertionInspection, error) {
+	return inspectAssertion(token, time.Now())
+}
+
+func inspectAssertion(token string, now time.Time) (*AssertionInspection, error) {
+	parsed, _, err := jwt.NewParser().ParseUnverified(strings.TrimSpace(token), jwt.MapClaims{})
+	if err != nil {
+		return nil, fmt.Errorf("not a JWT: %w", err)
+	}
+	claims := parsed.Claims.(jwt.MapClaims)
+
+	i := &AssertionInspection{Header: parsed.Header, Claims: claims}
+	i.Algorithm, _ = parsed.Header["alg"].(string)
+	i.KeyID, _ = parsed.Header["kid"].(string)
+	i.Issuer, _ = claims.GetIssuer()
+	i.Subject, _ = claims.GetSubject()
+	i.Audience, _ = claims.GetAudience()
+	if iat, err := claims.GetIssuedAt(); err == nil && iat != nil {
+		i.IssuedAt = iat.Time
+	}
+	if exp, err := claims.GetExpirationTime(); err == nil && exp != nil {
+		i.ExpiresAt = exp.Time
+	}
+
+	hint := func(format string, args ...any) {
+		i.Hints = append(i.Hints, fmt.Sprintf(format, args...))
+	}
+
+	if i.Algorithm != jwt.SigningMethodES256.Alg() {
+		hint("alg is %q; Apple Business Manager keys are EC P-256 keys and the assertion must be signed with ES256", i.Algorithm)
+	}
+	if i.KeyID == "" {
+		hint("kid header is missing; set it to the key ID of the private key")
+	}
+	switch {
+	case i.Issuer == "" || i.Subject == "":
+		hint("iss and sub must both be set to the client ID")
+	case i.Issuer != i.Subject:
+		hint("iss %q and sub %q differ; both must be the client ID", i.Issuer, i.Subject)
+	}
+	if len(i.Audience) != 1 || i.Audience[0] != DefaultOAuthTokenEndpoint {
+		hint("aud is %q; it must be %q", strings.Join(i.Audience, ", "), DefaultOAuthTokenEndpoint)
+	}
+
+	switch {
+	case i.IssuedAt.IsZero():
+		hint("iat claim is missing")
+	case i.IssuedAt.After(now.Add(assertionClockSkew)):
+		hint("iat is %s in the future; check the clock of the machine that signed it", i.IssuedAt.Sub(now).Round(time.Second))
+	}
+	switch {
+	case i.ExpiresAt.IsZero():
+		hint("exp claim is missing")
+	case !i.ExpiresAt.After(now):
+		hint("assertion expired %s ago", now.Sub(i.ExpiresAt).Round(time.Second))
+	}
+	if !i.IssuedAt.IsZero() && !i.ExpiresAt.IsZero() {
+		switch lifetime := i.ExpiresAt.Sub(i.IssuedAt); {
+		case lifetime <= 0:
+			hint("exp is not after iat")
+		case lifetime > MaxAssertionLifetime:
+			hint("lifetime is %.1f days; Apple accepts at most %.0f", lifetime.Hours()/24, MaxAssertionLifetime.Hours()/24)
+		}
+	}
+
+	return i, nil
+}
+
+// InspectClientAssertion signs a fresh client assertion with j's key and
+// settings and inspects it, to show exactly what the SDK sends to the token
+// endpoint.
+func (j *JWTAuth) InspectClientAssertion() (*AssertionInspection, error) {
+	assertion, err := j.generateClientAssertion()
+	if err != nil {
+		return nil, err
+	}
+	return InspectAssertion(assertion)
+}
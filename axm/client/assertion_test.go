@@ -0,0 +1,96 @@
+package client
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestJWTAuth_InspectClientAssertion(t *testing.T) {
+	privateKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	auth := NewJWTAuth(JWTAuthConfig{KeyID: "KEY123", IssuerID: "BUSINESSAPI.client", PrivateKey: privateKey})
+
+	inspection, err := auth.InspectClientAssertion()
+	if err != nil {
+		t.Fatalf("InspectClientAssertion failed: %v", err)
+	}
+	if !inspection.OK() {
+		t.Errorf("the SDK's own assertion has hints: %v", inspection.Hints)
+	}
+	if inspection.Algorithm != "ES256" || inspection.KeyID != "KEY123" || inspection.Issuer != "BUSINESSAPI.client" {
+		t.Errorf("inspection = %+v", inspection)
+	}
+	if !strings.Contains(inspection.String(), "no problems found") {
+		t.Errorf("String() = %q", inspection.String())
+	}
+}
+
+func TestInspectAssertion_Hints(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	sign := func(claims jwt.MapClaims) string {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("secret"))
+		if err != nil {
+			t.Fatalf("signing failed: %v", err)
+		}
+		return token
+	}
+
+	inspection, err := inspectAssertion(sign(jwt.MapClaims{
+		"iss": "team",
+		"sub": "client",
+		"aud": "appstoreconnect-v1",
+		"iat": now.Add(-200 * 24 * time.Hour).Unix(),
+		"exp": now.Add(-time.Hour).Unix(),
+	}), now)
+	if err != nil {
+		t.Fatalf("inspectAssertion failed: %v", err)
+	}
+
+	want := []string{
+		`alg is "HS256"`,
+		"kid header is missing",
+		`iss "team" and sub "client" differ`,
+		`aud is "appstoreconnect-v1"`,
+		"assertion expired 1h0m0s ago",
+		"lifetime is 200.0 days; Apple accepts at most 180",
+	}
+	if len(inspection.Hints) != len(want) {
+		t.Fatalf("hints = %q, want %d", inspection.Hints, len(want))
+	}
+	for i, prefix := range want {
+		if !strings.HasPrefix(inspection.Hints[i], prefix) {
+			t.Errorf("hint %d = %q, want prefix %q", i, inspection.Hints[i], prefix)
+		}
+	}
+}
+
+func TestInspectAssertion_ClockSkew(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	privateKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"iss": "client", "sub": "client", "aud": DefaultOAuthTokenEndpoint,
+		"iat": now.Add(10 * time.Minute).Unix(),
+		"exp": now.Add(24 * time.Hour).Unix(),
+	})
+	token.Header["kid"] = "KEY123"
+	signed, _ := token.SignedString(privateKey)
+
+	inspection, err := inspectAssertion(signed, now)
+	if err != nil {
+		t.Fatalf("inspectAssertion failed: %v", err)
+	}
+	if len(inspection.Hints) != 1 || !strings.HasPrefix(inspection.Hints[0], "iat is 10m0s in the future") {
+		t.Errorf("hints = %q, want only the clock skew", inspection.Hints)
+	}
+}
+
+func TestInspectAssertion_NotAJWT(t *testing.T) {
+	if _, err := InspectAssertion("not-a-token"); err == nil {
+		t.Error("InspectAssertion succeeded for a malformed token")
+	}
+}
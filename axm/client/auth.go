@@ -4,6 +4,7 @@ import (
 	"crypto/ecdsa"
 	"crypto/rsa"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -16,7 +17,9 @@ type AuthProvider interface {
 	ApplyAuth(req *resty.Request) error
 }
 
-// JWTAuth implements OAuth 2.0 JWT-based authentication for Apple Business Manager API
+// JWTAuth implements OAuth 2.0 JWT-based authentication for Apple Business Manager API.
+// It is safe for concurrent use; concurrent requests share one access token
+// and at most one of them refreshes it at a time.
 type JWTAuth struct {
 	keyID       string
 	issuerID    string
@@ -27,6 +30,9 @@ type JWTAuth struct {
 	tokenExpiry time.Time
 	mutex       sync.RWMutex
 	httpClient  *resty.Client
+
+	refreshHooksMu sync.RWMutex
+	refreshHooks   []TokenRefreshHook
 }
 
 // JWTAuthConfig holds configuration for JWT authentication
@@ -79,27 +85,51 @@ func (j *JWTAuth) getAccessToken() (string, error) {
 	j.mutex.RUnlock()
 
 	j.mutex.Lock()
-	defer j.mutex.Unlock()
 
 	// Double-check after acquiring write lock
 	if j.accessToken != "" && time.Now().Before(j.tokenExpiry.Add(-5*time.Minute)) {
-		return j.accessToken, nil
+		token := j.accessToken
+		j.mutex.Unlock()
+		return token, nil
 	}
 
+	info := j.refresh()
+	token := j.accessToken
+	j.mutex.Unlock()
+
+	// Hooks run after the lock is released so they may call back into j.
+	j.runRefreshHooks(info)
+
+	if info.Err != nil {
+		return "", info.Err
+	}
+	return token, nil
+}
+
+// refresh obtains a new access token and describes the attempt. The caller
+// holds the write lock.
+func (j *JWTAuth) refresh() TokenRefreshInfo {
+	start := time.Now()
+	info := TokenRefreshInfo{KeyID: j.keyID, IssuerID: j.issuerID}
+
 	clientAssertion, err := j.generateClientAssertion()
 	if err != nil {
-		return "", fmt.Errorf("failed to generate client assertion: %w", err)
+		info.Err = fmt.Errorf("failed to generate client assertion: %w", err)
+		info.Duration = time.Since(start)
+		return info
 	}
 
 	tokenResp, err := j.exchangeForAccessToken(clientAssertion)
+	info.Duration = time.Since(start)
 	if err != nil {
-		return "", fmt.Errorf("failed to exchange for access token: %w", err)
+		info.Err = fmt.Errorf("failed to exchange for access token: %w", err)
+		return info
 	}
 
 	j.accessToken = tokenResp.AccessToken
 	j.tokenExpiry = time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
-
-	return j.accessToken, nil
+	info.ExpiresAt = j.tokenExpiry
+	return info
 }
 
 // generateClientAssertion creates a JWT client assertion for OAuth 2.0 authentication
@@ -167,12 +197,32 @@ func (j *JWTAuth) exchangeForAccessToken(clientAssertion string) (*TokenResponse
 	}
 
 	if resp.StatusCode() != 200 {
-		return nil, fmt.Errorf("token request failed with status %d: %s", resp.StatusCode(), resp.String())
+		return nil, &TokenRequestError{StatusCode: resp.StatusCode(), Body: resp.String()}
 	}
 
 	return &tokenResp, nil
 }
 
+// TokenRequestError is returned when Apple's token endpoint answers with a
+// status other than 200, for example 400 with "invalid_client" when it
+// rejects the client assertion.
+type TokenRequestError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *TokenRequestError) Error() string {
+	if e.InvalidClient() {
+		return fmt.Sprintf("token request failed with status %d: %s (check the client assertion with InspectAssertion)", e.StatusCode, e.Body)
+	}
+	return fmt.Sprintf("token request failed with status %d: %s", e.StatusCode, e.Body)
+}
+
+// InvalidClient reports whether Apple rejected the client credentials.
+func (e *TokenRequestError) InvalidClient() bool {
+	return strings.Contains(e.Body, "invalid_client")
+}
+
 // ForceRefresh forces a token refresh on the next request
 func (j *JWTAuth) ForceRefresh() {
 	j.mutex.Lock()
@@ -181,6 +231,21 @@ func (j *JWTAuth) ForceRefresh() {
 	j.tokenExpiry = time.Time{}
 }
 
+// invalidate discards token if it is still the current access token and
+// reports whether it did. Requests rejected with a token that another request
+// has already replaced leave the replacement in place, so a burst of 401s
+// causes one refresh rather than one per request.
+func (j *JWTAuth) invalidate(token string) bool {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	if token == "" || token != j.accessToken {
+		return false
+	}
+	j.accessToken = ""
+	j.tokenExpiry = time.Time{}
+	return true
+}
+
 // APIKeyAuth implements simple API key authentication
 type APIKeyAuth struct {
 	apiKey string
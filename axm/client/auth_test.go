@@ -1,14 +1,20 @@
 package client
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/jarcoal/httpmock"
 	"resty.dev/v3"
 )
 
@@ -365,6 +371,66 @@ func TestJWTAuth_ConcurrentAccess(t *testing.T) {
 	}
 }
 
+func TestJWTAuth_Invalidate(t *testing.T) {
+	auth := &JWTAuth{accessToken: "token-2", tokenExpiry: time.Now().Add(time.Hour)}
+
+	if auth.invalidate("token-1") {
+		t.Error("invalidate of a replaced token = true, want false")
+	}
+	if auth.accessToken != "token-2" {
+		t.Errorf("accessToken = %q, want the replacement kept", auth.accessToken)
+	}
+	if !auth.invalidate("token-2") || auth.accessToken != "" {
+		t.Error("invalidate of the current token did not discard it")
+	}
+}
+
+func TestTransport_ConcurrentTokenRefresh(t *testing.T) {
+	privateKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	auth := NewJWTAuth(JWTAuthConfig{KeyID: "KEY123", IssuerID: "BUSINESSAPI.client", PrivateKey: privateKey})
+
+	var exchanges atomic.Int32
+	tokenEndpoint := httpmock.NewMockTransport()
+	tokenEndpoint.RegisterResponder("POST", DefaultOAuthTokenEndpoint,
+		func(req *http.Request) (*http.Response, error) {
+			n := exchanges.Add(1)
+			return httpmock.NewJsonResponse(200, TokenResponse{AccessToken: fmt.Sprintf("token-%d", n), TokenType: "Bearer", ExpiresIn: 3600})
+		})
+	auth.httpClient.SetTransport(tokenEndpoint)
+
+	api := httpmock.NewMockTransport()
+	api.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDevices",
+		func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get("Authorization") == "Bearer token-1" {
+				return httpmock.NewStringResponse(401, `{"errors":[{"status":"401","code":"NOT_AUTHORIZED"}]}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{"data":[]}`), nil
+		})
+
+	transport, err := NewTransport("KEY123", "BUSINESSAPI.client", privateKey,
+		WithAuth(auth), WithTransport(api), WithRetryCount(0))
+	if err != nil {
+		t.Fatalf("NewTransport failed: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Go(func() {
+			_, _ = transport.NewRequest(context.Background()).Get("/v1/orgDevices")
+		})
+	}
+	wg.Wait()
+	if _, err := transport.NewRequest(context.Background()).Get("/v1/orgDevices"); err != nil {
+		t.Fatalf("request after refresh failed: %v", err)
+	}
+
+	// Requests rejected with token-1 share token-2 rather than each
+	// discarding it and exchanging again.
+	if n := exchanges.Load(); n != 2 {
+		t.Errorf("token exchanges = %d, want 2", n)
+	}
+}
+
 func TestJWTAuth_GenerateClientAssertion_ClaimsStructure(t *testing.T) {
 	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
 	if err != nil {
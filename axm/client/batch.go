@@ -0,0 +1,230 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+)
+
+// BatchItem is the outcome of one input of a batch operation.
+type BatchItem[T any] struct {
+	// Key identifies the input, such as a device ID.
+	Key string
+
+	// Value is the item's result. It is the zero value when Err is set,
+	// unless the operation documents otherwise.
+	Value T
+
+	// Err is why the item failed, or nil if it succeeded.
+	Err error
+}
+
+// Success reports whether the item succeeded.
+func (i BatchItem[T]) Success() bool { return i.Err == nil }
+
+// MarshalJSON encodes the item as {"key", "value", "error"}, with Err as its
+// message.
+func (i BatchItem[T]) MarshalJSON() ([]byte, error) {
+	out := struct {
+		Key   string `json:"key"`
+		Value T      `json:"value"`
+		Error string `json:"error,omitempty"`
+	}{Key: i.Key, Value: i.Value}
+	if i.Err != nil {
+		out.Error = i.Err.Error()
+	}
+	return json.Marshal(out)
+}
+
+// BatchResult holds one BatchItem per distinct input of a batch operation, in
+// input order, so a failure is always reported against the input it belongs
+// to. Items that were never processed, for example because the operation
+// stopped early, have ErrNotProcessed.
+type BatchResult[T any] struct {
+	Items []BatchItem[T] `json:"items"`
+
+	index map[string]int
+}
+
+// ErrNotProcessed is the error of a BatchItem the operation did not reach.
+var ErrNotProcessed = errors.New("not processed")
+
+// NewBatchResult returns a result with one item per distinct key, in order.
+// Every item starts with ErrNotProcessed until Set is called for it.
+func NewBatchResult[T any](keys []string) *BatchResult[T] {
+	r := &BatchResult[T]{index: make(map[string]int, len(keys))}
+	for _, key := range keys {
+		if _, dup := r.index[key]; dup {
+			continue
+		}
+		r.index[key] = len(r.Items)
+		r.Items = append(r.Items, BatchItem[T]{Key: key, Err: ErrNotProcessed})
+	}
+	return r
+}
+
+// Keys returns the distinct keys, in input order.
+func (r *BatchResult[T]) Keys() []string {
+	keys := make([]string, len(r.Items))
+	for i, item := range r.Items {
+		keys[i] = item.Key
+	}
+	return keys
+}
+
+// Set records the outcome for key. Keys that were not passed to
+// NewBatchResult are ignored.
+func (r *BatchResult[T]) Set(key string, value T, err error) {
+	if i, ok := r.index[key]; ok {
+		r.Items[i].Value, r.Items[i].Err = value, err
+	}
+}
+
+// Get returns the item for key.
+func (r *BatchResult[T]) Get(key string) (BatchItem[T], bool) {
+	i, ok := r.index[key]
+	if !ok {
+		return BatchItem[T]{}, false
+	}
+	return r.Items[i], true
+}
+
+// Succeeded returns the items without an error.
+func (r *BatchResult[T]) Succeeded() []BatchItem[T] {
+	var out []BatchItem[T]
+	for _, item := range r.Items {
+		if item.Success() {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// Failed returns the items with an error.
+func (r *BatchResult[T]) Failed() []BatchItem[T] {
+	var out []BatchItem[T]
+	for _, item := range r.Items {
+		if !item.Success() {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// AllSucceeded reports whether no item failed.
+func (r *BatchResult[T]) AllSucceeded() bool {
+	for _, item := range r.Items {
+		if !item.Success() {
+			return false
+		}
+	}
+	return true
+}
+
+// Err returns a *MultiError holding one *ItemError per failed item, or nil if
+// every item succeeded.
+func (r *BatchResult[T]) Err() error {
+	var errs []*ItemError
+	for _, item := range r.Items {
+		if !item.Success() {
+			errs = append(errs, &ItemError{Key: item.Key, Err: item.Err})
+		}
+	}
+	return NewMultiError(errs)
+}
+
+// ItemError is the error of one input of an operation that touches many, such
+// as a single device in a bulk request.
+type ItemError struct {
+	// Key identifies the input, such as a device ID.
+	Key string
+	Err error
+}
+
+func (e *ItemError) Error() string { return e.Key + ": " + e.Err.Error() }
+
+func (e *ItemError) Unwrap() error { return e.Err }
+
+// MultiError aggregates the per-item errors of an operation that touches many
+// inputs. errors.Is and errors.As look through every item, so
+//
+//	errors.Is(err, ErrNotProcessed)
+//
+// reports whether any item was not processed, and errors.As with a
+// **ItemError finds the first failed item.
+type MultiError struct {
+	Errors []*ItemError
+}
+
+// NewMultiError returns a *MultiError holding errs, or nil if errs is empty.
+// Operations return its result directly so a nil error stays nil.
+func NewMultiError(errs []*ItemError) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return &MultiError{Errors: errs}
+}
+
+// Error lists every item's error, one per line, as errors.Join does.
+func (e *MultiError) Error() string {
+	return errors.Join(e.Unwrap()...).Error()
+}
+
+// Unwrap returns the item errors, for errors.Is and errors.As.
+func (e *MultiError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, err := range e.Errors {
+		errs[i] = err
+	}
+	return errs
+}
+
+// Keys returns the keys of the failed items, in order.
+func (e *MultiError) Keys() []string {
+	keys := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		keys[i] = err.Key
+	}
+	return keys
+}
+
+// ErrorFor returns the error for key, or nil if that item did not fail.
+func (e *MultiError) ErrorFor(key string) error {
+	for _, err := range e.Errors {
+		if err.Key == key {
+			return err.Err
+		}
+	}
+	return nil
+}
+
+// RunBatch calls fn for each distinct key, running up to concurrency calls at
+// once, and collects the outcomes. A concurrency below one runs the calls one
+// at a time.
+func RunBatch[T any](keys []string, concurrency int, fn func(key string) (T, error)) *BatchResult[T] {
+	result := NewBatchResult[T](keys)
+	concurrency = max(concurrency, 1)
+
+	next := make(chan int)
+	go func() {
+		defer close(next)
+		for i := range result.Items {
+			next <- i
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for range concurrency {
+		wg.Go(func() {
+			for i := range next {
+				// Each worker writes only the items it receives, so no lock
+				// is needed.
+				item := &result.Items[i]
+				item.Value, item.Err = fn(item.Key)
+			}
+		})
+	}
+	wg.Wait()
+
+	return result
+}
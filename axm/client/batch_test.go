@@ -0,0 +1,119 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"sync/atomic"
+	"testing"
+)
+
+func TestRunBatch(t *testing.T) {
+	errMissing := errors.New("missing")
+	var calls atomic.Int32
+
+	result := RunBatch([]string{"A", "B", "A", "C"}, 2, func(key string) (int, error) {
+		calls.Add(1)
+		if key == "B" {
+			return 0, errMissing
+		}
+		return len(key) + 10, nil
+	})
+
+	if got := calls.Load(); got != 3 {
+		t.Errorf("fn called %d times, want 3 (duplicates are skipped)", got)
+	}
+	if got, want := result.Keys(), []string{"A", "B", "C"}; !slices.Equal(got, want) {
+		t.Errorf("Keys() = %v, want %v", got, want)
+	}
+	if result.AllSucceeded() {
+		t.Error("AllSucceeded() = true, want false")
+	}
+	if got := len(result.Succeeded()); got != 2 {
+		t.Errorf("len(Succeeded()) = %d, want 2", got)
+	}
+
+	b, ok := result.Get("B")
+	if !ok || b.Success() || !errors.Is(b.Err, errMissing) {
+		t.Errorf("Get(B) = %+v, %v, want the missing error", b, ok)
+	}
+	if c, _ := result.Get("C"); !c.Success() || c.Value != 11 {
+		t.Errorf("Get(C) = %+v, want 11", c)
+	}
+	if _, ok := result.Get("D"); ok {
+		t.Error("Get(D) found an item for an unknown key")
+	}
+
+	err := result.Err()
+	if !errors.Is(err, errMissing) || err.Error() != "B: missing" {
+		t.Errorf("Err() = %v, want it to wrap the missing error with its key", err)
+	}
+	var multi *MultiError
+	if !errors.As(err, &multi) || !slices.Equal(multi.Keys(), []string{"B"}) {
+		t.Fatalf("Err() = %#v, want a *MultiError for B", err)
+	}
+	if multi.ErrorFor("B") != errMissing || multi.ErrorFor("A") != nil {
+		t.Errorf("ErrorFor returned the wrong errors")
+	}
+}
+
+func TestMultiError(t *testing.T) {
+	errA := errors.New("a failed")
+	err := NewMultiError([]*ItemError{
+		{Key: "A", Err: errA},
+		{Key: "B", Err: &ValidationError{Field: "device ID", Value: "B", Reason: "is bad"}},
+	})
+
+	if got, want := err.Error(), "A: a failed\nB: invalid device ID \"B\": is bad"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+	if !errors.Is(err, errA) || !errors.Is(err, ErrInvalidInput) {
+		t.Error("errors.Is does not see every item's error")
+	}
+	var item *ItemError
+	if !errors.As(err, &item) || item.Key != "A" {
+		t.Errorf("errors.As(*ItemError) = %v, want the first item", item)
+	}
+	var invalid *ValidationError
+	if !errors.As(err, &invalid) || invalid.Value != "B" {
+		t.Errorf("errors.As(*ValidationError) = %v, want B's error", invalid)
+	}
+	if NewMultiError(nil) != nil {
+		t.Error("NewMultiError(nil) != nil")
+	}
+}
+
+func TestBatchResult_NotProcessed(t *testing.T) {
+	result := NewBatchResult[string]([]string{"A", "B"})
+	result.Set("A", "done", nil)
+	result.Set("Z", "ignored", nil)
+
+	failed := result.Failed()
+	if len(failed) != 1 || failed[0].Key != "B" || !errors.Is(failed[0].Err, ErrNotProcessed) {
+		t.Fatalf("Failed() = %+v, want only B with ErrNotProcessed", failed)
+	}
+	if len(result.Items) != 2 {
+		t.Errorf("len(Items) = %d, want 2", len(result.Items))
+	}
+}
+
+func TestBatchResult_AllSucceeded(t *testing.T) {
+	result := RunBatch([]string{"A"}, 0, func(string) (bool, error) { return true, nil })
+	if !result.AllSucceeded() || result.Err() != nil {
+		t.Errorf("AllSucceeded() = %v, Err() = %v, want true, nil", result.AllSucceeded(), result.Err())
+	}
+}
+
+func TestBatchResult_MarshalJSON(t *testing.T) {
+	result := NewBatchResult[int]([]string{"A", "B"})
+	result.Set("A", 1, nil)
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	want := `{"items":[{"key":"A","value":1},{"key":"B","value":0,"error":"not processed"}]}`
+	if string(data) != want {
+		t.Errorf("Marshal = %s, want %s", data, want)
+	}
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"net/http"
+	"time"
+
+	"resty.dev/v3"
+)
+
+// SyncState records the Last-Modified and Date headers of a response so the
+// next pull of the same resource can be made conditional. Persist it between
+// scheduled runs; it marshals to JSON.
+type SyncState struct {
+	// LastModified is the response's Last-Modified header, if Apple sent one.
+	LastModified time.Time `json:"lastModified,omitzero"`
+
+	// Date is the response's Date header, the server time it was generated.
+	Date time.Time `json:"date,omitzero"`
+}
+
+// NewSyncState returns the SyncState of resp. Missing or malformed headers
+// leave the matching field zero.
+func NewSyncState(resp *resty.Response) SyncState {
+	var state SyncState
+	if resp == nil {
+		return state
+	}
+	header := resp.Header()
+	if t, err := http.ParseTime(header.Get("Last-Modified")); err == nil {
+		state.LastModified = t
+	}
+	if t, err := http.ParseTime(header.Get("Date")); err == nil {
+		state.Date = t
+	}
+	return state
+}
+
+// IsZero reports whether no header was recorded.
+func (s SyncState) IsZero() bool {
+	return s.LastModified.IsZero() && s.Date.IsZero()
+}
+
+// IfModifiedSince returns the If-Modified-Since header value for the next
+// request: LastModified when known, otherwise Date, or "" for a zero state.
+func (s SyncState) IfModifiedSince() string {
+	since := s.LastModified
+	if since.IsZero() {
+		since = s.Date
+	}
+	if since.IsZero() {
+		return ""
+	}
+	return since.UTC().Format(http.TimeFormat)
+}
@@ -0,0 +1,40 @@
+package client
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"resty.dev/v3"
+)
+
+func TestNewSyncState(t *testing.T) {
+	resp := &resty.Response{RawResponse: &http.Response{Header: http.Header{
+		"Last-Modified": []string{"Tue, 06 May 2025 08:00:00 GMT"},
+		"Date":          []string{"not a date"},
+	}}}
+
+	state := NewSyncState(resp)
+	if want := time.Date(2025, 5, 6, 8, 0, 0, 0, time.UTC); !state.LastModified.Equal(want) {
+		t.Errorf("LastModified = %v, want %v", state.LastModified, want)
+	}
+	if !state.Date.IsZero() {
+		t.Errorf("Date = %v, want zero for a malformed header", state.Date)
+	}
+	if got := state.IfModifiedSince(); got != "Tue, 06 May 2025 08:00:00 GMT" {
+		t.Errorf("IfModifiedSince() = %q", got)
+	}
+}
+
+func TestSyncState_Zero(t *testing.T) {
+	state := NewSyncState(nil)
+	if !state.IsZero() || state.IfModifiedSince() != "" {
+		t.Errorf("NewSyncState(nil) = %+v, want a zero state with no header", state)
+	}
+
+	local := time.FixedZone("CEST", 2*60*60)
+	state = SyncState{Date: time.Date(2025, 5, 1, 14, 0, 0, 0, local)}
+	if got := state.IfModifiedSince(); got != "Thu, 01 May 2025 12:00:00 GMT" {
+		t.Errorf("IfModifiedSince() = %q, want the Date in GMT", got)
+	}
+}
@@ -0,0 +1,181 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/ntlm"
+)
+
+// DialContextFunc dials a network connection, like net.Dialer.DialContext.
+type DialContextFunc func(ctx context.Context, network, addr string) (net.Conn, error)
+
+// newDialer returns a dialer with the HTTP client's default timeouts.
+func newDialer() *net.Dialer {
+	return &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+}
+
+// applyHostPins routes connections to the hosts pinned with WithHostIPs to
+// their addresses. It runs after every option so that it wraps whichever
+// dialer was configured.
+func (c *Transport) applyHostPins() error {
+	if len(c.hostPins) == 0 {
+		return nil
+	}
+	httpTransport, err := c.httpTransport()
+	if err != nil {
+		return err
+	}
+	dial := DialContextFunc(httpTransport.DialContext)
+	if dial == nil {
+		dial = newDialer().DialContext
+	}
+	httpTransport.DialContext = pinnedDialer(dial, c.hostPins)
+	return nil
+}
+
+// pinnedDialer returns a dial function that connects to one of pins[host]
+// instead of resolving host, trying the addresses in order. Other hosts are
+// passed to dial unchanged. TLS still verifies the certificate against host,
+// because the HTTP transport takes the server name from the request URL.
+func pinnedDialer(dial DialContextFunc, pins map[string][]string) DialContextFunc {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			return dial(ctx, network, addr)
+		}
+		ips, ok := pins[strings.ToLower(host)]
+		if !ok {
+			return dial(ctx, network, addr)
+		}
+		var errs []error
+		for _, ip := range ips {
+			conn, err := dial(ctx, network, net.JoinHostPort(ip, port))
+			if err == nil {
+				return conn, nil
+			}
+			errs = append(errs, err)
+			if ctx.Err() != nil {
+				break
+			}
+		}
+		return nil, fmt.Errorf("dial pinned %s: %w", host, errors.Join(errs...))
+	}
+}
+
+// ntlmProxyDialer returns a dial function that reaches addr through the proxy
+// that proxy selects for it, opening a CONNECT tunnel authenticated with
+// NTLM. The HTTP transport then uses the tunnel as it would a direct
+// connection. The dialer does not see the request, so proxy is asked about an
+// https URL for port 443 and an http URL otherwise; addresses it returns no
+// proxy for are dialed directly.
+func ntlmProxyDialer(proxy func(*http.Request) (*url.URL, error), dial DialContextFunc, creds ntlm.Credentials) DialContextFunc {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		scheme := "http"
+		if _, port, _ := net.SplitHostPort(addr); port == "443" {
+			scheme = "https"
+		}
+		proxyURL, err := proxy(&http.Request{URL: &url.URL{Scheme: scheme, Host: addr}})
+		if err != nil {
+			return nil, err
+		}
+		if proxyURL == nil {
+			return dial(ctx, network, addr)
+		}
+		if proxyURL.Scheme != "http" {
+			return nil, fmt.Errorf("NTLM proxy authentication requires an http:// proxy, not %s", proxyURL.Redacted())
+		}
+		proxyAddr := proxyURL.Host
+		if proxyURL.Port() == "" {
+			proxyAddr = net.JoinHostPort(proxyURL.Hostname(), "80")
+		}
+
+		conn, err := dial(ctx, network, proxyAddr)
+		if err != nil {
+			return nil, err
+		}
+		if err := ntlmConnect(ctx, conn, addr, creds); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("proxy %s: %w", proxyURL.Redacted(), err)
+		}
+		return conn, nil
+	}
+}
+
+// ntlmConnect opens a CONNECT tunnel to target over conn, a connection to the
+// proxy, answering the proxy's NTLM challenge. NTLM authenticates the
+// connection, so both CONNECT requests are sent on it.
+func ntlmConnect(ctx context.Context, conn net.Conn, target string, creds ntlm.Credentials) error {
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+		defer conn.SetDeadline(time.Time{})
+	}
+	br := bufio.NewReader(conn)
+	connect := func(token []byte) (*http.Response, error) {
+		req := &http.Request{
+			Method: http.MethodConnect,
+			URL:    &url.URL{Opaque: target},
+			Host:   target,
+			Header: http.Header{"Proxy-Authorization": {"NTLM " + base64.StdEncoding.EncodeToString(token)}},
+		}
+		if err := req.Write(conn); err != nil {
+			return nil, err
+		}
+		resp, err := http.ReadResponse(br, req)
+		if err != nil {
+			return nil, err
+		}
+		// A successful CONNECT response has no body; the tunnel follows.
+		if resp.StatusCode != http.StatusOK {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		return resp, nil
+	}
+
+	resp, err := connect(ntlm.Negotiate())
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+	if resp.StatusCode != http.StatusProxyAuthRequired {
+		return fmt.Errorf("CONNECT %s: %s", target, resp.Status)
+	}
+	var challenge []byte
+	for _, v := range resp.Header.Values("Proxy-Authenticate") {
+		if token, ok := strings.CutPrefix(v, "NTLM "); ok {
+			if challenge, err = base64.StdEncoding.DecodeString(token); err != nil {
+				return fmt.Errorf("decode NTLM challenge: %w", err)
+			}
+		}
+	}
+	if challenge == nil {
+		return fmt.Errorf("CONNECT %s: proxy sent no NTLM challenge", target)
+	}
+	if resp.Close {
+		return fmt.Errorf("CONNECT %s: proxy closed the connection during the NTLM handshake", target)
+	}
+
+	msg, err := ntlm.Authenticate(challenge, creds)
+	if err != nil {
+		return err
+	}
+	if resp, err = connect(msg); err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("CONNECT %s: %s", target, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,302 @@
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/ntlm"
+)
+
+func TestPinnedDialer(t *testing.T) {
+	var dialed []string
+	dial := func(ctx context.Context, network, addr string) (net.Conn, error) {
+		dialed = append(dialed, addr)
+		if addr == "192.0.2.1:443" {
+			return nil, errors.New("connection refused")
+		}
+		client, server := net.Pipe()
+		server.Close()
+		return client, nil
+	}
+	pinned := pinnedDialer(dial, map[string][]string{"api-business.apple.com": {"192.0.2.1", "2001:db8::1"}})
+
+	conn, err := pinned(context.Background(), "tcp", "API-Business.apple.com:443")
+	if err != nil {
+		t.Fatalf("dial pinned host failed: %v", err)
+	}
+	conn.Close()
+	if want := []string{"192.0.2.1:443", "[2001:db8::1]:443"}; !slices.Equal(dialed, want) {
+		t.Errorf("dialed %v, want %v", dialed, want)
+	}
+
+	dialed = nil
+	conn, err = pinned(context.Background(), "tcp", "example.com:443")
+	if err != nil {
+		t.Fatalf("dial other host failed: %v", err)
+	}
+	conn.Close()
+	if want := []string{"example.com:443"}; !slices.Equal(dialed, want) {
+		t.Errorf("dialed %v, want %v: other hosts are resolved as usual", dialed, want)
+	}
+}
+
+func TestPinnedDialer_AllFail(t *testing.T) {
+	dial := func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return nil, errors.New("unreachable " + addr)
+	}
+	pinned := pinnedDialer(dial, map[string][]string{"api-business.apple.com": {"192.0.2.1", "192.0.2.2"}})
+
+	_, err := pinned(context.Background(), "tcp", "api-business.apple.com:443")
+	if err == nil || err.Error() != "dial pinned api-business.apple.com: unreachable 192.0.2.1:443\nunreachable 192.0.2.2:443" {
+		t.Errorf("err = %v, want both addresses reported", err)
+	}
+}
+
+func TestWithHostIPs(t *testing.T) {
+	privateKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[]}`))
+	}))
+	defer server.Close()
+	serverURL, _ := url.Parse(server.URL)
+
+	// api.example.invalid never resolves, so the request only succeeds if the
+	// pin is used. WithDialer comes last to show the pin still applies.
+	client, err := NewTransport("key", "issuer", privateKey,
+		WithAuth(&testAuthProvider{}),
+		WithRetryCount(0),
+		WithoutProxy(),
+		WithBaseURL("http://api.example.invalid:"+serverURL.Port()),
+		WithHostIPs("api.example.invalid", "127.0.0.1"),
+		WithDialer(newDialer().DialContext),
+	)
+	if err != nil {
+		t.Fatalf("NewTransport failed: %v", err)
+	}
+
+	if _, err := client.execute(client.httpClient.R().SetContext(context.Background()), "GET", "/v1/orgDevices", nil); err != nil {
+		t.Fatalf("request to pinned host failed: %v", err)
+	}
+}
+
+func TestDialOptions_Errors(t *testing.T) {
+	privateKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	tests := []struct {
+		name   string
+		option ClientOption
+	}{
+		{"nil dialer", WithDialer(nil)},
+		{"nil resolver", WithResolver(nil)},
+		{"empty host", WithHostIPs("", "192.0.2.1")},
+		{"no IPs", WithHostIPs("api-business.apple.com")},
+		{"bad IP", WithHostIPs("api-business.apple.com", "api.internal")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewTransport("key", "issuer", privateKey, tt.option); err == nil {
+				t.Error("NewTransport succeeded, want error")
+			}
+		})
+	}
+}
+
+func TestWithResolver(t *testing.T) {
+	privateKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	client, err := NewTransport("key", "issuer", privateKey, WithResolver(&net.Resolver{PreferGo: true}))
+	if err != nil {
+		t.Fatalf("NewTransport with WithResolver failed: %v", err)
+	}
+	httpTransport, _ := client.httpTransport()
+	if httpTransport.DialContext == nil {
+		t.Error("DialContext was not set")
+	}
+}
+
+// ntlmProxy is a proxy that requires the NTLM handshake on each connection
+// and then tunnels it to backend, whatever the CONNECT target.
+type ntlmProxy struct {
+	url     string
+	accept  bool
+	backend string
+	// handshakes receives the target and AUTHENTICATE_MESSAGE of each
+	// completed handshake.
+	handshakes chan [2]string
+}
+
+func startNTLMProxy(t *testing.T, backend string, accept bool) *ntlmProxy {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	p := &ntlmProxy{url: "http://" + ln.Addr().String(), accept: accept, backend: backend, handshakes: make(chan [2]string, 10)}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go p.serve(conn)
+		}
+	}()
+	return p
+}
+
+func (p *ntlmProxy) serve(conn net.Conn) {
+	defer conn.Close()
+	br := bufio.NewReader(conn)
+	token := func(req *http.Request) []byte {
+		b, _ := base64.StdEncoding.DecodeString(strings.TrimPrefix(req.Header.Get("Proxy-Authorization"), "NTLM "))
+		return b
+	}
+
+	req, err := http.ReadRequest(br)
+	if err != nil || req.Method != http.MethodConnect || !bytes.HasPrefix(token(req), []byte("NTLMSSP\x00\x01")) {
+		io.WriteString(conn, "HTTP/1.1 400 Bad Request\r\nContent-Length: 0\r\n\r\n")
+		return
+	}
+	challenge := append([]byte("NTLMSSP\x00"), 2, 0, 0, 0, 0, 0, 0, 0, 48, 0, 0, 0)
+	challenge = binary.LittleEndian.AppendUint32(challenge, 0x00880201)
+	challenge = append(challenge, []byte("8bytes!!")...)
+	challenge = append(challenge, make([]byte, 8)...)
+	challenge = append(challenge, 0, 0, 0, 0, 48, 0, 0, 0)
+	io.WriteString(conn, "HTTP/1.1 407 Proxy Authentication Required\r\n"+
+		"Proxy-Authenticate: NTLM "+base64.StdEncoding.EncodeToString(challenge)+"\r\nContent-Length: 0\r\n\r\n")
+
+	if req, err = http.ReadRequest(br); err != nil {
+		return
+	}
+	p.handshakes <- [2]string{req.Host, string(token(req))}
+	if !p.accept {
+		io.WriteString(conn, "HTTP/1.1 407 Proxy Authentication Required\r\nContent-Length: 0\r\nConnection: close\r\n\r\n")
+		return
+	}
+	backend, err := net.Dial("tcp", p.backend)
+	if err != nil {
+		return
+	}
+	defer backend.Close()
+	io.WriteString(conn, "HTTP/1.1 200 Connection established\r\n\r\n")
+	go io.Copy(backend, br)
+	io.Copy(conn, backend)
+}
+
+func utf16Bytes(s string) []byte {
+	var b []byte
+	for _, u := range utf16.Encode([]rune(s)) {
+		b = binary.LittleEndian.AppendUint16(b, u)
+	}
+	return b
+}
+
+func TestWithProxyNTLMAuth(t *testing.T) {
+	privateKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[]}`))
+	}))
+	defer server.Close()
+	proxy := startNTLMProxy(t, strings.TrimPrefix(server.URL, "http://"), true)
+
+	// api.example.invalid never resolves, so the request only succeeds
+	// through the tunnel.
+	client, err := NewTransport("key", "issuer", privateKey,
+		WithAuth(&testAuthProvider{}),
+		WithRetryCount(0),
+		WithBaseURL("http://api.example.invalid"),
+		WithProxyNTLMAuth("CORP", "svc-abm", "s3cret"),
+		WithProxy(proxy.url),
+	)
+	if err != nil {
+		t.Fatalf("NewTransport failed: %v", err)
+	}
+
+	if _, err := client.execute(client.httpClient.R().SetContext(context.Background()), "GET", "/v1/orgDevices", nil); err != nil {
+		t.Fatalf("request through NTLM proxy failed: %v", err)
+	}
+	handshake := <-proxy.handshakes
+	if handshake[0] != "api.example.invalid:80" {
+		t.Errorf("CONNECT target = %q, want api.example.invalid:80", handshake[0])
+	}
+	msg := []byte(handshake[1])
+	if !bytes.HasPrefix(msg, []byte("NTLMSSP\x00\x03")) {
+		t.Fatalf("second CONNECT carried %q, want an AUTHENTICATE_MESSAGE", msg)
+	}
+	if !bytes.Contains(msg, utf16Bytes("CORP")) || !bytes.Contains(msg, utf16Bytes("svc-abm")) {
+		t.Error("AUTHENTICATE_MESSAGE does not name the account")
+	}
+}
+
+func TestWithProxyNTLMAuth_Rejected(t *testing.T) {
+	proxy := startNTLMProxy(t, "", false)
+	proxyURL, _ := url.Parse(proxy.url)
+	dial := ntlmProxyDialer(http.ProxyURL(proxyURL), newDialer().DialContext, ntlm.Credentials{Domain: "CORP", Username: "svc-abm", Password: "wrong"})
+
+	_, err := dial(context.Background(), "tcp", "api-business.apple.com:443")
+	if err == nil || !strings.Contains(err.Error(), "CONNECT api-business.apple.com:443: 407") {
+		t.Errorf("err = %v, want the rejected CONNECT", err)
+	}
+}
+
+func TestNTLMProxyDialer_ProxySelection(t *testing.T) {
+	var dialed []string
+	dial := func(ctx context.Context, network, addr string) (net.Conn, error) {
+		dialed = append(dialed, addr)
+		return nil, errors.New("unreachable")
+	}
+	var schemes []string
+	proxy := func(req *http.Request) (*url.URL, error) {
+		schemes = append(schemes, req.URL.Scheme)
+		if req.URL.Hostname() == "direct.example.com" {
+			return nil, nil
+		}
+		return url.Parse("https://proxy.example.com")
+	}
+	ntlmDial := ntlmProxyDialer(proxy, dial, ntlm.Credentials{Username: "user", Password: "pw"})
+
+	if _, err := ntlmDial(context.Background(), "tcp", "direct.example.com:80"); err == nil || !slices.Equal(dialed, []string{"direct.example.com:80"}) {
+		t.Errorf("dialed %v, %v, want a direct connection", dialed, err)
+	}
+	if _, err := ntlmDial(context.Background(), "tcp", "api-business.apple.com:443"); err == nil || !strings.Contains(err.Error(), "requires an http:// proxy") {
+		t.Errorf("err = %v, want https proxies rejected", err)
+	}
+	if !slices.Equal(schemes, []string{"http", "https"}) {
+		t.Errorf("proxy consulted for schemes %v, want http then https", schemes)
+	}
+}
+
+func TestWithProxyNTLMAuth_Errors(t *testing.T) {
+	privateKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	for name, opts := range map[string][]ClientOption{
+		"empty username": {WithProxyNTLMAuth("CORP", "", "pw")},
+		"no proxy":       {WithoutProxy(), WithProxyNTLMAuth("CORP", "user", "pw")},
+		"with Basic":     {WithProxy("http://proxy.example.com:8080"), WithProxyAuth("user", "pw"), WithProxyNTLMAuth("CORP", "user", "pw")},
+	} {
+		if _, err := NewTransport("key", "issuer", privateKey, opts...); err == nil {
+			t.Errorf("%s: NewTransport succeeded, want error", name)
+		}
+	}
+}
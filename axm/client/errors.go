@@ -16,6 +16,9 @@ var (
 	ErrAuthFailed      = fmt.Errorf("authentication failed")
 	ErrRateLimited     = fmt.Errorf("rate limit exceeded")
 	ErrInvalidResponse = fmt.Errorf("invalid response format")
+	ErrInvalidInput    = fmt.Errorf("invalid input")
+	ErrNotModified     = fmt.Errorf("not modified")
+	ErrClientClosed    = fmt.Errorf("client is closed")
 )
 
 // APIError represents a single error from the Apple Business Manager API
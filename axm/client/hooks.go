@@ -0,0 +1,273 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"resty.dev/v3"
+)
+
+// RequestInfo describes an outgoing request attempt passed to OnRequest hooks.
+type RequestInfo struct {
+	Method  string
+	URL     string
+	Attempt int
+}
+
+// ResponseInfo describes a received response passed to OnResponse hooks.
+type ResponseInfo struct {
+	Method     string
+	URL        string
+	StatusCode int
+	Duration   time.Duration
+	Attempt    int
+}
+
+// RequestHook is called before every request attempt, including retries.
+type RequestHook func(info RequestInfo)
+
+// ResponseHook is called after every response is received, including
+// responses that will be retried.
+type ResponseHook func(info ResponseInfo)
+
+// lifecycleHooks holds the registered request, response and mutation
+// callbacks.
+type lifecycleHooks struct {
+	mu         sync.RWMutex
+	onRequest  []RequestHook
+	onResponse []ResponseHook
+	onMutation []MutationHook
+}
+
+// OnRequest registers fn to be called before every request attempt. Hooks run
+// synchronously on the request goroutine in registration order, so they should
+// return quickly. It is safe to register hooks while requests are in flight.
+func (t *Transport) OnRequest(fn RequestHook) {
+	if fn == nil {
+		return
+	}
+	t.hooks.mu.Lock()
+	defer t.hooks.mu.Unlock()
+	t.hooks.onRequest = append(t.hooks.onRequest, fn)
+}
+
+// OnResponse registers fn to be called after every response is received. Hooks
+// run synchronously on the request goroutine in registration order, so they
+// should return quickly. It is safe to register hooks while requests are in flight.
+func (t *Transport) OnResponse(fn ResponseHook) {
+	if fn == nil {
+		return
+	}
+	t.hooks.mu.Lock()
+	defer t.hooks.mu.Unlock()
+	t.hooks.onResponse = append(t.hooks.onResponse, fn)
+}
+
+// runRequestHooks invokes the registered OnRequest hooks for req.
+func (t *Transport) runRequestHooks(c *resty.Client, req *resty.Request) {
+	t.hooks.mu.RLock()
+	hooks := t.hooks.onRequest
+	t.hooks.mu.RUnlock()
+
+	if len(hooks) == 0 {
+		return
+	}
+
+	requestURL := req.URL
+	if !strings.HasPrefix(requestURL, "http://") && !strings.HasPrefix(requestURL, "https://") {
+		requestURL = strings.TrimRight(c.BaseURL(), "/") + "/" + strings.TrimLeft(requestURL, "/")
+	}
+
+	info := RequestInfo{
+		Method:  req.Method,
+		URL:     requestURL,
+		Attempt: req.Attempt,
+	}
+	for _, hook := range hooks {
+		hook(info)
+	}
+}
+
+// runResponseHooks invokes the registered OnResponse hooks for resp.
+func (t *Transport) runResponseHooks(resp *resty.Response) {
+	t.hooks.mu.RLock()
+	hooks := t.hooks.onResponse
+	t.hooks.mu.RUnlock()
+
+	if len(hooks) == 0 {
+		return
+	}
+
+	requestURL := resp.Request.URL
+	if resp.Request.RawRequest != nil && resp.Request.RawRequest.URL != nil {
+		requestURL = resp.Request.RawRequest.URL.String()
+	}
+
+	info := ResponseInfo{
+		Method:     resp.Request.Method,
+		URL:        requestURL,
+		StatusCode: resp.StatusCode(),
+		Duration:   resp.Duration(),
+		Attempt:    resp.Request.Attempt,
+	}
+	for _, hook := range hooks {
+		hook(info)
+	}
+}
+
+// MutationInfo describes a completed create, update or delete call, passed
+// to OnMutation hooks. Unlike ResponseInfo, it is reported once per call,
+// after any retries, and carries the request and response bodies.
+type MutationInfo struct {
+	Method string
+
+	// Path is the request path, such as "/v1/orgDeviceActivities".
+	Path string
+
+	// KeyID is the API key the call was authenticated with, or empty when
+	// the transport uses another AuthProvider.
+	KeyID string
+
+	// RequestBody and ResponseBody are the JSON sent and received; either
+	// may be nil.
+	RequestBody  []byte
+	ResponseBody []byte
+
+	// StatusCode is the final response status, or 0 if no response was
+	// received.
+	StatusCode int
+
+	Attempts  int
+	StartedAt time.Time
+	Duration  time.Duration
+
+	// Err is the error the call returned, or nil if it succeeded.
+	Err error
+}
+
+// MutationHook is called after every call that is not a GET, with the
+// call's context.
+type MutationHook func(ctx context.Context, info MutationInfo)
+
+// OnMutation registers fn to be called once after every POST, PUT, PATCH or
+// DELETE call completes, successfully or not, for audit logging. Hooks run
+// synchronously on the calling goroutine in registration order, before the
+// call returns. It is safe to register hooks while requests are in flight.
+func (t *Transport) OnMutation(fn MutationHook) {
+	if fn == nil {
+		return
+	}
+	t.hooks.mu.Lock()
+	defer t.hooks.mu.Unlock()
+	t.hooks.onMutation = append(t.hooks.onMutation, fn)
+}
+
+// hasMutationHooks reports whether any OnMutation hooks are registered.
+func (t *Transport) hasMutationHooks() bool {
+	t.hooks.mu.RLock()
+	defer t.hooks.mu.RUnlock()
+	return len(t.hooks.onMutation) > 0
+}
+
+// runMutationHooks invokes the registered OnMutation hooks for a completed
+// call.
+func (t *Transport) runMutationHooks(req *resty.Request, method, path string, started time.Time, resp *resty.Response, err error) {
+	t.hooks.mu.RLock()
+	hooks := t.hooks.onMutation
+	t.hooks.mu.RUnlock()
+
+	if len(hooks) == 0 {
+		return
+	}
+
+	info := MutationInfo{
+		Method:    method,
+		Path:      path,
+		Attempts:  req.Attempt,
+		StartedAt: started,
+		Duration:  time.Since(started),
+		Err:       err,
+	}
+	if jwtAuth, ok := t.auth.(*JWTAuth); ok {
+		info.KeyID = jwtAuth.keyID
+	}
+	switch body := req.Body.(type) {
+	case nil:
+	case []byte:
+		info.RequestBody = body
+	case string:
+		info.RequestBody = []byte(body)
+	default:
+		info.RequestBody, _ = json.Marshal(body)
+	}
+	if resp != nil {
+		info.StatusCode = resp.StatusCode()
+		if b := resp.Bytes(); len(b) > 0 {
+			info.ResponseBody = b
+		}
+	}
+	for _, hook := range hooks {
+		hook(req.Context(), info)
+	}
+}
+
+// TokenRefreshInfo describes an attempt to obtain a new access token, passed
+// to OnTokenRefresh hooks.
+type TokenRefreshInfo struct {
+	KeyID    string
+	IssuerID string
+
+	// ExpiresAt is when the new token expires; zero if the refresh failed.
+	ExpiresAt time.Time
+
+	// Duration is how long the refresh took, including the token request.
+	Duration time.Duration
+
+	// Err is why the refresh failed, or nil if it succeeded. A
+	// *TokenRequestError in its chain means Apple answered and rejected the
+	// request; see TokenRequestError.InvalidClient.
+	Err error
+}
+
+// TokenRefreshHook is called after every access token refresh attempt.
+type TokenRefreshHook func(info TokenRefreshInfo)
+
+// OnTokenRefresh registers fn to be called after every attempt to obtain a new
+// access token, successful or not, so a long-running service can alert as
+// soon as Apple starts rejecting its credentials. Hooks run synchronously on
+// the goroutine whose request triggered the refresh, in registration order.
+func (j *JWTAuth) OnTokenRefresh(fn TokenRefreshHook) {
+	if fn == nil {
+		return
+	}
+	j.refreshHooksMu.Lock()
+	defer j.refreshHooksMu.Unlock()
+	j.refreshHooks = append(j.refreshHooks, fn)
+}
+
+// runRefreshHooks invokes the registered OnTokenRefresh hooks with info.
+func (j *JWTAuth) runRefreshHooks(info TokenRefreshInfo) {
+	j.refreshHooksMu.RLock()
+	hooks := j.refreshHooks
+	j.refreshHooksMu.RUnlock()
+
+	for _, hook := range hooks {
+		hook(info)
+	}
+}
+
+// OnTokenRefresh registers fn to be called after every access token refresh
+// attempt. It reports false, and registers nothing, when the transport does
+// not use JWT authentication, for example after WithAuth with another
+// provider.
+func (t *Transport) OnTokenRefresh(fn TokenRefreshHook) bool {
+	jwtAuth, ok := t.auth.(*JWTAuth)
+	if !ok {
+		return false
+	}
+	jwtAuth.OnTokenRefresh(fn)
+	return true
+}
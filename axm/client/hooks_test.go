@@ -0,0 +1,183 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestTransport_LifecycleHooks(t *testing.T) {
+	transport := setupRetryTransport(t, WithRetryCount(1), WithRetryPolicy(&countingRetryPolicy{}))
+
+	httpmock.RegisterResponder("GET", "https://api-business.apple.com/v1/test",
+		httpmock.NewStringResponder(503, `{"errors":[{"status":"503"}]}`).
+			Then(httpmock.NewStringResponder(200, `{"status":"ok"}`)))
+
+	var requests []RequestInfo
+	var responsesSeen []ResponseInfo
+	transport.OnRequest(func(info RequestInfo) { requests = append(requests, info) })
+	transport.OnResponse(func(info ResponseInfo) { responsesSeen = append(responsesSeen, info) })
+	transport.OnRequest(nil)
+
+	req := transport.httpClient.R().SetContext(context.Background())
+	if _, err := transport.execute(req, "GET", "/v1/test", nil); err != nil {
+		t.Fatalf("execute GET failed: %v", err)
+	}
+
+	if len(requests) != 2 {
+		t.Fatalf("request hooks = %d, want 2", len(requests))
+	}
+	if requests[0].Method != "GET" || requests[0].URL != "https://api-business.apple.com/v1/test" || requests[0].Attempt != 1 {
+		t.Errorf("first request info = %+v", requests[0])
+	}
+	if requests[1].Attempt != 2 {
+		t.Errorf("second request attempt = %d, want 2", requests[1].Attempt)
+	}
+
+	if len(responsesSeen) != 2 {
+		t.Fatalf("response hooks = %d, want 2", len(responsesSeen))
+	}
+	if responsesSeen[0].StatusCode != 503 || responsesSeen[0].Attempt != 1 {
+		t.Errorf("first response info = %+v", responsesSeen[0])
+	}
+	if responsesSeen[1].StatusCode != 200 || responsesSeen[1].Attempt != 2 {
+		t.Errorf("second response info = %+v", responsesSeen[1])
+	}
+	if responsesSeen[1].URL != "https://api-business.apple.com/v1/test" {
+		t.Errorf("response URL = %s", responsesSeen[1].URL)
+	}
+	if responsesSeen[1].Duration < 0 {
+		t.Errorf("response duration = %v, want >= 0", responsesSeen[1].Duration)
+	}
+}
+
+func TestTransport_OnMutation(t *testing.T) {
+	transport := setupRetryTransport(t, WithRetryCount(1), WithRetryPolicy(&countingRetryPolicy{}))
+
+	httpmock.RegisterResponder("POST", "https://api-business.apple.com/v1/things",
+		httpmock.NewStringResponder(503, `{"errors":[{"status":"503"}]}`).
+			Then(httpmock.NewStringResponder(201, `{"data":{"id":"t1"}}`).HeaderSet(http.Header{"Content-Type": {"application/json"}})))
+	httpmock.RegisterResponder("DELETE", "https://api-business.apple.com/v1/things/t2",
+		httpmock.NewStringResponder(404, `{"errors":[{"status":"404","code":"NOT_FOUND"}]}`))
+	httpmock.RegisterResponder("GET", "https://api-business.apple.com/v1/things",
+		httpmock.NewStringResponder(200, `{"data":[]}`))
+
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "operator")
+	var mutations []MutationInfo
+	transport.OnMutation(func(ctx context.Context, info MutationInfo) {
+		if ctx.Value(ctxKey{}) != "operator" {
+			t.Error("hook did not receive the call's context")
+		}
+		mutations = append(mutations, info)
+	})
+	transport.OnMutation(nil)
+
+	var result struct {
+		Data struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	req := transport.httpClient.R().SetContext(ctx).SetBody(map[string]string{"name": "thing"})
+	if _, err := transport.execute(req, "POST", "/v1/things", &result); err != nil {
+		t.Fatalf("execute POST failed: %v", err)
+	}
+	if _, err := transport.execute(transport.httpClient.R().SetContext(ctx), "GET", "/v1/things", nil); err != nil {
+		t.Fatalf("execute GET failed: %v", err)
+	}
+	if _, err := transport.execute(transport.httpClient.R().SetContext(ctx), "DELETE", "/v1/things/t2", nil); err == nil {
+		t.Fatal("execute DELETE succeeded, want 404 error")
+	}
+
+	if len(mutations) != 2 {
+		t.Fatalf("mutation hooks = %d, want 2 (GET is not a mutation)", len(mutations))
+	}
+	post := mutations[0]
+	if post.Method != "POST" || post.Path != "/v1/things" || post.StatusCode != 201 || post.Attempts != 2 || post.Err != nil {
+		t.Errorf("POST info = %+v", post)
+	}
+	if string(post.RequestBody) != `{"name":"thing"}` {
+		t.Errorf("POST request body = %s", post.RequestBody)
+	}
+	if string(post.ResponseBody) != `{"data":{"id":"t1"}}` || result.Data.ID != "t1" {
+		t.Errorf("POST response body = %s, result = %+v", post.ResponseBody, result)
+	}
+	if post.StartedAt.IsZero() || post.Duration < 0 {
+		t.Errorf("POST timing = %v, %v", post.StartedAt, post.Duration)
+	}
+
+	del := mutations[1]
+	if del.Method != "DELETE" || del.StatusCode != 404 || del.Err == nil || del.RequestBody != nil {
+		t.Errorf("DELETE info = %+v", del)
+	}
+}
+
+func TestJWTAuth_OnTokenRefresh(t *testing.T) {
+	privateKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	auth := NewJWTAuth(JWTAuthConfig{KeyID: "KEY123", IssuerID: "BUSINESSAPI.client", PrivateKey: privateKey})
+	httpmock.ActivateNonDefault(auth.httpClient.Client())
+	t.Cleanup(httpmock.DeactivateAndReset)
+
+	httpmock.RegisterResponder("POST", DefaultOAuthTokenEndpoint,
+		httpmock.NewStringResponder(400, `{"error":"invalid_client"}`).
+			Then(httpmock.NewJsonResponderOrPanic(200, TokenResponse{AccessToken: "token-1", TokenType: "Bearer", ExpiresIn: 3600})))
+
+	var refreshes []TokenRefreshInfo
+	auth.OnTokenRefresh(func(info TokenRefreshInfo) {
+		// Hooks run without the token lock held, so this must not deadlock.
+		auth.ForceRefresh()
+		refreshes = append(refreshes, info)
+	})
+	auth.OnTokenRefresh(nil)
+
+	if _, err := auth.getAccessToken(); err == nil {
+		t.Fatal("getAccessToken succeeded, want error")
+	}
+	if len(refreshes) != 1 {
+		t.Fatalf("refresh hooks = %d, want 1", len(refreshes))
+	}
+	failed := refreshes[0]
+	var tokenErr *TokenRequestError
+	if !errors.As(failed.Err, &tokenErr) || tokenErr.StatusCode != 400 || !tokenErr.InvalidClient() {
+		t.Fatalf("failed refresh Err = %v, want invalid_client TokenRequestError", failed.Err)
+	}
+	if failed.KeyID != "KEY123" || failed.IssuerID != "BUSINESSAPI.client" || !failed.ExpiresAt.IsZero() {
+		t.Errorf("failed refresh info = %+v", failed)
+	}
+
+	token, err := auth.getAccessToken()
+	if err != nil || token != "token-1" {
+		t.Fatalf("getAccessToken = %q, %v, want token-1", token, err)
+	}
+	if len(refreshes) != 2 {
+		t.Fatalf("refresh hooks = %d, want 2", len(refreshes))
+	}
+	if ok := refreshes[1]; ok.Err != nil || time.Until(ok.ExpiresAt) < 59*time.Minute {
+		t.Errorf("successful refresh info = %+v", ok)
+	}
+}
+
+func TestTransport_OnTokenRefresh(t *testing.T) {
+	transport := setupRetryTransport(t)
+	if transport.OnTokenRefresh(func(TokenRefreshInfo) {}) {
+		t.Error("OnTokenRefresh with a non-JWT provider = true, want false")
+	}
+
+	privateKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	jwtTransport, err := NewTransport("KEY123", "BUSINESSAPI.client", "unused", WithAuth(NewJWTAuth(JWTAuthConfig{
+		KeyID: "KEY123", IssuerID: "BUSINESSAPI.client", PrivateKey: privateKey,
+	})))
+	if err != nil {
+		t.Fatalf("NewTransport failed: %v", err)
+	}
+	if !jwtTransport.OnTokenRefresh(func(TokenRefreshInfo) {}) {
+		t.Error("OnTokenRefresh with JWT auth = false, want true")
+	}
+}
@@ -0,0 +1,109 @@
+package client
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// PageSizeConfig configures adaptive page sizing; see WithAdaptivePageSize.
+type PageSizeConfig struct {
+	// Min is the smallest limit the tuner will request. Defaults to 100.
+	Min int
+
+	// Max is the largest limit, and the one the first page is requested with.
+	// Defaults to 1000.
+	Max int
+
+	// TargetLatency is the page response time the tuner aims for. Pages that
+	// take longer shrink the limit in proportion; pages well under it let the
+	// limit grow again. Defaults to 5 seconds.
+	TargetLatency time.Duration
+}
+
+// growAfter is the number of consecutive fast, unthrottled pages after which
+// the page size grows.
+const growAfter = 3
+
+// pageSizer picks the limit for paginated requests from observed page
+// latencies and 429 responses. It is shared by every paginated request of a
+// Transport, so throttling seen by one sync slows the others too.
+type pageSizer struct {
+	cfg    PageSizeConfig
+	logger *zap.Logger
+
+	mu      sync.Mutex
+	size    int
+	healthy int
+}
+
+func newPageSizer(cfg PageSizeConfig) (*pageSizer, error) {
+	if cfg.Min == 0 {
+		cfg.Min = 100
+	}
+	if cfg.Max == 0 {
+		cfg.Max = 1000
+	}
+	if cfg.TargetLatency == 0 {
+		cfg.TargetLatency = 5 * time.Second
+	}
+	if cfg.Min < 1 || cfg.Max < cfg.Min {
+		return nil, fmt.Errorf("page size bounds must satisfy 1 <= min <= max, got %d and %d", cfg.Min, cfg.Max)
+	}
+	if cfg.TargetLatency < 0 {
+		return nil, fmt.Errorf("target latency cannot be negative")
+	}
+	return &pageSizer{cfg: cfg, logger: zap.NewNop(), size: cfg.Max}, nil
+}
+
+// current returns the limit to request the next page with.
+func (p *pageSizer) current() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.size
+}
+
+// throttled halves the page size after a 429 response.
+func (p *pageSizer) throttled() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.healthy = 0
+	p.resize(p.size/2, "rate limited")
+}
+
+// observe adjusts the page size for a page that took latency to arrive.
+func (p *pageSizer) observe(latency time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	target := p.cfg.TargetLatency
+	switch {
+	case latency > target:
+		p.healthy = 0
+		p.resize(int(float64(p.size)*float64(target)/float64(latency)), "slow page")
+	case latency <= target/2:
+		p.healthy++
+		if p.healthy >= growAfter {
+			p.healthy = 0
+			p.resize(p.size*3/2, "fast pages")
+		}
+	default:
+		p.healthy = 0
+	}
+}
+
+// resize clamps size to the configured bounds and applies it. The caller
+// holds p.mu.
+func (p *pageSizer) resize(size int, reason string) {
+	size = min(max(size, p.cfg.Min), p.cfg.Max)
+	if size == p.size {
+		return
+	}
+	p.logger.Info("Adjusted page size",
+		zap.Int("from", p.size),
+		zap.Int("to", size),
+		zap.String("reason", reason))
+	p.size = size
+}
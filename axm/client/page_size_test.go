@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestPageSizer(t *testing.T) {
+	p, err := newPageSizer(PageSizeConfig{Min: 200, Max: 1000, TargetLatency: time.Second})
+	if err != nil {
+		t.Fatalf("newPageSizer failed: %v", err)
+	}
+
+	steps := []struct {
+		name string
+		step func()
+		want int
+	}{
+		{"starts at max", func() {}, 1000},
+		{"429 halves", p.throttled, 500},
+		{"slow page shrinks in proportion", func() { p.observe(2 * time.Second) }, 250},
+		{"clamped to min", p.throttled, 200},
+		{"two fast pages are not enough", func() { p.observe(time.Millisecond); p.observe(time.Millisecond) }, 200},
+		{"third fast page grows", func() { p.observe(time.Millisecond) }, 300},
+		{"on-target page holds", func() { p.observe(800 * time.Millisecond) }, 300},
+		{"growth clamped to max", func() {
+			for range 30 {
+				p.observe(time.Millisecond)
+			}
+		}, 1000},
+	}
+	for _, s := range steps {
+		s.step()
+		if got := p.current(); got != s.want {
+			t.Fatalf("%s: size = %d, want %d", s.name, got, s.want)
+		}
+	}
+}
+
+func TestNewPageSizer_Defaults(t *testing.T) {
+	p, err := newPageSizer(PageSizeConfig{})
+	if err != nil {
+		t.Fatalf("newPageSizer failed: %v", err)
+	}
+	if p.cfg.Min != 100 || p.cfg.Max != 1000 || p.cfg.TargetLatency != 5*time.Second {
+		t.Errorf("defaults = %+v", p.cfg)
+	}
+
+	for _, cfg := range []PageSizeConfig{
+		{Min: 500, Max: 100},
+		{Min: -1},
+		{TargetLatency: -time.Second},
+	} {
+		if _, err := newPageSizer(cfg); err == nil {
+			t.Errorf("newPageSizer(%+v) succeeded, want error", cfg)
+		}
+	}
+}
+
+func TestTransport_GetPaginated_AdaptivePageSize(t *testing.T) {
+	transport := setupRetryTransport(t,
+		WithRetryCount(1),
+		WithRetryPolicy(&DefaultRetryPolicy{WaitTime: time.Millisecond, MaxWaitTime: time.Millisecond}),
+		WithAdaptivePageSize(PageSizeConfig{Min: 200, Max: 1000, TargetLatency: time.Minute}),
+	)
+
+	var limits []string
+	throttled := false
+	httpmock.RegisterResponder("GET", "https://api-business.apple.com/v1/items",
+		func(req *http.Request) (*http.Response, error) {
+			limits = append(limits, req.URL.Query().Get("limit"))
+			if req.URL.Query().Get("cursor") == "p2" && !throttled {
+				throttled = true
+				return httpmock.NewStringResponse(http.StatusTooManyRequests, `{"errors":[{"status":"429","code":"RATE_LIMIT_EXCEEDED"}]}`), nil
+			}
+			switch req.URL.Query().Get("cursor") {
+			case "":
+				return httpmock.NewStringResponse(200, `{"data":[{"id":"1"}],"links":{"next":"https://api-business.apple.com/v1/items?cursor=p2&limit=1000"}}`), nil
+			case "p2":
+				return httpmock.NewStringResponse(200, `{"data":[{"id":"2"}],"links":{"next":"https://api-business.apple.com/v1/items?cursor=p3&limit=1000"}}`), nil
+			default:
+				return httpmock.NewStringResponse(200, `{"data":[{"id":"3"}],"links":{}}`), nil
+			}
+		})
+
+	req := transport.httpClient.R().SetContext(context.Background()).SetQueryParam("limit", "50")
+	_, err := transport.executePaginated(req, "/v1/items", func([]byte) error { return nil })
+	if err != nil {
+		t.Fatalf("executePaginated failed: %v", err)
+	}
+
+	// The first page uses the maximum rather than the caller's limit. Page
+	// two is throttled and retried as sent; the 429 halves the limit for
+	// page three.
+	want := []string{"1000", "1000", "1000", "500"}
+	if !slices.Equal(limits, want) {
+		t.Errorf("limits = %v, want %v", limits, want)
+	}
+}
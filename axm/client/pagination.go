@@ -1,31 +1,15 @@
 package client
 
-import (
-	"encoding/json"
-	"fmt"
-	"net/url"
-)
+import "github.com/deploymenttheory/go-api-sdk-apple/internal/apiclient"
 
 // Meta contains pagination metadata matching Apple's API format.
-type Meta struct {
-	Paging *Paging `json:"paging,omitempty"`
-}
+type Meta = apiclient.Meta
 
 // Paging contains pagination information matching Apple's API format.
-type Paging struct {
-	Total      int    `json:"total,omitempty"`
-	Limit      int    `json:"limit,omitempty"`
-	NextCursor string `json:"nextCursor,omitempty"`
-}
+type Paging = apiclient.Paging
 
 // Links contains pagination navigation links matching Apple's API format.
-type Links struct {
-	Self  string `json:"self,omitempty"`
-	First string `json:"first,omitempty"`
-	Next  string `json:"next,omitempty"`
-	Prev  string `json:"prev,omitempty"`
-	Last  string `json:"last,omitempty"`
-}
+type Links = apiclient.Links
 
 // PaginationOptions represents common pagination parameters for Apple's API.
 type PaginationOptions struct {
@@ -46,35 +30,10 @@ func (opts *PaginationOptions) AddToQueryBuilder(qb *QueryBuilder) *QueryBuilder
 
 // HasNextPage checks if there is a next page available.
 func HasNextPage(links *Links) bool {
-	return links != nil && links.Next != ""
+	return apiclient.HasNextPage(links)
 }
 
 // HasPrevPage checks if there is a previous page available.
 func HasPrevPage(links *Links) bool {
-	return links != nil && links.Prev != ""
-}
-
-// extractParamsFromURL extracts query parameters from a URL string.
-func extractParamsFromURL(urlStr string) (map[string]string, error) {
-	parsedURL, err := url.Parse(urlStr)
-	if err != nil {
-		return nil, err
-	}
-
-	params := make(map[string]string)
-	for key, values := range parsedURL.Query() {
-		if len(values) > 0 {
-			params[key] = values[0]
-		}
-	}
-
-	return params, nil
-}
-
-// parseJSON is a helper that unmarshals raw JSON bytes into a target value.
-func parseJSON(data []byte, target any) error {
-	if err := json.Unmarshal(data, target); err != nil {
-		return fmt.Errorf("json unmarshal failed: %w", err)
-	}
-	return nil
+	return apiclient.HasPrevPage(links)
 }
@@ -78,91 +78,6 @@ func TestHasPrevPage(t *testing.T) {
 	}
 }
 
-func TestExtractParamsFromURL(t *testing.T) {
-	tests := []struct {
-		name    string
-		urlStr  string
-		want    map[string]string
-		wantErr bool
-	}{
-		{
-			name:   "Single parameter",
-			urlStr: "https://api-business.apple.com/v1/orgDevices?cursor=abc123",
-			want: map[string]string{
-				"cursor": "abc123",
-			},
-			wantErr: false,
-		},
-		{
-			name:   "Multiple parameters",
-			urlStr: "https://api-business.apple.com/v1/orgDevices?cursor=abc123&limit=100",
-			want: map[string]string{
-				"cursor": "abc123",
-				"limit":  "100",
-			},
-			wantErr: false,
-		},
-		{
-			name:    "No parameters",
-			urlStr:  "https://api-business.apple.com/v1/orgDevices",
-			want:    map[string]string{},
-			wantErr: false,
-		},
-		{
-			name:    "Invalid URL",
-			urlStr:  "://invalid-url",
-			want:    nil,
-			wantErr: true,
-		},
-		{
-			name:   "Parameter with special characters",
-			urlStr: "https://api-business.apple.com/v1/orgDevices?fields[orgDevices]=serialNumber,deviceModel",
-			want: map[string]string{
-				"fields[orgDevices]": "serialNumber,deviceModel",
-			},
-			wantErr: false,
-		},
-		{
-			name:   "Multiple values for same key (takes first)",
-			urlStr: "https://api-business.apple.com/v1/orgDevices?key=value1&key=value2",
-			want: map[string]string{
-				"key": "value1",
-			},
-			wantErr: false,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			got, err := extractParamsFromURL(tt.urlStr)
-
-			if (err != nil) != tt.wantErr {
-				t.Errorf("extractParamsFromURL() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
-
-			if tt.wantErr {
-				return
-			}
-
-			if len(got) != len(tt.want) {
-				t.Errorf("extractParamsFromURL() got %d params, want %d", len(got), len(tt.want))
-			}
-
-			for key, wantValue := range tt.want {
-				gotValue, ok := got[key]
-				if !ok {
-					t.Errorf("extractParamsFromURL() missing key %q", key)
-					continue
-				}
-				if gotValue != wantValue {
-					t.Errorf("extractParamsFromURL()[%q] = %v, want %v", key, gotValue, wantValue)
-				}
-			}
-		})
-	}
-}
-
 func TestPaginationOptions_AddToQueryBuilder(t *testing.T) {
 	tests := []struct {
 		name string
@@ -318,42 +233,3 @@ func TestPaginationOptions_Defaults(t *testing.T) {
 		t.Errorf("Default Cursor = %q, want empty string", opts.Cursor)
 	}
 }
-
-func TestExtractParamsFromURL_EdgeCases(t *testing.T) {
-	tests := []struct {
-		name    string
-		urlStr  string
-		wantErr bool
-	}{
-		{
-			name:    "Empty string",
-			urlStr:  "",
-			wantErr: false, // URL parsing allows empty string
-		},
-		{
-			name:    "Just query params",
-			urlStr:  "?cursor=abc&limit=10",
-			wantErr: false,
-		},
-		{
-			name:    "URL with fragment",
-			urlStr:  "https://api.example.com/resource?cursor=abc#fragment",
-			wantErr: false,
-		},
-		{
-			name:    "URL with empty query value",
-			urlStr:  "https://api.example.com/resource?cursor=",
-			wantErr: false,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			_, err := extractParamsFromURL(tt.urlStr)
-
-			if (err != nil) != tt.wantErr {
-				t.Errorf("extractParamsFromURL() error = %v, wantErr %v", err, tt.wantErr)
-			}
-		})
-	}
-}
@@ -0,0 +1,123 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// Priority orders requests waiting for a slot in the transport's request
+// queue; see WithPriorityQueue.
+type Priority int
+
+const (
+	// PriorityBackground is the default for the pages of a paginated
+	// request, such as a full inventory sync.
+	PriorityBackground Priority = iota
+
+	// PriorityInteractive is the default for single requests, such as a
+	// device lookup behind a UI. Waiting interactive requests are always
+	// sent before waiting background ones.
+	PriorityInteractive
+)
+
+// String returns "background" or "interactive".
+func (p Priority) String() string {
+	switch p {
+	case PriorityBackground:
+		return "background"
+	case PriorityInteractive:
+		return "interactive"
+	}
+	return fmt.Sprintf("Priority(%d)", int(p))
+}
+
+type priorityKey struct{}
+
+// ContextWithPriority returns a context whose requests are queued at p instead
+// of their default priority, for example to run a bulk lookup of single
+// devices in the background. It has no effect without WithPriorityQueue.
+func ContextWithPriority(ctx context.Context, p Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, p)
+}
+
+// priorityFromContext returns the priority set on ctx, or def.
+func priorityFromContext(ctx context.Context, def Priority) Priority {
+	if p, ok := ctx.Value(priorityKey{}).(Priority); ok {
+		return p
+	}
+	return def
+}
+
+// requestQueue limits the requests in flight and hands freed slots to the
+// highest-priority waiter, first come first served within a priority.
+type requestQueue struct {
+	mu      sync.Mutex
+	free    int
+	waiting [PriorityInteractive + 1][]chan struct{}
+}
+
+func newRequestQueue(maxConcurrent int) *requestQueue {
+	return &requestQueue{free: maxConcurrent}
+}
+
+// acquire waits for a slot. On success the caller must call release once the
+// request is done.
+func (q *requestQueue) acquire(ctx context.Context, p Priority) error {
+	p = min(max(p, PriorityBackground), PriorityInteractive)
+
+	q.mu.Lock()
+	if q.free > 0 {
+		q.free--
+		q.mu.Unlock()
+		return nil
+	}
+	ready := make(chan struct{})
+	q.waiting[p] = append(q.waiting[p], ready)
+	q.mu.Unlock()
+
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+	}
+
+	q.mu.Lock()
+	if i := slices.Index(q.waiting[p], ready); i >= 0 {
+		q.waiting[p] = slices.Delete(q.waiting[p], i, i+1)
+		q.mu.Unlock()
+		return ctx.Err()
+	}
+	q.mu.Unlock()
+
+	// The slot was handed over as ctx ended; pass it on.
+	q.release()
+	return ctx.Err()
+}
+
+// release frees a slot, handing it straight to the next waiter if any.
+func (q *requestQueue) release() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for p := PriorityInteractive; p >= PriorityBackground; p-- {
+		if len(q.waiting[p]) > 0 {
+			close(q.waiting[p][0])
+			q.waiting[p] = q.waiting[p][1:]
+			return
+		}
+	}
+	q.free++
+}
+
+// acquireSlot waits for a request slot at the priority set on ctx, or def, and
+// returns the function that frees it. Without a queue it returns at once.
+func (t *Transport) acquireSlot(ctx context.Context, def Priority) (func(), error) {
+	if t.queue == nil {
+		return func() {}, nil
+	}
+	if err := t.queue.acquire(ctx, priorityFromContext(ctx, def)); err != nil {
+		return nil, fmt.Errorf("waiting for request slot: %w", err)
+	}
+	return t.queue.release, nil
+}
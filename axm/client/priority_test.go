@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// waitForWaiters blocks until q has n requests waiting at p.
+func waitForWaiters(t *testing.T, q *requestQueue, p Priority, n int) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for {
+		q.mu.Lock()
+		got := len(q.waiting[p])
+		q.mu.Unlock()
+		if got == n {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%d %s requests waiting, want %d", got, p, n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// assertSlotFree fails t unless a slot of q is, or soon becomes, free.
+func assertSlotFree(t *testing.T, q *requestQueue) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := q.acquire(ctx, PriorityBackground); err != nil {
+		t.Fatalf("no slot freed: %v", err)
+	}
+	q.release()
+}
+
+func TestRequestQueue_InteractiveFirst(t *testing.T) {
+	q := newRequestQueue(1)
+	ctx := context.Background()
+	if err := q.acquire(ctx, PriorityBackground); err != nil {
+		t.Fatalf("acquire failed: %v", err)
+	}
+
+	order := make(chan string, 3)
+	start := func(name string, p Priority) {
+		go func() {
+			if err := q.acquire(ctx, p); err != nil {
+				t.Errorf("acquire %s failed: %v", name, err)
+				return
+			}
+			order <- name
+			q.release()
+		}()
+	}
+	start("page-1", PriorityBackground)
+	waitForWaiters(t, q, PriorityBackground, 1)
+	start("page-2", PriorityBackground)
+	waitForWaiters(t, q, PriorityBackground, 2)
+	start("lookup", PriorityInteractive)
+	waitForWaiters(t, q, PriorityInteractive, 1)
+
+	q.release()
+
+	want := []string{"lookup", "page-1", "page-2"}
+	for _, name := range want {
+		if got := <-order; got != name {
+			t.Fatalf("got %s, want order %v", got, want)
+		}
+	}
+	assertSlotFree(t, q)
+}
+
+func TestRequestQueue_CancelWhileWaiting(t *testing.T) {
+	q := newRequestQueue(1)
+	if err := q.acquire(context.Background(), PriorityInteractive); err != nil {
+		t.Fatalf("acquire failed: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- q.acquire(ctx, PriorityBackground) }()
+	waitForWaiters(t, q, PriorityBackground, 1)
+	cancel()
+
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Fatalf("acquire = %v, want context.Canceled", err)
+	}
+	waitForWaiters(t, q, PriorityBackground, 0)
+
+	q.release()
+	assertSlotFree(t, q)
+}
+
+func TestPriorityFromContext(t *testing.T) {
+	ctx := context.Background()
+	if got := priorityFromContext(ctx, PriorityInteractive); got != PriorityInteractive {
+		t.Errorf("default = %s, want interactive", got)
+	}
+	ctx = ContextWithPriority(ctx, PriorityBackground)
+	if got := priorityFromContext(ctx, PriorityInteractive); got != PriorityBackground {
+		t.Errorf("override = %s, want background", got)
+	}
+}
+
+func TestWithPriorityQueue(t *testing.T) {
+	transport := setupRetryTransport(t, WithPriorityQueue(2))
+	if transport.queue == nil || transport.queue.free != 2 {
+		t.Fatalf("queue = %+v, want 2 free slots", transport.queue)
+	}
+
+	if _, err := NewTransport("key", "issuer", "unused", WithAuth(&testAuthProvider{}), WithPriorityQueue(0)); err == nil {
+		t.Error("WithPriorityQueue(0) succeeded, want error")
+	}
+}
@@ -1,10 +1,13 @@
 package client
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"net/http"
 	"testing"
 
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/apiclient"
 	"github.com/jarcoal/httpmock"
 	"go.uber.org/zap"
 	"resty.dev/v3"
@@ -369,3 +372,86 @@ func TestTransport_HTTPErrorStatuses(t *testing.T) {
 		})
 	}
 }
+
+func TestTransport_GetPaginated_PagePrefetch(t *testing.T) {
+	for _, prefetch := range []bool{false, true} {
+		transport := setupTestTransport(t)
+		transport.pagePrefetch = prefetch
+
+		httpmock.RegisterResponder("GET", "https://api-business.apple.com/v1/items",
+			func(req *http.Request) (*http.Response, error) {
+				switch req.URL.Query().Get("cursor") {
+				case "":
+					return httpmock.NewStringResponse(200, `{"data":[{"id":"1"}],"links":{"next":"https://api-business.apple.com/v1/items?cursor=p2"}}`), nil
+				case "p2":
+					return httpmock.NewStringResponse(200, `{"data":[{"id":"2"}],"links":{"next":"https://api-business.apple.com/v1/items?cursor=p3"}}`), nil
+				default:
+					return httpmock.NewStringResponse(200, `{"data":[{"id":"3"}],"links":{}}`), nil
+				}
+			})
+
+		var ids []string
+		req := transport.httpClient.R().SetContext(context.Background())
+		_, err := transport.executePaginated(req, "/v1/items", func(page []byte) error {
+			var body struct {
+				Data []struct {
+					ID string `json:"id"`
+				} `json:"data"`
+			}
+			if err := apiclient.ParseJSON(page, &body); err != nil {
+				return err
+			}
+			for _, item := range body.Data {
+				ids = append(ids, item.ID)
+			}
+			return nil
+		})
+
+		if err != nil {
+			t.Fatalf("executePaginated (prefetch=%v) failed: %v", prefetch, err)
+		}
+		if len(ids) != 3 || ids[0] != "1" || ids[1] != "2" || ids[2] != "3" {
+			t.Errorf("executePaginated (prefetch=%v) ids = %v, want [1 2 3]", prefetch, ids)
+		}
+		if calls := httpmock.GetTotalCallCount(); calls != 3 {
+			t.Errorf("executePaginated (prefetch=%v) calls = %d, want 3", prefetch, calls)
+		}
+
+		httpmock.DeactivateAndReset()
+	}
+}
+
+func TestTransport_Get_GzipResponse(t *testing.T) {
+	transport := setupTestTransport(t)
+
+	var compressed bytes.Buffer
+	gz := gzip.NewWriter(&compressed)
+	if _, err := gz.Write([]byte(`{"status":"ok"}`)); err != nil {
+		t.Fatalf("failed to gzip body: %v", err)
+	}
+	gz.Close()
+
+	var acceptEncoding string
+	httpmock.RegisterResponder("GET", "https://api-business.apple.com/v1/test",
+		func(req *http.Request) (*http.Response, error) {
+			acceptEncoding = req.Header.Get("Accept-Encoding")
+			resp := httpmock.NewBytesResponse(200, compressed.Bytes())
+			resp.Header.Set("Content-Type", "application/json")
+			resp.Header.Set("Content-Encoding", "gzip")
+			return resp, nil
+		})
+
+	var result map[string]string
+	req := transport.httpClient.R().SetContext(context.Background())
+	_, err := transport.execute(req, "GET", "/v1/test", &result)
+
+	if err != nil {
+		t.Fatalf("execute GET failed: %v", err)
+	}
+	if acceptEncoding == "" || !bytes.Contains([]byte(acceptEncoding), []byte("gzip")) {
+		t.Errorf("Accept-Encoding = %q, want it to include gzip", acceptEncoding)
+	}
+	if result["status"] != "ok" {
+		t.Errorf("result['status'] = %v, want 'ok'", result["status"])
+	}
+}
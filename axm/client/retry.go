@@ -0,0 +1,76 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/apiclient"
+	"go.uber.org/zap"
+)
+
+// RetryPolicy decides whether a failed request attempt is retried and how long
+// to wait before the next attempt. Implementations must be safe for concurrent
+// use. The attempt argument is the 1-based number of the attempt that just
+// completed; resp may carry a zero status when no HTTP response was received.
+//
+// Install a policy with WithRetryPolicy. The maximum number of attempts is
+// still bounded by WithRetryCount.
+type RetryPolicy = apiclient.RetryPolicy
+
+// DefaultRetryPolicy retries transport errors, 429 Too Many Requests and 5xx
+// responses (except 501 Not Implemented) for idempotent methods only, waiting
+// with capped exponential backoff. A Retry-After header on 429/503 responses
+// takes precedence over the computed delay.
+type DefaultRetryPolicy = apiclient.DefaultRetryPolicy
+
+// RetryRule declares which HTTP statuses are retryable for requests matching
+// Method and PathPrefix. Empty Method or PathPrefix match any request. A rule
+// with no Statuses and RetryOnError unset marks matching requests as never
+// retryable.
+type RetryRule = apiclient.RetryRule
+
+// StatusMatrixRetryPolicy retries according to an explicit per-method/path
+// status matrix. Rules are evaluated in order and the first matching rule
+// decides; requests that match no rule fall back to DefaultRetryPolicy, which
+// also supplies the delay between attempts.
+type StatusMatrixRetryPolicy = apiclient.StatusMatrixRetryPolicy
+
+// NewDefaultRetryPolicy returns the default retry policy using the transport's
+// default wait times (1s initial, 10s maximum).
+func NewDefaultRetryPolicy() *DefaultRetryPolicy {
+	return apiclient.NewDefaultRetryPolicy()
+}
+
+// NewStatusMatrixRetryPolicy returns a StatusMatrixRetryPolicy with the given
+// rules and the default backoff.
+//
+// Example — never retry device activity creation, always retry GET 503:
+//
+//	client.NewStatusMatrixRetryPolicy(
+//	    client.RetryRule{Method: "POST", PathPrefix: constants.EndpointOrgDeviceActivities},
+//	    client.RetryRule{Method: "GET", Statuses: []int{503}, RetryOnError: true},
+//	)
+func NewStatusMatrixRetryPolicy(rules ...RetryRule) *StatusMatrixRetryPolicy {
+	return apiclient.NewStatusMatrixRetryPolicy(rules...)
+}
+
+// WithRetryStatusMatrix installs a StatusMatrixRetryPolicy built from rules.
+// It is shorthand for WithRetryPolicy(NewStatusMatrixRetryPolicy(rules...)).
+func WithRetryStatusMatrix(rules ...RetryRule) ClientOption {
+	return WithRetryPolicy(NewStatusMatrixRetryPolicy(rules...))
+}
+
+// WithRetryPolicy installs a custom RetryPolicy, replacing resty's built-in
+// retry conditions and backoff. Because the policy sees the request method,
+// retries are no longer restricted to idempotent methods — the policy decides.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Transport) error {
+		if policy == nil {
+			return fmt.Errorf("retry policy cannot be nil")
+		}
+
+		apiclient.InstallRetryPolicy(c.httpClient, policy)
+
+		c.logger.Info("Custom retry policy configured", zap.String("policy", fmt.Sprintf("%T", policy)))
+		return nil
+	}
+}
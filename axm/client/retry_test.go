@@ -0,0 +1,239 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"resty.dev/v3"
+)
+
+// countingRetryPolicy retries every failure with no delay and records attempts.
+type countingRetryPolicy struct {
+	attempts []int
+}
+
+func (p *countingRetryPolicy) ShouldRetry(resp *resty.Response, err error, attempt int) bool {
+	p.attempts = append(p.attempts, attempt)
+	return err != nil || resp.IsStatusFailure()
+}
+
+func (p *countingRetryPolicy) NextDelay(resp *resty.Response, err error, attempt int) time.Duration {
+	return 0
+}
+
+func setupRetryTransport(t *testing.T, options ...ClientOption) *Transport {
+	privateKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	options = append([]ClientOption{WithAuth(&testAuthProvider{})}, options...)
+	transport, err := NewTransport("key", "issuer", privateKey, options...)
+	if err != nil {
+		t.Fatalf("NewTransport failed: %v", err)
+	}
+
+	httpmock.ActivateNonDefault(transport.httpClient.Client())
+	t.Cleanup(httpmock.DeactivateAndReset)
+
+	return transport
+}
+
+func TestWithRetryPolicy_CustomPolicy(t *testing.T) {
+	policy := &countingRetryPolicy{}
+	transport := setupRetryTransport(t, WithRetryCount(2), WithRetryPolicy(policy))
+
+	httpmock.RegisterResponder("POST", "https://api-business.apple.com/v1/test",
+		httpmock.NewStringResponder(500, `{"errors":[{"status":"500","code":"INTERNAL_ERROR","detail":"boom"}]}`))
+
+	req := transport.httpClient.R().SetContext(context.Background())
+	_, err := transport.execute(req, "POST", "/v1/test", nil)
+
+	if err == nil {
+		t.Fatal("Expected error after retries, got nil")
+	}
+	if calls := httpmock.GetTotalCallCount(); calls != 3 {
+		t.Errorf("calls = %d, want 3", calls)
+	}
+	if len(policy.attempts) != 2 || policy.attempts[0] != 1 || policy.attempts[1] != 2 {
+		t.Errorf("attempts = %v, want [1 2]", policy.attempts)
+	}
+}
+
+func TestWithRetryPolicy_Nil(t *testing.T) {
+	privateKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	_, err := NewTransport("key", "issuer", privateKey, WithRetryPolicy(nil))
+
+	if err == nil {
+		t.Error("Expected error for nil retry policy, got nil")
+	}
+}
+
+func TestDefaultRetryPolicy_RetriesIdempotentServerErrors(t *testing.T) {
+	policy := &DefaultRetryPolicy{WaitTime: time.Millisecond, MaxWaitTime: time.Millisecond}
+	transport := setupRetryTransport(t, WithRetryCount(2), WithRetryPolicy(policy))
+
+	httpmock.RegisterResponder("GET", "https://api-business.apple.com/v1/test",
+		httpmock.NewStringResponder(503, `{"errors":[{"status":"503","code":"UNAVAILABLE","detail":"try later"}]}`))
+
+	req := transport.httpClient.R().SetContext(context.Background())
+	_, err := transport.execute(req, "GET", "/v1/test", nil)
+
+	if err == nil {
+		t.Fatal("Expected error after retries, got nil")
+	}
+	if calls := httpmock.GetTotalCallCount(); calls != 3 {
+		t.Errorf("calls = %d, want 3", calls)
+	}
+}
+
+func TestDefaultRetryPolicy_DoesNotRetryPost(t *testing.T) {
+	policy := &DefaultRetryPolicy{WaitTime: time.Millisecond, MaxWaitTime: time.Millisecond}
+	transport := setupRetryTransport(t, WithRetryCount(2), WithRetryPolicy(policy))
+
+	httpmock.RegisterResponder("POST", "https://api-business.apple.com/v1/orgDeviceActivities",
+		httpmock.NewStringResponder(503, `{"errors":[{"status":"503","code":"UNAVAILABLE","detail":"try later"}]}`))
+
+	req := transport.httpClient.R().SetContext(context.Background())
+	_, err := transport.execute(req, "POST", "/v1/orgDeviceActivities", nil)
+
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	if calls := httpmock.GetTotalCallCount(); calls != 1 {
+		t.Errorf("calls = %d, want 1", calls)
+	}
+}
+
+func TestDefaultRetryPolicy_ShouldRetry(t *testing.T) {
+	policy := NewDefaultRetryPolicy()
+
+	tests := []struct {
+		name       string
+		method     string
+		statusCode int
+		err        error
+		want       bool
+	}{
+		{name: "GET 200", method: "GET", statusCode: 200, want: false},
+		{name: "GET 404", method: "GET", statusCode: 404, want: false},
+		{name: "GET 429", method: "GET", statusCode: 429, want: true},
+		{name: "GET 500", method: "GET", statusCode: 500, want: true},
+		{name: "GET 501", method: "GET", statusCode: 501, want: false},
+		{name: "GET 503", method: "GET", statusCode: 503, want: true},
+		{name: "GET transport error", method: "GET", err: errors.New("connection reset"), want: true},
+		{name: "POST 503", method: "POST", statusCode: 503, want: false},
+		{name: "PATCH 503", method: "PATCH", statusCode: 503, want: false},
+		{name: "DELETE 503", method: "DELETE", statusCode: 503, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &resty.Response{
+				Request:     &resty.Request{Method: tt.method},
+				RawResponse: &http.Response{StatusCode: tt.statusCode, Header: http.Header{}},
+			}
+			if got := policy.ShouldRetry(resp, tt.err, 1); got != tt.want {
+				t.Errorf("ShouldRetry() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDefaultRetryPolicy_NextDelay(t *testing.T) {
+	policy := &DefaultRetryPolicy{WaitTime: 100 * time.Millisecond, MaxWaitTime: 500 * time.Millisecond}
+	resp := &resty.Response{
+		Request:     &resty.Request{Method: "GET"},
+		RawResponse: &http.Response{StatusCode: 500, Header: http.Header{}},
+	}
+
+	want := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 500 * time.Millisecond}
+	for i, expected := range want {
+		if got := policy.NextDelay(resp, nil, i+1); got != expected {
+			t.Errorf("NextDelay(attempt %d) = %v, want %v", i+1, got, expected)
+		}
+	}
+
+	rateLimited := &resty.Response{
+		Request:     &resty.Request{Method: "GET"},
+		RawResponse: &http.Response{StatusCode: 429, Header: http.Header{"Retry-After": []string{"7"}}},
+	}
+	if got := policy.NextDelay(rateLimited, nil, 1); got != 7*time.Second {
+		t.Errorf("NextDelay with Retry-After = %v, want 7s", got)
+	}
+}
+
+func TestWithRetryStatusMatrix(t *testing.T) {
+	transport := setupRetryTransport(t,
+		WithRetryCount(2),
+		WithRetryStatusMatrix(
+			RetryRule{Method: "POST", PathPrefix: "/v1/orgDeviceActivities"},
+			RetryRule{Method: "POST", Statuses: []int{503}},
+		),
+	)
+
+	httpmock.RegisterResponder("POST", "https://api-business.apple.com/v1/orgDeviceActivities",
+		httpmock.NewStringResponder(503, `{"errors":[{"status":"503","code":"UNAVAILABLE","detail":"try later"}]}`))
+	httpmock.RegisterResponder("POST", "https://api-business.apple.com/v1/mdmServers",
+		httpmock.NewStringResponder(503, `{"errors":[{"status":"503","code":"UNAVAILABLE","detail":"try later"}]}`))
+
+	transport.httpClient.SetRetryWaitTime(time.Millisecond).SetRetryMaxWaitTime(time.Millisecond)
+
+	req := transport.httpClient.R().SetContext(context.Background()).
+		SetRetryDelayStrategy(resty.RetryConstantDelayStrategy(time.Millisecond))
+	if _, err := transport.execute(req, "POST", "/v1/orgDeviceActivities", nil); err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	if calls := httpmock.GetCallCountInfo()["POST https://api-business.apple.com/v1/orgDeviceActivities"]; calls != 1 {
+		t.Errorf("orgDeviceActivities calls = %d, want 1", calls)
+	}
+
+	req = transport.httpClient.R().SetContext(context.Background()).
+		SetRetryDelayStrategy(resty.RetryConstantDelayStrategy(time.Millisecond))
+	if _, err := transport.execute(req, "POST", "/v1/mdmServers", nil); err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	if calls := httpmock.GetCallCountInfo()["POST https://api-business.apple.com/v1/mdmServers"]; calls != 3 {
+		t.Errorf("mdmServers calls = %d, want 3", calls)
+	}
+}
+
+func TestStatusMatrixRetryPolicy_ShouldRetry(t *testing.T) {
+	policy := NewStatusMatrixRetryPolicy(
+		RetryRule{Method: "POST", PathPrefix: "/v1/orgDeviceActivities"},
+		RetryRule{Method: "GET", Statuses: []int{503}, RetryOnError: true},
+	)
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		statusCode int
+		err        error
+		want       bool
+	}{
+		{name: "POST activities 503 never retried", method: "POST", path: "/v1/orgDeviceActivities", statusCode: 503, want: false},
+		{name: "GET 503 retried", method: "GET", path: "/v1/orgDevices", statusCode: 503, want: true},
+		{name: "GET 500 not in matrix", method: "GET", path: "/v1/orgDevices", statusCode: 500, want: false},
+		{name: "GET transport error", method: "GET", path: "/v1/orgDevices", err: errors.New("connection reset"), want: true},
+		{name: "DELETE 500 falls back to default", method: "DELETE", path: "/v1/mdmServers/1", statusCode: 500, want: true},
+		{name: "PATCH 500 falls back to default", method: "PATCH", path: "/v1/mdmServers/1", statusCode: 500, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &resty.Response{
+				Request:     &resty.Request{Method: tt.method, URL: tt.path},
+				RawResponse: &http.Response{StatusCode: tt.statusCode, Header: http.Header{}},
+			}
+			if got := policy.ShouldRetry(resp, tt.err, 1); got != tt.want {
+				t.Errorf("ShouldRetry() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// inflight counts the requests a Transport is executing so Shutdown can wait
+// for them. Its zero value is ready to use.
+type inflight struct {
+	mu      sync.Mutex
+	closing bool
+	done    chan struct{}
+	active  int
+	idle    chan struct{}
+}
+
+// doneChan returns the channel closed when the transport starts closing. The
+// caller holds mu.
+func (f *inflight) doneChan() chan struct{} {
+	if f.done == nil {
+		f.done = make(chan struct{})
+	}
+	return f.done
+}
+
+// begin registers a request, or returns ErrClientClosed once the transport is
+// closing.
+func (t *Transport) begin() error {
+	f := &t.inflight
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.closing {
+		return ErrClientClosed
+	}
+	if f.active == 0 {
+		f.idle = make(chan struct{})
+	}
+	f.active++
+	return nil
+}
+
+// end unregisters a request started with begin.
+func (t *Transport) end() {
+	f := &t.inflight
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.active--
+	if f.active == 0 {
+		close(f.idle)
+	}
+}
+
+// stopAccepting makes new requests fail with ErrClientClosed and returns a
+// channel closed once no request is in flight.
+func (t *Transport) stopAccepting() <-chan struct{} {
+	f := &t.inflight
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if !f.closing {
+		f.closing = true
+		close(f.doneChan())
+	}
+	if f.active == 0 {
+		idle := make(chan struct{})
+		close(idle)
+		return idle
+	}
+	return f.idle
+}
+
+// Done returns a channel that is closed when Close or Shutdown is called.
+// Long-running watchers select on it to stop polling when the client goes
+// away.
+func (t *Transport) Done() <-chan struct{} {
+	t.inflight.mu.Lock()
+	defer t.inflight.mu.Unlock()
+	return t.inflight.doneChan()
+}
+
+// Shutdown stops the transport accepting requests, waits for those in flight
+// to finish, including every remaining page of a paginated request, and then
+// closes it. New requests fail with ErrClientClosed as soon as Shutdown is
+// called. If ctx ends first, the transport is closed anyway and the context
+// error is returned; requests still running may then fail.
+func (t *Transport) Shutdown(ctx context.Context) error {
+	idle := t.stopAccepting()
+
+	var err error
+	select {
+	case <-idle:
+	case <-ctx.Done():
+		t.inflight.mu.Lock()
+		active := t.inflight.active
+		t.inflight.mu.Unlock()
+		err = fmt.Errorf("shutdown with %d requests in flight: %w", active, ctx.Err())
+	}
+
+	t.closeHTTPClient()
+	return err
+}
@@ -0,0 +1,91 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+// registerBlockingResponder serves GET /v1/slow once release is closed and
+// signals entered as each request arrives.
+func registerBlockingResponder(entered chan<- struct{}, release <-chan struct{}) {
+	httpmock.RegisterResponder("GET", "https://api-business.apple.com/v1/slow",
+		func(req *http.Request) (*http.Response, error) {
+			entered <- struct{}{}
+			<-release
+			return httpmock.NewStringResponse(200, `{}`), nil
+		})
+}
+
+func TestTransport_ShutdownDrainsInFlight(t *testing.T) {
+	transport := setupTestTransport(t)
+	entered, release := make(chan struct{}, 1), make(chan struct{})
+	registerBlockingResponder(entered, release)
+
+	inFlight := make(chan error, 1)
+	go func() {
+		_, err := transport.execute(transport.httpClient.R().SetContext(context.Background()), "GET", "/v1/slow", nil)
+		inFlight <- err
+	}()
+	<-entered
+
+	shutdown := make(chan error, 1)
+	go func() { shutdown <- transport.Shutdown(context.Background()) }()
+	<-transport.Done()
+
+	if _, err := transport.execute(transport.httpClient.R().SetContext(context.Background()), "GET", "/v1/slow", nil); !errors.Is(err, ErrClientClosed) {
+		t.Fatalf("request after Shutdown = %v, want ErrClientClosed", err)
+	}
+	select {
+	case err := <-shutdown:
+		t.Fatalf("Shutdown returned %v before the request in flight finished", err)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(release)
+	if err := <-inFlight; err != nil {
+		t.Errorf("request in flight failed: %v", err)
+	}
+	if err := <-shutdown; err != nil {
+		t.Errorf("Shutdown = %v, want nil", err)
+	}
+}
+
+func TestTransport_ShutdownTimeout(t *testing.T) {
+	transport := setupTestTransport(t)
+	entered, release := make(chan struct{}, 1), make(chan struct{})
+	registerBlockingResponder(entered, release)
+	defer close(release)
+
+	go transport.execute(transport.httpClient.R().SetContext(context.Background()), "GET", "/v1/slow", nil)
+	<-entered
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := transport.Shutdown(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Shutdown = %v, want DeadlineExceeded", err)
+	}
+	if got, want := err.Error(), "shutdown with 1 requests in flight: context deadline exceeded"; got != want {
+		t.Errorf("Shutdown error = %q, want %q", got, want)
+	}
+}
+
+func TestTransport_ShutdownIdle(t *testing.T) {
+	transport := setupTestTransport(t)
+
+	if err := transport.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown = %v, want nil", err)
+	}
+	if err := transport.Close(); err != nil {
+		t.Errorf("Close after Shutdown = %v, want nil", err)
+	}
+	req := transport.httpClient.R().SetContext(context.Background())
+	if _, err := transport.executePaginated(req, "/v1/items", func([]byte) error { return nil }); !errors.Is(err, ErrClientClosed) {
+		t.Errorf("paginated request after Shutdown = %v, want ErrClientClosed", err)
+	}
+}
@@ -3,10 +3,16 @@ package client
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
 	"os"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-apple/axm/constants"
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/apiclient"
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/ntlm"
 	"go.uber.org/zap"
 	"resty.dev/v3"
 )
@@ -18,6 +24,14 @@ type Transport struct {
 	auth         AuthProvider
 	errorHandler *ErrorHandler
 	baseURL      string
+	pagePrefetch bool
+	pageSizer    *pageSizer
+	queue        *requestQueue
+	inflight     inflight
+	proxyUser    *url.Userinfo
+	proxyNTLM    *ntlm.Credentials
+	hostPins     map[string][]string
+	hooks        lifecycleHooks
 }
 
 // Ensure Transport implements Client interface.
@@ -53,6 +67,8 @@ func NewTransport(keyID, issuerID string, privateKey any, options ...ClientOptio
 		Scope:      constants.ScopeBusinessAPI,
 	})
 
+	// resty advertises Accept-Encoding: gzip, deflate on every request and
+	// transparently decompresses matching responses before they are parsed.
 	httpClient := resty.New()
 	httpClient.
 		SetBaseURL(constants.DefaultBaseURL).
@@ -78,6 +94,18 @@ func NewTransport(keyID, issuerID string, privateKey any, options ...ClientOptio
 		}
 	}
 
+	if err := transport.applyHostPins(); err != nil {
+		return nil, fmt.Errorf("failed to apply client option: %w", err)
+	}
+	if err := transport.applyProxyAuth(); err != nil {
+		return nil, fmt.Errorf("failed to apply client option: %w", err)
+	}
+
+	// Options may set the logger after WithAdaptivePageSize.
+	if transport.pageSizer != nil {
+		transport.pageSizer.logger = transport.logger
+	}
+
 	httpClient.AddRequestMiddleware(func(c *resty.Client, req *resty.Request) error {
 		if err := transport.auth.ApplyAuth(req); err != nil {
 			return fmt.Errorf("auth failed: %w", err)
@@ -88,6 +116,8 @@ func NewTransport(keyID, issuerID string, privateKey any, options ...ClientOptio
 			zap.String("url", req.URL),
 		)
 
+		transport.runRequestHooks(c, req)
+
 		return nil
 	})
 
@@ -99,10 +129,15 @@ func NewTransport(keyID, issuerID string, privateKey any, options ...ClientOptio
 			zap.String("status", resp.Status()),
 		)
 
+		transport.runResponseHooks(resp)
+
+		if transport.pageSizer != nil && resp.StatusCode() == http.StatusTooManyRequests {
+			transport.pageSizer.throttled()
+		}
+
 		if resp.StatusCode() == 401 {
-			if jwtAuth, ok := transport.auth.(*JWTAuth); ok {
+			if jwtAuth, ok := transport.auth.(*JWTAuth); ok && jwtAuth.invalidate(resp.Request.AuthToken) {
 				transport.logger.Info("Received 401 response, forcing JWT token refresh")
-				jwtAuth.ForceRefresh()
 			}
 		}
 
@@ -139,16 +174,42 @@ func (t *Transport) GetHTTPClient() *resty.Client {
 	return t.httpClient
 }
 
-// Close closes the HTTP client and cleans up resources.
+// Close closes the HTTP client and cleans up resources at once, without
+// waiting for requests in flight; use Shutdown to let them finish. Later
+// requests fail with ErrClientClosed.
 func (t *Transport) Close() error {
+	t.stopAccepting()
+	t.closeHTTPClient()
+	return nil
+}
+
+// closeHTTPClient closes the underlying HTTP client.
+func (t *Transport) closeHTTPClient() {
 	if t.httpClient != nil {
 		t.httpClient.Close()
 	}
-	return nil
 }
 
 // execute implements requestExecutor — handles all HTTP method routing and error processing.
-func (t *Transport) execute(req *resty.Request, method, path string, result any) (*resty.Response, error) {
+func (t *Transport) execute(req *resty.Request, method, path string, result any) (resp *resty.Response, err error) {
+	if err := t.begin(); err != nil {
+		return nil, err
+	}
+	defer t.end()
+
+	release, err := t.acquireSlot(req.Context(), PriorityInteractive)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	if method != "GET" && t.hasMutationHooks() {
+		// Keep the response body readable for the hooks after it is parsed.
+		req.SetResponseBodyUnlimitedReads(true)
+		started := time.Now()
+		defer func() { t.runMutationHooks(req, method, path, started, resp, err) }()
+	}
+
 	var apiErr ErrorResponse
 	req.SetResultError(&apiErr)
 
@@ -156,9 +217,6 @@ func (t *Transport) execute(req *resty.Request, method, path string, result any)
 		req.SetResult(result)
 	}
 
-	var resp *resty.Response
-	var err error
-
 	switch method {
 	case "GET":
 		resp, err = req.Get(path)
@@ -178,6 +236,10 @@ func (t *Transport) execute(req *resty.Request, method, path string, result any)
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
 
+	if resp.StatusCode() == http.StatusNotModified {
+		return resp, ErrNotModified
+	}
+
 	if resp.IsStatusFailure() {
 		return resp, t.errorHandler.HandleError(resp, &apiErr)
 	}
@@ -195,7 +257,14 @@ func (t *Transport) executeGetBytes(req *resty.Request, path string) (*resty.Res
 }
 
 // executePaginated implements requestExecutor — cursor-based pagination loop.
+// When page prefetching is enabled the next page is requested concurrently
+// while mergePage processes the current one; pages are still merged in order.
 func (t *Transport) executePaginated(req *resty.Request, path string, mergePage func([]byte) error) (*resty.Response, error) {
+	if err := t.begin(); err != nil {
+		return nil, err
+	}
+	defer t.end()
+
 	// Capture initial query params from the request
 	currentParams := make(map[string]string)
 	for k, v := range req.QueryParams {
@@ -204,65 +273,126 @@ func (t *Transport) executePaginated(req *resty.Request, path string, mergePage
 		}
 	}
 
-	var lastResp *resty.Response
+	// A prefetch still running when a page fails is cancelled and waited
+	// for, so no request outlives the call.
+	var prefetches sync.WaitGroup
+	defer prefetches.Wait()
 
-	for {
-		// Build a fresh request for each page (reuse auth, headers)
-		pageReq := t.httpClient.R().SetContext(req.Context())
-		for k, v := range req.Header {
-			if len(v) > 0 {
-				pageReq.SetHeader(k, v[0])
-			}
-		}
-		for k, v := range currentParams {
-			if v != "" {
-				pageReq.SetQueryParam(k, v)
-			}
-		}
+	ctx, cancel := context.WithCancel(req.Context())
+	defer cancel()
 
-		var apiErr ErrorResponse
-		pageReq.SetResultError(&apiErr)
+	var lastResp *resty.Response
 
-		resp, err := pageReq.Get(path)
+	resp, err := t.fetchPage(ctx, req, path, currentParams)
+
+	for {
 		if err != nil {
-			return resp, fmt.Errorf("request failed: %w", err)
-		}
-		if resp.IsStatusFailure() {
-			return resp, t.errorHandler.HandleError(resp, &apiErr)
+			return resp, err
 		}
 
 		lastResp = resp
 		rawResponse := resp.Bytes()
 
-		if err := mergePage(rawResponse); err != nil {
-			return resp, err
-		}
-
 		// Extract pagination info to check for next page
 		var pageInfo struct {
 			Links *Links `json:"links,omitempty"`
 		}
-		if err := parseJSON(rawResponse, &pageInfo); err != nil {
+		if err := apiclient.ParseJSON(rawResponse, &pageInfo); err != nil {
 			return resp, fmt.Errorf("failed to parse pagination info: %w", err)
 		}
 
-		if !HasNextPage(pageInfo.Links) {
-			break
+		hasNext := HasNextPage(pageInfo.Links)
+		if hasNext {
+			nextParams, err := apiclient.ParamsFromURL(pageInfo.Links.Next)
+			if err != nil {
+				return resp, fmt.Errorf("failed to parse next URL: %w", err)
+			}
+
+			for k, v := range nextParams {
+				currentParams[k] = v
+			}
 		}
 
-		nextParams, err := extractParamsFromURL(pageInfo.Links.Next)
-		if err != nil {
-			return resp, fmt.Errorf("failed to parse next URL: %w", err)
+		var prefetched chan pageResult
+		if hasNext && t.pagePrefetch {
+			prefetched = make(chan pageResult, 1)
+			params := make(map[string]string, len(currentParams))
+			for k, v := range currentParams {
+				params[k] = v
+			}
+			prefetches.Go(func() {
+				resp, err := t.fetchPage(ctx, req, path, params)
+				prefetched <- pageResult{resp: resp, err: err}
+			})
+		}
+
+		if err := mergePage(rawResponse); err != nil {
+			return resp, err
+		}
+
+		if !hasNext {
+			break
 		}
 
-		for k, v := range nextParams {
-			currentParams[k] = v
+		if prefetched != nil {
+			result := <-prefetched
+			resp, err = result.resp, result.err
+		} else {
+			resp, err = t.fetchPage(ctx, req, path, currentParams)
 		}
 	}
 
 	return lastResp, nil
 }
 
+// pageResult carries the outcome of a prefetched page request.
+type pageResult struct {
+	resp *resty.Response
+	err  error
+}
+
+// fetchPage requests a single page of a paginated endpoint, reusing the
+// headers of the original request with the given query params.
+func (t *Transport) fetchPage(ctx context.Context, req *resty.Request, path string, params map[string]string) (*resty.Response, error) {
+	release, err := t.acquireSlot(ctx, PriorityBackground)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	// Build a fresh request for each page (reuse auth, headers)
+	pageReq := t.httpClient.R().SetContext(ctx)
+	for k, v := range req.Header {
+		if len(v) > 0 {
+			pageReq.SetHeader(k, v[0])
+		}
+	}
+	for k, v := range params {
+		if v != "" {
+			pageReq.SetQueryParam(k, v)
+		}
+	}
+	if t.pageSizer != nil {
+		pageReq.SetQueryParam("limit", strconv.Itoa(t.pageSizer.current()))
+	}
+
+	var apiErr ErrorResponse
+	pageReq.SetResultError(&apiErr)
+
+	resp, err := pageReq.Get(path)
+	if err != nil {
+		return resp, fmt.Errorf("request failed: %w", err)
+	}
+	if resp.IsStatusFailure() {
+		return resp, t.errorHandler.HandleError(resp, &apiErr)
+	}
+	if t.pageSizer != nil {
+		t.pageSizer.observe(resp.Duration())
+	}
+
+	return resp, nil
+}
+
 // NewTransportFromEnv creates a transport using environment variables.
 // Requires APPLE_KEY_ID and APPLE_ISSUER_ID plus exactly one of:
 //   - APPLE_PRIVATE_KEY_PEM  — PEM-encoded private key supplied inline
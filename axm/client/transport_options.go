@@ -1,11 +1,17 @@
 package client
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
+	"net"
 	"net/http"
+	"net/url"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/ntlm"
 	"go.uber.org/zap"
 )
 
@@ -19,6 +25,7 @@ func WithBaseURL(urlStr string) ClientOption {
 			return fmt.Errorf("base URL cannot be empty")
 		}
 		c.baseURL = urlStr
+		c.httpClient.SetBaseURL(urlStr)
 		c.logger.Info("Base URL configured", zap.String("base_url", urlStr))
 		return nil
 	}
@@ -130,6 +137,54 @@ func WithDebug() ClientOption {
 	}
 }
 
+// WithPagePrefetch enables prefetching of the next page while the current page
+// of a paginated response is being processed. At most one page is fetched ahead
+// and pages are always delivered in order.
+func WithPagePrefetch() ClientOption {
+	return func(c *Transport) error {
+		c.pagePrefetch = true
+		c.logger.Info("Page prefetching enabled")
+		return nil
+	}
+}
+
+// WithAdaptivePageSize lets the transport choose the limit of paginated
+// requests instead of the caller. The first page is requested with cfg.Max;
+// every 429 response halves the limit, slow pages shrink it in proportion to
+// how far they miss cfg.TargetLatency, and runs of fast pages grow it again.
+// The chosen limit replaces any limit set in the request options.
+func WithAdaptivePageSize(cfg PageSizeConfig) ClientOption {
+	return func(c *Transport) error {
+		sizer, err := newPageSizer(cfg)
+		if err != nil {
+			return err
+		}
+		c.pageSizer = sizer
+		c.logger.Info("Adaptive page size enabled",
+			zap.Int("min", sizer.cfg.Min),
+			zap.Int("max", sizer.cfg.Max),
+			zap.Duration("target_latency", sizer.cfg.TargetLatency))
+		return nil
+	}
+}
+
+// WithPriorityQueue limits the transport to maxConcurrent requests in flight
+// and queues the rest by Priority, so single interactive calls are not stuck
+// behind the pages of a long background sync. Pages of paginated requests
+// default to PriorityBackground and other requests to PriorityInteractive;
+// ContextWithPriority overrides either. A request holds its slot through its
+// retries.
+func WithPriorityQueue(maxConcurrent int) ClientOption {
+	return func(c *Transport) error {
+		if maxConcurrent < 1 {
+			return fmt.Errorf("max concurrent requests must be at least 1")
+		}
+		c.queue = newRequestQueue(maxConcurrent)
+		c.logger.Info("Priority request queue enabled", zap.Int("max_concurrent", maxConcurrent))
+		return nil
+	}
+}
+
 // WithErrorHandler sets a custom error handler.
 func WithErrorHandler(handler *ErrorHandler) ClientOption {
 	return func(c *Transport) error {
@@ -161,15 +216,153 @@ func WithGlobalHeaders(headers map[string]string) ClientOption {
 	}
 }
 
-// WithProxy sets an HTTP proxy for all requests.
+// WithDialer sets the function that opens network connections, for example
+// one that routes through a VPN interface or a custom service mesh. Host
+// pins from WithHostIPs still apply on top of it.
+func WithDialer(dial DialContextFunc) ClientOption {
+	return func(c *Transport) error {
+		if dial == nil {
+			return fmt.Errorf("dialer cannot be nil")
+		}
+		httpTransport, err := c.httpTransport()
+		if err != nil {
+			return err
+		}
+		httpTransport.DialContext = dial
+		c.logger.Info("Custom dialer configured")
+		return nil
+	}
+}
+
+// WithResolver resolves host names with resolver instead of the system
+// resolver, for split-horizon DNS where the system resolver cannot see
+// Apple's public records. Set resolver.PreferGo and resolver.Dial to query a
+// specific DNS server. It replaces any dialer set with WithDialer.
+func WithResolver(resolver *net.Resolver) ClientOption {
+	return func(c *Transport) error {
+		if resolver == nil {
+			return fmt.Errorf("resolver cannot be nil")
+		}
+		httpTransport, err := c.httpTransport()
+		if err != nil {
+			return err
+		}
+		dialer := newDialer()
+		dialer.Resolver = resolver
+		httpTransport.DialContext = dialer.DialContext
+		c.logger.Info("Custom DNS resolver configured")
+		return nil
+	}
+}
+
+// WithHostIPs pins host, such as "api-business.apple.com", to the given IP
+// addresses so it is never looked up in DNS. Connections try the addresses in
+// order. TLS still verifies the server certificate against host. Behind a
+// proxy, connections go to the proxy, so pin the proxy's host instead. A later
+// call for the same host replaces its addresses.
+func WithHostIPs(host string, ips ...string) ClientOption {
+	return func(c *Transport) error {
+		if host == "" {
+			return fmt.Errorf("host cannot be empty")
+		}
+		if len(ips) == 0 {
+			return fmt.Errorf("at least one IP address is required for %s", host)
+		}
+		for _, ip := range ips {
+			if net.ParseIP(ip) == nil {
+				return fmt.Errorf("invalid IP address %q for %s", ip, host)
+			}
+		}
+		if c.hostPins == nil {
+			c.hostPins = make(map[string][]string)
+		}
+		c.hostPins[strings.ToLower(host)] = slices.Clone(ips)
+		c.logger.Info("Host IPs pinned", zap.String("host", host), zap.Strings("ips", ips))
+		return nil
+	}
+}
+
+// WithProxy sets an HTTP proxy for all requests, overriding the
+// HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables that are used
+// otherwise. Credentials may be given in the URL, with WithProxyAuth or with
+// WithProxyNTLMAuth.
 // Example: "http://proxy.company.com:8080" or "socks5://127.0.0.1:1080"
 func WithProxy(proxyURL string) ClientOption {
 	return func(c *Transport) error {
 		if proxyURL == "" {
 			return fmt.Errorf("proxy URL cannot be empty")
 		}
+		u, err := url.Parse(proxyURL)
+		if err != nil {
+			return fmt.Errorf("invalid proxy URL: %w", err)
+		}
 		c.httpClient.SetProxy(proxyURL)
-		c.logger.Info("Proxy configured", zap.String("proxy", proxyURL))
+		c.logger.Info("Proxy configured", zap.String("proxy", u.Redacted()))
+		return nil
+	}
+}
+
+// WithoutProxy connects directly, ignoring the proxy environment variables.
+func WithoutProxy() ClientOption {
+	return func(c *Transport) error {
+		if _, err := c.httpTransport(); err != nil {
+			return err
+		}
+		c.httpClient.RemoveProxy()
+		c.logger.Info("Proxy disabled")
+		return nil
+	}
+}
+
+// WithProxyAuth sets Basic credentials for the proxy, whether it comes from
+// WithProxy or from the environment, replacing any in the proxy URL. They are
+// sent in the Proxy-Authorization header of plain requests and of the CONNECT
+// request that tunnels HTTPS. Use WithProxyNTLMAuth for NTLM proxies.
+func WithProxyAuth(username, password string) ClientOption {
+	return func(c *Transport) error {
+		if username == "" {
+			return fmt.Errorf("proxy username cannot be empty")
+		}
+		c.proxyUser = url.UserPassword(username, password)
+		c.logger.Info("Proxy authentication configured", zap.String("username", username))
+		return nil
+	}
+}
+
+// WithProxyNTLMAuth authenticates to the proxy, whether it comes from
+// WithProxy or from the environment, with NTLM (NTLMv2) as a Windows account.
+// NTLM authenticates a connection rather than a request, so every connection
+// is opened as a CONNECT tunnel through the proxy, plain HTTP included, and
+// the handshake is completed on it before it is used. The proxy must be an
+// http:// proxy, and WithProxyConnectHeader does not apply. Proxies from the
+// environment are chosen as for an https URL for port 443 and an http URL
+// otherwise.
+func WithProxyNTLMAuth(domain, username, password string) ClientOption {
+	return func(c *Transport) error {
+		if username == "" {
+			return fmt.Errorf("proxy username cannot be empty")
+		}
+		c.proxyNTLM = &ntlm.Credentials{Domain: domain, Username: username, Password: password}
+		c.logger.Info("Proxy NTLM authentication configured", zap.String("domain", domain), zap.String("username", username))
+		return nil
+	}
+}
+
+// WithProxyConnectHeader sets a function that returns extra headers for the
+// CONNECT request sent to the proxy for each new HTTPS connection, for proxies
+// that take a single-request token such as Negotiate (Kerberos). It is not
+// called for plain HTTP requests.
+func WithProxyConnectHeader(fn func(ctx context.Context, proxyURL *url.URL, target string) (http.Header, error)) ClientOption {
+	return func(c *Transport) error {
+		if fn == nil {
+			return fmt.Errorf("proxy connect header function cannot be nil")
+		}
+		httpTransport, err := c.httpTransport()
+		if err != nil {
+			return err
+		}
+		httpTransport.GetProxyConnectHeader = fn
+		c.logger.Info("Proxy connect header configured")
 		return nil
 	}
 }
@@ -236,6 +429,152 @@ func WithTransport(transport http.RoundTripper) ClientOption {
 	}
 }
 
+// WithMaxIdleConns sets the maximum number of idle (keep-alive) connections
+// kept across all hosts. Zero means no limit.
+func WithMaxIdleConns(maxIdleConns int) ClientOption {
+	return func(c *Transport) error {
+		if maxIdleConns < 0 {
+			return fmt.Errorf("max idle connections cannot be negative")
+		}
+		httpTransport, err := c.httpTransport()
+		if err != nil {
+			return err
+		}
+		httpTransport.MaxIdleConns = maxIdleConns
+		c.logger.Info("Max idle connections configured", zap.Int("max_idle_conns", maxIdleConns))
+		return nil
+	}
+}
+
+// WithMaxIdleConnsPerHost sets the maximum number of idle (keep-alive)
+// connections kept per host. Raise this above Go's default of 2 when running
+// concurrent bulk operations to avoid connection churn.
+func WithMaxIdleConnsPerHost(maxIdleConnsPerHost int) ClientOption {
+	return func(c *Transport) error {
+		if maxIdleConnsPerHost < 0 {
+			return fmt.Errorf("max idle connections per host cannot be negative")
+		}
+		httpTransport, err := c.httpTransport()
+		if err != nil {
+			return err
+		}
+		httpTransport.MaxIdleConnsPerHost = maxIdleConnsPerHost
+		c.logger.Info("Max idle connections per host configured", zap.Int("max_idle_conns_per_host", maxIdleConnsPerHost))
+		return nil
+	}
+}
+
+// WithMaxConnsPerHost limits the total number of connections per host,
+// including connections in the dialing, active, and idle states. Zero means no limit.
+func WithMaxConnsPerHost(maxConnsPerHost int) ClientOption {
+	return func(c *Transport) error {
+		if maxConnsPerHost < 0 {
+			return fmt.Errorf("max connections per host cannot be negative")
+		}
+		httpTransport, err := c.httpTransport()
+		if err != nil {
+			return err
+		}
+		httpTransport.MaxConnsPerHost = maxConnsPerHost
+		c.logger.Info("Max connections per host configured", zap.Int("max_conns_per_host", maxConnsPerHost))
+		return nil
+	}
+}
+
+// WithIdleConnTimeout sets how long an idle (keep-alive) connection remains
+// open before closing itself. Zero means no limit.
+func WithIdleConnTimeout(timeout time.Duration) ClientOption {
+	return func(c *Transport) error {
+		if timeout < 0 {
+			return fmt.Errorf("idle connection timeout cannot be negative")
+		}
+		httpTransport, err := c.httpTransport()
+		if err != nil {
+			return err
+		}
+		httpTransport.IdleConnTimeout = timeout
+		c.logger.Info("Idle connection timeout configured", zap.Duration("idle_conn_timeout", timeout))
+		return nil
+	}
+}
+
+// WithHTTP2 enables or disables HTTP/2 negotiation. HTTP/2 is attempted by
+// default; disabling it forces HTTP/1.1 connections.
+func WithHTTP2(enabled bool) ClientOption {
+	return func(c *Transport) error {
+		httpTransport, err := c.httpTransport()
+		if err != nil {
+			return err
+		}
+		httpTransport.ForceAttemptHTTP2 = enabled
+		if !enabled {
+			// A non-nil, empty TLSNextProto map disables HTTP/2 upgrades.
+			httpTransport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+		} else {
+			httpTransport.TLSNextProto = nil
+		}
+		c.logger.Info("HTTP/2 configured", zap.Bool("enabled", enabled))
+		return nil
+	}
+}
+
+// httpTransport returns the underlying *http.Transport so connection settings
+// can be tuned. It fails when a custom http.RoundTripper has been configured
+// via WithTransport.
+func (c *Transport) httpTransport() (*http.Transport, error) {
+	httpTransport, err := c.httpClient.HTTPTransport()
+	if err != nil {
+		return nil, fmt.Errorf("connection settings require an *http.Transport: %w", err)
+	}
+	return httpTransport, nil
+}
+
+// applyProxyAuth adds the WithProxyAuth or WithProxyNTLMAuth credentials to
+// the proxy chosen for each request. It runs after every option, and after
+// applyHostPins, so that it wraps whichever proxy and dialer were configured
+// last.
+func (c *Transport) applyProxyAuth() error {
+	if c.proxyUser == nil && c.proxyNTLM == nil {
+		return nil
+	}
+	if c.proxyUser != nil && c.proxyNTLM != nil {
+		return fmt.Errorf("WithProxyAuth and WithProxyNTLMAuth cannot be combined")
+	}
+	httpTransport, err := c.httpTransport()
+	if err != nil {
+		return err
+	}
+	if httpTransport.Proxy == nil {
+		return fmt.Errorf("proxy credentials require a proxy")
+	}
+	if c.proxyUser != nil {
+		httpTransport.Proxy = withProxyUser(httpTransport.Proxy, c.proxyUser)
+		return nil
+	}
+
+	dial := DialContextFunc(httpTransport.DialContext)
+	if dial == nil {
+		dial = newDialer().DialContext
+	}
+	httpTransport.DialContext = ntlmProxyDialer(httpTransport.Proxy, dial, *c.proxyNTLM)
+	httpTransport.Proxy = nil
+	return nil
+}
+
+// withProxyUser returns a proxy function that sets user on the URLs next
+// returns.
+func withProxyUser(next func(*http.Request) (*url.URL, error), user *url.Userinfo) func(*http.Request) (*url.URL, error) {
+	return func(req *http.Request) (*url.URL, error) {
+		proxyURL, err := next(req)
+		if err != nil || proxyURL == nil {
+			return proxyURL, err
+		}
+		withUser := *proxyURL
+		withUser.User = user
+		return &withUser, nil
+	}
+}
+
 // WithInsecureSkipVerify disables TLS certificate verification (USE WITH CAUTION).
 // This should ONLY be used for testing/development with self-signed certificates.
 func WithInsecureSkipVerify() ClientOption {
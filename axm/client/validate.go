@@ -0,0 +1,88 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxResourceIDLength is the longest resource ID ValidateID accepts.
+const MaxResourceIDLength = 128
+
+// ValidationError describes a request argument rejected before any network
+// call. It matches ErrInvalidInput with errors.Is.
+type ValidationError struct {
+	// Field names the argument, e.g. "device ID".
+	Field string
+
+	// Value is the rejected value.
+	Value string
+
+	// Reason explains what is wrong with Value; empty when Value is missing.
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Reason == "" {
+		return fmt.Sprintf("%s is required", e.Field)
+	}
+	return fmt.Sprintf("invalid %s %q: %s", e.Field, e.Value, e.Reason)
+}
+
+// Is reports whether target is ErrInvalidInput.
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrInvalidInput
+}
+
+// ValidateID checks a resource ID (a device, MDM server or activity ID)
+// before it is placed in a request path or body. IDs must be non-empty, at
+// most MaxResourceIDLength characters, and consist of ASCII letters, digits,
+// '-', '_' and '.', which covers device serial numbers and Apple's hex and
+// UUID identifiers. IDs may not start with '.', so "." and ".." cannot
+// address a parent resource once placed in a path. field names the ID in the
+// error, e.g. "device ID".
+func ValidateID(field, id string) error {
+	if id == "" {
+		return &ValidationError{Field: field}
+	}
+	if strings.TrimSpace(id) != id {
+		return &ValidationError{Field: field, Value: id, Reason: "has leading or trailing whitespace"}
+	}
+	if n := utf8.RuneCountInString(id); n > MaxResourceIDLength {
+		return &ValidationError{Field: field, Value: id,
+			Reason: fmt.Sprintf("is %d characters long, the maximum is %d", n, MaxResourceIDLength)}
+	}
+	if strings.HasPrefix(id, ".") {
+		return &ValidationError{Field: field, Value: id, Reason: "starts with '.'"}
+	}
+	for _, r := range id {
+		if !isIDRune(r) {
+			return &ValidationError{Field: field, Value: id, Reason: fmt.Sprintf("contains illegal character %q", r)}
+		}
+	}
+	return nil
+}
+
+// ValidateIDs checks each ID with ValidateID, reporting the position of the
+// first invalid one. An empty list is rejected.
+func ValidateIDs(field string, ids []string) error {
+	if len(ids) == 0 {
+		return &ValidationError{Field: "at least one " + field}
+	}
+	for i, id := range ids {
+		if err := ValidateID(field, id); err != nil {
+			return fmt.Errorf("%w (item %d of %d)", err, i+1, len(ids))
+		}
+	}
+	return nil
+}
+
+func isIDRune(r rune) bool {
+	switch {
+	case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		return true
+	case r == '-', r == '_', r == '.':
+		return true
+	}
+	return false
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateID(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		wantErr string
+	}{
+		{name: "serial number", id: "XABC123X0ABC123X0"},
+		{name: "hex server ID", id: "1F97349736CF4614A94F624E705841AD"},
+		{name: "UUID", id: "b1481656-b267-480d-b284-a809eed8b041"},
+		{name: "empty", id: "", wantErr: "device ID is required"},
+		{name: "whitespace", id: " C02XYZ", wantErr: "leading or trailing whitespace"},
+		{name: "path separator", id: "C02/XYZ", wantErr: `illegal character '/'`},
+		{name: "dot segment", id: ".", wantErr: "starts with '.'"},
+		{name: "parent segment", id: "..", wantErr: "starts with '.'"},
+		{name: "leading dot", id: ".C02XYZ", wantErr: "starts with '.'"},
+		{name: "inner dot", id: "com.example.C02XYZ"},
+		{name: "query", id: "C02XYZ?x=1", wantErr: `illegal character '?'`},
+		{name: "non-ASCII", id: "C02XYŽ", wantErr: `illegal character 'Ž'`},
+		{name: "too long", id: strings.Repeat("A", MaxResourceIDLength+1), wantErr: "the maximum is 128"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateID("device ID", tt.id)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("ValidateID(%q) = %v, want nil", tt.id, err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("ValidateID(%q) = %v, want error containing %q", tt.id, err, tt.wantErr)
+			}
+			if !errors.Is(err, ErrInvalidInput) {
+				t.Errorf("ValidateID(%q) error does not match ErrInvalidInput", tt.id)
+			}
+		})
+	}
+}
+
+func TestValidateIDs(t *testing.T) {
+	if err := ValidateIDs("device ID", []string{"A1", "B2"}); err != nil {
+		t.Fatalf("ValidateIDs valid list = %v", err)
+	}
+
+	err := ValidateIDs("device ID", nil)
+	if err == nil || err.Error() != "at least one device ID is required" {
+		t.Errorf("ValidateIDs(nil) = %v", err)
+	}
+
+	err = ValidateIDs("device ID", []string{"A1", "B 2", "C3"})
+	if err == nil || !strings.Contains(err.Error(), `invalid device ID "B 2"`) || !strings.Contains(err.Error(), "item 2 of 3") {
+		t.Errorf("ValidateIDs with invalid item = %v", err)
+	}
+	var verr *ValidationError
+	if !errors.As(err, &verr) || verr.Value != "B 2" {
+		t.Errorf("ValidateIDs error does not unwrap to the ValidationError: %v", err)
+	}
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devicemanagement"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/client"
+	"resty.dev/v3"
+)
+
+// Per-device results for rows that were never submitted or whose batch
+// request failed. Submitted devices report their activity's final status.
+const (
+	resultInvalid   = "INVALID"
+	resultDuplicate = "DUPLICATE"
+	resultError     = "ERROR"
+)
+
+// serialPattern matches a normalized device serial number.
+var serialPattern = regexp.MustCompile(`^[A-Z0-9]+$`)
+
+// serialHeaders are the CSV header names recognised for the serial column.
+var serialHeaders = []string{"serial", "serial_number", "serialnumber", "serial number"}
+
+// deviceResult is one row of a bulk assignment report.
+type deviceResult struct {
+	Serial     string `json:"serial"`
+	ActivityID string `json:"activityId,omitempty"`
+	Status     string `json:"status"`
+	Error      string `json:"error,omitempty"`
+}
+
+// submitFunc is AssignDevicesV1 or UnassignDevicesV1.
+type submitFunc func(ctx context.Context, mdmServerID string, deviceIDs []string) (*devicemanagement.ResponseOrgDeviceActivity, *resty.Response, error)
+
+// bulkOptions configures a CSV-driven assign or unassign.
+type bulkOptions struct {
+	assign    bool
+	serverID  string
+	csvPath   string
+	batchSize int
+	poll      time.Duration
+}
+
+// readSerials reads device serial numbers from a CSV file. The serial column is
+// the one headed "serial" (or "serial_number", "serialNumber", "Serial
+// Number") when the first row is a header, and the first column otherwise.
+// Serials are trimmed and upper-cased; malformed and repeated serials are
+// returned as results instead of being submitted.
+func readSerials(r io.Reader) (serials []string, rejected []deviceResult, err error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, nil, fmt.Errorf("read CSV: %w", err)
+	}
+
+	col := 0
+	if len(records) > 0 {
+		for i, cell := range records[0] {
+			if slices.Contains(serialHeaders, strings.ToLower(strings.TrimSpace(cell))) {
+				col = i
+				records = records[1:]
+				break
+			}
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, record := range records {
+		if col >= len(record) {
+			continue
+		}
+		serial := strings.ToUpper(strings.TrimSpace(record[col]))
+		switch {
+		case serial == "":
+			continue
+		case !serialPattern.MatchString(serial):
+			rejected = append(rejected, deviceResult{Serial: serial, Status: resultInvalid,
+				Error: "serial numbers contain only letters and digits"})
+		case seen[serial]:
+			rejected = append(rejected, deviceResult{Serial: serial, Status: resultDuplicate,
+				Error: "serial number appears more than once"})
+		default:
+			seen[serial] = true
+			serials = append(serials, serial)
+		}
+	}
+	return serials, rejected, nil
+}
+
+// bulkAssignment assigns or unassigns the devices listed in a CSV file in
+// batches of opts.batchSize, waits for each batch's activity to finish and
+// prints a per-device report: the rejected rows, then one row per submitted
+// device from a client.BatchResult keyed by serial number. It fails when any
+// device did not complete.
+func bulkAssignment(opts bulkOptions) command {
+	return func(ctx context.Context, c *axm.Client, out *printer) error {
+		f, err := os.Open(opts.csvPath)
+		if err != nil {
+			return err
+		}
+		serials, results, err := readSerials(f)
+		f.Close()
+		if err != nil {
+			return err
+		}
+
+		dm := c.AXMAPI.DeviceManagement
+		var submit submitFunc = dm.UnassignDevicesV1
+		if opts.assign {
+			submit = dm.AssignDevicesV1
+		}
+
+		outcomes := client.NewBatchResult[deviceResult](serials)
+		for batch := range slices.Chunk(serials, opts.batchSize) {
+			status, activityID, failures, err := runBatch(ctx, dm, submit, opts, batch)
+			for _, serial := range batch {
+				r := deviceResult{Serial: serial, ActivityID: activityID, Status: status}
+				itemErr := err
+				if f, ok := failures[serial]; ok {
+					r.Status = string(devicemanagement.ActivityStatusFailed)
+					itemErr = errors.New(cmp.Or(f.FailureReason, f.Outcome))
+				} else if itemErr == nil && !devicemanagement.ActivityStatus(status).Succeeded() {
+					itemErr = fmt.Errorf("activity finished with status %s", status)
+				}
+				outcomes.Set(serial, r, itemErr)
+			}
+		}
+
+		failed := len(results)
+		for _, item := range outcomes.Items {
+			r := item.Value
+			if !item.Success() {
+				r.Error = item.Err.Error()
+				failed++
+			}
+			results = append(results, r)
+		}
+		rows := make([][]string, len(results))
+		for i, r := range results {
+			rows[i] = []string{r.Serial, orDash(r.ActivityID), r.Status, orDash(r.Error)}
+		}
+		if err := out.print(results, []string{"SERIAL", "ACTIVITY", "STATUS", "ERROR"}, rows); err != nil {
+			return err
+		}
+		if failed > 0 {
+			return fmt.Errorf("%d of %d devices did not complete", failed, len(results))
+		}
+		return nil
+	}
+}
+
+// runBatch submits one batch and waits for its activity, returning the
+// activity's final status and the devices its report lists as failed, keyed by
+// serial number. If the wait is interrupted, the last observed status is
+// returned with the context error. A report that cannot be fetched leaves the
+// failures empty, so every device takes the activity's status.
+func runBatch(ctx context.Context, dm *devicemanagement.DeviceManagement, submit submitFunc,
+	opts bulkOptions, batch []string) (status, activityID string, failures map[string]devicemanagement.ActivityDeviceResult, err error) {
+	created, _, err := submit(ctx, opts.serverID, batch)
+	if err != nil {
+		return resultError, "", nil, err
+	}
+	activityID = created.Data.ID
+
+	final, err := dm.WaitForActivityV1(ctx, activityID, opts.poll)
+	if final == nil {
+		return resultError, activityID, nil, err
+	}
+	status = string(devicemanagement.ActivityStatusInProgress)
+	if final.Data.Attributes != nil {
+		status = string(final.Data.Attributes.Status)
+	}
+	if err != nil || !final.Data.Terminal() {
+		return status, activityID, nil, err
+	}
+
+	if report, _, rerr := dm.GetActivityReportV1(ctx, final.Data); rerr == nil {
+		failures = make(map[string]devicemanagement.ActivityDeviceResult)
+		for _, d := range report.FailedDevices() {
+			failures[strings.ToUpper(d.SerialNumber)] = d
+		}
+	}
+	return status, activityID, failures, nil
+}
@@ -0,0 +1,230 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devicemanagement"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devices"
+)
+
+// command runs one parsed subcommand against the API.
+type command func(ctx context.Context, c *axm.Client, out *printer) error
+
+// lookupCommand parses args into a command, validating its arguments before
+// any credentials are loaded.
+func lookupCommand(args []string) (command, error) {
+	name, rest := args[0], args[1:]
+	switch name {
+	case "devices":
+		if len(rest) == 0 {
+			return nil, fmt.Errorf("devices: expected list or get")
+		}
+		switch rest[0] {
+		case "list":
+			if len(rest) != 1 {
+				return nil, fmt.Errorf("devices list: unexpected arguments %q", rest[1:])
+			}
+			return listDevices, nil
+		case "get":
+			if len(rest) != 2 {
+				return nil, fmt.Errorf("devices get: expected one device ID")
+			}
+			return getDevice(rest[1]), nil
+		}
+		return nil, fmt.Errorf("devices: unknown subcommand %q", rest[0])
+
+	case "mdm-servers":
+		if len(rest) != 1 || rest[0] != "list" {
+			return nil, fmt.Errorf("mdm-servers: expected list")
+		}
+		return listMDMServers, nil
+
+	case "assign", "unassign":
+		fs := flag.NewFlagSet(name, flag.ContinueOnError)
+		fs.SetOutput(io.Discard)
+		server := fs.String("server", "", "device management service ID")
+		fromCSV := fs.String("from-csv", "", "CSV file of device serial numbers")
+		batchSize := fs.Int("batch", 100, "devices per activity with -from-csv")
+		poll := fs.Duration("poll", 5*time.Second, "activity poll interval with -from-csv")
+		if err := fs.Parse(rest); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		if *server == "" {
+			return nil, fmt.Errorf("%s: -server <id> is required", name)
+		}
+		if *fromCSV != "" {
+			if fs.NArg() != 0 {
+				return nil, fmt.Errorf("%s: device IDs cannot be combined with -from-csv", name)
+			}
+			if *batchSize < 1 || *poll <= 0 {
+				return nil, fmt.Errorf("%s: -batch and -poll must be positive", name)
+			}
+			return bulkAssignment(bulkOptions{
+				assign:    name == "assign",
+				serverID:  *server,
+				csvPath:   *fromCSV,
+				batchSize: *batchSize,
+				poll:      *poll,
+			}), nil
+		}
+		if fs.NArg() == 0 {
+			return nil, fmt.Errorf("%s: expected at least one device ID or -from-csv <file>", name)
+		}
+		return changeAssignment(name == "assign", *server, fs.Args()), nil
+
+	case "watch":
+		fs := flag.NewFlagSet(name, flag.ContinueOnError)
+		fs.SetOutput(io.Discard)
+		interval := fs.Duration("interval", 5*time.Minute, "time between inventory polls")
+		statePath := fs.String("state", "axmctl-watch.json", "file the inventory snapshot is saved to")
+		webhook := fs.String("webhook", "", "URL each event is POSTed to as JSON")
+		once := fs.Bool("once", false, "poll once and exit")
+		if err := fs.Parse(rest); err != nil {
+			return nil, fmt.Errorf("watch: %w", err)
+		}
+		if fs.NArg() != 0 {
+			return nil, fmt.Errorf("watch: unexpected arguments %q", fs.Args())
+		}
+		if *interval <= 0 || *statePath == "" {
+			return nil, fmt.Errorf("watch: -interval must be positive and -state non-empty")
+		}
+		return watchInventory(watchOptions{
+			interval:  *interval,
+			statePath: *statePath,
+			webhook:   *webhook,
+			once:      *once,
+		}), nil
+
+	case "export":
+		fs := flag.NewFlagSet(name, flag.ContinueOnError)
+		fs.SetOutput(io.Discard)
+		format := fs.String("format", exportCSV, "csv, jsonl or parquet")
+		fields := fs.String("fields", strings.Join(defaultExportFields, ","), "comma-separated fields to export")
+		outPath := fs.String("out", "", "output file; enables resumable checkpoints (default stdout)")
+		pageSize := fs.Int("page-size", 1000, "devices requested per page")
+		if err := fs.Parse(rest); err != nil {
+			return nil, fmt.Errorf("export: %w", err)
+		}
+		if fs.NArg() != 0 {
+			return nil, fmt.Errorf("export: unexpected arguments %q", fs.Args())
+		}
+		switch *format {
+		case exportCSV, exportJSONL, exportParquet:
+		default:
+			return nil, fmt.Errorf("export: unknown format %q", *format)
+		}
+		fieldList := strings.Split(*fields, ",")
+		if _, err := lookupExportColumns(fieldList); err != nil {
+			return nil, fmt.Errorf("export: %w", err)
+		}
+		if *pageSize < 1 {
+			return nil, fmt.Errorf("export: -page-size must be positive")
+		}
+		return exportInventory(exportOptions{
+			format:   *format,
+			fields:   fieldList,
+			outPath:  *outPath,
+			pageSize: *pageSize,
+		}), nil
+
+	case "activity":
+		if len(rest) != 1 {
+			return nil, fmt.Errorf("activity: expected one activity ID")
+		}
+		return getActivity(rest[0]), nil
+	}
+	return nil, fmt.Errorf("unknown command %q", name)
+}
+
+var deviceHeaders = []string{"ID", "SERIAL", "MODEL", "FAMILY", "STATUS", "ADDED"}
+
+func deviceRow(d devices.OrgDevice) []string {
+	a := d.Attributes
+	if a == nil {
+		a = &devices.OrgDeviceAttributes{}
+	}
+	return []string{d.ID, orDash(a.SerialNumber), orDash(a.DeviceModel), orDash(a.ProductFamily),
+		orDash(a.Status), formatTime(a.AddedToOrgDateTime)}
+}
+
+func listDevices(ctx context.Context, c *axm.Client, out *printer) error {
+	resp, _, err := c.AXMAPI.Devices.GetV1(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("list devices: %w", err)
+	}
+	rows := make([][]string, len(resp.Data))
+	for i, d := range resp.Data {
+		rows[i] = deviceRow(d)
+	}
+	return out.print(resp.Data, deviceHeaders, rows)
+}
+
+func getDevice(deviceID string) command {
+	return func(ctx context.Context, c *axm.Client, out *printer) error {
+		resp, _, err := c.AXMAPI.Devices.GetByDeviceIDV1(ctx, deviceID, nil)
+		if err != nil {
+			return fmt.Errorf("get device %s: %w", deviceID, err)
+		}
+		return out.print(resp.Data, deviceHeaders, [][]string{deviceRow(resp.Data)})
+	}
+}
+
+func listMDMServers(ctx context.Context, c *axm.Client, out *printer) error {
+	resp, _, err := c.AXMAPI.DeviceManagement.GetV1(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("list mdm servers: %w", err)
+	}
+	rows := make([][]string, len(resp.Data))
+	for i, s := range resp.Data {
+		a := s.Attributes
+		if a == nil {
+			a = &devicemanagement.MDMServerAttributes{}
+		}
+		rows[i] = []string{s.ID, orDash(a.ServerName), orDash(a.ServerType), formatTime(a.CreatedDateTime)}
+	}
+	return out.print(resp.Data, []string{"ID", "NAME", "TYPE", "CREATED"}, rows)
+}
+
+func changeAssignment(assign bool, serverID string, deviceIDs []string) command {
+	return func(ctx context.Context, c *axm.Client, out *printer) error {
+		dm := c.AXMAPI.DeviceManagement
+		call, verb := dm.UnassignDevicesV1, "unassign"
+		if assign {
+			call, verb = dm.AssignDevicesV1, "assign"
+		}
+		resp, _, err := call(ctx, serverID, deviceIDs)
+		if err != nil {
+			return fmt.Errorf("%s devices: %w", verb, err)
+		}
+		return printActivity(out, resp.Data)
+	}
+}
+
+func getActivity(activityID string) command {
+	return func(ctx context.Context, c *axm.Client, out *printer) error {
+		resp, _, err := c.AXMAPI.DeviceManagement.GetActivityByIDV1(ctx, activityID)
+		if err != nil {
+			return fmt.Errorf("get activity %s: %w", activityID, err)
+		}
+		return printActivity(out, resp.Data)
+	}
+}
+
+func printActivity(out *printer, a devicemanagement.OrgDeviceActivity) error {
+	attrs := a.Attributes
+	if attrs == nil {
+		attrs = &devicemanagement.OrgDeviceActivityAttributes{}
+	}
+	status := string(attrs.Status)
+	if attrs.SubStatus != "" {
+		status += " / " + string(attrs.SubStatus)
+	}
+	row := []string{a.ID, orDash(attrs.ActivityType), orDash(strings.TrimPrefix(status, " / ")), formatTime(attrs.CreatedDateTime)}
+	return out.print(a, []string{"ID", "TYPE", "STATUS", "CREATED"}, [][]string{row})
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm"
+)
+
+// config is the JSON credentials file. Its fields mirror the APPLE_*
+// environment variables read by axm.NewClientFromEnv.
+type config struct {
+	KeyID          string `json:"key_id"`
+	IssuerID       string `json:"issuer_id"`
+	PrivateKeyPath string `json:"private_key_path,omitempty"`
+	PrivateKey     string `json:"private_key,omitempty"`
+}
+
+// loadConfig reads the credentials file at path. An empty path returns a nil
+// config, meaning credentials come from the environment.
+func loadConfig(path string) (*config, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read config: %w", err)
+	}
+	var cfg config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parse config %s: %w", path, err)
+	}
+	if cfg.KeyID == "" || cfg.IssuerID == "" {
+		return nil, fmt.Errorf("config %s: key_id and issuer_id are required", path)
+	}
+	if (cfg.PrivateKey == "") == (cfg.PrivateKeyPath == "") {
+		return nil, fmt.Errorf("config %s: exactly one of private_key and private_key_path is required", path)
+	}
+	return &cfg, nil
+}
+
+// privateKey parses the configured private key.
+func (c *config) privateKey() (any, error) {
+	if c.PrivateKey != "" {
+		return axm.ParsePrivateKey([]byte(c.PrivateKey))
+	}
+	return axm.LoadPrivateKeyFromFile(c.PrivateKeyPath)
+}
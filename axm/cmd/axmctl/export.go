@@ -0,0 +1,368 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devices"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/reports"
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/parquet"
+)
+
+// Export formats.
+const (
+	exportCSV     = "csv"
+	exportJSONL   = "jsonl"
+	exportParquet = "parquet"
+)
+
+// exportColumn is one selectable export field. apiField is the orgDevices
+// attribute it needs, if any.
+type exportColumn struct {
+	name     string
+	apiField string
+	value    func(d devices.OrgDevice, a *devices.OrgDeviceAttributes, serverID, serverName string) any
+}
+
+// exportColumns lists every field -fields accepts, in the order they are
+// documented.
+var exportColumns = []exportColumn{
+	{"id", "", func(d devices.OrgDevice, _ *devices.OrgDeviceAttributes, _, _ string) any { return d.ID }},
+	{"serialNumber", devices.FieldSerialNumber, attr(func(a *devices.OrgDeviceAttributes) any { return a.SerialNumber })},
+	{"deviceModel", devices.FieldDeviceModel, attr(func(a *devices.OrgDeviceAttributes) any { return a.DeviceModel })},
+	{"productFamily", devices.FieldProductFamily, attr(func(a *devices.OrgDeviceAttributes) any { return a.ProductFamily })},
+	{"productType", devices.FieldProductType, attr(func(a *devices.OrgDeviceAttributes) any { return a.ProductType })},
+	{"deviceCapacity", devices.FieldDeviceCapacity, attr(func(a *devices.OrgDeviceAttributes) any { return a.DeviceCapacity })},
+	{"partNumber", devices.FieldPartNumber, attr(func(a *devices.OrgDeviceAttributes) any { return a.PartNumber })},
+	{"orderNumber", devices.FieldOrderNumber, attr(func(a *devices.OrgDeviceAttributes) any { return a.OrderNumber })},
+	{"color", devices.FieldColor, attr(func(a *devices.OrgDeviceAttributes) any { return a.Color })},
+	{"status", devices.FieldStatus, attr(func(a *devices.OrgDeviceAttributes) any { return a.Status })},
+	{"addedToOrgDateTime", devices.FieldAddedToOrgDateTime, attr(func(a *devices.OrgDeviceAttributes) any { return a.AddedToOrgDateTime })},
+	{"updatedDateTime", devices.FieldUpdatedDateTime, attr(func(a *devices.OrgDeviceAttributes) any { return a.UpdatedDateTime })},
+	{"orderDateTime", devices.FieldOrderDateTime, attr(func(a *devices.OrgDeviceAttributes) any { return a.OrderDateTime })},
+	{"imei", devices.FieldIMEI, attr(func(a *devices.OrgDeviceAttributes) any { return a.IMEI })},
+	{"meid", devices.FieldMEID, attr(func(a *devices.OrgDeviceAttributes) any { return a.MEID })},
+	{"eid", devices.FieldEID, attr(func(a *devices.OrgDeviceAttributes) any { return a.EID })},
+	{"wifiMacAddress", devices.FieldWiFiMACAddress, attr(func(a *devices.OrgDeviceAttributes) any { return a.WiFiMACAddress })},
+	{"bluetoothMacAddress", devices.FieldBluetoothMACAddress, attr(func(a *devices.OrgDeviceAttributes) any { return a.BluetoothMACAddress })},
+	{"ethernetMacAddress", devices.FieldEthernetMACAddress, attr(func(a *devices.OrgDeviceAttributes) any { return a.EthernetMACAddress })},
+	{"purchaseSourceId", devices.FieldPurchaseSourceId, attr(func(a *devices.OrgDeviceAttributes) any { return a.PurchaseSourceId })},
+	{"purchaseSourceType", devices.FieldPurchaseSourceType, attr(func(a *devices.OrgDeviceAttributes) any { return a.PurchaseSourceType })},
+	{"mdmServerId", "", func(_ devices.OrgDevice, _ *devices.OrgDeviceAttributes, id, _ string) any { return id }},
+	{"mdmServerName", "", func(_ devices.OrgDevice, _ *devices.OrgDeviceAttributes, _, name string) any { return name }},
+}
+
+// defaultExportFields are exported when -fields is not given.
+var defaultExportFields = []string{"id", "serialNumber", "deviceModel", "productFamily", "status", "mdmServerName"}
+
+// attr adapts an attribute accessor to an exportColumn value func.
+func attr(f func(a *devices.OrgDeviceAttributes) any) func(devices.OrgDevice, *devices.OrgDeviceAttributes, string, string) any {
+	return func(_ devices.OrgDevice, a *devices.OrgDeviceAttributes, _, _ string) any { return f(a) }
+}
+
+// lookupExportColumns resolves field names to columns.
+func lookupExportColumns(fields []string) ([]exportColumn, error) {
+	cols := make([]exportColumn, 0, len(fields))
+	for _, f := range fields {
+		i := slices.IndexFunc(exportColumns, func(c exportColumn) bool { return c.name == f })
+		if i < 0 {
+			return nil, fmt.Errorf("unknown field %q", f)
+		}
+		cols = append(cols, exportColumns[i])
+	}
+	return cols, nil
+}
+
+// exportOptions configures the export command.
+type exportOptions struct {
+	format   string
+	fields   []string
+	outPath  string
+	pageSize int
+}
+
+// exportCheckpoint records how far an export to a file has got. Offset is the
+// size of the output file after the last complete page. RowGroups holds the
+// Parquet row groups written so far, which the footer lists.
+type exportCheckpoint struct {
+	Format    string             `json:"format"`
+	Fields    []string           `json:"fields"`
+	Cursor    string             `json:"cursor"`
+	Offset    int64              `json:"offset"`
+	Devices   int                `json:"devices"`
+	RowGroups []parquet.RowGroup `json:"rowGroups,omitempty"`
+	Updated   time.Time          `json:"updated"`
+}
+
+// exportInventory writes every device in the organization, with the name of
+// the device management service it is assigned to, as CSV or JSON Lines.
+//
+// Devices are fetched a page at a time and written as each page arrives.
+// When writing to a file, a checkpoint is saved to <file>.checkpoint after
+// every page; if the export is interrupted, running the same command again
+// discards any partly written page and continues from the saved cursor. The
+// checkpoint is removed once the export completes. Rate-limited requests are
+// retried by the client, honouring Retry-After.
+func exportInventory(opts exportOptions) command {
+	return func(ctx context.Context, c *axm.Client, out *printer) error {
+		cols, err := lookupExportColumns(opts.fields)
+		if err != nil {
+			return err
+		}
+		var apiFields []string
+		for _, col := range cols {
+			if col.apiField != "" && !slices.Contains(apiFields, col.apiField) {
+				apiFields = append(apiFields, col.apiField)
+			}
+		}
+
+		// Load the server list before touching the output file.
+		servers := reports.NewServerDirectory(c)
+		if _, err := servers.Servers(ctx); err != nil {
+			return err
+		}
+
+		w := out.w
+		var f *os.File
+		cp := &exportCheckpoint{Format: opts.format, Fields: opts.fields}
+		checkpointPath := opts.outPath + ".checkpoint"
+		if opts.outPath != "" {
+			if cp, f, err = openExport(opts.outPath, checkpointPath, cp); err != nil {
+				return err
+			}
+			defer f.Close()
+			w = f
+		}
+
+		bw := bufio.NewWriter(w)
+		enc := newRowEncoder(bw, opts.format, opts.fields)
+		if cp.Offset == 0 {
+			if err := enc.header(); err != nil {
+				return err
+			}
+		} else {
+			enc.resume(cp)
+		}
+
+		query := &devices.RequestQueryOptions{Fields: apiFields, Limit: opts.pageSize}
+		for {
+			page, _, err := c.AXMAPI.Devices.GetPageV1(ctx, cp.Cursor, query)
+			if err != nil {
+				return fmt.Errorf("list devices: %w", err)
+			}
+			enriched, err := servers.Enrich(ctx, page.Data)
+			if err != nil {
+				return err
+			}
+			for _, d := range enriched {
+				a := d.Attributes
+				if a == nil {
+					a = &devices.OrgDeviceAttributes{}
+				}
+				values := make([]any, len(cols))
+				for i, col := range cols {
+					values[i] = col.value(d.OrgDevice, a, d.ServerID, d.ServerName)
+				}
+				if err := enc.row(values); err != nil {
+					return err
+				}
+			}
+			cp.Devices += len(page.Data)
+			cp.Cursor = page.NextCursor()
+			if err := enc.endPage(cp.Cursor == ""); err != nil {
+				return err
+			}
+			if err := bw.Flush(); err != nil {
+				return err
+			}
+
+			if f == nil {
+				if cp.Cursor == "" {
+					return nil
+				}
+				continue
+			}
+			if cp.Cursor == "" {
+				if err := os.Remove(checkpointPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+					return err
+				}
+				return nil
+			}
+			if err := f.Sync(); err != nil {
+				return err
+			}
+			if cp.Offset, err = f.Seek(0, io.SeekCurrent); err != nil {
+				return err
+			}
+			cp.RowGroups = enc.rowGroups()
+			cp.Updated = time.Now().UTC()
+			if err := saveJSON(checkpointPath, cp); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// openExport opens the output file for a fresh export, or for resuming the one
+// recorded at checkpointPath. A resumed file is truncated to the checkpoint's
+// offset and positioned at its end.
+func openExport(outPath, checkpointPath string, fresh *exportCheckpoint) (*exportCheckpoint, *os.File, error) {
+	data, err := os.ReadFile(checkpointPath)
+	if errors.Is(err, os.ErrNotExist) {
+		f, err := os.Create(outPath)
+		return fresh, f, err
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("read checkpoint: %w", err)
+	}
+
+	var cp exportCheckpoint
+	if err := json.Unmarshal(data, &cp); err != nil {
+		return nil, nil, fmt.Errorf("parse checkpoint %s: %w", checkpointPath, err)
+	}
+	if cp.Format != fresh.Format || !slices.Equal(cp.Fields, fresh.Fields) {
+		return nil, nil, fmt.Errorf("checkpoint %s was written for -format %s -fields %s; rerun with those flags or delete it",
+			checkpointPath, cp.Format, strings.Join(cp.Fields, ","))
+	}
+
+	f, err := os.OpenFile(outPath, os.O_WRONLY, 0)
+	if err != nil {
+		return nil, nil, fmt.Errorf("resume export: %w", err)
+	}
+	if err := f.Truncate(cp.Offset); err != nil {
+		f.Close()
+		return nil, nil, fmt.Errorf("resume export: %w", err)
+	}
+	if _, err := f.Seek(cp.Offset, io.SeekStart); err != nil {
+		f.Close()
+		return nil, nil, fmt.Errorf("resume export: %w", err)
+	}
+	return &cp, f, nil
+}
+
+// rowEncoder writes export rows in one format. Parquet rows are buffered and
+// written as one row group per page.
+type rowEncoder struct {
+	format  string
+	fields  []string
+	w       io.Writer
+	csv     *csv.Writer
+	parquet *parquet.Writer
+	rows    [][]*string
+}
+
+func newRowEncoder(w io.Writer, format string, fields []string) *rowEncoder {
+	e := &rowEncoder{format: format, fields: fields, w: w}
+	switch format {
+	case exportCSV:
+		e.csv = csv.NewWriter(w)
+	case exportParquet:
+		e.parquet = parquet.NewWriter(w, fields)
+	}
+	return e
+}
+
+// header starts the output: the CSV header row or the Parquet magic number.
+// JSON Lines has none.
+func (e *rowEncoder) header() error {
+	switch {
+	case e.csv != nil:
+		e.csv.Write(e.fields)
+		e.csv.Flush()
+		return e.csv.Error()
+	case e.parquet != nil:
+		return e.parquet.WriteHeader()
+	}
+	return nil
+}
+
+// resume continues the output recorded in cp instead of starting it.
+func (e *rowEncoder) resume(cp *exportCheckpoint) {
+	if e.parquet != nil {
+		e.parquet.Resume(cp.Offset, cp.RowGroups)
+	}
+}
+
+// row writes one device. CSV joins list values with ";" and leaves unset
+// values empty; JSON Lines and Parquet write them as null.
+func (e *rowEncoder) row(values []any) error {
+	switch {
+	case e.csv != nil:
+		record := make([]string, len(values))
+		for i, v := range values {
+			if s, ok := exportCell(v); ok {
+				record[i] = s
+			}
+		}
+		e.csv.Write(record)
+		e.csv.Flush()
+		return e.csv.Error()
+
+	case e.parquet != nil:
+		row := make([]*string, len(values))
+		for i, v := range values {
+			if s, ok := exportCell(v); ok {
+				row[i] = &s
+			}
+		}
+		e.rows = append(e.rows, row)
+		return nil
+	}
+
+	obj := make(map[string]any, len(e.fields))
+	for i, f := range e.fields {
+		obj[f] = values[i]
+	}
+	return json.NewEncoder(e.w).Encode(obj)
+}
+
+// endPage writes the rows buffered for a page, and the Parquet footer after
+// the last page.
+func (e *rowEncoder) endPage(last bool) error {
+	if e.parquet == nil {
+		return nil
+	}
+	if err := e.parquet.WriteRowGroup(e.rows); err != nil {
+		return err
+	}
+	e.rows = nil
+	if last {
+		return e.parquet.Close()
+	}
+	return nil
+}
+
+// rowGroups returns the Parquet row groups written so far, for the
+// checkpoint.
+func (e *rowEncoder) rowGroups() []parquet.RowGroup {
+	if e.parquet == nil {
+		return nil
+	}
+	return e.parquet.RowGroups()
+}
+
+// exportCell formats a column value as text, joining lists with ";". It
+// reports false for an unset time or list.
+func exportCell(v any) (string, bool) {
+	switch v := v.(type) {
+	case string:
+		return v, true
+	case []string:
+		return strings.Join(v, ";"), v != nil
+	case *time.Time:
+		if v != nil {
+			return v.UTC().Format(time.RFC3339), true
+		}
+	}
+	return "", false
+}
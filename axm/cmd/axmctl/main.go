@@ -0,0 +1,143 @@
+// Command axmctl is a command-line client for the Apple Business Manager and
+// Apple School Manager API, built on the axm package.
+//
+//	go run ./axm/cmd/axmctl [-config file] [-output table|json] <command> [args]
+//
+// Commands:
+//
+//	devices list                       list the organization's devices
+//	devices get <device-id>            show one device
+//	mdm-servers list                   list device management services
+//	assign -server <id> <device-id>…   assign devices to a device management service
+//	unassign -server <id> <device-id>… unassign devices from a device management service
+//	activity <activity-id>             show the status of an assign or unassign activity
+//	watch [-interval d] [-state file]  report devices as they are added, assigned or unassigned
+//	export [-format csv|jsonl|parquet] [-fields f,…] [-out file]
+//	                                   write the device inventory with MDM server names
+//
+// assign and unassign also accept -from-csv <file> in place of device IDs.
+// The serial numbers in the file are validated and submitted in batches of
+// -batch devices. The command then waits for each activity, polling every
+// -poll, and prints a per-device result. It exits 1 if any device did not
+// complete.
+//
+// watch polls the device inventory every -interval (default 5m) and prints an
+// event for each device that was added, assigned or unassigned since the
+// previous poll, also POSTing it as JSON to -webhook when set. The snapshot is
+// saved to -state between polls and runs; -once polls a single time, which
+// suits running from cron.
+//
+// export writes every device, one row per device, as CSV, JSON Lines or
+// Parquet.
+// -fields selects the columns (default id,serialNumber,deviceModel,
+// productFamily,status,mdmServerName). With -out, a checkpoint is kept next to
+// the file, and rerunning an interrupted export resumes from the last complete
+// page.
+//
+// Credentials are read from the JSON file named by -config or AXMCTL_CONFIG:
+//
+//	{"key_id": "…", "issuer_id": "…", "private_key_path": "/path/to/key.p8"}
+//
+// "private_key" may hold the PEM inline instead of "private_key_path". With no
+// config file, the APPLE_KEY_ID, APPLE_ISSUER_ID and APPLE_PRIVATE_KEY_PATH (or
+// APPLE_PRIVATE_KEY_PEM) environment variables read by axm.NewClientFromEnv
+// are used.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm"
+)
+
+const usage = `usage: axmctl [-config file] [-output table|json] [-timeout d] <command> [args]
+
+commands:
+  devices list                          list the organization's devices
+  devices get <device-id>               show one device
+  mdm-servers list                      list device management services
+  assign -server <id> <device-id>...    assign devices to a device management service
+  unassign -server <id> <device-id>...  unassign devices from a device management service
+  activity <activity-id>                show the status of an assign or unassign activity
+  watch [-interval d] [-state file] [-webhook url] [-once]
+                                        report devices as they are added, assigned or unassigned
+  export [-format csv|jsonl|parquet] [-fields f,...] [-out file] [-page-size n]
+                                        write the device inventory with MDM server names
+
+assign and unassign accept -from-csv <file> [-batch n] [-poll d] in place of
+device IDs to submit a CSV of serial numbers in batches and wait for each one.
+`
+
+// clientFactory builds the API client from the loaded configuration.
+type clientFactory func(cfg *config, timeout time.Duration) (*axm.Client, error)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	code := run(ctx, os.Args[1:], os.Stdout, os.Stderr, newClient)
+	stop()
+	os.Exit(code)
+}
+
+// run executes one axmctl invocation and returns the process exit status.
+func run(ctx context.Context, args []string, stdout, stderr io.Writer, factory clientFactory) int {
+	fs := flag.NewFlagSet("axmctl", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() { fmt.Fprint(stderr, usage) }
+	configPath := fs.String("config", os.Getenv("AXMCTL_CONFIG"), "JSON credentials file")
+	output := fs.String("output", formatTable, "output format: table or json")
+	timeout := fs.Duration("timeout", 30*time.Second, "per-request timeout")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if *output != formatTable && *output != formatJSON {
+		fmt.Fprintf(stderr, "axmctl: unknown output format %q\n", *output)
+		return 2
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return 2
+	}
+
+	cmd, err := lookupCommand(fs.Args())
+	if err != nil {
+		fmt.Fprintln(stderr, "axmctl:", err)
+		fmt.Fprint(stderr, usage)
+		return 2
+	}
+
+	cfg, err := loadConfig(*configPath)
+	if err != nil {
+		fmt.Fprintln(stderr, "axmctl:", err)
+		return 1
+	}
+	c, err := factory(cfg, *timeout)
+	if err != nil {
+		fmt.Fprintln(stderr, "axmctl:", err)
+		return 1
+	}
+
+	out := &printer{w: stdout, format: *output}
+	if err := cmd(ctx, c, out); err != nil {
+		fmt.Fprintln(stderr, "axmctl:", err)
+		return 1
+	}
+	return 0
+}
+
+// newClient is the production clientFactory.
+func newClient(cfg *config, timeout time.Duration) (*axm.Client, error) {
+	if cfg == nil {
+		return axm.NewClientFromEnv(axm.WithTimeout(timeout))
+	}
+	key, err := cfg.privateKey()
+	if err != nil {
+		return nil, err
+	}
+	return axm.NewClient(cfg.KeyID, cfg.IssuerID, key, axm.WithTimeout(timeout))
+}
@@ -0,0 +1,475 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devicemanagement"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/client"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"resty.dev/v3"
+)
+
+type noAuth struct{}
+
+func (noAuth) ApplyAuth(*resty.Request) error { return nil }
+
+const activityJSON = `{"data":{"type":"orgDeviceActivities","id":"act-1","attributes":{"status":"IN_PROGRESS","subStatus":"SUBMITTED","activityType":"ASSIGN_DEVICES"}}}`
+
+func jsonResponder(status int, body string) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		resp := httpmock.NewStringResponse(status, body)
+		resp.Header.Set("Content-Type", "application/json")
+		return resp, nil
+	}
+}
+
+// runCLI runs axmctl against a mock API and returns its exit status and output.
+func runCLI(t *testing.T, mt *httpmock.MockTransport, args ...string) (int, string, string) {
+	t.Helper()
+	factory := func(*config, time.Duration) (*axm.Client, error) {
+		return axm.NewClient("key-id", "issuer-id", "unused",
+			client.WithAuth(noAuth{}),
+			axm.WithTransport(mt),
+			axm.WithRetryCount(0),
+		)
+	}
+	var stdout, stderr bytes.Buffer
+	code := run(context.Background(), args, &stdout, &stderr, factory)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestRun_DevicesList(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDevices", jsonResponder(200,
+		`{"data":[{"type":"orgDevices","id":"DEV1","attributes":{"serialNumber":"C02XYZ","deviceModel":"MacBook Air","productFamily":"Mac","status":"ASSIGNED","addedToOrgDateTime":"2025-01-02T03:04:05Z"}}]}`))
+
+	code, stdout, stderr := runCLI(t, mt, "devices", "list")
+	require.Equal(t, 0, code, stderr)
+	assert.Equal(t, "ID    SERIAL  MODEL        FAMILY  STATUS    ADDED\n"+
+		"DEV1  C02XYZ  MacBook Air  Mac     ASSIGNED  2025-01-02T03:04:05Z\n", stdout)
+}
+
+func TestRun_MDMServersListJSON(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/mdmServers", jsonResponder(200,
+		`{"data":[{"type":"mdmServers","id":"SRV1","attributes":{"serverName":"Jamf Pro","serverType":"MDM"}}]}`))
+
+	code, stdout, stderr := runCLI(t, mt, "-output", "json", "mdm-servers", "list")
+	require.Equal(t, 0, code, stderr)
+
+	var servers []map[string]any
+	require.NoError(t, json.Unmarshal([]byte(stdout), &servers))
+	require.Len(t, servers, 1)
+	assert.Equal(t, "SRV1", servers[0]["id"])
+}
+
+func TestRun_AssignAndActivity(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	mt.RegisterResponder("POST", "https://api-business.apple.com/v1/orgDeviceActivities", jsonResponder(201, activityJSON))
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDeviceActivities/act-1", jsonResponder(200, activityJSON))
+
+	code, stdout, stderr := runCLI(t, mt, "assign", "-server", "SRV1", "DEV1", "DEV2")
+	require.Equal(t, 0, code, stderr)
+	assert.Contains(t, stdout, "act-1")
+	assert.Contains(t, stdout, "IN_PROGRESS / SUBMITTED")
+
+	code, stdout, stderr = runCLI(t, mt, "activity", "act-1")
+	require.Equal(t, 0, code, stderr)
+	assert.Contains(t, stdout, "ASSIGN_DEVICES")
+}
+
+func TestRun_APIError(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDevices/NOPE", jsonResponder(404,
+		`{"errors":[{"status":"404","code":"NOT_FOUND","title":"Not Found","detail":"no such device"}]}`))
+
+	code, _, stderr := runCLI(t, mt, "devices", "get", "NOPE")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "get device NOPE")
+}
+
+func TestRun_UsageErrors(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	for _, args := range [][]string{
+		{},
+		{"devices"},
+		{"devices", "get"},
+		{"mdm-servers", "show"},
+		{"assign", "DEV1"},
+		{"unassign", "-server", "SRV1"},
+		{"activity"},
+		{"bogus"},
+		{"-output", "yaml", "devices", "list"},
+	} {
+		code, _, _ := runCLI(t, mt, args...)
+		assert.Equal(t, 2, code, "%q", args)
+	}
+	assert.Zero(t, mt.NumResponders())
+}
+
+func TestLoadConfig(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, body string) string {
+		p := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(p, []byte(body), 0o600))
+		return p
+	}
+
+	cfg, err := loadConfig("")
+	require.NoError(t, err)
+	assert.Nil(t, cfg, "no path means environment credentials")
+
+	cfg, err = loadConfig(write("ok.json", `{"key_id":"K","issuer_id":"I","private_key_path":"/keys/k.p8"}`))
+	require.NoError(t, err)
+	assert.Equal(t, &config{KeyID: "K", IssuerID: "I", PrivateKeyPath: "/keys/k.p8"}, cfg)
+
+	_, err = loadConfig(write("nokey.json", `{"key_id":"K","issuer_id":"I"}`))
+	assert.ErrorContains(t, err, "exactly one of private_key and private_key_path")
+
+	_, err = loadConfig(write("noid.json", `{"issuer_id":"I","private_key":"pem"}`))
+	assert.ErrorContains(t, err, "key_id and issuer_id are required")
+
+	_, err = loadConfig(write("bad.json", `{`))
+	assert.ErrorContains(t, err, "parse config")
+
+	_, err = loadConfig(filepath.Join(dir, "missing.json"))
+	assert.ErrorContains(t, err, "read config")
+}
+
+func TestReadSerials(t *testing.T) {
+	serials, rejected, err := readSerials(strings.NewReader(
+		"Name,Serial Number\nalice, c02abc123\nbob,C02ABC123\ncarol,BAD-SERIAL\ndave,\nerin,FVFXYZ789\n"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"C02ABC123", "FVFXYZ789"}, serials)
+	require.Len(t, rejected, 2)
+	assert.Equal(t, deviceResult{Serial: "C02ABC123", Status: resultDuplicate, Error: "serial number appears more than once"}, rejected[0])
+	assert.Equal(t, "BAD-SERIAL", rejected[1].Serial)
+	assert.Equal(t, resultInvalid, rejected[1].Status)
+
+	serials, rejected, err = readSerials(strings.NewReader("C02ABC123\nFVFXYZ789\n"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"C02ABC123", "FVFXYZ789"}, serials, "headerless files use the first column")
+	assert.Empty(t, rejected)
+}
+
+func TestRun_AssignFromCSV(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	var batches [][]string
+	mt.RegisterResponder("POST", "https://api-business.apple.com/v1/orgDeviceActivities",
+		func(req *http.Request) (*http.Response, error) {
+			var body devicemanagement.OrgDeviceActivityCreateRequest
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				return nil, err
+			}
+			var ids []string
+			for _, d := range body.Data.Relationships.Devices.Data {
+				ids = append(ids, d.ID)
+			}
+			batches = append(batches, ids)
+			return jsonResponder(201, fmt.Sprintf(
+				`{"data":{"type":"orgDeviceActivities","id":"act-%d","attributes":{"status":"IN_PROGRESS"}}}`, len(batches)))(req)
+		})
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDeviceActivities/act-1",
+		jsonResponder(200, `{"data":{"type":"orgDeviceActivities","id":"act-1","attributes":{"status":"COMPLETED"}}}`))
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDeviceActivities/act-2",
+		jsonResponder(200, `{"data":{"type":"orgDeviceActivities","id":"act-2","attributes":{"status":"FAILED"}}}`))
+
+	csvPath := filepath.Join(t.TempDir(), "devices.csv")
+	require.NoError(t, os.WriteFile(csvPath, []byte("serial\nAAA111\nBBB222\nCCC333\nbad serial\n"), 0o600))
+
+	code, stdout, stderr := runCLI(t, mt, "-output", "json",
+		"assign", "-from-csv", csvPath, "-server", "SRV1", "-batch", "2", "-poll", "1ms")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "2 of 4 devices did not complete")
+	assert.Equal(t, [][]string{{"AAA111", "BBB222"}, {"CCC333"}}, batches)
+
+	var results []deviceResult
+	require.NoError(t, json.Unmarshal([]byte(stdout), &results))
+	assert.Equal(t, []deviceResult{
+		{Serial: "BAD SERIAL", Status: resultInvalid, Error: "serial numbers contain only letters and digits"},
+		{Serial: "AAA111", ActivityID: "act-1", Status: "COMPLETED"},
+		{Serial: "BBB222", ActivityID: "act-1", Status: "COMPLETED"},
+		{Serial: "CCC333", ActivityID: "act-2", Status: "FAILED", Error: "activity finished with status FAILED"},
+	}, results)
+}
+
+func TestRun_AssignFromCSVPartialFailure(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	mt.RegisterResponder("POST", "https://api-business.apple.com/v1/orgDeviceActivities",
+		jsonResponder(201, `{"data":{"type":"orgDeviceActivities","id":"act-1","attributes":{"status":"IN_PROGRESS"}}}`))
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDeviceActivities/act-1",
+		jsonResponder(200, `{"data":{"type":"orgDeviceActivities","id":"act-1","attributes":{"status":"COMPLETED","downloadUrl":"https://api-business.apple.com/v1/orgDeviceActivities/act-1/report"}}}`))
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDeviceActivities/act-1/report",
+		httpmock.NewStringResponder(200, "Serial Number,Status,Failure Reason\nAAA111,SUCCESS,\nbbb222,FAILED,Device not found\n"))
+
+	csvPath := filepath.Join(t.TempDir(), "devices.csv")
+	require.NoError(t, os.WriteFile(csvPath, []byte("serial\nAAA111\nBBB222\n"), 0o600))
+
+	code, stdout, stderr := runCLI(t, mt, "-output", "json",
+		"assign", "-from-csv", csvPath, "-server", "SRV1", "-poll", "1ms")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "1 of 2 devices did not complete")
+
+	var results []deviceResult
+	require.NoError(t, json.Unmarshal([]byte(stdout), &results))
+	assert.Equal(t, []deviceResult{
+		{Serial: "AAA111", ActivityID: "act-1", Status: "COMPLETED"},
+		{Serial: "BBB222", ActivityID: "act-1", Status: "FAILED", Error: "Device not found"},
+	}, results)
+}
+
+func TestRun_AssignFromCSVUsage(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	for _, args := range [][]string{
+		{"assign", "-from-csv", "devices.csv"},
+		{"assign", "-from-csv", "devices.csv", "-server", "SRV1", "DEV1"},
+		{"assign", "-from-csv", "devices.csv", "-server", "SRV1", "-batch", "0"},
+	} {
+		code, _, _ := runCLI(t, mt, args...)
+		assert.Equal(t, 2, code, "%q", args)
+	}
+}
+
+// registerInventory serves an inventory in which servers maps each MDM server
+// ID to the device IDs assigned to it.
+func registerInventory(mt *httpmock.MockTransport, devices map[string]string, servers map[string][]string) {
+	var data []string
+	for id, serial := range devices {
+		data = append(data, fmt.Sprintf(`{"type":"orgDevices","id":%q,"attributes":{"serialNumber":%q}}`, id, serial))
+	}
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDevices",
+		jsonResponder(200, `{"data":[`+strings.Join(data, ",")+`]}`))
+
+	var srv []string
+	for id, linked := range servers {
+		srv = append(srv, fmt.Sprintf(`{"type":"mdmServers","id":%q}`, id))
+		var links []string
+		for _, d := range linked {
+			links = append(links, fmt.Sprintf(`{"type":"orgDevices","id":%q}`, d))
+		}
+		mt.RegisterResponder("GET", "https://api-business.apple.com/v1/mdmServers/"+id+"/relationships/devices",
+			jsonResponder(200, `{"data":[`+strings.Join(links, ",")+`]}`))
+	}
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/mdmServers",
+		jsonResponder(200, `{"data":[`+strings.Join(srv, ",")+`]}`))
+}
+
+func TestRun_WatchOnce(t *testing.T) {
+	var posted []inventoryEvent
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var ev inventoryEvent
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&ev))
+		posted = append(posted, ev)
+	}))
+	defer hook.Close()
+
+	statePath := filepath.Join(t.TempDir(), "state.json")
+	args := []string{"-output", "json", "watch", "-once", "-state", statePath, "-webhook", hook.URL}
+
+	mt := httpmock.NewMockTransport()
+	registerInventory(mt,
+		map[string]string{"DEV1": "AAA111", "DEV2": "BBB222", "DEV3": "CCC333"},
+		map[string][]string{"SRV1": {"DEV1", "DEV2"}, "SRV2": nil})
+
+	code, stdout, stderr := runCLI(t, mt, args...)
+	require.Equal(t, 0, code, stderr)
+	assert.Empty(t, stdout, "the first run only records a baseline")
+	assert.Empty(t, posted)
+	require.FileExists(t, statePath)
+
+	mt = httpmock.NewMockTransport()
+	registerInventory(mt,
+		map[string]string{"DEV1": "AAA111", "DEV2": "BBB222", "DEV3": "CCC333", "DEV4": "DDD444"},
+		map[string][]string{"SRV1": {"DEV2"}, "SRV2": {"DEV3", "DEV4"}})
+
+	code, stdout, stderr = runCLI(t, mt, args...)
+	require.Equal(t, 0, code, stderr)
+
+	var events []inventoryEvent
+	require.NoError(t, json.Unmarshal([]byte(stdout), &events))
+	for i := range events {
+		events[i].Time = time.Time{}
+	}
+	assert.Equal(t, []inventoryEvent{
+		{Kind: eventUnassigned, DeviceID: "DEV1", SerialNumber: "AAA111", ServerID: "SRV1"},
+		{Kind: eventAssigned, DeviceID: "DEV3", SerialNumber: "CCC333", ServerID: "SRV2"},
+		{Kind: eventAdded, DeviceID: "DEV4", SerialNumber: "DDD444"},
+		{Kind: eventAssigned, DeviceID: "DEV4", SerialNumber: "DDD444", ServerID: "SRV2"},
+	}, events)
+	require.Len(t, posted, 4)
+	assert.Equal(t, "DEV1", posted[0].DeviceID)
+
+	code, stdout, _ = runCLI(t, mt, args...)
+	require.Equal(t, 0, code)
+	assert.Empty(t, stdout, "an unchanged inventory reports nothing")
+}
+
+func TestRun_WatchWebhookFailureKeepsState(t *testing.T) {
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer hook.Close()
+
+	statePath := filepath.Join(t.TempDir(), "state.json")
+	require.NoError(t, os.WriteFile(statePath, []byte(`{"devices":{}}`), 0o600))
+
+	mt := httpmock.NewMockTransport()
+	registerInventory(mt, map[string]string{"DEV1": "AAA111"}, nil)
+
+	code, _, stderr := runCLI(t, mt, "watch", "-once", "-state", statePath, "-webhook", hook.URL)
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "500")
+
+	data, err := os.ReadFile(statePath)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"devices":{}}`, string(data), "undelivered events are retried on the next run")
+}
+
+// registerDevicePages serves orgDevices as pages chained by cursor. A page
+// whose entry in failCursors is true fails with a server error.
+func registerDevicePages(mt *httpmock.MockTransport, pages [][]string, failCursors map[string]bool) {
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDevices",
+		func(req *http.Request) (*http.Response, error) {
+			cursor := req.URL.Query().Get("cursor")
+			if failCursors[cursor] {
+				return jsonResponder(500, `{"errors":[{"status":"500","code":"INTERNAL_ERROR","title":"Internal Server Error"}]}`)(req)
+			}
+			i := 0
+			if cursor != "" {
+				fmt.Sscanf(cursor, "page-%d", &i)
+			}
+			var data []string
+			for _, serial := range pages[i] {
+				data = append(data, fmt.Sprintf(`{"type":"orgDevices","id":"ID-%s","attributes":{"serialNumber":%q,"imei":["1","2"]}}`, serial, serial))
+			}
+			meta := `{"paging":{"limit":2}}`
+			if i+1 < len(pages) {
+				meta = fmt.Sprintf(`{"paging":{"limit":2,"nextCursor":"page-%d"}}`, i+1)
+			}
+			return jsonResponder(200, `{"data":[`+strings.Join(data, ",")+`],"meta":`+meta+`}`)(req)
+		})
+}
+
+func TestRun_ExportCSV(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	registerDevicePages(mt, [][]string{{"AAA111", "BBB222"}, {"CCC333"}}, nil)
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/mdmServers", jsonResponder(200,
+		`{"data":[{"type":"mdmServers","id":"SRV1","attributes":{"serverName":"Jamf Pro"}}]}`))
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/mdmServers/SRV1/relationships/devices", jsonResponder(200,
+		`{"data":[{"type":"orgDevices","id":"ID-BBB222"}]}`))
+
+	code, stdout, stderr := runCLI(t, mt, "export", "-fields", "serialNumber,imei,mdmServerId,mdmServerName")
+	require.Equal(t, 0, code, stderr)
+	assert.Equal(t, "serialNumber,imei,mdmServerId,mdmServerName\n"+
+		"AAA111,1;2,,\n"+
+		"BBB222,1;2,SRV1,Jamf Pro\n"+
+		"CCC333,1;2,,\n", stdout)
+}
+
+func TestRun_ExportResumesFromCheckpoint(t *testing.T) {
+	pages := [][]string{{"AAA111", "BBB222"}, {"CCC333", "DDD444"}, {"EEE555"}}
+	outPath := filepath.Join(t.TempDir(), "devices.jsonl")
+	args := []string{"export", "-format", "jsonl", "-fields", "id,serialNumber", "-out", outPath}
+
+	mt := httpmock.NewMockTransport()
+	registerInventory(mt, nil, nil)
+	registerDevicePages(mt, pages, map[string]bool{"page-2": true})
+
+	code, _, stderr := runCLI(t, mt, args...)
+	require.Equal(t, 1, code)
+	assert.Contains(t, stderr, "list devices")
+	require.FileExists(t, outPath+".checkpoint")
+
+	// Simulate a crash part-way through writing the failed page.
+	f, err := os.OpenFile(outPath, os.O_APPEND|os.O_WRONLY, 0)
+	require.NoError(t, err)
+	f.WriteString(`{"id":"ID-EEE`)
+	f.Close()
+
+	mt = httpmock.NewMockTransport()
+	registerInventory(mt, nil, nil)
+	registerDevicePages(mt, pages, map[string]bool{"": true, "page-1": true})
+
+	code, _, stderr = runCLI(t, mt, args...)
+	require.Equal(t, 0, code, stderr)
+	assert.NoFileExists(t, outPath+".checkpoint")
+
+	data, err := os.ReadFile(outPath)
+	require.NoError(t, err)
+	var serials []string
+	for line := range strings.Lines(string(data)) {
+		var row map[string]string
+		require.NoError(t, json.Unmarshal([]byte(line), &row), line)
+		serials = append(serials, row["serialNumber"])
+	}
+	assert.Equal(t, []string{"AAA111", "BBB222", "CCC333", "DDD444", "EEE555"}, serials)
+
+	require.NoError(t, os.WriteFile(outPath+".checkpoint", []byte(`{"format":"csv","fields":["id"],"cursor":"page-1","offset":3}`), 0o600))
+	code, _, stderr = runCLI(t, mt, args...)
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "rerun with those flags")
+}
+
+func TestRun_ExportParquetResume(t *testing.T) {
+	pages := [][]string{{"AAA111", "BBB222"}, {"CCC333", "DDD444"}, {"EEE555"}}
+	dir := t.TempDir()
+	export := func(outPath string, fail map[string]bool) (int, string) {
+		mt := httpmock.NewMockTransport()
+		registerInventory(mt, nil, nil)
+		registerDevicePages(mt, pages, fail)
+		code, _, stderr := runCLI(t, mt, "export", "-format", "parquet", "-fields", "id,serialNumber,orderDateTime", "-out", outPath)
+		return code, stderr
+	}
+
+	wantPath := filepath.Join(dir, "want.parquet")
+	code, stderr := export(wantPath, nil)
+	require.Equal(t, 0, code, stderr)
+	want, err := os.ReadFile(wantPath)
+	require.NoError(t, err)
+	assert.Equal(t, "PAR1", string(want[:4]))
+	assert.Equal(t, "PAR1", string(want[len(want)-4:]))
+	for _, serial := range []string{"AAA111", "CCC333", "EEE555"} {
+		assert.Contains(t, string(want), serial)
+	}
+
+	outPath := filepath.Join(dir, "devices.parquet")
+	code, _ = export(outPath, map[string]bool{"page-2": true})
+	require.Equal(t, 1, code)
+	require.FileExists(t, outPath+".checkpoint")
+	f, err := os.OpenFile(outPath, os.O_APPEND|os.O_WRONLY, 0)
+	require.NoError(t, err)
+	f.WriteString("partial row group")
+	f.Close()
+
+	code, stderr = export(outPath, map[string]bool{"": true, "page-1": true})
+	require.Equal(t, 0, code, stderr)
+	assert.NoFileExists(t, outPath+".checkpoint")
+	got, err := os.ReadFile(outPath)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestRun_ExportUsage(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	for _, args := range [][]string{
+		{"export", "-format", "xml"},
+		{"export", "-fields", "serialNumber,shoeSize"},
+		{"export", "-page-size", "0"},
+	} {
+		code, _, _ := runCLI(t, mt, args...)
+		assert.Equal(t, 2, code, "%q", args)
+	}
+}
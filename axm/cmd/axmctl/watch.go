@@ -0,0 +1,253 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/reports"
+)
+
+// Inventory event kinds.
+const (
+	eventAdded      = "added"
+	eventAssigned   = "assigned"
+	eventUnassigned = "unassigned"
+)
+
+// inventoryEvent is one change between two inventory snapshots. A device that
+// moves between services is reported as assigned with PreviousServerID set.
+type inventoryEvent struct {
+	Time             time.Time `json:"time"`
+	Kind             string    `json:"kind"`
+	DeviceID         string    `json:"deviceId"`
+	SerialNumber     string    `json:"serialNumber,omitempty"`
+	ServerID         string    `json:"serverId,omitempty"`
+	PreviousServerID string    `json:"previousServerId,omitempty"`
+}
+
+// deviceState is what the watcher remembers about one device.
+type deviceState struct {
+	SerialNumber string `json:"serialNumber,omitempty"`
+	ServerID     string `json:"serverId,omitempty"`
+}
+
+// watchState is the inventory snapshot persisted between polls and runs.
+type watchState struct {
+	Updated time.Time              `json:"updated"`
+	Devices map[string]deviceState `json:"devices"`
+}
+
+// watchOptions configures the watch command.
+type watchOptions struct {
+	interval  time.Duration
+	statePath string
+	webhook   string
+	once      bool
+}
+
+// webhookClient posts events to -webhook.
+var webhookClient = &http.Client{Timeout: 30 * time.Second}
+
+// watchInventory polls the organization's devices and their assigned services
+// every opts.interval, printing added, assigned and unassigned events (and
+// posting them to opts.webhook) as they are found.
+//
+// The snapshot is saved to opts.statePath after each poll, so a later run
+// reports what changed while axmctl was not running. Without a saved state the
+// first poll is a baseline and reports nothing. The state is only saved once
+// the poll's events have been delivered, so a failed webhook post is retried
+// on the next run. The command returns when ctx is cancelled, or after one
+// poll with opts.once.
+func watchInventory(opts watchOptions) command {
+	return func(ctx context.Context, c *axm.Client, out *printer) error {
+		prev, err := loadWatchState(opts.statePath)
+		if err != nil {
+			return err
+		}
+
+		ticker := time.NewTicker(opts.interval)
+		defer ticker.Stop()
+
+		for {
+			next, err := snapshotInventory(ctx, c)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+
+			if prev != nil {
+				events := diffInventory(prev, next)
+				if err := deliverEvents(ctx, out, opts.webhook, events); err != nil {
+					return err
+				}
+			}
+			if err := saveJSON(opts.statePath, next); err != nil {
+				return err
+			}
+			prev = next
+
+			if opts.once {
+				return nil
+			}
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return nil
+			}
+		}
+	}
+}
+
+// snapshotInventory lists every device and the device management service it
+// is assigned to.
+func snapshotInventory(ctx context.Context, c *axm.Client) (*watchState, error) {
+	enriched, err := reports.DevicesWithServers(ctx, c, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &watchState{Updated: time.Now().UTC(), Devices: make(map[string]deviceState, len(enriched))}
+	for _, d := range enriched {
+		ds := deviceState{ServerID: d.ServerID}
+		if d.Attributes != nil {
+			ds.SerialNumber = d.Attributes.SerialNumber
+		}
+		state.Devices[d.ID] = ds
+	}
+	return state, nil
+}
+
+// diffInventory returns the events that turn prev into next, ordered by
+// device ID. Devices that left the organization are not reported.
+func diffInventory(prev, next *watchState) []inventoryEvent {
+	ids := make([]string, 0, len(next.Devices))
+	for id := range next.Devices {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	var events []inventoryEvent
+	for _, id := range ids {
+		cur := next.Devices[id]
+		old, known := prev.Devices[id]
+		ev := inventoryEvent{Time: next.Updated, DeviceID: id, SerialNumber: cur.SerialNumber}
+		if !known {
+			ev.Kind = eventAdded
+			events = append(events, ev)
+		}
+		switch {
+		case cur.ServerID == old.ServerID:
+		case cur.ServerID == "":
+			ev.Kind, ev.ServerID = eventUnassigned, old.ServerID
+			events = append(events, ev)
+		default:
+			ev.Kind, ev.ServerID, ev.PreviousServerID = eventAssigned, cur.ServerID, old.ServerID
+			events = append(events, ev)
+		}
+	}
+	return events
+}
+
+// deliverEvents prints events and posts each one to webhook, if set.
+func deliverEvents(ctx context.Context, out *printer, webhook string, events []inventoryEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	rows := make([][]string, len(events))
+	for i, ev := range events {
+		rows[i] = []string{ev.Time.Format(time.RFC3339), ev.Kind, ev.DeviceID, orDash(ev.SerialNumber),
+			orDash(ev.ServerID), orDash(ev.PreviousServerID)}
+	}
+	if err := out.print(events, []string{"TIME", "EVENT", "DEVICE", "SERIAL", "SERVER", "PREVIOUS"}, rows); err != nil {
+		return err
+	}
+
+	if webhook == "" {
+		return nil
+	}
+	for _, ev := range events {
+		if err := postEvent(ctx, webhook, ev); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// postEvent sends ev to url as a JSON request body.
+func postEvent(ctx context.Context, url string, ev inventoryEvent) error {
+	body, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("webhook: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := webhookClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook: %w", err)
+	}
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook: %s returned %s", url, resp.Status)
+	}
+	return nil
+}
+
+// loadWatchState reads the saved snapshot, returning nil when none exists.
+func loadWatchState(path string) (*watchState, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read state: %w", err)
+	}
+	var state watchState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("parse state %s: %w", path, err)
+	}
+	if state.Devices == nil {
+		state.Devices = make(map[string]deviceState)
+	}
+	return &state, nil
+}
+
+// saveJSON writes v to path as indented JSON, replacing the file atomically
+// so an interrupted write never leaves it truncated.
+func saveJSON(path string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+// Command axmgrpc serves the axm gRPC facade defined in
+// axm/server/proto/axm/v1/axm.proto, so services in other languages can call
+// Apple Business Manager through a sidecar.
+//
+//	go run ./axm/cmd/axmgrpc [-listen addr] [-watch-interval d] [-min-watch-interval d]
+//
+// Credentials are read from the APPLE_KEY_ID, APPLE_ISSUER_ID and
+// APPLE_PRIVATE_KEY_PATH (or APPLE_PRIVATE_KEY_PEM) environment variables by
+// axm.NewClientFromEnv. The server reflection service is registered, so tools
+// such as grpcurl can list and call the methods.
+//
+// The listener is plain TCP without authentication; bind it to localhost or a
+// private network. On SIGINT or SIGTERM the server stops accepting calls,
+// waits up to -shutdown-timeout for running calls, and closes the client.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/server"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/server/axmv1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+func main() {
+	listen := flag.String("listen", "127.0.0.1:50051", "address to serve gRPC on")
+	watchInterval := flag.Duration("watch-interval", server.DefaultWatchInterval, "default WatchDevices poll interval")
+	minWatchInterval := flag.Duration("min-watch-interval", server.DefaultMinWatchInterval, "shortest WatchDevices poll interval a caller may request")
+	shutdownTimeout := flag.Duration("shutdown-timeout", 30*time.Second, "how long to wait for running calls on shutdown")
+	flag.Parse()
+
+	if err := run(*listen, *shutdownTimeout, server.Options{
+		WatchInterval:    *watchInterval,
+		MinWatchInterval: *minWatchInterval,
+		OnWatchError:     func(err error) { log.Printf("axmgrpc: watch: %v", err) },
+	}); err != nil {
+		fmt.Fprintln(os.Stderr, "axmgrpc:", err)
+		os.Exit(1)
+	}
+}
+
+func run(listen string, shutdownTimeout time.Duration, opts server.Options) error {
+	c, err := axm.NewClientFromEnv()
+	if err != nil {
+		return err
+	}
+	lis, err := net.Listen("tcp", listen)
+	if err != nil {
+		c.Close()
+		return err
+	}
+
+	srv := grpc.NewServer()
+	axmv1.RegisterAXMServiceServer(srv, server.New(c, opts))
+	reflection.Register(srv)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	served := make(chan error, 1)
+	go func() { served <- srv.Serve(lis) }()
+	log.Printf("axmgrpc: serving on %s", lis.Addr())
+
+	select {
+	case err := <-served:
+		c.Close()
+		return err
+	case <-ctx.Done():
+	}
+
+	log.Print("axmgrpc: shutting down")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	stopped := make(chan struct{})
+	go func() {
+		srv.GracefulStop()
+		close(stopped)
+	}()
+	// GracefulStop waits for WatchDevices streams too; shutting the client
+	// down ends them while letting unary calls in flight finish.
+	clientErr := c.Shutdown(shutdownCtx)
+	select {
+	case <-stopped:
+	case <-shutdownCtx.Done():
+		srv.Stop()
+	}
+	return clientErr
+}
@@ -0,0 +1,77 @@
+// Command axmload runs the axm load-test scenarios against an in-process mock
+// server and prints a summary, optionally writing the full report as JSON.
+//
+//	go run ./axm/cmd/axmload [-scenario pagination,assignment] [-devices 10000] [-out report.json]
+//
+// Results depend on the machine; compare reports from the same runner.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/loadtest"
+)
+
+func main() {
+	var cfg loadtest.Config
+	scenarios := flag.String("scenario", "", "comma-separated scenarios: pagination, assignment (default both)")
+	flag.IntVar(&cfg.Server.Devices, "devices", 10000, "device inventory size")
+	flag.DurationVar(&cfg.Server.Latency, "latency", 0, "latency added to every response")
+	flag.IntVar(&cfg.Server.ActivityPolls, "activity-polls", 1, "status requests before an activity completes")
+	flag.IntVar(&cfg.PageSize, "page-size", 1000, "device page size")
+	flag.IntVar(&cfg.Iterations, "iterations", 5, "inventory listings in the pagination scenario")
+	flag.IntVar(&cfg.BatchSize, "batch", 100, "devices per assign activity")
+	flag.IntVar(&cfg.Workers, "workers", 8, "concurrent assign activities")
+	flag.DurationVar(&cfg.PollInterval, "poll", 10*time.Millisecond, "activity poll interval")
+	out := flag.String("out", "", "write the report as JSON to this file")
+	flag.Parse()
+
+	if err := run(cfg, *scenarios, *out); err != nil {
+		fmt.Fprintln(os.Stderr, "axmload:", err)
+		os.Exit(1)
+	}
+}
+
+func run(cfg loadtest.Config, scenarios, out string) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	var names []string
+	if scenarios != "" {
+		names = strings.Split(scenarios, ",")
+	}
+	report, err := loadtest.Run(ctx, cfg, names...)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("axm %s, %s %s/%s, %d CPUs\n\n", report.SDKVersion, report.GoVersion, report.GOOS, report.GOARCH, report.CPUs)
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "scenario\tops\tdevices\trequests\tduration\tdevices/s\tp50\tp95\tp99\tB/device\t")
+	for _, r := range report.Results {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%s\t%.0f\t%s\t%s\t%s\t%.0f\t\n",
+			r.Scenario, r.Operations, r.Devices, r.Requests, r.Duration.Round(time.Millisecond), r.DevicesPerSecond,
+			r.Latency.P50.Round(time.Microsecond), r.Latency.P95.Round(time.Microsecond), r.Latency.P99.Round(time.Microsecond),
+			r.AllocBytesPerDevice)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if out == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(out, append(data, '\n'), 0o644)
+}
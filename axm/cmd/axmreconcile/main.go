@@ -0,0 +1,211 @@
+// Command axmreconcile keeps devices assigned to the MDM servers they belong
+// on, as a single long-running process.
+//
+//	go run ./axm/cmd/axmreconcile -config reconcile.json [-once] [-dry-run]
+//
+// The configuration file is JSON:
+//
+//	{
+//	  "schedule": "*/30 * * * *",
+//	  "jitter": "2m",
+//	  "runOnStart": true,
+//	  "maxRetries": 2,
+//	  "reportDir": "/var/lib/axmreconcile",
+//	  "policies": [
+//	    {"name": "kiosks", "mdmServerId": "…", "deviceIdsFile": "kiosks.txt"},
+//	    {"name": "lab", "mdmServerId": "…", "deviceIds": ["C02…"], "prune": true}
+//	  ]
+//	}
+//
+// schedule takes the forms accepted by reconcile.ParseSchedule and defaults
+// to "@every 30m". deviceIdsFile lists one serial number per line; blank
+// lines and lines starting with # are skipped, and a relative path is read
+// from the configuration file's directory. With reportDir, each run's report
+// is saved there as JSON.
+//
+// Credentials are read from the APPLE_KEY_ID, APPLE_ISSUER_ID and
+// APPLE_PRIVATE_KEY_PATH (or APPLE_PRIVATE_KEY_PEM) environment variables by
+// axm.NewClientFromEnv. With -once, it reconciles once and exits with status
+// 1 if any policy failed; otherwise it runs until SIGINT or SIGTERM.
+package main
+
+import (
+	"bufio"
+	"cmp"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devicemanagement"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/reconcile"
+)
+
+// config is the configuration file.
+type config struct {
+	Schedule   string         `json:"schedule"`
+	Jitter     string         `json:"jitter"`
+	RunOnStart bool           `json:"runOnStart"`
+	DryRun     bool           `json:"dryRun"`
+	MaxRetries int            `json:"maxRetries"`
+	ReportDir  string         `json:"reportDir"`
+	Policies   []policyConfig `json:"policies"`
+}
+
+type policyConfig struct {
+	reconcile.Policy
+	DeviceIDsFile string `json:"deviceIdsFile"`
+}
+
+func main() {
+	configPath := flag.String("config", "reconcile.json", "configuration file")
+	once := flag.Bool("once", false, "reconcile once and exit")
+	dryRun := flag.Bool("dry-run", false, "report what would change without changing it")
+	flag.Parse()
+
+	if err := run(*configPath, *once, *dryRun); err != nil {
+		fmt.Fprintln(os.Stderr, "axmreconcile:", err)
+		os.Exit(1)
+	}
+}
+
+func run(configPath string, once, dryRun bool) error {
+	cfg, policies, err := loadConfig(configPath)
+	if err != nil {
+		return err
+	}
+	cfg.Schedule = cmp.Or(cfg.Schedule, "@every 30m")
+	schedule, err := reconcile.ParseSchedule(cfg.Schedule)
+	if err != nil {
+		return err
+	}
+	var jitter time.Duration
+	if cfg.Jitter != "" {
+		if jitter, err = time.ParseDuration(cfg.Jitter); err != nil {
+			return fmt.Errorf("jitter: %w", err)
+		}
+	}
+
+	c, err := axm.NewClientFromEnv()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	engine, err := reconcile.NewEngine(c, policies, reconcile.Options{
+		DryRun: cfg.DryRun || dryRun,
+		Retry:  &devicemanagement.AssignmentRetryOptions{MaxRetries: cfg.MaxRetries},
+	})
+	if err != nil {
+		return err
+	}
+
+	hooks := []reconcile.Hook{reconcile.HookFuncs{Finished: logReport}}
+	if cfg.ReportDir != "" {
+		hooks = append(hooks, reconcile.NewReportDirHook(cfg.ReportDir, func(err error) {
+			log.Printf("axmreconcile: %v", err)
+		}))
+	}
+	runner := reconcile.NewRunner(engine, reconcile.RunnerOptions{
+		Schedule:   schedule,
+		Jitter:     jitter,
+		RunOnStart: cfg.RunOnStart,
+		Hooks:      hooks,
+	})
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	if once {
+		report, err := runner.RunOnce(ctx, time.Now())
+		if err != nil {
+			return err
+		}
+		return report.Err()
+	}
+	log.Printf("axmreconcile: %d policies, schedule %q", len(policies), cfg.Schedule)
+	return runner.Run(ctx)
+}
+
+// logReport logs a one-line summary of each policy in a finished run.
+func logReport(_ context.Context, report *reconcile.Report, err error) {
+	mode := ""
+	if report.DryRun {
+		mode = " (dry run)"
+	}
+	for _, p := range report.Policies {
+		switch {
+		case p.Error != "":
+			log.Printf("axmreconcile: run %d%s: %s: %s", report.Run, mode, p.Name, p.Error)
+		case p.InSync():
+			log.Printf("axmreconcile: run %d%s: %s: in sync (%d devices)", report.Run, mode, p.Name, p.Expected)
+		default:
+			log.Printf("axmreconcile: run %d%s: %s: %d missing, %d extra", report.Run, mode, p.Name, len(p.Missing), len(p.Extra))
+			if err := p.Err(); err != nil {
+				log.Printf("axmreconcile: run %d: %s: %v", report.Run, p.Name, err)
+			}
+		}
+	}
+	if err != nil {
+		log.Printf("axmreconcile: run %d stopped: %v", report.Run, err)
+	}
+}
+
+// loadConfig reads the configuration file and the device lists it names.
+func loadConfig(path string) (*config, []reconcile.Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	var cfg config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+
+	policies := make([]reconcile.Policy, len(cfg.Policies))
+	for i, p := range cfg.Policies {
+		if p.DeviceIDsFile != "" {
+			file := p.DeviceIDsFile
+			if !filepath.IsAbs(file) {
+				file = filepath.Join(filepath.Dir(path), file)
+			}
+			ids, err := readDeviceIDs(file)
+			if err != nil {
+				return nil, nil, err
+			}
+			p.DeviceIDs = append(p.DeviceIDs, ids...)
+		}
+		policies[i] = p.Policy
+	}
+	return &cfg, policies, nil
+}
+
+// readDeviceIDs reads one serial number per line, skipping blank lines and
+// lines starting with #.
+func readDeviceIDs(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var ids []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		ids = append(ids, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+	return ids, nil
+}
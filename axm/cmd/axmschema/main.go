@@ -0,0 +1,56 @@
+// Command axmschema writes an OpenAPI 3.1 or JSON Schema document describing
+// the axm request and response models, with descriptions taken from the doc
+// comments in the model sources.
+//
+//	go run ./axm/cmd/axmschema [-format openapi|jsonschema] [-src axm/axm_api] [-out file]
+//
+// The OpenAPI document is checked in as axm/schema/openapi.json and is
+// regenerated by go generate in that directory.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/schema"
+)
+
+func main() {
+	format := flag.String("format", "openapi", "document format: openapi or jsonschema")
+	src := flag.String("src", filepath.Join("axm", "axm_api"), "model source directory, for descriptions")
+	out := flag.String("out", "", "output file (default standard output)")
+	flag.Parse()
+
+	if err := run(*format, *src, *out); err != nil {
+		fmt.Fprintln(os.Stderr, "axmschema:", err)
+		os.Exit(1)
+	}
+}
+
+func run(format, src, out string) error {
+	comments, err := schema.ParseComments(src)
+	if err != nil {
+		return err
+	}
+	opts := schema.Options{Comments: comments}
+
+	var doc []byte
+	switch format {
+	case "openapi":
+		doc, err = schema.OpenAPI(opts)
+	case "jsonschema":
+		doc, err = schema.JSONSchema(opts)
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+	if err != nil {
+		return err
+	}
+	if out == "" {
+		_, err = os.Stdout.Write(doc)
+		return err
+	}
+	return os.WriteFile(out, doc, 0o644)
+}
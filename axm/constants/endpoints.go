@@ -3,6 +3,10 @@ package constants
 // API base URL
 const (
 	DefaultBaseURL = "https://api-business.apple.com"
+
+	// SchoolBaseURL is the Apple School Manager API host. School-only resources
+	// such as classes are served from this host with the school.api scope.
+	SchoolBaseURL = "https://api-school.apple.com"
 )
 
 // API version prefix
@@ -23,4 +27,7 @@ const (
 	EndpointPackages            = APIVersionV1 + "/packages"
 	EndpointConfigurations      = APIVersionV1 + "/configurations"
 	EndpointBlueprints          = APIVersionV1 + "/blueprints"
+	EndpointClasses             = APIVersionV1 + "/classes"
+	EndpointStudents            = APIVersionV1 + "/students"
+	EndpointInstructors         = APIVersionV1 + "/instructors"
 )
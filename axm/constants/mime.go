@@ -3,4 +3,5 @@ package constants
 // MIME type constants for HTTP headers
 const (
 	ApplicationJSON = "application/json"
+	TextCSV         = "text/csv"
 )
@@ -0,0 +1,503 @@
+package contract
+
+import (
+	"context"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/auditevents"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/blueprints"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/configurations"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devicemanagement"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/client"
+)
+
+var cases = []contractCase{
+	// ====== apps ======
+	{
+		method:   "Apps.GetV1",
+		request:  "GET /v1/apps",
+		fixtures: []string{"apps/mocks/validate_get_apps.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.Apps.GetV1(ctx, nil))
+		},
+	},
+	{
+		method:   "Apps.GetByAppIDV1",
+		request:  "GET /v1/apps/APP1",
+		fixtures: []string{"apps/mocks/validate_get_app_information.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.Apps.GetByAppIDV1(ctx, "APP1", nil))
+		},
+	},
+
+	// ====== auditevents ======
+	{
+		method:   "AuditEvents.GetV1",
+		request:  "GET /v1/auditEvents",
+		fixtures: []string{"auditevents/mocks/validate_get_audit_events.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.AuditEvents.GetV1(ctx, &auditevents.RequestQueryOptions{
+				FilterStartTimestamp: "2025-01-01T00:00:00Z",
+				FilterEndTimestamp:   "2025-02-01T00:00:00Z",
+			}))
+		},
+	},
+
+	// ====== blueprints ======
+	{
+		method:   "Blueprints.CreateV1",
+		request:  "POST /v1/blueprints",
+		fixtures: []string{"blueprints/mocks/validate_create_blueprint_response.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			req := &blueprints.BlueprintCreateRequest{}
+			req.Data.Attributes.Name = "Contract"
+			return result(api.Blueprints.CreateV1(ctx, req))
+		},
+	},
+	{
+		method:   "Blueprints.GetByBlueprintIDV1",
+		request:  "GET /v1/blueprints/BP1",
+		fixtures: []string{"blueprints/mocks/validate_get_blueprint_information.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.Blueprints.GetByBlueprintIDV1(ctx, "BP1", nil))
+		},
+	},
+	{
+		method:   "Blueprints.UpdateByBlueprintIDV1",
+		request:  "PATCH /v1/blueprints/BP1",
+		fixtures: []string{"blueprints/mocks/validate_update_blueprint_response.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.Blueprints.UpdateByBlueprintIDV1(ctx, "BP1", &blueprints.BlueprintUpdateRequest{}))
+		},
+	},
+	{
+		method:   "Blueprints.GetAppIDsByBlueprintIDV1",
+		request:  "GET /v1/blueprints/BP1/relationships/apps",
+		fixtures: []string{"blueprints/mocks/validate_get_blueprint_app_ids.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.Blueprints.GetAppIDsByBlueprintIDV1(ctx, "BP1", nil))
+		},
+	},
+	{
+		method:   "Blueprints.GetConfigurationIDsByBlueprintIDV1",
+		request:  "GET /v1/blueprints/BP1/relationships/configurations",
+		fixtures: []string{"blueprints/mocks/validate_get_blueprint_configuration_ids.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.Blueprints.GetConfigurationIDsByBlueprintIDV1(ctx, "BP1", nil))
+		},
+	},
+	{
+		method:   "Blueprints.GetPackageIDsByBlueprintIDV1",
+		request:  "GET /v1/blueprints/BP1/relationships/packages",
+		fixtures: []string{"blueprints/mocks/validate_get_blueprint_package_ids.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.Blueprints.GetPackageIDsByBlueprintIDV1(ctx, "BP1", nil))
+		},
+	},
+	{
+		method:   "Blueprints.GetDeviceIDsByBlueprintIDV1",
+		request:  "GET /v1/blueprints/BP1/relationships/orgDevices",
+		fixtures: []string{"blueprints/mocks/validate_get_blueprint_device_ids.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.Blueprints.GetDeviceIDsByBlueprintIDV1(ctx, "BP1", nil))
+		},
+	},
+	{
+		method:   "Blueprints.GetUserIDsByBlueprintIDV1",
+		request:  "GET /v1/blueprints/BP1/relationships/users",
+		fixtures: []string{"blueprints/mocks/validate_get_blueprint_user_ids.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.Blueprints.GetUserIDsByBlueprintIDV1(ctx, "BP1", nil))
+		},
+	},
+	{
+		method:   "Blueprints.GetUserGroupIDsByBlueprintIDV1",
+		request:  "GET /v1/blueprints/BP1/relationships/userGroups",
+		fixtures: []string{"blueprints/mocks/validate_get_blueprint_user_group_ids.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.Blueprints.GetUserGroupIDsByBlueprintIDV1(ctx, "BP1", nil))
+		},
+	},
+
+	// ====== classes ======
+	{
+		method:   "Classes.GetV1",
+		request:  "GET /v1/classes",
+		fixtures: []string{"classes/mocks/validate_get_classes.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.Classes.GetV1(ctx, nil))
+		},
+	},
+	{
+		method:   "Classes.GetByClassIDV1",
+		request:  "GET /v1/classes/CLS1",
+		fixtures: []string{"classes/mocks/validate_get_class_information.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.Classes.GetByClassIDV1(ctx, "CLS1", nil))
+		},
+	},
+	{
+		method:   "Classes.GetStudentIDsByClassIDV1",
+		request:  "GET /v1/classes/CLS1/relationships/students",
+		fixtures: []string{"classes/mocks/validate_get_class_students.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.Classes.GetStudentIDsByClassIDV1(ctx, "CLS1", nil))
+		},
+	},
+	{
+		method:   "Classes.GetInstructorIDsByClassIDV1",
+		request:  "GET /v1/classes/CLS1/relationships/instructors",
+		fixtures: []string{"classes/mocks/validate_get_class_instructors.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.Classes.GetInstructorIDsByClassIDV1(ctx, "CLS1", nil))
+		},
+	},
+
+	// ====== configurations ======
+	{
+		method:   "Configurations.GetV1",
+		request:  "GET /v1/configurations",
+		fixtures: []string{"configurations/mocks/validate_get_configurations.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.Configurations.GetV1(ctx, nil))
+		},
+	},
+	{
+		method:   "Configurations.GetByConfigurationIDV1",
+		request:  "GET /v1/configurations/CFG1",
+		fixtures: []string{"configurations/mocks/validate_get_configuration_information.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.Configurations.GetByConfigurationIDV1(ctx, "CFG1", nil))
+		},
+	},
+	{
+		method:   "Configurations.CreateV1",
+		request:  "POST /v1/configurations",
+		fixtures: []string{"configurations/mocks/validate_create_configuration_response.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			req := &configurations.ConfigurationCreateRequest{}
+			req.Data.Attributes.CustomSettingsValues.ConfigurationProfile = "<plist/>"
+			return result(api.Configurations.CreateV1(ctx, req))
+		},
+	},
+	{
+		method:   "Configurations.UpdateByConfigurationIDV1",
+		request:  "PATCH /v1/configurations/CFG1",
+		fixtures: []string{"configurations/mocks/validate_update_configuration_response.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.Configurations.UpdateByConfigurationIDV1(ctx, "CFG1", &configurations.ConfigurationUpdateRequest{}))
+		},
+	},
+
+	// ====== devicemanagement ======
+	{
+		method:   "DeviceManagement.GetV1",
+		request:  "GET /v1/mdmServers",
+		fixtures: []string{"devicemanagement/mocks/validate_get_device_management_services.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.DeviceManagement.GetV1(ctx, nil))
+		},
+	},
+	{
+		method:   "DeviceManagement.GetByMDMServerIDV1",
+		request:  "GET /v1/mdmServers/SRV1",
+		fixtures: []string{"devicemanagement/mocks/validate_get_mdm_server.json"},
+		unmodelled: []string{
+			"data.links",
+		},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.DeviceManagement.GetByMDMServerIDV1(ctx, "SRV1", nil))
+		},
+	},
+	{
+		method:   "DeviceManagement.CreateMDMServerV1",
+		request:  "POST /v1/mdmServers",
+		fixtures: []string{"devicemanagement/mocks/validate_create_mdm_server_response.json"},
+		unmodelled: []string{
+			"data.links",
+		},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			req := &devicemanagement.MDMServerCreateRequest{}
+			req.Data.Attributes.ServerName = "Contract"
+			req.Data.Attributes.ServerCertificate = devicemanagement.MDMServerCertificate{Name: "server.pem", Data: "MII="}
+			return result(api.DeviceManagement.CreateMDMServerV1(ctx, req))
+		},
+	},
+	{
+		method:   "DeviceManagement.UpdateMDMServerByIDV1",
+		request:  "PATCH /v1/mdmServers/SRV1",
+		fixtures: []string{"devicemanagement/mocks/validate_update_mdm_server_response.json"},
+		unmodelled: []string{
+			"data.links",
+		},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.DeviceManagement.UpdateMDMServerByIDV1(ctx, "SRV1", &devicemanagement.MDMServerUpdateRequest{}))
+		},
+	},
+	{
+		method:   "DeviceManagement.GetDeviceSerialNumbersByServerIDV1",
+		request:  "GET /v1/mdmServers/SRV1/relationships/devices",
+		fixtures: []string{"devicemanagement/mocks/validate_get_mdm_server_device_linkages.json"},
+		unmodelled: []string{
+			"links.related",
+		},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.DeviceManagement.GetDeviceSerialNumbersByServerIDV1(ctx, "SRV1", nil))
+		},
+	},
+	{
+		method:   "DeviceManagement.GetAssignedServerIDByDeviceIDV1",
+		request:  "GET /v1/orgDevices/DEV1/relationships/assignedServer",
+		fixtures: []string{"devicemanagement/mocks/validate_get_assigned_server_linkage.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.DeviceManagement.GetAssignedServerIDByDeviceIDV1(ctx, "DEV1"))
+		},
+	},
+	{
+		method:   "DeviceManagement.GetAssignedServerInfoByDeviceIDV1",
+		request:  "GET /v1/orgDevices/DEV1/assignedServer",
+		fixtures: []string{"devicemanagement/mocks/validate_get_assigned_server_info.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.DeviceManagement.GetAssignedServerInfoByDeviceIDV1(ctx, "DEV1", nil))
+		},
+	},
+	{
+		method:   "DeviceManagement.AssignDevicesV1",
+		request:  "POST /v1/orgDeviceActivities",
+		fixtures: []string{"devicemanagement/mocks/validate_assign_devices_response.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.DeviceManagement.AssignDevicesV1(ctx, "SRV1", []string{"DEV1"}))
+		},
+	},
+	{
+		method:   "DeviceManagement.UnassignDevicesV1",
+		request:  "POST /v1/orgDeviceActivities",
+		fixtures: []string{"devicemanagement/mocks/validate_unassign_devices_response.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.DeviceManagement.UnassignDevicesV1(ctx, "SRV1", []string{"DEV1"}))
+		},
+	},
+	{
+		method:   "DeviceManagement.GetActivityByIDV1",
+		request:  "GET /v1/orgDeviceActivities/ACT1",
+		fixtures: []string{"devicemanagement/mocks/validate_get_org_device_activity.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.DeviceManagement.GetActivityByIDV1(ctx, "ACT1"))
+		},
+	},
+
+	// ====== devices ======
+	{
+		method:   "Devices.GetV1",
+		request:  "GET /v1/orgDevices",
+		fixtures: []string{"devices/mocks/validate_get_organization_devices.json"},
+		unmodelled: []string{
+			"data[].links",
+			"data[].relationships",
+		},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.Devices.GetV1(ctx, nil))
+		},
+	},
+	{
+		method:   "Devices.GetUpdatedSinceV1",
+		request:  "GET /v1/orgDevices",
+		fixtures: []string{"devices/mocks/validate_get_organization_devices.json"},
+		unmodelled: []string{
+			"data[].links",
+			"data[].relationships",
+		},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			// Every fixture device was updated after since, so none is
+			// filtered out.
+			return result(api.Devices.GetUpdatedSinceV1(ctx, time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), nil))
+		},
+	},
+	{
+		method:   "Devices.GetIfModifiedV1",
+		request:  "GET /v1/orgDevices",
+		fixtures: []string{"devices/mocks/validate_get_organization_devices.json"},
+		unmodelled: []string{
+			"data[].links",
+			"data[].relationships",
+		},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.Devices.GetIfModifiedV1(ctx, client.SyncState{}, nil))
+		},
+	},
+	{
+		method:   "Devices.GetPageV1",
+		request:  "GET /v1/orgDevices",
+		fixtures: []string{"devices/mocks/validate_get_organization_devices.json"},
+		unmodelled: []string{
+			"data[].links",
+			"data[].relationships",
+		},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.Devices.GetPageV1(ctx, "", nil))
+		},
+	},
+	{
+		method:   "Devices.GetByDeviceIDV1",
+		request:  "GET /v1/orgDevices/DEV1",
+		fixtures: []string{"devices/mocks/validate_get_device_information.json"},
+		unmodelled: []string{
+			"data.links",
+			"data.relationships",
+			"links",
+		},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.Devices.GetByDeviceIDV1(ctx, "DEV1", nil))
+		},
+	},
+	{
+		method:   "Devices.GetAppleCareByDeviceIDV1",
+		request:  "GET /v1/orgDevices/DEV1/appleCareCoverage",
+		fixtures: []string{"devices/mocks/validate_get_applecare_coverage.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.Devices.GetAppleCareByDeviceIDV1(ctx, "DEV1", nil))
+		},
+	},
+
+	// ====== organizationalunits ======
+	{
+		method:   "OrganizationalUnits.GetV1",
+		request:  "GET /v1/organizationalUnits",
+		fixtures: []string{"organizationalunits/mocks/validate_get_organizational_units.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.OrganizationalUnits.GetV1(ctx, nil))
+		},
+	},
+	{
+		method:   "OrganizationalUnits.GetByOrganizationalUnitIDV1",
+		request:  "GET /v1/organizationalUnits/OU1",
+		fixtures: []string{"organizationalunits/mocks/validate_get_organizational_unit_information.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.OrganizationalUnits.GetByOrganizationalUnitIDV1(ctx, "OU1", nil))
+		},
+	},
+	{
+		method:   "OrganizationalUnits.GetUserIDsByOrganizationalUnitIDV1",
+		request:  "GET /v1/organizationalUnits/OU1/relationships/users",
+		fixtures: []string{"organizationalunits/mocks/validate_get_organizational_unit_user_ids.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.OrganizationalUnits.GetUserIDsByOrganizationalUnitIDV1(ctx, "OU1", nil))
+		},
+	},
+
+	// ====== packages ======
+	{
+		method:   "Packages.GetV1",
+		request:  "GET /v1/packages",
+		fixtures: []string{"packages/mocks/validate_get_packages.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.Packages.GetV1(ctx, nil))
+		},
+	},
+	{
+		method:   "Packages.GetByPackageIDV1",
+		request:  "GET /v1/packages/PKG1",
+		fixtures: []string{"packages/mocks/validate_get_package_information.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.Packages.GetByPackageIDV1(ctx, "PKG1", nil))
+		},
+	},
+
+	// ====== people ======
+	{
+		method:  "People.GetStudentsV1",
+		request: "GET /v1/students",
+		fixtures: []string{
+			"people/mocks/validate_get_students_page1.json",
+			"people/mocks/validate_get_students_page2.json",
+		},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.People.GetStudentsV1(ctx, nil))
+		},
+	},
+	{
+		method:   "People.GetStudentByIDV1",
+		request:  "GET /v1/students/STU1",
+		fixtures: []string{"people/mocks/validate_get_student_information.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.People.GetStudentByIDV1(ctx, "STU1", nil))
+		},
+	},
+	{
+		method:   "People.GetClassIDsByStudentIDV1",
+		request:  "GET /v1/students/STU1/relationships/classes",
+		fixtures: []string{"people/mocks/validate_get_person_classes.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.People.GetClassIDsByStudentIDV1(ctx, "STU1", nil))
+		},
+	},
+	{
+		method:   "People.GetInstructorsV1",
+		request:  "GET /v1/instructors",
+		fixtures: []string{"people/mocks/validate_get_instructors.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.People.GetInstructorsV1(ctx, nil))
+		},
+	},
+	{
+		method:   "People.GetInstructorByIDV1",
+		request:  "GET /v1/instructors/INS1",
+		fixtures: []string{"people/mocks/validate_get_instructor_information.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.People.GetInstructorByIDV1(ctx, "INS1", nil))
+		},
+	},
+	{
+		method:   "People.GetClassIDsByInstructorIDV1",
+		request:  "GET /v1/instructors/INS1/relationships/classes",
+		fixtures: []string{"people/mocks/validate_get_person_classes.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.People.GetClassIDsByInstructorIDV1(ctx, "INS1", nil))
+		},
+	},
+
+	// ====== usergroups ======
+	{
+		method:   "UserGroups.GetV1",
+		request:  "GET /v1/userGroups",
+		fixtures: []string{"usergroups/mocks/validate_get_user_groups.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.UserGroups.GetV1(ctx, nil))
+		},
+	},
+	{
+		method:   "UserGroups.GetByUserGroupIDV1",
+		request:  "GET /v1/userGroups/GRP1",
+		fixtures: []string{"usergroups/mocks/validate_get_user_group_information.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.UserGroups.GetByUserGroupIDV1(ctx, "GRP1", nil))
+		},
+	},
+	{
+		method:   "UserGroups.GetUserIDsByGroupIDV1",
+		request:  "GET /v1/userGroups/GRP1/relationships/users",
+		fixtures: []string{"usergroups/mocks/validate_get_user_group_user_ids.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.UserGroups.GetUserIDsByGroupIDV1(ctx, "GRP1", nil))
+		},
+	},
+
+	// ====== users ======
+	{
+		method:   "Users.GetV1",
+		request:  "GET /v1/users",
+		fixtures: []string{"users/mocks/validate_get_users.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.Users.GetV1(ctx, nil))
+		},
+	},
+	{
+		method:   "Users.GetByUserIDV1",
+		request:  "GET /v1/users/USR1",
+		fixtures: []string{"users/mocks/validate_get_user_information.json"},
+		call: func(ctx context.Context, api *axm.AXMAPIClient) (any, error) {
+			return result(api.Users.GetByUserIDV1(ctx, "USR1", nil))
+		},
+	},
+}
@@ -0,0 +1,324 @@
+package contract
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/client"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"resty.dev/v3"
+)
+
+const baseURL = "https://api-business.apple.com"
+
+// contractCase replays fixtures through one service method.
+type contractCase struct {
+	// method is the AXMAPIClient field and method name, such as
+	// "Devices.GetV1".
+	method string
+
+	// request is the method and path the call must send, such as
+	// "GET /v1/orgDevices".
+	request string
+
+	// fixtures are the recorded responses, relative to axm/axm_api. Each
+	// fixture after the first is served at the links.next URL of the one
+	// before it.
+	fixtures []string
+
+	// unmodelled lists the JSON paths in the fixtures that the result type
+	// does not decode, such as "data[].relationships". Array elements are
+	// written as [].
+	unmodelled []string
+
+	call func(ctx context.Context, api *axm.AXMAPIClient) (any, error)
+}
+
+// result adapts a service method's return values for contractCase.call.
+func result[T any](v *T, _ *resty.Response, err error) (any, error) {
+	return v, err
+}
+
+type noAuth struct{}
+
+func (noAuth) ApplyAuth(*resty.Request) error { return nil }
+
+func jsonResponder(body []byte) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		resp := httpmock.NewBytesResponse(http.StatusOK, body)
+		resp.Header.Set("Content-Type", "application/json")
+		return resp, nil
+	}
+}
+
+func TestContracts(t *testing.T) {
+	for _, tc := range cases {
+		t.Run(tc.method, func(t *testing.T) {
+			runCase(t, tc)
+		})
+	}
+}
+
+func runCase(t *testing.T, tc contractCase) {
+	method, path, ok := strings.Cut(tc.request, " ")
+	require.True(t, ok, "request %q is not METHOD /path", tc.request)
+
+	pages := make([][]byte, len(tc.fixtures))
+	mt := httpmock.NewMockTransport()
+	for i, name := range tc.fixtures {
+		data, err := os.ReadFile(filepath.Join("..", "axm_api", name))
+		require.NoError(t, err)
+		pages[i] = data
+
+		pageURL := baseURL + path
+		if i > 0 {
+			pageURL = nextLink(t, pages[i-1])
+		}
+		mt.RegisterResponder(method, pageURL, jsonResponder(data))
+	}
+
+	c, err := axm.NewClient("key-id", "issuer-id", "unused",
+		client.WithAuth(noAuth{}),
+		axm.WithTransport(mt),
+		axm.WithRetryCount(0),
+	)
+	require.NoError(t, err)
+	got, err := tc.call(context.Background(), c.AXMAPI)
+	require.NoError(t, err, "%s must decode %v", tc.method, tc.fixtures)
+	require.Equal(t, len(pages), mt.GetTotalCallCount(), "every fixture page must be requested once")
+
+	resultType := reflect.TypeOf(got).Elem()
+	unmodelled := make(map[string]bool)
+	var decoded []reflect.Value
+	for i, page := range pages {
+		v := reflect.New(resultType)
+		require.NoError(t, json.Unmarshal(page, v.Interface()), tc.fixtures[i])
+		decoded = append(decoded, v)
+
+		checkFidelity(t, tc.fixtures[i], page, v.Interface())
+		collectUnmodelled(unmodelled, "", decodeAny(t, page), resultType)
+	}
+
+	// Paginated methods concatenate data and keep the last page's other
+	// fields.
+	want := decoded[len(decoded)-1]
+	if len(decoded) > 1 {
+		data := want.Elem().FieldByName("Data")
+		all := reflect.MakeSlice(data.Type(), 0, 0)
+		for _, v := range decoded {
+			all = reflect.AppendSlice(all, v.Elem().FieldByName("Data"))
+		}
+		data.Set(all)
+	}
+	assert.Equal(t, want.Interface(), got, "result must equal the fixtures decoded directly")
+
+	for _, p := range slices.Sorted(maps.Keys(unmodelled)) {
+		assert.True(t, slices.Contains(tc.unmodelled, p), "fixture field %s is not decoded by %s; model it or list it as unmodelled", p, resultType)
+	}
+	for _, p := range tc.unmodelled {
+		assert.True(t, unmodelled[p], "%s is listed as unmodelled but is decoded or absent; take it off the list", p)
+	}
+}
+
+// nextLink returns the links.next URL of a page.
+func nextLink(t *testing.T, page []byte) string {
+	t.Helper()
+	var body struct {
+		Links struct {
+			Next string `json:"next"`
+		} `json:"links"`
+	}
+	require.NoError(t, json.Unmarshal(page, &body))
+	require.NotEmpty(t, body.Links.Next, "a fixture followed by another page must have links.next")
+	_, err := url.Parse(body.Links.Next)
+	require.NoError(t, err)
+	return body.Links.Next
+}
+
+func decodeAny(t *testing.T, data []byte) any {
+	t.Helper()
+	var v any
+	require.NoError(t, json.Unmarshal(data, &v))
+	return v
+}
+
+// checkFidelity encodes model and checks that every value in the encoding is
+// present, unchanged, in fixture.
+func checkFidelity(t *testing.T, name string, fixture []byte, model any) {
+	t.Helper()
+	encoded, err := json.Marshal(model)
+	require.NoError(t, err)
+	for _, diff := range compare("", decodeAny(t, encoded), decodeAny(t, fixture)) {
+		t.Errorf("%s: %s", name, diff)
+	}
+}
+
+// compare returns where enc, the model's encoding, differs from raw, the
+// fixture. Fields raw has and enc lacks are not differences.
+func compare(path string, enc, raw any) []string {
+	switch e := enc.(type) {
+	case map[string]any:
+		r, ok := raw.(map[string]any)
+		if !ok {
+			return []string{fmt.Sprintf("%s: model encodes an object, fixture has %T", displayPath(path), raw)}
+		}
+		var diffs []string
+		for k, ev := range e {
+			rv, ok := r[k]
+			if !ok {
+				diffs = append(diffs, fmt.Sprintf("%s: model encodes a value the fixture does not have", displayPath(join(path, k))))
+				continue
+			}
+			diffs = append(diffs, compare(join(path, k), ev, rv)...)
+		}
+		return diffs
+	case []any:
+		r, ok := raw.([]any)
+		if !ok || len(r) != len(e) {
+			return []string{fmt.Sprintf("%s: model encodes %d elements, fixture has %v", displayPath(path), len(e), raw)}
+		}
+		var diffs []string
+		for i := range e {
+			diffs = append(diffs, compare(path+"[]", e[i], r[i])...)
+		}
+		return diffs
+	}
+	if reflect.DeepEqual(enc, raw) || sameInstant(enc, raw) {
+		return nil
+	}
+	return []string{fmt.Sprintf("%s: model encodes %v, fixture has %v", displayPath(path), enc, raw)}
+}
+
+// sameInstant reports whether a and b are RFC 3339 timestamps of the same
+// instant, which time.Time may format differently from Apple.
+func sameInstant(a, b any) bool {
+	as, ok1 := a.(string)
+	bs, ok2 := b.(string)
+	if !ok1 || !ok2 {
+		return false
+	}
+	at, err1 := time.Parse(time.RFC3339Nano, as)
+	bt, err2 := time.Parse(time.RFC3339Nano, bs)
+	return err1 == nil && err2 == nil && at.Equal(bt)
+}
+
+// collectUnmodelled adds to out the path of each object field in raw that
+// type t has no field for.
+func collectUnmodelled(out map[string]bool, path string, raw any, t reflect.Type) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch r := raw.(type) {
+	case map[string]any:
+		switch t.Kind() {
+		case reflect.Struct:
+			for k, v := range r {
+				ft, ok := jsonField(t, k)
+				if !ok {
+					out[join(path, k)] = true
+					continue
+				}
+				collectUnmodelled(out, join(path, k), v, ft)
+			}
+		case reflect.Map:
+			for k, v := range r {
+				collectUnmodelled(out, join(path, k), v, t.Elem())
+			}
+		}
+	case []any:
+		if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+			for _, v := range r {
+				collectUnmodelled(out, path+"[]", v, t.Elem())
+			}
+		}
+	}
+}
+
+// jsonField returns the type of the field of struct t that encoding/json
+// decodes key into.
+func jsonField(t reflect.Type, key string) (reflect.Type, bool) {
+	var folded reflect.Type
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		switch name {
+		case "-":
+			continue
+		case "":
+			name = f.Name
+		}
+		if name == key {
+			return f.Type, true
+		}
+		if folded == nil && strings.EqualFold(name, key) {
+			folded = f.Type
+		}
+	}
+	return folded, folded != nil
+}
+
+func join(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func displayPath(path string) string {
+	if path == "" {
+		return "(root)"
+	}
+	return path
+}
+
+// exempt lists decoding methods that have no contract case, and why.
+var exempt = map[string]string{
+	"DeviceManagement.GetActivityReportV1": "downloads a CSV report rather than JSON; covered by activity_report_test.go",
+}
+
+// TestCoverage checks that every service method returning a decoded model has
+// a contract case.
+func TestCoverage(t *testing.T) {
+	covered := make(map[string]bool)
+	for _, tc := range cases {
+		covered[tc.method] = true
+	}
+
+	responseType := reflect.TypeFor[*resty.Response]()
+	decoding := make(map[string]bool)
+	api := reflect.TypeFor[axm.AXMAPIClient]()
+	for i := range api.NumField() {
+		service := api.Field(i)
+		for j := range service.Type.NumMethod() {
+			m := service.Type.Method(j).Type
+			if m.NumOut() != 3 || m.Out(0).Kind() != reflect.Pointer || m.Out(1) != responseType {
+				continue
+			}
+			name := service.Name + "." + service.Type.Method(j).Name
+			decoding[name] = true
+			if _, ok := exempt[name]; !ok {
+				assert.True(t, covered[name], "%s decodes a response but has no contract case", name)
+			}
+		}
+	}
+	for name := range covered {
+		assert.True(t, decoding[name], "case %s does not name a decoding service method", name)
+	}
+}
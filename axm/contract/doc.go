@@ -0,0 +1,22 @@
+// Package contract holds the SDK's contract tests, which replay recorded Apple
+// API responses through every service method that decodes a model and check
+// that the models still describe those payloads. It has no non-test code.
+//
+// Each case in cases_test.go names a service method, the request it is
+// expected to send, and the fixture files that are served in reply. The
+// fixtures are the recorded responses kept in each service's mocks directory.
+// For every case the suite checks that:
+//
+//   - the method sends the expected request and decodes the reply without
+//     error;
+//   - the result equals the fixture decoded directly into the result type, so
+//     the method neither drops nor invents data while handling pages;
+//   - every value the model encodes back to JSON is present, unchanged, in the
+//     fixture;
+//   - every field in the fixture is modelled, apart from those the case lists
+//     as unmodelled, and every listed field is in fact unmodelled.
+//
+// A struct field that is removed or renamed therefore fails the suite, and a
+// newly modelled field must be taken off its case's list. TestCoverage fails
+// when a service gains a decoding method without a case.
+package contract
@@ -0,0 +1,226 @@
+// Package inventorysync streams the Apple Business Manager inventory into a
+// store of your own, such as a database, CMDB or SaaS tool, through a Sink.
+package inventorysync
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devicemanagement"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devices"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/reports"
+)
+
+// DefaultInterval is how often Run syncs when Options.Interval is not set.
+const DefaultInterval = 15 * time.Minute
+
+// Sink receives inventory changes from an Engine. Implement it to keep your
+// own store in step with Apple Business Manager.
+//
+// Every method must be idempotent: after a failed pass, the next pass sends
+// the same changes again. An Engine never calls a Sink concurrently.
+type Sink interface {
+	// UpsertDevice creates or replaces a device, joined with the device
+	// management service it is assigned to.
+	UpsertDevice(ctx context.Context, device reports.DeviceWithServer) error
+
+	// UpsertServer creates or replaces a device management service.
+	UpsertServer(ctx context.Context, server devicemanagement.MDMServer) error
+
+	// DeleteDevice removes a device that has left the organization.
+	DeleteDevice(ctx context.Context, deviceID string) error
+
+	// Flush is called at the end of every successful pass, so a sink that
+	// batches writes can commit them.
+	Flush(ctx context.Context) error
+}
+
+// State is what an Engine remembers between passes: a fingerprint of every
+// device and server it has sent to the sink, keyed by ID. Save it, for
+// example as JSON, and pass it in Options.State to resume after a restart
+// without resending unchanged records.
+type State struct {
+	Devices map[string]string `json:"devices"`
+	Servers map[string]string `json:"servers"`
+}
+
+// Options configures an Engine.
+type Options struct {
+	// Interval is how often Run syncs. Defaults to DefaultInterval.
+	Interval time.Duration
+
+	// PageSize is the number of devices requested per page. Zero uses the
+	// API default.
+	PageSize int
+
+	// State resumes from a saved State. Without it, the first pass sends
+	// every device and server, and deletes nothing.
+	State *State
+
+	// OnError, if set, is called when a pass started by Run fails. Run
+	// keeps going and retries on the next tick.
+	OnError func(err error)
+}
+
+// Result counts what one pass sent to the sink.
+type Result struct {
+	DevicesUpserted  int
+	DevicesUnchanged int
+	DevicesDeleted   int
+	ServersUpserted  int
+}
+
+// Engine syncs the inventory into a Sink. Each pass lists every device a page
+// at a time and sends only the devices and servers that are new or changed
+// since they were last sent; devices that are no longer listed are deleted.
+// Call Sync for a single pass or Run to sync on an interval. An Engine is safe
+// for concurrent use; passes never overlap.
+type Engine struct {
+	client  *axm.Client
+	servers *reports.ServerDirectory
+	sink    Sink
+	opts    Options
+
+	mu    sync.Mutex
+	state State
+}
+
+// NewEngine returns an engine that reads the inventory through c and writes
+// it to sink.
+func NewEngine(c *axm.Client, sink Sink, opts Options) (*Engine, error) {
+	if sink == nil {
+		return nil, fmt.Errorf("sink is required")
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultInterval
+	}
+	e := &Engine{
+		client:  c,
+		servers: reports.NewServerDirectory(c),
+		sink:    sink,
+		opts:    opts,
+		state:   State{Devices: map[string]string{}, Servers: map[string]string{}},
+	}
+	if opts.State != nil {
+		maps.Copy(e.state.Devices, opts.State.Devices)
+		maps.Copy(e.state.Servers, opts.State.Servers)
+	}
+	return e, nil
+}
+
+// State returns a copy of the engine's state, to be saved after a pass.
+func (e *Engine) State() State {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return State{Devices: maps.Clone(e.state.Devices), Servers: maps.Clone(e.state.Servers)}
+}
+
+// Run syncs every Options.Interval until ctx is cancelled or the client is
+// closed, then returns nil. Failed passes are reported to Options.OnError.
+func (e *Engine) Run(ctx context.Context) error {
+	ticker := time.NewTicker(e.opts.Interval)
+	defer ticker.Stop()
+	for {
+		if _, err := e.Sync(ctx); err != nil && !errors.Is(err, client.ErrClientClosed) && ctx.Err() == nil {
+			if e.opts.OnError != nil {
+				e.opts.OnError(err)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-e.client.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sync runs one pass. Servers are sent first, then devices as each page
+// arrives, then deletions once the whole inventory has been listed, and
+// finally Flush. The state is only updated once Flush succeeds; after a failed
+// pass, the next one sends the same changes again.
+func (e *Engine) Sync(ctx context.Context) (*Result, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	result := &Result{}
+	e.servers.Invalidate()
+	servers, err := e.servers.Servers(ctx)
+	if err != nil {
+		return result, err
+	}
+	next := State{Devices: make(map[string]string, len(e.state.Devices)), Servers: make(map[string]string, len(servers))}
+	for _, id := range slices.Sorted(maps.Keys(servers)) {
+		fp := fingerprint(servers[id])
+		next.Servers[id] = fp
+		if e.state.Servers[id] == fp {
+			continue
+		}
+		if err := e.sink.UpsertServer(ctx, servers[id]); err != nil {
+			return result, fmt.Errorf("upsert server %s: %w", id, err)
+		}
+		result.ServersUpserted++
+	}
+
+	query := &devices.RequestQueryOptions{Limit: e.opts.PageSize}
+	cursor := ""
+	for {
+		page, _, err := e.client.AXMAPI.Devices.GetPageV1(ctx, cursor, query)
+		if err != nil {
+			return result, fmt.Errorf("list devices: %w", err)
+		}
+		enriched, err := e.servers.Enrich(ctx, page.Data)
+		if err != nil {
+			return result, err
+		}
+		for _, d := range enriched {
+			fp := fingerprint(d)
+			next.Devices[d.ID] = fp
+			if e.state.Devices[d.ID] == fp {
+				result.DevicesUnchanged++
+				continue
+			}
+			if err := e.sink.UpsertDevice(ctx, d); err != nil {
+				return result, fmt.Errorf("upsert device %s: %w", d.ID, err)
+			}
+			result.DevicesUpserted++
+		}
+		if cursor = page.NextCursor(); cursor == "" {
+			break
+		}
+	}
+
+	for _, id := range slices.Sorted(maps.Keys(e.state.Devices)) {
+		if _, ok := next.Devices[id]; ok {
+			continue
+		}
+		if err := e.sink.DeleteDevice(ctx, id); err != nil {
+			return result, fmt.Errorf("delete device %s: %w", id, err)
+		}
+		result.DevicesDeleted++
+	}
+
+	if err := e.sink.Flush(ctx); err != nil {
+		return result, fmt.Errorf("flush: %w", err)
+	}
+	e.state = next
+	return result, nil
+}
+
+// fingerprint identifies the content of v, to detect changed records.
+func fingerprint(v any) string {
+	data, _ := json.Marshal(v)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:16])
+}
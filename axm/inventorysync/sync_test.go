@@ -0,0 +1,206 @@
+package inventorysync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devicemanagement"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/reports"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"resty.dev/v3"
+)
+
+type noAuth struct{}
+
+func (noAuth) ApplyAuth(*resty.Request) error { return nil }
+
+func jsonResponder(status int, body string) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		resp := httpmock.NewStringResponse(status, body)
+		resp.Header.Set("Content-Type", "application/json")
+		return resp, nil
+	}
+}
+
+func setupClient(t *testing.T, mt *httpmock.MockTransport) *axm.Client {
+	t.Helper()
+	c, err := axm.NewClient("key-id", "issuer-id", "unused",
+		client.WithAuth(noAuth{}),
+		axm.WithTransport(mt),
+		axm.WithRetryCount(0),
+	)
+	require.NoError(t, err)
+	return c
+}
+
+// inventory serves a device list that tests can change between passes. Each
+// device is "id:serial"; pages are chained by cursor.
+type inventory struct {
+	mu       sync.Mutex
+	pages    [][]string
+	assigned []string
+}
+
+func (inv *inventory) set(assigned []string, pages ...[]string) {
+	inv.mu.Lock()
+	defer inv.mu.Unlock()
+	inv.pages, inv.assigned = pages, assigned
+}
+
+func (inv *inventory) register(mt *httpmock.MockTransport) {
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/mdmServers", jsonResponder(200,
+		`{"data":[{"type":"mdmServers","id":"SRV1","attributes":{"serverName":"Jamf Pro","serverType":"MDM"}}]}`))
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/mdmServers/SRV1/relationships/devices",
+		func(req *http.Request) (*http.Response, error) {
+			inv.mu.Lock()
+			defer inv.mu.Unlock()
+			var data []string
+			for _, id := range inv.assigned {
+				data = append(data, fmt.Sprintf(`{"type":"orgDevices","id":%q}`, id))
+			}
+			return jsonResponder(200, `{"data":[`+strings.Join(data, ",")+`]}`)(req)
+		})
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDevices",
+		func(req *http.Request) (*http.Response, error) {
+			inv.mu.Lock()
+			defer inv.mu.Unlock()
+			i := 0
+			if cursor := req.URL.Query().Get("cursor"); cursor != "" {
+				fmt.Sscanf(cursor, "page-%d", &i)
+			}
+			var data []string
+			for _, device := range inv.pages[i] {
+				id, serial, _ := strings.Cut(device, ":")
+				data = append(data, fmt.Sprintf(`{"type":"orgDevices","id":%q,"attributes":{"serialNumber":%q}}`, id, serial))
+			}
+			meta := `{"paging":{"limit":2}}`
+			if i+1 < len(inv.pages) {
+				meta = fmt.Sprintf(`{"paging":{"limit":2,"nextCursor":"page-%d"}}`, i+1)
+			}
+			return jsonResponder(200, `{"data":[`+strings.Join(data, ",")+`],"meta":`+meta+`}`)(req)
+		})
+}
+
+// memorySink records the calls it receives as "op:id" strings.
+type memorySink struct {
+	calls    []string
+	devices  map[string]reports.DeviceWithServer
+	flushErr error
+}
+
+func newMemorySink() *memorySink {
+	return &memorySink{devices: map[string]reports.DeviceWithServer{}}
+}
+
+func (s *memorySink) UpsertDevice(_ context.Context, d reports.DeviceWithServer) error {
+	s.calls = append(s.calls, "device:"+d.ID)
+	s.devices[d.ID] = d
+	return nil
+}
+
+func (s *memorySink) UpsertServer(_ context.Context, server devicemanagement.MDMServer) error {
+	s.calls = append(s.calls, "server:"+server.ID)
+	return nil
+}
+
+func (s *memorySink) DeleteDevice(_ context.Context, id string) error {
+	s.calls = append(s.calls, "delete:"+id)
+	delete(s.devices, id)
+	return nil
+}
+
+func (s *memorySink) Flush(context.Context) error {
+	s.calls = append(s.calls, "flush")
+	return s.flushErr
+}
+
+// pass runs one sync and returns the sink calls it made.
+func pass(t *testing.T, e *Engine, sink *memorySink) ([]string, *Result) {
+	t.Helper()
+	sink.calls = nil
+	result, err := e.Sync(context.Background())
+	require.NoError(t, err)
+	return sink.calls, result
+}
+
+func TestEngine_SyncSendsChanges(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	inv := &inventory{}
+	inv.register(mt)
+	inv.set([]string{"D2"}, []string{"D1:AAA", "D2:BBB"}, []string{"D3:CCC"})
+	sink := newMemorySink()
+	engine, err := NewEngine(setupClient(t, mt), sink, Options{})
+	require.NoError(t, err)
+
+	calls, result := pass(t, engine, sink)
+	assert.Equal(t, []string{"server:SRV1", "device:D1", "device:D2", "device:D3", "flush"}, calls)
+	assert.Equal(t, &Result{DevicesUpserted: 3, ServersUpserted: 1}, result)
+	assert.Equal(t, "Jamf Pro", sink.devices["D2"].ServerName)
+
+	calls, result = pass(t, engine, sink)
+	assert.Equal(t, []string{"flush"}, calls, "nothing changed")
+	assert.Equal(t, 3, result.DevicesUnchanged)
+
+	inv.set([]string{"D1", "D2"}, []string{"D1:AAA", "D2:BBB"}, []string{"D4:DDD"})
+	calls, result = pass(t, engine, sink)
+	assert.Equal(t, []string{"device:D1", "device:D4", "delete:D3", "flush"}, calls)
+	assert.Equal(t, &Result{DevicesUpserted: 2, DevicesUnchanged: 1, DevicesDeleted: 1}, result)
+	assert.Equal(t, "SRV1", sink.devices["D1"].ServerID, "assignment changes are sent")
+
+	saved := engine.State()
+	resumed, err := NewEngine(setupClient(t, mt), sink, Options{State: &saved})
+	require.NoError(t, err)
+	calls, _ = pass(t, resumed, sink)
+	assert.Equal(t, []string{"flush"}, calls, "a saved state is not resent")
+}
+
+func TestEngine_FailedFlushResends(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	inv := &inventory{}
+	inv.register(mt)
+	inv.set(nil, []string{"D1:AAA"})
+	sink := newMemorySink()
+	engine, err := NewEngine(setupClient(t, mt), sink, Options{})
+	require.NoError(t, err)
+
+	sink.flushErr = errors.New("commit failed")
+	_, err = engine.Sync(context.Background())
+	require.ErrorContains(t, err, "flush: commit failed")
+	assert.Empty(t, engine.State().Devices)
+
+	sink.flushErr = nil
+	calls, _ := pass(t, engine, sink)
+	assert.Equal(t, []string{"server:SRV1", "device:D1", "flush"}, calls)
+}
+
+func TestEngine_ListErrorDeletesNothing(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	inv := &inventory{}
+	inv.register(mt)
+	inv.set(nil, []string{"D1:AAA"})
+	sink := newMemorySink()
+	engine, err := NewEngine(setupClient(t, mt), sink, Options{State: &State{Devices: map[string]string{"D9": "old"}}})
+	require.NoError(t, err)
+
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDevices",
+		jsonResponder(500, `{"errors":[{"status":"500","code":"INTERNAL_ERROR","title":"Internal Server Error"}]}`))
+	sink.calls = nil
+	_, err = engine.Sync(context.Background())
+	require.ErrorContains(t, err, "list devices")
+	assert.Equal(t, []string{"server:SRV1"}, sink.calls)
+	assert.Contains(t, engine.State().Devices, "D9")
+}
+
+func TestNewEngine_RequiresSink(t *testing.T) {
+	_, err := NewEngine(nil, nil, Options{})
+	assert.ErrorContains(t, err, "sink is required")
+}
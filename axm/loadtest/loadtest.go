@@ -0,0 +1,316 @@
+// Package loadtest measures the axm client against an in-process mock of the
+// Apple Business Manager API, so performance can be compared between
+// releases without credentials or network access.
+//
+// Two scenarios are run by Run:
+//
+//   - ScenarioPagination lists the whole device inventory with
+//     Devices.GetV1, measuring page throughput and decode cost end to end.
+//   - ScenarioAssignment splits the inventory into assign activities and
+//     submits them from concurrent workers, each waiting for its activity to
+//     complete, measuring bulk assignment fan-out.
+//
+// The mock adds no latency by default, so results reflect the client's own
+// cost: JSON handling, pagination, retries and polling overhead. Set
+// ServerOptions.Latency to approximate a real round trip. Benchmarks for the
+// same workloads, for use with go test -bench and benchstat, are in this
+// package's tests. The cmd/axmload command runs the scenarios and writes a
+// Report as JSON.
+package loadtest
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devicemanagement"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devices"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/client"
+	"resty.dev/v3"
+)
+
+// Scenario names.
+const (
+	ScenarioPagination = "pagination"
+	ScenarioAssignment = "assignment"
+)
+
+// Config configures a load test run.
+type Config struct {
+	Server ServerOptions `json:"server"`
+
+	// PageSize is the limit requested for each device page. Defaults to
+	// 1000.
+	PageSize int `json:"pageSize"`
+
+	// Iterations is the number of full inventory listings in the pagination
+	// scenario. Defaults to 5.
+	Iterations int `json:"iterations"`
+
+	// BatchSize is the number of devices per assign activity. Defaults to
+	// 100.
+	BatchSize int `json:"batchSize"`
+
+	// Workers is the number of activities submitted and polled at once.
+	// Defaults to 8.
+	Workers int `json:"workers"`
+
+	// PollInterval is the activity poll interval. Defaults to 10ms.
+	PollInterval time.Duration `json:"pollInterval"`
+}
+
+func (c *Config) setDefaults() {
+	if c.PageSize <= 0 {
+		c.PageSize = 1000
+	}
+	if c.Iterations <= 0 {
+		c.Iterations = 5
+	}
+	if c.BatchSize <= 0 {
+		c.BatchSize = 100
+	}
+	if c.Workers <= 0 {
+		c.Workers = 8
+	}
+	if c.PollInterval <= 0 {
+		c.PollInterval = 10 * time.Millisecond
+	}
+}
+
+// Latency summarizes operation durations.
+type Latency struct {
+	P50 time.Duration `json:"p50"`
+	P95 time.Duration `json:"p95"`
+	P99 time.Duration `json:"p99"`
+	Max time.Duration `json:"max"`
+}
+
+// Result is the outcome of one scenario.
+type Result struct {
+	Scenario string `json:"scenario"`
+
+	// Operations is the number of timed operations: inventory listings for
+	// pagination, activities for assignment.
+	Operations int `json:"operations"`
+
+	// Devices is the number of devices listed or assigned.
+	Devices int `json:"devices"`
+
+	// Requests is the number of HTTP requests the mock server answered,
+	// including the untimed listing the assignment scenario starts with.
+	Requests int64 `json:"requests"`
+
+	Duration         time.Duration `json:"duration"`
+	DevicesPerSecond float64       `json:"devicesPerSecond"`
+	Latency          Latency       `json:"latency"`
+
+	// AllocBytesPerDevice is the memory allocated during the scenario,
+	// including by the in-process mock server, divided by Devices.
+	AllocBytesPerDevice float64 `json:"allocBytesPerDevice"`
+}
+
+// Report is the outcome of a Run.
+type Report struct {
+	SDKVersion string    `json:"sdkVersion"`
+	GoVersion  string    `json:"goVersion"`
+	GOOS       string    `json:"goos"`
+	GOARCH     string    `json:"goarch"`
+	CPUs       int       `json:"cpus"`
+	Started    time.Time `json:"started"`
+	Config     Config    `json:"config"`
+	Results    []Result  `json:"results"`
+}
+
+// Run starts a mock server configured by cfg.Server and runs the named
+// scenarios against it, or both when none are named.
+func Run(ctx context.Context, cfg Config, scenarios ...string) (*Report, error) {
+	cfg.setDefaults()
+	if len(scenarios) == 0 {
+		scenarios = []string{ScenarioPagination, ScenarioAssignment}
+	}
+	report := &Report{
+		SDKVersion: client.Version,
+		GoVersion:  runtime.Version(),
+		GOOS:       runtime.GOOS,
+		GOARCH:     runtime.GOARCH,
+		CPUs:       runtime.NumCPU(),
+		Started:    time.Now().UTC(),
+		Config:     cfg,
+	}
+	for _, name := range scenarios {
+		var run func(context.Context, *axm.Client, Config) (Result, error)
+		switch name {
+		case ScenarioPagination:
+			run = Pagination
+		case ScenarioAssignment:
+			run = Assignment
+		default:
+			return nil, fmt.Errorf("loadtest: unknown scenario %q", name)
+		}
+		result, err := runScenario(ctx, cfg, run)
+		if err != nil {
+			return nil, fmt.Errorf("loadtest: %s: %w", name, err)
+		}
+		report.Results = append(report.Results, result)
+	}
+	return report, nil
+}
+
+// runScenario runs one scenario against a fresh server and client.
+func runScenario(ctx context.Context, cfg Config, run func(context.Context, *axm.Client, Config) (Result, error)) (Result, error) {
+	srv := NewServer(cfg.Server)
+	defer srv.Close()
+	c, err := NewClient(srv)
+	if err != nil {
+		return Result{}, err
+	}
+	defer c.Close()
+
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	result, err := run(ctx, c, cfg)
+	runtime.ReadMemStats(&after)
+	if err != nil {
+		return result, err
+	}
+	result.Requests = srv.Requests()
+	if result.Devices > 0 {
+		result.AllocBytesPerDevice = float64(after.TotalAlloc-before.TotalAlloc) / float64(result.Devices)
+		result.DevicesPerSecond = float64(result.Devices) / result.Duration.Seconds()
+	}
+	return result, nil
+}
+
+// noAuth sends requests without credentials; the mock server does not check
+// them.
+type noAuth struct{}
+
+func (noAuth) ApplyAuth(*resty.Request) error { return nil }
+
+// NewClient returns a client for srv with retries disabled, so every request
+// the server counts is one the workload made.
+func NewClient(srv *Server, options ...client.ClientOption) (*axm.Client, error) {
+	options = append([]client.ClientOption{
+		client.WithAuth(noAuth{}),
+		axm.WithBaseURL(srv.URL),
+		axm.WithRetryCount(0),
+	}, options...)
+	return axm.NewClient("loadtest", "loadtest", "unused", options...)
+}
+
+// Pagination lists the whole inventory cfg.Iterations times, timing each
+// listing.
+func Pagination(ctx context.Context, c *axm.Client, cfg Config) (Result, error) {
+	cfg.setDefaults()
+	result := Result{Scenario: ScenarioPagination}
+	var timings []time.Duration
+	start := time.Now()
+	for range cfg.Iterations {
+		opStart := time.Now()
+		resp, _, err := c.AXMAPI.Devices.GetV1(ctx, &devices.RequestQueryOptions{Limit: cfg.PageSize})
+		if err != nil {
+			return result, err
+		}
+		timings = append(timings, time.Since(opStart))
+		result.Operations++
+		result.Devices += len(resp.Data)
+	}
+	result.Duration = time.Since(start)
+	result.Latency = summarize(timings)
+	return result, nil
+}
+
+// Assignment lists the inventory, splits it into activities of cfg.BatchSize
+// devices, and assigns them from cfg.Workers concurrent workers, each waiting
+// for its activity to complete. Only the assignment is timed.
+func Assignment(ctx context.Context, c *axm.Client, cfg Config) (Result, error) {
+	cfg.setDefaults()
+	result := Result{Scenario: ScenarioAssignment}
+	inventory, _, err := c.AXMAPI.Devices.GetV1(ctx, &devices.RequestQueryOptions{Limit: 1000})
+	if err != nil {
+		return result, err
+	}
+	ids := make([]string, len(inventory.Data))
+	for i, d := range inventory.Data {
+		ids[i] = d.ID
+	}
+	batches := slices.Collect(slices.Chunk(ids, cfg.BatchSize))
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	work := make(chan []string)
+	var (
+		mu       sync.Mutex
+		timings  []time.Duration
+		firstErr error
+		wg       sync.WaitGroup
+	)
+	dm := c.AXMAPI.DeviceManagement
+	start := time.Now()
+	for range cfg.Workers {
+		wg.Go(func() {
+			for batch := range work {
+				opStart := time.Now()
+				err := assignBatch(ctx, dm, batch, cfg.PollInterval)
+				mu.Lock()
+				if err != nil && firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				timings = append(timings, time.Since(opStart))
+				mu.Unlock()
+			}
+		})
+	}
+feed:
+	for _, batch := range batches {
+		select {
+		case work <- batch:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(work)
+	wg.Wait()
+	result.Duration = time.Since(start)
+	if firstErr != nil {
+		return result, firstErr
+	}
+	result.Operations = len(batches)
+	result.Devices = len(ids)
+	result.Latency = summarize(timings)
+	return result, nil
+}
+
+// assignBatch assigns one batch and waits for its activity to complete.
+func assignBatch(ctx context.Context, dm *devicemanagement.DeviceManagement, batch []string, poll time.Duration) error {
+	created, _, err := dm.AssignDevicesV1(ctx, "LOADTEST", batch)
+	if err != nil {
+		return err
+	}
+	final, err := dm.WaitForActivityV1(ctx, created.Data.ID, poll)
+	if err != nil {
+		return err
+	}
+	if !final.Data.Succeeded() {
+		return fmt.Errorf("activity %s did not complete", final.Data.ID)
+	}
+	return nil
+}
+
+// summarize returns the percentiles of timings.
+func summarize(timings []time.Duration) Latency {
+	if len(timings) == 0 {
+		return Latency{}
+	}
+	slices.Sort(timings)
+	at := func(p float64) time.Duration {
+		return timings[min(len(timings)-1, int(p*float64(len(timings))))]
+	}
+	return Latency{P50: at(0.50), P95: at(0.95), P99: at(0.99), Max: timings[len(timings)-1]}
+}
@@ -0,0 +1,136 @@
+package loadtest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devices"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	report, err := Run(context.Background(), Config{
+		Server:       ServerOptions{Devices: 250, ActivityPolls: 2},
+		PageSize:     100,
+		Iterations:   2,
+		BatchSize:    50,
+		Workers:      3,
+		PollInterval: time.Millisecond,
+	})
+	require.NoError(t, err)
+	require.Len(t, report.Results, 2)
+
+	pagination := report.Results[0]
+	assert.Equal(t, ScenarioPagination, pagination.Scenario)
+	assert.Equal(t, 2, pagination.Operations)
+	assert.Equal(t, 500, pagination.Devices)
+	assert.Equal(t, int64(6), pagination.Requests, "3 pages per listing")
+	assert.Positive(t, pagination.DevicesPerSecond)
+	assert.LessOrEqual(t, pagination.Latency.P50, pagination.Latency.Max)
+
+	assignment := report.Results[1]
+	assert.Equal(t, ScenarioAssignment, assignment.Scenario)
+	assert.Equal(t, 5, assignment.Operations)
+	assert.Equal(t, 250, assignment.Devices)
+	// One listing page, then per activity one create and two polls.
+	assert.Equal(t, int64(1+5*3), assignment.Requests)
+
+	_, err = Run(context.Background(), Config{}, "unknown")
+	assert.ErrorContains(t, err, `unknown scenario "unknown"`)
+}
+
+func TestServerPaging(t *testing.T) {
+	srv := NewServer(ServerOptions{Devices: 3})
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/v1/orgDevices?limit=2&cursor=2")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	var page devices.OrgDevicesResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&page))
+	require.Len(t, page.Data, 1)
+	assert.Equal(t, "LT0000000002", page.Data[0].Attributes.SerialNumber)
+	assert.Empty(t, page.NextCursor())
+}
+
+// BenchmarkDecodeDevicePage measures decoding one full page of 1000 devices.
+func BenchmarkDecodeDevicePage(b *testing.B) {
+	srv := NewServer(ServerOptions{Devices: 1000})
+	defer srv.Close()
+	resp, err := http.Get(srv.URL + "/v1/orgDevices?limit=1000")
+	if err != nil {
+		b.Fatal(err)
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.SetBytes(int64(len(body)))
+	b.ReportAllocs()
+	for b.Loop() {
+		var page devices.OrgDevicesResponse
+		if err := json.Unmarshal(body, &page); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkPagination measures listing a 10000-device inventory through the
+// client, at several page sizes.
+func BenchmarkPagination(b *testing.B) {
+	srv := NewServer(ServerOptions{Devices: 10000})
+	defer srv.Close()
+	c, err := NewClient(srv)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer c.Close()
+
+	for _, pageSize := range []int{100, 1000} {
+		b.Run(fmt.Sprintf("limit=%d", pageSize), func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				resp, _, err := c.AXMAPI.Devices.GetV1(context.Background(), &devices.RequestQueryOptions{Limit: pageSize})
+				if err != nil {
+					b.Fatal(err)
+				}
+				if len(resp.Data) != 10000 {
+					b.Fatalf("listed %d devices", len(resp.Data))
+				}
+			}
+			b.ReportMetric(float64(b.N*10000)/b.Elapsed().Seconds(), "devices/s")
+		})
+	}
+}
+
+// BenchmarkAssignmentFanOut measures assigning 1000 devices in activities of
+// 100 from a varying number of workers.
+func BenchmarkAssignmentFanOut(b *testing.B) {
+	srv := NewServer(ServerOptions{Devices: 1000})
+	defer srv.Close()
+	c, err := NewClient(srv)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer c.Close()
+
+	for _, workers := range []int{1, 8} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			cfg := Config{BatchSize: 100, Workers: workers, PollInterval: time.Millisecond}
+			b.ReportAllocs()
+			for b.Loop() {
+				if _, err := Assignment(context.Background(), c, cfg); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+package loadtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devicemanagement"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devices"
+)
+
+// ServerOptions configures a mock server.
+type ServerOptions struct {
+	// Devices is the size of the device inventory. Defaults to 10000.
+	Devices int `json:"devices"`
+
+	// DefaultPageSize is the page size when a request has no limit. Defaults
+	// to 100. Larger limits are capped at 1000, the API maximum.
+	DefaultPageSize int `json:"defaultPageSize"`
+
+	// Latency is added to every response, to approximate the round trip to
+	// Apple. Defaults to none.
+	Latency time.Duration `json:"latency"`
+
+	// ActivityPolls is the number of status requests an activity answers
+	// IN_PROGRESS before it completes. Defaults to 1.
+	ActivityPolls int `json:"activityPolls"`
+}
+
+// Server is an in-process mock of the orgDevices and orgDeviceActivities
+// endpoints, sized for load tests. Device pages are rendered once at start, so
+// the server adds little cost of its own to the client being measured.
+type Server struct {
+	*httptest.Server
+
+	opts     ServerOptions
+	devices  [][]byte
+	requests atomic.Int64
+
+	mu         sync.Mutex
+	activities map[string]int
+	nextID     int
+}
+
+// NewServer starts a mock server. Close it when done.
+func NewServer(opts ServerOptions) *Server {
+	if opts.Devices <= 0 {
+		opts.Devices = 10000
+	}
+	if opts.DefaultPageSize <= 0 {
+		opts.DefaultPageSize = 100
+	}
+	if opts.ActivityPolls <= 0 {
+		opts.ActivityPolls = 1
+	}
+	s := &Server{opts: opts, activities: make(map[string]int)}
+	s.devices = make([][]byte, opts.Devices)
+	for i := range s.devices {
+		s.devices[i] = renderDevice(i)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/orgDevices", s.listDevices)
+	mux.HandleFunc("POST /v1/orgDeviceActivities", s.createActivity)
+	mux.HandleFunc("GET /v1/orgDeviceActivities/{id}", s.getActivity)
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.requests.Add(1)
+		if opts.Latency > 0 {
+			time.Sleep(opts.Latency)
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	return s
+}
+
+// Requests returns the number of requests served so far.
+func (s *Server) Requests() int64 {
+	return s.requests.Load()
+}
+
+// renderDevice returns the JSON of the i-th device, with every attribute set
+// so decoding costs as much as for a real device.
+func renderDevice(i int) []byte {
+	serial := fmt.Sprintf("LT%010d", i)
+	added := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(i) * time.Minute)
+	updated := added.Add(24 * time.Hour)
+	data, _ := json.Marshal(devices.OrgDevice{
+		ID:   serial,
+		Type: "orgDevices",
+		Attributes: &devices.OrgDeviceAttributes{
+			SerialNumber:        serial,
+			AddedToOrgDateTime:  &added,
+			UpdatedDateTime:     &updated,
+			DeviceModel:         "MacBook Air (13-inch, M2, 2022)",
+			ProductFamily:       "Mac",
+			ProductType:         "Mac14,2",
+			DeviceCapacity:      "256GB",
+			PartNumber:          "MLY33LL/A",
+			OrderNumber:         fmt.Sprintf("ORD%08d", i/50),
+			Color:               "MIDNIGHT",
+			Status:              "ASSIGNED",
+			OrderDateTime:       &added,
+			IMEI:                []string{fmt.Sprintf("35%013d", i)},
+			EID:                 fmt.Sprintf("8904903200%022d", i),
+			WiFiMACAddress:      fmt.Sprintf("a4:83:e7:%02x:%02x:%02x", i>>16&0xff, i>>8&0xff, i&0xff),
+			BluetoothMACAddress: fmt.Sprintf("a4:83:e7:%02x:%02x:%02x", i>>16&0xff, i>>8&0xff, (i+1)&0xff),
+			PurchaseSourceId:    "1234567",
+			PurchaseSourceType:  "APPLE",
+			AssignedServer:      "LOADTEST",
+		},
+	})
+	return data
+}
+
+// listDevices serves a page of devices. The cursor is the offset of the page.
+func (s *Server) listDevices(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	limit := s.opts.DefaultPageSize
+	if v, err := strconv.Atoi(query.Get("limit")); err == nil && v > 0 {
+		limit = min(v, 1000)
+	}
+	offset, _ := strconv.Atoi(query.Get("cursor"))
+	offset = max(0, min(offset, len(s.devices)))
+	end := min(offset+limit, len(s.devices))
+
+	var b strings.Builder
+	b.WriteString(`{"data":[`)
+	for i, d := range s.devices[offset:end] {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.Write(d)
+	}
+	fmt.Fprintf(&b, `],"links":{"self":"%s/v1/orgDevices"`, s.URL)
+	next := ""
+	if end < len(s.devices) {
+		next = strconv.Itoa(end)
+		fmt.Fprintf(&b, `,"next":"%s/v1/orgDevices?cursor=%s&limit=%d"`, s.URL, next, limit)
+	}
+	fmt.Fprintf(&b, `},"meta":{"paging":{"total":%d,"limit":%d,"nextCursor":%q}}}`, len(s.devices), limit, next)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write([]byte(b.String()))
+}
+
+// createActivity accepts an assign or unassign request.
+func (s *Server) createActivity(w http.ResponseWriter, r *http.Request) {
+	var req devicemanagement.OrgDeviceActivityCreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, `{"errors":[{"status":"400","code":"PARAMETER_ERROR.INVALID","title":"Invalid body"}]}`, http.StatusBadRequest)
+		return
+	}
+	s.mu.Lock()
+	s.nextID++
+	id := fmt.Sprintf("LOADTEST-%d", s.nextID)
+	s.activities[id] = 0
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	writeActivity(w, id, req.Data.Attributes.ActivityType, devicemanagement.ActivityStatusInProgress)
+}
+
+// getActivity reports an activity IN_PROGRESS until it has been polled
+// ActivityPolls times, then COMPLETED.
+func (s *Server) getActivity(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	s.mu.Lock()
+	polls, ok := s.activities[id]
+	if ok {
+		polls++
+		s.activities[id] = polls
+	}
+	s.mu.Unlock()
+	if !ok {
+		http.Error(w, `{"errors":[{"status":"404","code":"NOT_FOUND","title":"Not found"}]}`, http.StatusNotFound)
+		return
+	}
+
+	status := devicemanagement.ActivityStatusInProgress
+	if polls >= s.opts.ActivityPolls {
+		status = devicemanagement.ActivityStatusCompleted
+	}
+	w.Header().Set("Content-Type", "application/json")
+	writeActivity(w, id, "", status)
+}
+
+func writeActivity(w http.ResponseWriter, id, activityType string, status devicemanagement.ActivityStatus) {
+	now := time.Now().UTC()
+	json.NewEncoder(w).Encode(devicemanagement.ResponseOrgDeviceActivity{
+		Data: devicemanagement.OrgDeviceActivity{
+			ID:   id,
+			Type: "orgDeviceActivities",
+			Attributes: &devicemanagement.OrgDeviceActivityAttributes{
+				Status:          status,
+				ActivityType:    activityType,
+				CreatedDateTime: &now,
+			},
+		},
+	})
+}
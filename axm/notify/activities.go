@@ -0,0 +1,241 @@
+// Package notify turns the Apple Business Manager API's poll-only resources
+// into push notifications delivered to a webhook or a message broker.
+package notify
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devicemanagement"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/client"
+)
+
+const (
+	// DefaultInterval is how often in-flight activities are polled when
+	// Options.Interval is not set.
+	DefaultInterval = 30 * time.Second
+
+	// HeaderTimestamp carries the Unix time the payload was signed at.
+	HeaderTimestamp = "X-AXM-Timestamp"
+
+	// HeaderSignature carries "sha256=" and the hex HMAC-SHA256 of the
+	// timestamp, a ".", and the request body, keyed with Options.Secret.
+	HeaderSignature = "X-AXM-Signature"
+)
+
+// Options configures an ActivityNotifier.
+type Options struct {
+	// WebhookURL receives a POST for every activity that reaches a terminal
+	// status. Required.
+	WebhookURL string
+
+	// Secret signs each payload; see HeaderSignature. Payloads are unsigned
+	// when it is empty.
+	Secret []byte
+
+	// Interval is how often in-flight activities are polled. Defaults to
+	// DefaultInterval.
+	Interval time.Duration
+
+	// HTTPClient delivers webhooks. Defaults to a client with a 30 second
+	// timeout.
+	HTTPClient *http.Client
+
+	// OnError, if set, is called for every failed poll or delivery. The
+	// activity stays watched and is retried on the next poll, except when
+	// Apple no longer knows it.
+	OnError func(activityID string, err error)
+}
+
+// ActivityEvent is the JSON payload posted when an activity finishes.
+type ActivityEvent struct {
+	ActivityID   string                             `json:"activityId"`
+	ActivityType string                             `json:"activityType,omitempty"`
+	Status       devicemanagement.ActivityStatus    `json:"status"`
+	SubStatus    devicemanagement.ActivitySubStatus `json:"subStatus,omitempty"`
+	Succeeded    bool                               `json:"succeeded"`
+	CreatedAt    *time.Time                         `json:"createdAt,omitempty"`
+	DownloadURL  string                             `json:"downloadUrl,omitempty"`
+
+	// ObservedAt is when the notifier saw the terminal status.
+	ObservedAt time.Time `json:"observedAt"`
+}
+
+// ActivityNotifier polls in-flight org device activities and posts an
+// ActivityEvent to a webhook when each one reaches a terminal status. Register
+// activities with Watch, then call Run, or call Poll from your own scheduler.
+// An ActivityNotifier is safe for concurrent use.
+type ActivityNotifier struct {
+	dm     *devicemanagement.DeviceManagement
+	closed <-chan struct{}
+	opts   Options
+
+	mu      sync.Mutex
+	pending map[string]struct{}
+}
+
+// NewActivityNotifier returns a notifier that polls activities through c.
+func NewActivityNotifier(c *axm.Client, opts Options) (*ActivityNotifier, error) {
+	if opts.WebhookURL == "" {
+		return nil, fmt.Errorf("webhook URL is required")
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultInterval
+	}
+	if opts.HTTPClient == nil {
+		opts.HTTPClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &ActivityNotifier{
+		dm:      c.AXMAPI.DeviceManagement,
+		closed:  c.Done(),
+		opts:    opts,
+		pending: make(map[string]struct{}),
+	}, nil
+}
+
+// Watch adds activityIDs to the activities being watched.
+func (n *ActivityNotifier) Watch(activityIDs ...string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for _, id := range activityIDs {
+		n.pending[id] = struct{}{}
+	}
+}
+
+// Pending returns the IDs of the activities still being watched, sorted.
+func (n *ActivityNotifier) Pending() []string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	ids := make([]string, 0, len(n.pending))
+	for id := range n.pending {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// Run polls every Options.Interval until ctx is cancelled or the client is
+// closed, then returns nil. A poll in progress when the client starts a
+// Shutdown is allowed to finish.
+func (n *ActivityNotifier) Run(ctx context.Context) error {
+	ticker := time.NewTicker(n.opts.Interval)
+	defer ticker.Stop()
+	for {
+		n.Poll(ctx)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-n.closed:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll checks every watched activity once and delivers an event for each one
+// that has finished. Delivered activities stop being watched; activities whose
+// poll or delivery failed are kept and retried next time. It returns the
+// number of events delivered.
+func (n *ActivityNotifier) Poll(ctx context.Context) int {
+	delivered := 0
+	for _, id := range n.Pending() {
+		if ctx.Err() != nil {
+			break
+		}
+		result, _, err := n.dm.GetActivityByIDV1(ctx, id)
+		if errors.Is(err, client.ErrClientClosed) {
+			break
+		}
+		if err != nil {
+			if axm.IsNotFound(err) {
+				n.forget(id)
+			}
+			n.report(id, err)
+			continue
+		}
+		if !result.Data.Terminal() {
+			continue
+		}
+		if err := n.deliver(ctx, newActivityEvent(result.Data)); err != nil {
+			n.report(id, err)
+			continue
+		}
+		n.forget(id)
+		delivered++
+	}
+	return delivered
+}
+
+// newActivityEvent builds the event for a finished activity.
+func newActivityEvent(a devicemanagement.OrgDeviceActivity) ActivityEvent {
+	ev := ActivityEvent{ActivityID: a.ID, Succeeded: a.Succeeded(), ObservedAt: time.Now().UTC()}
+	if attrs := a.Attributes; attrs != nil {
+		ev.ActivityType = attrs.ActivityType
+		ev.Status, ev.SubStatus = attrs.Status, attrs.SubStatus
+		ev.CreatedAt = attrs.CreatedDateTime
+		ev.DownloadURL = attrs.DownloadURL
+	}
+	return ev
+}
+
+// deliver posts ev to the webhook, signed when a secret is configured.
+func (n *ActivityNotifier) deliver(ctx context.Context, ev ActivityEvent) error {
+	body, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	return postWebhook(ctx, n.opts.HTTPClient, n.opts.WebhookURL, n.opts.Secret, body, nil)
+}
+
+func (n *ActivityNotifier) forget(id string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	delete(n.pending, id)
+}
+
+func (n *ActivityNotifier) report(id string, err error) {
+	if n.opts.OnError != nil {
+		n.opts.OnError(id, err)
+	}
+}
+
+// Sign returns the HeaderSignature value for body sent at timestamp.
+func Sign(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// ErrInvalidSignature is returned by Verify when a payload's signature does not
+// match.
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// Verify checks a received webhook's HeaderSignature against body and its
+// HeaderTimestamp, and rejects timestamps more than maxAge from now. Receivers
+// use it to authenticate payloads.
+func Verify(secret []byte, timestamp, signature string, body []byte, maxAge time.Duration) error {
+	sent, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: bad timestamp %q", ErrInvalidSignature, timestamp)
+	}
+	if age := time.Since(time.Unix(sent, 0)); age > maxAge || age < -maxAge {
+		return fmt.Errorf("%w: timestamp outside %s", ErrInvalidSignature, maxAge)
+	}
+	if !hmac.Equal([]byte(signature), []byte(Sign(secret, timestamp, body))) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
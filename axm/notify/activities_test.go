@@ -0,0 +1,173 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/client"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"resty.dev/v3"
+)
+
+type noAuth struct{}
+
+func (noAuth) ApplyAuth(*resty.Request) error { return nil }
+
+func jsonResponder(status int, body string) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		resp := httpmock.NewStringResponse(status, body)
+		resp.Header.Set("Content-Type", "application/json")
+		return resp, nil
+	}
+}
+
+func setupClient(t *testing.T, mt *httpmock.MockTransport) *axm.Client {
+	t.Helper()
+	c, err := axm.NewClient("key-id", "issuer-id", "unused",
+		client.WithAuth(noAuth{}),
+		axm.WithTransport(mt),
+		axm.WithRetryCount(0),
+	)
+	require.NoError(t, err)
+	return c
+}
+
+// webhookRecorder is a webhook endpoint that records what it receives and
+// answers with status.
+type webhookRecorder struct {
+	mu       sync.Mutex
+	status   int
+	events   []ActivityEvent
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func (w *webhookRecorder) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	var ev ActivityEvent
+	json.Unmarshal(body, &ev)
+	w.events = append(w.events, ev)
+	w.requests = append(w.requests, r)
+	w.bodies = append(w.bodies, body)
+	rw.WriteHeader(w.status)
+}
+
+func TestActivityNotifier_DeliversTerminalActivities(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDeviceActivities/act-1",
+		jsonResponder(200, `{"data":{"type":"orgDeviceActivities","id":"act-1","attributes":{"status":"COMPLETED","activityType":"ASSIGN_DEVICES","downloadUrl":"https://example.com/report.csv"}}}`))
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDeviceActivities/act-2",
+		jsonResponder(200, `{"data":{"type":"orgDeviceActivities","id":"act-2","attributes":{"status":"IN_PROGRESS"}}}`))
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDeviceActivities/act-3",
+		jsonResponder(200, `{"data":{"type":"orgDeviceActivities","id":"act-3","attributes":{"status":"FAILED","subStatus":"PROCESSING"}}}`))
+
+	hook := &webhookRecorder{status: http.StatusNoContent}
+	server := httptest.NewServer(hook)
+	defer server.Close()
+
+	secret := []byte("s3cret")
+	n, err := NewActivityNotifier(setupClient(t, mt), Options{WebhookURL: server.URL, Secret: secret})
+	require.NoError(t, err)
+	n.Watch("act-1", "act-2", "act-3")
+
+	delivered := n.Poll(context.Background())
+
+	assert.Equal(t, 2, delivered)
+	assert.Equal(t, []string{"act-2"}, n.Pending())
+	require.Len(t, hook.events, 2)
+	assert.Equal(t, "act-1", hook.events[0].ActivityID)
+	assert.True(t, hook.events[0].Succeeded)
+	assert.Equal(t, "ASSIGN_DEVICES", hook.events[0].ActivityType)
+	assert.Equal(t, "https://example.com/report.csv", hook.events[0].DownloadURL)
+	assert.Equal(t, "act-3", hook.events[1].ActivityID)
+	assert.False(t, hook.events[1].Succeeded)
+
+	for i, req := range hook.requests {
+		assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+		assert.NoError(t, Verify(secret, req.Header.Get(HeaderTimestamp), req.Header.Get(HeaderSignature), hook.bodies[i], time.Minute))
+	}
+}
+
+func TestActivityNotifier_RetriesFailedDelivery(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDeviceActivities/act-1",
+		jsonResponder(200, `{"data":{"type":"orgDeviceActivities","id":"act-1","attributes":{"status":"COMPLETED"}}}`))
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDeviceActivities/gone",
+		jsonResponder(404, `{"errors":[{"status":"404","code":"NOT_FOUND","title":"Not Found","detail":"Activity not found"}]}`))
+
+	hook := &webhookRecorder{status: http.StatusServiceUnavailable}
+	server := httptest.NewServer(hook)
+	defer server.Close()
+
+	var failed []string
+	n, err := NewActivityNotifier(setupClient(t, mt), Options{
+		WebhookURL: server.URL,
+		OnError:    func(id string, err error) { failed = append(failed, id) },
+	})
+	require.NoError(t, err)
+	n.Watch("act-1", "gone")
+
+	assert.Equal(t, 0, n.Poll(context.Background()))
+	assert.Equal(t, []string{"act-1", "gone"}, failed)
+	assert.Equal(t, []string{"act-1"}, n.Pending(), "undelivered activities stay watched; unknown ones are dropped")
+	assert.Empty(t, hook.requests[0].Header.Get(HeaderSignature), "payloads are unsigned without a secret")
+
+	hook.status = http.StatusOK
+	assert.Equal(t, 1, n.Poll(context.Background()))
+	assert.Empty(t, n.Pending())
+}
+
+func TestActivityNotifier_StopsWhenClientCloses(t *testing.T) {
+	c := setupClient(t, httpmock.NewMockTransport())
+	var errs []error
+	n, err := NewActivityNotifier(c, Options{
+		WebhookURL: "https://example.com/hook",
+		Interval:   time.Hour,
+		OnError:    func(id string, err error) { errs = append(errs, err) },
+	})
+	require.NoError(t, err)
+	n.Watch("act-1")
+	require.NoError(t, c.Close())
+
+	done := make(chan error, 1)
+	go func() { done <- n.Run(context.Background()) }()
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("Run did not return after the client closed")
+	}
+	assert.Empty(t, errs, "a closed client is not reported as a poll failure")
+	assert.Equal(t, []string{"act-1"}, n.Pending())
+}
+
+func TestActivityNotifier_RequiresWebhook(t *testing.T) {
+	_, err := NewActivityNotifier(setupClient(t, httpmock.NewMockTransport()), Options{})
+	assert.ErrorContains(t, err, "webhook URL is required")
+}
+
+func TestVerify(t *testing.T) {
+	secret := []byte("s3cret")
+	body := []byte(`{"activityId":"act-1"}`)
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	stale := strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10)
+
+	assert.NoError(t, Verify(secret, now, Sign(secret, now, body), body, time.Minute))
+	assert.ErrorIs(t, Verify([]byte("other"), now, Sign(secret, now, body), body, time.Minute), ErrInvalidSignature)
+	assert.ErrorIs(t, Verify(secret, now, Sign(secret, now, body), []byte(`{}`), time.Minute), ErrInvalidSignature)
+	assert.ErrorIs(t, Verify(secret, stale, Sign(secret, stale, body), body, time.Minute), ErrInvalidSignature)
+	assert.ErrorIs(t, Verify(secret, "yesterday", "sha256=00", body, time.Minute), ErrInvalidSignature)
+}
@@ -0,0 +1,182 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/sigv4"
+)
+
+// AWSCredentials sign requests to Amazon SNS and SQS.
+type AWSCredentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+
+	// SessionToken is set for temporary credentials.
+	SessionToken string
+}
+
+// AWSCredentialsFromEnv reads credentials from AWS_ACCESS_KEY_ID,
+// AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN. Other credential sources, such
+// as profiles and instance roles, are not supported.
+func AWSCredentialsFromEnv() (AWSCredentials, error) {
+	creds := AWSCredentials{
+		AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+		SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+	}
+	if creds.AccessKeyID == "" || creds.SecretAccessKey == "" {
+		return AWSCredentials{}, fmt.Errorf("AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY must be set")
+	}
+	return creds, nil
+}
+
+// SNSOptions configures an SNSPublisher.
+type SNSOptions struct {
+	// TopicARN receives the events. Required.
+	TopicARN string
+
+	// Region is the topic's region, such as "eu-west-1". Required.
+	Region string
+
+	Credentials AWSCredentials
+
+	// Endpoint overrides https://sns.<Region>.amazonaws.com/, for example
+	// for a VPC endpoint.
+	Endpoint string
+
+	// HTTPClient sends the requests. Defaults to a client with a 30 second
+	// timeout.
+	HTTPClient *http.Client
+}
+
+// SNSPublisher publishes messages to an Amazon SNS topic with the Publish
+// action, signed with Signature Version 4, so the AWS SDK is not needed. The
+// event type is sent as the "type" message attribute, for subscription filter
+// policies. For a FIFO topic, Message.Key is the message group and Message.ID
+// the deduplication ID.
+type SNSPublisher struct {
+	opts SNSOptions
+}
+
+// NewSNSPublisher returns a publisher for opts.TopicARN.
+func NewSNSPublisher(opts SNSOptions) (*SNSPublisher, error) {
+	if opts.TopicARN == "" || opts.Region == "" {
+		return nil, fmt.Errorf("topic ARN and region are required")
+	}
+	if opts.Credentials.AccessKeyID == "" || opts.Credentials.SecretAccessKey == "" {
+		return nil, fmt.Errorf("AWS credentials are required")
+	}
+	if opts.Endpoint == "" {
+		opts.Endpoint = "https://sns." + opts.Region + ".amazonaws.com/"
+	}
+	if opts.HTTPClient == nil {
+		opts.HTTPClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &SNSPublisher{opts: opts}, nil
+}
+
+// Publish sends msg to the topic.
+func (p *SNSPublisher) Publish(ctx context.Context, msg Message) error {
+	form := url.Values{
+		"Action":   {"Publish"},
+		"Version":  {"2010-03-31"},
+		"TopicArn": {p.opts.TopicARN},
+		"Message":  {string(msg.Body)},
+
+		"MessageAttributes.entry.1.Name":              {"type"},
+		"MessageAttributes.entry.1.Value.DataType":    {"String"},
+		"MessageAttributes.entry.1.Value.StringValue": {msg.Type},
+	}
+	if strings.HasSuffix(p.opts.TopicARN, ".fifo") {
+		form.Set("MessageGroupId", msg.Key)
+		form.Set("MessageDeduplicationId", msg.ID)
+	}
+	return postAWS(ctx, p.opts.HTTPClient, p.opts.Endpoint, form, p.opts.Credentials, p.opts.Region, "sns")
+}
+
+// SQSOptions configures an SQSPublisher.
+type SQSOptions struct {
+	// QueueURL receives the events, such as
+	// "https://sqs.eu-west-1.amazonaws.com/123456789012/abm-events". Required.
+	QueueURL string
+
+	// Region is the queue's region. Required.
+	Region string
+
+	Credentials AWSCredentials
+
+	// HTTPClient sends the requests. Defaults to a client with a 30 second
+	// timeout.
+	HTTPClient *http.Client
+}
+
+// SQSPublisher sends messages to an Amazon SQS queue with the SendMessage
+// action, signed with Signature Version 4, so the AWS SDK is not needed. The
+// event type is sent as the "type" message attribute. For a FIFO queue,
+// Message.Key is the message group and Message.ID the deduplication ID.
+type SQSPublisher struct {
+	opts SQSOptions
+}
+
+// NewSQSPublisher returns a publisher for opts.QueueURL.
+func NewSQSPublisher(opts SQSOptions) (*SQSPublisher, error) {
+	if opts.QueueURL == "" || opts.Region == "" {
+		return nil, fmt.Errorf("queue URL and region are required")
+	}
+	if opts.Credentials.AccessKeyID == "" || opts.Credentials.SecretAccessKey == "" {
+		return nil, fmt.Errorf("AWS credentials are required")
+	}
+	if opts.HTTPClient == nil {
+		opts.HTTPClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &SQSPublisher{opts: opts}, nil
+}
+
+// Publish sends msg to the queue.
+func (p *SQSPublisher) Publish(ctx context.Context, msg Message) error {
+	form := url.Values{
+		"Action":      {"SendMessage"},
+		"Version":     {"2012-11-05"},
+		"MessageBody": {string(msg.Body)},
+
+		"MessageAttribute.1.Name":              {"type"},
+		"MessageAttribute.1.Value.DataType":    {"String"},
+		"MessageAttribute.1.Value.StringValue": {msg.Type},
+	}
+	if strings.HasSuffix(p.opts.QueueURL, ".fifo") {
+		form.Set("MessageGroupId", msg.Key)
+		form.Set("MessageDeduplicationId", msg.ID)
+	}
+	return postAWS(ctx, p.opts.HTTPClient, p.opts.QueueURL, form, p.opts.Credentials, p.opts.Region, "sqs")
+}
+
+// postAWS posts form to an AWS query API endpoint.
+func postAWS(ctx context.Context, hc *http.Client, endpoint string, form url.Values, creds AWSCredentials, region, service string) error {
+	body := []byte(form.Encode())
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("%s: %w", service, err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	sigv4.Sign(req, sigv4.Credentials(creds), region, service, sigv4.PayloadHash(body), time.Now())
+
+	resp, err := hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s: %w", service, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+		return fmt.Errorf("%s: %s %s returned %s: %s", service, form.Get("Action"), endpoint, resp.Status, bytes.TrimSpace(data))
+	}
+	io.Copy(io.Discard, resp.Body)
+	return nil
+}
@@ -0,0 +1,102 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// KafkaRESTOptions configures a KafkaRESTPublisher.
+type KafkaRESTOptions struct {
+	// URL is the base URL of the REST Proxy, such as
+	// "https://kafka-rest.example.com:8082". Required.
+	URL string
+
+	// Topic receives the events. Required.
+	Topic string
+
+	// Username and Password, if set, are sent with HTTP basic
+	// authentication.
+	Username string
+	Password string
+
+	// HTTPClient sends the requests. Defaults to a client with a 30 second
+	// timeout.
+	HTTPClient *http.Client
+}
+
+// KafkaRESTPublisher publishes messages to a Kafka topic through the v2 API of
+// the Confluent REST Proxy, so no Kafka client library is needed. Each message
+// is produced as a JSON record keyed by Message.Key. To produce with a native
+// Kafka client instead, implement Publisher around it.
+type KafkaRESTPublisher struct {
+	opts     KafkaRESTOptions
+	endpoint string
+}
+
+// NewKafkaRESTPublisher returns a publisher that produces to opts.Topic.
+func NewKafkaRESTPublisher(opts KafkaRESTOptions) (*KafkaRESTPublisher, error) {
+	if opts.URL == "" || opts.Topic == "" {
+		return nil, fmt.Errorf("REST Proxy URL and topic are required")
+	}
+	if opts.HTTPClient == nil {
+		opts.HTTPClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	endpoint := strings.TrimSuffix(opts.URL, "/") + "/topics/" + url.PathEscape(opts.Topic)
+	return &KafkaRESTPublisher{opts: opts, endpoint: endpoint}, nil
+}
+
+// Publish produces msg as a single record.
+func (p *KafkaRESTPublisher) Publish(ctx context.Context, msg Message) error {
+	type record struct {
+		Key   string          `json:"key"`
+		Value json.RawMessage `json:"value"`
+	}
+	body, err := json.Marshal(struct {
+		Records []record `json:"records"`
+	}{[]record{{Key: msg.Key, Value: msg.Body}}})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("kafka: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/vnd.kafka.json.v2+json")
+	req.Header.Set("Accept", "application/vnd.kafka.v2+json")
+	if p.opts.Username != "" {
+		req.SetBasicAuth(p.opts.Username, p.opts.Password)
+	}
+
+	resp, err := p.opts.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("kafka: %w", err)
+	}
+	defer resp.Body.Close()
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("kafka: produce to %s returned %s: %s", p.opts.Topic, resp.Status, bytes.TrimSpace(data))
+	}
+
+	// The proxy reports per-record failures in a 200 response.
+	var result struct {
+		Offsets []struct {
+			Error *string `json:"error"`
+		} `json:"offsets"`
+	}
+	if err := json.Unmarshal(data, &result); err == nil {
+		for _, o := range result.Offsets {
+			if o.Error != nil && *o.Error != "" {
+				return fmt.Errorf("kafka: produce to %s: %s", p.opts.Topic, *o.Error)
+			}
+		}
+	}
+	return nil
+}
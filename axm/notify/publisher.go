@@ -0,0 +1,101 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devicemanagement"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/reports"
+)
+
+// Message is one event handed to a Publisher.
+type Message struct {
+	// ID is unique to the event. Publishers use it for deduplication where
+	// the broker supports it.
+	ID string
+
+	// Key is the device or server ID the event is about. Publishers use it
+	// as the partition key or message group, so the events for one device
+	// stay in order.
+	Key string
+
+	// Type is the DeviceEvent type, such as EventDeviceUpserted. Publishers
+	// send it as a message attribute or header where the broker has them.
+	Type string
+
+	// Body is the DeviceEvent as JSON.
+	Body []byte
+}
+
+// Publisher sends messages to a message broker. Implement it to publish
+// inventory events to a broker without a reference implementation here; for
+// example, wrap the WriteMessages method of a Kafka client library.
+type Publisher interface {
+	Publish(ctx context.Context, msg Message) error
+}
+
+// PublisherFunc adapts a function to a Publisher.
+type PublisherFunc func(ctx context.Context, msg Message) error
+
+// Publish calls f.
+func (f PublisherFunc) Publish(ctx context.Context, msg Message) error { return f(ctx, msg) }
+
+// PublisherOptions configures a PublisherSink.
+type PublisherOptions struct {
+	// DeadLetter, if set, receives each event the publisher rejected, with
+	// its error. If it returns nil the event is dropped and the sync carries
+	// on; otherwise, or if DeadLetter is not set, the sink returns the error
+	// and the inventorysync engine sends the change again on its next pass.
+	DeadLetter func(ev DeviceEvent, err error) error
+}
+
+// PublisherSink publishes inventory changes as DeviceEvents through a
+// Publisher, to feed event-driven systems from the inventorysync engine. It
+// implements inventorysync.Sink. Retries are left to the publisher.
+type PublisherSink struct {
+	pub  Publisher
+	opts PublisherOptions
+}
+
+// NewPublisherSink returns a sink that publishes through pub.
+func NewPublisherSink(pub Publisher, opts PublisherOptions) (*PublisherSink, error) {
+	if pub == nil {
+		return nil, fmt.Errorf("publisher is required")
+	}
+	return &PublisherSink{pub: pub, opts: opts}, nil
+}
+
+// UpsertDevice publishes a device.upserted event keyed by the device ID.
+func (s *PublisherSink) UpsertDevice(ctx context.Context, d reports.DeviceWithServer) error {
+	return s.publish(ctx, d.ID, deviceUpsertedEvent(d))
+}
+
+// UpsertServer publishes a server.upserted event keyed by the server ID.
+func (s *PublisherSink) UpsertServer(ctx context.Context, server devicemanagement.MDMServer) error {
+	return s.publish(ctx, server.ID, serverUpsertedEvent(server))
+}
+
+// DeleteDevice publishes a device.deleted event keyed by the device ID.
+func (s *PublisherSink) DeleteDevice(ctx context.Context, deviceID string) error {
+	return s.publish(ctx, deviceID, deviceDeletedEvent(deviceID))
+}
+
+// Flush does nothing; events are published as they happen.
+func (s *PublisherSink) Flush(context.Context) error { return nil }
+
+func (s *PublisherSink) publish(ctx context.Context, key string, ev DeviceEvent) error {
+	body, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	err = s.pub.Publish(ctx, Message{ID: ev.ID, Key: key, Type: ev.Type, Body: body})
+	if err == nil {
+		return nil
+	}
+	err = fmt.Errorf("publish %s %s: %w", ev.Type, ev.ID, err)
+	if s.opts.DeadLetter == nil {
+		return err
+	}
+	return s.opts.DeadLetter(ev, err)
+}
@@ -0,0 +1,156 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/inventorysync"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/reports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ inventorysync.Sink = (*PublisherSink)(nil)
+
+func TestPublisherSink(t *testing.T) {
+	var published []Message
+	pub := PublisherFunc(func(_ context.Context, msg Message) error {
+		published = append(published, msg)
+		if msg.Key == "BAD" {
+			return errors.New("broker unavailable")
+		}
+		return nil
+	})
+	var dead []DeviceEvent
+	sink, err := NewPublisherSink(pub, PublisherOptions{DeadLetter: func(ev DeviceEvent, err error) error {
+		dead = append(dead, ev)
+		return nil
+	}})
+	require.NoError(t, err)
+
+	d := reports.DeviceWithServer{ServerID: "SRV1"}
+	d.ID = "D1"
+	require.NoError(t, sink.UpsertDevice(context.Background(), d))
+	require.NoError(t, sink.DeleteDevice(context.Background(), "BAD"))
+
+	require.Len(t, published, 2)
+	msg := published[0]
+	assert.Equal(t, "D1", msg.Key)
+	assert.Equal(t, EventDeviceUpserted, msg.Type)
+	var ev DeviceEvent
+	require.NoError(t, json.Unmarshal(msg.Body, &ev))
+	assert.Equal(t, msg.ID, ev.ID)
+	assert.Equal(t, "SRV1", ev.ServerID)
+
+	require.Len(t, dead, 1)
+	assert.Equal(t, EventDeviceDeleted, dead[0].Type)
+
+	_, err = NewPublisherSink(nil, PublisherOptions{})
+	assert.ErrorContains(t, err, "publisher is required")
+}
+
+// capture is an endpoint that records the last request and answers with
+// status and body.
+type capture struct {
+	status int
+	body   string
+	req    *http.Request
+	data   []byte
+}
+
+func (c *capture) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	c.req = r
+	c.data, _ = io.ReadAll(r.Body)
+	rw.WriteHeader(c.status)
+	io.WriteString(rw, c.body)
+}
+
+func newCapture(t *testing.T, status int, body string) (*capture, string) {
+	t.Helper()
+	c := &capture{status: status, body: body}
+	server := httptest.NewServer(c)
+	t.Cleanup(server.Close)
+	return c, server.URL
+}
+
+func TestKafkaRESTPublisher(t *testing.T) {
+	proxy, proxyURL := newCapture(t, http.StatusOK, `{"offsets":[{"partition":0,"offset":7,"error_code":null,"error":null}]}`)
+	pub, err := NewKafkaRESTPublisher(KafkaRESTOptions{URL: proxyURL + "/", Topic: "abm.events", Username: "u", Password: "p"})
+	require.NoError(t, err)
+
+	msg := Message{ID: "1", Key: "D1", Type: EventDeviceUpserted, Body: []byte(`{"deviceId":"D1"}`)}
+	require.NoError(t, pub.Publish(context.Background(), msg))
+	assert.Equal(t, "/topics/abm.events", proxy.req.URL.Path)
+	assert.Equal(t, "application/vnd.kafka.json.v2+json", proxy.req.Header.Get("Content-Type"))
+	user, pass, _ := proxy.req.BasicAuth()
+	assert.Equal(t, "u:p", user+":"+pass)
+	assert.JSONEq(t, `{"records":[{"key":"D1","value":{"deviceId":"D1"}}]}`, string(proxy.data))
+
+	proxy.body = `{"offsets":[{"partition":null,"offset":null,"error_code":50002,"error":"Kafka error"}]}`
+	assert.ErrorContains(t, pub.Publish(context.Background(), msg), "kafka: produce to abm.events: Kafka error")
+
+	proxy.status, proxy.body = http.StatusNotFound, `{"error_code":40401,"message":"Topic not found."}`
+	assert.ErrorContains(t, pub.Publish(context.Background(), msg), "404 Not Found")
+}
+
+var testAWSCredentials = AWSCredentials{AccessKeyID: "AKIDEXAMPLE", SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY"}
+
+func TestSNSPublisher(t *testing.T) {
+	sns, endpoint := newCapture(t, http.StatusOK, `<PublishResponse/>`)
+	creds := testAWSCredentials
+	creds.SessionToken = "session"
+	pub, err := NewSNSPublisher(SNSOptions{
+		TopicARN:    "arn:aws:sns:eu-west-1:123456789012:abm-events.fifo",
+		Region:      "eu-west-1",
+		Credentials: creds,
+		Endpoint:    endpoint,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, pub.Publish(context.Background(), Message{ID: "ev-1", Key: "D1", Type: EventDeviceDeleted, Body: []byte(`{"id":"ev-1"}`)}))
+	form, err := url.ParseQuery(string(sns.data))
+	require.NoError(t, err)
+	assert.Equal(t, "Publish", form.Get("Action"))
+	assert.Equal(t, `{"id":"ev-1"}`, form.Get("Message"))
+	assert.Equal(t, "type", form.Get("MessageAttributes.entry.1.Name"))
+	assert.Equal(t, EventDeviceDeleted, form.Get("MessageAttributes.entry.1.Value.StringValue"))
+	assert.Equal(t, "D1", form.Get("MessageGroupId"))
+	assert.Equal(t, "ev-1", form.Get("MessageDeduplicationId"))
+
+	auth := sns.req.Header.Get("Authorization")
+	assert.True(t, strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/"), auth)
+	assert.Contains(t, auth, "/eu-west-1/sns/aws4_request")
+	assert.Contains(t, auth, "SignedHeaders=content-type;host;x-amz-date;x-amz-security-token,")
+	assert.Equal(t, "session", sns.req.Header.Get("X-Amz-Security-Token"))
+
+	_, err = NewSNSPublisher(SNSOptions{TopicARN: "arn", Region: "eu-west-1"})
+	assert.ErrorContains(t, err, "AWS credentials are required")
+}
+
+func TestSQSPublisher(t *testing.T) {
+	sqs, endpoint := newCapture(t, http.StatusOK, `<SendMessageResponse/>`)
+	pub, err := NewSQSPublisher(SQSOptions{QueueURL: endpoint + "/123456789012/abm-events", Region: "eu-west-1", Credentials: testAWSCredentials})
+	require.NoError(t, err)
+
+	require.NoError(t, pub.Publish(context.Background(), Message{ID: "ev-1", Key: "D1", Type: EventDeviceUpserted, Body: []byte(`{}`)}))
+	assert.Equal(t, "/123456789012/abm-events", sqs.req.URL.Path)
+	form, err := url.ParseQuery(string(sqs.data))
+	require.NoError(t, err)
+	assert.Equal(t, "SendMessage", form.Get("Action"))
+	assert.Equal(t, "{}", form.Get("MessageBody"))
+	assert.Equal(t, EventDeviceUpserted, form.Get("MessageAttribute.1.Value.StringValue"))
+	assert.Empty(t, form.Get("MessageGroupId"), "standard queues have no message group")
+	assert.Contains(t, sqs.req.Header.Get("Authorization"), "/eu-west-1/sqs/aws4_request")
+
+	sqs.status, sqs.body = http.StatusForbidden, `<ErrorResponse><Error><Code>AccessDenied</Code></Error></ErrorResponse>`
+	err = pub.Publish(context.Background(), Message{Body: []byte(`{}`)})
+	assert.ErrorContains(t, err, "sqs: SendMessage")
+	assert.ErrorContains(t, err, "AccessDenied")
+}
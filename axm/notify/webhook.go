@@ -0,0 +1,252 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devicemanagement"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devices"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/reports"
+)
+
+// HeaderDelivery carries a unique ID for each event, the same on every retry,
+// so receivers can discard duplicates.
+const HeaderDelivery = "X-AXM-Delivery"
+
+// Device event types.
+const (
+	EventDeviceUpserted = "device.upserted"
+	EventDeviceDeleted  = "device.deleted"
+	EventServerUpserted = "server.upserted"
+)
+
+// DeviceEvent is the JSON payload WebhookSink posts, and PublisherSink
+// publishes, for each inventory change.
+type DeviceEvent struct {
+	// ID identifies the event; it is also sent in HeaderDelivery.
+	ID   string `json:"id"`
+	Type string `json:"type"`
+
+	DeviceID string                      `json:"deviceId,omitempty"`
+	Device   *devices.OrgDevice          `json:"device,omitempty"`
+	ServerID string                      `json:"serverId,omitempty"`
+	Server   *devicemanagement.MDMServer `json:"server,omitempty"`
+
+	// ServerName is the name of the service a device is assigned to, for
+	// device.upserted events.
+	ServerName string `json:"serverName,omitempty"`
+
+	ObservedAt time.Time `json:"observedAt"`
+}
+
+func newDeviceEvent(eventType string) DeviceEvent {
+	return DeviceEvent{ID: newEventID(), Type: eventType, ObservedAt: time.Now().UTC()}
+}
+
+func deviceUpsertedEvent(d reports.DeviceWithServer) DeviceEvent {
+	ev := newDeviceEvent(EventDeviceUpserted)
+	ev.DeviceID, ev.Device = d.ID, &d.OrgDevice
+	ev.ServerID, ev.ServerName = d.ServerID, d.ServerName
+	return ev
+}
+
+func serverUpsertedEvent(server devicemanagement.MDMServer) DeviceEvent {
+	ev := newDeviceEvent(EventServerUpserted)
+	ev.ServerID, ev.Server = server.ID, &server
+	return ev
+}
+
+func deviceDeletedEvent(deviceID string) DeviceEvent {
+	ev := newDeviceEvent(EventDeviceDeleted)
+	ev.DeviceID = deviceID
+	return ev
+}
+
+// WebhookOptions configures a WebhookSink.
+type WebhookOptions struct {
+	// URL receives a POST for every event. Required.
+	URL string
+
+	// Secret signs each payload; see HeaderSignature. Payloads are unsigned
+	// when it is empty.
+	Secret []byte
+
+	// HTTPClient delivers events. Defaults to a client with a 30 second
+	// timeout.
+	HTTPClient *http.Client
+
+	// MaxAttempts is how many times an event is posted before it is dead
+	// lettered. Defaults to 5.
+	MaxAttempts int
+
+	// Backoff is the wait before the first retry, doubling for each retry
+	// after it up to one minute. A Retry-After header on a 429 or 503 response
+	// is honoured instead. Defaults to one second.
+	Backoff time.Duration
+
+	// DeadLetter, if set, receives each event that could not be delivered,
+	// with the last error. If it returns nil the event is dropped and the
+	// sync carries on; otherwise, or if DeadLetter is not set, the sink
+	// returns the error and the inventorysync engine sends the change again
+	// on its next pass.
+	DeadLetter func(ev DeviceEvent, err error) error
+}
+
+// WebhookSink delivers inventory changes as signed HTTP POSTs, so systems such
+// as ServiceNow can consume them without polling. It implements
+// inventorysync.Sink: pass it to inventorysync.NewEngine. Each event is posted
+// as soon as the engine reports the change, and retried on network errors,
+// 429 and 5xx responses; other responses are not retried.
+type WebhookSink struct {
+	opts WebhookOptions
+}
+
+// NewWebhookSink returns a sink that posts DeviceEvents to opts.URL.
+func NewWebhookSink(opts WebhookOptions) (*WebhookSink, error) {
+	if opts.URL == "" {
+		return nil, fmt.Errorf("webhook URL is required")
+	}
+	if opts.HTTPClient == nil {
+		opts.HTTPClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = 5
+	}
+	if opts.Backoff <= 0 {
+		opts.Backoff = time.Second
+	}
+	return &WebhookSink{opts: opts}, nil
+}
+
+// UpsertDevice posts a device.upserted event.
+func (s *WebhookSink) UpsertDevice(ctx context.Context, d reports.DeviceWithServer) error {
+	return s.send(ctx, deviceUpsertedEvent(d))
+}
+
+// UpsertServer posts a server.upserted event.
+func (s *WebhookSink) UpsertServer(ctx context.Context, server devicemanagement.MDMServer) error {
+	return s.send(ctx, serverUpsertedEvent(server))
+}
+
+// DeleteDevice posts a device.deleted event.
+func (s *WebhookSink) DeleteDevice(ctx context.Context, deviceID string) error {
+	return s.send(ctx, deviceDeletedEvent(deviceID))
+}
+
+// Flush does nothing; events are delivered as they happen.
+func (s *WebhookSink) Flush(context.Context) error { return nil }
+
+// send delivers ev, retrying and dead lettering as configured.
+func (s *WebhookSink) send(ctx context.Context, ev DeviceEvent) error {
+	body, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+
+	backoff := s.opts.Backoff
+	for attempt := 1; ; attempt++ {
+		err = postWebhook(ctx, s.opts.HTTPClient, s.opts.URL, s.opts.Secret, body, http.Header{HeaderDelivery: {ev.ID}})
+		if err == nil {
+			return nil
+		}
+		var status *webhookStatusError
+		retryable := !errors.As(err, &status) || status.retryable()
+		if !retryable || attempt >= s.opts.MaxAttempts || ctx.Err() != nil {
+			break
+		}
+
+		wait := backoff
+		if status != nil && status.retryAfter > 0 {
+			wait = status.retryAfter
+		}
+		backoff = min(backoff*2, time.Minute)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+
+	err = fmt.Errorf("deliver %s %s: %w", ev.Type, ev.ID, err)
+	if s.opts.DeadLetter == nil {
+		return err
+	}
+	return s.opts.DeadLetter(ev, err)
+}
+
+// JSONLinesDeadLetter returns a DeadLetter function, for WebhookOptions or
+// PublisherOptions, that appends each undeliverable event to w as a JSON line
+// with its error, for replay later.
+func JSONLinesDeadLetter(w io.Writer) func(DeviceEvent, error) error {
+	enc := json.NewEncoder(w)
+	return func(ev DeviceEvent, err error) error {
+		return enc.Encode(struct {
+			Event DeviceEvent `json:"event"`
+			Error string      `json:"error"`
+		}{ev, err.Error()})
+	}
+}
+
+// webhookStatusError is a webhook response with a status of 300 or more.
+type webhookStatusError struct {
+	url        string
+	status     string
+	code       int
+	retryAfter time.Duration
+}
+
+func (e *webhookStatusError) Error() string {
+	return fmt.Sprintf("webhook: %s returned %s", e.url, e.status)
+}
+
+func (e *webhookStatusError) retryable() bool {
+	return e.code == http.StatusTooManyRequests || e.code >= 500
+}
+
+// postWebhook posts body to url with header, signed when secret is set.
+func postWebhook(ctx context.Context, hc *http.Client, url string, secret, body []byte, header http.Header) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("webhook: %w", err)
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if len(secret) > 0 {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(HeaderTimestamp, timestamp)
+		req.Header.Set(HeaderSignature, Sign(secret, timestamp, body))
+	}
+
+	resp, err := hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook: %w", err)
+	}
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		statusErr := &webhookStatusError{url: url, status: resp.Status, code: resp.StatusCode}
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds > 0 {
+			statusErr.retryAfter = time.Duration(seconds) * time.Second
+		}
+		return statusErr
+	}
+	return nil
+}
+
+// newEventID returns a random 128-bit hex ID.
+func newEventID() string {
+	var b [16]byte
+	rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
@@ -0,0 +1,125 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devicemanagement"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devices"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/inventorysync"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/reports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ inventorysync.Sink = (*WebhookSink)(nil)
+
+// statusSequence is a webhook endpoint that answers with each status in turn,
+// then with the last one, recording the requests it receives.
+type statusSequence struct {
+	mu       sync.Mutex
+	statuses []int
+	requests []*http.Request
+	events   []DeviceEvent
+	bodies   [][]byte
+}
+
+func (s *statusSequence) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var ev DeviceEvent
+	json.Unmarshal(body, &ev)
+	s.requests = append(s.requests, r)
+	s.events = append(s.events, ev)
+	s.bodies = append(s.bodies, body)
+	status := s.statuses[min(len(s.requests), len(s.statuses))-1]
+	if status == http.StatusTooManyRequests {
+		rw.Header().Set("Retry-After", "0")
+	}
+	rw.WriteHeader(status)
+}
+
+func newTestWebhookSink(t *testing.T, hook http.Handler, opts WebhookOptions) *WebhookSink {
+	t.Helper()
+	server := httptest.NewServer(hook)
+	t.Cleanup(server.Close)
+	opts.URL = server.URL
+	opts.Backoff = time.Millisecond
+	sink, err := NewWebhookSink(opts)
+	require.NoError(t, err)
+	return sink
+}
+
+func TestWebhookSink_RetriesAndSigns(t *testing.T) {
+	hook := &statusSequence{statuses: []int{http.StatusBadGateway, http.StatusTooManyRequests, http.StatusOK}}
+	secret := []byte("s3cret")
+	sink := newTestWebhookSink(t, hook, WebhookOptions{Secret: secret})
+
+	device := reports.DeviceWithServer{
+		OrgDevice:  devices.OrgDevice{ID: "D1", Attributes: &devices.OrgDeviceAttributes{SerialNumber: "AAA"}},
+		ServerID:   "SRV1",
+		ServerName: "Jamf Pro",
+	}
+	require.NoError(t, sink.UpsertDevice(context.Background(), device))
+
+	require.Len(t, hook.requests, 3)
+	ev := hook.events[2]
+	assert.Equal(t, EventDeviceUpserted, ev.Type)
+	assert.Equal(t, "D1", ev.DeviceID)
+	assert.Equal(t, "AAA", ev.Device.Attributes.SerialNumber)
+	assert.Equal(t, "Jamf Pro", ev.ServerName)
+
+	ids := map[string]bool{}
+	for _, r := range hook.requests {
+		ids[r.Header.Get(HeaderDelivery)] = true
+	}
+	assert.Equal(t, map[string]bool{ev.ID: true}, ids, "retries reuse the delivery ID")
+
+	last := hook.requests[2]
+	assert.NoError(t, Verify(secret, last.Header.Get(HeaderTimestamp), last.Header.Get(HeaderSignature), hook.bodies[2], time.Minute))
+}
+
+func TestWebhookSink_DeadLetter(t *testing.T) {
+	hook := &statusSequence{statuses: []int{http.StatusInternalServerError}}
+	var dead bytes.Buffer
+	sink := newTestWebhookSink(t, hook, WebhookOptions{MaxAttempts: 3, DeadLetter: JSONLinesDeadLetter(&dead)})
+
+	require.NoError(t, sink.DeleteDevice(context.Background(), "D9"), "dead-lettered events do not fail the sync")
+	assert.Len(t, hook.requests, 3)
+
+	var entry struct {
+		Event DeviceEvent `json:"event"`
+		Error string      `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal(dead.Bytes(), &entry))
+	assert.Equal(t, EventDeviceDeleted, entry.Event.Type)
+	assert.Equal(t, "D9", entry.Event.DeviceID)
+	assert.Contains(t, entry.Error, "500 Internal Server Error")
+}
+
+func TestWebhookSink_PermanentFailure(t *testing.T) {
+	hook := &statusSequence{statuses: []int{http.StatusBadRequest}}
+	sink := newTestWebhookSink(t, hook, WebhookOptions{})
+
+	err := sink.UpsertServer(context.Background(), devicemanagement.MDMServer{ID: "SRV1"})
+	require.ErrorContains(t, err, "deliver server.upserted")
+	assert.Len(t, hook.requests, 1, "4xx responses are not retried")
+
+	failing := errors.New("dead letter queue unavailable")
+	sink.opts.DeadLetter = func(DeviceEvent, error) error { return failing }
+	assert.ErrorIs(t, sink.DeleteDevice(context.Background(), "D1"), failing)
+}
+
+func TestNewWebhookSink_RequiresURL(t *testing.T) {
+	_, err := NewWebhookSink(WebhookOptions{})
+	assert.ErrorContains(t, err, "webhook URL is required")
+}
@@ -0,0 +1,248 @@
+// Package reconcile keeps devices assigned to the MDM servers they belong on.
+//
+// An Engine compares each Policy — a set of serial numbers and the server
+// they should be on — with Apple Business Manager, assigns the devices that
+// are missing and, with Prune, unassigns devices that should not be there. A
+// Runner repeats that on a Schedule, with a random delay before each run, and
+// reports every run to its Hooks.
+//
+//	engine, _ := reconcile.NewEngine(c, []reconcile.Policy{{
+//		Name:        "kiosks",
+//		MDMServerID: serverID,
+//		DeviceIDs:   serials,
+//	}}, reconcile.Options{})
+//	schedule, _ := reconcile.ParseSchedule("*/30 * * * *")
+//	runner := reconcile.NewRunner(engine, reconcile.RunnerOptions{
+//		Schedule: schedule,
+//		Jitter:   2 * time.Minute,
+//		Hooks:    []reconcile.Hook{reconcile.NewReportDirHook("/var/lib/axm/reports", nil)},
+//	})
+//	runner.Run(ctx)
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devicemanagement"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/reports"
+)
+
+// Policy is the set of devices that belong on one MDM server.
+type Policy struct {
+	// Name identifies the policy in reports. Defaults to MDMServerID.
+	Name string `json:"name"`
+
+	// MDMServerID is the server the devices belong on.
+	MDMServerID string `json:"mdmServerId"`
+
+	// DeviceIDs are the serial numbers of the devices. Case and surrounding
+	// space are ignored.
+	DeviceIDs []string `json:"deviceIds"`
+
+	// Prune unassigns devices on the server that are not in DeviceIDs. Without
+	// it, such devices are only reported.
+	Prune bool `json:"prune,omitempty"`
+}
+
+// Options configures an Engine.
+type Options struct {
+	// DryRun reports what would change without assigning or unassigning
+	// anything.
+	DryRun bool
+
+	// Retry is passed to AssignDevicesWithRetryV1 and
+	// UnassignDevicesWithRetryV1. Nil submits once per run; devices that
+	// still fail are picked up again by the next run.
+	Retry *devicemanagement.AssignmentRetryOptions
+}
+
+// PolicyReport is the outcome of reconciling one policy.
+type PolicyReport struct {
+	Name        string `json:"name"`
+	MDMServerID string `json:"mdmServerId"`
+
+	// Expected is the number of devices the policy names.
+	Expected int `json:"expected"`
+
+	// Missing are the devices that were not on the server, and Extra the
+	// devices on the server the policy does not name. Both are sorted.
+	Missing []string `json:"missing,omitempty"`
+	Extra   []string `json:"extra,omitempty"`
+
+	// Assigned and Unassigned are the outcomes of the activities submitted
+	// for Missing and, with Prune, Extra. They are nil when nothing was
+	// submitted.
+	Assigned   *devicemanagement.AssignmentResult `json:"assigned,omitempty"`
+	Unassigned *devicemanagement.AssignmentResult `json:"unassigned,omitempty"`
+
+	// Error is why the policy could not be reconciled.
+	Error string `json:"error,omitempty"`
+
+	err error
+}
+
+// InSync reports whether the server held exactly the policy's devices when
+// the run started.
+func (p *PolicyReport) InSync() bool {
+	return len(p.Missing) == 0 && len(p.Extra) == 0 && p.Error == ""
+}
+
+// Err returns the error reconciling the policy, or the devices that failed
+// to move as a *client.MultiError, or nil.
+func (p *PolicyReport) Err() error {
+	if p.err != nil {
+		return p.err
+	}
+	var errs []error
+	for _, r := range []*devicemanagement.AssignmentResult{p.Assigned, p.Unassigned} {
+		if r == nil {
+			continue
+		}
+		if err := r.Err(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Report is the outcome of one reconciliation run.
+type Report struct {
+	// Run numbers the runs of a Runner from 1. It is 0 for a run started
+	// with Engine.Reconcile.
+	Run int `json:"run"`
+
+	// ScheduledAt is when a Runner's run was due, before jitter.
+	ScheduledAt time.Time `json:"scheduledAt,omitzero"`
+
+	StartedAt  time.Time `json:"startedAt"`
+	FinishedAt time.Time `json:"finishedAt,omitzero"`
+	DryRun     bool      `json:"dryRun,omitempty"`
+
+	Policies []PolicyReport `json:"policies"`
+}
+
+// InSync reports whether every policy was already in sync.
+func (r *Report) InSync() bool {
+	for i := range r.Policies {
+		if !r.Policies[i].InSync() {
+			return false
+		}
+	}
+	return true
+}
+
+// Err joins the errors of every policy, each prefixed with its name, or
+// returns nil.
+func (r *Report) Err() error {
+	var errs []error
+	for i := range r.Policies {
+		if err := r.Policies[i].Err(); err != nil {
+			errs = append(errs, fmt.Errorf("policy %s: %w", r.Policies[i].Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Engine reconciles a fixed set of policies. It is safe for concurrent use;
+// runs never overlap.
+type Engine struct {
+	client   *axm.Client
+	policies []Policy
+	opts     Options
+
+	mu sync.Mutex
+}
+
+// NewEngine returns an engine that reconciles policies through c. Device IDs
+// are normalised to upper case. A device may only appear in one policy, as
+// it can only be on one server.
+func NewEngine(c *axm.Client, policies []Policy, opts Options) (*Engine, error) {
+	if len(policies) == 0 {
+		return nil, fmt.Errorf("at least one policy is required")
+	}
+	owner := make(map[string]string)
+	normalised := make([]Policy, len(policies))
+	for i, p := range policies {
+		if err := client.ValidateID("MDM server ID", p.MDMServerID); err != nil {
+			return nil, fmt.Errorf("policy %d: %w", i, err)
+		}
+		if p.Name == "" {
+			p.Name = p.MDMServerID
+		}
+		ids := make([]string, 0, len(p.DeviceIDs))
+		for _, id := range p.DeviceIDs {
+			id = strings.ToUpper(strings.TrimSpace(id))
+			if id == "" {
+				continue
+			}
+			if other, ok := owner[id]; ok && other != p.Name {
+				return nil, fmt.Errorf("device %s is in policies %s and %s", id, other, p.Name)
+			}
+			owner[id] = p.Name
+			ids = append(ids, id)
+		}
+		p.DeviceIDs = ids
+		normalised[i] = p
+	}
+	return &Engine{client: c, policies: normalised, opts: opts}, nil
+}
+
+// Reconcile runs every policy in order. A policy that fails is recorded in
+// its PolicyReport and the rest still run; the returned error is only
+// non-nil when ctx ends or the client is closed, and the report so far is
+// returned with it. Use Report.Err for the policies' errors.
+func (e *Engine) Reconcile(ctx context.Context) (*Report, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	report := &Report{StartedAt: time.Now().UTC(), DryRun: e.opts.DryRun}
+	for _, p := range e.policies {
+		pr := e.reconcilePolicy(ctx, p)
+		report.Policies = append(report.Policies, pr)
+		if pr.err != nil && (ctx.Err() != nil || errors.Is(pr.err, client.ErrClientClosed)) {
+			report.FinishedAt = time.Now().UTC()
+			return report, pr.err
+		}
+	}
+	report.FinishedAt = time.Now().UTC()
+	return report, nil
+}
+
+func (e *Engine) reconcilePolicy(ctx context.Context, p Policy) PolicyReport {
+	pr := PolicyReport{Name: p.Name, MDMServerID: p.MDMServerID, Expected: len(p.DeviceIDs)}
+	fail := func(err error) PolicyReport {
+		pr.err, pr.Error = err, err.Error()
+		return pr
+	}
+
+	diff, err := reports.CompareServerToExpected(ctx, e.client, p.MDMServerID, p.DeviceIDs)
+	if err != nil {
+		return fail(err)
+	}
+	pr.Missing, pr.Extra = diff.OnlyInB, diff.OnlyInA
+	if e.opts.DryRun {
+		return pr
+	}
+
+	dm := e.client.AXMAPI.DeviceManagement
+	if len(pr.Missing) > 0 {
+		pr.Assigned, err = dm.AssignDevicesWithRetryV1(ctx, p.MDMServerID, pr.Missing, e.opts.Retry)
+		if err != nil {
+			return fail(fmt.Errorf("assign: %w", err))
+		}
+	}
+	if p.Prune && len(pr.Extra) > 0 {
+		pr.Unassigned, err = dm.UnassignDevicesWithRetryV1(ctx, p.MDMServerID, pr.Extra, e.opts.Retry)
+		if err != nil {
+			return fail(fmt.Errorf("unassign: %w", err))
+		}
+	}
+	return pr
+}
@@ -0,0 +1,204 @@
+package reconcile
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/client"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"resty.dev/v3"
+)
+
+type noAuth struct{}
+
+func (noAuth) ApplyAuth(*resty.Request) error { return nil }
+
+func jsonResponder(status int, body string) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		resp := httpmock.NewStringResponse(status, body)
+		resp.Header.Set("Content-Type", "application/json")
+		return resp, nil
+	}
+}
+
+func setupClient(t *testing.T, mt *httpmock.MockTransport) *axm.Client {
+	t.Helper()
+	c, err := axm.NewClient("key-id", "issuer-id", "unused",
+		client.WithAuth(noAuth{}),
+		axm.WithTransport(mt),
+		axm.WithRetryCount(0),
+	)
+	require.NoError(t, err)
+	return c
+}
+
+// activityRecorder answers activity creation and records what was submitted.
+// Every activity completes at once, without a report.
+type activityRecorder struct {
+	mu        sync.Mutex
+	submitted []submittedActivity
+}
+
+type submittedActivity struct {
+	ActivityType string
+	ServerID     string
+	DeviceIDs    []string
+}
+
+func (a *activityRecorder) register(mt *httpmock.MockTransport) {
+	mt.RegisterResponder("POST", "https://api-business.apple.com/v1/orgDeviceActivities", func(req *http.Request) (*http.Response, error) {
+		body, _ := io.ReadAll(req.Body)
+		var doc struct {
+			Data struct {
+				Attributes struct {
+					ActivityType string `json:"activityType"`
+				} `json:"attributes"`
+				Relationships struct {
+					MDMServer struct {
+						Data struct{ ID string } `json:"data"`
+					} `json:"mdmServer"`
+					Devices struct {
+						Data []struct{ ID string } `json:"data"`
+					} `json:"devices"`
+				} `json:"relationships"`
+			} `json:"data"`
+		}
+		if err := json.Unmarshal(body, &doc); err != nil {
+			return nil, err
+		}
+		s := submittedActivity{ActivityType: doc.Data.Attributes.ActivityType, ServerID: doc.Data.Relationships.MDMServer.Data.ID}
+		for _, d := range doc.Data.Relationships.Devices.Data {
+			s.DeviceIDs = append(s.DeviceIDs, d.ID)
+		}
+		a.mu.Lock()
+		a.submitted = append(a.submitted, s)
+		a.mu.Unlock()
+		return jsonResponder(201, `{"data":{"type":"orgDeviceActivities","id":"act-1","attributes":{"status":"IN_PROGRESS"}}}`)(req)
+	})
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDeviceActivities/act-1",
+		jsonResponder(200, `{"data":{"type":"orgDeviceActivities","id":"act-1","attributes":{"status":"COMPLETED"}}}`))
+}
+
+func registerServerDevices(mt *httpmock.MockTransport) {
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/mdmServers/SRV1/relationships/devices",
+		jsonResponder(200, `{"data":[{"type":"orgDevices","id":"D1"},{"type":"orgDevices","id":"D2"}]}`))
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/mdmServers/SRV2/relationships/devices",
+		jsonResponder(200, `{"data":[{"type":"orgDevices","id":"D3"}]}`))
+}
+
+func TestNewEngine_Validation(t *testing.T) {
+	c := setupClient(t, httpmock.NewMockTransport())
+
+	_, err := NewEngine(c, nil, Options{})
+	assert.ErrorContains(t, err, "at least one policy is required")
+
+	_, err = NewEngine(c, []Policy{{Name: "no server", DeviceIDs: []string{"D1"}}}, Options{})
+	assert.ErrorContains(t, err, "policy 0")
+
+	_, err = NewEngine(c, []Policy{
+		{Name: "kiosks", MDMServerID: "SRV1", DeviceIDs: []string{"d1"}},
+		{Name: "staff", MDMServerID: "SRV2", DeviceIDs: []string{" D1 "}},
+	}, Options{})
+	assert.EqualError(t, err, "device D1 is in policies kiosks and staff")
+}
+
+func TestEngine_Reconcile(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	registerServerDevices(mt)
+	activities := &activityRecorder{}
+	activities.register(mt)
+	c := setupClient(t, mt)
+
+	engine, err := NewEngine(c, []Policy{
+		{Name: "kiosks", MDMServerID: "SRV1", DeviceIDs: []string{"d2", "D4"}},
+		{MDMServerID: "SRV2", DeviceIDs: []string{"D5"}, Prune: true},
+	}, Options{})
+	require.NoError(t, err)
+
+	report, err := engine.Reconcile(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, report.Err())
+	assert.False(t, report.InSync())
+	require.Len(t, report.Policies, 2)
+
+	kiosks := report.Policies[0]
+	assert.Equal(t, "kiosks", kiosks.Name)
+	assert.Equal(t, 2, kiosks.Expected)
+	assert.Equal(t, []string{"D4"}, kiosks.Missing)
+	assert.Equal(t, []string{"D1"}, kiosks.Extra, "extra devices are reported")
+	require.NotNil(t, kiosks.Assigned)
+	assert.True(t, kiosks.Assigned.AllSucceeded())
+	assert.Nil(t, kiosks.Unassigned, "extra devices are left alone without Prune")
+
+	pruned := report.Policies[1]
+	assert.Equal(t, "SRV2", pruned.Name, "the name defaults to the server ID")
+	assert.Equal(t, []string{"D3"}, pruned.Extra)
+	require.NotNil(t, pruned.Unassigned)
+
+	assert.Equal(t, []submittedActivity{
+		{ActivityType: "ASSIGN_DEVICES", ServerID: "SRV1", DeviceIDs: []string{"D4"}},
+		{ActivityType: "ASSIGN_DEVICES", ServerID: "SRV2", DeviceIDs: []string{"D5"}},
+		{ActivityType: "UNASSIGN_DEVICES", ServerID: "SRV2", DeviceIDs: []string{"D3"}},
+	}, activities.submitted)
+}
+
+func TestEngine_Reconcile_DryRun(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	registerServerDevices(mt)
+	activities := &activityRecorder{}
+	activities.register(mt)
+	c := setupClient(t, mt)
+
+	engine, err := NewEngine(c, []Policy{{MDMServerID: "SRV1", DeviceIDs: []string{"D1", "D4"}, Prune: true}}, Options{DryRun: true})
+	require.NoError(t, err)
+
+	report, err := engine.Reconcile(context.Background())
+	require.NoError(t, err)
+	assert.True(t, report.DryRun)
+	assert.Equal(t, []string{"D4"}, report.Policies[0].Missing)
+	assert.Equal(t, []string{"D2"}, report.Policies[0].Extra)
+	assert.Empty(t, activities.submitted)
+}
+
+func TestEngine_Reconcile_InSync(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	registerServerDevices(mt)
+	c := setupClient(t, mt)
+
+	engine, err := NewEngine(c, []Policy{{MDMServerID: "SRV2", DeviceIDs: []string{"D3"}, Prune: true}}, Options{})
+	require.NoError(t, err)
+
+	report, err := engine.Reconcile(context.Background())
+	require.NoError(t, err)
+	assert.True(t, report.InSync())
+	assert.Nil(t, report.Policies[0].Assigned)
+}
+
+func TestEngine_Reconcile_PolicyError(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	registerServerDevices(mt)
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/mdmServers/GONE/relationships/devices",
+		jsonResponder(404, `{"errors":[{"status":"404","code":"NOT_FOUND","title":"Not found","detail":"No such server"}]}`))
+	c := setupClient(t, mt)
+
+	engine, err := NewEngine(c, []Policy{
+		{Name: "old", MDMServerID: "GONE", DeviceIDs: []string{"D9"}},
+		{Name: "current", MDMServerID: "SRV2", DeviceIDs: []string{"D3"}},
+	}, Options{})
+	require.NoError(t, err)
+
+	report, err := engine.Reconcile(context.Background())
+	require.NoError(t, err, "a failed policy does not stop the run")
+	require.Len(t, report.Policies, 2)
+	assert.Contains(t, report.Policies[0].Error, "GONE")
+	assert.True(t, report.Policies[1].InSync())
+	assert.ErrorContains(t, report.Err(), "policy old: ")
+	assert.False(t, report.InSync())
+}
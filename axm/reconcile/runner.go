@@ -0,0 +1,184 @@
+package reconcile
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/client"
+)
+
+// DefaultInterval is how often a Runner reconciles when
+// RunnerOptions.Schedule is not set.
+const DefaultInterval = 30 * time.Minute
+
+// Hook is told about every run of a Runner. Hooks are called in order from
+// the Runner's goroutine, so a slow hook delays the next run.
+type Hook interface {
+	// RunStarted is called before a run, with a report holding only the run
+	// number and scheduled time.
+	RunStarted(ctx context.Context, report *Report)
+
+	// RunFinished is called after a run with its report, and the error that
+	// ended it early, if any. Per-policy errors are in report.Err.
+	RunFinished(ctx context.Context, report *Report, err error)
+}
+
+// HookFuncs adapts functions to a Hook. Nil fields are skipped.
+type HookFuncs struct {
+	Started  func(ctx context.Context, report *Report)
+	Finished func(ctx context.Context, report *Report, err error)
+}
+
+// RunStarted implements Hook.
+func (h HookFuncs) RunStarted(ctx context.Context, report *Report) {
+	if h.Started != nil {
+		h.Started(ctx, report)
+	}
+}
+
+// RunFinished implements Hook.
+func (h HookFuncs) RunFinished(ctx context.Context, report *Report, err error) {
+	if h.Finished != nil {
+		h.Finished(ctx, report, err)
+	}
+}
+
+// RunnerOptions configures a Runner.
+type RunnerOptions struct {
+	// Schedule decides when to run. Defaults to Every(DefaultInterval).
+	Schedule Schedule
+
+	// Jitter delays each run by a random duration up to Jitter, so that many
+	// runners on the same schedule do not call Apple at the same moment.
+	Jitter time.Duration
+
+	// RunOnStart runs once as soon as Run is called, before following the
+	// schedule. The first run is still jittered.
+	RunOnStart bool
+
+	// Hooks are told about every run.
+	Hooks []Hook
+}
+
+// Runner reconciles an Engine on a schedule.
+type Runner struct {
+	engine *Engine
+	opts   RunnerOptions
+
+	mu   sync.Mutex
+	runs int
+	last *Report
+}
+
+// NewRunner returns a runner for engine.
+func NewRunner(engine *Engine, opts RunnerOptions) *Runner {
+	if opts.Schedule == nil {
+		opts.Schedule = Every(DefaultInterval)
+	}
+	return &Runner{engine: engine, opts: opts}
+}
+
+// LastReport returns the report of the most recent finished run, or nil.
+func (r *Runner) LastReport() *Report {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.last
+}
+
+// Run reconciles on the schedule until ctx is cancelled or the client is
+// closed, then returns nil. It returns an error only if the schedule has no
+// next run. A run in progress is stopped by the cancellation and reported to
+// the hooks with the error.
+func (r *Runner) Run(ctx context.Context) error {
+	next := time.Now()
+	if !r.opts.RunOnStart {
+		next = r.opts.Schedule.Next(next)
+	}
+	for {
+		if next.IsZero() {
+			return errors.New("schedule has no next run")
+		}
+		delay := time.Until(next)
+		if r.opts.Jitter > 0 {
+			delay += rand.N(r.opts.Jitter)
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-r.engine.client.Done():
+			timer.Stop()
+			return nil
+		case <-timer.C:
+		}
+
+		_, err := r.RunOnce(ctx, next)
+		if ctx.Err() != nil || errors.Is(err, client.ErrClientClosed) {
+			return nil
+		}
+		// Schedule from now rather than from next, so a run that overran
+		// its slot does not start again immediately.
+		next = r.opts.Schedule.Next(time.Now())
+	}
+}
+
+// RunOnce reconciles now, outside the schedule, and reports the run to the
+// hooks as due at scheduledAt. It returns the error that ended the run
+// early, if any; per-policy errors are in the report's Err.
+func (r *Runner) RunOnce(ctx context.Context, scheduledAt time.Time) (*Report, error) {
+	r.mu.Lock()
+	r.runs++
+	run := r.runs
+	r.mu.Unlock()
+
+	started := &Report{Run: run, ScheduledAt: scheduledAt.UTC()}
+	for _, h := range r.opts.Hooks {
+		h.RunStarted(ctx, started)
+	}
+
+	report, err := r.engine.Reconcile(ctx)
+	report.Run, report.ScheduledAt = run, started.ScheduledAt
+
+	r.mu.Lock()
+	r.last = report
+	r.mu.Unlock()
+	for _, h := range r.opts.Hooks {
+		h.RunFinished(context.WithoutCancel(ctx), report, err)
+	}
+	return report, err
+}
+
+// NewReportDirHook returns a Hook that saves each run's report in dir as
+// indented JSON, named after the run's start time and number, such as
+// "reconcile-20260301T090000Z-1.json". The directory is created if needed.
+// Failures to save are passed to onError if it is not nil.
+func NewReportDirHook(dir string, onError func(error)) Hook {
+	return HookFuncs{Finished: func(_ context.Context, report *Report, _ error) {
+		if err := writeReport(dir, report); err != nil && onError != nil {
+			onError(err)
+		}
+	}}
+}
+
+func writeReport(dir string, report *Report) error {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("create report directory: %w", err)
+	}
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	name := filepath.Join(dir, fmt.Sprintf("reconcile-%s-%d.json", report.StartedAt.UTC().Format("20060102T150405Z"), report.Run))
+	if err := os.WriteFile(name, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("write report: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package reconcile
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunner_Run(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	registerServerDevices(mt)
+	c := setupClient(t, mt)
+	engine, err := NewEngine(c, []Policy{{MDMServerID: "SRV2", DeviceIDs: []string{"D3"}}}, Options{})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var mu sync.Mutex
+	var started, finished []int
+	dir := t.TempDir()
+	runner := NewRunner(engine, RunnerOptions{
+		Schedule:   Every(10 * time.Millisecond),
+		Jitter:     5 * time.Millisecond,
+		RunOnStart: true,
+		Hooks: []Hook{
+			HookFuncs{
+				Started: func(_ context.Context, r *Report) {
+					mu.Lock()
+					defer mu.Unlock()
+					assert.Empty(t, r.Policies)
+					started = append(started, r.Run)
+				},
+				Finished: func(_ context.Context, r *Report, err error) {
+					mu.Lock()
+					defer mu.Unlock()
+					assert.NoError(t, err)
+					assert.True(t, r.InSync())
+					finished = append(finished, r.Run)
+					if len(finished) == 3 {
+						cancel()
+					}
+				},
+			},
+			NewReportDirHook(dir, func(err error) { t.Error(err) }),
+		},
+	})
+
+	done := make(chan error, 1)
+	go func() { done <- runner.Run(ctx) }()
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("runner did not stop")
+	}
+
+	assert.Equal(t, []int{1, 2, 3}, started)
+	assert.Equal(t, []int{1, 2, 3}, finished)
+	last := runner.LastReport()
+	require.NotNil(t, last)
+	assert.Equal(t, 3, last.Run)
+	assert.False(t, last.ScheduledAt.IsZero())
+
+	files, err := filepath.Glob(filepath.Join(dir, "reconcile-*-*.json"))
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+	data, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	var saved Report
+	require.NoError(t, json.Unmarshal(data, &saved))
+	assert.Equal(t, "SRV2", saved.Policies[0].MDMServerID)
+}
+
+func TestRunner_StopsWhenClientCloses(t *testing.T) {
+	c := setupClient(t, httpmock.NewMockTransport())
+	engine, err := NewEngine(c, []Policy{{MDMServerID: "SRV1"}}, Options{})
+	require.NoError(t, err)
+	runner := NewRunner(engine, RunnerOptions{Schedule: Every(time.Hour)})
+
+	done := make(chan error, 1)
+	go func() { done <- runner.Run(context.Background()) }()
+	c.Close()
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("runner did not stop")
+	}
+	assert.Nil(t, runner.LastReport())
+}
+
+type neverSchedule struct{}
+
+func (neverSchedule) Next(time.Time) time.Time { return time.Time{} }
+
+func TestRunner_ScheduleWithoutNextRun(t *testing.T) {
+	c := setupClient(t, httpmock.NewMockTransport())
+	engine, err := NewEngine(c, []Policy{{MDMServerID: "SRV1"}}, Options{})
+	require.NoError(t, err)
+
+	err = NewRunner(engine, RunnerOptions{Schedule: neverSchedule{}}).Run(context.Background())
+	assert.EqualError(t, err, "schedule has no next run")
+}
@@ -0,0 +1,97 @@
+package reconcile
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSchedule(t *testing.T) {
+	// A Wednesday.
+	from := time.Date(2026, 3, 4, 10, 17, 42, 0, time.UTC)
+	tests := []struct {
+		spec string
+		want []time.Time
+	}{
+		{"@every 15m", []time.Time{from.Add(15 * time.Minute), from.Add(30 * time.Minute)}},
+		{"*/20 * * * *", []time.Time{
+			time.Date(2026, 3, 4, 10, 20, 0, 0, time.UTC),
+			time.Date(2026, 3, 4, 10, 40, 0, 0, time.UTC),
+			time.Date(2026, 3, 4, 11, 0, 0, 0, time.UTC),
+		}},
+		{"@hourly", []time.Time{
+			time.Date(2026, 3, 4, 11, 0, 0, 0, time.UTC),
+			time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC),
+		}},
+		{"@daily", []time.Time{
+			time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC),
+			time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC),
+		}},
+		{"30 6 * * 1-5", []time.Time{
+			time.Date(2026, 3, 5, 6, 30, 0, 0, time.UTC),
+			time.Date(2026, 3, 6, 6, 30, 0, 0, time.UTC),
+			time.Date(2026, 3, 9, 6, 30, 0, 0, time.UTC),
+		}},
+		{"0 9,17 * * 7", []time.Time{
+			time.Date(2026, 3, 8, 9, 0, 0, 0, time.UTC),
+			time.Date(2026, 3, 8, 17, 0, 0, 0, time.UTC),
+		}},
+		{"0 0 1 */3 *", []time.Time{
+			time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC),
+		}},
+		// Day of month and day of week both restricted: either matches.
+		{"0 12 15 * 5", []time.Time{
+			time.Date(2026, 3, 6, 12, 0, 0, 0, time.UTC),
+			time.Date(2026, 3, 13, 12, 0, 0, 0, time.UTC),
+			time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC),
+			time.Date(2026, 3, 20, 12, 0, 0, 0, time.UTC),
+		}},
+		{"0 0 29 2 *", []time.Time{time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			s, err := ParseSchedule(tt.spec)
+			require.NoError(t, err)
+			next := from
+			for _, want := range tt.want {
+				next = s.Next(next)
+				assert.Equal(t, want, next)
+			}
+		})
+	}
+}
+
+func TestParseSchedule_Location(t *testing.T) {
+	loc := time.FixedZone("UTC+10", 10*60*60)
+	s, err := ParseSchedule("0 6 * * *")
+	require.NoError(t, err)
+
+	next := s.Next(time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC).In(loc))
+	assert.Equal(t, time.Date(2026, 3, 5, 6, 0, 0, 0, loc), next)
+}
+
+func TestParseSchedule_Never(t *testing.T) {
+	s, err := ParseSchedule("0 0 30 2 *")
+	require.NoError(t, err)
+	assert.True(t, s.Next(time.Now()).IsZero())
+}
+
+func TestParseSchedule_Invalid(t *testing.T) {
+	for spec, want := range map[string]string{
+		"":                "want 5 fields",
+		"* * * *":         "want 5 fields",
+		"60 * * * *":      `minute: "60" is outside 0-59`,
+		"* 5-2 * * *":     `hour: "5-2" is outside 0-23`,
+		"* * 0 * *":       `day of month: "0" is outside 1-31`,
+		"* * * jan *":     `month: invalid value "jan"`,
+		"*/0 * * * *":     `minute: invalid step "0"`,
+		"@every tomorrow": "invalid duration",
+		"@every -5m":      "interval must be positive",
+	} {
+		_, err := ParseSchedule(spec)
+		assert.ErrorContains(t, err, want, spec)
+	}
+}
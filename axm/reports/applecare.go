@@ -0,0 +1,248 @@
+// Package reports builds organization-wide reports from the Apple Business
+// Manager API by combining calls across its services.
+package reports
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devices"
+)
+
+const (
+	// DefaultExpiryWindowDays is the expiry window used when
+	// AppleCareOptions.WithinDays is not set.
+	DefaultExpiryWindowDays = 30
+
+	// DefaultConcurrency is the number of coverage lookups run at once when
+	// AppleCareOptions.Concurrency is not set.
+	DefaultConcurrency = devices.DefaultAppleCareConcurrency
+)
+
+// AppleCareOptions configures AppleCareExpiry.
+type AppleCareOptions struct {
+	// WithinDays is how close to its end date coverage must be to count as
+	// expiring. Defaults to DefaultExpiryWindowDays.
+	WithinDays int
+
+	// Concurrency is the number of devices whose coverage is fetched at once.
+	// Defaults to DefaultConcurrency.
+	Concurrency int
+
+	// Now is the time the report is evaluated at. Defaults to time.Now.
+	Now time.Time
+}
+
+// AppleCareReport groups an organization's devices by AppleCare coverage.
+// Devices with coverage that runs beyond the expiry window are counted in
+// TotalDevices but not listed.
+type AppleCareReport struct {
+	// GeneratedAt is the time the report was evaluated at.
+	GeneratedAt time.Time
+
+	// WithinDays is the expiry window the report used.
+	WithinDays int
+
+	// TotalDevices is the number of devices in the inventory.
+	TotalDevices int
+
+	// Expiring lists devices whose coverage ends within the window, soonest
+	// first.
+	Expiring []DeviceCoverage
+
+	// Expired lists devices whose coverage has ended or been canceled, most
+	// recently ended first.
+	Expired []DeviceCoverage
+
+	// Uncovered lists devices with no coverage plans, by serial number.
+	Uncovered []DeviceCoverage
+
+	// Failed lists devices whose coverage could not be fetched.
+	Failed []DeviceError
+}
+
+// DeviceCoverage is one device in an AppleCareReport.
+type DeviceCoverage struct {
+	DeviceID     string
+	SerialNumber string
+	DeviceModel  string
+
+	// Coverage is the plan that determined the device's category: the one
+	// ending last. It is nil for uncovered devices.
+	Coverage *devices.AppleCareCoverage
+
+	// EndsAt is when that plan ends or ended. For a canceled plan this is the
+	// cancellation time, when known.
+	EndsAt *time.Time
+
+	// DaysRemaining is the number of whole days until EndsAt; it is negative
+	// for expired coverage and zero when EndsAt is unknown.
+	DaysRemaining int
+}
+
+// DeviceError records a device whose coverage lookup failed.
+type DeviceError struct {
+	DeviceID     string
+	SerialNumber string
+	Err          error
+}
+
+// Error implements error.
+func (e DeviceError) Error() string {
+	return fmt.Sprintf("device %s (%s): %v", e.DeviceID, e.SerialNumber, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e DeviceError) Unwrap() error { return e.Err }
+
+// coverageCategory is where a device lands in the report.
+type coverageCategory int
+
+const (
+	categoryCovered coverageCategory = iota
+	categoryExpiring
+	categoryExpired
+	categoryUncovered
+)
+
+// AppleCareExpiry walks the organization's device inventory, fetches each
+// device's AppleCare coverage and sorts the devices into expiring, expired and
+// uncovered lists.
+//
+// A device's coverage is judged by its plan with the latest end date; a
+// canceled plan no longer covers the device. An active plan without an end
+// date covers it indefinitely. Coverage lookups that fail are reported in
+// Failed rather than failing the report; an error is returned only if the
+// inventory itself cannot be listed or ctx is cancelled.
+func AppleCareExpiry(ctx context.Context, c *axm.Client, opts *AppleCareOptions) (*AppleCareReport, error) {
+	if opts == nil {
+		opts = &AppleCareOptions{}
+	}
+	report := &AppleCareReport{
+		GeneratedAt: opts.Now,
+		WithinDays:  opts.WithinDays,
+	}
+	if report.GeneratedAt.IsZero() {
+		report.GeneratedAt = time.Now()
+	}
+	if report.WithinDays <= 0 {
+		report.WithinDays = DefaultExpiryWindowDays
+	}
+
+	inventory, _, err := c.AXMAPI.Devices.GetV1(ctx, &devices.RequestQueryOptions{
+		Fields: []string{devices.FieldSerialNumber, devices.FieldDeviceModel},
+		Limit:  1000,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list devices: %w", err)
+	}
+
+	ids := make([]string, len(inventory.Data))
+	for i, d := range inventory.Data {
+		ids[i] = d.ID
+	}
+	batch := c.AXMAPI.Devices.GetAppleCareByDeviceIDsV1(ctx, ids, opts.Concurrency, nil)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	report.TotalDevices = len(inventory.Data)
+	for _, device := range inventory.Data {
+		entry := DeviceCoverage{DeviceID: device.ID}
+		if device.Attributes != nil {
+			entry.SerialNumber = device.Attributes.SerialNumber
+			entry.DeviceModel = device.Attributes.DeviceModel
+		}
+		coverage, _ := batch.Get(device.ID)
+		if !coverage.Success() {
+			report.Failed = append(report.Failed, DeviceError{DeviceID: entry.DeviceID, SerialNumber: entry.SerialNumber, Err: coverage.Err})
+			continue
+		}
+		report.add(entry, coverage.Value.Data)
+	}
+
+	slices.SortFunc(report.Expiring, func(a, b DeviceCoverage) int { return a.EndsAt.Compare(*b.EndsAt) })
+	slices.SortFunc(report.Expired, func(a, b DeviceCoverage) int { return compareEnds(b.EndsAt, a.EndsAt) })
+	slices.SortFunc(report.Uncovered, func(a, b DeviceCoverage) int { return cmp.Compare(a.SerialNumber, b.SerialNumber) })
+	slices.SortFunc(report.Failed, func(a, b DeviceError) int { return cmp.Compare(a.SerialNumber, b.SerialNumber) })
+	return report, nil
+}
+
+// add classifies one device from its coverage plans and files it.
+func (r *AppleCareReport) add(entry DeviceCoverage, plans []devices.AppleCareCoverage) {
+	category, plan, endsAt := classifyCoverage(plans, r.GeneratedAt, r.WithinDays)
+	entry.Coverage, entry.EndsAt = plan, endsAt
+	if endsAt != nil {
+		entry.DaysRemaining = int(endsAt.Sub(r.GeneratedAt).Hours() / 24)
+	}
+
+	switch category {
+	case categoryExpiring:
+		r.Expiring = append(r.Expiring, entry)
+	case categoryExpired:
+		r.Expired = append(r.Expired, entry)
+	case categoryUncovered:
+		r.Uncovered = append(r.Uncovered, entry)
+	}
+}
+
+// classifyCoverage returns a device's category at now, the plan that decided
+// it and when that plan ends.
+func classifyCoverage(plans []devices.AppleCareCoverage, now time.Time, withinDays int) (coverageCategory, *devices.AppleCareCoverage, *time.Time) {
+	if len(plans) == 0 {
+		return categoryUncovered, nil, nil
+	}
+
+	var active, last *devices.AppleCareCoverage
+	var activeEnd, lastEnd *time.Time
+	for i := range plans {
+		p := &plans[i]
+		a := p.Attributes
+		if a == nil {
+			continue
+		}
+		end := a.EndDateTime
+		if a.IsCanceled {
+			if a.ContractCancelDateTime != nil {
+				end = a.ContractCancelDateTime
+			}
+		} else if end == nil {
+			if a.Status == devices.AppleCareStatusActive {
+				return categoryCovered, p, nil
+			}
+		} else if activeEnd == nil || end.After(*activeEnd) {
+			active, activeEnd = p, end
+		}
+		if last == nil || compareEnds(end, lastEnd) > 0 {
+			last, lastEnd = p, end
+		}
+	}
+
+	switch {
+	case active != nil && activeEnd.After(now.AddDate(0, 0, withinDays)):
+		return categoryCovered, active, activeEnd
+	case active != nil && activeEnd.After(now):
+		return categoryExpiring, active, activeEnd
+	case last == nil:
+		return categoryExpired, &plans[0], nil
+	default:
+		return categoryExpired, last, lastEnd
+	}
+}
+
+// compareEnds orders end times with unknown (nil) ends first.
+func compareEnds(a, b *time.Time) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+	return a.Compare(*b)
+}
@@ -0,0 +1,134 @@
+package reports
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devices"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/client"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"resty.dev/v3"
+)
+
+type noAuth struct{}
+
+func (noAuth) ApplyAuth(*resty.Request) error { return nil }
+
+func jsonResponder(status int, body string) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		resp := httpmock.NewStringResponse(status, body)
+		resp.Header.Set("Content-Type", "application/json")
+		return resp, nil
+	}
+}
+
+func setupClient(t *testing.T, mt *httpmock.MockTransport) *axm.Client {
+	t.Helper()
+	c, err := axm.NewClient("key-id", "issuer-id", "unused",
+		client.WithAuth(noAuth{}),
+		axm.WithTransport(mt),
+		axm.WithRetryCount(0),
+	)
+	require.NoError(t, err)
+	return c
+}
+
+func TestAppleCareExpiry(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDevices", jsonResponder(200, `{"data":[
+		{"type":"orgDevices","id":"D1","attributes":{"serialNumber":"SOON","deviceModel":"MacBook Air"}},
+		{"type":"orgDevices","id":"D2","attributes":{"serialNumber":"LATER"}},
+		{"type":"orgDevices","id":"D3","attributes":{"serialNumber":"ENDED"}},
+		{"type":"orgDevices","id":"D4","attributes":{"serialNumber":"NONE"}},
+		{"type":"orgDevices","id":"D5","attributes":{"serialNumber":"CANCELED"}},
+		{"type":"orgDevices","id":"D6","attributes":{"serialNumber":"BROKEN"}},
+		{"type":"orgDevices","id":"D7","attributes":{"serialNumber":"OPENENDED"}}
+	]}`))
+	coverage := map[string]string{
+		"D1": `[{"type":"appleCareCoverage","id":"P1","attributes":{"status":"ACTIVE","endDateTime":"2026-01-11T00:00:00Z"}},
+		        {"type":"appleCareCoverage","id":"P1-OLD","attributes":{"status":"EXPIRED","endDateTime":"2025-01-01T00:00:00Z"}}]`,
+		"D2": `[{"type":"appleCareCoverage","id":"P2","attributes":{"status":"ACTIVE","endDateTime":"2027-01-01T00:00:00Z"}}]`,
+		"D3": `[{"type":"appleCareCoverage","id":"P3","attributes":{"status":"EXPIRED","endDateTime":"2025-12-01T00:00:00Z"}}]`,
+		"D4": `[]`,
+		"D5": `[{"type":"appleCareCoverage","id":"P5","attributes":{"status":"INACTIVE","isCanceled":true,"contractCancelDateTime":"2025-12-20T00:00:00Z"}}]`,
+		"D7": `[{"type":"appleCareCoverage","id":"P7","attributes":{"status":"ACTIVE"}}]`,
+	}
+	for id, plans := range coverage {
+		mt.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDevices/"+id+"/appleCareCoverage",
+			jsonResponder(200, `{"data":`+plans+`}`))
+	}
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDevices/D6/appleCareCoverage",
+		jsonResponder(500, `{"errors":[{"status":"500","code":"INTERNAL_ERROR","title":"Internal Server Error"}]}`))
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	report, err := AppleCareExpiry(context.Background(), setupClient(t, mt), &AppleCareOptions{WithinDays: 30, Now: now})
+	require.NoError(t, err)
+
+	assert.Equal(t, 7, report.TotalDevices)
+	assert.Equal(t, now, report.GeneratedAt)
+
+	require.Len(t, report.Expiring, 1)
+	assert.Equal(t, "SOON", report.Expiring[0].SerialNumber)
+	assert.Equal(t, "MacBook Air", report.Expiring[0].DeviceModel)
+	assert.Equal(t, "P1", report.Expiring[0].Coverage.ID)
+	assert.Equal(t, 10, report.Expiring[0].DaysRemaining)
+
+	require.Len(t, report.Expired, 2)
+	assert.Equal(t, "CANCELED", report.Expired[0].SerialNumber, "most recently ended first")
+	assert.Equal(t, "ENDED", report.Expired[1].SerialNumber)
+	assert.Equal(t, -31, report.Expired[1].DaysRemaining)
+
+	require.Len(t, report.Uncovered, 1)
+	assert.Equal(t, "NONE", report.Uncovered[0].SerialNumber)
+	assert.Nil(t, report.Uncovered[0].Coverage)
+
+	require.Len(t, report.Failed, 1)
+	assert.Equal(t, "BROKEN", report.Failed[0].SerialNumber)
+	assert.Error(t, report.Failed[0].Err)
+}
+
+func TestAppleCareExpiry_InventoryError(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDevices",
+		jsonResponder(500, `{"errors":[{"status":"500","code":"INTERNAL_ERROR","title":"Internal Server Error"}]}`))
+
+	report, err := AppleCareExpiry(context.Background(), setupClient(t, mt), nil)
+	require.Error(t, err)
+	assert.Nil(t, report)
+}
+
+func TestClassifyCoverage(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(s string) *time.Time {
+		t, _ := time.Parse(time.DateOnly, s)
+		return &t
+	}
+	plan := func(status string, end *time.Time, canceled bool) devices.AppleCareCoverage {
+		return devices.AppleCareCoverage{Attributes: &devices.AppleCareCoverageAttributes{Status: status, EndDateTime: end, IsCanceled: canceled}}
+	}
+
+	tests := []struct {
+		name  string
+		plans []devices.AppleCareCoverage
+		want  coverageCategory
+	}{
+		{"no plans", nil, categoryUncovered},
+		{"ends after window", []devices.AppleCareCoverage{plan("ACTIVE", at("2026-03-01"), false)}, categoryCovered},
+		{"ends within window", []devices.AppleCareCoverage{plan("ACTIVE", at("2026-01-20"), false)}, categoryExpiring},
+		{"ended", []devices.AppleCareCoverage{plan("EXPIRED", at("2025-06-01"), false)}, categoryExpired},
+		{"renewed", []devices.AppleCareCoverage{plan("EXPIRED", at("2025-06-01"), false), plan("ACTIVE", at("2027-06-01"), false)}, categoryCovered},
+		{"canceled before end", []devices.AppleCareCoverage{plan("INACTIVE", at("2027-06-01"), true)}, categoryExpired},
+		{"active without end", []devices.AppleCareCoverage{plan("ACTIVE", nil, false)}, categoryCovered},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _, _ := classifyCoverage(tt.plans, now, 30)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
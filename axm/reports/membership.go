@@ -0,0 +1,91 @@
+package reports
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm"
+)
+
+// MembershipDiff compares two sets of device IDs, A and B. Each list is sorted
+// and free of duplicates.
+type MembershipDiff struct {
+	OnlyInA []string
+	OnlyInB []string
+	Common  []string
+}
+
+// InSync reports whether A and B contain the same devices.
+func (d *MembershipDiff) InSync() bool {
+	return len(d.OnlyInA) == 0 && len(d.OnlyInB) == 0
+}
+
+// DiffDeviceSets compares two lists of device IDs.
+func DiffDeviceSets(a, b []string) *MembershipDiff {
+	inA := make(map[string]bool, len(a))
+	for _, id := range a {
+		inA[id] = true
+	}
+	inB := make(map[string]bool, len(b))
+	for _, id := range b {
+		inB[id] = true
+	}
+
+	diff := &MembershipDiff{}
+	for id := range inA {
+		if inB[id] {
+			diff.Common = append(diff.Common, id)
+		} else {
+			diff.OnlyInA = append(diff.OnlyInA, id)
+		}
+	}
+	for id := range inB {
+		if !inA[id] {
+			diff.OnlyInB = append(diff.OnlyInB, id)
+		}
+	}
+	slices.Sort(diff.OnlyInA)
+	slices.Sort(diff.OnlyInB)
+	slices.Sort(diff.Common)
+	return diff
+}
+
+// CompareServers compares the devices assigned to two device management
+// services, with serverA's devices as A. During a staged migration, OnlyInA
+// is what is left to move from serverA to serverB.
+func CompareServers(ctx context.Context, c *axm.Client, serverA, serverB string) (*MembershipDiff, error) {
+	a, err := serverDeviceIDs(ctx, c, serverA)
+	if err != nil {
+		return nil, err
+	}
+	b, err := serverDeviceIDs(ctx, c, serverB)
+	if err != nil {
+		return nil, err
+	}
+	return DiffDeviceSets(a, b), nil
+}
+
+// CompareServerToExpected compares the devices assigned to a device management
+// service (A) with the device IDs expected to be there (B). OnlyInB lists
+// devices still to be assigned and OnlyInA devices that should not be there.
+func CompareServerToExpected(ctx context.Context, c *axm.Client, serverID string, expected []string) (*MembershipDiff, error) {
+	a, err := serverDeviceIDs(ctx, c, serverID)
+	if err != nil {
+		return nil, err
+	}
+	return DiffDeviceSets(a, expected), nil
+}
+
+// serverDeviceIDs lists the IDs of the devices assigned to serverID.
+func serverDeviceIDs(ctx context.Context, c *axm.Client, serverID string) ([]string, error) {
+	linked, _, err := c.AXMAPI.DeviceManagement.GetDeviceSerialNumbersByServerIDV1(ctx, serverID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("list devices for mdm server %s: %w", serverID, err)
+	}
+	ids := make([]string, len(linked.Data))
+	for i, l := range linked.Data {
+		ids[i] = l.ID
+	}
+	return ids, nil
+}
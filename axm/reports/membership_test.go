@@ -0,0 +1,49 @@
+package reports
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffDeviceSets(t *testing.T) {
+	diff := DiffDeviceSets([]string{"D3", "D1", "D2", "D1"}, []string{"D4", "D2", "D3"})
+
+	assert.Equal(t, []string{"D1"}, diff.OnlyInA)
+	assert.Equal(t, []string{"D4"}, diff.OnlyInB)
+	assert.Equal(t, []string{"D2", "D3"}, diff.Common)
+	assert.False(t, diff.InSync())
+
+	assert.True(t, DiffDeviceSets([]string{"D1"}, []string{"D1"}).InSync())
+	assert.True(t, DiffDeviceSets(nil, nil).InSync())
+}
+
+func TestCompareServers(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	registerServers(mt)
+	c := setupClient(t, mt)
+
+	diff, err := CompareServers(context.Background(), c, "SRV1", "SRV2")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"D1", "D2"}, diff.OnlyInA)
+	assert.Equal(t, []string{"D3"}, diff.OnlyInB)
+	assert.Empty(t, diff.Common)
+
+	diff, err = CompareServerToExpected(context.Background(), c, "SRV1", []string{"D2", "D5"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"D1"}, diff.OnlyInA)
+	assert.Equal(t, []string{"D5"}, diff.OnlyInB)
+	assert.Equal(t, []string{"D2"}, diff.Common)
+}
+
+func TestCompareServers_Error(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	registerServers(mt)
+
+	_, err := CompareServers(context.Background(), setupClient(t, mt), "SRV1", "MISSING")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "MISSING")
+}
@@ -0,0 +1,333 @@
+package reports
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devices"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/client"
+)
+
+// DefaultTenantConcurrency is the number of tenants reported on at once when
+// MultiOrgOptions.Concurrency is not set.
+const DefaultTenantConcurrency = 4
+
+// AppleCare statuses of a TenantDevice.
+const (
+	AppleCareCovered   = "COVERED"
+	AppleCareExpiring  = "EXPIRING"
+	AppleCareExpired   = "EXPIRED"
+	AppleCareUncovered = "UNCOVERED"
+	AppleCareUnknown   = "UNKNOWN"
+)
+
+// Tenant is one Apple Business Manager or Apple School Manager organization,
+// with the client for its API account.
+type Tenant struct {
+	// Name identifies the tenant in reports. It must be unique.
+	Name   string
+	Client *axm.Client
+}
+
+// MultiOrgOptions configures MultiOrg.
+type MultiOrgOptions struct {
+	// AppleCare, if set, also looks up every device's AppleCare coverage
+	// with these options. This takes one request per device, so it is off by
+	// default.
+	AppleCare *AppleCareOptions
+
+	// Concurrency is the number of tenants reported on at once. Defaults to
+	// DefaultTenantConcurrency. Each tenant has its own API account and rate
+	// limit.
+	Concurrency int
+
+	// Now is the time the report is evaluated at. Defaults to time.Now.
+	Now time.Time
+}
+
+// TenantDevice is one row of the consolidated dataset: a device, the tenant
+// it belongs to and the device management service it is assigned to.
+type TenantDevice struct {
+	Tenant string
+	DeviceWithServer
+
+	// AppleCareStatus is one of the AppleCare constants, or empty when
+	// coverage was not looked up. It is AppleCareUnknown when the lookup
+	// failed.
+	AppleCareStatus string
+
+	// AppleCareEndsAt is when the plan that decided AppleCareStatus ends or
+	// ended, when known.
+	AppleCareEndsAt *time.Time
+}
+
+// ServerCount is the number of devices assigned to one device management
+// service.
+type ServerCount struct {
+	Tenant     string
+	ServerID   string
+	ServerName string
+	ServerType string
+	Devices    int
+}
+
+// AppleCareCounts counts devices by AppleCare status.
+type AppleCareCounts struct {
+	Covered   int
+	Expiring  int
+	Expired   int
+	Uncovered int
+
+	// Unknown counts devices whose coverage lookup failed.
+	Unknown int
+}
+
+// TenantSummary counts one tenant's devices. In MultiOrgReport.Total it
+// counts every tenant's.
+type TenantSummary struct {
+	Tenant string
+
+	Devices    int
+	Assigned   int
+	Unassigned int
+
+	// ByProductFamily counts devices by product family, such as "Mac".
+	ByProductFamily map[string]int
+
+	// ByServer counts assigned devices by service, most devices first. In
+	// Total, each tenant's services are listed separately.
+	ByServer []ServerCount
+
+	// AppleCare counts devices by coverage, when it was looked up.
+	AppleCare *AppleCareCounts
+
+	// Err is why the tenant could not be reported on. Its devices are
+	// missing from the report.
+	Err error
+}
+
+// MultiOrgReport consolidates devices, assignments and AppleCare coverage
+// across tenants.
+type MultiOrgReport struct {
+	// GeneratedAt is the time the report was evaluated at.
+	GeneratedAt time.Time
+
+	// Tenants summarises each tenant, in the order given.
+	Tenants []TenantSummary
+
+	// Total sums the tenants that succeeded.
+	Total TenantSummary
+
+	// Devices is the consolidated dataset: every device of every tenant that
+	// succeeded, ordered by tenant and then serial number.
+	Devices []TenantDevice
+}
+
+// Err returns a *client.MultiError keyed by tenant name for the tenants that
+// could not be reported on, or nil.
+func (r *MultiOrgReport) Err() error {
+	var errs []*client.ItemError
+	for _, t := range r.Tenants {
+		if t.Err != nil {
+			errs = append(errs, &client.ItemError{Key: t.Tenant, Err: t.Err})
+		}
+	}
+	return client.NewMultiError(errs)
+}
+
+// tenantResult is what MultiOrg gathers for one tenant.
+type tenantResult struct {
+	summary TenantSummary
+	devices []TenantDevice
+}
+
+// MultiOrg lists the devices of every tenant, joins them with their assigned
+// device management service and, with opts.AppleCare, their AppleCare
+// coverage, and returns them as one dataset with per-tenant and total counts.
+//
+// A tenant that fails is reported in its TenantSummary.Err, and in
+// MultiOrgReport.Err, rather than failing the report. An error is returned
+// only for invalid tenants or when ctx is cancelled.
+func MultiOrg(ctx context.Context, tenants []Tenant, opts *MultiOrgOptions) (*MultiOrgReport, error) {
+	if opts == nil {
+		opts = &MultiOrgOptions{}
+	}
+	byName := make(map[string]Tenant, len(tenants))
+	names := make([]string, len(tenants))
+	for i, t := range tenants {
+		if t.Name == "" || t.Client == nil {
+			return nil, fmt.Errorf("tenant %d: name and client are required", i)
+		}
+		if _, dup := byName[t.Name]; dup {
+			return nil, fmt.Errorf("tenant %q is listed twice", t.Name)
+		}
+		byName[t.Name] = t
+		names[i] = t.Name
+	}
+
+	report := &MultiOrgReport{GeneratedAt: opts.Now}
+	if report.GeneratedAt.IsZero() {
+		report.GeneratedAt = time.Now()
+	}
+	var appleCare AppleCareOptions
+	if opts.AppleCare != nil {
+		appleCare = *opts.AppleCare
+		appleCare.Now = cmp.Or(appleCare.Now, report.GeneratedAt)
+		if appleCare.WithinDays <= 0 {
+			appleCare.WithinDays = DefaultExpiryWindowDays
+		}
+	}
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultTenantConcurrency
+	}
+
+	batch := client.RunBatch(names, concurrency, func(name string) (tenantResult, error) {
+		var ac *AppleCareOptions
+		if opts.AppleCare != nil {
+			ac = &appleCare
+		}
+		return tenantReport(ctx, byName[name], ac)
+	})
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	report.Total.ByProductFamily = map[string]int{}
+	if opts.AppleCare != nil {
+		report.Total.AppleCare = &AppleCareCounts{}
+	}
+	for _, item := range batch.Items {
+		if !item.Success() {
+			report.Tenants = append(report.Tenants, TenantSummary{Tenant: item.Key, Err: item.Err})
+			continue
+		}
+		s := item.Value.summary
+		report.Tenants = append(report.Tenants, s)
+		report.Devices = append(report.Devices, item.Value.devices...)
+
+		report.Total.Devices += s.Devices
+		report.Total.Assigned += s.Assigned
+		report.Total.Unassigned += s.Unassigned
+		for family, n := range s.ByProductFamily {
+			report.Total.ByProductFamily[family] += n
+		}
+		report.Total.ByServer = append(report.Total.ByServer, s.ByServer...)
+		if s.AppleCare != nil {
+			t := report.Total.AppleCare
+			t.Covered += s.AppleCare.Covered
+			t.Expiring += s.AppleCare.Expiring
+			t.Expired += s.AppleCare.Expired
+			t.Uncovered += s.AppleCare.Uncovered
+			t.Unknown += s.AppleCare.Unknown
+		}
+	}
+	sortServerCounts(report.Total.ByServer)
+	return report, nil
+}
+
+// tenantReport gathers one tenant's devices and counts. With appleCare, it
+// also looks up each device's coverage.
+func tenantReport(ctx context.Context, t Tenant, appleCare *AppleCareOptions) (tenantResult, error) {
+	inventory, err := DevicesWithServers(ctx, t.Client, nil)
+	if err != nil {
+		return tenantResult{}, err
+	}
+
+	var coverage *client.BatchResult[*devices.AppleCareCoverageResponse]
+	if appleCare != nil {
+		ids := make([]string, len(inventory))
+		for i, d := range inventory {
+			ids[i] = d.ID
+		}
+		coverage = t.Client.AXMAPI.Devices.GetAppleCareByDeviceIDsV1(ctx, ids, appleCare.Concurrency, nil)
+		if err := ctx.Err(); err != nil {
+			return tenantResult{}, err
+		}
+	}
+
+	s := TenantSummary{Tenant: t.Name, Devices: len(inventory), ByProductFamily: map[string]int{}}
+	if appleCare != nil {
+		s.AppleCare = &AppleCareCounts{}
+	}
+	servers := map[string]*ServerCount{}
+	rows := make([]TenantDevice, len(inventory))
+	for i, d := range inventory {
+		row := TenantDevice{Tenant: t.Name, DeviceWithServer: d}
+		family := ""
+		if d.Attributes != nil {
+			family = d.Attributes.ProductFamily
+		}
+		s.ByProductFamily[family]++
+
+		if d.ServerID == "" {
+			s.Unassigned++
+		} else {
+			s.Assigned++
+			sc, ok := servers[d.ServerID]
+			if !ok {
+				sc = &ServerCount{Tenant: t.Name, ServerID: d.ServerID, ServerName: d.ServerName, ServerType: d.ServerType}
+				servers[d.ServerID] = sc
+			}
+			sc.Devices++
+		}
+
+		if coverage != nil {
+			item, _ := coverage.Get(d.ID)
+			row.AppleCareStatus = AppleCareUnknown
+			if item.Success() {
+				category, _, endsAt := classifyCoverage(item.Value.Data, appleCare.Now, appleCare.WithinDays)
+				row.AppleCareStatus, row.AppleCareEndsAt = appleCareStatus(category), endsAt
+			}
+			s.AppleCare.add(row.AppleCareStatus)
+		}
+		rows[i] = row
+	}
+
+	for _, id := range slices.Sorted(maps.Keys(servers)) {
+		s.ByServer = append(s.ByServer, *servers[id])
+	}
+	sortServerCounts(s.ByServer)
+	slices.SortStableFunc(rows, func(a, b TenantDevice) int {
+		return cmp.Compare(serialNumber(a.OrgDevice), serialNumber(b.OrgDevice))
+	})
+	return tenantResult{summary: s, devices: rows}, nil
+}
+
+func (c *AppleCareCounts) add(status string) {
+	switch status {
+	case AppleCareCovered:
+		c.Covered++
+	case AppleCareExpiring:
+		c.Expiring++
+	case AppleCareExpired:
+		c.Expired++
+	case AppleCareUncovered:
+		c.Uncovered++
+	default:
+		c.Unknown++
+	}
+}
+
+func appleCareStatus(c coverageCategory) string {
+	switch c {
+	case categoryExpiring:
+		return AppleCareExpiring
+	case categoryExpired:
+		return AppleCareExpired
+	case categoryUncovered:
+		return AppleCareUncovered
+	}
+	return AppleCareCovered
+}
+
+// sortServerCounts orders services by device count, most first. The sort is
+// stable, so ties keep their order.
+func sortServerCounts(counts []ServerCount) {
+	slices.SortStableFunc(counts, func(a, b ServerCount) int { return cmp.Compare(b.Devices, a.Devices) })
+}
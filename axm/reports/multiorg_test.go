@@ -0,0 +1,123 @@
+package reports
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiOrg(t *testing.T) {
+	east := httpmock.NewMockTransport()
+	registerServers(east)
+	east.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDevices", jsonResponder(200, `{"data":[
+		{"type":"orgDevices","id":"D3","attributes":{"serialNumber":"E3","productFamily":"iPad"}},
+		{"type":"orgDevices","id":"D1","attributes":{"serialNumber":"E1","productFamily":"Mac"}},
+		{"type":"orgDevices","id":"D2","attributes":{"serialNumber":"E2","productFamily":"Mac"}},
+		{"type":"orgDevices","id":"D4","attributes":{"serialNumber":"E4","productFamily":"iPhone"}}
+	]}`))
+	coverage := map[string]string{
+		"D1": `[{"type":"appleCareCoverage","id":"P1","attributes":{"status":"ACTIVE","endDateTime":"2026-01-11T00:00:00Z"}}]`,
+		"D2": `[{"type":"appleCareCoverage","id":"P2","attributes":{"status":"ACTIVE","endDateTime":"2027-01-01T00:00:00Z"}}]`,
+		"D3": `[]`,
+	}
+	for id, plans := range coverage {
+		east.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDevices/"+id+"/appleCareCoverage",
+			jsonResponder(200, `{"data":`+plans+`}`))
+	}
+	east.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDevices/D4/appleCareCoverage",
+		jsonResponder(500, `{"errors":[{"status":"500","code":"INTERNAL_ERROR","title":"Internal Server Error"}]}`))
+
+	west := httpmock.NewMockTransport()
+	west.RegisterResponder("GET", "https://api-business.apple.com/v1/mdmServers", jsonResponder(200, `{"data":[
+		{"type":"mdmServers","id":"SRVW","attributes":{"serverName":"Intune","serverType":"MDM"}}
+	]}`))
+	west.RegisterResponder("GET", "https://api-business.apple.com/v1/mdmServers/SRVW/relationships/devices",
+		jsonResponder(200, `{"data":[{"type":"orgDevices","id":"W1"}]}`))
+	west.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDevices", jsonResponder(200, `{"data":[
+		{"type":"orgDevices","id":"W1","attributes":{"serialNumber":"W1","productFamily":"Mac"}}
+	]}`))
+	west.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDevices/W1/appleCareCoverage",
+		jsonResponder(200, `{"data":[{"type":"appleCareCoverage","id":"PW","attributes":{"status":"EXPIRED","endDateTime":"2025-12-01T00:00:00Z"}}]}`))
+
+	broken := httpmock.NewMockTransport()
+	broken.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDevices",
+		jsonResponder(500, `{"errors":[{"status":"500","code":"INTERNAL_ERROR","title":"Internal Server Error"}]}`))
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	report, err := MultiOrg(context.Background(), []Tenant{
+		{Name: "east", Client: setupClient(t, east)},
+		{Name: "broken", Client: setupClient(t, broken)},
+		{Name: "west", Client: setupClient(t, west)},
+	}, &MultiOrgOptions{AppleCare: &AppleCareOptions{WithinDays: 30}, Now: now})
+	require.NoError(t, err)
+	assert.Equal(t, now, report.GeneratedAt)
+
+	require.Len(t, report.Tenants, 3)
+	eastSummary := report.Tenants[0]
+	assert.Equal(t, "east", eastSummary.Tenant)
+	assert.Equal(t, 4, eastSummary.Devices)
+	assert.Equal(t, 3, eastSummary.Assigned)
+	assert.Equal(t, 1, eastSummary.Unassigned)
+	assert.Equal(t, map[string]int{"Mac": 2, "iPad": 1, "iPhone": 1}, eastSummary.ByProductFamily)
+	assert.Equal(t, []ServerCount{
+		{Tenant: "east", ServerID: "SRV1", ServerName: "Jamf Pro", ServerType: "MDM", Devices: 2},
+		{Tenant: "east", ServerID: "SRV2", ServerName: "Apple Configurator", ServerType: "APPLE_CONFIGURATOR", Devices: 1},
+	}, eastSummary.ByServer)
+	assert.Equal(t, &AppleCareCounts{Covered: 1, Expiring: 1, Uncovered: 1, Unknown: 1}, eastSummary.AppleCare)
+
+	assert.Equal(t, "broken", report.Tenants[1].Tenant)
+	assert.Error(t, report.Tenants[1].Err)
+	assert.ErrorContains(t, report.Err(), "broken: ")
+
+	total := report.Total
+	assert.Equal(t, 5, total.Devices)
+	assert.Equal(t, 4, total.Assigned)
+	assert.Equal(t, 1, total.Unassigned)
+	assert.Equal(t, map[string]int{"Mac": 3, "iPad": 1, "iPhone": 1}, total.ByProductFamily)
+	require.Len(t, total.ByServer, 3)
+	assert.Equal(t, "SRV1", total.ByServer[0].ServerID)
+	assert.Equal(t, "west", total.ByServer[2].Tenant, "ties keep tenant order")
+	assert.Equal(t, &AppleCareCounts{Covered: 1, Expiring: 1, Expired: 1, Uncovered: 1, Unknown: 1}, total.AppleCare)
+
+	require.Len(t, report.Devices, 5)
+	var serials, statuses []string
+	for _, d := range report.Devices {
+		serials = append(serials, d.Tenant+"/"+d.Attributes.SerialNumber)
+		statuses = append(statuses, d.AppleCareStatus)
+	}
+	assert.Equal(t, []string{"east/E1", "east/E2", "east/E3", "east/E4", "west/W1"}, serials)
+	assert.Equal(t, []string{AppleCareExpiring, AppleCareCovered, AppleCareUncovered, AppleCareUnknown, AppleCareExpired}, statuses)
+	assert.Equal(t, "Jamf Pro", report.Devices[0].ServerName)
+	require.NotNil(t, report.Devices[0].AppleCareEndsAt)
+	assert.Equal(t, time.Date(2026, 1, 11, 0, 0, 0, 0, time.UTC), *report.Devices[0].AppleCareEndsAt)
+	assert.Empty(t, report.Devices[3].ServerID)
+}
+
+func TestMultiOrg_WithoutAppleCare(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	registerServers(mt)
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDevices", jsonResponder(200, `{"data":[
+		{"type":"orgDevices","id":"D1","attributes":{"serialNumber":"S1"}}
+	]}`))
+
+	report, err := MultiOrg(context.Background(), []Tenant{{Name: "only", Client: setupClient(t, mt)}}, nil)
+	require.NoError(t, err)
+	require.NoError(t, report.Err())
+	assert.Nil(t, report.Total.AppleCare)
+	assert.Empty(t, report.Devices[0].AppleCareStatus)
+	assert.Equal(t, 4, mt.GetTotalCallCount(), "coverage is not looked up")
+}
+
+func TestMultiOrg_InvalidTenants(t *testing.T) {
+	c := setupClient(t, httpmock.NewMockTransport())
+
+	_, err := MultiOrg(context.Background(), []Tenant{{Name: "a", Client: c}, {Client: c}}, nil)
+	assert.EqualError(t, err, "tenant 1: name and client are required")
+
+	_, err = MultiOrg(context.Background(), []Tenant{{Name: "a", Client: c}, {Name: "a", Client: c}}, nil)
+	assert.EqualError(t, err, `tenant "a" is listed twice`)
+}
@@ -0,0 +1,108 @@
+package reports
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devices"
+)
+
+// PurchaseSource is the devices bought through one reseller or Apple account,
+// split by order.
+type PurchaseSource struct {
+	// Type is the purchaseSourceType, e.g. "APPLE". It is empty for devices
+	// with no purchase source.
+	Type string
+
+	// ID is the purchaseSourceId identifying the reseller or Apple account.
+	ID string
+
+	// DeviceCount is the number of devices across all Orders.
+	DeviceCount int
+
+	// Orders lists the orders placed through this source, by order number.
+	Orders []PurchaseOrder
+}
+
+// PurchaseOrder is the devices on one order.
+type PurchaseOrder struct {
+	// OrderNumber is empty for devices with no order number.
+	OrderNumber string
+
+	// Devices lists the order's devices by serial number.
+	Devices []devices.OrgDevice
+}
+
+// purchaseFields are the orgDevices attributes PurchaseSources requests: the
+// grouping keys plus what is needed to identify each device on an order.
+var purchaseFields = []string{
+	devices.FieldSerialNumber,
+	devices.FieldDeviceModel,
+	devices.FieldPurchaseSourceType,
+	devices.FieldPurchaseSourceId,
+	devices.FieldOrderNumber,
+	devices.FieldOrderDateTime,
+}
+
+// PurchaseSources lists the organization's devices and groups them with
+// GroupByPurchaseSource, for reconciling the inventory against purchase
+// records.
+func PurchaseSources(ctx context.Context, c *axm.Client) ([]PurchaseSource, error) {
+	inventory, _, err := c.AXMAPI.Devices.GetV1(ctx, &devices.RequestQueryOptions{
+		Fields: purchaseFields,
+		Limit:  1000,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list devices: %w", err)
+	}
+	return GroupByPurchaseSource(inventory.Data), nil
+}
+
+// GroupByPurchaseSource groups devices by purchase source type and ID, then by
+// order number. Sources are ordered by type and ID, orders by order number and
+// devices by serial number; devices missing any of these values are grouped
+// under the empty value.
+func GroupByPurchaseSource(inventory []devices.OrgDevice) []PurchaseSource {
+	type sourceKey struct{ typ, id string }
+	orders := make(map[sourceKey]map[string][]devices.OrgDevice)
+	for _, d := range inventory {
+		a := d.Attributes
+		if a == nil {
+			a = &devices.OrgDeviceAttributes{}
+		}
+		key := sourceKey{a.PurchaseSourceType, a.PurchaseSourceId}
+		if orders[key] == nil {
+			orders[key] = make(map[string][]devices.OrgDevice)
+		}
+		orders[key][a.OrderNumber] = append(orders[key][a.OrderNumber], d)
+	}
+
+	sources := make([]PurchaseSource, 0, len(orders))
+	for key, byOrder := range orders {
+		source := PurchaseSource{Type: key.typ, ID: key.id}
+		for number, devs := range byOrder {
+			slices.SortFunc(devs, func(a, b devices.OrgDevice) int {
+				return cmp.Or(cmp.Compare(serialNumber(a), serialNumber(b)), cmp.Compare(a.ID, b.ID))
+			})
+			source.Orders = append(source.Orders, PurchaseOrder{OrderNumber: number, Devices: devs})
+			source.DeviceCount += len(devs)
+		}
+		slices.SortFunc(source.Orders, func(a, b PurchaseOrder) int { return cmp.Compare(a.OrderNumber, b.OrderNumber) })
+		sources = append(sources, source)
+	}
+	slices.SortFunc(sources, func(a, b PurchaseSource) int {
+		return cmp.Or(cmp.Compare(a.Type, b.Type), cmp.Compare(a.ID, b.ID))
+	})
+	return sources
+}
+
+// serialNumber returns the device's serial number, or "" if unknown.
+func serialNumber(d devices.OrgDevice) string {
+	if d.Attributes == nil {
+		return ""
+	}
+	return d.Attributes.SerialNumber
+}
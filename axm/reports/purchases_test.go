@@ -0,0 +1,69 @@
+package reports
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devices"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func device(id, serial, sourceType, sourceID, order string) devices.OrgDevice {
+	return devices.OrgDevice{ID: id, Type: "orgDevices", Attributes: &devices.OrgDeviceAttributes{
+		SerialNumber:       serial,
+		PurchaseSourceType: sourceType,
+		PurchaseSourceId:   sourceID,
+		OrderNumber:        order,
+	}}
+}
+
+func TestGroupByPurchaseSource(t *testing.T) {
+	sources := GroupByPurchaseSource([]devices.OrgDevice{
+		device("1", "S3", "RESELLER", "R1", "PO-2"),
+		device("2", "S1", "APPLE", "A1", "PO-1"),
+		device("3", "S2", "RESELLER", "R1", "PO-2"),
+		device("4", "S4", "RESELLER", "R1", "PO-1"),
+		{ID: "5", Type: "orgDevices"},
+	})
+
+	require.Len(t, sources, 3)
+
+	assert.Equal(t, "", sources[0].Type, "devices without a purchase source sort first")
+	assert.Equal(t, 1, sources[0].DeviceCount)
+
+	assert.Equal(t, "APPLE", sources[1].Type)
+	assert.Equal(t, "A1", sources[1].ID)
+
+	reseller := sources[2]
+	assert.Equal(t, "R1", reseller.ID)
+	assert.Equal(t, 3, reseller.DeviceCount)
+	require.Len(t, reseller.Orders, 2)
+	assert.Equal(t, "PO-1", reseller.Orders[0].OrderNumber)
+	assert.Equal(t, "PO-2", reseller.Orders[1].OrderNumber)
+	require.Len(t, reseller.Orders[1].Devices, 2)
+	assert.Equal(t, "S2", reseller.Orders[1].Devices[0].Attributes.SerialNumber)
+	assert.Equal(t, "S3", reseller.Orders[1].Devices[1].Attributes.SerialNumber)
+}
+
+func TestPurchaseSources(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	var fields string
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDevices", func(req *http.Request) (*http.Response, error) {
+		fields = req.URL.Query().Get("fields[orgDevices]")
+		return jsonResponder(200, `{"data":[
+			{"type":"orgDevices","id":"1","attributes":{"serialNumber":"S1","purchaseSourceType":"APPLE","purchaseSourceId":"A1","orderNumber":"PO-1"}},
+			{"type":"orgDevices","id":"2","attributes":{"serialNumber":"S2","purchaseSourceType":"APPLE","purchaseSourceId":"A1","orderNumber":"PO-1"}}
+		]}`)(req)
+	})
+
+	sources, err := PurchaseSources(context.Background(), setupClient(t, mt))
+	require.NoError(t, err)
+	assert.Contains(t, fields, "purchaseSourceId")
+	require.Len(t, sources, 1)
+	assert.Equal(t, 2, sources[0].DeviceCount)
+	require.Len(t, sources[0].Orders, 1)
+	assert.Len(t, sources[0].Orders[0].Devices, 2)
+}
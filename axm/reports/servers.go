@@ -0,0 +1,136 @@
+package reports
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sync"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devicemanagement"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devices"
+)
+
+// DeviceWithServer is a device joined with the device management service it
+// is assigned to. The server fields are empty for unassigned devices.
+type DeviceWithServer struct {
+	devices.OrgDevice
+
+	ServerID   string
+	ServerName string
+	ServerType string
+}
+
+// ServerDirectory resolves devices to their assigned device management
+// service. The first lookup lists every service and its device IDs, one
+// request per service rather than one per device, and the result is cached
+// until Invalidate is called. A ServerDirectory is safe for concurrent use.
+type ServerDirectory struct {
+	client *axm.Client
+
+	mu       sync.Mutex
+	loaded   bool
+	servers  map[string]devicemanagement.MDMServer
+	assigned map[string]string
+}
+
+// NewServerDirectory returns a ServerDirectory backed by c.
+func NewServerDirectory(c *axm.Client) *ServerDirectory {
+	return &ServerDirectory{client: c}
+}
+
+// Invalidate discards the cached server list, so the next lookup fetches it
+// again.
+func (d *ServerDirectory) Invalidate() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.loaded, d.servers, d.assigned = false, nil, nil
+}
+
+// load fetches the servers and their device linkages if they are not cached.
+// The caller must hold d.mu.
+func (d *ServerDirectory) load(ctx context.Context) error {
+	if d.loaded {
+		return nil
+	}
+
+	list, _, err := d.client.AXMAPI.DeviceManagement.GetV1(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("list mdm servers: %w", err)
+	}
+	servers := make(map[string]devicemanagement.MDMServer, len(list.Data))
+	assigned := make(map[string]string)
+	for _, s := range list.Data {
+		servers[s.ID] = s
+		ids, err := serverDeviceIDs(ctx, d.client, s.ID)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			assigned[id] = s.ID
+		}
+	}
+
+	d.servers, d.assigned, d.loaded = servers, assigned, true
+	return nil
+}
+
+// Servers returns a copy of every device management service, keyed by ID.
+func (d *ServerDirectory) Servers(ctx context.Context) (map[string]devicemanagement.MDMServer, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.load(ctx); err != nil {
+		return nil, err
+	}
+	return maps.Clone(d.servers), nil
+}
+
+// ServerFor returns the service deviceID is assigned to, or nil if it is
+// unassigned.
+func (d *ServerDirectory) ServerFor(ctx context.Context, deviceID string) (*devicemanagement.MDMServer, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.load(ctx); err != nil {
+		return nil, err
+	}
+	id, ok := d.assigned[deviceID]
+	if !ok {
+		return nil, nil
+	}
+	server := d.servers[id]
+	return &server, nil
+}
+
+// Enrich joins each device with its assigned service.
+func (d *ServerDirectory) Enrich(ctx context.Context, devs []devices.OrgDevice) ([]DeviceWithServer, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.load(ctx); err != nil {
+		return nil, err
+	}
+
+	out := make([]DeviceWithServer, len(devs))
+	for i, dev := range devs {
+		out[i] = DeviceWithServer{OrgDevice: dev}
+		id, ok := d.assigned[dev.ID]
+		if !ok {
+			continue
+		}
+		out[i].ServerID = id
+		if a := d.servers[id].Attributes; a != nil {
+			out[i].ServerName, out[i].ServerType = a.ServerName, a.ServerType
+		}
+	}
+	return out, nil
+}
+
+// DevicesWithServers lists the organization's devices and joins each with the
+// name and type of its assigned device management service. Use a
+// ServerDirectory directly to reuse the server list across calls.
+func DevicesWithServers(ctx context.Context, c *axm.Client, opts *devices.RequestQueryOptions) ([]DeviceWithServer, error) {
+	inventory, _, err := c.AXMAPI.Devices.GetV1(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("list devices: %w", err)
+	}
+	return NewServerDirectory(c).Enrich(ctx, inventory.Data)
+}
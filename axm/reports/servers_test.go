@@ -0,0 +1,80 @@
+package reports
+
+import (
+	"context"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devices"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func registerServers(mt *httpmock.MockTransport) {
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/mdmServers", jsonResponder(200, `{"data":[
+		{"type":"mdmServers","id":"SRV1","attributes":{"serverName":"Jamf Pro","serverType":"MDM"}},
+		{"type":"mdmServers","id":"SRV2","attributes":{"serverName":"Apple Configurator","serverType":"APPLE_CONFIGURATOR"}}
+	]}`))
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/mdmServers/SRV1/relationships/devices",
+		jsonResponder(200, `{"data":[{"type":"orgDevices","id":"D1"},{"type":"orgDevices","id":"D2"}]}`))
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/mdmServers/SRV2/relationships/devices",
+		jsonResponder(200, `{"data":[{"type":"orgDevices","id":"D3"}]}`))
+}
+
+func TestServerDirectory_CachesServerList(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	registerServers(mt)
+	dir := NewServerDirectory(setupClient(t, mt))
+	ctx := context.Background()
+
+	enriched, err := dir.Enrich(ctx, []devices.OrgDevice{{ID: "D1"}, {ID: "D3"}, {ID: "D9"}})
+	require.NoError(t, err)
+	require.Len(t, enriched, 3)
+	assert.Equal(t, "SRV1", enriched[0].ServerID)
+	assert.Equal(t, "Jamf Pro", enriched[0].ServerName)
+	assert.Equal(t, "MDM", enriched[0].ServerType)
+	assert.Equal(t, "Apple Configurator", enriched[1].ServerName)
+	assert.Empty(t, enriched[2].ServerID, "unassigned devices have no server")
+
+	server, err := dir.ServerFor(ctx, "D2")
+	require.NoError(t, err)
+	assert.Equal(t, "SRV1", server.ID)
+
+	server, err = dir.ServerFor(ctx, "D9")
+	require.NoError(t, err)
+	assert.Nil(t, server)
+
+	assert.Equal(t, 3, mt.GetTotalCallCount(), "servers and linkages are fetched once")
+
+	dir.Invalidate()
+	servers, err := dir.Servers(ctx)
+	require.NoError(t, err)
+	assert.Len(t, servers, 2)
+	assert.Equal(t, 6, mt.GetTotalCallCount())
+}
+
+func TestDevicesWithServers(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	registerServers(mt)
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDevices", jsonResponder(200, `{"data":[
+		{"type":"orgDevices","id":"D2","attributes":{"serialNumber":"S2"}},
+		{"type":"orgDevices","id":"D4","attributes":{"serialNumber":"S4"}}
+	]}`))
+
+	enriched, err := DevicesWithServers(context.Background(), setupClient(t, mt), nil)
+	require.NoError(t, err)
+	require.Len(t, enriched, 2)
+	assert.Equal(t, "S2", enriched[0].Attributes.SerialNumber)
+	assert.Equal(t, "Jamf Pro", enriched[0].ServerName)
+	assert.Empty(t, enriched[1].ServerName)
+}
+
+func TestServerDirectory_Error(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/mdmServers",
+		jsonResponder(500, `{"errors":[{"status":"500","code":"INTERNAL_ERROR","title":"Internal Server Error"}]}`))
+
+	_, err := NewServerDirectory(setupClient(t, mt)).ServerFor(context.Background(), "D1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "list mdm servers")
+}
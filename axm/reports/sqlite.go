@@ -0,0 +1,320 @@
+package reports
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devicemanagement"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devices"
+)
+
+// SQLiteSchema creates the tables ExportSQLite writes, if they do not exist.
+// Timestamps are stored as RFC 3339 text in UTC, so SQLite's date functions
+// accept them, and list attributes as JSON arrays, readable with json_each.
+//
+//	devices      one row per device, keyed by device ID
+//	servers      one row per device management service, keyed by server ID
+//	assignments  which service each assigned device belongs to
+//	exports      one row per export, with its mode and row counts
+//
+// synced_at on devices and servers is the start time of the export that last
+// wrote the row. It is truncated to the second, like every timestamp.
+const SQLiteSchema = `
+CREATE TABLE IF NOT EXISTS devices (
+	id                     TEXT PRIMARY KEY,
+	serial_number          TEXT,
+	device_model           TEXT,
+	product_family         TEXT,
+	product_type           TEXT,
+	device_capacity        TEXT,
+	part_number            TEXT,
+	order_number           TEXT,
+	color                  TEXT,
+	status                 TEXT,
+	order_date_time        TEXT,
+	added_to_org_date_time TEXT,
+	updated_date_time      TEXT,
+	imei                   TEXT,
+	meid                   TEXT,
+	eid                    TEXT,
+	wifi_mac_address       TEXT,
+	bluetooth_mac_address  TEXT,
+	ethernet_mac_address   TEXT,
+	purchase_source_id     TEXT,
+	purchase_source_type   TEXT,
+	synced_at              TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS devices_serial_number ON devices (serial_number);
+CREATE TABLE IF NOT EXISTS servers (
+	id                       TEXT PRIMARY KEY,
+	server_name              TEXT,
+	server_type              TEXT,
+	status                   TEXT,
+	default_product_families TEXT,
+	created_date_time        TEXT,
+	updated_date_time        TEXT,
+	synced_at                TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS assignments (
+	device_id TEXT PRIMARY KEY,
+	server_id TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS assignments_server_id ON assignments (server_id);
+CREATE TABLE IF NOT EXISTS exports (
+	started_at  TEXT NOT NULL,
+	finished_at TEXT NOT NULL,
+	mode        TEXT NOT NULL,
+	devices     INTEGER NOT NULL,
+	servers     INTEGER NOT NULL,
+	assignments INTEGER NOT NULL
+);`
+
+// SQLiteExportOptions configures ExportSQLite.
+type SQLiteExportOptions struct {
+	// Incremental upserts devices and servers instead of replacing the
+	// tables, so rows for devices no longer returned are kept.
+	Incremental bool
+
+	// UpdatedSince, with Incremental, fetches only the devices updated after
+	// it. Pass the time returned by LastSQLiteExport to pick up where the
+	// previous export left off.
+	UpdatedSince time.Time
+}
+
+// SQLiteExportResult summarises an export.
+type SQLiteExportResult struct {
+	StartedAt   time.Time
+	Devices     int
+	Servers     int
+	Assignments int
+}
+
+// ExportSQLite writes the organization's devices, device management services
+// and device assignments into db, creating the tables in SQLiteSchema if
+// needed. db must be opened with a SQLite driver, such as modernc.org/sqlite
+// or github.com/mattn/go-sqlite3; this package does not import one.
+//
+// Everything is fetched before the database is touched, and then written in a
+// single transaction, so a failed export leaves the previous contents intact.
+// By default the devices, servers and assignments tables are replaced. With
+// opts.Incremental, devices and servers are upserted instead. Assignments are
+// always replaced, as the full list is fetched on every export.
+func ExportSQLite(ctx context.Context, c *axm.Client, db *sql.DB, opts *SQLiteExportOptions) (*SQLiteExportResult, error) {
+	if opts == nil {
+		opts = &SQLiteExportOptions{}
+	}
+	if !opts.UpdatedSince.IsZero() && !opts.Incremental {
+		return nil, errors.New("UpdatedSince requires Incremental")
+	}
+	started := time.Now().UTC()
+
+	var inventory *devices.OrgDevicesResponse
+	var err error
+	if opts.UpdatedSince.IsZero() {
+		inventory, _, err = c.AXMAPI.Devices.GetV1(ctx, nil)
+	} else {
+		inventory, _, err = c.AXMAPI.Devices.GetUpdatedSinceV1(ctx, opts.UpdatedSince, nil)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("list devices: %w", err)
+	}
+
+	dir := NewServerDirectory(c)
+	dir.mu.Lock()
+	err = dir.load(ctx)
+	servers, assigned := dir.servers, dir.assigned
+	dir.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin export: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, SQLiteSchema); err != nil {
+		return nil, fmt.Errorf("create schema: %w", err)
+	}
+	tables := []string{"assignments"}
+	if !opts.Incremental {
+		tables = append(tables, "devices", "servers")
+	}
+	for _, table := range tables {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM "+table); err != nil {
+			return nil, fmt.Errorf("clear %s: %w", table, err)
+		}
+	}
+
+	syncedAt := sqliteTime(&started)
+	for _, d := range inventory.Data {
+		if err := upsertSQLite(ctx, tx, "devices", deviceRow(d, syncedAt)); err != nil {
+			return nil, fmt.Errorf("write device %s: %w", d.ID, err)
+		}
+	}
+	for _, id := range slices.Sorted(maps.Keys(servers)) {
+		s := servers[id]
+		if err := upsertSQLite(ctx, tx, "servers", serverRow(s, syncedAt)); err != nil {
+			return nil, fmt.Errorf("write server %s: %w", s.ID, err)
+		}
+	}
+	for _, deviceID := range slices.Sorted(maps.Keys(assigned)) {
+		row := sqliteRow{{"device_id", deviceID}, {"server_id", assigned[deviceID]}}
+		if err := upsertSQLite(ctx, tx, "assignments", row); err != nil {
+			return nil, fmt.Errorf("write assignment of %s: %w", deviceID, err)
+		}
+	}
+
+	result := &SQLiteExportResult{
+		StartedAt:   started,
+		Devices:     len(inventory.Data),
+		Servers:     len(servers),
+		Assignments: len(assigned),
+	}
+	finished := time.Now()
+	mode := "full"
+	if opts.Incremental {
+		mode = "incremental"
+	}
+	if _, err := tx.ExecContext(ctx,
+		"INSERT INTO exports (started_at, finished_at, mode, devices, servers, assignments) VALUES (?, ?, ?, ?, ?, ?)",
+		syncedAt, sqliteTime(&finished), mode, result.Devices, result.Servers, result.Assignments,
+	); err != nil {
+		return nil, fmt.Errorf("record export: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit export: %w", err)
+	}
+	return result, nil
+}
+
+// LastSQLiteExport returns the start time of the most recent export recorded
+// in db, or the zero time if there is none.
+func LastSQLiteExport(ctx context.Context, db *sql.DB) (time.Time, error) {
+	var tables int
+	err := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'exports'").Scan(&tables)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("read last export: %w", err)
+	}
+	if tables == 0 {
+		return time.Time{}, nil
+	}
+
+	var last sql.NullString
+	if err := db.QueryRowContext(ctx, "SELECT MAX(started_at) FROM exports").Scan(&last); err != nil {
+		return time.Time{}, fmt.Errorf("read last export: %w", err)
+	}
+	if !last.Valid {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, last.String)
+}
+
+// sqliteRow is a row's columns in insertion order. The first column is the
+// primary key.
+type sqliteRow []struct {
+	name  string
+	value any
+}
+
+// upsertSQLite inserts row into table, replacing the columns of an existing
+// row with the same primary key.
+func upsertSQLite(ctx context.Context, tx *sql.Tx, table string, row sqliteRow) error {
+	names := make([]string, len(row))
+	args := make([]any, len(row))
+	updates := make([]string, 0, len(row)-1)
+	for i, col := range row {
+		names[i], args[i] = col.name, col.value
+		if i > 0 {
+			updates = append(updates, col.name+" = excluded."+col.name)
+		}
+	}
+	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES (?%s) ON CONFLICT (%s) DO UPDATE SET %s",
+		table, strings.Join(names, ", "), strings.Repeat(", ?", len(row)-1), names[0], strings.Join(updates, ", "))
+	_, err := tx.ExecContext(ctx, query, args...)
+	return err
+}
+
+func deviceRow(d devices.OrgDevice, syncedAt any) sqliteRow {
+	a := d.Attributes
+	if a == nil {
+		a = &devices.OrgDeviceAttributes{}
+	}
+	return sqliteRow{
+		{"id", d.ID},
+		{"serial_number", sqliteText(a.SerialNumber)},
+		{"device_model", sqliteText(a.DeviceModel)},
+		{"product_family", sqliteText(a.ProductFamily)},
+		{"product_type", sqliteText(a.ProductType)},
+		{"device_capacity", sqliteText(a.DeviceCapacity)},
+		{"part_number", sqliteText(a.PartNumber)},
+		{"order_number", sqliteText(a.OrderNumber)},
+		{"color", sqliteText(a.Color)},
+		{"status", sqliteText(a.Status)},
+		{"order_date_time", sqliteTime(a.OrderDateTime)},
+		{"added_to_org_date_time", sqliteTime(a.AddedToOrgDateTime)},
+		{"updated_date_time", sqliteTime(a.UpdatedDateTime)},
+		{"imei", sqliteList(a.IMEI)},
+		{"meid", sqliteList(a.MEID)},
+		{"eid", sqliteText(a.EID)},
+		{"wifi_mac_address", sqliteText(a.WiFiMACAddress)},
+		{"bluetooth_mac_address", sqliteText(a.BluetoothMACAddress)},
+		{"ethernet_mac_address", sqliteList(a.EthernetMACAddress)},
+		{"purchase_source_id", sqliteText(a.PurchaseSourceId)},
+		{"purchase_source_type", sqliteText(a.PurchaseSourceType)},
+		{"synced_at", syncedAt},
+	}
+}
+
+func serverRow(s devicemanagement.MDMServer, syncedAt any) sqliteRow {
+	a := s.Attributes
+	if a == nil {
+		a = &devicemanagement.MDMServerAttributes{}
+	}
+	return sqliteRow{
+		{"id", s.ID},
+		{"server_name", sqliteText(a.ServerName)},
+		{"server_type", sqliteText(a.ServerType)},
+		{"status", sqliteText(a.Status)},
+		{"default_product_families", sqliteList(a.DefaultProductFamilies)},
+		{"created_date_time", sqliteTime(a.CreatedDateTime)},
+		{"updated_date_time", sqliteTime(a.UpdatedDateTime)},
+		{"synced_at", syncedAt},
+	}
+}
+
+// sqliteText stores an empty string as NULL.
+func sqliteText(s string) any {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+// sqliteTime stores a timestamp as RFC 3339 text in UTC to the second, so that
+// values sort as text, or NULL if unset.
+func sqliteTime(t *time.Time) any {
+	if t == nil || t.IsZero() {
+		return nil
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// sqliteList stores a list as a JSON array, or NULL if it is empty.
+func sqliteList(values []string) any {
+	if len(values) == 0 {
+		return nil
+	}
+	data, _ := json.Marshal(values)
+	return string(data)
+}
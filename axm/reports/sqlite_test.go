@@ -0,0 +1,242 @@
+package reports
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sqlRecorder backs a database/sql driver that records the statements it
+// executes and answers queries from a fixed table, so the exporter can be
+// tested without a SQLite driver.
+type sqlRecorder struct {
+	mu        sync.Mutex
+	execs     []recordedExec
+	committed bool
+	queries   map[string][]driver.Value
+}
+
+type recordedExec struct {
+	query string
+	args  []driver.Value
+}
+
+var (
+	recordersMu sync.Mutex
+	recorders   = map[string]*sqlRecorder{}
+)
+
+func init() {
+	sql.Register("recording", recordingDriver{})
+}
+
+type recordingDriver struct{}
+
+func (recordingDriver) Open(name string) (driver.Conn, error) {
+	recordersMu.Lock()
+	defer recordersMu.Unlock()
+	return &recordingConn{d: recorders[name]}, nil
+}
+
+// openRecordingDB returns a database whose statements are recorded by a new sqlRecorder.
+func openRecordingDB(t *testing.T) (*sql.DB, *sqlRecorder) {
+	t.Helper()
+	rec := &sqlRecorder{queries: map[string][]driver.Value{}}
+	recordersMu.Lock()
+	recorders[t.Name()] = rec
+	recordersMu.Unlock()
+	db, err := sql.Open("recording", t.Name())
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db, rec
+}
+
+// execsLike returns the recorded statements starting with prefix.
+func (d *sqlRecorder) execsLike(prefix string) []recordedExec {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var out []recordedExec
+	for _, e := range d.execs {
+		if strings.HasPrefix(strings.TrimSpace(e.query), prefix) {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+type recordingConn struct{ d *sqlRecorder }
+
+func (c *recordingConn) Prepare(query string) (driver.Stmt, error) {
+	return &recordingStmt{d: c.d, query: query}, nil
+}
+func (c *recordingConn) Close() error              { return nil }
+func (c *recordingConn) Begin() (driver.Tx, error) { return recordingTx{c.d}, nil }
+
+type recordingTx struct{ d *sqlRecorder }
+
+func (tx recordingTx) Commit() error {
+	tx.d.mu.Lock()
+	defer tx.d.mu.Unlock()
+	tx.d.committed = true
+	return nil
+}
+func (tx recordingTx) Rollback() error { return nil }
+
+type recordingStmt struct {
+	d     *sqlRecorder
+	query string
+}
+
+func (s *recordingStmt) Close() error  { return nil }
+func (s *recordingStmt) NumInput() int { return -1 }
+
+func (s *recordingStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+	s.d.execs = append(s.d.execs, recordedExec{query: s.query, args: args})
+	return driver.RowsAffected(1), nil
+}
+
+func (s *recordingStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+	row, ok := s.d.queries[s.query]
+	if !ok {
+		return nil, fmt.Errorf("unexpected query %q", s.query)
+	}
+	return &recordingRows{row: row}, nil
+}
+
+type recordingRows struct {
+	row  []driver.Value
+	done bool
+}
+
+func (r *recordingRows) Columns() []string { return make([]string, len(r.row)) }
+func (r *recordingRows) Close() error      { return nil }
+func (r *recordingRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	copy(dest, r.row)
+	return nil
+}
+
+func TestExportSQLite_Full(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	registerServers(mt)
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDevices", jsonResponder(200, `{"data":[
+		{"type":"orgDevices","id":"D1","attributes":{"serialNumber":"S1","imei":["1","2"],"updatedDateTime":"2025-03-01T10:00:00.5Z"}},
+		{"type":"orgDevices","id":"D4"}
+	]}`))
+	db, rec := openRecordingDB(t)
+
+	result, err := ExportSQLite(context.Background(), setupClient(t, mt), db, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Devices)
+	assert.Equal(t, 2, result.Servers)
+	assert.Equal(t, 3, result.Assignments)
+	assert.True(t, rec.committed)
+
+	require.Len(t, rec.execsLike("CREATE TABLE IF NOT EXISTS devices"), 1)
+	var cleared []string
+	for _, e := range rec.execsLike("DELETE FROM") {
+		cleared = append(cleared, strings.TrimPrefix(e.query, "DELETE FROM "))
+	}
+	assert.Equal(t, []string{"assignments", "devices", "servers"}, cleared)
+
+	devicesRows := rec.execsLike("INSERT INTO devices")
+	require.Len(t, devicesRows, 2)
+	assert.Contains(t, devicesRows[0].query, "ON CONFLICT (id) DO UPDATE SET serial_number = excluded.serial_number")
+	args := devicesRows[0].args
+	assert.Equal(t, "D1", args[0])
+	assert.Equal(t, "S1", args[1])
+	assert.Nil(t, args[2], "unset attributes are NULL")
+	assert.Equal(t, "2025-03-01T10:00:00Z", args[12])
+	assert.Equal(t, `["1","2"]`, args[13])
+	assert.Equal(t, result.StartedAt.Format(time.RFC3339), args[len(args)-1])
+
+	servers := rec.execsLike("INSERT INTO servers")
+	require.Len(t, servers, 2)
+	assert.Equal(t, "SRV1", servers[0].args[0])
+	assert.Equal(t, "Jamf Pro", servers[0].args[1])
+
+	assignments := rec.execsLike("INSERT INTO assignments")
+	require.Len(t, assignments, 3)
+	assert.Equal(t, []driver.Value{"D1", "SRV1"}, assignments[0].args)
+	assert.Equal(t, []driver.Value{"D3", "SRV2"}, assignments[2].args)
+
+	exports := rec.execsLike("INSERT INTO exports")
+	require.Len(t, exports, 1)
+	assert.Equal(t, "full", exports[0].args[2])
+	assert.Equal(t, int64(2), exports[0].args[3])
+}
+
+func TestExportSQLite_Incremental(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	registerServers(mt)
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDevices", jsonResponder(200, `{"data":[
+		{"type":"orgDevices","id":"D1","attributes":{"updatedDateTime":"2025-03-01T10:00:00Z"}},
+		{"type":"orgDevices","id":"D2","attributes":{"updatedDateTime":"2025-01-01T10:00:00Z"}}
+	]}`))
+	db, rec := openRecordingDB(t)
+
+	since := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	result, err := ExportSQLite(context.Background(), setupClient(t, mt), db,
+		&SQLiteExportOptions{Incremental: true, UpdatedSince: since})
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Devices, "only devices updated since the last export are written")
+
+	deletes := rec.execsLike("DELETE FROM")
+	require.Len(t, deletes, 1)
+	assert.Equal(t, "DELETE FROM assignments", deletes[0].query)
+	assert.Equal(t, "incremental", rec.execsLike("INSERT INTO exports")[0].args[2])
+
+	_, err = ExportSQLite(context.Background(), setupClient(t, mt), db, &SQLiteExportOptions{UpdatedSince: since})
+	assert.ErrorContains(t, err, "UpdatedSince requires Incremental")
+}
+
+func TestExportSQLite_FetchErrorLeavesDatabaseUntouched(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDevices",
+		jsonResponder(500, `{"errors":[{"status":"500","code":"INTERNAL_ERROR","title":"Internal Server Error"}]}`))
+	db, rec := openRecordingDB(t)
+
+	_, err := ExportSQLite(context.Background(), setupClient(t, mt), db, nil)
+	require.ErrorContains(t, err, "list devices")
+	assert.Empty(t, rec.execs)
+	assert.False(t, rec.committed)
+}
+
+func TestLastSQLiteExport(t *testing.T) {
+	db, rec := openRecordingDB(t)
+	tableQuery := "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'exports'"
+	ctx := context.Background()
+
+	rec.queries[tableQuery] = []driver.Value{int64(0)}
+	last, err := LastSQLiteExport(ctx, db)
+	require.NoError(t, err)
+	assert.True(t, last.IsZero(), "no exports table")
+
+	rec.queries[tableQuery] = []driver.Value{int64(1)}
+	rec.queries["SELECT MAX(started_at) FROM exports"] = []driver.Value{nil}
+	last, err = LastSQLiteExport(ctx, db)
+	require.NoError(t, err)
+	assert.True(t, last.IsZero(), "empty exports table")
+
+	rec.queries["SELECT MAX(started_at) FROM exports"] = []driver.Value{"2025-03-01T10:00:00Z"}
+	last, err = LastSQLiteExport(ctx, db)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC), last)
+}
@@ -0,0 +1,91 @@
+package schema
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Comments maps schema names to descriptions. A type is keyed by its schema
+// name, such as "devices.OrgDevice", and a field by the type's key followed by
+// the Go field name, such as "devices.OrgDevice.Attributes".
+type Comments map[string]string
+
+// ParseComments reads the doc comments of the types and fields declared in
+// the non-test Go files of each package directory under root, such as
+// axm/axm_api. A field without a doc comment uses its line comment.
+func ParseComments(root string) (Comments, error) {
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return nil, fmt.Errorf("schema: %w", err)
+	}
+	comments := make(Comments)
+	fset := token.NewFileSet()
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		dir := filepath.Join(root, entry.Name())
+		files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+		if err != nil {
+			return nil, err
+		}
+		for _, path := range files {
+			if strings.HasSuffix(path, "_test.go") {
+				continue
+			}
+			file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+			if err != nil {
+				return nil, fmt.Errorf("schema: %w", err)
+			}
+			collectComments(comments, file)
+		}
+	}
+	return comments, nil
+}
+
+func collectComments(comments Comments, file *ast.File) {
+	pkg := file.Name.Name
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			ts := spec.(*ast.TypeSpec)
+			st, ok := ts.Type.(*ast.StructType)
+			if !ok || !ts.Name.IsExported() {
+				continue
+			}
+			key := pkg + "." + ts.Name.Name
+			doc := ts.Doc
+			if doc == nil && len(gen.Specs) == 1 {
+				doc = gen.Doc
+			}
+			if text := commentText(doc); text != "" {
+				comments[key] = text
+			}
+			for _, field := range st.Fields.List {
+				text := commentText(field.Doc)
+				if text == "" {
+					text = commentText(field.Comment)
+				}
+				if text == "" {
+					continue
+				}
+				for _, name := range field.Names {
+					comments[key+"."+name.Name] = text
+				}
+			}
+		}
+	}
+}
+
+// commentText returns a comment as one line of text.
+func commentText(group *ast.CommentGroup) string {
+	return strings.Join(strings.Fields(group.Text()), " ")
+}
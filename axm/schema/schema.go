@@ -0,0 +1,306 @@
+// Package schema generates an OpenAPI 3.1 or JSON Schema (draft 2020-12)
+// document from the request and response models in axm/axm_api, so that
+// validation layers and code generators for other languages can use the same
+// view of Apple's payloads as the SDK.
+//
+// The schemas are derived from the Go types by reflection: json tags give the
+// property names, fields without omitempty are required, and *time.Time
+// becomes a date-time string. Each schema is named after its package and type,
+// such as "devices.OrgDevice", because several packages define types with the
+// same name. Descriptions come from the doc comments of the types and fields,
+// which ParseComments reads from the package sources.
+//
+// The generated document for the current models is checked in as
+// openapi.json; run go generate in this directory after changing a model.
+//
+// The document describes only payloads, not paths: the SDK's models do not
+// record which endpoint returns which type. Values the API documents as enums,
+// such as activity statuses, are left as plain strings so that a value Apple
+// adds later does not fail validation.
+package schema
+
+//go:generate go run ../cmd/axmschema -src ../axm_api -out openapi.json
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/apps"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/auditevents"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/blueprints"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/classes"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/configurations"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devicemanagement"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devices"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/organizationalunits"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/packages"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/people"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/usergroups"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/users"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/client"
+)
+
+// Dialect is the JSON Schema dialect of the generated schemas.
+const Dialect = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema is a JSON Schema. Only the keywords the generator emits are defined.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+}
+
+// Models returns the root request and response models that the document
+// describes. Types they reference are included as well.
+func Models() []any {
+	return []any{
+		apps.AppsResponse{},
+		apps.AppResponse{},
+		auditevents.AuditEventsResponse{},
+		blueprints.BlueprintResponse{},
+		blueprints.BlueprintCreateRequest{},
+		blueprints.BlueprintUpdateRequest{},
+		blueprints.BlueprintAppsLinkagesRequest{},
+		blueprints.BlueprintAppsLinkagesResponse{},
+		blueprints.BlueprintConfigurationsLinkagesRequest{},
+		blueprints.BlueprintConfigurationsLinkagesResponse{},
+		blueprints.BlueprintPackagesLinkagesRequest{},
+		blueprints.BlueprintPackagesLinkagesResponse{},
+		blueprints.BlueprintOrgDevicesLinkagesRequest{},
+		blueprints.BlueprintOrgDevicesLinkagesResponse{},
+		blueprints.BlueprintUsersLinkagesRequest{},
+		blueprints.BlueprintUsersLinkagesResponse{},
+		blueprints.BlueprintUserGroupsLinkagesRequest{},
+		blueprints.BlueprintUserGroupsLinkagesResponse{},
+		classes.ClassesResponse{},
+		classes.ClassResponse{},
+		classes.ClassMembersLinkagesResponse{},
+		configurations.ConfigurationsResponse{},
+		configurations.ConfigurationResponse{},
+		configurations.ConfigurationCreateRequest{},
+		configurations.ConfigurationUpdateRequest{},
+		devicemanagement.ResponseMDMServers{},
+		devicemanagement.MDMServerResponse{},
+		devicemanagement.MDMServerCreateRequest{},
+		devicemanagement.MDMServerUpdateRequest{},
+		devicemanagement.ResponseMDMServerDevicesLinkages{},
+		devicemanagement.ResponseOrgDeviceAssignedServerLinkage{},
+		devicemanagement.ResponseOrgDeviceActivity{},
+		devicemanagement.OrgDeviceActivityCreateRequest{},
+		devices.OrgDeviceResponse{},
+		devices.OrgDevicesResponse{},
+		devices.AppleCareCoverageResponse{},
+		organizationalunits.OrganizationalUnitsResponse{},
+		organizationalunits.OrganizationalUnitResponse{},
+		organizationalunits.OrganizationalUnitUsersLinkagesResponse{},
+		packages.PackagesResponse{},
+		packages.PackageResponse{},
+		people.PeopleResponse{},
+		people.PersonResponse{},
+		people.ClassLinkagesResponse{},
+		usergroups.UserGroupsResponse{},
+		usergroups.UserGroupResponse{},
+		usergroups.UserGroupUsersLinkagesResponse{},
+		users.UsersResponse{},
+		users.UserResponse{},
+	}
+}
+
+// Options configures document generation.
+type Options struct {
+	// Models are the root types to describe. Defaults to Models().
+	Models []any
+
+	// Comments supplies descriptions, keyed as described on Comments.
+	Comments Comments
+}
+
+// OpenAPI returns an OpenAPI 3.1 document whose components.schemas describe
+// the models.
+func OpenAPI(opts Options) ([]byte, error) {
+	defs, err := generate(opts, "#/components/schemas/")
+	if err != nil {
+		return nil, err
+	}
+	doc := map[string]any{
+		"openapi":           "3.1.0",
+		"jsonSchemaDialect": Dialect,
+		"info": map[string]any{
+			"title":       "Apple Business Manager API models",
+			"description": "Request and response payloads as modelled by github.com/deploymenttheory/go-api-sdk-apple/axm.",
+			"version":     client.Version,
+		},
+		"paths":      map[string]any{},
+		"components": map[string]any{"schemas": defs},
+	}
+	return marshal(doc)
+}
+
+// JSONSchema returns a JSON Schema document that defines the models under
+// $defs.
+func JSONSchema(opts Options) ([]byte, error) {
+	defs, err := generate(opts, "#/$defs/")
+	if err != nil {
+		return nil, err
+	}
+	doc := map[string]any{
+		"$schema": Dialect,
+		"title":   "Apple Business Manager API models",
+		"$defs":   defs,
+	}
+	return marshal(doc)
+}
+
+func marshal(doc any) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// generator builds the named schemas for a set of models.
+type generator struct {
+	refPrefix string
+	comments  Comments
+	defs      map[string]*Schema
+}
+
+func generate(opts Options, refPrefix string) (map[string]*Schema, error) {
+	models := opts.Models
+	if models == nil {
+		models = Models()
+	}
+	g := &generator{refPrefix: refPrefix, comments: opts.Comments, defs: make(map[string]*Schema)}
+	for _, m := range models {
+		t := reflect.TypeOf(m)
+		if t == nil || t.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("schema: model %T is not a struct", m)
+		}
+		if _, err := g.schemaFor(t); err != nil {
+			return nil, err
+		}
+	}
+	return g.defs, nil
+}
+
+var timeType = reflect.TypeFor[time.Time]()
+
+// schemaFor returns the schema of t, adding a named schema to g.defs for each
+// struct type it reaches.
+func (g *generator) schemaFor(t reflect.Type) (*Schema, error) {
+	if t == timeType {
+		return &Schema{Type: "string", Format: "date-time"}, nil
+	}
+	switch t.Kind() {
+	case reflect.Pointer:
+		return g.schemaFor(t.Elem())
+	case reflect.String:
+		return &Schema{Type: "string"}, nil
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}, nil
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}, nil
+	case reflect.Slice, reflect.Array:
+		items, err := g.schemaFor(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "array", Items: items}, nil
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("schema: map key of %s is not a string", t)
+		}
+		values, err := g.schemaFor(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "object", AdditionalProperties: values}, nil
+	case reflect.Interface:
+		return &Schema{}, nil
+	case reflect.Struct:
+		return g.structRef(t)
+	}
+	return nil, fmt.Errorf("schema: unsupported type %s", t)
+}
+
+// structRef defines the schema of struct type t, once, and returns a
+// reference to it.
+func (g *generator) structRef(t reflect.Type) (*Schema, error) {
+	name := typeName(t)
+	ref := &Schema{Ref: g.refPrefix + name}
+	if _, ok := g.defs[name]; ok {
+		return ref, nil
+	}
+	s := &Schema{Type: "object", Description: g.comments[name], Properties: make(map[string]*Schema)}
+	// Registered before the fields are walked, so recursive types terminate.
+	g.defs[name] = s
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		prop, omitempty, ok := jsonName(f)
+		if !ok {
+			continue
+		}
+		fs, err := g.schemaFor(f.Type)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", name, f.Name, err)
+		}
+		if desc := g.comments[name+"."+f.Name]; desc != "" {
+			if fs.Ref != "" {
+				// Siblings of $ref are allowed from draft 2019-09 on, which
+				// OpenAPI 3.1 uses.
+				fs = &Schema{Ref: fs.Ref}
+			}
+			fs.Description = desc
+		}
+		s.Properties[prop] = fs
+		if !omitempty {
+			s.Required = append(s.Required, prop)
+		}
+	}
+	return ref, nil
+}
+
+// typeName returns the schema name of a struct type: its package name and
+// type name, such as "devices.OrgDevice".
+func typeName(t reflect.Type) string {
+	pkg := t.PkgPath()
+	return pkg[strings.LastIndex(pkg, "/")+1:] + "." + t.Name()
+}
+
+// jsonName returns the property name of a struct field, whether it is
+// omitted when empty, and false if encoding/json skips it.
+func jsonName(f reflect.StructField) (name string, omitempty, ok bool) {
+	tag := f.Tag.Get("json")
+	if tag == "-" {
+		return "", false, false
+	}
+	name, opts, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = f.Name
+	}
+	for opt := range strings.SplitSeq(opts, ",") {
+		if opt == "omitempty" || opt == "omitzero" {
+			omitempty = true
+		}
+	}
+	return name, omitempty, true
+}
@@ -0,0 +1,80 @@
+package schema
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type node struct {
+	Name     string         `json:"name"`
+	Created  *time.Time     `json:"created,omitempty"`
+	Tags     []string       `json:"tags,omitempty"`
+	Labels   map[string]int `json:"labels,omitempty"`
+	Children []node         `json:"children,omitempty"`
+	Parent   *node          `json:"parent,omitempty"`
+	Skipped  string         `json:"-"`
+	Untagged bool
+}
+
+func TestOpenAPI(t *testing.T) {
+	data, err := OpenAPI(Options{
+		Models:   []any{node{}},
+		Comments: Comments{"schema.node": "A tree node.", "schema.node.Parent": "The enclosing node."},
+	})
+	require.NoError(t, err)
+
+	var doc struct {
+		OpenAPI    string `json:"openapi"`
+		Components struct {
+			Schemas map[string]*Schema `json:"schemas"`
+		} `json:"components"`
+	}
+	require.NoError(t, json.Unmarshal(data, &doc))
+	assert.Equal(t, "3.1.0", doc.OpenAPI)
+	require.Len(t, doc.Components.Schemas, 1)
+
+	s := doc.Components.Schemas["schema.node"]
+	require.NotNil(t, s)
+	assert.Equal(t, "A tree node.", s.Description)
+	assert.Equal(t, []string{"name", "Untagged"}, s.Required)
+	assert.Len(t, s.Properties, 7)
+	assert.Equal(t, &Schema{Type: "string", Format: "date-time"}, s.Properties["created"])
+	assert.Equal(t, &Schema{Type: "array", Items: &Schema{Type: "string"}}, s.Properties["tags"])
+	assert.Equal(t, &Schema{Type: "object", AdditionalProperties: &Schema{Type: "integer"}}, s.Properties["labels"])
+	assert.Equal(t, &Schema{Type: "array", Items: &Schema{Ref: "#/components/schemas/schema.node"}}, s.Properties["children"])
+	assert.Equal(t, &Schema{Ref: "#/components/schemas/schema.node", Description: "The enclosing node."}, s.Properties["parent"])
+	assert.Equal(t, &Schema{Type: "boolean"}, s.Properties["Untagged"])
+}
+
+func TestJSONSchema(t *testing.T) {
+	data, err := JSONSchema(Options{Models: []any{node{}}})
+	require.NoError(t, err)
+	var doc map[string]any
+	require.NoError(t, json.Unmarshal(data, &doc))
+	assert.Equal(t, Dialect, doc["$schema"])
+	assert.Contains(t, string(data), `"$ref": "#/$defs/schema.node"`)
+
+	_, err = JSONSchema(Options{Models: []any{"not a struct"}})
+	assert.ErrorContains(t, err, "is not a struct")
+}
+
+// TestCheckedInDocument fails when a model changed without openapi.json being
+// regenerated.
+func TestCheckedInDocument(t *testing.T) {
+	comments, err := ParseComments("../axm_api")
+	require.NoError(t, err)
+	assert.Equal(t, "OrgDevice represents a device in the Apple Business Manager system based on the API specification",
+		comments["devices.OrgDevice"])
+	assert.Equal(t, `must be "mdmServers"`, comments["devicemanagement.MDMServerCreateRequestData.Type"])
+
+	want, err := OpenAPI(Options{Comments: comments})
+	require.NoError(t, err)
+	got, err := os.ReadFile("openapi.json")
+	require.NoError(t, err)
+	assert.True(t, string(want) == string(got), "openapi.json is out of date; run go generate ./axm/schema")
+}
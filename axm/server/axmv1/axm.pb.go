@@ -0,0 +1,1074 @@
+// Protobuf definitions for the gRPC facade in package
+// github.com/deploymenttheory/go-api-sdk-apple/axm/server. Regenerate the Go
+// code in axm/server/axmv1 after editing; see axm/server/doc.go.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: axm/v1/axm.proto
+
+package axmv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Device struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SerialNumber        string                 `protobuf:"bytes,2,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	DeviceModel         string                 `protobuf:"bytes,3,opt,name=device_model,json=deviceModel,proto3" json:"device_model,omitempty"`
+	ProductFamily       string                 `protobuf:"bytes,4,opt,name=product_family,json=productFamily,proto3" json:"product_family,omitempty"`
+	ProductType         string                 `protobuf:"bytes,5,opt,name=product_type,json=productType,proto3" json:"product_type,omitempty"`
+	DeviceCapacity      string                 `protobuf:"bytes,6,opt,name=device_capacity,json=deviceCapacity,proto3" json:"device_capacity,omitempty"`
+	PartNumber          string                 `protobuf:"bytes,7,opt,name=part_number,json=partNumber,proto3" json:"part_number,omitempty"`
+	OrderNumber         string                 `protobuf:"bytes,8,opt,name=order_number,json=orderNumber,proto3" json:"order_number,omitempty"`
+	Color               string                 `protobuf:"bytes,9,opt,name=color,proto3" json:"color,omitempty"`
+	Status              string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	OrderTime           *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=order_time,json=orderTime,proto3" json:"order_time,omitempty"`
+	AddedToOrgTime      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=added_to_org_time,json=addedToOrgTime,proto3" json:"added_to_org_time,omitempty"`
+	UpdateTime          *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	Imei                []string               `protobuf:"bytes,14,rep,name=imei,proto3" json:"imei,omitempty"`
+	Meid                []string               `protobuf:"bytes,15,rep,name=meid,proto3" json:"meid,omitempty"`
+	Eid                 string                 `protobuf:"bytes,16,opt,name=eid,proto3" json:"eid,omitempty"`
+	WifiMacAddress      string                 `protobuf:"bytes,17,opt,name=wifi_mac_address,json=wifiMacAddress,proto3" json:"wifi_mac_address,omitempty"`
+	BluetoothMacAddress string                 `protobuf:"bytes,18,opt,name=bluetooth_mac_address,json=bluetoothMacAddress,proto3" json:"bluetooth_mac_address,omitempty"`
+	EthernetMacAddress  []string               `protobuf:"bytes,19,rep,name=ethernet_mac_address,json=ethernetMacAddress,proto3" json:"ethernet_mac_address,omitempty"`
+	PurchaseSourceId    string                 `protobuf:"bytes,20,opt,name=purchase_source_id,json=purchaseSourceId,proto3" json:"purchase_source_id,omitempty"`
+	PurchaseSourceType  string                 `protobuf:"bytes,21,opt,name=purchase_source_type,json=purchaseSourceType,proto3" json:"purchase_source_type,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Device) Reset() {
+	*x = Device{}
+	mi := &file_axm_v1_axm_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Device) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Device) ProtoMessage() {}
+
+func (x *Device) ProtoReflect() protoreflect.Message {
+	mi := &file_axm_v1_axm_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Device.ProtoReflect.Descriptor instead.
+func (*Device) Descriptor() ([]byte, []int) {
+	return file_axm_v1_axm_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Device) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Device) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *Device) GetDeviceModel() string {
+	if x != nil {
+		return x.DeviceModel
+	}
+	return ""
+}
+
+func (x *Device) GetProductFamily() string {
+	if x != nil {
+		return x.ProductFamily
+	}
+	return ""
+}
+
+func (x *Device) GetProductType() string {
+	if x != nil {
+		return x.ProductType
+	}
+	return ""
+}
+
+func (x *Device) GetDeviceCapacity() string {
+	if x != nil {
+		return x.DeviceCapacity
+	}
+	return ""
+}
+
+func (x *Device) GetPartNumber() string {
+	if x != nil {
+		return x.PartNumber
+	}
+	return ""
+}
+
+func (x *Device) GetOrderNumber() string {
+	if x != nil {
+		return x.OrderNumber
+	}
+	return ""
+}
+
+func (x *Device) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *Device) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Device) GetOrderTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OrderTime
+	}
+	return nil
+}
+
+func (x *Device) GetAddedToOrgTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AddedToOrgTime
+	}
+	return nil
+}
+
+func (x *Device) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *Device) GetImei() []string {
+	if x != nil {
+		return x.Imei
+	}
+	return nil
+}
+
+func (x *Device) GetMeid() []string {
+	if x != nil {
+		return x.Meid
+	}
+	return nil
+}
+
+func (x *Device) GetEid() string {
+	if x != nil {
+		return x.Eid
+	}
+	return ""
+}
+
+func (x *Device) GetWifiMacAddress() string {
+	if x != nil {
+		return x.WifiMacAddress
+	}
+	return ""
+}
+
+func (x *Device) GetBluetoothMacAddress() string {
+	if x != nil {
+		return x.BluetoothMacAddress
+	}
+	return ""
+}
+
+func (x *Device) GetEthernetMacAddress() []string {
+	if x != nil {
+		return x.EthernetMacAddress
+	}
+	return nil
+}
+
+func (x *Device) GetPurchaseSourceId() string {
+	if x != nil {
+		return x.PurchaseSourceId
+	}
+	return ""
+}
+
+func (x *Device) GetPurchaseSourceType() string {
+	if x != nil {
+		return x.PurchaseSourceType
+	}
+	return ""
+}
+
+type Server struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ServerName    string                 `protobuf:"bytes,2,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	ServerType    string                 `protobuf:"bytes,3,opt,name=server_type,json=serverType,proto3" json:"server_type,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server) Reset() {
+	*x = Server{}
+	mi := &file_axm_v1_axm_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server) ProtoMessage() {}
+
+func (x *Server) ProtoReflect() protoreflect.Message {
+	mi := &file_axm_v1_axm_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server.ProtoReflect.Descriptor instead.
+func (*Server) Descriptor() ([]byte, []int) {
+	return file_axm_v1_axm_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Server) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Server) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *Server) GetServerType() string {
+	if x != nil {
+		return x.ServerType
+	}
+	return ""
+}
+
+func (x *Server) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Server) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Server) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type Activity struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActivityType string                 `protobuf:"bytes,2,opt,name=activity_type,json=activityType,proto3" json:"activity_type,omitempty"`
+	Status       string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	SubStatus    string                 `protobuf:"bytes,4,opt,name=sub_status,json=subStatus,proto3" json:"sub_status,omitempty"`
+	CreateTime   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	DownloadUrl  string                 `protobuf:"bytes,6,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
+	// terminal is true once the activity has finished, and succeeded is true
+	// if it finished without failures.
+	Terminal      bool `protobuf:"varint,7,opt,name=terminal,proto3" json:"terminal,omitempty"`
+	Succeeded     bool `protobuf:"varint,8,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Activity) Reset() {
+	*x = Activity{}
+	mi := &file_axm_v1_axm_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Activity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Activity) ProtoMessage() {}
+
+func (x *Activity) ProtoReflect() protoreflect.Message {
+	mi := &file_axm_v1_axm_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Activity.ProtoReflect.Descriptor instead.
+func (*Activity) Descriptor() ([]byte, []int) {
+	return file_axm_v1_axm_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Activity) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Activity) GetActivityType() string {
+	if x != nil {
+		return x.ActivityType
+	}
+	return ""
+}
+
+func (x *Activity) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Activity) GetSubStatus() string {
+	if x != nil {
+		return x.SubStatus
+	}
+	return ""
+}
+
+func (x *Activity) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Activity) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *Activity) GetTerminal() bool {
+	if x != nil {
+		return x.Terminal
+	}
+	return false
+}
+
+func (x *Activity) GetSucceeded() bool {
+	if x != nil {
+		return x.Succeeded
+	}
+	return false
+}
+
+type ListDevicesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// page_size is the number of devices to return, up to 1000. Zero uses the
+	// API default.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token is the next_page_token of the previous response.
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDevicesRequest) Reset() {
+	*x = ListDevicesRequest{}
+	mi := &file_axm_v1_axm_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDevicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDevicesRequest) ProtoMessage() {}
+
+func (x *ListDevicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_axm_v1_axm_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDevicesRequest.ProtoReflect.Descriptor instead.
+func (*ListDevicesRequest) Descriptor() ([]byte, []int) {
+	return file_axm_v1_axm_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListDevicesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDevicesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListDevicesResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Devices []*Device              `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+	// next_page_token is empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDevicesResponse) Reset() {
+	*x = ListDevicesResponse{}
+	mi := &file_axm_v1_axm_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDevicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDevicesResponse) ProtoMessage() {}
+
+func (x *ListDevicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_axm_v1_axm_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDevicesResponse.ProtoReflect.Descriptor instead.
+func (*ListDevicesResponse) Descriptor() ([]byte, []int) {
+	return file_axm_v1_axm_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListDevicesResponse) GetDevices() []*Device {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+func (x *ListDevicesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeviceRequest) Reset() {
+	*x = GetDeviceRequest{}
+	mi := &file_axm_v1_axm_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeviceRequest) ProtoMessage() {}
+
+func (x *GetDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_axm_v1_axm_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeviceRequest.ProtoReflect.Descriptor instead.
+func (*GetDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_axm_v1_axm_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetDeviceRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+type ListServersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServersRequest) Reset() {
+	*x = ListServersRequest{}
+	mi := &file_axm_v1_axm_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServersRequest) ProtoMessage() {}
+
+func (x *ListServersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_axm_v1_axm_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServersRequest.ProtoReflect.Descriptor instead.
+func (*ListServersRequest) Descriptor() ([]byte, []int) {
+	return file_axm_v1_axm_proto_rawDescGZIP(), []int{6}
+}
+
+type ListServersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Servers       []*Server              `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServersResponse) Reset() {
+	*x = ListServersResponse{}
+	mi := &file_axm_v1_axm_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServersResponse) ProtoMessage() {}
+
+func (x *ListServersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_axm_v1_axm_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServersResponse.ProtoReflect.Descriptor instead.
+func (*ListServersResponse) Descriptor() ([]byte, []int) {
+	return file_axm_v1_axm_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListServersResponse) GetServers() []*Server {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+type AssignDevicesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	DeviceIds     []string               `protobuf:"bytes,2,rep,name=device_ids,json=deviceIds,proto3" json:"device_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignDevicesRequest) Reset() {
+	*x = AssignDevicesRequest{}
+	mi := &file_axm_v1_axm_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignDevicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignDevicesRequest) ProtoMessage() {}
+
+func (x *AssignDevicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_axm_v1_axm_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignDevicesRequest.ProtoReflect.Descriptor instead.
+func (*AssignDevicesRequest) Descriptor() ([]byte, []int) {
+	return file_axm_v1_axm_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AssignDevicesRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *AssignDevicesRequest) GetDeviceIds() []string {
+	if x != nil {
+		return x.DeviceIds
+	}
+	return nil
+}
+
+type GetActivityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActivityId    string                 `protobuf:"bytes,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActivityRequest) Reset() {
+	*x = GetActivityRequest{}
+	mi := &file_axm_v1_axm_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActivityRequest) ProtoMessage() {}
+
+func (x *GetActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_axm_v1_axm_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActivityRequest.ProtoReflect.Descriptor instead.
+func (*GetActivityRequest) Descriptor() ([]byte, []int) {
+	return file_axm_v1_axm_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetActivityRequest) GetActivityId() string {
+	if x != nil {
+		return x.ActivityId
+	}
+	return ""
+}
+
+type WatchDevicesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// interval_seconds is the time between polls. Zero uses the server's
+	// default; values below the server's minimum are raised to it.
+	IntervalSeconds int32 `protobuf:"varint,1,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WatchDevicesRequest) Reset() {
+	*x = WatchDevicesRequest{}
+	mi := &file_axm_v1_axm_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchDevicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchDevicesRequest) ProtoMessage() {}
+
+func (x *WatchDevicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_axm_v1_axm_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchDevicesRequest.ProtoReflect.Descriptor instead.
+func (*WatchDevicesRequest) Descriptor() ([]byte, []int) {
+	return file_axm_v1_axm_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WatchDevicesRequest) GetIntervalSeconds() int32 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+type DeviceEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is unique to the event.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// type is "device.upserted", "device.deleted" or "server.upserted".
+	Type     string  `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	DeviceId string  `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Device   *Device `protobuf:"bytes,4,opt,name=device,proto3" json:"device,omitempty"`
+	// server_id and server_name identify the service a device is assigned to,
+	// or the service a server.upserted event describes.
+	ServerId      string                 `protobuf:"bytes,5,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ServerName    string                 `protobuf:"bytes,6,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	Server        *Server                `protobuf:"bytes,7,opt,name=server,proto3" json:"server,omitempty"`
+	ObserveTime   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=observe_time,json=observeTime,proto3" json:"observe_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceEvent) Reset() {
+	*x = DeviceEvent{}
+	mi := &file_axm_v1_axm_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceEvent) ProtoMessage() {}
+
+func (x *DeviceEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_axm_v1_axm_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceEvent.ProtoReflect.Descriptor instead.
+func (*DeviceEvent) Descriptor() ([]byte, []int) {
+	return file_axm_v1_axm_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeviceEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeviceEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DeviceEvent) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *DeviceEvent) GetDevice() *Device {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+func (x *DeviceEvent) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *DeviceEvent) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *DeviceEvent) GetServer() *Server {
+	if x != nil {
+		return x.Server
+	}
+	return nil
+}
+
+func (x *DeviceEvent) GetObserveTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ObserveTime
+	}
+	return nil
+}
+
+var File_axm_v1_axm_proto protoreflect.FileDescriptor
+
+const file_axm_v1_axm_proto_rawDesc = "" +
+	"\n" +
+	"\x10axm/v1/axm.proto\x12\x06axm.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xae\x06\n" +
+	"\x06Device\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rserial_number\x18\x02 \x01(\tR\fserialNumber\x12!\n" +
+	"\fdevice_model\x18\x03 \x01(\tR\vdeviceModel\x12%\n" +
+	"\x0eproduct_family\x18\x04 \x01(\tR\rproductFamily\x12!\n" +
+	"\fproduct_type\x18\x05 \x01(\tR\vproductType\x12'\n" +
+	"\x0fdevice_capacity\x18\x06 \x01(\tR\x0edeviceCapacity\x12\x1f\n" +
+	"\vpart_number\x18\a \x01(\tR\n" +
+	"partNumber\x12!\n" +
+	"\forder_number\x18\b \x01(\tR\vorderNumber\x12\x14\n" +
+	"\x05color\x18\t \x01(\tR\x05color\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"order_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\torderTime\x12E\n" +
+	"\x11added_to_org_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x0eaddedToOrgTime\x12;\n" +
+	"\vupdate_time\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x12\x12\n" +
+	"\x04imei\x18\x0e \x03(\tR\x04imei\x12\x12\n" +
+	"\x04meid\x18\x0f \x03(\tR\x04meid\x12\x10\n" +
+	"\x03eid\x18\x10 \x01(\tR\x03eid\x12(\n" +
+	"\x10wifi_mac_address\x18\x11 \x01(\tR\x0ewifiMacAddress\x122\n" +
+	"\x15bluetooth_mac_address\x18\x12 \x01(\tR\x13bluetoothMacAddress\x120\n" +
+	"\x14ethernet_mac_address\x18\x13 \x03(\tR\x12ethernetMacAddress\x12,\n" +
+	"\x12purchase_source_id\x18\x14 \x01(\tR\x10purchaseSourceId\x120\n" +
+	"\x14purchase_source_type\x18\x15 \x01(\tR\x12purchaseSourceType\"\xec\x01\n" +
+	"\x06Server\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vserver_name\x18\x02 \x01(\tR\n" +
+	"serverName\x12\x1f\n" +
+	"\vserver_type\x18\x03 \x01(\tR\n" +
+	"serverType\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12;\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\"\x90\x02\n" +
+	"\bActivity\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\ractivity_type\x18\x02 \x01(\tR\factivityType\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"sub_status\x18\x04 \x01(\tR\tsubStatus\x12;\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12!\n" +
+	"\fdownload_url\x18\x06 \x01(\tR\vdownloadUrl\x12\x1a\n" +
+	"\bterminal\x18\a \x01(\bR\bterminal\x12\x1c\n" +
+	"\tsucceeded\x18\b \x01(\bR\tsucceeded\"P\n" +
+	"\x12ListDevicesRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"g\n" +
+	"\x13ListDevicesResponse\x12(\n" +
+	"\adevices\x18\x01 \x03(\v2\x0e.axm.v1.DeviceR\adevices\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"/\n" +
+	"\x10GetDeviceRequest\x12\x1b\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\bdeviceId\"\x14\n" +
+	"\x12ListServersRequest\"?\n" +
+	"\x13ListServersResponse\x12(\n" +
+	"\aservers\x18\x01 \x03(\v2\x0e.axm.v1.ServerR\aservers\"R\n" +
+	"\x14AssignDevicesRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x1d\n" +
+	"\n" +
+	"device_ids\x18\x02 \x03(\tR\tdeviceIds\"5\n" +
+	"\x12GetActivityRequest\x12\x1f\n" +
+	"\vactivity_id\x18\x01 \x01(\tR\n" +
+	"activityId\"@\n" +
+	"\x13WatchDevicesRequest\x12)\n" +
+	"\x10interval_seconds\x18\x01 \x01(\x05R\x0fintervalSeconds\"\x9b\x02\n" +
+	"\vDeviceEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1b\n" +
+	"\tdevice_id\x18\x03 \x01(\tR\bdeviceId\x12&\n" +
+	"\x06device\x18\x04 \x01(\v2\x0e.axm.v1.DeviceR\x06device\x12\x1b\n" +
+	"\tserver_id\x18\x05 \x01(\tR\bserverId\x12\x1f\n" +
+	"\vserver_name\x18\x06 \x01(\tR\n" +
+	"serverName\x12&\n" +
+	"\x06server\x18\a \x01(\v2\x0e.axm.v1.ServerR\x06server\x12=\n" +
+	"\fobserve_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vobserveTime2\xd8\x03\n" +
+	"\n" +
+	"AXMService\x12F\n" +
+	"\vListDevices\x12\x1a.axm.v1.ListDevicesRequest\x1a\x1b.axm.v1.ListDevicesResponse\x125\n" +
+	"\tGetDevice\x12\x18.axm.v1.GetDeviceRequest\x1a\x0e.axm.v1.Device\x12F\n" +
+	"\vListServers\x12\x1a.axm.v1.ListServersRequest\x1a\x1b.axm.v1.ListServersResponse\x12?\n" +
+	"\rAssignDevices\x12\x1c.axm.v1.AssignDevicesRequest\x1a\x10.axm.v1.Activity\x12A\n" +
+	"\x0fUnassignDevices\x12\x1c.axm.v1.AssignDevicesRequest\x1a\x10.axm.v1.Activity\x12;\n" +
+	"\vGetActivity\x12\x1a.axm.v1.GetActivityRequest\x1a\x10.axm.v1.Activity\x12B\n" +
+	"\fWatchDevices\x12\x1b.axm.v1.WatchDevicesRequest\x1a\x13.axm.v1.DeviceEvent0\x01BEZCgithub.com/deploymenttheory/go-api-sdk-apple/axm/server/axmv1;axmv1b\x06proto3"
+
+var (
+	file_axm_v1_axm_proto_rawDescOnce sync.Once
+	file_axm_v1_axm_proto_rawDescData []byte
+)
+
+func file_axm_v1_axm_proto_rawDescGZIP() []byte {
+	file_axm_v1_axm_proto_rawDescOnce.Do(func() {
+		file_axm_v1_axm_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_axm_v1_axm_proto_rawDesc), len(file_axm_v1_axm_proto_rawDesc)))
+	})
+	return file_axm_v1_axm_proto_rawDescData
+}
+
+var file_axm_v1_axm_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_axm_v1_axm_proto_goTypes = []any{
+	(*Device)(nil),                // 0: axm.v1.Device
+	(*Server)(nil),                // 1: axm.v1.Server
+	(*Activity)(nil),              // 2: axm.v1.Activity
+	(*ListDevicesRequest)(nil),    // 3: axm.v1.ListDevicesRequest
+	(*ListDevicesResponse)(nil),   // 4: axm.v1.ListDevicesResponse
+	(*GetDeviceRequest)(nil),      // 5: axm.v1.GetDeviceRequest
+	(*ListServersRequest)(nil),    // 6: axm.v1.ListServersRequest
+	(*ListServersResponse)(nil),   // 7: axm.v1.ListServersResponse
+	(*AssignDevicesRequest)(nil),  // 8: axm.v1.AssignDevicesRequest
+	(*GetActivityRequest)(nil),    // 9: axm.v1.GetActivityRequest
+	(*WatchDevicesRequest)(nil),   // 10: axm.v1.WatchDevicesRequest
+	(*DeviceEvent)(nil),           // 11: axm.v1.DeviceEvent
+	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
+}
+var file_axm_v1_axm_proto_depIdxs = []int32{
+	12, // 0: axm.v1.Device.order_time:type_name -> google.protobuf.Timestamp
+	12, // 1: axm.v1.Device.added_to_org_time:type_name -> google.protobuf.Timestamp
+	12, // 2: axm.v1.Device.update_time:type_name -> google.protobuf.Timestamp
+	12, // 3: axm.v1.Server.create_time:type_name -> google.protobuf.Timestamp
+	12, // 4: axm.v1.Server.update_time:type_name -> google.protobuf.Timestamp
+	12, // 5: axm.v1.Activity.create_time:type_name -> google.protobuf.Timestamp
+	0,  // 6: axm.v1.ListDevicesResponse.devices:type_name -> axm.v1.Device
+	1,  // 7: axm.v1.ListServersResponse.servers:type_name -> axm.v1.Server
+	0,  // 8: axm.v1.DeviceEvent.device:type_name -> axm.v1.Device
+	1,  // 9: axm.v1.DeviceEvent.server:type_name -> axm.v1.Server
+	12, // 10: axm.v1.DeviceEvent.observe_time:type_name -> google.protobuf.Timestamp
+	3,  // 11: axm.v1.AXMService.ListDevices:input_type -> axm.v1.ListDevicesRequest
+	5,  // 12: axm.v1.AXMService.GetDevice:input_type -> axm.v1.GetDeviceRequest
+	6,  // 13: axm.v1.AXMService.ListServers:input_type -> axm.v1.ListServersRequest
+	8,  // 14: axm.v1.AXMService.AssignDevices:input_type -> axm.v1.AssignDevicesRequest
+	8,  // 15: axm.v1.AXMService.UnassignDevices:input_type -> axm.v1.AssignDevicesRequest
+	9,  // 16: axm.v1.AXMService.GetActivity:input_type -> axm.v1.GetActivityRequest
+	10, // 17: axm.v1.AXMService.WatchDevices:input_type -> axm.v1.WatchDevicesRequest
+	4,  // 18: axm.v1.AXMService.ListDevices:output_type -> axm.v1.ListDevicesResponse
+	0,  // 19: axm.v1.AXMService.GetDevice:output_type -> axm.v1.Device
+	7,  // 20: axm.v1.AXMService.ListServers:output_type -> axm.v1.ListServersResponse
+	2,  // 21: axm.v1.AXMService.AssignDevices:output_type -> axm.v1.Activity
+	2,  // 22: axm.v1.AXMService.UnassignDevices:output_type -> axm.v1.Activity
+	2,  // 23: axm.v1.AXMService.GetActivity:output_type -> axm.v1.Activity
+	11, // 24: axm.v1.AXMService.WatchDevices:output_type -> axm.v1.DeviceEvent
+	18, // [18:25] is the sub-list for method output_type
+	11, // [11:18] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_axm_v1_axm_proto_init() }
+func file_axm_v1_axm_proto_init() {
+	if File_axm_v1_axm_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_axm_v1_axm_proto_rawDesc), len(file_axm_v1_axm_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_axm_v1_axm_proto_goTypes,
+		DependencyIndexes: file_axm_v1_axm_proto_depIdxs,
+		MessageInfos:      file_axm_v1_axm_proto_msgTypes,
+	}.Build()
+	File_axm_v1_axm_proto = out.File
+	file_axm_v1_axm_proto_goTypes = nil
+	file_axm_v1_axm_proto_depIdxs = nil
+}
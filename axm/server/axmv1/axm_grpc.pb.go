@@ -0,0 +1,381 @@
+// Protobuf definitions for the gRPC facade in package
+// github.com/deploymenttheory/go-api-sdk-apple/axm/server. Regenerate the Go
+// code in axm/server/axmv1 after editing; see axm/server/doc.go.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: axm/v1/axm.proto
+
+package axmv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AXMService_ListDevices_FullMethodName     = "/axm.v1.AXMService/ListDevices"
+	AXMService_GetDevice_FullMethodName       = "/axm.v1.AXMService/GetDevice"
+	AXMService_ListServers_FullMethodName     = "/axm.v1.AXMService/ListServers"
+	AXMService_AssignDevices_FullMethodName   = "/axm.v1.AXMService/AssignDevices"
+	AXMService_UnassignDevices_FullMethodName = "/axm.v1.AXMService/UnassignDevices"
+	AXMService_GetActivity_FullMethodName     = "/axm.v1.AXMService/GetActivity"
+	AXMService_WatchDevices_FullMethodName    = "/axm.v1.AXMService/WatchDevices"
+)
+
+// AXMServiceClient is the client API for AXMService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AXMService exposes the main Apple Business Manager operations of the SDK.
+type AXMServiceClient interface {
+	// ListDevices returns one page of the organization's devices.
+	ListDevices(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption) (*ListDevicesResponse, error)
+	// GetDevice returns one device.
+	GetDevice(ctx context.Context, in *GetDeviceRequest, opts ...grpc.CallOption) (*Device, error)
+	// ListServers returns every device management service.
+	ListServers(ctx context.Context, in *ListServersRequest, opts ...grpc.CallOption) (*ListServersResponse, error)
+	// AssignDevices assigns devices to a device management service and returns
+	// the activity Apple created for the request.
+	AssignDevices(ctx context.Context, in *AssignDevicesRequest, opts ...grpc.CallOption) (*Activity, error)
+	// UnassignDevices unassigns devices from a device management service.
+	UnassignDevices(ctx context.Context, in *AssignDevicesRequest, opts ...grpc.CallOption) (*Activity, error)
+	// GetActivity returns the status of an assign or unassign activity.
+	GetActivity(ctx context.Context, in *GetActivityRequest, opts ...grpc.CallOption) (*Activity, error)
+	// WatchDevices polls the inventory and streams a DeviceEvent for every
+	// device and server that is new or changed, and for every device that
+	// leaves the organization. The first poll reports the whole inventory.
+	WatchDevices(ctx context.Context, in *WatchDevicesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DeviceEvent], error)
+}
+
+type aXMServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAXMServiceClient(cc grpc.ClientConnInterface) AXMServiceClient {
+	return &aXMServiceClient{cc}
+}
+
+func (c *aXMServiceClient) ListDevices(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption) (*ListDevicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDevicesResponse)
+	err := c.cc.Invoke(ctx, AXMService_ListDevices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aXMServiceClient) GetDevice(ctx context.Context, in *GetDeviceRequest, opts ...grpc.CallOption) (*Device, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Device)
+	err := c.cc.Invoke(ctx, AXMService_GetDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aXMServiceClient) ListServers(ctx context.Context, in *ListServersRequest, opts ...grpc.CallOption) (*ListServersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListServersResponse)
+	err := c.cc.Invoke(ctx, AXMService_ListServers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aXMServiceClient) AssignDevices(ctx context.Context, in *AssignDevicesRequest, opts ...grpc.CallOption) (*Activity, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Activity)
+	err := c.cc.Invoke(ctx, AXMService_AssignDevices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aXMServiceClient) UnassignDevices(ctx context.Context, in *AssignDevicesRequest, opts ...grpc.CallOption) (*Activity, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Activity)
+	err := c.cc.Invoke(ctx, AXMService_UnassignDevices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aXMServiceClient) GetActivity(ctx context.Context, in *GetActivityRequest, opts ...grpc.CallOption) (*Activity, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Activity)
+	err := c.cc.Invoke(ctx, AXMService_GetActivity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aXMServiceClient) WatchDevices(ctx context.Context, in *WatchDevicesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DeviceEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AXMService_ServiceDesc.Streams[0], AXMService_WatchDevices_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchDevicesRequest, DeviceEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AXMService_WatchDevicesClient = grpc.ServerStreamingClient[DeviceEvent]
+
+// AXMServiceServer is the server API for AXMService service.
+// All implementations must embed UnimplementedAXMServiceServer
+// for forward compatibility.
+//
+// AXMService exposes the main Apple Business Manager operations of the SDK.
+type AXMServiceServer interface {
+	// ListDevices returns one page of the organization's devices.
+	ListDevices(context.Context, *ListDevicesRequest) (*ListDevicesResponse, error)
+	// GetDevice returns one device.
+	GetDevice(context.Context, *GetDeviceRequest) (*Device, error)
+	// ListServers returns every device management service.
+	ListServers(context.Context, *ListServersRequest) (*ListServersResponse, error)
+	// AssignDevices assigns devices to a device management service and returns
+	// the activity Apple created for the request.
+	AssignDevices(context.Context, *AssignDevicesRequest) (*Activity, error)
+	// UnassignDevices unassigns devices from a device management service.
+	UnassignDevices(context.Context, *AssignDevicesRequest) (*Activity, error)
+	// GetActivity returns the status of an assign or unassign activity.
+	GetActivity(context.Context, *GetActivityRequest) (*Activity, error)
+	// WatchDevices polls the inventory and streams a DeviceEvent for every
+	// device and server that is new or changed, and for every device that
+	// leaves the organization. The first poll reports the whole inventory.
+	WatchDevices(*WatchDevicesRequest, grpc.ServerStreamingServer[DeviceEvent]) error
+	mustEmbedUnimplementedAXMServiceServer()
+}
+
+// UnimplementedAXMServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAXMServiceServer struct{}
+
+func (UnimplementedAXMServiceServer) ListDevices(context.Context, *ListDevicesRequest) (*ListDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDevices not implemented")
+}
+func (UnimplementedAXMServiceServer) GetDevice(context.Context, *GetDeviceRequest) (*Device, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDevice not implemented")
+}
+func (UnimplementedAXMServiceServer) ListServers(context.Context, *ListServersRequest) (*ListServersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServers not implemented")
+}
+func (UnimplementedAXMServiceServer) AssignDevices(context.Context, *AssignDevicesRequest) (*Activity, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignDevices not implemented")
+}
+func (UnimplementedAXMServiceServer) UnassignDevices(context.Context, *AssignDevicesRequest) (*Activity, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnassignDevices not implemented")
+}
+func (UnimplementedAXMServiceServer) GetActivity(context.Context, *GetActivityRequest) (*Activity, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActivity not implemented")
+}
+func (UnimplementedAXMServiceServer) WatchDevices(*WatchDevicesRequest, grpc.ServerStreamingServer[DeviceEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchDevices not implemented")
+}
+func (UnimplementedAXMServiceServer) mustEmbedUnimplementedAXMServiceServer() {}
+func (UnimplementedAXMServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeAXMServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AXMServiceServer will
+// result in compilation errors.
+type UnsafeAXMServiceServer interface {
+	mustEmbedUnimplementedAXMServiceServer()
+}
+
+func RegisterAXMServiceServer(s grpc.ServiceRegistrar, srv AXMServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAXMServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AXMService_ServiceDesc, srv)
+}
+
+func _AXMService_ListDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AXMServiceServer).ListDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AXMService_ListDevices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AXMServiceServer).ListDevices(ctx, req.(*ListDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AXMService_GetDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AXMServiceServer).GetDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AXMService_GetDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AXMServiceServer).GetDevice(ctx, req.(*GetDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AXMService_ListServers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AXMServiceServer).ListServers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AXMService_ListServers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AXMServiceServer).ListServers(ctx, req.(*ListServersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AXMService_AssignDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AXMServiceServer).AssignDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AXMService_AssignDevices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AXMServiceServer).AssignDevices(ctx, req.(*AssignDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AXMService_UnassignDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AXMServiceServer).UnassignDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AXMService_UnassignDevices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AXMServiceServer).UnassignDevices(ctx, req.(*AssignDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AXMService_GetActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AXMServiceServer).GetActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AXMService_GetActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AXMServiceServer).GetActivity(ctx, req.(*GetActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AXMService_WatchDevices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchDevicesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AXMServiceServer).WatchDevices(m, &grpc.GenericServerStream[WatchDevicesRequest, DeviceEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AXMService_WatchDevicesServer = grpc.ServerStreamingServer[DeviceEvent]
+
+// AXMService_ServiceDesc is the grpc.ServiceDesc for AXMService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AXMService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "axm.v1.AXMService",
+	HandlerType: (*AXMServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListDevices",
+			Handler:    _AXMService_ListDevices_Handler,
+		},
+		{
+			MethodName: "GetDevice",
+			Handler:    _AXMService_GetDevice_Handler,
+		},
+		{
+			MethodName: "ListServers",
+			Handler:    _AXMService_ListServers_Handler,
+		},
+		{
+			MethodName: "AssignDevices",
+			Handler:    _AXMService_AssignDevices_Handler,
+		},
+		{
+			MethodName: "UnassignDevices",
+			Handler:    _AXMService_UnassignDevices_Handler,
+		},
+		{
+			MethodName: "GetActivity",
+			Handler:    _AXMService_GetActivity_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchDevices",
+			Handler:       _AXMService_WatchDevices_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "axm/v1/axm.proto",
+}
@@ -0,0 +1,71 @@
+package server
+
+import (
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devicemanagement"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devices"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/server/axmv1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func deviceToProto(d devices.OrgDevice) *axmv1.Device {
+	out := &axmv1.Device{Id: d.ID}
+	a := d.Attributes
+	if a == nil {
+		return out
+	}
+	out.SerialNumber = a.SerialNumber
+	out.DeviceModel = a.DeviceModel
+	out.ProductFamily = a.ProductFamily
+	out.ProductType = a.ProductType
+	out.DeviceCapacity = a.DeviceCapacity
+	out.PartNumber = a.PartNumber
+	out.OrderNumber = a.OrderNumber
+	out.Color = a.Color
+	out.Status = a.Status
+	out.OrderTime = timestampToProto(a.OrderDateTime)
+	out.AddedToOrgTime = timestampToProto(a.AddedToOrgDateTime)
+	out.UpdateTime = timestampToProto(a.UpdatedDateTime)
+	out.Imei = a.IMEI
+	out.Meid = a.MEID
+	out.Eid = a.EID
+	out.WifiMacAddress = a.WiFiMACAddress
+	out.BluetoothMacAddress = a.BluetoothMACAddress
+	out.EthernetMacAddress = a.EthernetMACAddress
+	out.PurchaseSourceId = a.PurchaseSourceId
+	out.PurchaseSourceType = a.PurchaseSourceType
+	return out
+}
+
+func serverToProto(s devicemanagement.MDMServer) *axmv1.Server {
+	out := &axmv1.Server{Id: s.ID}
+	if a := s.Attributes; a != nil {
+		out.ServerName = a.ServerName
+		out.ServerType = a.ServerType
+		out.Status = a.Status
+		out.CreateTime = timestampToProto(a.CreatedDateTime)
+		out.UpdateTime = timestampToProto(a.UpdatedDateTime)
+	}
+	return out
+}
+
+func activityToProto(a devicemanagement.OrgDeviceActivity) *axmv1.Activity {
+	out := &axmv1.Activity{Id: a.ID, Terminal: a.Terminal(), Succeeded: a.Succeeded()}
+	if attrs := a.Attributes; attrs != nil {
+		out.ActivityType = attrs.ActivityType
+		out.Status = string(attrs.Status)
+		out.SubStatus = string(attrs.SubStatus)
+		out.CreateTime = timestampToProto(attrs.CreatedDateTime)
+		out.DownloadUrl = attrs.DownloadURL
+	}
+	return out
+}
+
+// timestampToProto converts an optional API timestamp, leaving nil unset.
+func timestampToProto(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
@@ -0,0 +1,18 @@
+// Package server exposes the main operations of the axm SDK over gRPC, so
+// services written in other languages can use Apple Business Manager through
+// a sidecar. The service is defined in proto/axm/v1/axm.proto; generate a
+// client for your language from that file. The generated Go code is in
+// package axmv1, and axm/cmd/axmgrpc runs the service as a standalone binary.
+//
+// Register a Server with a grpc.Server:
+//
+//	srv := grpc.NewServer()
+//	axmv1.RegisterAXMServiceServer(srv, server.New(c, server.Options{}))
+//
+// The server adds no authentication of its own: listen on localhost or a
+// private network, or configure TLS and credentials on the grpc.Server.
+package server
+
+// The generated code is checked in. To regenerate it, install protoc,
+// protoc-gen-go and protoc-gen-go-grpc, and run go generate in this directory.
+//go:generate protoc -I proto --go_out=. --go_opt=module=github.com/deploymenttheory/go-api-sdk-apple/axm/server --go-grpc_out=. --go-grpc_opt=module=github.com/deploymenttheory/go-api-sdk-apple/axm/server axm/v1/axm.proto
@@ -0,0 +1,231 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devicemanagement"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devices"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/inventorysync"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/notify"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/reports"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/server/axmv1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"resty.dev/v3"
+)
+
+const (
+	// DefaultWatchInterval is the WatchDevices poll interval when neither the
+	// request nor Options.WatchInterval sets one.
+	DefaultWatchInterval = inventorysync.DefaultInterval
+
+	// DefaultMinWatchInterval is the shortest WatchDevices poll interval
+	// allowed when Options.MinWatchInterval is not set.
+	DefaultMinWatchInterval = time.Minute
+)
+
+// Options configures a Server.
+type Options struct {
+	// WatchInterval is the WatchDevices poll interval used when a request
+	// does not set one. Defaults to DefaultWatchInterval.
+	WatchInterval time.Duration
+
+	// MinWatchInterval is the shortest poll interval a WatchDevices request
+	// may ask for. Defaults to DefaultMinWatchInterval.
+	MinWatchInterval time.Duration
+
+	// OnWatchError, if set, is called when a WatchDevices poll fails. The
+	// stream stays open and the poll is retried at the next interval.
+	OnWatchError func(err error)
+}
+
+// Server implements axmv1.AXMServiceServer on top of an axm.Client.
+type Server struct {
+	axmv1.UnimplementedAXMServiceServer
+
+	client *axm.Client
+	opts   Options
+}
+
+// New returns a Server that calls Apple through c.
+func New(c *axm.Client, opts Options) *Server {
+	if opts.WatchInterval <= 0 {
+		opts.WatchInterval = DefaultWatchInterval
+	}
+	if opts.MinWatchInterval <= 0 {
+		opts.MinWatchInterval = DefaultMinWatchInterval
+	}
+	return &Server{client: c, opts: opts}
+}
+
+// ListDevices returns one page of the organization's devices.
+func (s *Server) ListDevices(ctx context.Context, req *axmv1.ListDevicesRequest) (*axmv1.ListDevicesResponse, error) {
+	if req.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+	page, _, err := s.client.AXMAPI.Devices.GetPageV1(ctx, req.GetPageToken(), &devices.RequestQueryOptions{Limit: int(req.GetPageSize())})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &axmv1.ListDevicesResponse{NextPageToken: page.NextCursor()}
+	for _, d := range page.Data {
+		resp.Devices = append(resp.Devices, deviceToProto(d))
+	}
+	return resp, nil
+}
+
+// GetDevice returns one device.
+func (s *Server) GetDevice(ctx context.Context, req *axmv1.GetDeviceRequest) (*axmv1.Device, error) {
+	if req.GetDeviceId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "device_id is required")
+	}
+	result, _, err := s.client.AXMAPI.Devices.GetByDeviceIDV1(ctx, req.GetDeviceId(), nil)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return deviceToProto(result.Data), nil
+}
+
+// ListServers returns every device management service.
+func (s *Server) ListServers(ctx context.Context, _ *axmv1.ListServersRequest) (*axmv1.ListServersResponse, error) {
+	list, _, err := s.client.AXMAPI.DeviceManagement.GetV1(ctx, nil)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &axmv1.ListServersResponse{}
+	for _, server := range list.Data {
+		resp.Servers = append(resp.Servers, serverToProto(server))
+	}
+	return resp, nil
+}
+
+// AssignDevices assigns devices to a device management service.
+func (s *Server) AssignDevices(ctx context.Context, req *axmv1.AssignDevicesRequest) (*axmv1.Activity, error) {
+	return s.submit(ctx, req, s.client.AXMAPI.DeviceManagement.AssignDevicesV1)
+}
+
+// UnassignDevices unassigns devices from a device management service.
+func (s *Server) UnassignDevices(ctx context.Context, req *axmv1.AssignDevicesRequest) (*axmv1.Activity, error) {
+	return s.submit(ctx, req, s.client.AXMAPI.DeviceManagement.UnassignDevicesV1)
+}
+
+type submitFunc func(ctx context.Context, mdmServerID string, deviceIDs []string) (*devicemanagement.ResponseOrgDeviceActivity, *resty.Response, error)
+
+func (s *Server) submit(ctx context.Context, req *axmv1.AssignDevicesRequest, fn submitFunc) (*axmv1.Activity, error) {
+	if req.GetServerId() == "" || len(req.GetDeviceIds()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "server_id and device_ids are required")
+	}
+	result, _, err := fn(ctx, req.GetServerId(), req.GetDeviceIds())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return activityToProto(result.Data), nil
+}
+
+// GetActivity returns the status of an assign or unassign activity.
+func (s *Server) GetActivity(ctx context.Context, req *axmv1.GetActivityRequest) (*axmv1.Activity, error) {
+	if req.GetActivityId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "activity_id is required")
+	}
+	result, _, err := s.client.AXMAPI.DeviceManagement.GetActivityByIDV1(ctx, req.GetActivityId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return activityToProto(result.Data), nil
+}
+
+// WatchDevices streams inventory changes until the client cancels the call or
+// the axm.Client is closed. Each call runs its own inventorysync.Engine, so
+// the first poll reports every device and server.
+func (s *Server) WatchDevices(req *axmv1.WatchDevicesRequest, stream axmv1.AXMService_WatchDevicesServer) error {
+	interval := s.opts.WatchInterval
+	if req.GetIntervalSeconds() > 0 {
+		interval = max(time.Duration(req.GetIntervalSeconds())*time.Second, s.opts.MinWatchInterval)
+	}
+	engine, err := inventorysync.NewEngine(s.client, &streamSink{stream: stream}, inventorysync.Options{
+		Interval: interval,
+		OnError:  s.opts.OnWatchError,
+	})
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return engine.Run(stream.Context())
+}
+
+// streamSink sends inventory changes on a WatchDevices stream.
+type streamSink struct {
+	stream axmv1.AXMService_WatchDevicesServer
+}
+
+func (s *streamSink) UpsertDevice(_ context.Context, d reports.DeviceWithServer) error {
+	ev := newEvent(notify.EventDeviceUpserted)
+	ev.DeviceId, ev.Device = d.ID, deviceToProto(d.OrgDevice)
+	ev.ServerId, ev.ServerName = d.ServerID, d.ServerName
+	return s.stream.Send(ev)
+}
+
+func (s *streamSink) UpsertServer(_ context.Context, server devicemanagement.MDMServer) error {
+	ev := newEvent(notify.EventServerUpserted)
+	ev.ServerId, ev.Server = server.ID, serverToProto(server)
+	if server.Attributes != nil {
+		ev.ServerName = server.Attributes.ServerName
+	}
+	return s.stream.Send(ev)
+}
+
+func (s *streamSink) DeleteDevice(_ context.Context, deviceID string) error {
+	ev := newEvent(notify.EventDeviceDeleted)
+	ev.DeviceId = deviceID
+	return s.stream.Send(ev)
+}
+
+func (s *streamSink) Flush(context.Context) error { return nil }
+
+// newEvent returns an event with a random ID, using the notify event types so
+// consumers can share handling with webhook and queue events.
+func newEvent(eventType string) *axmv1.DeviceEvent {
+	var id [16]byte
+	rand.Read(id[:])
+	return &axmv1.DeviceEvent{Id: hex.EncodeToString(id[:]), Type: eventType, ObserveTime: timestamppb.Now()}
+}
+
+// toStatus converts an SDK error to a gRPC status, mapping the HTTP status of
+// an API error to the closest gRPC code.
+func toStatus(err error) error {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+	if errors.Is(err, client.ErrClientClosed) {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) {
+		return status.Error(codes.Unknown, err.Error())
+	}
+	code := codes.Unknown
+	switch httpStatus, _ := strconv.Atoi(apiErr.Status); {
+	case httpStatus == 400 || httpStatus == 422:
+		code = codes.InvalidArgument
+	case httpStatus == 401:
+		code = codes.Unauthenticated
+	case httpStatus == 403:
+		code = codes.PermissionDenied
+	case httpStatus == 404:
+		code = codes.NotFound
+	case httpStatus == 409:
+		code = codes.FailedPrecondition
+	case httpStatus == 429:
+		code = codes.ResourceExhausted
+	case httpStatus >= 500:
+		code = codes.Unavailable
+	}
+	return status.Error(code, err.Error())
+}
@@ -0,0 +1,167 @@
+package server
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/axm"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/axm_api/devicemanagement"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/notify"
+	"github.com/deploymenttheory/go-api-sdk-apple/axm/server/axmv1"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"resty.dev/v3"
+)
+
+type noAuth struct{}
+
+func (noAuth) ApplyAuth(*resty.Request) error { return nil }
+
+func jsonResponder(status int, body string) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		resp := httpmock.NewStringResponse(status, body)
+		resp.Header.Set("Content-Type", "application/json")
+		return resp, nil
+	}
+}
+
+// setupServer serves a Server backed by mt over an in-memory listener and
+// returns a client for it.
+func setupServer(t *testing.T, mt *httpmock.MockTransport, opts Options) axmv1.AXMServiceClient {
+	t.Helper()
+	c, err := axm.NewClient("key-id", "issuer-id", "unused",
+		client.WithAuth(noAuth{}),
+		axm.WithTransport(mt),
+		axm.WithRetryCount(0),
+	)
+	require.NoError(t, err)
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	axmv1.RegisterAXMServiceServer(srv, New(c, opts))
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return axmv1.NewAXMServiceClient(conn)
+}
+
+func TestListAndGetDevices(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDevices", jsonResponder(200,
+		`{"data":[{"type":"orgDevices","id":"D1","attributes":{"serialNumber":"C02X","addedToOrgDateTime":"2024-05-01T10:00:00Z"}}],
+		 "links":{"next":"https://api-business.apple.com/v1/orgDevices?cursor=abc"}}`))
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDevices/D1", jsonResponder(200,
+		`{"data":{"type":"orgDevices","id":"D1","attributes":{"serialNumber":"C02X","status":"ASSIGNED"}}}`))
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDevices/MISSING", jsonResponder(404,
+		`{"errors":[{"status":"404","code":"NOT_FOUND","title":"Not found"}]}`))
+	api := setupServer(t, mt, Options{})
+	ctx := context.Background()
+
+	list, err := api.ListDevices(ctx, &axmv1.ListDevicesRequest{PageSize: 1})
+	require.NoError(t, err)
+	require.Len(t, list.Devices, 1)
+	assert.Equal(t, "C02X", list.Devices[0].SerialNumber)
+	assert.Equal(t, time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC), list.Devices[0].AddedToOrgTime.AsTime())
+	assert.Nil(t, list.Devices[0].OrderTime)
+	assert.Equal(t, "abc", list.NextPageToken)
+
+	device, err := api.GetDevice(ctx, &axmv1.GetDeviceRequest{DeviceId: "D1"})
+	require.NoError(t, err)
+	assert.Equal(t, "ASSIGNED", device.Status)
+
+	_, err = api.GetDevice(ctx, &axmv1.GetDeviceRequest{DeviceId: "MISSING"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = api.GetDevice(ctx, &axmv1.GetDeviceRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestAssignDevices(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	mt.RegisterResponder("POST", "https://api-business.apple.com/v1/orgDeviceActivities", jsonResponder(201,
+		`{"data":{"type":"orgDeviceActivities","id":"ACT1","attributes":{"activityType":"ASSIGN_DEVICES","status":"IN_PROGRESS","subStatus":"SUBMITTED"}}}`))
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDeviceActivities/ACT1", jsonResponder(200,
+		`{"data":{"type":"orgDeviceActivities","id":"ACT1","attributes":{"status":"COMPLETED","subStatus":"`+
+			string(devicemanagement.ActivitySubStatusCompletedWithSuccess)+`"}}}`))
+	api := setupServer(t, mt, Options{})
+	ctx := context.Background()
+
+	activity, err := api.AssignDevices(ctx, &axmv1.AssignDevicesRequest{ServerId: "SRV1", DeviceIds: []string{"D1"}})
+	require.NoError(t, err)
+	assert.Equal(t, "ACT1", activity.Id)
+	assert.Equal(t, "IN_PROGRESS", activity.Status)
+	assert.False(t, activity.Terminal)
+
+	activity, err = api.GetActivity(ctx, &axmv1.GetActivityRequest{ActivityId: "ACT1"})
+	require.NoError(t, err)
+	assert.True(t, activity.Terminal)
+	assert.True(t, activity.Succeeded)
+	assert.Equal(t, string(devicemanagement.ActivitySubStatusCompletedWithSuccess), activity.SubStatus)
+
+	_, err = api.UnassignDevices(ctx, &axmv1.AssignDevicesRequest{ServerId: "SRV1"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestWatchDevices(t *testing.T) {
+	mt := httpmock.NewMockTransport()
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/mdmServers", jsonResponder(200,
+		`{"data":[{"type":"mdmServers","id":"SRV1","attributes":{"serverName":"Jamf Pro","serverType":"MDM"}}]}`))
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/mdmServers/SRV1/relationships/devices", jsonResponder(200,
+		`{"data":[{"type":"orgDevices","id":"D1"}]}`))
+	mt.RegisterResponder("GET", "https://api-business.apple.com/v1/orgDevices", jsonResponder(200,
+		`{"data":[{"type":"orgDevices","id":"D1","attributes":{"serialNumber":"C02X"}}]}`))
+	api := setupServer(t, mt, Options{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := api.WatchDevices(ctx, &axmv1.WatchDevicesRequest{IntervalSeconds: 1})
+	require.NoError(t, err)
+
+	ev, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, notify.EventServerUpserted, ev.Type)
+	assert.Equal(t, "Jamf Pro", ev.ServerName)
+	assert.NotEmpty(t, ev.Id)
+
+	ev, err = stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, notify.EventDeviceUpserted, ev.Type)
+	assert.Equal(t, "D1", ev.DeviceId)
+	assert.Equal(t, "SRV1", ev.ServerId)
+	assert.Equal(t, "C02X", ev.Device.SerialNumber)
+
+	cancel()
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Canceled, status.Code(err))
+}
+
+func TestToStatus(t *testing.T) {
+	for httpStatus, want := range map[string]codes.Code{
+		"400": codes.InvalidArgument,
+		"401": codes.Unauthenticated,
+		"403": codes.PermissionDenied,
+		"409": codes.FailedPrecondition,
+		"429": codes.ResourceExhausted,
+		"503": codes.Unavailable,
+	} {
+		assert.Equal(t, want, status.Code(toStatus(&client.APIError{Status: httpStatus})), httpStatus)
+	}
+	assert.Equal(t, codes.Unavailable, status.Code(toStatus(client.ErrClientClosed)))
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(toStatus(context.DeadlineExceeded)))
+}
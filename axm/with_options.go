@@ -1,9 +1,12 @@
 package axm
 
 import (
+	"context"
 	"crypto/tls"
 	"errors"
+	"net"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-apple/axm/client"
@@ -14,6 +17,16 @@ import (
 // Pass one or more ClientOption values to NewClient, NewClientFromFile, or NewClientFromEnv.
 type ClientOption = client.ClientOption
 
+// RetryPolicy decides whether a failed request is retried and how long to wait.
+// See client.DefaultRetryPolicy for the built-in implementation.
+type RetryPolicy = client.RetryPolicy
+
+// PageSizeConfig bounds the page sizes WithAdaptivePageSize chooses from.
+type PageSizeConfig = client.PageSizeConfig
+
+// RetryRule declares which HTTP statuses are retryable for a method and path prefix.
+type RetryRule = client.RetryRule
+
 // WithBaseURL sets a custom base URL, overriding the default Apple Business Manager endpoint.
 func WithBaseURL(baseURL string) ClientOption {
 	return client.WithBaseURL(baseURL)
@@ -44,6 +57,21 @@ func WithRetryMaxWaitTime(maxWaitTime time.Duration) ClientOption {
 	return client.WithRetryMaxWaitTime(maxWaitTime)
 }
 
+// WithRetryPolicy installs a custom retry policy in place of the built-in retry conditions.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return client.WithRetryPolicy(policy)
+}
+
+// WithRetryStatusMatrix retries only the statuses declared per method/path by rules.
+func WithRetryStatusMatrix(rules ...RetryRule) ClientOption {
+	return client.WithRetryStatusMatrix(rules...)
+}
+
+// NewDefaultRetryPolicy returns the SDK's default retry policy, suitable for use with WithRetryPolicy.
+func NewDefaultRetryPolicy() *client.DefaultRetryPolicy {
+	return client.NewDefaultRetryPolicy()
+}
+
 // WithUserAgent sets a custom user-agent string.
 func WithUserAgent(userAgent string) ClientOption {
 	return client.WithUserAgent(userAgent)
@@ -59,6 +87,21 @@ func WithDebug() ClientOption {
 	return client.WithDebug()
 }
 
+// WithPagePrefetch fetches the next page of a paginated response while the current page is processed.
+func WithPagePrefetch() ClientOption {
+	return client.WithPagePrefetch()
+}
+
+// WithAdaptivePageSize tunes the limit of paginated requests from observed latency and 429 responses.
+func WithAdaptivePageSize(cfg PageSizeConfig) ClientOption {
+	return client.WithAdaptivePageSize(cfg)
+}
+
+// WithPriorityQueue limits requests in flight and sends interactive requests ahead of background pagination.
+func WithPriorityQueue(maxConcurrent int) ClientOption {
+	return client.WithPriorityQueue(maxConcurrent)
+}
+
 // WithGlobalHeader adds a single header to every outgoing request.
 func WithGlobalHeader(key, value string) ClientOption {
 	return client.WithGlobalHeader(key, value)
@@ -69,11 +112,46 @@ func WithGlobalHeaders(headers map[string]string) ClientOption {
 	return client.WithGlobalHeaders(headers)
 }
 
-// WithProxy sets an HTTP proxy for all requests.
+// WithDialer sets the function that opens network connections.
+func WithDialer(dial client.DialContextFunc) ClientOption {
+	return client.WithDialer(dial)
+}
+
+// WithResolver resolves host names with a custom DNS resolver, for split-horizon DNS.
+func WithResolver(resolver *net.Resolver) ClientOption {
+	return client.WithResolver(resolver)
+}
+
+// WithHostIPs pins a host such as api-business.apple.com to fixed IP addresses, bypassing DNS.
+func WithHostIPs(host string, ips ...string) ClientOption {
+	return client.WithHostIPs(host, ips...)
+}
+
+// WithProxy sets an HTTP proxy for all requests, overriding the proxy environment variables.
 func WithProxy(proxyURL string) ClientOption {
 	return client.WithProxy(proxyURL)
 }
 
+// WithoutProxy connects directly, ignoring the HTTP_PROXY and HTTPS_PROXY environment variables.
+func WithoutProxy() ClientOption {
+	return client.WithoutProxy()
+}
+
+// WithProxyAuth sets Basic credentials for the proxy from WithProxy or the environment.
+func WithProxyAuth(username, password string) ClientOption {
+	return client.WithProxyAuth(username, password)
+}
+
+// WithProxyNTLMAuth authenticates to the proxy from WithProxy or the environment with NTLM.
+func WithProxyNTLMAuth(domain, username, password string) ClientOption {
+	return client.WithProxyNTLMAuth(domain, username, password)
+}
+
+// WithProxyConnectHeader adds headers, such as a Negotiate token, to the CONNECT request sent to the proxy.
+func WithProxyConnectHeader(fn func(ctx context.Context, proxyURL *url.URL, target string) (http.Header, error)) ClientOption {
+	return client.WithProxyConnectHeader(fn)
+}
+
 // WithTLSClientConfig sets custom TLS configuration.
 func WithTLSClientConfig(tlsConfig *tls.Config) ClientOption {
 	return client.WithTLSClientConfig(tlsConfig)
@@ -104,6 +182,31 @@ func WithTransport(transport http.RoundTripper) ClientOption {
 	return client.WithTransport(transport)
 }
 
+// WithMaxIdleConns sets the maximum number of idle connections kept across all hosts.
+func WithMaxIdleConns(maxIdleConns int) ClientOption {
+	return client.WithMaxIdleConns(maxIdleConns)
+}
+
+// WithMaxIdleConnsPerHost sets the maximum number of idle connections kept per host.
+func WithMaxIdleConnsPerHost(maxIdleConnsPerHost int) ClientOption {
+	return client.WithMaxIdleConnsPerHost(maxIdleConnsPerHost)
+}
+
+// WithMaxConnsPerHost limits the total number of connections per host.
+func WithMaxConnsPerHost(maxConnsPerHost int) ClientOption {
+	return client.WithMaxConnsPerHost(maxConnsPerHost)
+}
+
+// WithIdleConnTimeout sets how long an idle connection remains open.
+func WithIdleConnTimeout(timeout time.Duration) ClientOption {
+	return client.WithIdleConnTimeout(timeout)
+}
+
+// WithHTTP2 enables or disables HTTP/2 negotiation (enabled by default).
+func WithHTTP2(enabled bool) ClientOption {
+	return client.WithHTTP2(enabled)
+}
+
 // WithInsecureSkipVerify disables TLS certificate verification (use only for testing).
 func WithInsecureSkipVerify() ClientOption {
 	return client.WithInsecureSkipVerify()
@@ -0,0 +1,220 @@
+package client
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/dep/constants"
+	"resty.dev/v3"
+)
+
+// AuthProvider interface for different authentication methods
+type AuthProvider interface {
+	ApplyAuth(req *resty.Request) error
+}
+
+// SessionFetcher obtains a new session token from the /session endpoint.
+type SessionFetcher func(ctx context.Context, authorization string) (string, error)
+
+// SessionAuth implements DEP session authentication. The server token's OAuth
+// 1.0a credentials sign a request to /session, and the returned session token
+// is sent in the X-ADM-Auth-Session header of every other request until the
+// server rejects or replaces it. It is safe for concurrent use.
+type SessionAuth struct {
+	token      *ServerToken
+	sessionURL string
+	fetch      SessionFetcher
+	session    string
+	mutex      sync.RWMutex
+
+	// now and nonce are replaceable for deterministic signatures in tests.
+	now   func() time.Time
+	nonce func() string
+}
+
+// NewSessionAuth creates a session authentication provider. sessionURL is the
+// absolute URL of the /session endpoint that is signed, and fetch performs the
+// request with the resulting OAuth Authorization header value.
+func NewSessionAuth(token *ServerToken, sessionURL string, fetch SessionFetcher) *SessionAuth {
+	return &SessionAuth{
+		token:      token,
+		sessionURL: sessionURL,
+		fetch:      fetch,
+		now:        time.Now,
+		nonce:      randomNonce,
+	}
+}
+
+// ApplyAuth sets the X-ADM-Auth-Session header, starting a session if needed.
+func (s *SessionAuth) ApplyAuth(req *resty.Request) error {
+	session, err := s.getSession(req.Context())
+	if err != nil {
+		return fmt.Errorf("failed to get auth session: %w", err)
+	}
+
+	req.SetHeader(constants.HeaderAuthSession, session)
+	return nil
+}
+
+// getSession returns the current session token, starting a new session if none exists.
+func (s *SessionAuth) getSession(ctx context.Context) (string, error) {
+	s.mutex.RLock()
+	if s.session != "" {
+		session := s.session
+		s.mutex.RUnlock()
+		return session, nil
+	}
+	s.mutex.RUnlock()
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	// Double-check after acquiring write lock
+	if s.session != "" {
+		return s.session, nil
+	}
+
+	authorization, err := s.OAuthHeader("GET", s.sessionURL)
+	if err != nil {
+		return "", err
+	}
+
+	session, err := s.fetch(ctx, authorization)
+	if err != nil {
+		return "", err
+	}
+	if session == "" {
+		return "", fmt.Errorf("session response did not include auth_session_token")
+	}
+
+	s.session = session
+	return s.session, nil
+}
+
+// UpdateSession replaces the session token, e.g. when the server returns a
+// refreshed X-ADM-Auth-Session header.
+func (s *SessionAuth) UpdateSession(session string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.session = session
+}
+
+// ForceRefresh discards the session so the next request starts a new one.
+func (s *SessionAuth) ForceRefresh() {
+	s.UpdateSession("")
+}
+
+// expire discards session if it is still the current session and reports
+// whether it did. Concurrent requests rejected with the same session then
+// start one new session between them, rather than each discarding the
+// session another has just started.
+func (s *SessionAuth) expire(session string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if session == "" || session != s.session {
+		return false
+	}
+	s.session = ""
+	return true
+}
+
+// OAuthHeader builds the OAuth 1.0a Authorization header (HMAC-SHA1) for a
+// request to rawURL. Apple requires the realm "ADM".
+func (s *SessionAuth) OAuthHeader(method, rawURL string) (string, error) {
+	params := map[string]string{
+		"oauth_consumer_key":     s.token.ConsumerKey,
+		"oauth_token":            s.token.AccessToken,
+		"oauth_signature_method": "HMAC-SHA1",
+		"oauth_timestamp":        strconv.FormatInt(s.now().Unix(), 10),
+		"oauth_nonce":            s.nonce(),
+		"oauth_version":          "1.0",
+	}
+
+	signature, err := oauthSignature(method, rawURL, params, s.token.ConsumerSecret, s.token.AccessSecret)
+	if err != nil {
+		return "", err
+	}
+	params["oauth_signature"] = signature
+
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := []string{fmt.Sprintf(`realm=%q`, constants.OAuthRealm)}
+	for _, k := range keys {
+		parts = append(parts, fmt.Sprintf(`%s="%s"`, k, oauthEscape(params[k])))
+	}
+
+	return "OAuth " + strings.Join(parts, ", "), nil
+}
+
+// oauthSignature computes the HMAC-SHA1 signature over the OAuth signature base string.
+func oauthSignature(method, rawURL string, params map[string]string, consumerSecret, accessSecret string) (string, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse URL: %w", err)
+	}
+
+	all := make(map[string]string, len(params))
+	for k, v := range params {
+		all[k] = v
+	}
+	for k, v := range parsed.Query() {
+		if len(v) > 0 {
+			all[k] = v[0]
+		}
+	}
+
+	keys := make([]string, 0, len(all))
+	for k := range all {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, oauthEscape(k)+"="+oauthEscape(all[k]))
+	}
+
+	baseURL := strings.ToLower(parsed.Scheme) + "://" + strings.ToLower(parsed.Host) + parsed.EscapedPath()
+	baseString := strings.ToUpper(method) + "&" + oauthEscape(baseURL) + "&" + oauthEscape(strings.Join(pairs, "&"))
+
+	mac := hmac.New(sha1.New, []byte(oauthEscape(consumerSecret)+"&"+oauthEscape(accessSecret)))
+	mac.Write([]byte(baseString))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// oauthEscape percent-encodes s per RFC 5849 section 3.6.
+func oauthEscape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '.' || c == '_' || c == '~' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+// randomNonce returns a random hex nonce for OAuth requests.
+func randomNonce() string {
+	buf := make([]byte, 16)
+	_, _ = rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
@@ -0,0 +1,237 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"go.uber.org/zap"
+)
+
+func testServerToken() *ServerToken {
+	return &ServerToken{
+		ConsumerKey:       "CK_123",
+		ConsumerSecret:    "CS_secret",
+		AccessToken:       "AT_456",
+		AccessSecret:      "AS_secret/+=",
+		AccessTokenExpiry: "2027-01-01T00:00:00Z",
+	}
+}
+
+func TestSessionAuth_OAuthHeader(t *testing.T) {
+	auth := NewSessionAuth(testServerToken(), "https://mdmenrollment.apple.com/session", nil)
+	auth.now = func() time.Time { return time.Unix(1700000000, 0) }
+	auth.nonce = func() string { return "nonce123" }
+
+	header, err := auth.OAuthHeader("GET", "https://mdmenrollment.apple.com/session")
+	if err != nil {
+		t.Fatalf("OAuthHeader() error = %v", err)
+	}
+
+	for _, want := range []string{
+		`OAuth realm="ADM"`,
+		`oauth_consumer_key="CK_123"`,
+		`oauth_token="AT_456"`,
+		`oauth_signature_method="HMAC-SHA1"`,
+		`oauth_timestamp="1700000000"`,
+		`oauth_nonce="nonce123"`,
+		`oauth_version="1.0"`,
+		`oauth_signature="%2FHbCqfIcGUZi4m1fZziYuIdY1Ek%3D"`,
+	} {
+		if !strings.Contains(header, want) {
+			t.Errorf("OAuthHeader() = %s, missing %s", header, want)
+		}
+	}
+}
+
+func TestOAuthEscape(t *testing.T) {
+	tests := map[string]string{
+		"abc-._~":   "abc-._~",
+		"a b":       "a%20b",
+		"a+b/c=":    "a%2Bb%2Fc%3D",
+		"ünicode":   "%C3%BCnicode",
+		"100%":      "100%25",
+		"":          "",
+		"A&B=C?D:E": "A%26B%3DC%3FD%3AE",
+	}
+	for in, want := range tests {
+		if got := oauthEscape(in); got != want {
+			t.Errorf("oauthEscape(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+// setupSessionTransport creates a transport using real session auth with httpmock enabled.
+func setupSessionTransport(t *testing.T) *Transport {
+	t.Helper()
+
+	transport, err := NewTransport(testServerToken(), WithLogger(zap.NewNop()), WithRetryCount(0))
+	if err != nil {
+		t.Fatalf("NewTransport() error = %v", err)
+	}
+
+	httpmock.ActivateNonDefault(transport.GetHTTPClient().Client())
+	t.Cleanup(httpmock.DeactivateAndReset)
+
+	return transport
+}
+
+func TestTransport_SessionAuth(t *testing.T) {
+	transport := setupSessionTransport(t)
+
+	sessions := 0
+	httpmock.RegisterResponder("GET", "https://mdmenrollment.apple.com/session",
+		func(req *http.Request) (*http.Response, error) {
+			if !strings.HasPrefix(req.Header.Get("Authorization"), `OAuth realm="ADM"`) {
+				t.Errorf("session request missing OAuth header: %q", req.Header.Get("Authorization"))
+			}
+			if req.Header.Get("X-ADM-Auth-Session") != "" {
+				t.Error("session request should not carry a session header")
+			}
+			sessions++
+			return httpmock.NewStringResponse(200, `{"auth_session_token":"session-1"}`), nil
+		})
+
+	var seen []string
+	httpmock.RegisterResponder("GET", "https://mdmenrollment.apple.com/account",
+		func(req *http.Request) (*http.Response, error) {
+			seen = append(seen, req.Header.Get("X-ADM-Auth-Session"))
+			if req.Header.Get("X-Server-Protocol-Version") != "3" {
+				t.Errorf("X-Server-Protocol-Version = %q", req.Header.Get("X-Server-Protocol-Version"))
+			}
+			resp := httpmock.NewStringResponse(200, `{}`)
+			if len(seen) == 1 {
+				resp.Header.Set("X-ADM-Auth-Session", "session-2")
+			}
+			return resp, nil
+		})
+
+	for range 2 {
+		if _, err := transport.NewRequest(context.Background()).Get("/account"); err != nil {
+			t.Fatalf("request error = %v", err)
+		}
+	}
+
+	if sessions != 1 {
+		t.Errorf("session requests = %d, want 1", sessions)
+	}
+	if len(seen) != 2 || seen[0] != "session-1" || seen[1] != "session-2" {
+		t.Errorf("session headers = %v, want [session-1 session-2]", seen)
+	}
+}
+
+func TestTransport_SessionRefreshOn401(t *testing.T) {
+	transport := setupSessionTransport(t)
+
+	sessions := 0
+	httpmock.RegisterResponder("GET", "https://mdmenrollment.apple.com/session",
+		func(req *http.Request) (*http.Response, error) {
+			sessions++
+			return httpmock.NewStringResponse(200, `{"auth_session_token":"session-`+strconv.Itoa(sessions)+`"}`), nil
+		})
+
+	var seen []string
+	httpmock.RegisterResponder("POST", "https://mdmenrollment.apple.com/server/devices",
+		func(req *http.Request) (*http.Response, error) {
+			session := req.Header.Get("X-ADM-Auth-Session")
+			seen = append(seen, session)
+			if session == "session-1" {
+				return httpmock.NewStringResponse(401, "UNAUTHORIZED"), nil
+			}
+			return httpmock.NewStringResponse(200, `{"devices":[]}`), nil
+		})
+
+	resp, err := transport.NewRequest(context.Background()).SetBody(map[string]int{"limit": 1}).Post("/server/devices")
+	if err != nil {
+		t.Fatalf("request error = %v", err)
+	}
+	if resp.StatusCode() != 200 {
+		t.Errorf("status = %d, want 200", resp.StatusCode())
+	}
+	if sessions != 2 {
+		t.Errorf("session requests = %d, want 2", sessions)
+	}
+	if len(seen) != 2 || seen[1] != "session-2" {
+		t.Errorf("session headers = %v", seen)
+	}
+}
+
+func TestTransport_ConcurrentSessionRefresh(t *testing.T) {
+	transport := setupSessionTransport(t)
+
+	var sessions atomic.Int32
+	httpmock.RegisterResponder("GET", "https://mdmenrollment.apple.com/session",
+		func(req *http.Request) (*http.Response, error) {
+			n := sessions.Add(1)
+			return httpmock.NewStringResponse(200, `{"auth_session_token":"session-`+strconv.Itoa(int(n))+`"}`), nil
+		})
+	httpmock.RegisterResponder("GET", "https://mdmenrollment.apple.com/account",
+		func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get("X-ADM-Auth-Session") == "session-1" {
+				return httpmock.NewStringResponse(401, "UNAUTHORIZED"), nil
+			}
+			return httpmock.NewStringResponse(200, `{}`), nil
+		})
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Go(func() {
+			if _, err := transport.NewRequest(context.Background()).Get("/account"); err != nil {
+				t.Errorf("request error = %v", err)
+			}
+		})
+	}
+	wg.Wait()
+
+	// Every request rejected with session-1 must share session-2 rather than
+	// discard it and start a third.
+	if n := sessions.Load(); n != 2 {
+		t.Errorf("session requests = %d, want 2", n)
+	}
+}
+
+func TestTransport_SessionFailure(t *testing.T) {
+	transport := setupSessionTransport(t)
+
+	httpmock.RegisterResponder("GET", "https://mdmenrollment.apple.com/session",
+		httpmock.NewStringResponder(403, "ACCESS_DENIED"))
+
+	_, err := transport.NewRequest(context.Background()).Get("/account")
+	if err == nil {
+		t.Fatal("expected error when session cannot be established")
+	}
+	if !IsErrorCode(err, "ACCESS_DENIED") {
+		t.Errorf("error = %v, want ACCESS_DENIED", err)
+	}
+}
+
+func TestParseServerToken(t *testing.T) {
+	wrapped := "Content-Type: text/plain;charset=UTF8\nContent-Transfer-Encoding: 7bit\n\n" +
+		"-----BEGIN MESSAGE-----\n" +
+		`{"consumer_key":"CK_123","consumer_secret":"CS_secret","access_token":"AT_456","access_secret":"AS_secret","access_token_expiry":"2027-01-01T00:00:00Z"}` +
+		"\n-----END MESSAGE-----\n"
+
+	token, err := ParseServerToken([]byte(wrapped))
+	if err != nil {
+		t.Fatalf("ParseServerToken() error = %v", err)
+	}
+	if token.ConsumerKey != "CK_123" || token.AccessSecret != "AS_secret" {
+		t.Errorf("unexpected token: %+v", token)
+	}
+	if want := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC); !token.Expiry().Equal(want) {
+		t.Errorf("Expiry() = %v, want %v", token.Expiry(), want)
+	}
+
+	if _, err := ParseServerToken([]byte(`{"consumer_key":"x"}`)); err == nil {
+		t.Error("expected error for incomplete token")
+	}
+	if _, err := ParseServerToken([]byte("garbage")); err == nil {
+		t.Error("expected error for invalid token")
+	}
+}
@@ -0,0 +1,7 @@
+package client
+
+// DefaultUserAgent is the default User-Agent header value for all requests.
+const (
+	DefaultUserAgent = "go-api-sdk-apple/1.0.0"
+	Version          = "1.0.0"
+)
@@ -0,0 +1,97 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+// DEP error codes returned in plain-text response bodies.
+const (
+	ErrorCodeCursorRequired = "CURSOR_REQUIRED"
+	ErrorCodeInvalidCursor  = "INVALID_CURSOR"
+	ErrorCodeExpiredCursor  = "EXPIRED_CURSOR"
+	ErrorCodeUnauthorized   = "UNAUTHORIZED"
+	ErrorCodeForbidden      = "FORBIDDEN"
+	ErrorCodeTermsNotSigned = "T_C_NOT_SIGNED"
+	ErrorCodeTokenRejected  = "TOKEN_REJECTED"
+)
+
+// APIError represents an error from the DEP API. The API reports errors as a
+// bare code such as "EXPIRED_CURSOR" in a text/plain body.
+type APIError struct {
+	StatusCode int
+	Code       string
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	if e.Code != "" {
+		return fmt.Sprintf("DEP API error %d: %s", e.StatusCode, e.Code)
+	}
+	return fmt.Sprintf("DEP API error %d: %s", e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+// IsErrorCode reports whether err wraps an *APIError with the given code.
+func IsErrorCode(err error, code string) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.Code == code
+}
+
+// IsCursorExpired reports whether err indicates an expired or invalid sync
+// cursor, in which case a full fetch must be restarted.
+func IsCursorExpired(err error) bool {
+	return IsErrorCode(err, ErrorCodeExpiredCursor) || IsErrorCode(err, ErrorCodeInvalidCursor)
+}
+
+// ErrorHandler centralizes error handling for all API requests
+type ErrorHandler struct {
+	logger *zap.Logger
+}
+
+// NewErrorHandler creates a new error handler
+func NewErrorHandler(logger *zap.Logger) *ErrorHandler {
+	return &ErrorHandler{
+		logger: logger,
+	}
+}
+
+// HandleError processes DEP API error responses and returns structured errors
+func (eh *ErrorHandler) HandleError(resp *resty.Response) error {
+	statusCode := resp.StatusCode()
+	body := strings.TrimSpace(resp.String())
+
+	apiErr := &APIError{StatusCode: statusCode, Body: body}
+	if isErrorCode(body) {
+		apiErr.Code = body
+	}
+
+	if eh.logger != nil {
+		eh.logger.Error("API request failed",
+			zap.Int("status_code", statusCode),
+			zap.String("code", apiErr.Code),
+			zap.String("url", resp.Request.URL),
+			zap.String("method", resp.Request.Method),
+		)
+	}
+
+	return apiErr
+}
+
+// isErrorCode reports whether body looks like a DEP error code (upper-case
+// letters and underscores only).
+func isErrorCode(body string) bool {
+	if body == "" || len(body) > 64 {
+		return false
+	}
+	for _, c := range body {
+		if (c < 'A' || c > 'Z') && c != '_' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,78 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// ServerToken holds the OAuth 1.0a credentials of an MDM server, as found in
+// the decrypted server token (.p7m) downloaded from Apple Business Manager or
+// Apple School Manager.
+type ServerToken struct {
+	ConsumerKey       string `json:"consumer_key"`
+	ConsumerSecret    string `json:"consumer_secret"`
+	AccessToken       string `json:"access_token"`
+	AccessSecret      string `json:"access_secret"`
+	AccessTokenExpiry string `json:"access_token_expiry"`
+}
+
+// ParseServerToken parses a decrypted server token. The JSON document may be
+// wrapped in the "-----BEGIN MESSAGE-----" envelope produced when the .p7m is
+// decrypted with openssl smime.
+func ParseServerToken(data []byte) (*ServerToken, error) {
+	content := string(data)
+	if start := strings.Index(content, "-----BEGIN MESSAGE-----"); start >= 0 {
+		content = content[start+len("-----BEGIN MESSAGE-----"):]
+		if end := strings.Index(content, "-----END MESSAGE-----"); end >= 0 {
+			content = content[:end]
+		}
+	}
+
+	var token ServerToken
+	if err := json.Unmarshal([]byte(strings.TrimSpace(content)), &token); err != nil {
+		return nil, fmt.Errorf("failed to parse server token: %w", err)
+	}
+
+	if err := token.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// LoadServerTokenFromFile reads and parses a decrypted server token file.
+func LoadServerTokenFromFile(filePath string) (*ServerToken, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read server token file: %w", err)
+	}
+	return ParseServerToken(data)
+}
+
+// Validate checks that all OAuth credentials are present.
+func (t *ServerToken) Validate() error {
+	switch {
+	case t.ConsumerKey == "":
+		return fmt.Errorf("server token is missing consumer_key")
+	case t.ConsumerSecret == "":
+		return fmt.Errorf("server token is missing consumer_secret")
+	case t.AccessToken == "":
+		return fmt.Errorf("server token is missing access_token")
+	case t.AccessSecret == "":
+		return fmt.Errorf("server token is missing access_secret")
+	}
+	return nil
+}
+
+// Expiry returns the access token expiry, or the zero time if it is absent
+// or cannot be parsed. Apple formats it as "2006-01-02T15:04:05Z".
+func (t *ServerToken) Expiry() time.Time {
+	expiry, err := time.Parse(time.RFC3339, t.AccessTokenExpiry)
+	if err != nil {
+		return time.Time{}
+	}
+	return expiry
+}
@@ -0,0 +1,232 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/dep/constants"
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+// Transport represents the Device Enrollment Program (DEP) API transport layer.
+type Transport struct {
+	httpClient   *resty.Client
+	logger       *zap.Logger
+	auth         AuthProvider
+	errorHandler *ErrorHandler
+	baseURL      string
+}
+
+// Ensure Transport implements Client interface.
+var _ Client = (*Transport)(nil)
+
+// sessionResponse is the body returned by the /session endpoint.
+type sessionResponse struct {
+	AuthSessionToken string `json:"auth_session_token"`
+}
+
+// NewTransport creates a new HTTP transport for the DEP API.
+// This is an internal function - users should use dep.NewClient() instead.
+func NewTransport(serverToken *ServerToken, options ...ClientOption) (*Transport, error) {
+	if serverToken == nil {
+		return nil, fmt.Errorf("server token is required")
+	}
+	if err := serverToken.Validate(); err != nil {
+		return nil, err
+	}
+
+	logger := zap.NewNop()
+
+	httpClient := resty.New()
+	httpClient.
+		SetBaseURL(constants.DefaultBaseURL).
+		SetTimeout(30*time.Second).
+		SetRetryCount(3).
+		SetRetryWaitTime(1*time.Second).
+		SetRetryMaxWaitTime(10*time.Second).
+		SetHeader("User-Agent", DefaultUserAgent).
+		SetHeader(constants.HeaderServerProtocolVersion, constants.ServerProtocolVersion)
+
+	errorHandler := NewErrorHandler(logger)
+
+	transport := &Transport{
+		httpClient:   httpClient,
+		logger:       logger,
+		errorHandler: errorHandler,
+		baseURL:      constants.DefaultBaseURL,
+	}
+
+	for _, option := range options {
+		if err := option(transport); err != nil {
+			return nil, fmt.Errorf("failed to apply client option: %w", err)
+		}
+	}
+
+	if transport.auth == nil {
+		transport.auth = NewSessionAuth(serverToken, transport.baseURL+constants.EndpointSession, transport.fetchSession)
+	}
+
+	httpClient.AddRequestMiddleware(func(c *resty.Client, req *resty.Request) error {
+		// The session request itself is signed with OAuth instead.
+		if req.URL != constants.EndpointSession {
+			if err := transport.auth.ApplyAuth(req); err != nil {
+				return fmt.Errorf("auth failed: %w", err)
+			}
+		}
+
+		transport.logger.Info("API request",
+			zap.String("method", req.Method),
+			zap.String("url", req.URL),
+		)
+
+		return nil
+	})
+
+	httpClient.AddResponseMiddleware(func(c *resty.Client, resp *resty.Response) error {
+		transport.logger.Info("API response",
+			zap.String("method", resp.Request.Method),
+			zap.String("url", resp.Request.URL),
+			zap.Int("status_code", resp.StatusCode()),
+			zap.String("status", resp.Status()),
+		)
+
+		if sessionAuth, ok := transport.auth.(*SessionAuth); ok {
+			if session := resp.Header().Get(constants.HeaderAuthSession); session != "" {
+				sessionAuth.UpdateSession(session)
+			} else if resp.StatusCode() == http.StatusUnauthorized && resp.Request.URL != constants.EndpointSession &&
+				sessionAuth.expire(resp.Request.Header.Get(constants.HeaderAuthSession)) {
+				transport.logger.Info("Received 401 response, forcing new auth session")
+			}
+		}
+
+		return nil
+	})
+
+	transport.logger.Info("DEP API client created",
+		zap.String("base_url", transport.baseURL))
+
+	return transport, nil
+}
+
+// fetchSession requests a new session token from /session using an OAuth
+// signed Authorization header.
+func (t *Transport) fetchSession(ctx context.Context, authorization string) (string, error) {
+	resp, err := t.httpClient.R().
+		SetContext(ctx).
+		SetHeader("Authorization", authorization).
+		Get(constants.EndpointSession)
+	if err != nil {
+		return "", fmt.Errorf("session request failed: %w", err)
+	}
+	if resp.IsStatusFailure() {
+		return "", t.errorHandler.HandleError(resp)
+	}
+
+	var session sessionResponse
+	if err := json.Unmarshal(resp.Bytes(), &session); err != nil {
+		return "", fmt.Errorf("failed to parse session response: %w", err)
+	}
+
+	return session.AuthSessionToken, nil
+}
+
+// NewRequest returns a new RequestBuilder for constructing API requests.
+func (t *Transport) NewRequest(ctx context.Context) *RequestBuilder {
+	return &RequestBuilder{
+		req:      t.httpClient.R().SetContext(ctx),
+		executor: t,
+	}
+}
+
+// GetLogger returns the configured logger.
+func (t *Transport) GetLogger() *zap.Logger {
+	return t.logger
+}
+
+// GetHTTPClient returns the underlying HTTP client for testing purposes.
+func (t *Transport) GetHTTPClient() *resty.Client {
+	return t.httpClient
+}
+
+// Close closes the HTTP client and cleans up resources.
+func (t *Transport) Close() error {
+	if t.httpClient != nil {
+		t.httpClient.Close()
+	}
+	return nil
+}
+
+// execute implements requestExecutor — handles all HTTP method routing and error processing.
+// A request rejected with 401 is sent once more on a fresh session, since DEP
+// sessions expire server-side without notice.
+func (t *Transport) execute(req *resty.Request, method, path string, result any) (*resty.Response, error) {
+	resp, err := t.send(req, method, path)
+	if err == nil && resp.StatusCode() == http.StatusUnauthorized {
+		if _, ok := t.auth.(*SessionAuth); ok {
+			retry := t.httpClient.R().SetContext(req.Context()).SetBody(req.Body)
+			for k, v := range req.Header {
+				if len(v) > 0 && k != constants.HeaderAuthSession {
+					retry.SetHeader(k, v[0])
+				}
+			}
+			resp, err = t.send(retry, method, path)
+		}
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	if resp.IsStatusFailure() {
+		return resp, t.errorHandler.HandleError(resp)
+	}
+
+	if result != nil && len(resp.Bytes()) > 0 {
+		if err := json.Unmarshal(resp.Bytes(), result); err != nil {
+			return resp, fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+	}
+
+	return resp, nil
+}
+
+// send dispatches req with the given method.
+func (t *Transport) send(req *resty.Request, method, path string) (*resty.Response, error) {
+	switch method {
+	case "GET":
+		return req.Get(path)
+	case "POST":
+		req.SetHeader("Content-Type", constants.ApplicationJSON)
+		return req.Post(path)
+	case "PUT":
+		req.SetHeader("Content-Type", constants.ApplicationJSON)
+		return req.Put(path)
+	case "DELETE":
+		// DEP removes profile assignments with a JSON body on DELETE.
+		if req.Body != nil {
+			req.SetMethodDeleteAllowPayload(true)
+			req.SetHeader("Content-Type", constants.ApplicationJSON)
+		}
+		return req.Delete(path)
+	default:
+		return nil, fmt.Errorf("unsupported HTTP method: %s", method)
+	}
+}
+
+// NewTransportFromFile creates a transport using a decrypted server token file.
+func NewTransportFromFile(serverTokenPath string, options ...ClientOption) (*Transport, error) {
+	if serverTokenPath == "" {
+		return nil, fmt.Errorf("serverTokenPath is required")
+	}
+
+	serverToken, err := LoadServerTokenFromFile(serverTokenPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTransport(serverToken, options...)
+}
@@ -0,0 +1,16 @@
+package constants
+
+// Session authentication configuration
+const (
+	// ServerProtocolVersion is sent in the X-Server-Protocol-Version header.
+	ServerProtocolVersion = "3"
+
+	// HeaderServerProtocolVersion selects the DEP protocol version.
+	HeaderServerProtocolVersion = "X-Server-Protocol-Version"
+
+	// HeaderAuthSession carries the session token returned by /session.
+	HeaderAuthSession = "X-ADM-Auth-Session"
+
+	// OAuthRealm is the realm used in the OAuth 1.0a Authorization header.
+	OAuthRealm = "ADM"
+)
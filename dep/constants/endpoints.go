@@ -0,0 +1,17 @@
+package constants
+
+// API base URL
+const (
+	DefaultBaseURL = "https://mdmenrollment.apple.com"
+)
+
+// Endpoint path constants for the Device Enrollment Program (DEP) API
+const (
+	EndpointSession        = "/session"
+	EndpointAccount        = "/account"
+	EndpointServerDevices  = "/server/devices"
+	EndpointDevicesSync    = "/devices/sync"
+	EndpointProfile        = "/profile"
+	EndpointProfileDevice  = "/profile/devices"
+	EndpointActivationLock = "/device/activationlock"
+)
@@ -0,0 +1,75 @@
+package dep
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-apple/dep/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/dep/dep_api/account"
+	"github.com/deploymenttheory/go-api-sdk-apple/dep/dep_api/activationlock"
+	"github.com/deploymenttheory/go-api-sdk-apple/dep/dep_api/devices"
+	"github.com/deploymenttheory/go-api-sdk-apple/dep/dep_api/profiles"
+)
+
+// Client is the main entry point for the Device Enrollment Program (DEP) API SDK.
+//
+// The DEP API is the cursor-based device assignment API used by MDM servers
+// (mdmenrollment.apple.com). It authenticates with the OAuth credentials of
+// an MDM server token rather than the JWT keys used by the axm package.
+//
+// A Client is safe for concurrent use by multiple goroutines; requests share
+// one auth session.
+type Client struct {
+	transport *client.Transport
+	DEPAPI    *DEPAPIClient
+}
+
+// DEPAPIClient groups all DEP API services.
+type DEPAPIClient struct {
+	Account        *account.Account
+	ActivationLock *activationlock.ActivationLock
+	Devices        *devices.Devices
+	Profiles       *profiles.Profiles
+}
+
+// NewClient creates a new DEP API client.
+// Parameters:
+//   - serverToken: The OAuth credentials from the decrypted MDM server token
+//   - options: Optional configuration options (WithLogger, WithTimeout, etc.)
+func NewClient(serverToken *client.ServerToken, options ...client.ClientOption) (*Client, error) {
+	transport, err := client.NewTransport(serverToken, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return newClient(transport), nil
+}
+
+// NewClientFromFile creates a client using a decrypted server token file.
+// Parameters:
+//   - serverTokenPath: Path to the decrypted server token (JSON, optionally
+//     wrapped in a "-----BEGIN MESSAGE-----" envelope)
+//   - options: Optional configuration options (WithLogger, WithTimeout, etc.)
+func NewClientFromFile(serverTokenPath string, options ...client.ClientOption) (*Client, error) {
+	transport, err := client.NewTransportFromFile(serverTokenPath, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return newClient(transport), nil
+}
+
+// newClient wires all services to transport.
+func newClient(transport *client.Transport) *Client {
+	return &Client{
+		transport: transport,
+		DEPAPI: &DEPAPIClient{
+			Account:        account.NewService(transport),
+			ActivationLock: activationlock.NewService(transport),
+			Devices:        devices.NewService(transport),
+			Profiles:       profiles.NewService(transport),
+		},
+	}
+}
+
+// Close releases resources held by the client.
+func (c *Client) Close() error {
+	return c.transport.Close()
+}
@@ -0,0 +1,42 @@
+package account
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/dep/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/dep/constants"
+	"resty.dev/v3"
+)
+
+// Account handles communication with the account
+// related methods of the DEP API.
+//
+// DEP API docs: https://developer.apple.com/documentation/devicemanagement/device_assignment
+type (
+	Account struct {
+		client client.Client
+	}
+)
+
+// NewService creates a new account service.
+func NewService(c client.Client) *Account {
+	return &Account{client: c}
+}
+
+// GetV1 retrieves details about the MDM server and the organization it belongs to.
+// URL: GET https://mdmenrollment.apple.com/account
+// https://developer.apple.com/documentation/devicemanagement/get_account_detail
+func (s *Account) GetV1(ctx context.Context) (*AccountDetail, *resty.Response, error) {
+	var result AccountDetail
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetResult(&result).
+		Get(constants.EndpointAccount)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
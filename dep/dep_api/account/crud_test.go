@@ -0,0 +1,83 @@
+package account
+
+import (
+	"context"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/dep/client"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+// MockAuthProvider implements the AuthProvider interface for testing.
+type MockAuthProvider struct{}
+
+func (m *MockAuthProvider) ApplyAuth(req *resty.Request) error {
+	req.SetHeader("X-ADM-Auth-Session", "test-session")
+	return nil
+}
+
+// setupMockClient creates a client with httpmock enabled.
+func setupMockClient(t *testing.T) *Account {
+	t.Helper()
+
+	transport, err := client.NewTransport(
+		&client.ServerToken{ConsumerKey: "ck", ConsumerSecret: "cs", AccessToken: "at", AccessSecret: "as"},
+		client.WithAuth(&MockAuthProvider{}),
+		client.WithLogger(zap.NewNop()),
+		client.WithRetryCount(0),
+	)
+	require.NoError(t, err)
+
+	httpmock.ActivateNonDefault(transport.GetHTTPClient().Client())
+	t.Cleanup(httpmock.DeactivateAndReset)
+
+	return NewService(transport)
+}
+
+func TestGetAccount_Success(t *testing.T) {
+	svc := setupMockClient(t)
+
+	httpmock.RegisterResponder("GET", "https://mdmenrollment.apple.com/account",
+		httpmock.NewStringResponder(200, `{
+			"server_name": "Example MDM",
+			"server_uuid": "677cd1d0-5ad1-4f61-8e5c-ad2f8b6a5e42",
+			"admin_id": "admin@example.com",
+			"org_name": "Example Inc",
+			"org_email": "it@example.com",
+			"org_phone": "555-0100",
+			"org_address": "1 Infinite Loop",
+			"org_type": "org",
+			"org_version": "v2",
+			"urls": [{"uri": "/server/devices", "http_method": ["POST"], "limit": {"default": 100, "maximum": 1000}}]
+		}`))
+
+	result, resp, err := svc.GetV1(context.Background())
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, 200, resp.StatusCode())
+	assert.Equal(t, "Example MDM", result.ServerName)
+	assert.Equal(t, "Example Inc", result.OrgName)
+	require.Len(t, result.URLs, 1)
+	assert.Equal(t, []string{"POST"}, result.URLs[0].HTTPMethod)
+	require.NotNil(t, result.URLs[0].Limit)
+	assert.Equal(t, 1000, result.URLs[0].Limit.Maximum)
+}
+
+func TestGetAccount_Forbidden(t *testing.T) {
+	svc := setupMockClient(t)
+
+	httpmock.RegisterResponder("GET", "https://mdmenrollment.apple.com/account",
+		httpmock.NewStringResponder(403, "T_C_NOT_SIGNED"))
+
+	result, resp, err := svc.GetV1(context.Background())
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+	require.NotNil(t, resp)
+	assert.True(t, client.IsErrorCode(err, client.ErrorCodeTermsNotSigned))
+}
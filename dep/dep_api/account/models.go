@@ -0,0 +1,32 @@
+package account
+
+// AccountDetail is the response for the account endpoint.
+// https://developer.apple.com/documentation/devicemanagement/accountdetail
+type AccountDetail struct {
+	ServerName    string       `json:"server_name"`
+	ServerUUID    string       `json:"server_uuid"`
+	AdminID       string       `json:"admin_id"`
+	FacilitatorID string       `json:"facilitator_id,omitempty"`
+	OrgName       string       `json:"org_name"`
+	OrgEmail      string       `json:"org_email"`
+	OrgPhone      string       `json:"org_phone"`
+	OrgAddress    string       `json:"org_address"`
+	OrgID         string       `json:"org_id,omitempty"`
+	OrgIDHash     string       `json:"org_id_hash,omitempty"`
+	OrgType       string       `json:"org_type,omitempty"`
+	OrgVersion    string       `json:"org_version,omitempty"`
+	URLs          []AccountURL `json:"urls,omitempty"`
+}
+
+// AccountURL describes an endpoint the MDM server is allowed to call.
+type AccountURL struct {
+	URI        string   `json:"uri"`
+	HTTPMethod []string `json:"http_method"`
+	Limit      *Limit   `json:"limit,omitempty"`
+}
+
+// Limit describes the request limits of an endpoint.
+type Limit struct {
+	Default int `json:"default"`
+	Maximum int `json:"maximum"`
+}
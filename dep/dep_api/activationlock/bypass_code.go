@@ -0,0 +1,113 @@
+package activationlock
+
+import (
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// BypassCode is an Activation Lock bypass code. The human-readable Code is
+// what an administrator enters on the device (or what MDM sends with
+// ClearActivationLock); EscrowKey is the value escrowed with Apple.
+type BypassCode struct {
+	raw []byte
+}
+
+// GenerateBypassCode creates a new random bypass code.
+func GenerateBypassCode() (*BypassCode, error) {
+	raw := make([]byte, BypassCodeKeySize)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, fmt.Errorf("failed to generate bypass code: %w", err)
+	}
+	return &BypassCode{raw: raw}, nil
+}
+
+// NewBypassCode wraps existing key material as a bypass code.
+func NewBypassCode(raw []byte) (*BypassCode, error) {
+	if len(raw) != BypassCodeKeySize {
+		return nil, fmt.Errorf("bypass code key must be %d bytes, got %d", BypassCodeKeySize, len(raw))
+	}
+	return &BypassCode{raw: append([]byte(nil), raw...)}, nil
+}
+
+// ParseBypassCode decodes a human-readable bypass code, such as one returned
+// by the ActivationLockBypassCode MDM command, so its escrow key can be
+// recomputed. Dashes and letter case are ignored.
+func ParseBypassCode(code string) (*BypassCode, error) {
+	symbols := strings.ToUpper(strings.ReplaceAll(code, "-", ""))
+	if len(symbols) != bypassCodeLength {
+		return nil, fmt.Errorf("bypass code must have %d symbols, got %d", bypassCodeLength, len(symbols))
+	}
+
+	raw := make([]byte, 0, BypassCodeKeySize)
+	var buffer uint
+	var bits uint
+	for i, symbol := range symbols {
+		value := strings.IndexRune(bypassCodeAlphabet, symbol)
+		if value < 0 {
+			return nil, fmt.Errorf("invalid bypass code symbol %q at position %d", symbol, i)
+		}
+		buffer = buffer<<5 | uint(value)
+		bits += 5
+		if bits >= 8 && len(raw) < BypassCodeKeySize {
+			bits -= 8
+			raw = append(raw, byte(buffer>>bits))
+			buffer &= 1<<bits - 1
+		}
+	}
+
+	return &BypassCode{raw: raw}, nil
+}
+
+// Bytes returns a copy of the key material behind the code.
+func (b *BypassCode) Bytes() []byte {
+	return append([]byte(nil), b.raw...)
+}
+
+// Code returns the human-readable code in XXXXX-XXXXX-XXXX-XXXX-XXXX-XXXX form.
+func (b *BypassCode) Code() string {
+	symbols := make([]byte, 0, bypassCodeLength)
+	var buffer uint
+	var bits uint
+	for _, c := range b.raw {
+		buffer = buffer<<8 | uint(c)
+		bits += 8
+		for bits >= 5 {
+			bits -= 5
+			symbols = append(symbols, bypassCodeAlphabet[buffer>>bits&0x1f])
+		}
+		buffer &= 1<<bits - 1
+	}
+	if bits > 0 {
+		symbols = append(symbols, bypassCodeAlphabet[buffer<<(5-bits)&0x1f])
+	}
+
+	var sb strings.Builder
+	offset := 0
+	for i, size := range bypassCodeGroups {
+		if i > 0 {
+			sb.WriteByte('-')
+		}
+		sb.Write(symbols[offset : offset+size])
+		offset += size
+	}
+	return sb.String()
+}
+
+// String implements fmt.Stringer and returns Code.
+func (b *BypassCode) String() string {
+	return b.Code()
+}
+
+// EscrowKey returns the hex-encoded escrow key for the code: a PBKDF2-SHA256
+// derivation of the key material with an empty salt.
+func (b *BypassCode) EscrowKey() (string, error) {
+	key, err := pbkdf2.Key(sha256.New, string(b.raw), nil, escrowKeyIterations, escrowKeySize)
+	if err != nil {
+		return "", fmt.Errorf("failed to derive escrow key: %w", err)
+	}
+	return hex.EncodeToString(key), nil
+}
@@ -0,0 +1,71 @@
+package activationlock
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestBypassCode_Format(t *testing.T) {
+	code, err := NewBypassCode(make([]byte, BypassCodeKeySize))
+	if err != nil {
+		t.Fatalf("NewBypassCode() error = %v", err)
+	}
+	if got, want := code.Code(), "00000-00000-0000-0000-0000-0000"; got != want {
+		t.Errorf("Code() = %q, want %q", got, want)
+	}
+
+	code, err = NewBypassCode(bytes.Repeat([]byte{0xff}, BypassCodeKeySize))
+	if err != nil {
+		t.Fatalf("NewBypassCode() error = %v", err)
+	}
+	if got, want := code.Code(), "ZZZZZ-ZZZZZ-ZZZZ-ZZZZ-ZZZZ-ZZZW"; got != want {
+		t.Errorf("Code() = %q, want %q", got, want)
+	}
+}
+
+func TestBypassCode_RoundTrip(t *testing.T) {
+	generated, err := GenerateBypassCode()
+	if err != nil {
+		t.Fatalf("GenerateBypassCode() error = %v", err)
+	}
+
+	parsed, err := ParseBypassCode(strings.ToLower(generated.Code()))
+	if err != nil {
+		t.Fatalf("ParseBypassCode() error = %v", err)
+	}
+	if !bytes.Equal(parsed.Bytes(), generated.Bytes()) {
+		t.Errorf("ParseBypassCode() bytes = %x, want %x", parsed.Bytes(), generated.Bytes())
+	}
+
+	want, err := generated.EscrowKey()
+	if err != nil {
+		t.Fatalf("EscrowKey() error = %v", err)
+	}
+	got, err := parsed.EscrowKey()
+	if err != nil {
+		t.Fatalf("EscrowKey() error = %v", err)
+	}
+	if got != want || len(got) != 2*escrowKeySize {
+		t.Errorf("EscrowKey() = %q, want %q", got, want)
+	}
+}
+
+func TestParseBypassCode_Invalid(t *testing.T) {
+	tests := []string{
+		"",
+		"00000-00000-0000-0000-0000",
+		"00000-00000-0000-0000-0000-000B",
+	}
+	for _, code := range tests {
+		if _, err := ParseBypassCode(code); err == nil {
+			t.Errorf("ParseBypassCode(%q) expected error", code)
+		}
+	}
+}
+
+func TestNewBypassCode_InvalidSize(t *testing.T) {
+	if _, err := NewBypassCode([]byte{1, 2, 3}); err == nil {
+		t.Error("NewBypassCode() expected error for short key")
+	}
+}
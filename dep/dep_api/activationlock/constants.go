@@ -0,0 +1,35 @@
+package activationlock
+
+// Response status constants returned when escrowing an Activation Lock bypass code.
+// https://developer.apple.com/documentation/devicemanagement/activationlockresponse
+const (
+	StatusSuccess             = "SUCCESS"
+	StatusNotAccessible       = "NOT_ACCESSIBLE"
+	StatusOrgNotSupported     = "ORG_NOT_SUPPORTED"
+	StatusDeviceNotSupported  = "DEVICE_NOT_SUPPORTED"
+	StatusDeviceAlreadyLocked = "DEVICE_ALREADY_LOCKED"
+	StatusFailed              = "FAILED"
+)
+
+// Bypass code constants.
+const (
+	// BypassCodeKeySize is the number of random bytes a bypass code encodes.
+	BypassCodeKeySize = 16
+
+	// bypassCodeAlphabet is the 32-symbol alphabet used by Apple bypass codes.
+	// It omits B, I, O and S to avoid confusion with digits.
+	bypassCodeAlphabet = "0123456789ACDEFGHJKLMNPQRTUVWXYZ"
+
+	// bypassCodeLength is the number of symbols in a bypass code, excluding dashes.
+	bypassCodeLength = 26
+
+	// escrowKeyIterations is the PBKDF2 iteration count used to derive the escrow key.
+	escrowKeyIterations = 50000
+
+	// escrowKeySize is the size in bytes of the derived escrow key.
+	escrowKeySize = 32
+)
+
+// bypassCodeGroups are the symbol counts of the dash-separated code groups,
+// giving the XXXXX-XXXXX-XXXX-XXXX-XXXX-XXXX format.
+var bypassCodeGroups = []int{5, 5, 4, 4, 4, 4}
@@ -0,0 +1,95 @@
+package activationlock
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/dep/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/dep/constants"
+	"resty.dev/v3"
+)
+
+// ActivationLock handles communication with the Activation Lock
+// related methods of the DEP API.
+//
+// Apple does not return escrowed bypass codes through the enrollment API;
+// MDM servers keep the code they generated (or retrieve it from the device
+// with the ActivationLockBypassCode command) and use ParseBypassCode to
+// recompute its escrow key.
+//
+// DEP API docs: https://developer.apple.com/documentation/devicemanagement/device_assignment
+type (
+	ActivationLock struct {
+		client client.Client
+	}
+)
+
+// NewService creates a new Activation Lock service.
+func NewService(c client.Client) *ActivationLock {
+	return &ActivationLock{client: c}
+}
+
+// EscrowV1 enables Activation Lock on a supervised device and escrows the
+// bypass code's escrow key with Apple. Check EscrowResponse.ResponseStatus for
+// the per-device outcome.
+// URL: POST https://mdmenrollment.apple.com/device/activationlock
+// https://developer.apple.com/documentation/devicemanagement/activation_lock_a_device
+func (s *ActivationLock) EscrowV1(ctx context.Context, request *EscrowRequest) (*EscrowResponse, *resty.Response, error) {
+	if request == nil {
+		return nil, nil, fmt.Errorf("request is required")
+	}
+	if request.Device == "" {
+		return nil, nil, fmt.Errorf("device serial number is required")
+	}
+	if request.EscrowKey != "" {
+		if err := validateEscrowKey(request.EscrowKey); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var result EscrowResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetBody(request).
+		SetResult(&result).
+		Post(constants.EndpointActivationLock)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// EscrowBypassCodeV1 derives the escrow key from code and escrows it for the
+// device identified by serialNumber.
+func (s *ActivationLock) EscrowBypassCodeV1(ctx context.Context, serialNumber string, code *BypassCode, lostMessage string) (*EscrowResponse, *resty.Response, error) {
+	if code == nil {
+		return nil, nil, fmt.Errorf("bypass code is required")
+	}
+
+	escrowKey, err := code.EscrowKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.EscrowV1(ctx, &EscrowRequest{
+		Device:      serialNumber,
+		EscrowKey:   escrowKey,
+		LostMessage: lostMessage,
+	})
+}
+
+// validateEscrowKey checks that key is a hex-encoded 32-byte escrow key.
+func validateEscrowKey(key string) error {
+	decoded, err := hex.DecodeString(key)
+	if err != nil {
+		return fmt.Errorf("escrow key must be hex encoded: %w", err)
+	}
+	if len(decoded) != escrowKeySize {
+		return fmt.Errorf("escrow key must be %d bytes, got %d", escrowKeySize, len(decoded))
+	}
+	return nil
+}
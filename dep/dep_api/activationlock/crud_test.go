@@ -0,0 +1,100 @@
+package activationlock
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/dep/client"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+// MockAuthProvider implements the AuthProvider interface for testing.
+type MockAuthProvider struct{}
+
+func (m *MockAuthProvider) ApplyAuth(req *resty.Request) error {
+	req.SetHeader("X-ADM-Auth-Session", "test-session")
+	return nil
+}
+
+// setupMockClient creates a client with httpmock enabled.
+func setupMockClient(t *testing.T) *ActivationLock {
+	t.Helper()
+
+	transport, err := client.NewTransport(
+		&client.ServerToken{ConsumerKey: "ck", ConsumerSecret: "cs", AccessToken: "at", AccessSecret: "as"},
+		client.WithAuth(&MockAuthProvider{}),
+		client.WithLogger(zap.NewNop()),
+		client.WithRetryCount(0),
+	)
+	require.NoError(t, err)
+
+	httpmock.ActivateNonDefault(transport.GetHTTPClient().Client())
+	t.Cleanup(httpmock.DeactivateAndReset)
+
+	return NewService(transport)
+}
+
+func TestEscrowBypassCode_Success(t *testing.T) {
+	svc := setupMockClient(t)
+
+	var received EscrowRequest
+	httpmock.RegisterResponder("POST", "https://mdmenrollment.apple.com/device/activationlock",
+		func(req *http.Request) (*http.Response, error) {
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&received))
+			return httpmock.NewStringResponse(200, `{"serial_number": "C02AAAAA", "response_status": "SUCCESS"}`), nil
+		})
+
+	code, err := GenerateBypassCode()
+	require.NoError(t, err)
+	escrowKey, err := code.EscrowKey()
+	require.NoError(t, err)
+
+	result, resp, err := svc.EscrowBypassCodeV1(context.Background(), "C02AAAAA", code, "Return to IT")
+
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	assert.True(t, result.Succeeded())
+	assert.Equal(t, "C02AAAAA", result.SerialNumber)
+	assert.Equal(t, "C02AAAAA", received.Device)
+	assert.Equal(t, escrowKey, received.EscrowKey)
+	assert.Equal(t, "Return to IT", received.LostMessage)
+}
+
+func TestEscrow_DeviceNotSupported(t *testing.T) {
+	svc := setupMockClient(t)
+
+	httpmock.RegisterResponder("POST", "https://mdmenrollment.apple.com/device/activationlock",
+		httpmock.NewStringResponder(200, `{"serial_number": "C02AAAAA", "response_status": "DEVICE_NOT_SUPPORTED"}`))
+
+	result, _, err := svc.EscrowV1(context.Background(), &EscrowRequest{Device: "C02AAAAA"})
+
+	require.NoError(t, err)
+	assert.False(t, result.Succeeded())
+	assert.Equal(t, StatusDeviceNotSupported, result.ResponseStatus)
+}
+
+func TestEscrow_Validation(t *testing.T) {
+	svc := setupMockClient(t)
+
+	_, _, err := svc.EscrowV1(context.Background(), nil)
+	assert.Error(t, err)
+
+	_, _, err = svc.EscrowV1(context.Background(), &EscrowRequest{EscrowKey: strings.Repeat("ab", escrowKeySize)})
+	assert.ErrorContains(t, err, "serial number")
+
+	_, _, err = svc.EscrowV1(context.Background(), &EscrowRequest{Device: "C02AAAAA", EscrowKey: "not-hex"})
+	assert.ErrorContains(t, err, "hex")
+
+	_, _, err = svc.EscrowV1(context.Background(), &EscrowRequest{Device: "C02AAAAA", EscrowKey: "abcd"})
+	assert.ErrorContains(t, err, "32 bytes")
+
+	_, _, err = svc.EscrowBypassCodeV1(context.Background(), "C02AAAAA", nil, "")
+	assert.ErrorContains(t, err, "bypass code")
+}
@@ -0,0 +1,28 @@
+package activationlock
+
+// EscrowRequest is the request body for enabling Activation Lock on a
+// supervised device and escrowing its bypass code.
+// https://developer.apple.com/documentation/devicemanagement/activationlockrequest
+type EscrowRequest struct {
+	// Device is the serial number of the device.
+	Device string `json:"device"`
+
+	// EscrowKey is the hex-encoded escrow key derived from the bypass code.
+	// See BypassCode.EscrowKey.
+	EscrowKey string `json:"escrow_key,omitempty"`
+
+	// LostMessage is shown on the device while it is in Lost Mode.
+	LostMessage string `json:"lost_message,omitempty"`
+}
+
+// EscrowResponse is the response for an Activation Lock escrow request.
+// https://developer.apple.com/documentation/devicemanagement/activationlockresponse
+type EscrowResponse struct {
+	SerialNumber   string `json:"serial_number"`
+	ResponseStatus string `json:"response_status"`
+}
+
+// Succeeded reports whether Apple accepted the escrow request.
+func (r *EscrowResponse) Succeeded() bool {
+	return r.ResponseStatus == StatusSuccess
+}
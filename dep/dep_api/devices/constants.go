@@ -0,0 +1,22 @@
+package devices
+
+// OpType constants for the op_type field of synced devices.
+const (
+	OpTypeAdded    = "added"
+	OpTypeModified = "modified"
+	OpTypeDeleted  = "deleted"
+)
+
+// ProfileStatus constants for the profile_status field values.
+const (
+	ProfileStatusEmpty    = "empty"
+	ProfileStatusAssigned = "assigned"
+	ProfileStatusPushed   = "pushed"
+	ProfileStatusRemoved  = "removed"
+)
+
+// API limits for device fetch and sync requests.
+const (
+	DefaultLimit = 100
+	MaxLimit     = 1000
+)
@@ -0,0 +1,121 @@
+package devices
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/dep/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/dep/constants"
+	"resty.dev/v3"
+)
+
+// Devices handles communication with the device fetch and sync
+// related methods of the DEP API.
+//
+// A full inventory is read with FetchV1 (or FetchAllV1), which returns a
+// cursor. That cursor is then passed to SyncV1 (or SyncAllV1) to receive only
+// the devices added, modified or deleted since. Fetch cursors expire after
+// seven days; when client.IsCursorExpired reports true, restart with a fetch.
+//
+// DEP API docs: https://developer.apple.com/documentation/devicemanagement/device_assignment
+type (
+	Devices struct {
+		client client.Client
+	}
+)
+
+// NewService creates a new devices service.
+func NewService(c client.Client) *Devices {
+	return &Devices{client: c}
+}
+
+// FetchV1 retrieves one page of the devices assigned to the MDM server.
+// URL: POST https://mdmenrollment.apple.com/server/devices
+// https://developer.apple.com/documentation/devicemanagement/fetch_devices
+func (s *Devices) FetchV1(ctx context.Context, opts *RequestQueryOptions) (*DevicesResponse, *resty.Response, error) {
+	return s.page(ctx, constants.EndpointServerDevices, opts)
+}
+
+// FetchAllV1 retrieves every device assigned to the MDM server, following
+// the cursor until no more devices remain. The returned Cursor can be passed
+// to SyncV1 to receive subsequent changes.
+func (s *Devices) FetchAllV1(ctx context.Context, opts *RequestQueryOptions) (*DevicesResponse, *resty.Response, error) {
+	return s.all(ctx, constants.EndpointServerDevices, opts)
+}
+
+// SyncV1 retrieves one page of device changes since the given cursor.
+// URL: POST https://mdmenrollment.apple.com/devices/sync
+// https://developer.apple.com/documentation/devicemanagement/sync_devices
+func (s *Devices) SyncV1(ctx context.Context, opts *RequestQueryOptions) (*DevicesResponse, *resty.Response, error) {
+	if opts == nil || opts.Cursor == "" {
+		return nil, nil, fmt.Errorf("cursor is required")
+	}
+
+	return s.page(ctx, constants.EndpointDevicesSync, opts)
+}
+
+// SyncAllV1 retrieves all device changes since the given cursor, following
+// the cursor until no more changes remain.
+func (s *Devices) SyncAllV1(ctx context.Context, opts *RequestQueryOptions) (*DevicesResponse, *resty.Response, error) {
+	if opts == nil || opts.Cursor == "" {
+		return nil, nil, fmt.Errorf("cursor is required")
+	}
+
+	return s.all(ctx, constants.EndpointDevicesSync, opts)
+}
+
+// page requests a single page from a cursor-based endpoint.
+func (s *Devices) page(ctx context.Context, endpoint string, opts *RequestQueryOptions) (*DevicesResponse, *resty.Response, error) {
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	body := CursorRequest{Cursor: opts.Cursor, Limit: opts.Limit}
+	if body.Limit > MaxLimit {
+		body.Limit = MaxLimit // Enforce API maximum
+	}
+
+	var result DevicesResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetBody(body).
+		SetResult(&result).
+		Post(endpoint)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// all follows a cursor-based endpoint until more_to_follow is false.
+func (s *Devices) all(ctx context.Context, endpoint string, opts *RequestQueryOptions) (*DevicesResponse, *resty.Response, error) {
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	cursor := opts.Cursor
+	var allDevices []Device
+
+	for {
+		page, resp, err := s.page(ctx, endpoint, &RequestQueryOptions{Cursor: cursor, Limit: opts.Limit})
+		if err != nil {
+			return nil, resp, err
+		}
+
+		allDevices = append(allDevices, page.Devices...)
+		if page.Cursor != "" {
+			cursor = page.Cursor
+		}
+
+		if !page.MoreToFollow {
+			return &DevicesResponse{
+				Devices:      allDevices,
+				Cursor:       cursor,
+				FetchedUntil: page.FetchedUntil,
+			}, resp, nil
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package devices
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/dep/client"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+// MockAuthProvider implements the AuthProvider interface for testing.
+type MockAuthProvider struct{}
+
+func (m *MockAuthProvider) ApplyAuth(req *resty.Request) error {
+	req.SetHeader("X-ADM-Auth-Session", "test-session")
+	return nil
+}
+
+// setupMockClient creates a client with httpmock enabled.
+func setupMockClient(t *testing.T) *Devices {
+	t.Helper()
+
+	transport, err := client.NewTransport(
+		&client.ServerToken{ConsumerKey: "ck", ConsumerSecret: "cs", AccessToken: "at", AccessSecret: "as"},
+		client.WithAuth(&MockAuthProvider{}),
+		client.WithLogger(zap.NewNop()),
+		client.WithRetryCount(0),
+	)
+	require.NoError(t, err)
+
+	httpmock.ActivateNonDefault(transport.GetHTTPClient().Client())
+	t.Cleanup(httpmock.DeactivateAndReset)
+
+	return NewService(transport)
+}
+
+// cursorResponder serves pages keyed by the request cursor and records the request bodies.
+func cursorResponder(t *testing.T, pages map[string]string, requests *[]CursorRequest) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		var body CursorRequest
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		*requests = append(*requests, body)
+
+		page, ok := pages[body.Cursor]
+		if !ok {
+			return httpmock.NewStringResponse(400, "INVALID_CURSOR"), nil
+		}
+		return httpmock.NewStringResponse(200, page), nil
+	}
+}
+
+func TestFetchDevices_SinglePage(t *testing.T) {
+	svc := setupMockClient(t)
+
+	var requests []CursorRequest
+	httpmock.RegisterResponder("POST", "https://mdmenrollment.apple.com/server/devices",
+		cursorResponder(t, map[string]string{
+			"": `{"devices": [{"serial_number": "C02AAA", "model": "MacBook Pro", "os": "OSX", "device_family": "Mac", "profile_status": "empty"}],
+				"cursor": "c1", "fetched_until": "2026-01-01T00:00:00Z", "more_to_follow": true}`,
+		}, &requests))
+
+	result, resp, err := svc.FetchV1(context.Background(), &RequestQueryOptions{Limit: 5000})
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Len(t, result.Devices, 1)
+	assert.Equal(t, "C02AAA", result.Devices[0].SerialNumber)
+	assert.Equal(t, ProfileStatusEmpty, result.Devices[0].ProfileStatus)
+	assert.Equal(t, "c1", result.Cursor)
+	assert.True(t, result.MoreToFollow)
+	assert.Equal(t, MaxLimit, requests[0].Limit)
+}
+
+func TestFetchAllDevices_FollowsCursor(t *testing.T) {
+	svc := setupMockClient(t)
+
+	var requests []CursorRequest
+	httpmock.RegisterResponder("POST", "https://mdmenrollment.apple.com/server/devices",
+		cursorResponder(t, map[string]string{
+			"":   `{"devices": [{"serial_number": "A"}], "cursor": "c1", "more_to_follow": true}`,
+			"c1": `{"devices": [{"serial_number": "B"}], "cursor": "c2", "more_to_follow": true}`,
+			"c2": `{"devices": [], "cursor": "c3", "fetched_until": "2026-01-01T00:00:00Z", "more_to_follow": false}`,
+		}, &requests))
+
+	result, _, err := svc.FetchAllV1(context.Background(), &RequestQueryOptions{Limit: 100})
+
+	require.NoError(t, err)
+	require.Len(t, result.Devices, 2)
+	assert.Equal(t, "A", result.Devices[0].SerialNumber)
+	assert.Equal(t, "B", result.Devices[1].SerialNumber)
+	assert.Equal(t, "c3", result.Cursor)
+	assert.Equal(t, "2026-01-01T00:00:00Z", result.FetchedUntil)
+	assert.False(t, result.MoreToFollow)
+	require.Len(t, requests, 3)
+	assert.Equal(t, "c2", requests[2].Cursor)
+}
+
+func TestSyncAllDevices_Success(t *testing.T) {
+	svc := setupMockClient(t)
+
+	var requests []CursorRequest
+	httpmock.RegisterResponder("POST", "https://mdmenrollment.apple.com/devices/sync",
+		cursorResponder(t, map[string]string{
+			"c3": `{"devices": [{"serial_number": "A", "op_type": "modified", "op_date": "2026-01-02T00:00:00Z"}], "cursor": "c4", "more_to_follow": true}`,
+			"c4": `{"devices": [{"serial_number": "C", "op_type": "deleted"}], "cursor": "c5", "more_to_follow": false}`,
+		}, &requests))
+
+	result, _, err := svc.SyncAllV1(context.Background(), &RequestQueryOptions{Cursor: "c3"})
+
+	require.NoError(t, err)
+	require.Len(t, result.Devices, 2)
+	assert.Equal(t, OpTypeModified, result.Devices[0].OpType)
+	assert.Equal(t, OpTypeDeleted, result.Devices[1].OpType)
+	assert.Equal(t, "c5", result.Cursor)
+}
+
+func TestSyncDevices_RequiresCursor(t *testing.T) {
+	svc := setupMockClient(t)
+
+	result, resp, err := svc.SyncV1(context.Background(), nil)
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.Nil(t, resp)
+
+	_, _, err = svc.SyncAllV1(context.Background(), &RequestQueryOptions{})
+	require.Error(t, err)
+}
+
+func TestSyncDevices_ExpiredCursor(t *testing.T) {
+	svc := setupMockClient(t)
+
+	httpmock.RegisterResponder("POST", "https://mdmenrollment.apple.com/devices/sync",
+		httpmock.NewStringResponder(400, "EXPIRED_CURSOR"))
+
+	result, resp, err := svc.SyncV1(context.Background(), &RequestQueryOptions{Cursor: "old"})
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+	require.NotNil(t, resp)
+	assert.Equal(t, 400, resp.StatusCode())
+	assert.True(t, client.IsCursorExpired(err))
+}
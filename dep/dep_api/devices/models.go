@@ -0,0 +1,46 @@
+package devices
+
+// Device represents a device assigned to the MDM server.
+// https://developer.apple.com/documentation/devicemanagement/device
+type Device struct {
+	SerialNumber       string `json:"serial_number"`
+	Model              string `json:"model,omitempty"`
+	Description        string `json:"description,omitempty"`
+	Color              string `json:"color,omitempty"`
+	AssetTag           string `json:"asset_tag,omitempty"`
+	ProfileStatus      string `json:"profile_status,omitempty"`
+	ProfileUUID        string `json:"profile_uuid,omitempty"`
+	ProfileAssignTime  string `json:"profile_assign_time,omitempty"`
+	ProfilePushTime    string `json:"profile_push_time,omitempty"`
+	DeviceAssignedDate string `json:"device_assigned_date,omitempty"`
+	DeviceAssignedBy   string `json:"device_assigned_by,omitempty"`
+	OS                 string `json:"os,omitempty"`
+	DeviceFamily       string `json:"device_family,omitempty"`
+
+	// OpType and OpDate are only set on devices returned by the sync endpoint.
+	OpType string `json:"op_type,omitempty"`
+	OpDate string `json:"op_date,omitempty"`
+}
+
+// DevicesResponse is the response for the fetch and sync endpoints.
+// https://developer.apple.com/documentation/devicemanagement/fetchdeviceresponse
+type DevicesResponse struct {
+	Devices      []Device `json:"devices"`
+	Cursor       string   `json:"cursor"`
+	FetchedUntil string   `json:"fetched_until,omitempty"`
+	MoreToFollow bool     `json:"more_to_follow"`
+}
+
+// CursorRequest is the request body for the fetch and sync endpoints.
+type CursorRequest struct {
+	Cursor string `json:"cursor,omitempty"`
+	Limit  int    `json:"limit,omitempty"`
+}
+
+// RequestQueryOptions represents options for the fetch and sync endpoints.
+type RequestQueryOptions struct {
+	// Cursor resumes a previous fetch or sync. Leave empty to start a full fetch.
+	Cursor string
+	// Limit is the number of devices per page (default 100, max 1000).
+	Limit int
+}
@@ -0,0 +1,54 @@
+package profiles
+
+// Skip key constants for the skip_setup_items field. Each hides the matching
+// Setup Assistant pane.
+// https://developer.apple.com/documentation/devicemanagement/skipkeys
+const (
+	SkipAccessibility                       = "Accessibility"
+	SkipAppearance                          = "Appearance"
+	SkipAppleID                             = "AppleID"
+	SkipAppStore                            = "AppStore"
+	SkipBiometric                           = "Biometric"
+	SkipDeviceToDeviceMigration             = "DeviceToDeviceMigration"
+	SkipDiagnostics                         = "Diagnostics"
+	SkipDisplayTone                         = "DisplayTone"
+	SkipEnableLockdownMode                  = "EnableLockdownMode"
+	SkipFileVault                           = "FileVault"
+	SkipICloudDiagnostics                   = "iCloudDiagnostics"
+	SkipICloudStorage                       = "iCloudStorage"
+	SkipIntelligence                        = "Intelligence"
+	SkipKeyboard                            = "Keyboard"
+	SkipLocation                            = "Location"
+	SkipMessagingActivationUsingPhoneNumber = "MessagingActivationUsingPhoneNumber"
+	SkipPasscode                            = "Passcode"
+	SkipPayment                             = "Payment"
+	SkipPreferredLanguage                   = "PreferredLanguage"
+	SkipPrivacy                             = "Privacy"
+	SkipRestore                             = "Restore"
+	SkipRestoreCompleted                    = "RestoreCompleted"
+	SkipSafetyAndHandling                   = "SafetyAndHandling"
+	SkipScreenSaver                         = "ScreenSaver"
+	SkipScreenTime                          = "ScreenTime"
+	SkipSIMSetup                            = "SIMSetup"
+	SkipSiri                                = "Siri"
+	SkipSoftwareUpdate                      = "SoftwareUpdate"
+	SkipTapToSetup                          = "TapToSetup"
+	SkipTermsOfAddress                      = "TermsOfAddress"
+	SkipTOS                                 = "TOS"
+	SkipUnlockWithWatch                     = "UnlockWithWatch"
+	SkipUpdateCompleted                     = "UpdateCompleted"
+	SkipWallpaper                           = "Wallpaper"
+	SkipWatchMigration                      = "WatchMigration"
+	SkipWelcome                             = "Welcome"
+)
+
+// Device result constants for the per-device status of profile operations.
+const (
+	DeviceResultSuccess       = "SUCCESS"
+	DeviceResultNotAccessible = "NOT_ACCESSIBLE"
+	DeviceResultFailed        = "FAILED"
+)
+
+// MaxDevicesPerRequest is the maximum number of serial numbers accepted by
+// a single assign or remove request.
+const MaxDevicesPerRequest = 1000
@@ -0,0 +1,142 @@
+package profiles
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/dep/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/dep/constants"
+	"resty.dev/v3"
+)
+
+// Profiles handles communication with the enrollment profile
+// related methods of the DEP API.
+//
+// DEP API docs: https://developer.apple.com/documentation/devicemanagement/device_assignment
+type (
+	Profiles struct {
+		client client.Client
+	}
+)
+
+// NewService creates a new profiles service.
+func NewService(c client.Client) *Profiles {
+	return &Profiles{client: c}
+}
+
+// DefineV1 creates an enrollment profile and returns its UUID. If
+// profile.Devices is set the profile is also assigned to those devices.
+// URL: POST https://mdmenrollment.apple.com/profile
+// https://developer.apple.com/documentation/devicemanagement/define_a_profile
+func (s *Profiles) DefineV1(ctx context.Context, profile *Profile) (*DefineProfileResponse, *resty.Response, error) {
+	if profile == nil {
+		return nil, nil, fmt.Errorf("profile is required")
+	}
+	if profile.ProfileName == "" {
+		return nil, nil, fmt.Errorf("profile name is required")
+	}
+	if profile.URL == "" {
+		return nil, nil, fmt.Errorf("profile URL is required")
+	}
+	if len(profile.Devices) > MaxDevicesPerRequest {
+		return nil, nil, fmt.Errorf("at most %d devices are allowed per request, got %d", MaxDevicesPerRequest, len(profile.Devices))
+	}
+
+	var result DefineProfileResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetBody(profile).
+		SetResult(&result).
+		Post(constants.EndpointProfile)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// GetByProfileUUIDV1 retrieves a previously defined profile.
+// URL: GET https://mdmenrollment.apple.com/profile?profile_uuid={uuid}
+// https://developer.apple.com/documentation/devicemanagement/get_a_profile
+func (s *Profiles) GetByProfileUUIDV1(ctx context.Context, profileUUID string) (*Profile, *resty.Response, error) {
+	if profileUUID == "" {
+		return nil, nil, fmt.Errorf("profile UUID is required")
+	}
+
+	var result Profile
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParam("profile_uuid", profileUUID).
+		SetResult(&result).
+		Get(constants.EndpointProfile)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// AssignV1 assigns a profile to devices by serial number. The response
+// reports a status per device; use FailedDevices to find rejected serials.
+// URL: PUT https://mdmenrollment.apple.com/profile/devices
+// https://developer.apple.com/documentation/devicemanagement/assign_a_profile
+func (s *Profiles) AssignV1(ctx context.Context, profileUUID string, serialNumbers []string) (*AssignProfileResponse, *resty.Response, error) {
+	if profileUUID == "" {
+		return nil, nil, fmt.Errorf("profile UUID is required")
+	}
+	if err := validateSerialNumbers(serialNumbers); err != nil {
+		return nil, nil, err
+	}
+
+	var result AssignProfileResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetBody(&AssignProfileRequest{ProfileUUID: profileUUID, Devices: serialNumbers}).
+		SetResult(&result).
+		Put(constants.EndpointProfileDevice)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// RemoveV1 removes the profile assignment from devices by serial number.
+// URL: DELETE https://mdmenrollment.apple.com/profile/devices
+// https://developer.apple.com/documentation/devicemanagement/remove_a_profile-c2c
+func (s *Profiles) RemoveV1(ctx context.Context, serialNumbers []string) (*RemoveProfileResponse, *resty.Response, error) {
+	if err := validateSerialNumbers(serialNumbers); err != nil {
+		return nil, nil, err
+	}
+
+	var result RemoveProfileResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetBody(&RemoveProfileRequest{Devices: serialNumbers}).
+		SetResult(&result).
+		Delete(constants.EndpointProfileDevice)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// validateSerialNumbers checks the device list of an assign or remove request.
+func validateSerialNumbers(serialNumbers []string) error {
+	if len(serialNumbers) == 0 {
+		return fmt.Errorf("at least one serial number is required")
+	}
+	if len(serialNumbers) > MaxDevicesPerRequest {
+		return fmt.Errorf("at most %d devices are allowed per request, got %d", MaxDevicesPerRequest, len(serialNumbers))
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package profiles
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/dep/client"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+// MockAuthProvider implements the AuthProvider interface for testing.
+type MockAuthProvider struct{}
+
+func (m *MockAuthProvider) ApplyAuth(req *resty.Request) error {
+	req.SetHeader("X-ADM-Auth-Session", "test-session")
+	return nil
+}
+
+// setupMockClient creates a client with httpmock enabled.
+func setupMockClient(t *testing.T) *Profiles {
+	t.Helper()
+
+	transport, err := client.NewTransport(
+		&client.ServerToken{ConsumerKey: "ck", ConsumerSecret: "cs", AccessToken: "at", AccessSecret: "as"},
+		client.WithAuth(&MockAuthProvider{}),
+		client.WithLogger(zap.NewNop()),
+		client.WithRetryCount(0),
+	)
+	require.NoError(t, err)
+
+	httpmock.ActivateNonDefault(transport.GetHTTPClient().Client())
+	t.Cleanup(httpmock.DeactivateAndReset)
+
+	return NewService(transport)
+}
+
+func boolPtr(b bool) *bool { return &b }
+
+func TestDefineProfile_Success(t *testing.T) {
+	svc := setupMockClient(t)
+
+	var received map[string]any
+	httpmock.RegisterResponder("POST", "https://mdmenrollment.apple.com/profile",
+		func(req *http.Request) (*http.Response, error) {
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&received))
+			return httpmock.NewStringResponse(200, `{
+				"profile_uuid": "88ab1c2d",
+				"devices": {"C02AAAAA": "SUCCESS", "C02BBBBB": "NOT_ACCESSIBLE"}
+			}`), nil
+		})
+
+	result, resp, err := svc.DefineV1(context.Background(), &Profile{
+		ProfileName:    "Staff",
+		URL:            "https://mdm.example.com/enroll",
+		IsSupervised:   boolPtr(true),
+		IsMDMRemovable: boolPtr(false),
+		SkipSetupItems: []string{SkipAppleID, SkipSiri},
+		Devices:        []string{"C02AAAAA", "C02BBBBB"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	assert.Equal(t, "88ab1c2d", result.ProfileUUID)
+	assert.Equal(t, []string{"C02BBBBB"}, FailedDevices(result.Devices))
+
+	assert.Equal(t, "Staff", received["profile_name"])
+	assert.Equal(t, true, received["is_supervised"])
+	assert.Equal(t, false, received["is_mdm_removable"])
+	assert.NotContains(t, received, "allow_pairing")
+	assert.Equal(t, []any{"AppleID", "Siri"}, received["skip_setup_items"])
+}
+
+func TestDefineProfile_Validation(t *testing.T) {
+	svc := setupMockClient(t)
+
+	_, _, err := svc.DefineV1(context.Background(), nil)
+	assert.Error(t, err)
+
+	_, _, err = svc.DefineV1(context.Background(), &Profile{URL: "https://mdm.example.com"})
+	assert.ErrorContains(t, err, "profile name")
+
+	_, _, err = svc.DefineV1(context.Background(), &Profile{ProfileName: "Staff"})
+	assert.ErrorContains(t, err, "profile URL")
+}
+
+func TestGetProfile_Success(t *testing.T) {
+	svc := setupMockClient(t)
+
+	httpmock.RegisterResponderWithQuery("GET", "https://mdmenrollment.apple.com/profile",
+		map[string]string{"profile_uuid": "88ab1c2d"},
+		httpmock.NewStringResponder(200, `{
+			"profile_name": "Staff",
+			"url": "https://mdm.example.com/enroll",
+			"is_supervised": true,
+			"skip_setup_items": ["Location"]
+		}`))
+
+	result, _, err := svc.GetByProfileUUIDV1(context.Background(), "88ab1c2d")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Staff", result.ProfileName)
+	require.NotNil(t, result.IsSupervised)
+	assert.True(t, *result.IsSupervised)
+	assert.Equal(t, []string{SkipLocation}, result.SkipSetupItems)
+}
+
+func TestAssignProfile_Success(t *testing.T) {
+	svc := setupMockClient(t)
+
+	var received AssignProfileRequest
+	httpmock.RegisterResponder("PUT", "https://mdmenrollment.apple.com/profile/devices",
+		func(req *http.Request) (*http.Response, error) {
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&received))
+			return httpmock.NewStringResponse(200, `{
+				"profile_uuid": "88ab1c2d",
+				"devices": {"C02AAAAA": "SUCCESS"}
+			}`), nil
+		})
+
+	result, _, err := svc.AssignV1(context.Background(), "88ab1c2d", []string{"C02AAAAA"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "88ab1c2d", received.ProfileUUID)
+	assert.Equal(t, []string{"C02AAAAA"}, received.Devices)
+	assert.Equal(t, DeviceResultSuccess, result.Devices["C02AAAAA"])
+	assert.Empty(t, FailedDevices(result.Devices))
+}
+
+func TestAssignProfile_Validation(t *testing.T) {
+	svc := setupMockClient(t)
+
+	_, _, err := svc.AssignV1(context.Background(), "", []string{"C02AAAAA"})
+	assert.ErrorContains(t, err, "profile UUID")
+
+	_, _, err = svc.AssignV1(context.Background(), "88ab1c2d", nil)
+	assert.ErrorContains(t, err, "serial number")
+
+	_, _, err = svc.AssignV1(context.Background(), "88ab1c2d", make([]string, MaxDevicesPerRequest+1))
+	assert.ErrorContains(t, err, "at most")
+}
+
+func TestRemoveProfile_SendsBody(t *testing.T) {
+	svc := setupMockClient(t)
+
+	var received RemoveProfileRequest
+	var contentType string
+	httpmock.RegisterResponder("DELETE", "https://mdmenrollment.apple.com/profile/devices",
+		func(req *http.Request) (*http.Response, error) {
+			contentType = req.Header.Get("Content-Type")
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&received))
+			return httpmock.NewStringResponse(200, `{"devices": {"C02AAAAA": "SUCCESS", "C02BBBBB": "FAILED"}}`), nil
+		})
+
+	result, _, err := svc.RemoveV1(context.Background(), []string{"C02AAAAA", "C02BBBBB"})
+
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(contentType, "application/json"))
+	assert.Equal(t, []string{"C02AAAAA", "C02BBBBB"}, received.Devices)
+	assert.Equal(t, []string{"C02BBBBB"}, FailedDevices(result.Devices))
+}
@@ -0,0 +1,80 @@
+package profiles
+
+import "sort"
+
+// Profile is an automated device enrollment profile.
+// https://developer.apple.com/documentation/devicemanagement/profile
+type Profile struct {
+	ProfileName string `json:"profile_name"`
+	URL         string `json:"url"`
+
+	// Supervision and enrollment behaviour
+	IsSupervised          *bool `json:"is_supervised,omitempty"`
+	IsMandatory           *bool `json:"is_mandatory,omitempty"`
+	IsMDMRemovable        *bool `json:"is_mdm_removable,omitempty"`
+	IsMultiUser           *bool `json:"is_multi_user,omitempty"`
+	AllowPairing          *bool `json:"allow_pairing,omitempty"`
+	AwaitDeviceConfigured *bool `json:"await_device_configured,omitempty"`
+	AutoAdvanceSetup      *bool `json:"auto_advance_setup,omitempty"`
+
+	// Setup Assistant
+	SkipSetupItems []string `json:"skip_setup_items,omitempty"`
+	Language       string   `json:"language,omitempty"`
+	Region         string   `json:"region,omitempty"`
+
+	// Organization details shown during setup
+	Department          string `json:"department,omitempty"`
+	SupportPhoneNumber  string `json:"support_phone_number,omitempty"`
+	SupportEmailAddress string `json:"support_email_address,omitempty"`
+	OrgMagic            string `json:"org_magic,omitempty"`
+
+	// Trust
+	AnchorCerts          []string `json:"anchor_certs,omitempty"`
+	SupervisingHostCerts []string `json:"supervising_host_certs,omitempty"`
+
+	ConfigurationWebURL string `json:"configuration_web_url,omitempty"`
+
+	// Devices optionally assigns the profile to these serial numbers when it is defined.
+	Devices []string `json:"devices,omitempty"`
+}
+
+// DefineProfileResponse is the response for defining a profile.
+// https://developer.apple.com/documentation/devicemanagement/profileserviceresponse
+type DefineProfileResponse struct {
+	ProfileUUID string            `json:"profile_uuid"`
+	Devices     map[string]string `json:"devices,omitempty"`
+}
+
+// AssignProfileRequest is the request body for assigning a profile to devices.
+type AssignProfileRequest struct {
+	ProfileUUID string   `json:"profile_uuid"`
+	Devices     []string `json:"devices"`
+}
+
+// AssignProfileResponse is the response for assigning a profile to devices.
+type AssignProfileResponse struct {
+	ProfileUUID string            `json:"profile_uuid"`
+	Devices     map[string]string `json:"devices"`
+}
+
+// RemoveProfileRequest is the request body for removing profile assignments.
+type RemoveProfileRequest struct {
+	Devices []string `json:"devices"`
+}
+
+// RemoveProfileResponse is the response for removing profile assignments.
+type RemoveProfileResponse struct {
+	Devices map[string]string `json:"devices"`
+}
+
+// FailedDevices returns the serial numbers whose status is not SUCCESS, sorted.
+func FailedDevices(devices map[string]string) []string {
+	var failed []string
+	for serial, status := range devices {
+		if status != DeviceResultSuccess {
+			failed = append(failed, serial)
+		}
+	}
+	sort.Strings(failed)
+	return failed
+}
@@ -0,0 +1,107 @@
+package dep
+
+import (
+	"crypto/tls"
+	"net/http"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/dep/client"
+	"go.uber.org/zap"
+)
+
+// ClientOption configures the DEP API transport at construction time.
+// Pass one or more ClientOption values to NewClient or NewClientFromFile.
+type ClientOption = client.ClientOption
+
+// ServerToken holds the OAuth credentials of an MDM server token.
+type ServerToken = client.ServerToken
+
+// WithBaseURL sets a custom base URL, overriding the default DEP endpoint.
+func WithBaseURL(baseURL string) ClientOption {
+	return client.WithBaseURL(baseURL)
+}
+
+// WithLogger sets a custom zap logger. Returns an error if logger is nil.
+func WithLogger(logger *zap.Logger) ClientOption {
+	return client.WithLogger(logger)
+}
+
+// WithTimeout sets the timeout for all HTTP requests.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return client.WithTimeout(timeout)
+}
+
+// WithRetryCount sets the maximum number of retries for failed requests.
+func WithRetryCount(count int) ClientOption {
+	return client.WithRetryCount(count)
+}
+
+// WithRetryWaitTime sets the initial wait time between retry attempts.
+func WithRetryWaitTime(waitTime time.Duration) ClientOption {
+	return client.WithRetryWaitTime(waitTime)
+}
+
+// WithRetryMaxWaitTime sets the maximum wait time between retry attempts.
+func WithRetryMaxWaitTime(maxWaitTime time.Duration) ClientOption {
+	return client.WithRetryMaxWaitTime(maxWaitTime)
+}
+
+// WithUserAgent sets a custom user-agent string.
+func WithUserAgent(userAgent string) ClientOption {
+	return client.WithUserAgent(userAgent)
+}
+
+// WithCustomAgent appends a custom identifier to the default user agent.
+func WithCustomAgent(customAgent string) ClientOption {
+	return client.WithCustomAgent(customAgent)
+}
+
+// WithDebug enables resty's request/response debug logging.
+func WithDebug() ClientOption {
+	return client.WithDebug()
+}
+
+// WithGlobalHeader adds a single header to every outgoing request.
+func WithGlobalHeader(key, value string) ClientOption {
+	return client.WithGlobalHeader(key, value)
+}
+
+// WithGlobalHeaders adds multiple headers to every outgoing request.
+func WithGlobalHeaders(headers map[string]string) ClientOption {
+	return client.WithGlobalHeaders(headers)
+}
+
+// WithProxy sets an HTTP proxy for all requests.
+func WithProxy(proxyURL string) ClientOption {
+	return client.WithProxy(proxyURL)
+}
+
+// WithTLSClientConfig sets custom TLS configuration.
+func WithTLSClientConfig(tlsConfig *tls.Config) ClientOption {
+	return client.WithTLSClientConfig(tlsConfig)
+}
+
+// WithTransport sets a custom HTTP transport (http.RoundTripper).
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return client.WithTransport(transport)
+}
+
+// WithMinTLSVersion sets the minimum TLS version for connections.
+func WithMinTLSVersion(minVersion uint16) ClientOption {
+	return client.WithMinTLSVersion(minVersion)
+}
+
+// ParseServerToken parses a decrypted MDM server token.
+func ParseServerToken(data []byte) (*ServerToken, error) {
+	return client.ParseServerToken(data)
+}
+
+// LoadServerTokenFromFile reads and parses a decrypted MDM server token file.
+func LoadServerTokenFromFile(filePath string) (*ServerToken, error) {
+	return client.LoadServerTokenFromFile(filePath)
+}
+
+// IsCursorExpired reports whether err indicates an expired or invalid cursor.
+func IsCursorExpired(err error) bool {
+	return client.IsCursorExpired(err)
+}
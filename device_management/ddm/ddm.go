@@ -1,11 +1,14 @@
 // Package ddm turns validated, generated declaration structs into
 // syntactically correct Declarative Device Management JSON. It is the DDM
 // half of the SDK's workflow engine — typed values in, spec-validated
-// declarations out.
+// declarations out — and builds the declaration-items and tokens documents
+// a DDM server serves during synchronization.
 package ddm
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 )
@@ -37,6 +40,13 @@ func WithServerToken(token string) DeclarationOption {
 	return func(d *Declaration) { d.ServerToken = token }
 }
 
+// WithHashedServerToken sets the declaration's ServerToken to its content
+// hash (see Declaration.Hash), so the token changes exactly when the
+// declaration does. Apply it after any option that alters the declaration.
+func WithHashedServerToken() DeclarationOption {
+	return func(d *Declaration) { d.ServerToken = d.Hash() }
+}
+
 // NewDeclaration validates payload and builds the declaration envelope.
 func NewDeclaration(identifier string, payload DeclarationPayload, opts ...DeclarationOption) (*Declaration, error) {
 	if identifier == "" {
@@ -61,14 +71,26 @@ func NewDeclaration(identifier string, payload DeclarationPayload, opts ...Decla
 
 // JSON renders the declaration as indented DDM JSON.
 func (d *Declaration) JSON() ([]byte, error) {
+	return encodeJSON(d.Identifier, d)
+}
+
+// Hash returns the hex SHA-256 of the declaration's compact JSON with
+// ServerToken cleared. Payload struct fields serialize in declaration order,
+// so equal declarations always hash equally.
+func (d *Declaration) Hash() string {
+	unsigned := *d
+	unsigned.ServerToken = ""
 	var buf bytes.Buffer
 	enc := json.NewEncoder(&buf)
 	enc.SetEscapeHTML(false)
-	enc.SetIndent("", "  ")
-	if err := enc.Encode(d); err != nil {
-		return nil, fmt.Errorf("ddm: encode %s: %w", d.Identifier, err)
+	if err := enc.Encode(&unsigned); err != nil {
+		// Generated payloads always encode; fall back to hashing the
+		// identity so the token is still stable.
+		buf.Reset()
+		buf.WriteString(d.Type + "\x00" + d.Identifier)
 	}
-	return buf.Bytes(), nil
+	sum := sha256.Sum256(buf.Bytes())
+	return hex.EncodeToString(sum[:])
 }
 
 // BuildDeclaration is the one-call form: validate, wrap and render JSON.
@@ -0,0 +1,277 @@
+package ddm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Declaration validity states reported in DeclarationStatus.Valid.
+const (
+	ValidityValid   = "valid"
+	ValidityInvalid = "invalid"
+	ValidityUnknown = "unknown"
+)
+
+// StatusReport is a status report sent by a device: the Data of a
+// DeclarativeManagement check-in whose Endpoint is "status". Status items
+// without a typed field are available through Item.
+type StatusReport struct {
+	// FullReport is true when the report carries the device's full status
+	// rather than only what changed since the last report.
+	FullReport bool
+
+	// Device holds the device.* properties present in the report. Fields
+	// are nil when the report does not include them.
+	Device DeviceStatus
+
+	// Declarations is the management.declarations item, or nil when the
+	// report does not include it.
+	Declarations *DeclarationsStatus
+
+	// Errors lists the status items the device could not report.
+	Errors []StatusError
+
+	// Items is the report's StatusItems object, nested by the dot-separated
+	// parts of each status item name.
+	Items map[string]any
+}
+
+// DeviceStatus holds the device properties of a status report.
+type DeviceStatus struct {
+	SerialNumber *string
+	UDID         *string
+
+	ModelFamily        *string
+	ModelIdentifier    *string
+	ModelMarketingName *string
+	ModelNumber        *string
+
+	OSFamily                   *string
+	OSVersion                  *string
+	OSBuildVersion             *string
+	OSMarketingName            *string
+	OSSupplementalBuildVersion *string
+	OSSupplementalExtraVersion *string
+
+	// BatteryHealth is one of "normal", "service-recommended",
+	// "non-genuine", "unknown" or "unsupported".
+	BatteryHealth *string
+}
+
+// DeclarationsStatus is the management.declarations status item: the
+// declarations the device has processed, by category.
+type DeclarationsStatus struct {
+	Activations    []DeclarationStatus `json:"activations"`
+	Assets         []DeclarationStatus `json:"assets"`
+	Configurations []DeclarationStatus `json:"configurations"`
+	Management     []DeclarationStatus `json:"management"`
+}
+
+// DeclarationStatus is the device's state for one declaration.
+type DeclarationStatus struct {
+	Identifier  string `json:"identifier"`
+	ServerToken string `json:"server-token"`
+	Active      bool   `json:"active"`
+	// Valid is ValidityValid, ValidityInvalid or ValidityUnknown.
+	Valid   string         `json:"valid"`
+	Reasons []StatusReason `json:"reasons,omitempty"`
+}
+
+// StatusReason describes why a declaration is invalid or a status item
+// could not be reported. Declaration reasons use lower-case keys and
+// report errors capitalized ones; both decode into it.
+type StatusReason struct {
+	Code        string         `json:"code"`
+	Description string         `json:"description,omitempty"`
+	Details     map[string]any `json:"details,omitempty"`
+}
+
+// StatusError is an entry of a status report's Errors array.
+type StatusError struct {
+	StatusItem string         `json:"StatusItem"`
+	Reasons    []StatusReason `json:"Reasons,omitempty"`
+}
+
+// statusReportWire is the subset of a status report decoded into typed
+// fields.
+type statusReportWire struct {
+	StatusItems *struct {
+		Device struct {
+			Identifier struct {
+				SerialNumber *string `json:"serial-number"`
+				UDID         *string `json:"udid"`
+			} `json:"identifier"`
+			Model struct {
+				Family        *string `json:"family"`
+				Identifier    *string `json:"identifier"`
+				MarketingName *string `json:"marketing-name"`
+				Number        *string `json:"number"`
+			} `json:"model"`
+			OperatingSystem struct {
+				Family        *string `json:"family"`
+				Version       *string `json:"version"`
+				BuildVersion  *string `json:"build-version"`
+				MarketingName *string `json:"marketing-name"`
+				Supplemental  struct {
+					BuildVersion *string `json:"build-version"`
+					ExtraVersion *string `json:"extra-version"`
+				} `json:"supplemental"`
+			} `json:"operating-system"`
+			Power struct {
+				BatteryHealth *string `json:"battery-health"`
+			} `json:"power"`
+		} `json:"device"`
+		Management struct {
+			Declarations *DeclarationsStatus `json:"declarations"`
+		} `json:"management"`
+	} `json:"StatusItems"`
+	Errors     []StatusError `json:"Errors"`
+	FullReport bool          `json:"FullReport"`
+}
+
+// ParseStatusReport decodes a status report.
+func ParseStatusReport(data []byte) (*StatusReport, error) {
+	var wire statusReportWire
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return nil, fmt.Errorf("ddm: parse status report: %w", err)
+	}
+	if wire.StatusItems == nil {
+		return nil, fmt.Errorf("ddm: status report has no StatusItems")
+	}
+	var raw struct {
+		StatusItems map[string]any `json:"StatusItems"`
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&raw); err != nil {
+		return nil, fmt.Errorf("ddm: parse status report: %w", err)
+	}
+
+	items := wire.StatusItems
+	device, system := items.Device, items.Device.OperatingSystem
+	return &StatusReport{
+		FullReport: wire.FullReport,
+		Device: DeviceStatus{
+			SerialNumber:               device.Identifier.SerialNumber,
+			UDID:                       device.Identifier.UDID,
+			ModelFamily:                device.Model.Family,
+			ModelIdentifier:            device.Model.Identifier,
+			ModelMarketingName:         device.Model.MarketingName,
+			ModelNumber:                device.Model.Number,
+			OSFamily:                   system.Family,
+			OSVersion:                  system.Version,
+			OSBuildVersion:             system.BuildVersion,
+			OSMarketingName:            system.MarketingName,
+			OSSupplementalBuildVersion: system.Supplemental.BuildVersion,
+			OSSupplementalExtraVersion: system.Supplemental.ExtraVersion,
+			BatteryHealth:              device.Power.BatteryHealth,
+		},
+		Declarations: items.Management.Declarations,
+		Errors:       wire.Errors,
+		Items:        raw.StatusItems,
+	}, nil
+}
+
+// Item returns the value of the named status item, such as
+// "passcode.is-compliant", and whether the report includes it. Numbers are
+// json.Number values.
+func (r *StatusReport) Item(name string) (any, bool) {
+	var v any = r.Items
+	for part := range strings.SplitSeq(name, ".") {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if v, ok = m[part]; !ok {
+			return nil, false
+		}
+	}
+	return v, true
+}
+
+// All returns every declaration in the status, across categories.
+func (s *DeclarationsStatus) All() []DeclarationStatus {
+	var all []DeclarationStatus
+	for _, list := range [][]DeclarationStatus{s.Activations, s.Assets, s.Configurations, s.Management} {
+		all = append(all, list...)
+	}
+	return all
+}
+
+// StaleDeclaration is a declaration the device reports with a server token
+// other than the declared one.
+type StaleDeclaration struct {
+	Status DeclarationStatus
+	// ServerToken is the declared server token.
+	ServerToken string
+}
+
+// DeclarationDiff compares a device's declarations status with the
+// declarations it should hold. Each list is sorted by identifier.
+type DeclarationDiff struct {
+	// Missing are declared but not reported by the device.
+	Missing []ManifestItem
+	// Stale are reported with an outdated server token.
+	Stale []StaleDeclaration
+	// Unexpected are reported by the device but no longer declared.
+	Unexpected []DeclarationStatus
+	// Invalid are reported with the declared server token but rejected by
+	// the device; their Reasons say why.
+	Invalid []DeclarationStatus
+	// Inactive are current and valid but not active, such as
+	// configurations no activation references or whose predicate is false.
+	Inactive []DeclarationStatus
+}
+
+// InSync reports whether the device holds exactly the declared set, with
+// every declaration valid. Inactive declarations do not count against it.
+func (d *DeclarationDiff) InSync() bool {
+	return len(d.Missing) == 0 && len(d.Stale) == 0 && len(d.Unexpected) == 0 && len(d.Invalid) == 0
+}
+
+// Diff compares the status with the declaration-items manifest the server
+// serves the device (see NewDeclarationItems). Use the declarations status
+// of a full report (StatusReport.FullReport), or state accumulated from
+// earlier reports; an incremental report may list only the declarations
+// that changed.
+func (s *DeclarationsStatus) Diff(items *DeclarationItems) *DeclarationDiff {
+	declared := map[string]ManifestItem{}
+	m := items.Declarations
+	for _, list := range [][]ManifestItem{m.Activations, m.Assets, m.Configurations, m.Management} {
+		for _, item := range list {
+			declared[item.Identifier] = item
+		}
+	}
+
+	diff := &DeclarationDiff{}
+	reported := map[string]bool{}
+	for _, st := range s.All() {
+		reported[st.Identifier] = true
+		item, ok := declared[st.Identifier]
+		switch {
+		case !ok:
+			diff.Unexpected = append(diff.Unexpected, st)
+		case st.ServerToken != item.ServerToken:
+			diff.Stale = append(diff.Stale, StaleDeclaration{Status: st, ServerToken: item.ServerToken})
+		case st.Valid == ValidityInvalid:
+			diff.Invalid = append(diff.Invalid, st)
+		case !st.Active:
+			diff.Inactive = append(diff.Inactive, st)
+		}
+	}
+	for id, item := range declared {
+		if !reported[id] {
+			diff.Missing = append(diff.Missing, item)
+		}
+	}
+
+	sortItems(diff.Missing)
+	sort.Slice(diff.Stale, func(a, b int) bool { return diff.Stale[a].Status.Identifier < diff.Stale[b].Status.Identifier })
+	for _, list := range [][]DeclarationStatus{diff.Unexpected, diff.Invalid, diff.Inactive} {
+		sort.Slice(list, func(a, b int) bool { return list[a].Identifier < list[b].Identifier })
+	}
+	return diff
+}
@@ -0,0 +1,146 @@
+package ddm
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Declaration categories, keyed by the Type prefix Apple assigns them.
+const (
+	typePrefixActivation    = "com.apple.activation."
+	typePrefixAsset         = "com.apple.asset."
+	typePrefixConfiguration = "com.apple.configuration."
+	typePrefixManagement    = "com.apple.management."
+)
+
+// ManifestItem identifies one declaration in the declaration-items
+// manifest.
+type ManifestItem struct {
+	Identifier  string `json:"Identifier"`
+	ServerToken string `json:"ServerToken"`
+}
+
+// ManifestDeclarations groups manifest items by declaration category.
+type ManifestDeclarations struct {
+	Activations    []ManifestItem `json:"Activations"`
+	Assets         []ManifestItem `json:"Assets"`
+	Configurations []ManifestItem `json:"Configurations"`
+	Management     []ManifestItem `json:"Management"`
+}
+
+// DeclarationItems is the response to a device's
+// declaration-items request: every declaration the device should hold,
+// plus a token summarizing the whole set.
+type DeclarationItems struct {
+	Declarations      ManifestDeclarations `json:"Declarations"`
+	DeclarationsToken string               `json:"DeclarationsToken"`
+}
+
+// SyncTokens carries the declarations token and the time it was issued.
+type SyncTokens struct {
+	DeclarationsToken string    `json:"DeclarationsToken"`
+	Timestamp         time.Time `json:"Timestamp"`
+}
+
+// Tokens is the response to a device's tokens request, and the
+// SyncTokens body of a DeclarativeManagement command's Data.
+type Tokens struct {
+	SyncTokens SyncTokens `json:"SyncTokens"`
+}
+
+// NewDeclarationItems builds the declaration-items manifest for decls.
+// Declarations without a ServerToken are listed under their content hash,
+// matching WithHashedServerToken. Items are sorted by identifier and the
+// DeclarationsToken is the hex SHA-256 of every identifier and server
+// token, so it changes whenever any declaration is added, removed or
+// updated.
+func NewDeclarationItems(decls ...*Declaration) (*DeclarationItems, error) {
+	items := &DeclarationItems{Declarations: ManifestDeclarations{
+		Activations:    []ManifestItem{},
+		Assets:         []ManifestItem{},
+		Configurations: []ManifestItem{},
+		Management:     []ManifestItem{},
+	}}
+	seen := make(map[string]bool, len(decls))
+	all := make([]ManifestItem, 0, len(decls))
+	for _, d := range decls {
+		if d == nil {
+			return nil, fmt.Errorf("ddm: nil declaration")
+		}
+		if seen[d.Identifier] {
+			return nil, fmt.Errorf("ddm: duplicate declaration identifier %q", d.Identifier)
+		}
+		seen[d.Identifier] = true
+
+		item := ManifestItem{Identifier: d.Identifier, ServerToken: d.ServerToken}
+		if item.ServerToken == "" {
+			item.ServerToken = d.Hash()
+		}
+		m := &items.Declarations
+		switch {
+		case strings.HasPrefix(d.Type, typePrefixActivation):
+			m.Activations = append(m.Activations, item)
+		case strings.HasPrefix(d.Type, typePrefixAsset):
+			m.Assets = append(m.Assets, item)
+		case strings.HasPrefix(d.Type, typePrefixConfiguration):
+			m.Configurations = append(m.Configurations, item)
+		case strings.HasPrefix(d.Type, typePrefixManagement):
+			m.Management = append(m.Management, item)
+		default:
+			return nil, fmt.Errorf("ddm: declaration %s has unknown type %q", d.Identifier, d.Type)
+		}
+		all = append(all, item)
+	}
+
+	m := &items.Declarations
+	for _, list := range [][]ManifestItem{m.Activations, m.Assets, m.Configurations, m.Management} {
+		sortItems(list)
+	}
+	sortItems(all)
+	h := sha256.New()
+	for _, item := range all {
+		fmt.Fprintf(h, "%s\x00%s\x00", item.Identifier, item.ServerToken)
+	}
+	items.DeclarationsToken = hex.EncodeToString(h.Sum(nil))
+	return items, nil
+}
+
+// Tokens returns the tokens response for the manifest, stamped with
+// timestamp (truncated to whole seconds, in UTC).
+func (i *DeclarationItems) Tokens(timestamp time.Time) *Tokens {
+	return &Tokens{SyncTokens: SyncTokens{
+		DeclarationsToken: i.DeclarationsToken,
+		Timestamp:         timestamp.UTC().Truncate(time.Second),
+	}}
+}
+
+// JSON renders the manifest as indented DDM JSON.
+func (i *DeclarationItems) JSON() ([]byte, error) {
+	return encodeJSON("declaration-items", i)
+}
+
+// JSON renders the tokens response as indented DDM JSON.
+func (t *Tokens) JSON() ([]byte, error) {
+	return encodeJSON("tokens", t)
+}
+
+func sortItems(items []ManifestItem) {
+	sort.Slice(items, func(a, b int) bool { return items[a].Identifier < items[b].Identifier })
+}
+
+func encodeJSON(name string, v any) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return nil, fmt.Errorf("ddm: encode %s: %w", name, err)
+	}
+	return buf.Bytes(), nil
+}
@@ -10,11 +10,15 @@
 //	decl, _ := ddm.BuildDeclaration("com.example.passcode",
 //	    &configurations.PasscodeSettings{MinimumLength: ptr.To(int64(8))})
 //
-// The generated packages (mdm/commands, mdm/profiles, ddm/configurations,
-// ddm/assets, ddm/activations, ddm/management) honour Apple's spec:
-// required keys are value fields, optional keys are pointers, and every
-// payload's Validate method enforces allowed values, ranges, formats and
-// nested payload keys. Regeneration is driven by cmd/fetchspec (pinned
+// Device-sent plists decode into the same generated types:
+// checkin.Parse types check-in messages and mdm.ParseCommandResult types
+// command acknowledgements, including their error chains.
+//
+// The generated packages (mdm/commands, mdm/checkin, mdm/profiles,
+// ddm/configurations, ddm/assets, ddm/activations, ddm/management) honour
+// Apple's spec: required keys are value fields, optional keys are pointers,
+// and every payload's Validate method enforces allowed values, ranges,
+// formats and nested payload keys. Regeneration is driven by cmd/fetchspec (pinned
 // upstream commit → metadata/specs snapshots) and cmd/gendm (offline
 // codegen); cmd/specdiff renders semantic schema diffs between drops.
 package device_management
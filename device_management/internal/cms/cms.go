@@ -0,0 +1,550 @@
+// Package cms implements the subset of Cryptographic Message Syntax
+// (PKCS #7, RFC 5652) the SDK needs: attached SignedData for signed
+// configuration profiles and SCEP messages, certs-only SignedData, and
+// RSA key-transport EnvelopedData.
+package cms
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/des"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+	"sort"
+
+	_ "crypto/sha1"   // SHA-1 only SCEP servers
+	_ "crypto/sha256" // default digest
+	_ "crypto/sha512" // SHA-384 and SHA-512 signers
+)
+
+// Content encryption algorithms accepted by Envelope.
+var (
+	OIDAES128CBC  = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 2}
+	OIDAES256CBC  = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}
+	OIDDESEDE3CBC = asn1.ObjectIdentifier{1, 2, 840, 113549, 3, 7}
+)
+
+var (
+	oidData                   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	oidSignedData             = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+	oidEnvelopedData          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 3}
+	oidAttributeContentType   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 3}
+	oidAttributeMessageDigest = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 4}
+	oidRSAEncryption          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 1}
+	oidECDSAWithSHA256        = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 2}
+	oidECDSAWithSHA384        = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 3}
+	oidECDSAWithSHA512        = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 4}
+	oidSHA1                   = asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}
+	oidSHA256                 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+	oidSHA384                 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 2}
+	oidSHA512                 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 3}
+)
+
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	// Content is the [0] EXPLICIT wrapper; its Bytes hold the inner value.
+	Content asn1.RawValue `asn1:"optional"`
+}
+
+type rawCertificates struct {
+	Raw asn1.RawContent
+}
+
+type signedData struct {
+	Version          int
+	DigestAlgorithms []pkix.AlgorithmIdentifier `asn1:"set"`
+	ContentInfo      contentInfo
+	Certificates     rawCertificates `asn1:"optional,tag:0"`
+	CRLs             rawCertificates `asn1:"optional,tag:1"`
+	SignerInfos      []signerInfo    `asn1:"set"`
+}
+
+type issuerAndSerial struct {
+	Issuer       asn1.RawValue
+	SerialNumber *big.Int
+}
+
+// Attribute is a signed (authenticated) attribute of a SignerInfo.
+type Attribute struct {
+	Type asn1.ObjectIdentifier
+	// Value is the SET OF attribute values.
+	Value asn1.RawValue
+}
+
+type signerInfo struct {
+	Version                   int
+	IssuerAndSerialNumber     issuerAndSerial
+	DigestAlgorithm           pkix.AlgorithmIdentifier
+	AuthenticatedAttributes   []Attribute `asn1:"optional,omitempty,tag:0"`
+	DigestEncryptionAlgorithm pkix.AlgorithmIdentifier
+	EncryptedDigest           []byte
+	UnauthenticatedAttributes []Attribute `asn1:"optional,omitempty,tag:1"`
+}
+
+type envelopedData struct {
+	Version              int
+	RecipientInfos       []recipientInfo `asn1:"set"`
+	EncryptedContentInfo encryptedContentInfo
+}
+
+type recipientInfo struct {
+	Version                int
+	IssuerAndSerialNumber  issuerAndSerial
+	KeyEncryptionAlgorithm pkix.AlgorithmIdentifier
+	EncryptedKey           []byte
+}
+
+type encryptedContentInfo struct {
+	ContentType                asn1.ObjectIdentifier
+	ContentEncryptionAlgorithm pkix.AlgorithmIdentifier
+	EncryptedContent           []byte `asn1:"optional,tag:0"`
+}
+
+// Message is a decoded SignedData.
+type Message struct {
+	// Content is the encapsulated content; empty for certs-only messages.
+	Content []byte
+	// Certificates are the certificates the message carries.
+	Certificates []*x509.Certificate
+	// Signer is the certificate whose signature Parse verified, or nil when
+	// the message is unsigned or does not carry its signer's certificate.
+	Signer *x509.Certificate
+
+	attributes map[string][]byte
+}
+
+// explicit wraps der in a [0] EXPLICIT tag.
+func explicit(der []byte) asn1.RawValue {
+	return asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: der}
+}
+
+func marshalContentInfo(contentType asn1.ObjectIdentifier, inner []byte) ([]byte, error) {
+	ci := contentInfo{ContentType: contentType}
+	if inner != nil {
+		ci.Content = explicit(inner)
+	}
+	return asn1.Marshal(ci)
+}
+
+// NewAttribute builds an attribute holding one value encoded with
+// encoding/asn1 params (e.g. "printable" for SCEP string attributes).
+func NewAttribute(oid asn1.ObjectIdentifier, value any, params string) (Attribute, error) {
+	der, err := asn1.MarshalWithParams(value, params)
+	if err != nil {
+		return Attribute{}, err
+	}
+	return Attribute{Type: oid, Value: asn1.RawValue{Tag: asn1.TagSet, IsCompound: true, Bytes: der}}, nil
+}
+
+// attributeSet returns the DER SET OF attrs that the signature covers.
+func attributeSet(attrs []Attribute) ([]byte, error) {
+	var body []byte
+	for _, a := range attrs {
+		der, err := asn1.Marshal(a)
+		if err != nil {
+			return nil, err
+		}
+		body = append(body, der...)
+	}
+	return asn1.Marshal(asn1.RawValue{Tag: asn1.TagSet, IsCompound: true, Bytes: body})
+}
+
+func hashForOID(oid asn1.ObjectIdentifier) (crypto.Hash, error) {
+	switch {
+	case oid.Equal(oidSHA1):
+		return crypto.SHA1, nil
+	case oid.Equal(oidSHA256):
+		return crypto.SHA256, nil
+	case oid.Equal(oidSHA384):
+		return crypto.SHA384, nil
+	case oid.Equal(oidSHA512):
+		return crypto.SHA512, nil
+	}
+	return 0, fmt.Errorf("cms: unsupported digest algorithm %s", oid)
+}
+
+func oidForHash(h crypto.Hash) asn1.ObjectIdentifier {
+	switch h {
+	case crypto.SHA1:
+		return oidSHA1
+	case crypto.SHA384:
+		return oidSHA384
+	case crypto.SHA512:
+		return oidSHA512
+	}
+	return oidSHA256
+}
+
+// signatureAlgorithm returns the AlgorithmIdentifier for oid: RSA carries
+// NULL parameters, ECDSA none.
+func signatureAlgorithm(oid asn1.ObjectIdentifier) pkix.AlgorithmIdentifier {
+	if oid.Equal(oidRSAEncryption) {
+		return pkix.AlgorithmIdentifier{Algorithm: oid, Parameters: asn1.NullRawValue}
+	}
+	return pkix.AlgorithmIdentifier{Algorithm: oid}
+}
+
+func digest(h crypto.Hash, data []byte) []byte {
+	d := h.New()
+	d.Write(data)
+	return d.Sum(nil)
+}
+
+// Sign builds an attached SignedData ContentInfo over content, signed with
+// key (RSA or ECDSA) using hash h. The message carries cert followed by
+// chain, and attrs plus the content-type and message-digest attributes are
+// signed.
+func Sign(content []byte, cert *x509.Certificate, key crypto.Signer, h crypto.Hash, attrs []Attribute, chain ...*x509.Certificate) ([]byte, error) {
+	var sigAlg asn1.ObjectIdentifier
+	switch key.Public().(type) {
+	case *rsa.PublicKey:
+		sigAlg = oidRSAEncryption
+	case *ecdsa.PublicKey:
+		switch h {
+		case crypto.SHA384:
+			sigAlg = oidECDSAWithSHA384
+		case crypto.SHA512:
+			sigAlg = oidECDSAWithSHA512
+		default:
+			sigAlg = oidECDSAWithSHA256
+		}
+	default:
+		return nil, fmt.Errorf("cms: unsupported signer key %T", key.Public())
+	}
+
+	contentType, err := NewAttribute(oidAttributeContentType, oidData, "")
+	if err != nil {
+		return nil, err
+	}
+	messageDigest, err := NewAttribute(oidAttributeMessageDigest, digest(h, content), "")
+	if err != nil {
+		return nil, err
+	}
+	attrs = append([]Attribute{contentType, messageDigest}, attrs...)
+
+	// DER orders SET OF members by their encoding.
+	encoded := make([][]byte, len(attrs))
+	for i := range attrs {
+		if encoded[i], err = asn1.Marshal(attrs[i]); err != nil {
+			return nil, err
+		}
+	}
+	order := make([]int, len(attrs))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(a, b int) bool { return bytes.Compare(encoded[order[a]], encoded[order[b]]) < 0 })
+	sorted := make([]Attribute, len(attrs))
+	for i, j := range order {
+		sorted[i] = attrs[j]
+	}
+	attrs = sorted
+
+	signed, err := attributeSet(attrs)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := key.Sign(rand.Reader, digest(h, signed), h)
+	if err != nil {
+		return nil, fmt.Errorf("cms: sign: %w", err)
+	}
+
+	raw := bytes.Clone(cert.Raw)
+	for _, c := range chain {
+		raw = append(raw, c.Raw...)
+	}
+	certs, err := asn1.Marshal(asn1.RawValue{Tag: asn1.TagSet, IsCompound: true, Bytes: raw})
+	if err != nil {
+		return nil, err
+	}
+	encap := asn1.RawValue{Tag: asn1.TagOctetString, Bytes: content}
+	encapDER, err := asn1.Marshal(encap)
+	if err != nil {
+		return nil, err
+	}
+	digestAlg := pkix.AlgorithmIdentifier{Algorithm: oidForHash(h), Parameters: asn1.NullRawValue}
+	sd := signedData{
+		Version:          1,
+		DigestAlgorithms: []pkix.AlgorithmIdentifier{digestAlg},
+		ContentInfo:      contentInfo{ContentType: oidData, Content: explicit(encapDER)},
+		Certificates:     rawCertificates{Raw: certs},
+		SignerInfos: []signerInfo{{
+			Version:                   1,
+			IssuerAndSerialNumber:     issuerAndSerial{Issuer: asn1.RawValue{FullBytes: cert.RawIssuer}, SerialNumber: cert.SerialNumber},
+			DigestAlgorithm:           digestAlg,
+			AuthenticatedAttributes:   attrs,
+			DigestEncryptionAlgorithm: signatureAlgorithm(sigAlg),
+			EncryptedDigest:           signature,
+		}},
+	}
+	der, err := asn1.Marshal(sd)
+	if err != nil {
+		return nil, fmt.Errorf("cms: encode signed data: %w", err)
+	}
+	return marshalContentInfo(oidSignedData, der)
+}
+
+// CertificatesOnly builds a certs-only ("degenerate") SignedData carrying
+// certs, as SCEP uses to deliver certificates.
+func CertificatesOnly(certs []*x509.Certificate) ([]byte, error) {
+	var raw []byte
+	for _, c := range certs {
+		raw = append(raw, c.Raw...)
+	}
+	set, err := asn1.Marshal(asn1.RawValue{Tag: asn1.TagSet, IsCompound: true, Bytes: raw})
+	if err != nil {
+		return nil, err
+	}
+	der, err := asn1.Marshal(signedData{
+		Version:          1,
+		DigestAlgorithms: []pkix.AlgorithmIdentifier{},
+		ContentInfo:      contentInfo{ContentType: oidData},
+		Certificates:     rawCertificates{Raw: set},
+		SignerInfos:      []signerInfo{},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalContentInfo(oidSignedData, der)
+}
+
+// Parse decodes a SignedData ContentInfo. When the message has a signer
+// whose certificate it carries, the message digest and signature are
+// verified and Message.Signer is set; an invalid signature is an error.
+// Certificate chains are not evaluated.
+func Parse(der []byte) (*Message, error) {
+	var ci contentInfo
+	if _, err := asn1.Unmarshal(der, &ci); err != nil {
+		return nil, fmt.Errorf("cms: decode content info: %w", err)
+	}
+	if !ci.ContentType.Equal(oidSignedData) {
+		return nil, fmt.Errorf("cms: content type %s is not signed data", ci.ContentType)
+	}
+	var sd signedData
+	if _, err := asn1.Unmarshal(ci.Content.Bytes, &sd); err != nil {
+		return nil, fmt.Errorf("cms: decode signed data: %w", err)
+	}
+
+	msg := &Message{attributes: map[string][]byte{}}
+	if len(sd.Certificates.Raw) > 0 {
+		var set asn1.RawValue
+		if _, err := asn1.Unmarshal(sd.Certificates.Raw, &set); err != nil {
+			return nil, fmt.Errorf("cms: decode certificates: %w", err)
+		}
+		certs, err := x509.ParseCertificates(set.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("cms: parse certificates: %w", err)
+		}
+		msg.Certificates = certs
+	}
+	if len(sd.ContentInfo.Content.Bytes) > 0 {
+		if _, err := asn1.Unmarshal(sd.ContentInfo.Content.Bytes, &msg.Content); err != nil {
+			return nil, fmt.Errorf("cms: decode signed content: %w", err)
+		}
+	}
+	if len(sd.SignerInfos) == 0 {
+		return msg, nil
+	}
+
+	si := sd.SignerInfos[0]
+	for _, a := range si.AuthenticatedAttributes {
+		msg.attributes[a.Type.String()] = a.Value.Bytes
+	}
+	for _, c := range msg.Certificates {
+		if c.SerialNumber.Cmp(si.IssuerAndSerialNumber.SerialNumber) == 0 &&
+			bytes.Equal(c.RawIssuer, si.IssuerAndSerialNumber.Issuer.FullBytes) {
+			msg.Signer = c
+		}
+	}
+	if msg.Signer == nil {
+		return msg, nil
+	}
+
+	h, err := hashForOID(si.DigestAlgorithm.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+	var wantDigest []byte
+	if v, ok := msg.attributes[oidAttributeMessageDigest.String()]; ok {
+		if _, err := asn1.Unmarshal(v, &wantDigest); err != nil {
+			return nil, fmt.Errorf("cms: decode message digest: %w", err)
+		}
+	}
+	if !bytes.Equal(wantDigest, digest(h, msg.Content)) {
+		return nil, fmt.Errorf("cms: message digest mismatch")
+	}
+	signed, err := attributeSet(si.AuthenticatedAttributes)
+	if err != nil {
+		return nil, err
+	}
+	hashed := digest(h, signed)
+	switch pub := msg.Signer.PublicKey.(type) {
+	case *rsa.PublicKey:
+		if err := rsa.VerifyPKCS1v15(pub, h, hashed, si.EncryptedDigest); err != nil {
+			return nil, fmt.Errorf("cms: verify signature: %w", err)
+		}
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(pub, hashed, si.EncryptedDigest) {
+			return nil, fmt.Errorf("cms: verify signature: ECDSA verification failed")
+		}
+	default:
+		return nil, fmt.Errorf("cms: unsupported signer key %T", msg.Signer.PublicKey)
+	}
+	return msg, nil
+}
+
+// StringAttribute returns a string-valued signed attribute, or "" when
+// absent.
+func (m *Message) StringAttribute(oid asn1.ObjectIdentifier) string {
+	var s string
+	if v, ok := m.attributes[oid.String()]; ok {
+		_, _ = asn1.Unmarshal(v, &s)
+	}
+	return s
+}
+
+// BytesAttribute returns an octet-string signed attribute, or nil when
+// absent.
+func (m *Message) BytesAttribute(oid asn1.ObjectIdentifier) []byte {
+	var b []byte
+	if v, ok := m.attributes[oid.String()]; ok {
+		_, _ = asn1.Unmarshal(v, &b)
+	}
+	return b
+}
+
+// Envelope encrypts content for recipient with contentAlg (one of the
+// OID*CBC algorithms), returning an EnvelopedData ContentInfo.
+func Envelope(content []byte, recipient *x509.Certificate, contentAlg asn1.ObjectIdentifier) ([]byte, error) {
+	pub, ok := recipient.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("cms: recipient key is %T, want RSA", recipient.PublicKey)
+	}
+
+	var keySize, blockSize int
+	switch {
+	case contentAlg.Equal(OIDAES128CBC):
+		keySize, blockSize = 16, aes.BlockSize
+	case contentAlg.Equal(OIDAES256CBC):
+		keySize, blockSize = 32, aes.BlockSize
+	case contentAlg.Equal(OIDDESEDE3CBC):
+		keySize, blockSize = 24, des.BlockSize
+	default:
+		return nil, fmt.Errorf("cms: unsupported content encryption %s", contentAlg)
+	}
+	key := make([]byte, keySize)
+	iv := make([]byte, blockSize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	if _, err := rand.Read(iv); err != nil {
+		return nil, err
+	}
+	block, err := newBlockCipher(contentAlg, key)
+	if err != nil {
+		return nil, err
+	}
+	pad := blockSize - len(content)%blockSize
+	ciphertext := append(bytes.Clone(content), bytes.Repeat([]byte{byte(pad)}, pad)...)
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(ciphertext, ciphertext)
+
+	encryptedKey, err := rsa.EncryptPKCS1v15(rand.Reader, pub, key)
+	if err != nil {
+		return nil, fmt.Errorf("cms: encrypt content key: %w", err)
+	}
+	ivDER, err := asn1.Marshal(iv)
+	if err != nil {
+		return nil, err
+	}
+	der, err := asn1.Marshal(envelopedData{
+		RecipientInfos: []recipientInfo{{
+			IssuerAndSerialNumber:  issuerAndSerial{Issuer: asn1.RawValue{FullBytes: recipient.RawIssuer}, SerialNumber: recipient.SerialNumber},
+			KeyEncryptionAlgorithm: pkix.AlgorithmIdentifier{Algorithm: oidRSAEncryption, Parameters: asn1.NullRawValue},
+			EncryptedKey:           encryptedKey,
+		}},
+		EncryptedContentInfo: encryptedContentInfo{
+			ContentType:                oidData,
+			ContentEncryptionAlgorithm: pkix.AlgorithmIdentifier{Algorithm: contentAlg, Parameters: asn1.RawValue{FullBytes: ivDER}},
+			EncryptedContent:           ciphertext,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cms: encode enveloped data: %w", err)
+	}
+	return marshalContentInfo(oidEnvelopedData, der)
+}
+
+// OpenEnvelope decrypts an EnvelopedData ContentInfo with key.
+func OpenEnvelope(der []byte, key *rsa.PrivateKey) ([]byte, error) {
+	var ci contentInfo
+	if _, err := asn1.Unmarshal(der, &ci); err != nil {
+		return nil, fmt.Errorf("cms: decode content info: %w", err)
+	}
+	if !ci.ContentType.Equal(oidEnvelopedData) {
+		return nil, fmt.Errorf("cms: content type %s is not enveloped data", ci.ContentType)
+	}
+	var ed envelopedData
+	if _, err := asn1.Unmarshal(ci.Content.Bytes, &ed); err != nil {
+		return nil, fmt.Errorf("cms: decode enveloped data: %w", err)
+	}
+	if len(ed.RecipientInfos) == 0 {
+		return nil, fmt.Errorf("cms: enveloped data has no recipients")
+	}
+
+	var contentKey []byte
+	var err error
+	for _, ri := range ed.RecipientInfos {
+		if contentKey, err = rsa.DecryptPKCS1v15(rand.Reader, key, ri.EncryptedKey); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cms: decrypt content key: %w", err)
+	}
+
+	eci := ed.EncryptedContentInfo
+	block, err := newBlockCipher(eci.ContentEncryptionAlgorithm.Algorithm, contentKey)
+	if err != nil {
+		return nil, err
+	}
+	var iv []byte
+	if _, err := asn1.Unmarshal(eci.ContentEncryptionAlgorithm.Parameters.FullBytes, &iv); err != nil || len(iv) != block.BlockSize() {
+		return nil, fmt.Errorf("cms: invalid content encryption IV")
+	}
+	ciphertext := eci.EncryptedContent
+	if len(ciphertext) == 0 || len(ciphertext)%block.BlockSize() != 0 {
+		return nil, fmt.Errorf("cms: invalid encrypted content length %d", len(ciphertext))
+	}
+	plaintext := make([]byte, len(ciphertext))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(plaintext, ciphertext)
+	pad := int(plaintext[len(plaintext)-1])
+	if pad == 0 || pad > block.BlockSize() {
+		return nil, fmt.Errorf("cms: invalid content padding")
+	}
+	return plaintext[:len(plaintext)-pad], nil
+}
+
+func newBlockCipher(alg asn1.ObjectIdentifier, key []byte) (cipher.Block, error) {
+	var block cipher.Block
+	var err error
+	switch {
+	case alg.Equal(OIDAES128CBC), alg.Equal(OIDAES256CBC):
+		block, err = aes.NewCipher(key)
+	case alg.Equal(OIDDESEDE3CBC):
+		block, err = des.NewTripleDESCipher(key)
+	default:
+		return nil, fmt.Errorf("cms: unsupported content encryption %s", alg)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cms: content cipher: %w", err)
+	}
+	return block, nil
+}
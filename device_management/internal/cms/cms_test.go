@@ -0,0 +1,136 @@
+package cms
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+func selfSigned(t *testing.T, key crypto.Signer, cn string) *x509.Certificate {
+	t.Helper()
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(42),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+func TestSignParse(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	oidCustom := asn1.ObjectIdentifier{1, 2, 3, 4}
+
+	for name, tc := range map[string]struct {
+		key  crypto.Signer
+		hash crypto.Hash
+	}{
+		"rsa-sha1":     {rsaKey, crypto.SHA1},
+		"rsa-sha256":   {rsaKey, crypto.SHA256},
+		"ecdsa-sha256": {ecKey, crypto.SHA256},
+		"ecdsa-sha384": {ecKey, crypto.SHA384},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cert := selfSigned(t, tc.key, name)
+			extra := selfSigned(t, tc.key, "intermediate")
+			attr, err := NewAttribute(oidCustom, "hello", "printable")
+			if err != nil {
+				t.Fatal(err)
+			}
+			der, err := Sign([]byte("content"), cert, tc.key, tc.hash, []Attribute{attr}, extra)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			msg, err := Parse(der)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(msg.Content) != "content" || msg.Signer == nil || !msg.Signer.Equal(cert) {
+				t.Fatalf("message = %+v", msg)
+			}
+			if len(msg.Certificates) != 2 || !msg.Certificates[1].Equal(extra) {
+				t.Errorf("certificates = %d", len(msg.Certificates))
+			}
+			if got := msg.StringAttribute(oidCustom); got != "hello" {
+				t.Errorf("attribute = %q", got)
+			}
+
+			tampered := bytes.Replace(der, []byte("content"), []byte("CONTENT"), 1)
+			if _, err := Parse(tampered); err == nil || !strings.Contains(err.Error(), "digest mismatch") {
+				t.Errorf("tampered content: %v", err)
+			}
+		})
+	}
+}
+
+func TestCertificatesOnly(t *testing.T) {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	a, b := selfSigned(t, key, "a"), selfSigned(t, key, "b")
+	der, err := CertificatesOnly([]*x509.Certificate{a, b})
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg, err := Parse(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.Signer != nil || len(msg.Content) != 0 || len(msg.Certificates) != 2 {
+		t.Errorf("message = %+v", msg)
+	}
+}
+
+func TestEnvelope(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recipient := selfSigned(t, key, "recipient")
+	other, _ := rsa.GenerateKey(rand.Reader, 2048)
+
+	for _, alg := range []asn1.ObjectIdentifier{OIDAES128CBC, OIDAES256CBC, OIDDESEDE3CBC} {
+		for _, size := range []int{0, 15, 16, 33} {
+			plaintext := bytes.Repeat([]byte{'x'}, size)
+			der, err := Envelope(plaintext, recipient, alg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := OpenEnvelope(der, key)
+			if err != nil || !bytes.Equal(got, plaintext) {
+				t.Errorf("%s/%d: %q, %v", alg, size, got, err)
+			}
+			if _, err := OpenEnvelope(der, other); err == nil {
+				t.Errorf("%s/%d: opened with the wrong key", alg, size)
+			}
+		}
+	}
+
+	if _, err := Envelope([]byte("x"), recipient, asn1.ObjectIdentifier{1, 2, 3}); err == nil {
+		t.Error("unknown content algorithm accepted")
+	}
+}
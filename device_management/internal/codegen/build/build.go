@@ -74,6 +74,9 @@ func File(s *spec.Spec, pkg string, kind Kind, shared *SharedTypes, usedConsts m
 	if len(s.ResponseKeys) > 0 {
 		respName := claimName(shared, mainName+"Response", "resp:"+s.Category+"/"+s.Name)
 		comment := []string{respName + " models the device response to the " + mainName + " command."}
+		if kind == KindPlain {
+			comment = []string{respName + " models the server response to the " + mainName + " message."}
+		}
 		resp := b.buildStruct(respName, comment, s.ResponseKeys, "", "")
 		f.Structs = append(f.Structs, resp)
 	}
@@ -53,6 +53,14 @@ var families = []family{
 		mapName:     "ByPayloadType",
 		registryDoc: "ByPayloadType maps profile PayloadType identifiers to payload factories.",
 	},
+	{
+		categoryPrefix: "mdm/checkin",
+		dir:            "mdm/checkin", pkg: "checkin",
+		kind:  build.KindPlain,
+		iface: "mdm.CheckinMessage", ifaceImport: modulePath + "/mdm",
+		mapName:     "ByMessageType",
+		registryDoc: "ByMessageType maps check-in MessageType identifiers to message factories.",
+	},
 	{
 		categoryPrefix: "declarative/declarations/configurations",
 		dir:            "ddm/configurations", pkg: "configurations",
@@ -210,8 +218,10 @@ func emitFamily(fam *family, specs []*spec.Spec, outDir string) (int, error) {
 
 		// A few upstream specs share a wire identifier (the com.apple.MCX
 		// profile family); the first spec in sorted order wins the registry
-		// slot, later ones remain reachable as plain structs.
-		if id := s.TypeIdentifier(); id != "" && fam.kind != build.KindPlain && !registered[id] {
+		// slot, later ones remain reachable as plain structs. Plain
+		// families (check-in messages) carry no identifier method but are
+		// still registered by their wire identifier.
+		if id := s.TypeIdentifier(); id != "" && !registered[id] {
 			registered[id] = true
 			reg.Entries = append(reg.Entries, view.RegistryEntry{
 				Identifier: id,
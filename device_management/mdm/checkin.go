@@ -0,0 +1,8 @@
+package mdm
+
+// CheckinMessage is implemented by every generated check-in message struct
+// (Authenticate, TokenUpdate, CheckOut, …) in the checkin package.
+type CheckinMessage interface {
+	// Validate checks the message against Apple's spec.
+	Validate() error
+}
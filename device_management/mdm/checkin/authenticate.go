@@ -0,0 +1,38 @@
+// Code generated by go-api-sdk-apple-dm-codegen. DO NOT EDIT.
+
+package checkin
+
+// Authenticate — Authenticate.
+// Authenticates a user during MDM payload installation.
+//
+// Supported: iOS 4.0+, macOS 10.7+, tvOS 10.2+, visionOS 1.1+, watchOS 10.0+.
+type Authenticate struct {
+	// The device's name.
+	DeviceName string `plist:"DeviceName" json:"DeviceName"`
+	// The device's model name.
+	ModelName string `plist:"ModelName" json:"ModelName"`
+	// The device's model.
+	Model string `plist:"Model" json:"Model"`
+	// The message type, which requires a value of `Authenticate`.
+	MessageType string `plist:"MessageType" json:"MessageType"`
+	// The topic that the device subscribes to.
+	Topic string `plist:"Topic" json:"Topic"`
+	// The device's UDID (unique device identifier). The system requires this value if the enrollment
+	// type is a device enrollment.
+	UDID *string `plist:"UDID,omitempty" json:"UDID,omitempty"`
+	// The per-enrollment identifier for the device. The system requires this value if the enrollment
+	// type is a user enrollment.
+	EnrollmentID *string `plist:"EnrollmentID,omitempty" json:"EnrollmentID,omitempty"`
+	// The device's OS version.
+	OSVersion *string `plist:"OSVersion,omitempty" json:"OSVersion,omitempty"`
+	// The device's build version.
+	BuildVersion *string `plist:"BuildVersion,omitempty" json:"BuildVersion,omitempty"`
+	// The device's product name (such as `iPhone17,2`).
+	ProductName *string `plist:"ProductName,omitempty" json:"ProductName,omitempty"`
+	// The device's serial number.
+	SerialNumber *string `plist:"SerialNumber,omitempty" json:"SerialNumber,omitempty"`
+	// The device's IMEI (International Mobile Equipment Identity).
+	IMEI *string `plist:"IMEI,omitempty" json:"IMEI,omitempty"`
+	// The device's MEID (Mobile Equipment Identifier).
+	MEID *string `plist:"MEID,omitempty" json:"MEID,omitempty"`
+}
@@ -0,0 +1,20 @@
+// Code generated by go-api-sdk-apple-dm-codegen. DO NOT EDIT.
+
+package checkin
+
+// CheckOut — Check Out.
+// Responds to the removal of the MDM enrollment profile from a device.
+//
+// Supported: iOS 4.0+, macOS 10.7+, tvOS 10.2+, visionOS 1.1+, watchOS 10.0+.
+type CheckOut struct {
+	// The message type, which requires a value of `CheckOut`.
+	MessageType string `plist:"MessageType" json:"MessageType"`
+	// The topic the device subscribes to.
+	Topic string `plist:"Topic" json:"Topic"`
+	// The device's UDID (unique device identifier). The system requires this value if the enrollment
+	// type is a device enrollment.
+	UDID string `plist:"UDID" json:"UDID"`
+	// The per-enrollment identifier for the device. The system requires this value if the enrollment
+	// type is a user enrollment.
+	EnrollmentID string `plist:"EnrollmentID" json:"EnrollmentID"`
+}
@@ -0,0 +1,31 @@
+// Code generated by go-api-sdk-apple-dm-codegen. DO NOT EDIT.
+
+package checkin
+
+// DeclarativeManagement — Declarative Management.
+// Sends declarative management requests to the server.
+//
+// Supported: iOS 15.0+, macOS 13.0+, tvOS 16.0+, visionOS 1.1+, watchOS 10.0+.
+type DeclarativeManagement struct {
+	// The message type, which requires a value of `DeclarativeManagement`.
+	MessageType string `plist:"MessageType" json:"MessageType"`
+	// The type of operation the declaration is requesting. This key needs to be one of these values:
+	Endpoint string `plist:"Endpoint" json:"Endpoint"`
+	// A Base64-encoded JSON object using the `SynchronizationTokens` schema.
+	Data []byte `plist:"Data,omitempty" json:"Data,omitempty"`
+	// The device's UDID (unique device identifier). The system requires this value if the enrollment
+	// type is a device enrollment.
+	UDID string `plist:"UDID" json:"UDID"`
+	// The per-enrollment identifier for the device. The system requires this value if the enrollment
+	// type is a user enrollment.
+	EnrollmentID string `plist:"EnrollmentID" json:"EnrollmentID"`
+	// The per-enrollment identifier for the user. The system requires this value if the enrollment
+	// type is a user enrollment on the user channel.
+	EnrollmentUserID string `plist:"EnrollmentUserID" json:"EnrollmentUserID"`
+	// For macOS, this value is the short name of the user.
+	UserShortName *string `plist:"UserShortName,omitempty" json:"UserShortName,omitempty"`
+	// For macOS, this value is the ID of the user.
+	UserID *string `plist:"UserID,omitempty" json:"UserID,omitempty"`
+	// The full name of the user.
+	UserLongName string `plist:"UserLongName" json:"UserLongName"`
+}
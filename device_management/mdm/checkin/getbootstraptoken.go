@@ -0,0 +1,23 @@
+// Code generated by go-api-sdk-apple-dm-codegen. DO NOT EDIT.
+
+package checkin
+
+// GetBootstrapToken — Get Bootstrap Token.
+// Gets the bootstrap token from the server.
+//
+// Supported: iOS 26.0+, macOS 10.15+, visionOS 26.0+.
+type GetBootstrapToken struct {
+	// The message type, which requires a value of `GetBootstrapToken`.
+	MessageType string `plist:"MessageType" json:"MessageType"`
+	// If `true`, the device is awaiting a `Device-Configured-Command` command before proceeding
+	// through Setup Assistant.
+	//
+	// Default: false.
+	AwaitingConfiguration *bool `plist:"AwaitingConfiguration,omitempty" json:"AwaitingConfiguration,omitempty"`
+}
+
+// GetBootstrapTokenResponse models the server response to the GetBootstrapToken message.
+type GetBootstrapTokenResponse struct {
+	// The current bootstrap token data for the device.
+	BootstrapToken []byte `plist:"BootstrapToken,omitempty" json:"BootstrapToken,omitempty"`
+}
@@ -0,0 +1,51 @@
+// Code generated by go-api-sdk-apple-dm-codegen. DO NOT EDIT.
+
+package checkin
+
+// GetToken — Get Token.
+// Gets a token from the server.
+//
+// Supported: iOS 17.0+, macOS 14.0+, visionOS 1.1+.
+type GetToken struct {
+	// The message type, which requires a value of `GetToken`.
+	MessageType string `plist:"MessageType" json:"MessageType"`
+	// A string that specifies the service for the requested token.
+	TokenServiceType GetTokenTokenServiceType `plist:"TokenServiceType" json:"TokenServiceType"`
+	// Parameters that the system uses to generate the token.
+	TokenParameters *GetTokenTokenParameters `plist:"TokenParameters,omitempty" json:"TokenParameters,omitempty"`
+	// The device's UDID (unique device identifier). The system requires this value if the enrollment
+	// type is a device enrollment.
+	UDID string `plist:"UDID" json:"UDID"`
+	// The per-enrollment identifier for the device. The system requires this value if the enrollment
+	// type is a user enrollment.
+	EnrollmentID string `plist:"EnrollmentID" json:"EnrollmentID"`
+	// The per-enrollment identifier for the user. The system requires this value if the enrollment
+	// type is a user enrollment on the user channel.
+	EnrollmentUserID string `plist:"EnrollmentUserID" json:"EnrollmentUserID"`
+	// For macOS, this value is the short name of the user.
+	UserShortName *string `plist:"UserShortName,omitempty" json:"UserShortName,omitempty"`
+	// For macOS, this value is the ID of the user.
+	UserID *string `plist:"UserID,omitempty" json:"UserID,omitempty"`
+	// The full name of the user.
+	UserLongName string `plist:"UserLongName" json:"UserLongName"`
+}
+
+// GetTokenResponse models the server response to the GetToken message.
+type GetTokenResponse struct {
+	// The token data. If the token is a string value, it needs to be a UTF-8-encoded string.
+	TokenData []byte `plist:"TokenData" json:"TokenData"`
+}
+
+// GetTokenTokenParameters is the TokenParameters dictionary.
+// Parameters that the system uses to generate the token.
+type GetTokenTokenParameters struct {
+	// A security token to generate the server token. Required by the `com.apple.watch.pairing` service
+	// type.
+	SecurityToken *string `plist:"SecurityToken,omitempty" json:"SecurityToken,omitempty"`
+	// The identifier of the phone paired to the watch. Required by the `com.apple.watch.pairing`
+	// service type.
+	PhoneUDID *string `plist:"PhoneUDID,omitempty" json:"PhoneUDID,omitempty"`
+	// The identifier of the watch paired to the phone. Required by the `com.apple.watch.pairing`
+	// service type.
+	WatchUDID *string `plist:"WatchUDID,omitempty" json:"WatchUDID,omitempty"`
+}
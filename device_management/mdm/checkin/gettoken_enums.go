@@ -0,0 +1,15 @@
+// Code generated by go-api-sdk-apple-dm-codegen. DO NOT EDIT.
+
+package checkin
+
+// GetTokenTokenServiceType — allowed values for GetToken.TokenServiceType.
+type GetTokenTokenServiceType string
+
+// GetTokenTokenServiceType allowed values.
+const (
+	GetTokenTokenServiceTypeComAppleMaid         GetTokenTokenServiceType = "com.apple.maid"
+	GetTokenTokenServiceTypeComAppleWatchPairing GetTokenTokenServiceType = "com.apple.watch.pairing"
+)
+
+// String returns the GetTokenTokenServiceType value as a plain string.
+func (e GetTokenTokenServiceType) String() string { return string(e) }
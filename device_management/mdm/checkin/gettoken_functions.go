@@ -0,0 +1,41 @@
+// Code generated by go-api-sdk-apple-dm-codegen. DO NOT EDIT.
+
+package checkin
+
+import (
+	"errors"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/device_management/validate"
+)
+
+// Validate checks the payload against Apple's spec: required keys,
+// allowed values, ranges, formats and nested payload keys.
+func (p *GetToken) Validate() error {
+	var errs []error
+	if err := validate.InList("MessageType", p.MessageType, []string{"GetToken"}); err != nil {
+		errs = append(errs, err)
+	}
+	if err := validate.InList("TokenServiceType", p.TokenServiceType, []GetTokenTokenServiceType{GetTokenTokenServiceTypeComAppleMaid, GetTokenTokenServiceTypeComAppleWatchPairing}); err != nil {
+		errs = append(errs, err)
+	}
+	if p.TokenParameters != nil {
+		if err := p.TokenParameters.Validate(); err != nil {
+			errs = append(errs, validate.Nested("TokenParameters", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks the payload against Apple's spec: required keys,
+// allowed values, ranges, formats and nested payload keys.
+func (p *GetTokenResponse) Validate() error {
+	var errs []error
+	if p.TokenData == nil {
+		errs = append(errs, validate.Required("TokenData"))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks the payload against Apple's spec. GetTokenTokenParameters has no
+// constraints beyond its field types.
+func (p *GetTokenTokenParameters) Validate() error { return nil }
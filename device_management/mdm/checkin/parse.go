@@ -0,0 +1,46 @@
+package checkin
+
+import (
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/device_management/mdm"
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/plistdec"
+)
+
+// Parse decodes a check-in request body (the plist a device PUTs to the
+// CheckInURL), dispatches on MessageType to the matching generated struct
+// and validates it. Callers type-switch on the result:
+//
+//	msg, err := checkin.Parse(body)
+//	switch m := msg.(type) {
+//	case *checkin.Authenticate: …
+//	case *checkin.TokenUpdate:  …
+//	case *checkin.CheckOut:     …
+//	}
+func Parse(data []byte) (mdm.CheckinMessage, error) {
+	value, err := plistdec.Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("checkin: %w", err)
+	}
+	dict, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("checkin: message is not a dict")
+	}
+	messageType, _ := dict["MessageType"].(string)
+	if messageType == "" {
+		return nil, fmt.Errorf("checkin: message has no MessageType")
+	}
+	factory, ok := ByMessageType[messageType]
+	if !ok {
+		return nil, fmt.Errorf("checkin: unknown MessageType %q", messageType)
+	}
+
+	msg := factory()
+	if err := plistdec.Assign(dict, msg); err != nil {
+		return nil, fmt.Errorf("checkin: %s: %w", messageType, err)
+	}
+	if err := msg.Validate(); err != nil {
+		return nil, fmt.Errorf("checkin: invalid %s message: %w", messageType, err)
+	}
+	return msg, nil
+}
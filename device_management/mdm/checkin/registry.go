@@ -0,0 +1,21 @@
+// Code generated by go-api-sdk-apple-dm-codegen. DO NOT EDIT.
+
+package checkin
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-apple/device_management/mdm"
+)
+
+// ByMessageType maps check-in MessageType identifiers to message factories.
+// Factories return zero payloads ready to populate or decode into.
+var ByMessageType = map[string]func() mdm.CheckinMessage{
+	"Authenticate":          func() mdm.CheckinMessage { return new(Authenticate) },
+	"CheckOut":              func() mdm.CheckinMessage { return new(CheckOut) },
+	"DeclarativeManagement": func() mdm.CheckinMessage { return new(DeclarativeManagement) },
+	"GetBootstrapToken":     func() mdm.CheckinMessage { return new(GetBootstrapToken) },
+	"GetToken":              func() mdm.CheckinMessage { return new(GetToken) },
+	"ReturnToService":       func() mdm.CheckinMessage { return new(ReturnToService) },
+	"SetBootstrapToken":     func() mdm.CheckinMessage { return new(SetBootstrapToken) },
+	"TokenUpdate":           func() mdm.CheckinMessage { return new(TokenUpdate) },
+	"UserAuthenticate":      func() mdm.CheckinMessage { return new(UserAuthenticate) },
+}
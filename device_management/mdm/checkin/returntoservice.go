@@ -0,0 +1,43 @@
+// Code generated by go-api-sdk-apple-dm-codegen. DO NOT EDIT.
+
+package checkin
+
+// ReturnToService — Return To Service.
+// Gets the return-to-service configuration from the server.
+//
+// Supported: iOS 26.0+, visionOS 26.0+.
+type ReturnToService struct {
+	// The message type, which requires a value of `ReturnToService`.
+	MessageType string `plist:"MessageType" json:"MessageType"`
+	// The device's UDID (unique device identifier). The system requires this value if the enrollment
+	// type is a device enrollment.
+	UDID string `plist:"UDID" json:"UDID"`
+}
+
+// ReturnToServiceResponse models the server response to the ReturnToService message.
+type ReturnToServiceResponse struct {
+	// If `true`, the device preserves the data plan on an iPhone or iPad with eSIM functionality, if
+	// one exists. This value is available in iOS 26.4 and later.
+	//
+	// Default: false.
+	PreserveDataPlan *bool `plist:"PreserveDataPlan,omitempty" json:"PreserveDataPlan,omitempty"`
+	// A dictionary containing the configuration for return to service.
+	ReturnToService ReturnToServiceResponseReturnToService `plist:"ReturnToService" json:"ReturnToService"`
+}
+
+// ReturnToServiceResponseReturnToService is the ReturnToService dictionary.
+// A dictionary containing the configuration for return to service.
+type ReturnToServiceResponseReturnToService struct {
+	// If `true`, the device automatically erases itself and then performs reenrollment.
+	Enabled bool `plist:"Enabled" json:"Enabled"`
+	// The Wi-Fi profile that installs after erasure when using return to service. This is required
+	// when the device doesn't have Ethernet access.
+	WiFiProfileData []byte `plist:"WiFiProfileData,omitempty" json:"WiFiProfileData,omitempty"`
+	// The MDM profile that installs after erasure when using return to service. If provided, the
+	// device uses this profile directly instead of fetching it from the server. This key is required
+	// if the device's Automated Device Enrollment profile contains the `configuration-web-url` key.
+	MDMProfileData []byte `plist:"MDMProfileData,omitempty" json:"MDMProfileData,omitempty"`
+	// The system uses the bootstrap token for return to service with app preservation. Required when
+	// Automated Device Enrollment enables return to service for the device.
+	BootstrapToken []byte `plist:"BootstrapToken,omitempty" json:"BootstrapToken,omitempty"`
+}
@@ -0,0 +1,33 @@
+// Code generated by go-api-sdk-apple-dm-codegen. DO NOT EDIT.
+
+package checkin
+
+import (
+	"errors"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/device_management/validate"
+)
+
+// Validate checks the payload against Apple's spec: required keys,
+// allowed values, ranges, formats and nested payload keys.
+func (p *ReturnToService) Validate() error {
+	var errs []error
+	if err := validate.InList("MessageType", p.MessageType, []string{"ReturnToService"}); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks the payload against Apple's spec: required keys,
+// allowed values, ranges, formats and nested payload keys.
+func (p *ReturnToServiceResponse) Validate() error {
+	var errs []error
+	if err := p.ReturnToService.Validate(); err != nil {
+		errs = append(errs, validate.Nested("ReturnToService", err))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks the payload against Apple's spec. ReturnToServiceResponseReturnToService has no
+// constraints beyond its field types.
+func (p *ReturnToServiceResponseReturnToService) Validate() error { return nil }
@@ -0,0 +1,20 @@
+// Code generated by go-api-sdk-apple-dm-codegen. DO NOT EDIT.
+
+package checkin
+
+// SetBootstrapToken — Set Bootstrap Token.
+// Sends the bootstrap token to the server.
+//
+// Supported: iOS 26.0+, macOS 10.15+, visionOS 26.0+.
+type SetBootstrapToken struct {
+	// The message type, which requires a value of `SetBootstrapToken`.
+	MessageType string `plist:"MessageType" json:"MessageType"`
+	// The device's bootstrap token data. If this field is missing or zero length, the server needs to
+	// remove the bootstrap token for this device.
+	BootstrapToken []byte `plist:"BootstrapToken,omitempty" json:"BootstrapToken,omitempty"`
+	// If `true`, the device is awaiting a `Device-Configured-Command` command before proceeding
+	// through Setup Assistant.
+	//
+	// Default: false.
+	AwaitingConfiguration *bool `plist:"AwaitingConfiguration,omitempty" json:"AwaitingConfiguration,omitempty"`
+}
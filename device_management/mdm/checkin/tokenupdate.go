@@ -0,0 +1,43 @@
+// Code generated by go-api-sdk-apple-dm-codegen. DO NOT EDIT.
+
+package checkin
+
+// TokenUpdate — Token Update.
+// Updates the token for a device on the server.
+//
+// Supported: iOS 4.0+, macOS 10.7+, tvOS 10.2+, visionOS 1.1+, watchOS 10.0+.
+type TokenUpdate struct {
+	// If `true`, the device isn't on-console.
+	NotOnConsole bool `plist:"NotOnConsole" json:"NotOnConsole"`
+	// The message type, which requires a value of `TokenUpdate`.
+	MessageType string `plist:"MessageType" json:"MessageType"`
+	// The topic the device subscribes to.
+	Topic string `plist:"Topic" json:"Topic"`
+	// The device's UDID (unique device identifier). The system requires this value if the enrollment
+	// type is a device enrollment.
+	UDID string `plist:"UDID" json:"UDID"`
+	// The per-enrollment identifier for the device. The system requires this value if the enrollment
+	// type is a user enrollment.
+	EnrollmentID string `plist:"EnrollmentID" json:"EnrollmentID"`
+	// The per-enrollment identifier for the user. The system requires this value if the enrollment
+	// type is a user enrollment on the user channel.
+	EnrollmentUserID string `plist:"EnrollmentUserID" json:"EnrollmentUserID"`
+	// For macOS, this value is the short name of the user.
+	UserShortName *string `plist:"UserShortName,omitempty" json:"UserShortName,omitempty"`
+	// For macOS, this value is the ID of the user.
+	UserID *string `plist:"UserID,omitempty" json:"UserID,omitempty"`
+	// The full name of the user.
+	UserLongName string `plist:"UserLongName" json:"UserLongName"`
+	// The push token for the device.
+	Token []byte `plist:"Token" json:"Token"`
+	// The magic string to include in the push notification message.
+	PushMagic string `plist:"PushMagic" json:"PushMagic"`
+	// The data to use to unlock the device. If provided, the server needs to retain this data and send
+	// it when trying to implement `Clear-Passcode-Command`.
+	UnlockToken []byte `plist:"UnlockToken,omitempty" json:"UnlockToken,omitempty"`
+	// If `true` from the device channel, the device is awaiting a `Device-Configured-Command` command
+	// before proceeding through Setup Assistant.
+	//
+	// Default: false.
+	AwaitingConfiguration *bool `plist:"AwaitingConfiguration,omitempty" json:"AwaitingConfiguration,omitempty"`
+}
@@ -0,0 +1,22 @@
+// Code generated by go-api-sdk-apple-dm-codegen. DO NOT EDIT.
+
+package checkin
+
+import (
+	"errors"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/device_management/validate"
+)
+
+// Validate checks the payload against Apple's spec: required keys,
+// allowed values, ranges, formats and nested payload keys.
+func (p *TokenUpdate) Validate() error {
+	var errs []error
+	if err := validate.InList("MessageType", p.MessageType, []string{"TokenUpdate"}); err != nil {
+		errs = append(errs, err)
+	}
+	if p.Token == nil {
+		errs = append(errs, validate.Required("Token"))
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,20 @@
+// Code generated by go-api-sdk-apple-dm-codegen. DO NOT EDIT.
+
+package checkin
+
+// UserAuthenticate — User Authenticate.
+// Authenticates a user with a two-step authentication protocol.
+//
+// Supported: macOS 10.7+.
+type UserAuthenticate struct {
+	// The message type, which requires a value of `UserAuthenticate`.
+	MessageType string `plist:"MessageType" json:"MessageType"`
+	// The device's UDID (unique device identifier). The system requires this value if the enrollment
+	// type is a device enrollment.
+	UDID string `plist:"UDID" json:"UDID"`
+	// The local mobile user's GUID or the network user's GUID from an Open Directory record.
+	UserID string `plist:"UserID" json:"UserID"`
+	// A string that the client provides in the second `User-Authenticate` request after receiving
+	// `DigestChallenge` from the server on the first `User-Authenticate` request.
+	DigestResponse string `plist:"DigestResponse" json:"DigestResponse"`
+}
@@ -0,0 +1,19 @@
+// Code generated by go-api-sdk-apple-dm-codegen. DO NOT EDIT.
+
+package checkin
+
+import (
+	"errors"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/device_management/validate"
+)
+
+// Validate checks the payload against Apple's spec: required keys,
+// allowed values, ranges, formats and nested payload keys.
+func (p *UserAuthenticate) Validate() error {
+	var errs []error
+	if err := validate.InList("MessageType", p.MessageType, []string{"UserAuthenticate"}); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,248 @@
+package profiles
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/plistdec"
+)
+
+// Vars are the values a Template substitutes for one device. Templates
+// refer to them as {{.SerialNumber}}, {{.User.Email}}, {{.Org.Name}} or
+// {{.Values.site}}.
+type Vars struct {
+	SerialNumber string
+	UDID         string
+	DeviceName   string
+	User         UserVars
+	Org          OrgVars
+
+	// Values holds any other values, by name. A template that names a
+	// missing key fails rather than rendering an empty string.
+	Values map[string]any
+}
+
+// UserVars describe the device's assigned user.
+type UserVars struct {
+	Username string
+	FullName string
+	Email    string
+}
+
+// OrgVars describe the organization that owns the device.
+type OrgVars struct {
+	Name       string
+	Identifier string
+	Email      string
+	Phone      string
+}
+
+// TemplateOption customizes template parsing.
+type TemplateOption func(*templateConfig)
+
+type templateConfig struct {
+	funcs     template.FuncMap
+	fragments []templateFragment
+}
+
+type templateFragment struct {
+	name, src string
+}
+
+// WithFragment adds a payload fragment that the template, and other
+// fragments, include with {{template "name" .}}. A fragment is typically one
+// PayloadContent <dict>, so payloads shared by many profiles are written
+// once.
+func WithFragment(name, src string) TemplateOption {
+	return func(c *templateConfig) { c.fragments = append(c.fragments, templateFragment{name, src}) }
+}
+
+// WithFuncs adds functions the template and its fragments can call,
+// alongside the text/template builtins and uuid.
+func WithFuncs(funcs template.FuncMap) TemplateOption {
+	return func(c *templateConfig) {
+		if c.funcs == nil {
+			c.funcs = template.FuncMap{}
+		}
+		for name, fn := range funcs {
+			c.funcs[name] = fn
+		}
+	}
+}
+
+// Template renders per-device .mobileconfig files from one configuration
+// profile written as a Go text/template. Every action's output is XML
+// escaped, so values cannot break out of the <string> they are placed in.
+// Besides the text/template builtins, templates can call uuid, which derives
+// a stable PayloadUUID from its arguments:
+//
+//	<key>PayloadUUID</key><string>{{uuid "com.example.wifi" .SerialNumber}}</string>
+//
+// A Template is safe for concurrent use.
+type Template struct {
+	name string
+	tmpl *template.Template
+}
+
+// ParseTemplate parses a configuration profile template and its fragments.
+// Fragment names must differ from each other and from name.
+func ParseTemplate(name, src string, opts ...TemplateOption) (*Template, error) {
+	var cfg templateConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	t := template.New(name).Option("missingkey=error").
+		Funcs(template.FuncMap{"uuid": templateUUID}).
+		Funcs(cfg.funcs).
+		Funcs(template.FuncMap{escapeFuncName: escapeXML})
+	seen := map[string]bool{name: true}
+	for _, f := range cfg.fragments {
+		if seen[f.name] {
+			return nil, fmt.Errorf("profiles: template %s: fragment %q is defined twice", name, f.name)
+		}
+		seen[f.name] = true
+		if _, err := t.New(f.name).Parse(f.src); err != nil {
+			return nil, fmt.Errorf("profiles: template %s: %w", name, err)
+		}
+	}
+	if _, err := t.Parse(src); err != nil {
+		return nil, fmt.Errorf("profiles: template %s: %w", name, err)
+	}
+	for _, tt := range t.Templates() {
+		if tt.Tree != nil {
+			escapeActions(tt.Tree.Root)
+		}
+	}
+	return &Template{name: name, tmpl: t}, nil
+}
+
+// Render executes the template for one device and checks the result: it must
+// be a well-formed Configuration profile with a PayloadIdentifier and
+// PayloadUUID, and each payload of a known PayloadType must pass Validate.
+func (t *Template) Render(v Vars) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := t.tmpl.Execute(&buf, v); err != nil {
+		return nil, fmt.Errorf("profiles: template %s: %w", t.name, err)
+	}
+	if err := checkProfile(buf.Bytes()); err != nil {
+		return nil, fmt.Errorf("profiles: template %s: %w", t.name, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// RenderAll renders the template for each device. out[i] is the profile for
+// vars[i], or nil if it failed; the failures are joined in the error, each
+// naming the device's serial number.
+func (t *Template) RenderAll(vars []Vars) ([][]byte, error) {
+	out := make([][]byte, len(vars))
+	var errs []error
+	for i, v := range vars {
+		doc, err := t.Render(v)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("device %d (%s): %w", i, v.SerialNumber, err))
+			continue
+		}
+		out[i] = doc
+	}
+	return out, errors.Join(errs...)
+}
+
+// escapeFuncName is the function escapeActions appends to every action.
+const escapeFuncName = "_xmlescape"
+
+// escapeActions appends the XML escaper to the pipeline of every action
+// below n that prints a value.
+func escapeActions(n parse.Node) {
+	switch n := n.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, c := range n.Nodes {
+			escapeActions(c)
+		}
+	case *parse.ActionNode:
+		if len(n.Pipe.Decl) == 0 {
+			n.Pipe.Cmds = append(n.Pipe.Cmds, &parse.CommandNode{
+				NodeType: parse.NodeCommand,
+				Pos:      n.Pos,
+				Args:     []parse.Node{parse.NewIdentifier(escapeFuncName).SetPos(n.Pos)},
+			})
+		}
+	case *parse.IfNode:
+		escapeActions(n.List)
+		escapeActions(n.ElseList)
+	case *parse.RangeNode:
+		escapeActions(n.List)
+		escapeActions(n.ElseList)
+	case *parse.WithNode:
+		escapeActions(n.List)
+		escapeActions(n.ElseList)
+	}
+}
+
+func escapeXML(v any) string {
+	var b strings.Builder
+	xml.EscapeText(&b, fmt.Append(nil, v))
+	return b.String()
+}
+
+// templateUUID derives a UUID from its arguments, as mdm.NewProfile does for
+// payload UUIDs, so the same device gets the same UUID on every render.
+func templateUUID(parts ...any) string {
+	h := sha256.New()
+	for _, p := range parts {
+		fmt.Fprintf(h, "%v\x00", p)
+	}
+	s := h.Sum(nil)
+	return fmt.Sprintf("%X-%X-%X-%X-%X", s[0:4], s[4:6], s[6:8], s[8:10], s[10:16])
+}
+
+// checkProfile parses a rendered profile and validates its payloads.
+func checkProfile(doc []byte) error {
+	value, err := plistdec.Parse(doc)
+	if err != nil {
+		return err
+	}
+	root, ok := value.(map[string]any)
+	if !ok {
+		return fmt.Errorf("profile is not a dictionary")
+	}
+	if root["PayloadType"] != "Configuration" {
+		return fmt.Errorf("PayloadType is %v, want Configuration", root["PayloadType"])
+	}
+	for _, key := range []string{"PayloadIdentifier", "PayloadUUID"} {
+		if s, _ := root[key].(string); s == "" {
+			return fmt.Errorf("%s is required", key)
+		}
+	}
+	content, _ := root["PayloadContent"].([]any)
+	var errs []error
+	for i, c := range content {
+		entry, ok := c.(map[string]any)
+		if !ok {
+			errs = append(errs, fmt.Errorf("payload %d is not a dictionary", i))
+			continue
+		}
+		payloadType, _ := entry["PayloadType"].(string)
+		factory, ok := ByPayloadType[payloadType]
+		if !ok {
+			continue
+		}
+		p := factory()
+		if err := plistdec.Assign(entry, p); err != nil {
+			errs = append(errs, fmt.Errorf("payload %d (%s): %w", i, payloadType, err))
+			continue
+		}
+		if err := p.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("payload %d: invalid %s payload: %w", i, payloadType, err))
+		}
+	}
+	return errors.Join(errs...)
+}
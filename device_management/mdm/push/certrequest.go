@@ -0,0 +1,145 @@
+package push
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/plistdec"
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/plistenc"
+)
+
+// CertificateRequest is the vendor-signed push certificate request an MDM
+// operator uploads to the Apple Push Certificates Portal
+// (identity.apple.com) to obtain or renew an MDM push certificate.
+type CertificateRequest struct {
+	// CSR is the customer's DER certificate signing request.
+	CSR *x509.CertificateRequest
+	// Chain is the MDM vendor certificate followed by its issuers.
+	Chain []*x509.Certificate
+	// Signature is the vendor's SHA-256 RSA signature over the CSR.
+	Signature []byte
+}
+
+// NewPushCSR creates the customer-side DER certificate signing request for
+// an MDM push certificate. subject usually carries a CommonName and the
+// operator's email address; the portal takes identity from the vendor
+// signature, not from the subject.
+func NewPushCSR(key *rsa.PrivateKey, subject pkix.Name) ([]byte, error) {
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:            subject,
+		SignatureAlgorithm: x509.SHA256WithRSA,
+	}, key)
+	if err != nil {
+		return nil, fmt.Errorf("push: create CSR: %w", err)
+	}
+	return der, nil
+}
+
+// SignCertificateRequest signs a customer CSR with the MDM vendor's signing
+// certificate and key and returns the base64-encoded request plist, ready
+// to upload to identity.apple.com. chain must list the vendor certificate's
+// issuers: the Apple Worldwide Developer Relations intermediate and the
+// Apple root.
+func SignCertificateRequest(csr []byte, vendorCert *x509.Certificate, vendorKey *rsa.PrivateKey, chain ...*x509.Certificate) ([]byte, error) {
+	if _, err := x509.ParseCertificateRequest(csr); err != nil {
+		return nil, fmt.Errorf("push: parse CSR: %w", err)
+	}
+	if vendorCert == nil || vendorKey == nil {
+		return nil, fmt.Errorf("push: vendor certificate and key are required")
+	}
+	if !vendorKey.PublicKey.Equal(vendorCert.PublicKey) {
+		return nil, fmt.Errorf("push: vendor key does not match certificate %q", subjectName(vendorCert.Subject))
+	}
+
+	hashed := sha256.Sum256(csr)
+	signature, err := rsa.SignPKCS1v15(rand.Reader, vendorKey, crypto.SHA256, hashed[:])
+	if err != nil {
+		return nil, fmt.Errorf("push: sign CSR: %w", err)
+	}
+
+	var pemChain bytes.Buffer
+	for _, cert := range append([]*x509.Certificate{vendorCert}, chain...) {
+		if err := pem.Encode(&pemChain, &pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}); err != nil {
+			return nil, fmt.Errorf("push: encode chain: %w", err)
+		}
+	}
+
+	doc, err := plistenc.Document(plistenc.Dict{
+		{Key: "PushCertRequestCSR", Value: base64.StdEncoding.EncodeToString(csr)},
+		{Key: "PushCertCertificateChain", Value: pemChain.String()},
+		{Key: "PushCertSignature", Value: base64.StdEncoding.EncodeToString(signature)},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("push: encode request: %w", err)
+	}
+	out := make([]byte, base64.StdEncoding.EncodedLen(len(doc)))
+	base64.StdEncoding.Encode(out, doc)
+	return out, nil
+}
+
+// ParseCertificateRequest decodes a base64 request produced by
+// SignCertificateRequest and checks the vendor signature against the first
+// certificate in its chain, so a request can be validated before upload.
+func ParseCertificateRequest(encoded []byte) (*CertificateRequest, error) {
+	doc, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(encoded)))
+	if err != nil {
+		return nil, fmt.Errorf("push: decode request: %w", err)
+	}
+	var fields struct {
+		CSR       string `plist:"PushCertRequestCSR"`
+		Chain     string `plist:"PushCertCertificateChain"`
+		Signature string `plist:"PushCertSignature"`
+	}
+	if err := plistdec.Unmarshal(doc, &fields); err != nil {
+		return nil, fmt.Errorf("push: decode request: %w", err)
+	}
+
+	csrDER, err := base64.StdEncoding.DecodeString(fields.CSR)
+	if err != nil {
+		return nil, fmt.Errorf("push: decode PushCertRequestCSR: %w", err)
+	}
+	csr, err := x509.ParseCertificateRequest(csrDER)
+	if err != nil {
+		return nil, fmt.Errorf("push: parse PushCertRequestCSR: %w", err)
+	}
+	signature, err := base64.StdEncoding.DecodeString(fields.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("push: decode PushCertSignature: %w", err)
+	}
+
+	req := &CertificateRequest{CSR: csr, Signature: signature}
+	rest := []byte(fields.Chain)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("push: parse PushCertCertificateChain: %w", err)
+		}
+		req.Chain = append(req.Chain, cert)
+	}
+	if len(req.Chain) == 0 {
+		return nil, fmt.Errorf("push: request has no vendor certificate")
+	}
+
+	pub, ok := req.Chain[0].PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("push: vendor key is %T, want RSA", req.Chain[0].PublicKey)
+	}
+	hashed := sha256.Sum256(csrDER)
+	if err := rsa.VerifyPKCS1v15(pub, crypto.SHA256, hashed[:], signature); err != nil {
+		return nil, fmt.Errorf("push: vendor signature: %w", err)
+	}
+	return req, nil
+}
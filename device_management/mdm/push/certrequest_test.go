@@ -0,0 +1,94 @@
+package push
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+func rsaCertificate(t *testing.T, cn string) (*x509.Certificate, *rsa.PrivateKey) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(7),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	return cert, key
+}
+
+func TestSignCertificateRequest(t *testing.T) {
+	vendor, vendorKey := rsaCertificate(t, "MDM Vendor: Example")
+	wwdr, _ := rsaCertificate(t, "Apple Worldwide Developer Relations")
+	customerKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	csr, err := NewPushCSR(customerKey, pkix.Name{CommonName: "Example MDM Push"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	encoded, err := SignCertificateRequest(csr, vendor, vendorKey, wwdr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err := base64.StdEncoding.DecodeString(string(encoded))
+	if err != nil {
+		t.Fatalf("request is not base64: %v", err)
+	}
+	for _, key := range []string{"PushCertRequestCSR", "PushCertCertificateChain", "PushCertSignature"} {
+		if !strings.Contains(string(doc), "<key>"+key+"</key>") {
+			t.Errorf("request plist missing %s", key)
+		}
+	}
+
+	req, err := ParseCertificateRequest(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.CSR.Subject.CommonName != "Example MDM Push" || !req.CSR.PublicKey.(*rsa.PublicKey).Equal(&customerKey.PublicKey) {
+		t.Errorf("CSR = %v", req.CSR.Subject)
+	}
+	if len(req.Chain) != 2 || !req.Chain[0].Equal(vendor) || !req.Chain[1].Equal(wwdr) {
+		t.Errorf("chain = %d certificates", len(req.Chain))
+	}
+
+	forged := bytes.Replace(doc, []byte("MIIC"), []byte("MIID"), 1)
+	if _, err := ParseCertificateRequest([]byte(base64.StdEncoding.EncodeToString(forged))); err == nil {
+		t.Error("tampered request accepted")
+	}
+}
+
+func TestSignCertificateRequestErrors(t *testing.T) {
+	vendor, _ := rsaCertificate(t, "vendor")
+	_, otherKey := rsaCertificate(t, "other")
+	csr, err := NewPushCSR(otherKey, pkix.Name{CommonName: "c"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := SignCertificateRequest(csr, vendor, otherKey); err == nil || !strings.Contains(err.Error(), "does not match") {
+		t.Errorf("mismatched key: %v", err)
+	}
+	if _, err := SignCertificateRequest([]byte("not a csr"), vendor, otherKey); err == nil {
+		t.Error("invalid CSR accepted")
+	}
+}
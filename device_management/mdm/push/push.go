@@ -0,0 +1,250 @@
+// Package push sends MDM wake-up notifications through the Apple Push
+// Notification service (APNs). An MDM push carries no command: it only
+// tells the device to contact its check-in server, so the payload is just
+// the PushMagic string the device reported in its TokenUpdate.
+//
+// The Client holds one pooled HTTP/2 transport authenticated with the MDM
+// push certificate; APNs multiplexes concurrent pushes over each
+// connection, so a single Client should be shared across goroutines.
+//
+// NewPushCSR and SignCertificateRequest produce the vendor-signed request
+// the push certificate itself is issued from.
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/device_management/mdm/checkin"
+)
+
+// APNs endpoints. MDM push certificates issued by the Apple Push
+// Certificates Portal are production certificates.
+const (
+	ProductionURL  = "https://api.push.apple.com"
+	DevelopmentURL = "https://api.sandbox.push.apple.com"
+)
+
+const (
+	defaultTimeout     = 30 * time.Second
+	defaultConcurrency = 16
+)
+
+// oidUserID is the subject attribute Apple stores the push topic in.
+var oidUserID = asn1.ObjectIdentifier{0, 9, 2342, 19200300, 100, 1, 1}
+
+// Target addresses one enrollment channel, as reported by a TokenUpdate
+// check-in.
+type Target struct {
+	// Token is the device push token.
+	Token []byte
+	// PushMagic is the string the device expects in the push payload.
+	PushMagic string
+}
+
+// TargetFromTokenUpdate returns the push target a TokenUpdate check-in
+// registers.
+func TargetFromTokenUpdate(msg *checkin.TokenUpdate) Target {
+	return Target{Token: msg.Token, PushMagic: msg.PushMagic}
+}
+
+// Response describes an accepted push.
+type Response struct {
+	// ID is the apns-id APNs assigned to the notification.
+	ID string
+	// StatusCode is the HTTP status, 200 for an accepted push.
+	StatusCode int
+}
+
+// Result pairs a target with the outcome of pushing to it.
+type Result struct {
+	Target   Target
+	Response *Response
+	Err      error
+}
+
+// Client sends MDM pushes.
+type Client struct {
+	httpClient  *http.Client
+	baseURL     string
+	topic       string
+	concurrency int
+	expiration  time.Duration
+}
+
+// Option customizes a Client.
+type Option func(*Client)
+
+// WithBaseURL overrides the APNs endpoint, e.g. DevelopmentURL or a test
+// server.
+func WithBaseURL(url string) Option {
+	return func(c *Client) { c.baseURL = strings.TrimSuffix(url, "/") }
+}
+
+// WithTopic overrides the topic read from the push certificate.
+func WithTopic(topic string) Option {
+	return func(c *Client) { c.topic = topic }
+}
+
+// WithHTTPClient replaces the certificate-authenticated HTTP/2 client. The
+// caller is responsible for presenting the push certificate.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) { c.httpClient = hc }
+}
+
+// WithConcurrency caps the number of pushes PushMany keeps in flight.
+func WithConcurrency(n int) Option {
+	return func(c *Client) {
+		if n > 0 {
+			c.concurrency = n
+		}
+	}
+}
+
+// WithExpiration asks APNs to store an undeliverable push for up to d
+// before discarding it. Without it APNs attempts delivery once.
+func WithExpiration(d time.Duration) Option {
+	return func(c *Client) { c.expiration = d }
+}
+
+// NewClient builds a Client authenticated with the MDM push certificate.
+// The topic (com.apple.mgmt.External.<uuid>) is read from the
+// certificate's subject unless WithTopic is given.
+func NewClient(cert tls.Certificate, opts ...Option) (*Client, error) {
+	c := &Client{baseURL: ProductionURL, concurrency: defaultConcurrency}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.topic == "" {
+		leaf := cert.Leaf
+		if leaf == nil {
+			if len(cert.Certificate) == 0 {
+				return nil, fmt.Errorf("push: certificate has no leaf")
+			}
+			parsed, err := x509.ParseCertificate(cert.Certificate[0])
+			if err != nil {
+				return nil, fmt.Errorf("push: parse certificate: %w", err)
+			}
+			leaf = parsed
+		}
+		topic, err := TopicFromCertificate(leaf)
+		if err != nil {
+			return nil, err
+		}
+		c.topic = topic
+	}
+
+	if c.httpClient == nil {
+		c.httpClient = &http.Client{
+			Timeout: defaultTimeout,
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					Certificates: []tls.Certificate{cert},
+					MinVersion:   tls.VersionTLS12,
+				},
+				ForceAttemptHTTP2:   true,
+				MaxIdleConnsPerHost: c.concurrency,
+				IdleConnTimeout:     5 * time.Minute,
+			},
+		}
+	}
+	return c, nil
+}
+
+// TopicFromCertificate returns the APNs topic stored in the UID attribute
+// of an MDM push certificate's subject.
+func TopicFromCertificate(cert *x509.Certificate) (string, error) {
+	for _, name := range cert.Subject.Names {
+		if name.Type.Equal(oidUserID) {
+			if topic, ok := name.Value.(string); ok && topic != "" {
+				return topic, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("push: certificate %q has no UID topic", subjectName(cert.Subject))
+}
+
+// Topic returns the topic pushes are sent on.
+func (c *Client) Topic() string { return c.topic }
+
+// Push sends one MDM wake-up notification. A rejected push returns an
+// *Error carrying the APNs reason.
+func (c *Client) Push(ctx context.Context, target Target) (*Response, error) {
+	if len(target.Token) == 0 {
+		return nil, fmt.Errorf("push: target has no device token")
+	}
+	if target.PushMagic == "" {
+		return nil, fmt.Errorf("push: target has no PushMagic")
+	}
+
+	body, err := json.Marshal(map[string]string{"mdm": target.PushMagic})
+	if err != nil {
+		return nil, fmt.Errorf("push: encode payload: %w", err)
+	}
+	url := c.baseURL + "/3/device/" + hex.EncodeToString(target.Token)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("push: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("apns-topic", c.topic)
+	req.Header.Set("apns-push-type", "mdm")
+	req.Header.Set("apns-priority", "10")
+	if c.expiration > 0 {
+		req.Header.Set("apns-expiration", fmt.Sprint(time.Now().Add(c.expiration).Unix()))
+	} else {
+		req.Header.Set("apns-expiration", "0")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("push: %w", err)
+	}
+	defer resp.Body.Close()
+
+	id := resp.Header.Get("apns-id")
+	if resp.StatusCode == http.StatusOK {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return &Response{ID: id, StatusCode: resp.StatusCode}, nil
+	}
+	return nil, newError(resp.StatusCode, id, resp.Body)
+}
+
+// PushMany sends a push to every target concurrently, bounded by
+// WithConcurrency, and returns one Result per target in input order.
+func (c *Client) PushMany(ctx context.Context, targets []Target) []Result {
+	results := make([]Result, len(targets))
+	sem := make(chan struct{}, c.concurrency)
+	var wg sync.WaitGroup
+	for i, target := range targets {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() { <-sem; wg.Done() }()
+			resp, err := c.Push(ctx, target)
+			results[i] = Result{Target: target, Response: resp, Err: err}
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+func subjectName(name pkix.Name) string {
+	if name.CommonName != "" {
+		return name.CommonName
+	}
+	return name.String()
+}
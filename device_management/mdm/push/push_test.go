@@ -0,0 +1,215 @@
+package push
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/device_management/mdm/checkin"
+)
+
+const testTopic = "com.apple.mgmt.External.0a1b2c3d-0000-4000-8000-000000000000"
+
+func testCertificate(t *testing.T, subject pkix.Name) tls.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      subject,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+func pushCertificate(t *testing.T) tls.Certificate {
+	return testCertificate(t, pkix.Name{
+		CommonName: "APSP:0a1b2c3d",
+		ExtraNames: []pkix.AttributeTypeAndValue{{Type: oidUserID, Value: testTopic}},
+	})
+}
+
+// apnsServer starts an HTTP/2 TLS server and a Client pointed at it.
+func apnsServer(t *testing.T, handler http.HandlerFunc, opts ...Option) *Client {
+	t.Helper()
+	srv := httptest.NewUnstartedServer(handler)
+	srv.EnableHTTP2 = true
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+
+	opts = append([]Option{WithBaseURL(srv.URL), WithHTTPClient(srv.Client())}, opts...)
+	c, err := NewClient(pushCertificate(t), opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestTopicFromCertificate(t *testing.T) {
+	c, err := NewClient(pushCertificate(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Topic() != testTopic {
+		t.Errorf("Topic() = %q", c.Topic())
+	}
+
+	_, err = NewClient(testCertificate(t, pkix.Name{CommonName: "not a push cert"}))
+	if err == nil || !strings.Contains(err.Error(), "no UID topic") {
+		t.Errorf("err = %v", err)
+	}
+
+	c, err = NewClient(tls.Certificate{}, WithTopic("explicit"))
+	if err != nil || c.Topic() != "explicit" {
+		t.Errorf("WithTopic: %v %v", c, err)
+	}
+}
+
+func TestPush(t *testing.T) {
+	c := apnsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.ProtoMajor != 2 {
+			t.Errorf("proto = %s", r.Proto)
+		}
+		if r.Method != http.MethodPost || r.URL.Path != "/3/device/deadbeef" {
+			t.Errorf("request = %s %s", r.Method, r.URL.Path)
+		}
+		for header, want := range map[string]string{
+			"apns-topic":      testTopic,
+			"apns-push-type":  "mdm",
+			"apns-priority":   "10",
+			"apns-expiration": "0",
+		} {
+			if got := r.Header.Get(header); got != want {
+				t.Errorf("%s = %q, want %q", header, got, want)
+			}
+		}
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"mdm":"magic-1"}` {
+			t.Errorf("body = %s", body)
+		}
+		w.Header().Set("apns-id", "A-1")
+	})
+
+	target := TargetFromTokenUpdate(&checkin.TokenUpdate{Token: []byte{0xde, 0xad, 0xbe, 0xef}, PushMagic: "magic-1"})
+	resp, err := c.Push(context.Background(), target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.ID != "A-1" || resp.StatusCode != http.StatusOK {
+		t.Errorf("resp = %+v", resp)
+	}
+
+	if _, err := c.Push(context.Background(), Target{PushMagic: "m"}); err == nil {
+		t.Error("empty token accepted")
+	}
+	if _, err := c.Push(context.Background(), Target{Token: []byte{1}}); err == nil {
+		t.Error("empty PushMagic accepted")
+	}
+}
+
+func TestPushErrors(t *testing.T) {
+	c := apnsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("apns-id", "A-2")
+		switch r.URL.Path {
+		case "/3/device/01":
+			w.WriteHeader(http.StatusGone)
+			_ = json.NewEncoder(w).Encode(map[string]any{"reason": ReasonUnregistered, "timestamp": 1752742800000})
+		case "/3/device/02":
+			w.WriteHeader(http.StatusTooManyRequests)
+			_ = json.NewEncoder(w).Encode(map[string]any{"reason": ReasonTooManyRequests})
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = io.WriteString(w, "not json")
+		}
+	})
+
+	_, err := c.Push(context.Background(), Target{Token: []byte{0x01}, PushMagic: "m"})
+	var apnsErr *Error
+	if !errors.As(err, &apnsErr) {
+		t.Fatalf("err = %v", err)
+	}
+	if apnsErr.Reason != ReasonUnregistered || apnsErr.ID != "A-2" || !apnsErr.TokenInvalid() || apnsErr.Retryable() {
+		t.Errorf("error = %+v", apnsErr)
+	}
+	if !apnsErr.Timestamp.Equal(time.UnixMilli(1752742800000)) || !IsTokenInvalid(err) {
+		t.Errorf("timestamp = %v", apnsErr.Timestamp)
+	}
+	if err.Error() != "push: APNs returned 410 Unregistered" {
+		t.Errorf("Error() = %q", err.Error())
+	}
+
+	_, err = c.Push(context.Background(), Target{Token: []byte{0x02}, PushMagic: "m"})
+	if !errors.As(err, &apnsErr) || !apnsErr.Retryable() || IsTokenInvalid(err) {
+		t.Errorf("429 error = %v", err)
+	}
+
+	_, err = c.Push(context.Background(), Target{Token: []byte{0x03}, PushMagic: "m"})
+	if !errors.As(err, &apnsErr) || apnsErr.Reason != "" || err.Error() != "push: APNs returned 400" {
+		t.Errorf("undecodable error = %v", err)
+	}
+}
+
+func TestPushMany(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	c := apnsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		if r.URL.Path == "/3/device/ff" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = io.WriteString(w, `{"reason":"BadDeviceToken"}`)
+		}
+	}, WithConcurrency(2))
+
+	targets := []Target{
+		{Token: []byte{0x01}, PushMagic: "m"},
+		{Token: []byte{0xff}, PushMagic: "m"},
+		{Token: []byte{0x02}, PushMagic: "m"},
+		{Token: []byte{0x03}, PushMagic: "m"},
+	}
+	results := c.PushMany(context.Background(), targets)
+	if len(results) != len(targets) {
+		t.Fatalf("results = %d", len(results))
+	}
+	for i, r := range results {
+		if r.Target.Token[0] != targets[i].Token[0] {
+			t.Errorf("result %d out of order", i)
+		}
+		if (i == 1) != (r.Err != nil) {
+			t.Errorf("result %d err = %v", i, r.Err)
+		}
+	}
+	if !IsTokenInvalid(results[1].Err) {
+		t.Errorf("BadDeviceToken not reported as invalid token: %v", results[1].Err)
+	}
+	if peak.Load() > 2 {
+		t.Errorf("peak concurrency = %d, want <= 2", peak.Load())
+	}
+}
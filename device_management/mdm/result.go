@@ -0,0 +1,133 @@
+package mdm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/plistdec"
+)
+
+// Command result statuses a device reports to the server URL.
+const (
+	StatusAcknowledged       = "Acknowledged"
+	StatusError              = "Error"
+	StatusCommandFormatError = "CommandFormatError"
+	StatusIdle               = "Idle"
+	StatusNotNow             = "NotNow"
+)
+
+// CommandResult is a device's report to the server URL: either the
+// acknowledgement of a command (with its response keys) or an Idle poll
+// for the next command.
+type CommandResult struct {
+	UDID             string `plist:"UDID,omitempty"`
+	EnrollmentID     string `plist:"EnrollmentID,omitempty"`
+	EnrollmentUserID string `plist:"EnrollmentUserID,omitempty"`
+	UserID           string `plist:"UserID,omitempty"`
+	UserShortName    string `plist:"UserShortName,omitempty"`
+	UserLongName     string `plist:"UserLongName,omitempty"`
+	NotOnConsole     bool   `plist:"NotOnConsole,omitempty"`
+	// CommandUUID identifies the command being reported; empty when Status
+	// is Idle.
+	CommandUUID string `plist:"CommandUUID,omitempty"`
+	// Status is one of the Status constants.
+	Status string `plist:"Status"`
+	// ErrorChain describes why the command failed, outermost error first.
+	ErrorChain []ErrorChainItem `plist:"ErrorChain,omitempty"`
+
+	raw map[string]any
+}
+
+// ErrorChainItem is one link of a command result's ErrorChain.
+type ErrorChainItem struct {
+	ErrorCode            int64  `plist:"ErrorCode"`
+	ErrorDomain          string `plist:"ErrorDomain"`
+	LocalizedDescription string `plist:"LocalizedDescription,omitempty"`
+	USEnglishDescription string `plist:"USEnglishDescription,omitempty"`
+}
+
+// String renders the item as "Domain Code: description", preferring the
+// US English description.
+func (e ErrorChainItem) String() string {
+	desc := e.USEnglishDescription
+	if desc == "" {
+		desc = e.LocalizedDescription
+	}
+	s := fmt.Sprintf("%s %d", e.ErrorDomain, e.ErrorCode)
+	if desc != "" {
+		s += ": " + desc
+	}
+	return s
+}
+
+// CommandError is returned by CommandResult.Err for a command the device
+// rejected.
+type CommandError struct {
+	CommandUUID string
+	Status      string
+	Chain       []ErrorChainItem
+}
+
+func (e *CommandError) Error() string {
+	msg := fmt.Sprintf("mdm: command %s: %s", e.CommandUUID, e.Status)
+	if len(e.Chain) > 0 {
+		parts := make([]string, len(e.Chain))
+		for i, item := range e.Chain {
+			parts[i] = item.String()
+		}
+		msg += ": " + strings.Join(parts, "; ")
+	}
+	return msg
+}
+
+// Has reports whether any link of the chain matches domain and code.
+func (e *CommandError) Has(domain string, code int64) bool {
+	for _, item := range e.Chain {
+		if item.ErrorDomain == domain && item.ErrorCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseCommandResult decodes a command result plist sent by a device.
+func ParseCommandResult(data []byte) (*CommandResult, error) {
+	value, err := plistdec.Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("mdm: command result: %w", err)
+	}
+	dict, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("mdm: command result is not a dict")
+	}
+	r := &CommandResult{raw: dict}
+	if err := plistdec.Assign(dict, r); err != nil {
+		return nil, fmt.Errorf("mdm: command result: %w", err)
+	}
+	if r.Status == "" {
+		return nil, fmt.Errorf("mdm: command result has no Status")
+	}
+	if r.CommandUUID == "" && r.Status != StatusIdle {
+		return nil, fmt.Errorf("mdm: %s command result has no CommandUUID", r.Status)
+	}
+	return r, nil
+}
+
+// Err returns a *CommandError when the device reported Error or
+// CommandFormatError, and nil otherwise.
+func (r *CommandResult) Err() error {
+	if r.Status != StatusError && r.Status != StatusCommandFormatError {
+		return nil
+	}
+	return &CommandError{CommandUUID: r.CommandUUID, Status: r.Status, Chain: r.ErrorChain}
+}
+
+// DecodeResponse decodes the result's command-specific keys into v, a
+// pointer to the generated response struct for the command, e.g.
+// *commands.DeviceInformationResponse.
+func (r *CommandResult) DecodeResponse(v any) error {
+	if err := plistdec.Assign(r.raw, v); err != nil {
+		return fmt.Errorf("mdm: command %s response: %w", r.CommandUUID, err)
+	}
+	return nil
+}
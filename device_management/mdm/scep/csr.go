@@ -0,0 +1,99 @@
+package scep
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/device_management/internal/cms"
+)
+
+var (
+	oidChallengePassword = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 7}
+	oidSHA256WithRSA     = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 11}
+)
+
+type tbsCertificateRequest struct {
+	Raw           asn1.RawContent
+	Version       int
+	Subject       asn1.RawValue
+	PublicKey     asn1.RawValue
+	RawAttributes []asn1.RawValue `asn1:"tag:0"`
+}
+
+type certificateRequest struct {
+	TBS                tbsCertificateRequest
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Signature          asn1.BitString
+}
+
+// NewCSR creates a DER PKCS #10 certificate request for key from template
+// and, when challenge is set, adds the challengePassword attribute SCEP
+// servers use to authorize enrollment. SCEP requires an RSA key: the same
+// key decrypts the server's response.
+func NewCSR(key *rsa.PrivateKey, template *x509.CertificateRequest, challenge string) ([]byte, error) {
+	tmpl := *template
+	tmpl.SignatureAlgorithm = x509.SHA256WithRSA
+	der, err := x509.CreateCertificateRequest(rand.Reader, &tmpl, key)
+	if err != nil {
+		return nil, fmt.Errorf("scep: create CSR: %w", err)
+	}
+	if challenge == "" {
+		return der, nil
+	}
+
+	var csr certificateRequest
+	if _, err := asn1.Unmarshal(der, &csr); err != nil {
+		return nil, fmt.Errorf("scep: decode CSR: %w", err)
+	}
+	attr, err := cms.NewAttribute(oidChallengePassword, challenge, "utf8")
+	if err != nil {
+		return nil, err
+	}
+	attrDER, err := asn1.Marshal(attr)
+	if err != nil {
+		return nil, err
+	}
+	csr.TBS.Raw = nil
+	csr.TBS.RawAttributes = append(csr.TBS.RawAttributes, asn1.RawValue{FullBytes: attrDER})
+	tbs, err := asn1.Marshal(csr.TBS)
+	if err != nil {
+		return nil, fmt.Errorf("scep: encode CSR: %w", err)
+	}
+	hashed := sha256.Sum256(tbs)
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hashed[:])
+	if err != nil {
+		return nil, fmt.Errorf("scep: sign CSR: %w", err)
+	}
+	csr.TBS.Raw = tbs
+	csr.SignatureAlgorithm = pkix.AlgorithmIdentifier{Algorithm: oidSHA256WithRSA, Parameters: asn1.NullRawValue}
+	csr.Signature = asn1.BitString{Bytes: signature, BitLength: len(signature) * 8}
+	return asn1.Marshal(csr)
+}
+
+// ChallengePassword returns the challengePassword attribute of csr, or ""
+// when it has none. crypto/x509 does not expose the attribute; SCEP servers
+// in test harnesses use this to check the challenge.
+func ChallengePassword(csr *x509.CertificateRequest) (string, error) {
+	var tbs tbsCertificateRequest
+	if _, err := asn1.Unmarshal(csr.RawTBSCertificateRequest, &tbs); err != nil {
+		return "", fmt.Errorf("scep: decode CSR: %w", err)
+	}
+	for _, raw := range tbs.RawAttributes {
+		var attr cms.Attribute
+		if _, err := asn1.Unmarshal(raw.FullBytes, &attr); err != nil || !attr.Type.Equal(oidChallengePassword) {
+			continue
+		}
+		var challenge string
+		if _, err := asn1.Unmarshal(attr.Value.Bytes, &challenge); err != nil {
+			return "", fmt.Errorf("scep: decode challengePassword: %w", err)
+		}
+		return challenge, nil
+	}
+	return "", nil
+}
@@ -0,0 +1,77 @@
+package scep
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/device_management/mdm/profiles"
+)
+
+// subjectAttributeNames are the short names Apple profiles use for subject
+// attributes; others are written as dotted OIDs.
+var subjectAttributeNames = map[string]string{
+	"2.5.4.3":                    "CN",
+	"2.5.4.5":                    "SERIALNUMBER",
+	"2.5.4.6":                    "C",
+	"2.5.4.7":                    "L",
+	"2.5.4.8":                    "ST",
+	"2.5.4.10":                   "O",
+	"2.5.4.11":                   "OU",
+	"1.2.840.113549.1.9.1":       "E",
+	"0.9.2342.19200300.100.1.25": "DC",
+}
+
+// ProfileSubject converts name to the nested array form of the SCEP
+// payload's Subject key, e.g. [[["O","Example"]],[["CN","device"]]], one
+// entry per relative distinguished name.
+func ProfileSubject(name pkix.Name) [][][]string {
+	var subject [][][]string
+	for _, rdn := range name.ToRDNSequence() {
+		var set [][]string
+		for _, atv := range rdn {
+			key, ok := subjectAttributeNames[atv.Type.String()]
+			if !ok {
+				key = atv.Type.String()
+			}
+			set = append(set, []string{key, fmt.Sprint(atv.Value)})
+		}
+		subject = append(subject, set)
+	}
+	return subject
+}
+
+// CAFingerprint returns the SHA-256 fingerprint of ca for the SCEP
+// payload's CAFingerprint key, which pins the CA the device accepts from
+// GetCACert.
+func CAFingerprint(ca *x509.Certificate) []byte {
+	sum := sha256.Sum256(ca.Raw)
+	return sum[:]
+}
+
+// NewPayload builds a validated com.apple.security.scep profile payload
+// enrolling a 2048-bit RSA key against url with subject and challenge.
+// When ca is non-nil its fingerprint is pinned. Set further keys on the
+// returned PayloadContent before adding it to a profile with
+// mdm.WithPayload.
+func NewPayload(url string, subject pkix.Name, challenge string, ca *x509.Certificate) (*profiles.SecurityScep, error) {
+	keySize := profiles.SecurityScepPayloadContentKeysizeValue2048
+	keyType := "RSA"
+	p := &profiles.SecurityScep{PayloadContent: profiles.SecurityScepPayloadContent{
+		URL:     url,
+		Subject: ProfileSubject(subject),
+		Keysize: &keySize,
+		KeyType: &keyType,
+	}}
+	if challenge != "" {
+		p.PayloadContent.Challenge = &challenge
+	}
+	if ca != nil {
+		p.PayloadContent.CAFingerprint = CAFingerprint(ca)
+	}
+	if err := p.Validate(); err != nil {
+		return nil, fmt.Errorf("scep: invalid payload: %w", err)
+	}
+	return p, nil
+}
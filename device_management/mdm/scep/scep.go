@@ -0,0 +1,366 @@
+// Package scep implements the client side of the Simple Certificate
+// Enrollment Protocol (RFC 8894) and builds SCEP profile payloads, so device
+// identity bootstrapping can be exercised end to end in test harnesses:
+// generate a CSR, fetch the CA with GetCACert and enroll via PKIOperation.
+//
+// Only RSA keys are supported, matching what Apple devices and most SCEP
+// servers use.
+package scep
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/device_management/internal/cms"
+)
+
+// SCEP signed attributes (RFC 8894 §3.2.1).
+var (
+	oidSCEPMessageType    = asn1.ObjectIdentifier{2, 16, 840, 1, 113733, 1, 9, 2}
+	oidSCEPPKIStatus      = asn1.ObjectIdentifier{2, 16, 840, 1, 113733, 1, 9, 3}
+	oidSCEPFailInfo       = asn1.ObjectIdentifier{2, 16, 840, 1, 113733, 1, 9, 4}
+	oidSCEPSenderNonce    = asn1.ObjectIdentifier{2, 16, 840, 1, 113733, 1, 9, 5}
+	oidSCEPRecipientNonce = asn1.ObjectIdentifier{2, 16, 840, 1, 113733, 1, 9, 6}
+	oidSCEPTransactionID  = asn1.ObjectIdentifier{2, 16, 840, 1, 113733, 1, 9, 7}
+)
+
+// SCEP message types (RFC 8894 §3.2.1.2).
+const (
+	MessageTypeCertRep = "3"
+	MessageTypePKCSReq = "19"
+)
+
+// PKIStatus is a SCEP pkiStatus value.
+type PKIStatus string
+
+// SCEP pkiStatus values.
+const (
+	StatusSuccess PKIStatus = "0"
+	StatusFailure PKIStatus = "2"
+	StatusPending PKIStatus = "3"
+)
+
+func (s PKIStatus) String() string {
+	switch s {
+	case StatusSuccess:
+		return "SUCCESS"
+	case StatusFailure:
+		return "FAILURE"
+	case StatusPending:
+		return "PENDING"
+	}
+	return fmt.Sprintf("PKIStatus(%s)", string(s))
+}
+
+// FailInfo is a SCEP failInfo value explaining a FAILURE.
+type FailInfo string
+
+// SCEP failInfo values.
+const (
+	FailBadAlg          FailInfo = "0"
+	FailBadMessageCheck FailInfo = "1"
+	FailBadRequest      FailInfo = "2"
+	FailBadTime         FailInfo = "3"
+	FailBadCertID       FailInfo = "4"
+)
+
+func (f FailInfo) String() string {
+	switch f {
+	case FailBadAlg:
+		return "badAlg"
+	case FailBadMessageCheck:
+		return "badMessageCheck"
+	case FailBadRequest:
+		return "badRequest"
+	case FailBadTime:
+		return "badTime"
+	case FailBadCertID:
+		return "badCertId"
+	}
+	return fmt.Sprintf("FailInfo(%s)", string(f))
+}
+
+// Error is a PKIOperation the server did not grant: a FAILURE or a
+// PENDING (manual approval) response.
+type Error struct {
+	Status        PKIStatus
+	FailInfo      FailInfo
+	TransactionID string
+}
+
+func (e *Error) Error() string {
+	if e.Status == StatusFailure && e.FailInfo != "" {
+		return fmt.Sprintf("scep: enrollment %s: %s (%s)", e.TransactionID, e.Status, e.FailInfo)
+	}
+	return fmt.Sprintf("scep: enrollment %s: %s", e.TransactionID, e.Status)
+}
+
+// Client talks to one SCEP server URL.
+type Client struct {
+	httpClient *http.Client
+	url        string
+}
+
+// Option customizes a Client.
+type Option func(*Client)
+
+// WithHTTPClient replaces the default HTTP client.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) { c.httpClient = hc }
+}
+
+// NewClient returns a client for the SCEP server at serverURL, e.g.
+// "https://scep.example.com/scep".
+func NewClient(serverURL string, opts ...Option) *Client {
+	c := &Client{httpClient: &http.Client{Timeout: 30 * time.Second}, url: serverURL}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// GetCACaps returns the capabilities the server advertises, e.g. "AES",
+// "POSTPKIOperation", "SHA-256".
+func (c *Client) GetCACaps(ctx context.Context) ([]string, error) {
+	body, _, err := c.get(ctx, "GetCACaps", "")
+	if err != nil {
+		return nil, err
+	}
+	return strings.Fields(string(body)), nil
+}
+
+// GetCACert returns the CA certificate, followed by any RA certificates
+// when the server publishes a certificate chain.
+func (c *Client) GetCACert(ctx context.Context) ([]*x509.Certificate, error) {
+	body, contentType, err := c.get(ctx, "GetCACert", "ca")
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(contentType, "application/x-x509-ca-ra-cert") {
+		if cert, err := x509.ParseCertificate(body); err == nil {
+			return []*x509.Certificate{cert}, nil
+		}
+	}
+	msg, err := cms.Parse(body)
+	if err != nil {
+		return nil, err
+	}
+	if len(msg.Certificates) == 0 {
+		return nil, fmt.Errorf("scep: GetCACert returned no certificates")
+	}
+	return msg.Certificates, nil
+}
+
+// PKIOperation sends a DER pkiMessage and returns the server's raw
+// response. usePost selects the POST binding (the POSTPKIOperation
+// capability) over base64 in the query string.
+func (c *Client) PKIOperation(ctx context.Context, message []byte, usePost bool) ([]byte, error) {
+	if !usePost {
+		body, _, err := c.get(ctx, "PKIOperation", base64.StdEncoding.EncodeToString(message))
+		return body, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.operationURL("PKIOperation", ""), bytes.NewReader(message))
+	if err != nil {
+		return nil, fmt.Errorf("scep: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-pki-message")
+	body, _, err := c.do(req, "PKIOperation")
+	return body, err
+}
+
+// Enroll performs a PKCSReq enrollment of csr (from NewCSR, signed by key)
+// and returns the issued certificate. The request is encrypted to the
+// server's RA certificate (or the CA when it has none) and signed with a
+// transient self-signed certificate for key, as RFC 8894 specifies for a
+// client without an existing certificate. A FAILURE or PENDING response
+// returns an *Error.
+func (c *Client) Enroll(ctx context.Context, key *rsa.PrivateKey, csr []byte) (*x509.Certificate, error) {
+	request, err := x509.ParseCertificateRequest(csr)
+	if err != nil {
+		return nil, fmt.Errorf("scep: parse CSR: %w", err)
+	}
+	caps, err := c.GetCACaps(ctx)
+	if err != nil {
+		caps = nil // GetCACaps is optional; fall back to baseline algorithms
+	}
+	certs, err := c.GetCACert(ctx)
+	if err != nil {
+		return nil, err
+	}
+	recipient := recipientCertificate(certs)
+
+	hash, contentAlg := crypto.SHA1, cms.OIDDESEDE3CBC
+	usePost := false
+	for _, capability := range caps {
+		switch strings.ToUpper(capability) {
+		case "SHA-256", "SCEPSTANDARD":
+			hash = crypto.SHA256
+		case "AES":
+			contentAlg = cms.OIDAES128CBC
+		case "POSTPKIOPERATION":
+			usePost = true
+		}
+	}
+
+	signer, err := selfSignedCertificate(key, request)
+	if err != nil {
+		return nil, err
+	}
+	enveloped, err := cms.Envelope(csr, recipient, contentAlg)
+	if err != nil {
+		return nil, err
+	}
+	pub, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("scep: %w", err)
+	}
+	sum := sha256.Sum256(pub)
+	transactionID := hex.EncodeToString(sum[:])
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	attrs, err := scepAttributes(MessageTypePKCSReq, transactionID, nonce)
+	if err != nil {
+		return nil, err
+	}
+	message, err := cms.Sign(enveloped, signer, key, hash, attrs)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.PKIOperation(ctx, message, usePost)
+	if err != nil {
+		return nil, err
+	}
+	reply, err := cms.Parse(response)
+	if err != nil {
+		return nil, err
+	}
+	if reply.Signer == nil {
+		return nil, fmt.Errorf("scep: CertRep is not signed by a certificate it carries")
+	}
+	if got := reply.StringAttribute(oidSCEPTransactionID); got != transactionID {
+		return nil, fmt.Errorf("scep: CertRep transaction ID %q does not match %q", got, transactionID)
+	}
+	if !bytes.Equal(reply.BytesAttribute(oidSCEPRecipientNonce), nonce) {
+		return nil, fmt.Errorf("scep: CertRep recipient nonce does not match the request")
+	}
+	if status := PKIStatus(reply.StringAttribute(oidSCEPPKIStatus)); status != StatusSuccess {
+		return nil, &Error{Status: status, FailInfo: FailInfo(reply.StringAttribute(oidSCEPFailInfo)), TransactionID: transactionID}
+	}
+
+	degenerate, err := cms.OpenEnvelope(reply.Content, key)
+	if err != nil {
+		return nil, err
+	}
+	issued, err := cms.Parse(degenerate)
+	if err != nil {
+		return nil, err
+	}
+	for _, cert := range issued.Certificates {
+		if certPub, ok := cert.PublicKey.(*rsa.PublicKey); ok && certPub.Equal(&key.PublicKey) {
+			return cert, nil
+		}
+	}
+	return nil, fmt.Errorf("scep: CertRep has no certificate for the enrolled key")
+}
+
+// scepAttributes returns the messageType, transactionID and senderNonce
+// authenticated attributes every SCEP request carries.
+func scepAttributes(messageType, transactionID string, senderNonce []byte) ([]cms.Attribute, error) {
+	mt, err := cms.NewAttribute(oidSCEPMessageType, messageType, "printable")
+	if err != nil {
+		return nil, err
+	}
+	tid, err := cms.NewAttribute(oidSCEPTransactionID, transactionID, "printable")
+	if err != nil {
+		return nil, err
+	}
+	sn, err := cms.NewAttribute(oidSCEPSenderNonce, senderNonce, "")
+	if err != nil {
+		return nil, err
+	}
+	return []cms.Attribute{mt, tid, sn}, nil
+}
+
+// recipientCertificate picks the certificate to encrypt requests to: the
+// first RA (non-CA) certificate able to encipher keys, else the CA.
+func recipientCertificate(certs []*x509.Certificate) *x509.Certificate {
+	for _, cert := range certs {
+		if !cert.IsCA && (cert.KeyUsage == 0 || cert.KeyUsage&x509.KeyUsageKeyEncipherment != 0) {
+			return cert
+		}
+	}
+	return certs[0]
+}
+
+func selfSignedCertificate(key *rsa.PrivateKey, csr *x509.CertificateRequest) (*x509.Certificate, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 64))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	der, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      csr.Subject,
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+	}, &x509.Certificate{Subject: csr.Subject, SerialNumber: serial}, &key.PublicKey, key)
+	if err != nil {
+		return nil, fmt.Errorf("scep: create signer certificate: %w", err)
+	}
+	return x509.ParseCertificate(der)
+}
+
+func (c *Client) operationURL(operation, message string) string {
+	q := url.Values{"operation": {operation}}
+	if message != "" {
+		q.Set("message", message)
+	}
+	sep := "?"
+	if strings.Contains(c.url, "?") {
+		sep = "&"
+	}
+	return c.url + sep + q.Encode()
+}
+
+func (c *Client) get(ctx context.Context, operation, message string) ([]byte, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.operationURL(operation, message), nil)
+	if err != nil {
+		return nil, "", fmt.Errorf("scep: %w", err)
+	}
+	return c.do(req, operation)
+}
+
+func (c *Client) do(req *http.Request, operation string) ([]byte, string, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("scep: %s: %w", operation, err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", fmt.Errorf("scep: %s: %w", operation, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("scep: %s returned %s", operation, resp.Status)
+	}
+	return body, resp.Header.Get("Content-Type"), nil
+}
@@ -0,0 +1,264 @@
+package scep
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/device_management/internal/cms"
+	"github.com/deploymenttheory/go-api-sdk-apple/device_management/mdm"
+)
+
+func rsaKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+type testServer struct {
+	caps      string
+	challenge string
+	caKey     *rsa.PrivateKey
+	ca        *x509.Certificate
+	posts     int
+}
+
+// newTestServer starts a minimal SCEP CA that issues certificates for
+// requests carrying challenge.
+func newTestServer(t *testing.T, caps, challenge string) (*testServer, *Client) {
+	t.Helper()
+	s := &testServer{caps: caps, challenge: challenge, caKey: rsaKey(t)}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test SCEP CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &s.caKey.PublicKey, s.caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.ca, err = x509.ParseCertificate(der); err != nil {
+		t.Fatal(err)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("operation") {
+		case "GetCACaps":
+			_, _ = io.WriteString(w, s.caps)
+		case "GetCACert":
+			w.Header().Set("Content-Type", "application/x-x509-ca-cert")
+			_, _ = w.Write(s.ca.Raw)
+		case "PKIOperation":
+			var msg []byte
+			if r.Method == http.MethodPost {
+				s.posts++
+				msg, _ = io.ReadAll(r.Body)
+			} else {
+				msg, _ = base64.StdEncoding.DecodeString(r.URL.Query().Get("message"))
+			}
+			reply, err := s.pkiOperation(msg)
+			if err != nil {
+				t.Errorf("server: %v", err)
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			w.Header().Set("Content-Type", "application/x-pki-message")
+			_, _ = w.Write(reply)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return s, NewClient(srv.URL + "/scep")
+}
+
+func (s *testServer) pkiOperation(der []byte) ([]byte, error) {
+	req, err := cms.Parse(der)
+	if err != nil {
+		return nil, err
+	}
+	if req.Signer == nil {
+		return nil, errors.New("request is not signed")
+	}
+	if mt := req.StringAttribute(oidSCEPMessageType); mt != MessageTypePKCSReq {
+		return nil, errors.New("unexpected messageType " + mt)
+	}
+	csrDER, err := cms.OpenEnvelope(req.Content, s.caKey)
+	if err != nil {
+		return nil, err
+	}
+	csr, err := x509.ParseCertificateRequest(csrDER)
+	if err != nil {
+		return nil, err
+	}
+	challenge, err := ChallengePassword(csr)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, 16)
+	_, _ = rand.Read(nonce)
+	attrs, err := scepAttributes(MessageTypeCertRep, req.StringAttribute(oidSCEPTransactionID), nonce)
+	if err != nil {
+		return nil, err
+	}
+	recipientNonce, _ := cms.NewAttribute(oidSCEPRecipientNonce, req.BytesAttribute(oidSCEPSenderNonce), "")
+	attrs = append(attrs, recipientNonce)
+
+	if challenge != s.challenge {
+		status, _ := cms.NewAttribute(oidSCEPPKIStatus, string(StatusFailure), "printable")
+		failInfo, _ := cms.NewAttribute(oidSCEPFailInfo, string(FailBadRequest), "printable")
+		return cms.Sign(nil, s.ca, s.caKey, crypto.SHA256, append(attrs, status, failInfo))
+	}
+
+	certDER, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      csr.Subject,
+		DNSNames:     csr.DNSNames,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+	}, s.ca, csr.PublicKey, s.caKey)
+	if err != nil {
+		return nil, err
+	}
+	issued, _ := x509.ParseCertificate(certDER)
+	degenerate, err := cms.CertificatesOnly([]*x509.Certificate{issued})
+	if err != nil {
+		return nil, err
+	}
+	enveloped, err := cms.Envelope(degenerate, req.Signer, cms.OIDAES256CBC)
+	if err != nil {
+		return nil, err
+	}
+	status, _ := cms.NewAttribute(oidSCEPPKIStatus, string(StatusSuccess), "printable")
+	return cms.Sign(enveloped, s.ca, s.caKey, crypto.SHA256, append(attrs, status))
+}
+
+func deviceCSR(t *testing.T, key *rsa.PrivateKey, challenge string) []byte {
+	t.Helper()
+	csr, err := NewCSR(key, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: "device-0001", Organization: []string{"Example"}},
+		DNSNames: []string{"device-0001.example.com"},
+	}, challenge)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return csr
+}
+
+func TestNewCSR(t *testing.T) {
+	key := rsaKey(t)
+	csr, err := x509.ParseCertificateRequest(deviceCSR(t, key, "s3cret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := csr.CheckSignature(); err != nil {
+		t.Fatalf("CSR signature: %v", err)
+	}
+	if csr.Subject.CommonName != "device-0001" || len(csr.DNSNames) != 1 {
+		t.Errorf("CSR = %+v", csr)
+	}
+	if challenge, err := ChallengePassword(csr); err != nil || challenge != "s3cret" {
+		t.Errorf("ChallengePassword = %q, %v", challenge, err)
+	}
+
+	plain, _ := x509.ParseCertificateRequest(deviceCSR(t, key, ""))
+	if challenge, err := ChallengePassword(plain); err != nil || challenge != "" {
+		t.Errorf("ChallengePassword without attribute = %q, %v", challenge, err)
+	}
+}
+
+func TestEnroll(t *testing.T) {
+	for name, caps := range map[string]string{
+		"modern":   "AES\nPOSTPKIOperation\nSHA-256\n",
+		"baseline": "",
+	} {
+		t.Run(name, func(t *testing.T) {
+			server, client := newTestServer(t, caps, "s3cret")
+			certs, err := client.GetCACert(context.Background())
+			if err != nil || len(certs) != 1 || !certs[0].Equal(server.ca) {
+				t.Fatalf("GetCACert = %v, %v", certs, err)
+			}
+
+			key := rsaKey(t)
+			cert, err := client.Enroll(context.Background(), key, deviceCSR(t, key, "s3cret"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cert.Subject.CommonName != "device-0001" || cert.DNSNames[0] != "device-0001.example.com" {
+				t.Errorf("issued = %v %v", cert.Subject, cert.DNSNames)
+			}
+			if err := cert.CheckSignatureFrom(server.ca); err != nil {
+				t.Errorf("issued certificate not signed by CA: %v", err)
+			}
+			if wantPost := caps != ""; (server.posts == 1) != wantPost {
+				t.Errorf("POST PKIOperations = %d", server.posts)
+			}
+		})
+	}
+}
+
+func TestEnrollFailure(t *testing.T) {
+	_, client := newTestServer(t, "AES\nSHA-256\n", "s3cret")
+	key := rsaKey(t)
+
+	_, err := client.Enroll(context.Background(), key, deviceCSR(t, key, "wrong"))
+	var scepErr *Error
+	if !errors.As(err, &scepErr) {
+		t.Fatalf("err = %v", err)
+	}
+	if scepErr.Status != StatusFailure || scepErr.FailInfo != FailBadRequest {
+		t.Errorf("error = %+v", scepErr)
+	}
+	if !strings.Contains(err.Error(), "FAILURE (badRequest)") {
+		t.Errorf("Error() = %q", err.Error())
+	}
+}
+
+func TestNewPayload(t *testing.T) {
+	server, _ := newTestServer(t, "", "")
+	payload, err := NewPayload("https://scep.example.com/scep",
+		pkix.Name{CommonName: "device-0001", Organization: []string{"Example"}},
+		"s3cret", server.ca)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := payload.PayloadContent
+	subject := content.Subject
+	if len(subject) != 2 || subject[0][0][0] != "O" || subject[1][0][1] != "device-0001" {
+		t.Errorf("Subject = %v", subject)
+	}
+	if *content.Challenge != "s3cret" || len(content.CAFingerprint) != 32 {
+		t.Errorf("content = %+v", content)
+	}
+
+	profile, err := mdm.NewProfile("com.example.scep", mdm.WithPayload(payload))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"<string>com.apple.security.scep</string>", "<key>CAFingerprint</key>", "<string>CN</string>"} {
+		if !strings.Contains(string(profile), want) {
+			t.Errorf("profile missing %s", want)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package mdm
+
+import (
+	"crypto"
+	"crypto/x509"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/device_management/internal/cms"
+)
+
+// SignProfile wraps a rendered profile (from NewProfile) in a CMS
+// SignedData message signed with key, producing the signed .mobileconfig
+// devices show as "Verified" when cert chains to a trusted root. chain
+// lists intermediates to embed after cert. RSA and ECDSA keys are
+// supported; the digest is SHA-256.
+func SignProfile(profile []byte, cert *x509.Certificate, key crypto.Signer, chain ...*x509.Certificate) ([]byte, error) {
+	if len(profile) == 0 {
+		return nil, fmt.Errorf("mdm: empty profile")
+	}
+	if cert == nil || key == nil {
+		return nil, fmt.Errorf("mdm: signing certificate and key are required")
+	}
+	signed, err := cms.Sign(profile, cert, key, crypto.SHA256, nil, chain...)
+	if err != nil {
+		return nil, fmt.Errorf("mdm: sign profile: %w", err)
+	}
+	return signed, nil
+}
+
+// VerifyProfile checks a signed .mobileconfig and returns the profile plist
+// it carries together with the signing certificate. The signature is always
+// verified; when opts is non-nil the signer's chain is also verified
+// against opts.Roots, with the certificates embedded in the message used as
+// intermediates.
+func VerifyProfile(signed []byte, opts *x509.VerifyOptions) ([]byte, *x509.Certificate, error) {
+	msg, err := cms.Parse(signed)
+	if err != nil {
+		return nil, nil, fmt.Errorf("mdm: verify profile: %w", err)
+	}
+	if msg.Signer == nil {
+		return nil, nil, fmt.Errorf("mdm: verify profile: message does not carry its signer's certificate")
+	}
+	if len(msg.Content) == 0 {
+		return nil, nil, fmt.Errorf("mdm: verify profile: message has no content")
+	}
+	if opts != nil {
+		vo := *opts
+		if opts.Intermediates != nil {
+			vo.Intermediates = opts.Intermediates.Clone()
+		} else {
+			vo.Intermediates = x509.NewCertPool()
+		}
+		for _, c := range msg.Certificates {
+			if c != msg.Signer {
+				vo.Intermediates.AddCert(c)
+			}
+		}
+		if len(vo.KeyUsages) == 0 {
+			vo.KeyUsages = []x509.ExtKeyUsage{x509.ExtKeyUsageAny}
+		}
+		if _, err := msg.Signer.Verify(vo); err != nil {
+			return nil, nil, fmt.Errorf("mdm: verify profile signer: %w", err)
+		}
+	}
+	return msg.Content, msg.Signer, nil
+}
@@ -2,18 +2,27 @@ package device_management_test
 
 // End-to-end smoke tests over the generated surface: typed payloads in,
 // validated Apple config out — MDM command plists, configuration profiles
-// and DDM declaration JSON.
+// and DDM declaration JSON — plus typed parsing of device check-ins and
+// command results.
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/json"
 	"errors"
+	"math/big"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-apple/device_management/ddm"
 	"github.com/deploymenttheory/go-api-sdk-apple/device_management/ddm/activations"
 	"github.com/deploymenttheory/go-api-sdk-apple/device_management/ddm/configurations"
 	"github.com/deploymenttheory/go-api-sdk-apple/device_management/mdm"
+	"github.com/deploymenttheory/go-api-sdk-apple/device_management/mdm/checkin"
 	"github.com/deploymenttheory/go-api-sdk-apple/device_management/mdm/commands"
 	"github.com/deploymenttheory/go-api-sdk-apple/device_management/mdm/profiles"
 	"github.com/deploymenttheory/go-api-sdk-apple/device_management/ptr"
@@ -77,6 +86,121 @@ func TestSmokeProfileMobileconfig(t *testing.T) {
 	}
 }
 
+func TestSmokeProfileTemplate(t *testing.T) {
+	tmpl, err := profiles.ParseTemplate("corp-wifi", string(plistDoc(`
+		<key>PayloadContent</key><array>{{template "wifi" .}}</array>
+		<key>PayloadDisplayName</key><string>Wi-Fi for {{.User.FullName}}</string>
+		<key>PayloadIdentifier</key><string>com.example.wifi.{{.SerialNumber}}</string>
+		<key>PayloadOrganization</key><string>{{.Org.Name}}</string>
+		<key>PayloadType</key><string>Configuration</string>
+		<key>PayloadUUID</key><string>{{uuid "com.example.wifi" .SerialNumber}}</string>
+		<key>PayloadVersion</key><integer>1</integer>`)),
+		profiles.WithFragment("wifi", `<dict>
+			<key>PayloadType</key><string>com.apple.wifi.managed</string>
+			<key>PayloadIdentifier</key><string>com.example.wifi.{{.SerialNumber}}.0</string>
+			<key>PayloadUUID</key><string>{{uuid "com.example.wifi" .SerialNumber 0}}</string>
+			<key>PayloadVersion</key><integer>1</integer>
+			<key>SSID_STR</key><string>{{.Values.ssid}}</string>
+			<key>EncryptionType</key><string>{{.Values.encryption | upper}}</string>
+		</dict>`),
+		profiles.WithFuncs(map[string]any{"upper": strings.ToUpper}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vars := profiles.Vars{
+		SerialNumber: "C02XK1JQJG5H",
+		User:         profiles.UserVars{FullName: "Jane <Admin>"},
+		Org:          profiles.OrgVars{Name: "Smith & Jones"},
+		Values:       map[string]any{"ssid": "corp", "encryption": "wpa2"},
+	}
+	doc, err := tmpl.Render(vars)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := string(doc)
+	for _, want := range []string{
+		"<string>com.example.wifi.C02XK1JQJG5H</string>",
+		"<string>Wi-Fi for Jane &lt;Admin&gt;</string>",
+		"<string>Smith &amp; Jones</string>",
+		"<string>corp</string>",
+		"<string>WPA2</string>",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("profile missing %q:\n%s", want, out)
+		}
+	}
+	again, _ := tmpl.Render(vars)
+	if string(again) != out {
+		t.Error("template rendering is not deterministic")
+	}
+
+	other := vars
+	other.SerialNumber = "DMPXK2L3Q1GC"
+	other.Values = map[string]any{"ssid": "corp", "encryption": "rot13"}
+	docs, err := tmpl.RenderAll([]profiles.Vars{vars, other, {SerialNumber: "NOVALUES"}})
+	if docs[0] == nil || docs[1] != nil || docs[2] != nil {
+		t.Fatalf("RenderAll results = %v", docs)
+	}
+	if err == nil || !strings.Contains(err.Error(), "device 1 (DMPXK2L3Q1GC)") || !strings.Contains(err.Error(), "EncryptionType") {
+		t.Fatalf("invalid payload error = %v", err)
+	}
+	if !strings.Contains(err.Error(), "device 2 (NOVALUES)") || !strings.Contains(err.Error(), `map has no entry for key "ssid"`) {
+		t.Fatalf("missing value error = %v", err)
+	}
+
+	if _, err := profiles.ParseTemplate("wifi", "", profiles.WithFragment("wifi", "")); err == nil {
+		t.Fatal("fragment named after its template accepted")
+	}
+	if _, err := profiles.ParseTemplate("bad", `{{.SerialNumber`); err == nil {
+		t.Fatal("unterminated action accepted")
+	}
+}
+
+func TestSmokeSignedProfile(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Profile Signing"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+
+	prof, err := mdm.NewProfile("com.example.dict",
+		mdm.WithPayload(&profiles.Dictionary{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed, err := mdm.SignProfile(prof, cert, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	roots := x509.NewCertPool()
+	roots.AddCert(cert)
+	content, signer, err := mdm.VerifyProfile(signed, &x509.VerifyOptions{Roots: roots})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != string(prof) || !signer.Equal(cert) {
+		t.Fatal("verified profile differs from the signed one")
+	}
+	if _, _, err := mdm.VerifyProfile(signed, &x509.VerifyOptions{Roots: x509.NewCertPool()}); err == nil {
+		t.Fatal("untrusted signer accepted")
+	}
+}
+
 func TestSmokeDeclarationJSON(t *testing.T) {
 	doc, err := ddm.BuildDeclaration("com.example.passcode", &configurations.PasscodeSettings{
 		RequirePasscode: ptr.To(true),
@@ -106,6 +230,167 @@ func TestSmokeDeclarationJSON(t *testing.T) {
 	}
 }
 
+func TestSmokeDeclarationSync(t *testing.T) {
+	config, err := ddm.NewDeclaration("com.example.passcode",
+		&configurations.PasscodeSettings{MinimumLength: ptr.To(int64(8))},
+		ddm.WithHashedServerToken())
+	if err != nil {
+		t.Fatal(err)
+	}
+	same, _ := ddm.NewDeclaration("com.example.passcode",
+		&configurations.PasscodeSettings{MinimumLength: ptr.To(int64(8))})
+	if config.ServerToken == "" || config.ServerToken != same.Hash() {
+		t.Fatalf("hashed token %q not stable", config.ServerToken)
+	}
+	activation, err := ddm.NewDeclaration("com.example.activation",
+		&activations.Simple{StandardConfigurations: []string{"com.example.passcode"}},
+		ddm.WithServerToken("a1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	items, err := ddm.NewDeclarationItems(config, activation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, err := items.JSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var manifest struct {
+		Declarations      map[string][]ddm.ManifestItem
+		DeclarationsToken string
+	}
+	if err := json.Unmarshal(doc, &manifest); err != nil {
+		t.Fatalf("declaration-items is not valid JSON: %v\n%s", err, doc)
+	}
+	if got := manifest.Declarations["Configurations"]; len(got) != 1 || got[0].ServerToken != config.ServerToken {
+		t.Fatalf("Configurations = %+v", got)
+	}
+	if got := manifest.Declarations["Activations"]; len(got) != 1 || got[0].ServerToken != "a1" {
+		t.Fatalf("Activations = %+v", got)
+	}
+	if assets, ok := manifest.Declarations["Assets"]; !ok || assets == nil || len(assets) != 0 {
+		t.Fatalf("empty category must serialize as [], got %v", assets)
+	}
+
+	reordered, _ := ddm.NewDeclarationItems(activation, config)
+	if reordered.DeclarationsToken != items.DeclarationsToken {
+		t.Fatal("DeclarationsToken depends on declaration order")
+	}
+	changed, _ := ddm.NewDeclarationItems(config)
+	if changed.DeclarationsToken == items.DeclarationsToken {
+		t.Fatal("DeclarationsToken unchanged after removing a declaration")
+	}
+	if _, err := ddm.NewDeclarationItems(config, config); err == nil {
+		t.Fatal("duplicate identifier accepted")
+	}
+
+	tokens := items.Tokens(time.Date(2026, 7, 17, 9, 30, 0, 5e8, time.FixedZone("", 3600)))
+	doc, err = tokens.JSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(doc), `"Timestamp": "2026-07-17T08:30:00Z"`) ||
+		!strings.Contains(string(doc), items.DeclarationsToken) {
+		t.Fatalf("tokens = %s", doc)
+	}
+}
+
+func TestSmokeStatusReport(t *testing.T) {
+	passcode, _ := ddm.NewDeclaration("com.example.passcode",
+		&configurations.PasscodeSettings{MinimumLength: ptr.To(int64(8))}, ddm.WithServerToken("p2"))
+	activation, _ := ddm.NewDeclaration("com.example.activation",
+		&activations.Simple{StandardConfigurations: []string{"com.example.passcode"}}, ddm.WithServerToken("a1"))
+	safari, _ := ddm.NewDeclaration("com.example.safari",
+		&configurations.SafariSettings{}, ddm.WithServerToken("s1"))
+	math, _ := ddm.NewDeclaration("com.example.math",
+		&configurations.MathSettings{}, ddm.WithServerToken("m1"))
+	unused, _ := ddm.NewDeclaration("com.example.unused",
+		&configurations.MathSettings{}, ddm.WithServerToken("u1"))
+	items, err := ddm.NewDeclarationItems(passcode, activation, safari, math, unused)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := ddm.ParseStatusReport([]byte(`{
+		"StatusItems": {
+			"device": {
+				"identifier": {"serial-number": "C02XK1JQJG5H"},
+				"operating-system": {"family": "macOS", "version": "15.5", "supplemental": {"extra-version": "(a)"}},
+				"power": {"battery-health": "normal"}
+			},
+			"passcode": {"is-compliant": true},
+			"management": {"declarations": {
+				"activations": [{"identifier": "com.example.activation", "server-token": "a1", "active": true, "valid": "valid"}],
+				"configurations": [
+					{"identifier": "com.example.passcode", "server-token": "p1", "active": true, "valid": "valid"},
+					{"identifier": "com.example.safari", "server-token": "s1", "active": false, "valid": "invalid",
+					 "reasons": [{"code": "Error.ConfigurationCannotBeApplied", "description": "Unsupported", "details": {"Key": "HomePage"}}]},
+					{"identifier": "com.example.unused", "server-token": "u1", "active": false, "valid": "valid"},
+					{"identifier": "com.example.removed", "server-token": "r1", "active": true, "valid": "valid"}
+				],
+				"assets": [],
+				"management": []
+			}}
+		},
+		"Errors": [{"StatusItem": "device.model.marketing-name", "Reasons": [{"Code": "Error.NotSupported"}]}],
+		"FullReport": true
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := report.Device
+	if !report.FullReport || d.SerialNumber == nil || *d.SerialNumber != "C02XK1JQJG5H" ||
+		*d.OSVersion != "15.5" || *d.OSSupplementalExtraVersion != "(a)" || *d.BatteryHealth != "normal" {
+		t.Fatalf("device = %+v", d)
+	}
+	if d.UDID != nil || d.ModelFamily != nil {
+		t.Fatal("absent device properties must be nil")
+	}
+	if v, ok := report.Item("passcode.is-compliant"); !ok || v != true {
+		t.Fatalf("passcode.is-compliant = %v, %v", v, ok)
+	}
+	if _, ok := report.Item("passcode.is-present"); ok {
+		t.Fatal("absent status item reported present")
+	}
+	if len(report.Errors) != 1 || report.Errors[0].StatusItem != "device.model.marketing-name" ||
+		report.Errors[0].Reasons[0].Code != "Error.NotSupported" {
+		t.Fatalf("errors = %+v", report.Errors)
+	}
+
+	diff := report.Declarations.Diff(items)
+	if diff.InSync() {
+		t.Fatal("diff reports in sync")
+	}
+	if len(diff.Missing) != 1 || diff.Missing[0].Identifier != "com.example.math" {
+		t.Fatalf("missing = %+v", diff.Missing)
+	}
+	if len(diff.Stale) != 1 || diff.Stale[0].Status.ServerToken != "p1" || diff.Stale[0].ServerToken != "p2" {
+		t.Fatalf("stale = %+v", diff.Stale)
+	}
+	if len(diff.Unexpected) != 1 || diff.Unexpected[0].Identifier != "com.example.removed" {
+		t.Fatalf("unexpected = %+v", diff.Unexpected)
+	}
+	if len(diff.Invalid) != 1 || diff.Invalid[0].Reasons[0].Details["Key"] != "HomePage" {
+		t.Fatalf("invalid = %+v", diff.Invalid)
+	}
+	if len(diff.Inactive) != 1 || diff.Inactive[0].Identifier != "com.example.unused" {
+		t.Fatalf("inactive = %+v", diff.Inactive)
+	}
+
+	current, _ := ddm.NewDeclarationItems(activation, unused)
+	all := report.Declarations
+	synced := &ddm.DeclarationsStatus{Activations: all.Activations, Configurations: all.Configurations[2:3]}
+	if d := synced.Diff(current); !d.InSync() || len(d.Inactive) != 1 {
+		t.Fatalf("in-sync diff = %+v", d)
+	}
+
+	if _, err := ddm.ParseStatusReport([]byte(`{"Errors": []}`)); err == nil {
+		t.Fatal("report without StatusItems accepted")
+	}
+}
+
 func TestSmokeValidationRejectsBadConfig(t *testing.T) {
 	// Spec: MinimumLength has range 0..16.
 	_, err := ddm.BuildDeclaration("com.example.passcode", &configurations.PasscodeSettings{
@@ -147,3 +432,137 @@ func TestSmokeValidateHelpersComposable(t *testing.T) {
 		t.Fatalf("joined validation errors = %v", err)
 	}
 }
+
+func plistDoc(body string) []byte {
+	return []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0"><dict>` + body + `</dict></plist>`)
+}
+
+func TestSmokeCheckinParse(t *testing.T) {
+	msg, err := checkin.Parse(plistDoc(`
+		<key>MessageType</key><string>Authenticate</string>
+		<key>Topic</key><string>com.apple.mgmt.External.abc</string>
+		<key>UDID</key><string>0000-1111</string>
+		<key>DeviceName</key><string>Lab Mac</string>
+		<key>Model</key><string>Mac14,3</string>
+		<key>ModelName</key><string>Mac mini</string>
+		<key>SerialNumber</key><string>C02XYZ</string>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth, ok := msg.(*checkin.Authenticate)
+	if !ok {
+		t.Fatalf("Parse returned %T", msg)
+	}
+	if auth.UDID == nil || *auth.UDID != "0000-1111" || auth.SerialNumber == nil || *auth.SerialNumber != "C02XYZ" {
+		t.Fatalf("Authenticate = %+v", auth)
+	}
+	if auth.IMEI != nil {
+		t.Fatal("absent optional key was allocated")
+	}
+
+	msg, err = checkin.Parse(plistDoc(`
+		<key>MessageType</key><string>TokenUpdate</string>
+		<key>Topic</key><string>com.apple.mgmt.External.abc</string>
+		<key>UDID</key><string>0000-1111</string>
+		<key>PushMagic</key><string>magic</string>
+		<key>Token</key><data>3q2+7w==</data>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tu, ok := msg.(*checkin.TokenUpdate)
+	if !ok || string(tu.Token) != "\xde\xad\xbe\xef" {
+		t.Fatalf("TokenUpdate = %#v", msg)
+	}
+
+	msg, err = checkin.Parse(plistDoc(`
+		<key>MessageType</key><string>CheckOut</string>
+		<key>Topic</key><string>com.apple.mgmt.External.abc</string>
+		<key>UDID</key><string>0000-1111</string>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := msg.(*checkin.CheckOut); !ok {
+		t.Fatalf("Parse returned %T", msg)
+	}
+}
+
+func TestSmokeCheckinParseRejects(t *testing.T) {
+	for name, body := range map[string]string{
+		"unknown type": `<key>MessageType</key><string>Bogus</string>`,
+		"no type":      `<key>UDID</key><string>0000-1111</string>`,
+		"wrong type":   `<key>MessageType</key><string>TokenUpdate</string><key>Token</key><string>x</string>`,
+	} {
+		if _, err := checkin.Parse(plistDoc(body)); err == nil || !strings.HasPrefix(err.Error(), "checkin: ") {
+			t.Errorf("%s: err = %v", name, err)
+		}
+	}
+}
+
+func TestSmokeCommandResultAcknowledged(t *testing.T) {
+	r, err := mdm.ParseCommandResult(plistDoc(`
+		<key>UDID</key><string>0000-1111</string>
+		<key>CommandUUID</key><string>cmd-1</string>
+		<key>Status</key><string>Acknowledged</string>
+		<key>InstalledApplicationList</key><array>
+			<dict>
+				<key>Identifier</key><string>com.example.app</string>
+				<key>Name</key><string>Example</string>
+				<key>BundleSize</key><integer>4096</integer>
+			</dict>
+		</array>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Err() != nil || r.CommandUUID != "cmd-1" || r.UDID != "0000-1111" {
+		t.Fatalf("result = %+v", r)
+	}
+	var resp commands.InstalledApplicationListResponse
+	if err := r.DecodeResponse(&resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.InstalledApplicationList) != 1 {
+		t.Fatalf("response = %+v", resp)
+	}
+	app := resp.InstalledApplicationList[0]
+	if *app.Identifier != "com.example.app" || *app.BundleSize != 4096 || app.Version != nil {
+		t.Fatalf("app = %+v", app)
+	}
+}
+
+func TestSmokeCommandResultErrorChain(t *testing.T) {
+	r, err := mdm.ParseCommandResult(plistDoc(`
+		<key>CommandUUID</key><string>cmd-2</string>
+		<key>Status</key><string>Error</string>
+		<key>ErrorChain</key><array>
+			<dict>
+				<key>ErrorCode</key><integer>12021</integer>
+				<key>ErrorDomain</key><string>MCMDMErrorDomain</string>
+				<key>LocalizedDescription</key><string>Unknown command</string>
+			</dict>
+		</array>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cmdErr *mdm.CommandError
+	if !errors.As(r.Err(), &cmdErr) {
+		t.Fatalf("Err() = %v", r.Err())
+	}
+	if !cmdErr.Has("MCMDMErrorDomain", 12021) || cmdErr.Has("MCMDMErrorDomain", 1) {
+		t.Fatalf("Has mismatch for %v", cmdErr)
+	}
+	if !strings.Contains(cmdErr.Error(), "Unknown command") {
+		t.Fatalf("Error() = %q", cmdErr.Error())
+	}
+}
+
+func TestSmokeCommandResultIdle(t *testing.T) {
+	r, err := mdm.ParseCommandResult(plistDoc(`<key>Status</key><string>Idle</string>`))
+	if err != nil || r.Status != mdm.StatusIdle {
+		t.Fatalf("idle result = %+v, %v", r, err)
+	}
+	if _, err := mdm.ParseCommandResult(plistDoc(`<key>Status</key><string>Acknowledged</string>`)); err == nil {
+		t.Fatal("acknowledgement without CommandUUID accepted")
+	}
+}
@@ -0,0 +1,360 @@
+// Package appattest validates App Attest attestations and assertions on the
+// server, following Apple's "Validating apps that connect to your server".
+//
+// An app first attests a new key: the server checks the attestation with
+// VerifyAttestation and stores the returned public key and a counter of 0.
+// Each later request carries an assertion, which VerifyAssertion checks with
+// the stored key before the server stores the returned counter.
+package appattest
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Environment values reported in Attestation.Environment.
+const (
+	EnvironmentProduction  = "production"
+	EnvironmentDevelopment = "development"
+)
+
+// AAGUIDs identifying the App Attest environment in authenticator data.
+var (
+	aaguidProduction  = []byte("appattest\x00\x00\x00\x00\x00\x00\x00")
+	aaguidDevelopment = []byte("appattestdevelop")
+)
+
+// oidNonce is the credential certificate extension that carries the nonce.
+var oidNonce = asn1.ObjectIdentifier{1, 2, 840, 113635, 100, 8, 2}
+
+var (
+	// ErrInvalidAttestation is returned (wrapped) when an attestation fails
+	// validation.
+	ErrInvalidAttestation = errors.New("appattest: invalid attestation")
+	// ErrInvalidAssertion is returned (wrapped) when an assertion fails
+	// validation.
+	ErrInvalidAssertion = errors.New("appattest: invalid assertion")
+)
+
+// Verifier validates App Attest data for one app.
+//
+// The root certificate is not bundled; download the Apple App Attestation
+// Root CA from https://www.apple.com/certificateauthority/ and parse it with
+// x509.ParseCertificate.
+type Verifier struct {
+	roots        *x509.CertPool
+	appIDHash    [32]byte
+	environments []string
+	now          func() time.Time
+}
+
+// VerifierOption configures a Verifier.
+type VerifierOption func(*Verifier)
+
+// WithEnvironments sets the App Attest environments whose attestations are
+// accepted (default: EnvironmentProduction only). Accept
+// EnvironmentDevelopment for apps built with the development entitlement.
+func WithEnvironments(environments ...string) VerifierOption {
+	return func(v *Verifier) { v.environments = environments }
+}
+
+// WithClock sets the clock used to check certificate validity.
+func WithClock(now func() time.Time) VerifierOption {
+	return func(v *Verifier) { v.now = now }
+}
+
+// NewVerifier returns a Verifier for the app with the given team ID and
+// bundle ID that trusts root.
+func NewVerifier(teamID, bundleID string, root *x509.Certificate, opts ...VerifierOption) (*Verifier, error) {
+	if teamID == "" || bundleID == "" {
+		return nil, fmt.Errorf("appattest: team ID and bundle ID are required")
+	}
+	if root == nil {
+		return nil, fmt.Errorf("appattest: root certificate is required")
+	}
+	pool := x509.NewCertPool()
+	pool.AddCert(root)
+	v := &Verifier{
+		roots:        pool,
+		appIDHash:    sha256.Sum256([]byte(teamID + "." + bundleID)),
+		environments: []string{EnvironmentProduction},
+		now:          time.Now,
+	}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v, nil
+}
+
+// Attestation is a validated App Attest key.
+type Attestation struct {
+	// KeyID is the base64 key identifier the app received from
+	// generateKey.
+	KeyID string
+	// PublicKey verifies the app's later assertions; store it with KeyID.
+	PublicKey *ecdsa.PublicKey
+	// Environment is EnvironmentProduction or EnvironmentDevelopment.
+	Environment string
+	// Receipt can be exchanged with Apple for fraud risk metrics.
+	Receipt []byte
+}
+
+// Assertion is a validated App Attest assertion.
+type Assertion struct {
+	// Counter is the key's sign counter; store it and pass it to the next
+	// VerifyAssertion call.
+	Counter uint32
+}
+
+// authenticatorData is the parsed WebAuthn authenticator data.
+type authenticatorData struct {
+	rpIDHash     []byte
+	counter      uint32
+	aaguid       []byte
+	credentialID []byte
+}
+
+// VerifyAttestation validates the attestation object an app produced with
+// attestKey for keyID, over the SHA-256 hash of challenge, the one-time
+// value the server sent the app.
+func (v *Verifier) VerifyAttestation(keyID string, attestation, challenge []byte) (*Attestation, error) {
+	att, err := v.verifyAttestation(keyID, attestation, challenge)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidAttestation, err)
+	}
+	return att, nil
+}
+
+func (v *Verifier) verifyAttestation(keyID string, attestation, challenge []byte) (*Attestation, error) {
+	rawKeyID, err := base64.StdEncoding.DecodeString(keyID)
+	if err != nil {
+		return nil, fmt.Errorf("key ID is not base64: %v", err)
+	}
+
+	decoded, err := decodeCBOR(attestation)
+	if err != nil {
+		return nil, err
+	}
+	obj, ok := decoded.(map[any]any)
+	if !ok {
+		return nil, fmt.Errorf("attestation is not a map")
+	}
+	if format, _ := obj["fmt"].(string); format != "apple-appattest" {
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+	stmt, _ := obj["attStmt"].(map[any]any)
+	rawAuthData, _ := obj["authData"].([]byte)
+	if stmt == nil || rawAuthData == nil {
+		return nil, fmt.Errorf("attestation is missing attStmt or authData")
+	}
+	x5c, _ := stmt["x5c"].([]any)
+	receipt, _ := stmt["receipt"].([]byte)
+	if len(x5c) < 2 {
+		return nil, fmt.Errorf("x5c must hold the credential and intermediate certificates")
+	}
+
+	// 1. The credential certificate chains to the App Attestation root.
+	certs := make([]*x509.Certificate, len(x5c))
+	for i, raw := range x5c {
+		der, ok := raw.([]byte)
+		if !ok {
+			return nil, fmt.Errorf("x5c[%d] is not a byte string", i)
+		}
+		if certs[i], err = x509.ParseCertificate(der); err != nil {
+			return nil, fmt.Errorf("x5c[%d]: %v", i, err)
+		}
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	credCert := certs[0]
+	if _, err := credCert.Verify(x509.VerifyOptions{
+		Roots:         v.roots,
+		Intermediates: intermediates,
+		CurrentTime:   v.now(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}); err != nil {
+		return nil, fmt.Errorf("certificate chain: %v", err)
+	}
+
+	// 2-4. The certificate's nonce extension matches
+	// SHA256(authData || SHA256(challenge)).
+	clientDataHash := sha256.Sum256(challenge)
+	nonce := sha256.Sum256(append(bytes.Clone(rawAuthData), clientDataHash[:]...))
+	certNonce, err := credentialNonce(credCert)
+	if err != nil {
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare(certNonce, nonce[:]) != 1 {
+		return nil, fmt.Errorf("nonce does not match the challenge")
+	}
+
+	// 5. The key ID is the SHA-256 hash of the credential public key.
+	publicKey, ok := credCert.PublicKey.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("credential key is not ECDSA")
+	}
+	point, err := publicKey.ECDH()
+	if err != nil {
+		return nil, fmt.Errorf("credential key: %v", err)
+	}
+	if keyHash := sha256.Sum256(point.Bytes()); !bytes.Equal(keyHash[:], rawKeyID) {
+		return nil, fmt.Errorf("key ID does not match the credential key")
+	}
+
+	// 6-9. The authenticator data is for this app, unused, in an accepted
+	// environment and for this key.
+	authData, err := parseAuthenticatorData(rawAuthData, true)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(authData.rpIDHash, v.appIDHash[:]) {
+		return nil, fmt.Errorf("app ID does not match")
+	}
+	if authData.counter != 0 {
+		return nil, fmt.Errorf("counter is %d, want 0", authData.counter)
+	}
+	environment, err := v.environment(authData.aaguid)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(authData.credentialID, rawKeyID) {
+		return nil, fmt.Errorf("credential ID does not match the key ID")
+	}
+
+	return &Attestation{
+		KeyID:       keyID,
+		PublicKey:   publicKey,
+		Environment: environment,
+		Receipt:     receipt,
+	}, nil
+}
+
+// VerifyAssertion validates an assertion an app produced with
+// generateAssertion over the SHA-256 hash of clientData. publicKey and
+// lastCounter are the stored values for the key; the counter must increase
+// with every assertion.
+func (v *Verifier) VerifyAssertion(assertion, clientData []byte, publicKey *ecdsa.PublicKey, lastCounter uint32) (*Assertion, error) {
+	a, err := v.verifyAssertion(assertion, clientData, publicKey, lastCounter)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidAssertion, err)
+	}
+	return a, nil
+}
+
+func (v *Verifier) verifyAssertion(assertion, clientData []byte, publicKey *ecdsa.PublicKey, lastCounter uint32) (*Assertion, error) {
+	if publicKey == nil {
+		return nil, fmt.Errorf("public key is required")
+	}
+
+	decoded, err := decodeCBOR(assertion)
+	if err != nil {
+		return nil, err
+	}
+	obj, ok := decoded.(map[any]any)
+	if !ok {
+		return nil, fmt.Errorf("assertion is not a map")
+	}
+	signature, _ := obj["signature"].([]byte)
+	rawAuthData, _ := obj["authenticatorData"].([]byte)
+	if signature == nil || rawAuthData == nil {
+		return nil, fmt.Errorf("assertion is missing signature or authenticatorData")
+	}
+
+	// 1-3. The signature covers SHA256(authenticatorData || SHA256(clientData)).
+	clientDataHash := sha256.Sum256(clientData)
+	nonce := sha256.Sum256(append(bytes.Clone(rawAuthData), clientDataHash[:]...))
+	digest := sha256.Sum256(nonce[:])
+	if !ecdsa.VerifyASN1(publicKey, digest[:], signature) {
+		return nil, fmt.Errorf("signature does not verify")
+	}
+
+	// 4-5. The authenticator data is for this app and the counter advanced.
+	authData, err := parseAuthenticatorData(rawAuthData, false)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(authData.rpIDHash, v.appIDHash[:]) {
+		return nil, fmt.Errorf("app ID does not match")
+	}
+	if authData.counter <= lastCounter {
+		return nil, fmt.Errorf("counter %d does not exceed %d", authData.counter, lastCounter)
+	}
+
+	return &Assertion{Counter: authData.counter}, nil
+}
+
+// environment maps an AAGUID to an accepted environment.
+func (v *Verifier) environment(aaguid []byte) (string, error) {
+	var environment string
+	switch {
+	case bytes.Equal(aaguid, aaguidProduction):
+		environment = EnvironmentProduction
+	case bytes.Equal(aaguid, aaguidDevelopment):
+		environment = EnvironmentDevelopment
+	default:
+		return "", fmt.Errorf("unknown AAGUID %x", aaguid)
+	}
+	for _, accepted := range v.environments {
+		if accepted == environment {
+			return environment, nil
+		}
+	}
+	return "", fmt.Errorf("%s environment is not accepted", environment)
+}
+
+// credentialNonce extracts the nonce from the credential certificate, stored
+// as SEQUENCE { [1] EXPLICIT OCTET STRING }.
+func credentialNonce(cert *x509.Certificate) ([]byte, error) {
+	for _, ext := range cert.Extensions {
+		if !ext.Id.Equal(oidNonce) {
+			continue
+		}
+		var value struct {
+			Nonce []byte `asn1:"explicit,tag:1"`
+		}
+		if _, err := asn1.Unmarshal(ext.Value, &value); err != nil {
+			return nil, fmt.Errorf("nonce extension: %v", err)
+		}
+		return value.Nonce, nil
+	}
+	return nil, fmt.Errorf("credential certificate has no nonce extension")
+}
+
+// parseAuthenticatorData parses authenticator data; attested data also
+// carries the AAGUID and credential ID.
+func parseAuthenticatorData(data []byte, attested bool) (*authenticatorData, error) {
+	const header = 32 + 1 + 4
+	if len(data) < header {
+		return nil, fmt.Errorf("authenticator data is %d bytes, want at least %d", len(data), header)
+	}
+	a := &authenticatorData{
+		rpIDHash: data[:32],
+		counter:  binary.BigEndian.Uint32(data[33:37]),
+	}
+	if !attested {
+		return a, nil
+	}
+
+	rest := data[header:]
+	if len(rest) < 18 {
+		return nil, fmt.Errorf("authenticator data has no attested credential data")
+	}
+	a.aaguid = rest[:16]
+	idLen := int(binary.BigEndian.Uint16(rest[16:18]))
+	if len(rest) < 18+idLen {
+		return nil, fmt.Errorf("credential ID length %d exceeds authenticator data", idLen)
+	}
+	a.credentialID = rest[18 : 18+idLen]
+	return a, nil
+}
@@ -0,0 +1,363 @@
+package appattest
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/binary"
+	"math/big"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	teamID   = "TEAM123456"
+	bundleID = "com.example.app"
+)
+
+var testNow = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// encodeCBOR encodes the subset of values decodeCBOR supports.
+func encodeCBOR(v any) []byte {
+	head := func(major byte, n uint64) []byte {
+		switch {
+		case n < 24:
+			return []byte{major<<5 | byte(n)}
+		case n < 1<<8:
+			return []byte{major<<5 | 24, byte(n)}
+		case n < 1<<16:
+			return binary.BigEndian.AppendUint16([]byte{major<<5 | 25}, uint16(n))
+		default:
+			return binary.BigEndian.AppendUint32([]byte{major<<5 | 26}, uint32(n))
+		}
+	}
+	switch t := v.(type) {
+	case int:
+		if t < 0 {
+			return head(1, uint64(-1-t))
+		}
+		return head(0, uint64(t))
+	case []byte:
+		return append(head(2, uint64(len(t))), t...)
+	case string:
+		return append(head(3, uint64(len(t))), t...)
+	case []any:
+		out := head(4, uint64(len(t)))
+		for _, item := range t {
+			out = append(out, encodeCBOR(item)...)
+		}
+		return out
+	case map[string]any:
+		keys := make([]string, 0, len(t))
+		for k := range t {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		out := head(5, uint64(len(t)))
+		for _, k := range keys {
+			out = append(out, encodeCBOR(k)...)
+			out = append(out, encodeCBOR(t[k])...)
+		}
+		return out
+	}
+	panic("unsupported type")
+}
+
+// testCA issues App Attest-shaped credential certificates.
+type testCA struct {
+	root, intermediate *x509.Certificate
+	intermediateKey    *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	rootKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	rootTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test App Attestation Root CA"},
+		NotBefore:             testNow.AddDate(-1, 0, 0),
+		NotAfter:              testNow.AddDate(10, 0, 0),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	rootDER, err := x509.CreateCertificate(rand.Reader, rootTmpl, rootTmpl, &rootKey.PublicKey, rootKey)
+	require.NoError(t, err)
+	root, err := x509.ParseCertificate(rootDER)
+	require.NoError(t, err)
+
+	intKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	intTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(2),
+		Subject:               pkix.Name{CommonName: "Test App Attestation CA 1"},
+		NotBefore:             testNow.AddDate(-1, 0, 0),
+		NotAfter:              testNow.AddDate(5, 0, 0),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	intDER, err := x509.CreateCertificate(rand.Reader, intTmpl, root, &intKey.PublicKey, rootKey)
+	require.NoError(t, err)
+	intermediate, err := x509.ParseCertificate(intDER)
+	require.NoError(t, err)
+
+	return &testCA{root: root, intermediate: intermediate, intermediateKey: intKey}
+}
+
+// device is an App Attest key on a simulated device.
+type device struct {
+	key   *ecdsa.PrivateKey
+	keyID []byte
+}
+
+func newDevice(t *testing.T) *device {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	point, err := key.PublicKey.ECDH()
+	require.NoError(t, err)
+	keyID := sha256.Sum256(point.Bytes())
+	return &device{key: key, keyID: keyID[:]}
+}
+
+func (d *device) KeyID() string {
+	return base64.StdEncoding.EncodeToString(d.keyID)
+}
+
+func authData(appID string, counter uint32, aaguid, credentialID []byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(appID))
+	out := append(rpIDHash[:], 0x40)
+	out = binary.BigEndian.AppendUint32(out, counter)
+	if aaguid != nil {
+		out = append(out, aaguid...)
+		out = binary.BigEndian.AppendUint16(out, uint16(len(credentialID)))
+		out = append(out, credentialID...)
+	}
+	return out
+}
+
+// attestKey builds the attestation object the device returns for challenge.
+func (d *device) attestKey(t *testing.T, ca *testCA, challenge, rawAuthData []byte) []byte {
+	t.Helper()
+	clientDataHash := sha256.Sum256(challenge)
+	nonce := sha256.Sum256(append(bytes.Clone(rawAuthData), clientDataHash[:]...))
+	ext, err := asn1.Marshal(struct {
+		Nonce []byte `asn1:"explicit,tag:1"`
+	}{nonce[:]})
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber:    big.NewInt(3),
+		Subject:         pkix.Name{CommonName: "credential"},
+		NotBefore:       testNow.Add(-time.Hour),
+		NotAfter:        testNow.AddDate(0, 0, 3),
+		KeyUsage:        x509.KeyUsageDigitalSignature,
+		ExtraExtensions: []pkix.Extension{{Id: oidNonce, Value: ext}},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.intermediate, &d.key.PublicKey, ca.intermediateKey)
+	require.NoError(t, err)
+
+	return encodeCBOR(map[string]any{
+		"fmt": "apple-appattest",
+		"attStmt": map[string]any{
+			"x5c":     []any{der, ca.intermediate.Raw},
+			"receipt": []byte("receipt"),
+		},
+		"authData": rawAuthData,
+	})
+}
+
+// generateAssertion builds an assertion over clientData.
+func (d *device) generateAssertion(t *testing.T, clientData, rawAuthData []byte) []byte {
+	t.Helper()
+	clientDataHash := sha256.Sum256(clientData)
+	nonce := sha256.Sum256(append(bytes.Clone(rawAuthData), clientDataHash[:]...))
+	digest := sha256.Sum256(nonce[:])
+	sig, err := ecdsa.SignASN1(rand.Reader, d.key, digest[:])
+	require.NoError(t, err)
+	return encodeCBOR(map[string]any{
+		"signature":         sig,
+		"authenticatorData": rawAuthData,
+	})
+}
+
+func newTestVerifier(t *testing.T, ca *testCA, opts ...VerifierOption) *Verifier {
+	t.Helper()
+	v, err := NewVerifier(teamID, bundleID, ca.root, append([]VerifierOption{WithClock(func() time.Time { return testNow })}, opts...)...)
+	require.NoError(t, err)
+	return v
+}
+
+func TestVerifyAttestation(t *testing.T) {
+	ca := newTestCA(t)
+	d := newDevice(t)
+	challenge := []byte("one-time-challenge")
+	obj := d.attestKey(t, ca, challenge, authData(teamID+"."+bundleID, 0, aaguidProduction, d.keyID))
+
+	att, err := newTestVerifier(t, ca).VerifyAttestation(d.KeyID(), obj, challenge)
+
+	require.NoError(t, err)
+	assert.Equal(t, d.KeyID(), att.KeyID)
+	assert.True(t, att.PublicKey.Equal(&d.key.PublicKey))
+	assert.Equal(t, EnvironmentProduction, att.Environment)
+	assert.Equal(t, []byte("receipt"), att.Receipt)
+}
+
+func TestVerifyAttestation_Rejects(t *testing.T) {
+	ca := newTestCA(t)
+	d := newDevice(t)
+	appID := teamID + "." + bundleID
+	challenge := []byte("one-time-challenge")
+
+	tests := []struct {
+		name      string
+		keyID     string
+		obj       func() []byte
+		challenge []byte
+		verifier  *Verifier
+		wantErr   string
+	}{
+		{
+			name:      "different challenge",
+			obj:       func() []byte { return d.attestKey(t, ca, challenge, authData(appID, 0, aaguidProduction, d.keyID)) },
+			challenge: []byte("replayed"),
+			wantErr:   "nonce does not match",
+		},
+		{
+			name: "other app",
+			obj: func() []byte {
+				return d.attestKey(t, ca, challenge, authData("TEAM123456.com.example.other", 0, aaguidProduction, d.keyID))
+			},
+			wantErr: "app ID does not match",
+		},
+		{
+			name:    "non-zero counter",
+			obj:     func() []byte { return d.attestKey(t, ca, challenge, authData(appID, 1, aaguidProduction, d.keyID)) },
+			wantErr: "counter is 1",
+		},
+		{
+			name:    "development key in production",
+			obj:     func() []byte { return d.attestKey(t, ca, challenge, authData(appID, 0, aaguidDevelopment, d.keyID)) },
+			wantErr: "development environment is not accepted",
+		},
+		{
+			name:    "key ID of another key",
+			keyID:   newDevice(t).KeyID(),
+			obj:     func() []byte { return d.attestKey(t, ca, challenge, authData(appID, 0, aaguidProduction, d.keyID)) },
+			wantErr: "key ID does not match",
+		},
+		{
+			name:     "untrusted root",
+			obj:      func() []byte { return d.attestKey(t, ca, challenge, authData(appID, 0, aaguidProduction, d.keyID)) },
+			verifier: newTestVerifier(t, newTestCA(t)),
+			wantErr:  "certificate chain",
+		},
+		{
+			name:    "wrong format",
+			obj:     func() []byte { return encodeCBOR(map[string]any{"fmt": "packed"}) },
+			wantErr: `unsupported format "packed"`,
+		},
+		{
+			name:    "not CBOR",
+			obj:     func() []byte { return []byte{0xff} },
+			wantErr: "cbor",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keyID := tt.keyID
+			if keyID == "" {
+				keyID = d.KeyID()
+			}
+			c := tt.challenge
+			if c == nil {
+				c = challenge
+			}
+			v := tt.verifier
+			if v == nil {
+				v = newTestVerifier(t, ca)
+			}
+
+			_, err := v.VerifyAttestation(keyID, tt.obj(), c)
+
+			require.ErrorIs(t, err, ErrInvalidAttestation)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestVerifyAttestation_Development(t *testing.T) {
+	ca := newTestCA(t)
+	d := newDevice(t)
+	obj := d.attestKey(t, ca, []byte("c"), authData(teamID+"."+bundleID, 0, aaguidDevelopment, d.keyID))
+
+	att, err := newTestVerifier(t, ca, WithEnvironments(EnvironmentProduction, EnvironmentDevelopment)).
+		VerifyAttestation(d.KeyID(), obj, []byte("c"))
+
+	require.NoError(t, err)
+	assert.Equal(t, EnvironmentDevelopment, att.Environment)
+}
+
+func TestVerifyAssertion(t *testing.T) {
+	ca := newTestCA(t)
+	v := newTestVerifier(t, ca)
+	d := newDevice(t)
+	appID := teamID + "." + bundleID
+	clientData := []byte(`{"challenge":"abc","action":"purchase"}`)
+
+	a, err := v.VerifyAssertion(d.generateAssertion(t, clientData, authData(appID, 1, nil, nil)), clientData, &d.key.PublicKey, 0)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), a.Counter)
+
+	t.Run("replayed counter", func(t *testing.T) {
+		_, err := v.VerifyAssertion(d.generateAssertion(t, clientData, authData(appID, 1, nil, nil)), clientData, &d.key.PublicKey, 1)
+		require.ErrorIs(t, err, ErrInvalidAssertion)
+		assert.Contains(t, err.Error(), "counter 1 does not exceed 1")
+	})
+
+	t.Run("tampered client data", func(t *testing.T) {
+		_, err := v.VerifyAssertion(d.generateAssertion(t, clientData, authData(appID, 2, nil, nil)), []byte(`{"challenge":"abc","action":"refund"}`), &d.key.PublicKey, 1)
+		require.ErrorIs(t, err, ErrInvalidAssertion)
+		assert.Contains(t, err.Error(), "signature does not verify")
+	})
+
+	t.Run("other key", func(t *testing.T) {
+		_, err := v.VerifyAssertion(d.generateAssertion(t, clientData, authData(appID, 2, nil, nil)), clientData, &newDevice(t).key.PublicKey, 1)
+		assert.ErrorIs(t, err, ErrInvalidAssertion)
+	})
+
+	t.Run("other app", func(t *testing.T) {
+		_, err := v.VerifyAssertion(d.generateAssertion(t, clientData, authData("TEAM123456.com.example.other", 2, nil, nil)), clientData, &d.key.PublicKey, 1)
+		require.ErrorIs(t, err, ErrInvalidAssertion)
+		assert.Contains(t, err.Error(), "app ID does not match")
+	})
+}
+
+func TestDecodeCBOR(t *testing.T) {
+	v, err := decodeCBOR(encodeCBOR(map[string]any{"a": []any{1, -2, "x", []byte{1}}}))
+	require.NoError(t, err)
+	assert.Equal(t, map[any]any{"a": []any{int64(1), int64(-2), "x", []byte{1}}}, v)
+
+	_, err = decodeCBOR([]byte{0x5a, 0xff, 0xff, 0xff, 0xff})
+	assert.ErrorContains(t, err, "exceeds data")
+
+	_, err = decodeCBOR([]byte{0x01, 0x02})
+	assert.ErrorContains(t, err, "trailing bytes")
+
+	deep := bytes.Repeat([]byte{0x81}, maxCBORDepth+2)
+	_, err = decodeCBOR(append(deep, 0x00))
+	assert.ErrorContains(t, err, "nesting too deep")
+}
@@ -0,0 +1,52 @@
+package client
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/es256"
+	"resty.dev/v3"
+)
+
+// AuthProvider interface for different authentication methods
+type AuthProvider interface {
+	ApplyAuth(req *resty.Request) error
+}
+
+// JWTAuth implements JWT Bearer authentication for the DeviceCheck API.
+// Requests are authorized with an ES256 token signed by a DeviceCheck key
+// from the developer account; one token is shared by every request until it
+// is replaced after TokenLifetime. It is safe for concurrent use.
+type JWTAuth struct {
+	signer *es256.Signer
+}
+
+// JWTAuthConfig holds configuration for JWT authentication
+type JWTAuthConfig struct {
+	KeyID      string
+	TeamID     string
+	PrivateKey *ecdsa.PrivateKey
+}
+
+// NewJWTAuth creates a new JWT authentication provider
+func NewJWTAuth(config JWTAuthConfig) *JWTAuth {
+	return &JWTAuth{
+		signer: es256.NewSigner(config.KeyID, config.TeamID, config.PrivateKey, TokenLifetime),
+	}
+}
+
+// ApplyAuth applies JWT Bearer authentication to the request
+func (j *JWTAuth) ApplyAuth(req *resty.Request) error {
+	token, err := j.signer.Token()
+	if err != nil {
+		return fmt.Errorf("failed to get JWT token: %w", err)
+	}
+
+	req.SetAuthToken(token)
+	return nil
+}
+
+// ForceRefresh forces a token refresh on the next request
+func (j *JWTAuth) ForceRefresh() {
+	j.signer.Refresh()
+}
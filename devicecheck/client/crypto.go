@@ -0,0 +1,54 @@
+package client
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"os"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/es256"
+)
+
+// LoadPrivateKeyFromFile loads an ECDSA P-256 private key from a PEM (.p8) file
+func LoadPrivateKeyFromFile(filePath string) (any, error) {
+	keyData, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read private key file: %w", err)
+	}
+
+	return ParsePrivateKey(keyData)
+}
+
+// ParsePrivateKey parses an ECDSA P-256 private key from PEM-encoded PKCS8 or
+// EC data
+func ParsePrivateKey(keyData []byte) (any, error) {
+	key, err := es256.ParsePrivateKey(keyData)
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// LoadPrivateKeyFromEnv loads a private key from the environment variable APPLE_PRIVATE_KEY_PATH
+func LoadPrivateKeyFromEnv() (any, error) {
+	privateKeyPath := os.Getenv("APPLE_PRIVATE_KEY_PATH")
+	if privateKeyPath == "" {
+		return nil, fmt.Errorf("APPLE_PRIVATE_KEY_PATH environment variable is not set")
+	}
+
+	return LoadPrivateKeyFromFile(privateKeyPath)
+}
+
+// ValidatePrivateKey validates that the private key is suitable for signing
+// DeviceCheck API tokens, which must be ES256 (P-256 ECDSA).
+func ValidatePrivateKey(privateKey any) error {
+	if privateKey == nil {
+		return fmt.Errorf("private key is nil")
+	}
+
+	key, ok := privateKey.(*ecdsa.PrivateKey)
+	if !ok {
+		return fmt.Errorf("unsupported private key type: %T (the DeviceCheck API requires an ECDSA P-256 key)", privateKey)
+	}
+
+	return es256.ValidateKey(key)
+}
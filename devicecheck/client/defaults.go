@@ -0,0 +1,20 @@
+package client
+
+import "time"
+
+// DefaultUserAgent is the default User-Agent header value for all requests.
+const (
+	DefaultUserAgent = "go-api-sdk-apple/1.0.0"
+	Version          = "1.0.0"
+)
+
+// The following constants are re-exported from the constants package so that
+// code and tests in the client package can reference them without importing
+// the constants package directly.
+const (
+	DefaultBaseURL     = "https://api.devicecheck.apple.com"
+	DevelopmentBaseURL = "https://api.development.devicecheck.apple.com"
+)
+
+// TokenLifetime is how long a generated JWT is reused before it is replaced.
+const TokenLifetime = 30 * time.Minute
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+// APIError represents an error from the DeviceCheck API.
+// The API returns errors as a plain-text explanation, e.g.
+// "Missing or incorrectly formatted device token payload".
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("API error %d: %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("API error %d: %s", e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+// IsAPIError reports whether err wraps an *APIError with the given HTTP status code.
+func IsAPIError(err error, statusCode int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
+
+// ErrorHandler centralizes error handling for all API requests
+type ErrorHandler struct {
+	logger *zap.Logger
+}
+
+// NewErrorHandler creates a new error handler
+func NewErrorHandler(logger *zap.Logger) *ErrorHandler {
+	return &ErrorHandler{
+		logger: logger,
+	}
+}
+
+// HandleError processes DeviceCheck API error responses and returns structured errors
+func (eh *ErrorHandler) HandleError(resp *resty.Response) error {
+	statusCode := resp.StatusCode()
+	message := strings.TrimSpace(resp.String())
+
+	eh.logger.Error("API request failed",
+		zap.Int("status_code", statusCode),
+		zap.String("message", message),
+		zap.String("url", resp.Request.URL),
+		zap.String("method", resp.Request.Method),
+	)
+
+	return &APIError{StatusCode: statusCode, Message: message}
+}
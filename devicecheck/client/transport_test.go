@@ -0,0 +1,169 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jarcoal/httpmock"
+	"go.uber.org/zap"
+)
+
+func newTestKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	return key
+}
+
+func TestNewTransport_RequiredArguments(t *testing.T) {
+	key := newTestKey(t)
+
+	tests := []struct {
+		name          string
+		keyID, teamID string
+		privateKey    any
+		wantErr       string
+	}{
+		{"missing key ID", "", "TEAM123456", key, "keyID is required"},
+		{"missing team ID", "kid", "", key, "teamID is required"},
+		{"missing key", "kid", "TEAM123456", nil, "privateKey is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewTransport(tt.keyID, tt.teamID, tt.privateKey)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWithDevelopment(t *testing.T) {
+	c, err := NewTransport("kid", "TEAM123456", newTestKey(t), WithDevelopment())
+	if err != nil {
+		t.Fatalf("NewTransport failed: %v", err)
+	}
+	if c.httpClient.BaseURL() != DevelopmentBaseURL {
+		t.Errorf("base URL = %v, want %v", c.httpClient.BaseURL(), DevelopmentBaseURL)
+	}
+}
+
+func TestJWTAuth_SignsBearerToken(t *testing.T) {
+	key := newTestKey(t)
+	c, err := NewTransport("ABC123DEFG", "TEAM123456", key, WithLogger(zap.NewNop()), WithRetryCount(0))
+	if err != nil {
+		t.Fatalf("NewTransport failed: %v", err)
+	}
+
+	httpmock.ActivateNonDefault(c.GetHTTPClient().Client())
+	t.Cleanup(httpmock.DeactivateAndReset)
+
+	var authHeaders []string
+	httpmock.RegisterResponder("POST", DefaultBaseURL+"/v1/validate_device_token", func(req *http.Request) (*http.Response, error) {
+		authHeaders = append(authHeaders, req.Header.Get("Authorization"))
+		return httpmock.NewStringResponse(200, ""), nil
+	})
+
+	for range 2 {
+		if _, err := c.NewRequest(context.Background()).Post("/v1/validate_device_token"); err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+	}
+
+	if authHeaders[0] != authHeaders[1] {
+		t.Error("expected the token to be reused across requests")
+	}
+	token, err := jwt.Parse(strings.TrimPrefix(authHeaders[0], "Bearer "),
+		func(*jwt.Token) (any, error) { return &key.PublicKey, nil },
+		jwt.WithValidMethods([]string{"ES256"}))
+	if err != nil {
+		t.Fatalf("token does not verify: %v", err)
+	}
+	if token.Header["kid"] != "ABC123DEFG" {
+		t.Errorf("kid = %v", token.Header["kid"])
+	}
+	if iss, _ := token.Claims.GetIssuer(); iss != "TEAM123456" {
+		t.Errorf("iss = %v", iss)
+	}
+}
+
+func TestExecute_PlainTextError(t *testing.T) {
+	c, err := NewTransport("kid", "TEAM123456", newTestKey(t), WithLogger(zap.NewNop()), WithRetryCount(0))
+	if err != nil {
+		t.Fatalf("NewTransport failed: %v", err)
+	}
+
+	httpmock.ActivateNonDefault(c.GetHTTPClient().Client())
+	t.Cleanup(httpmock.DeactivateAndReset)
+
+	httpmock.RegisterResponder("POST", DefaultBaseURL+"/v1/query_two_bits",
+		httpmock.NewStringResponder(401, "Unable to verify authorization token\n"))
+
+	_, err = c.NewRequest(context.Background()).Post("/v1/query_two_bits")
+	if !IsAPIError(err, http.StatusUnauthorized) {
+		t.Fatalf("error = %v, want 401 APIError", err)
+	}
+	if !strings.HasSuffix(err.Error(), "Unable to verify authorization token") {
+		t.Errorf("error = %q", err.Error())
+	}
+}
+
+func TestTransport_ConcurrentTokenRefresh(t *testing.T) {
+	c, err := NewTransport("ABC123DEFG", "TEAM123456", newTestKey(t), WithLogger(zap.NewNop()), WithRetryCount(0))
+	if err != nil {
+		t.Fatalf("NewTransport failed: %v", err)
+	}
+	httpmock.ActivateNonDefault(c.GetHTTPClient().Client())
+	t.Cleanup(httpmock.DeactivateAndReset)
+
+	server := &rejectFirstToken{}
+	httpmock.RegisterResponder("POST", DefaultBaseURL+"/v1/validate_device_token", server.respond)
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Go(func() {
+			_, _ = c.NewRequest(context.Background()).Post("/v1/validate_device_token")
+		})
+	}
+	wg.Wait()
+	if _, err := c.NewRequest(context.Background()).Post("/v1/validate_device_token"); err != nil {
+		t.Fatalf("request after refresh failed: %v", err)
+	}
+
+	// Requests rejected with the first token share one replacement rather
+	// than each discarding the one another has just signed.
+	if len(server.tokens) != 2 {
+		t.Errorf("distinct tokens = %d, want 2", len(server.tokens))
+	}
+}
+
+// rejectFirstToken answers 401 to requests bearing the first token it sees
+// and 200 to any other, recording each distinct token.
+type rejectFirstToken struct {
+	mu     sync.Mutex
+	tokens []string
+}
+
+func (r *rejectFirstToken) respond(req *http.Request) (*http.Response, error) {
+	token := req.Header.Get("Authorization")
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !slices.Contains(r.tokens, token) {
+		r.tokens = append(r.tokens, token)
+	}
+	if token == r.tokens[0] {
+		return httpmock.NewStringResponse(401, `{"errors":[{"status":"401","code":"NOT_AUTHORIZED"}]}`), nil
+	}
+	return httpmock.NewStringResponse(200, `{}`), nil
+}
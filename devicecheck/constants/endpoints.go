@@ -0,0 +1,17 @@
+package constants
+
+// API base URLs
+const (
+	// DefaultBaseURL is the base URL of the production DeviceCheck API.
+	DefaultBaseURL = "https://api.devicecheck.apple.com"
+	// DevelopmentBaseURL is the base URL for device tokens from development
+	// builds of the app.
+	DevelopmentBaseURL = "https://api.development.devicecheck.apple.com"
+)
+
+// Endpoint path constants for the DeviceCheck API
+const (
+	EndpointQueryTwoBitsV1        = "/v1/query_two_bits"
+	EndpointUpdateTwoBitsV1       = "/v1/update_two_bits"
+	EndpointValidateDeviceTokenV1 = "/v1/validate_device_token"
+)
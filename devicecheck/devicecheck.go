@@ -0,0 +1,80 @@
+package devicecheck
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-apple/devicecheck/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/devicecheck/devicecheck_api/twobits"
+)
+
+// Client is the main entry point for the DeviceCheck API SDK. App Attest
+// validation needs no API calls; see the appattest package.
+// A Client is safe for concurrent use by multiple goroutines.
+type Client struct {
+	transport      *client.Transport
+	DeviceCheckAPI *DeviceCheckAPIClient
+}
+
+// DeviceCheckAPIClient groups all DeviceCheck API services.
+type DeviceCheckAPIClient struct {
+	TwoBits *twobits.TwoBits
+}
+
+// NewClient creates a new DeviceCheck API client.
+// Parameters:
+//   - keyID: The ID of a key with DeviceCheck enabled
+//   - teamID: Your Apple Developer team ID
+//   - privateKey: The key's private key (*ecdsa.PrivateKey, P-256)
+//   - options: Optional configuration options (WithDevelopment, WithLogger, etc.)
+//
+// Example:
+//
+//	c, err := devicecheck.NewClientFromFile(keyID, teamID, "AuthKey.p8")
+//	bits, _, err := c.DeviceCheckAPI.TwoBits.QueryTwoBitsV1(ctx, &twobits.DeviceRequest{DeviceToken: token})
+func NewClient(keyID, teamID string, privateKey any, options ...client.ClientOption) (*Client, error) {
+	transport, err := client.NewTransport(keyID, teamID, privateKey, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return newClient(transport), nil
+}
+
+// NewClientFromFile creates a client using a private key from file.
+// Parameters:
+//   - keyID: The ID of a key with DeviceCheck enabled
+//   - teamID: Your Apple Developer team ID
+//   - privateKeyPath: Path to the key's private key file (.p8)
+//   - options: Optional configuration options (WithDevelopment, WithLogger, etc.)
+func NewClientFromFile(keyID, teamID, privateKeyPath string, options ...client.ClientOption) (*Client, error) {
+	transport, err := client.NewTransportFromFile(keyID, teamID, privateKeyPath, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return newClient(transport), nil
+}
+
+// NewClientFromEnv creates a client using environment variables.
+// Expects: APPLE_KEY_ID, APPLE_TEAM_ID, and one of APPLE_PRIVATE_KEY_PEM or
+// APPLE_PRIVATE_KEY_PATH.
+func NewClientFromEnv(options ...client.ClientOption) (*Client, error) {
+	transport, err := client.NewTransportFromEnv(options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return newClient(transport), nil
+}
+
+func newClient(transport *client.Transport) *Client {
+	return &Client{
+		transport: transport,
+		DeviceCheckAPI: &DeviceCheckAPIClient{
+			TwoBits: twobits.NewService(transport),
+		},
+	}
+}
+
+// Close releases resources held by the client.
+func (c *Client) Close() error {
+	return c.transport.Close()
+}
@@ -0,0 +1,8 @@
+package twobits
+
+// bitStateNotFound is the plain-text body the query endpoint returns with
+// HTTP 200 when the bits of a device were never set.
+const bitStateNotFound = "Failed to find bit state"
+
+// lastUpdateTimeLayout is the format of BitState.LastUpdateTime.
+const lastUpdateTimeLayout = "2006-01"
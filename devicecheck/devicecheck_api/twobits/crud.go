@@ -0,0 +1,123 @@
+package twobits
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/devicecheck/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/devicecheck/constants"
+	"resty.dev/v3"
+)
+
+// TwoBits handles communication with the two-bits methods of the
+// DeviceCheck API, which store two bits of state per device that survive app
+// reinstalls and device resets.
+//
+// DeviceCheck API docs: https://developer.apple.com/documentation/devicecheck
+type (
+	TwoBits struct {
+		client client.Client
+		now    func() time.Time
+	}
+)
+
+// NewService creates a new two-bits service.
+func NewService(c client.Client) *TwoBits {
+	return &TwoBits{client: c, now: time.Now}
+}
+
+// QueryTwoBitsV1 gets the two bits stored for a device. BitState.Found is
+// false when they were never set.
+// URL: POST https://api.devicecheck.apple.com/v1/query_two_bits
+// https://developer.apple.com/documentation/devicecheck
+func (s *TwoBits) QueryTwoBitsV1(ctx context.Context, request *DeviceRequest) (*BitState, *resty.Response, error) {
+	body, err := s.prepare(request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetBody(body).
+		Post(constants.EndpointQueryTwoBitsV1)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	raw := strings.TrimSpace(resp.String())
+	if raw == "" || raw == bitStateNotFound {
+		return &BitState{}, resp, nil
+	}
+
+	var result BitState
+	if err := json.Unmarshal([]byte(raw), &result); err != nil {
+		return nil, resp, fmt.Errorf("failed to decode two bits: %w", err)
+	}
+	result.Found = true
+
+	return &result, resp, nil
+}
+
+// UpdateTwoBitsV1 sets the two bits stored for a device.
+// URL: POST https://api.devicecheck.apple.com/v1/update_two_bits
+// https://developer.apple.com/documentation/devicecheck
+func (s *TwoBits) UpdateTwoBitsV1(ctx context.Context, request *UpdateRequest) (*resty.Response, error) {
+	if request == nil {
+		return nil, fmt.Errorf("request is required")
+	}
+	device, err := s.prepare(&request.DeviceRequest)
+	if err != nil {
+		return nil, err
+	}
+	body := *request
+	body.DeviceRequest = *device
+
+	return s.client.NewRequest(ctx).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetBody(&body).
+		Post(constants.EndpointUpdateTwoBitsV1)
+}
+
+// ValidateDeviceTokenV1 checks that a device token was generated by a
+// genuine Apple device running the app. An invalid token fails with an
+// HTTP 400 APIError.
+// URL: POST https://api.devicecheck.apple.com/v1/validate_device_token
+// https://developer.apple.com/documentation/devicecheck
+func (s *TwoBits) ValidateDeviceTokenV1(ctx context.Context, request *DeviceRequest) (*resty.Response, error) {
+	body, err := s.prepare(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.NewRequest(ctx).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetBody(body).
+		Post(constants.EndpointValidateDeviceTokenV1)
+}
+
+// prepare validates request and returns a copy with the transaction ID and
+// timestamp filled in.
+func (s *TwoBits) prepare(request *DeviceRequest) (*DeviceRequest, error) {
+	if request == nil || request.DeviceToken == "" {
+		return nil, fmt.Errorf("device token is required")
+	}
+
+	body := *request
+	if body.TransactionID == "" {
+		id := make([]byte, 16)
+		if _, err := rand.Read(id); err != nil {
+			return nil, fmt.Errorf("failed to generate transaction ID: %w", err)
+		}
+		body.TransactionID = hex.EncodeToString(id)
+	}
+	if body.Timestamp == 0 {
+		body.Timestamp = s.now().UnixMilli()
+	}
+	return &body, nil
+}
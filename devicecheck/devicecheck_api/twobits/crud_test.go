@@ -0,0 +1,153 @@
+package twobits
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/devicecheck/client"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+const baseURL = "https://api.devicecheck.apple.com"
+
+// setupMockClient creates a client with httpmock enabled.
+func setupMockClient(t *testing.T) *TwoBits {
+	coreClient, err := client.NewTransport(
+		"test-key-id",
+		"TEAM123456",
+		"dummy-key",
+		client.WithAuth(&MockAuthProvider{}),
+		client.WithLogger(zap.NewNop()),
+		client.WithRetryCount(0),
+	)
+	require.NoError(t, err)
+
+	httpmock.ActivateNonDefault(coreClient.GetHTTPClient().Client())
+
+	t.Cleanup(func() {
+		httpmock.DeactivateAndReset()
+	})
+
+	svc := NewService(coreClient)
+	svc.now = func() time.Time { return time.UnixMilli(1767225600000) }
+	return svc
+}
+
+// MockAuthProvider implements the AuthProvider interface for testing.
+type MockAuthProvider struct{}
+
+func (m *MockAuthProvider) ApplyAuth(req *resty.Request) error {
+	return nil
+}
+
+// bodyResponder records the JSON request body and answers with body.
+func bodyResponder(captured *map[string]any, status int, body string) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		raw, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(raw, captured); err != nil {
+			return nil, err
+		}
+		return httpmock.NewStringResponse(status, body), nil
+	}
+}
+
+func TestQueryTwoBitsV1_Success(t *testing.T) {
+	svc := setupMockClient(t)
+
+	var body map[string]any
+	httpmock.RegisterResponder("POST", baseURL+"/v1/query_two_bits",
+		bodyResponder(&body, 200, `{"bit0": true, "bit1": false, "last_update_time": "2025-11"}`))
+
+	result, resp, err := svc.QueryTwoBitsV1(context.Background(), &DeviceRequest{DeviceToken: "dGVzdA=="})
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.True(t, result.Found)
+	assert.True(t, result.Bit0)
+	assert.False(t, result.Bit1)
+	assert.Equal(t, time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC), result.LastUpdated())
+
+	assert.Equal(t, "dGVzdA==", body["device_token"])
+	assert.Equal(t, float64(1767225600000), body["timestamp"])
+	assert.Len(t, body["transaction_id"], 32)
+}
+
+func TestQueryTwoBitsV1_NeverSet(t *testing.T) {
+	svc := setupMockClient(t)
+
+	var body map[string]any
+	httpmock.RegisterResponder("POST", baseURL+"/v1/query_two_bits",
+		bodyResponder(&body, 200, "Failed to find bit state"))
+
+	result, _, err := svc.QueryTwoBitsV1(context.Background(), &DeviceRequest{DeviceToken: "dGVzdA==", TransactionID: "tx-1"})
+
+	require.NoError(t, err)
+	assert.False(t, result.Found)
+	assert.True(t, result.LastUpdated().IsZero())
+	assert.Equal(t, "tx-1", body["transaction_id"])
+}
+
+func TestQueryTwoBitsV1_BadDeviceToken(t *testing.T) {
+	svc := setupMockClient(t)
+
+	var body map[string]any
+	httpmock.RegisterResponder("POST", baseURL+"/v1/query_two_bits",
+		bodyResponder(&body, 400, "Missing or incorrectly formatted device token payload"))
+
+	result, _, err := svc.QueryTwoBitsV1(context.Background(), &DeviceRequest{DeviceToken: "bad"})
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.True(t, client.IsAPIError(err, http.StatusBadRequest))
+	assert.Contains(t, err.Error(), "incorrectly formatted device token")
+}
+
+func TestQueryTwoBitsV1_MissingDeviceToken(t *testing.T) {
+	svc := setupMockClient(t)
+
+	_, _, err := svc.QueryTwoBitsV1(context.Background(), &DeviceRequest{})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "device token is required")
+}
+
+func TestUpdateTwoBitsV1_Success(t *testing.T) {
+	svc := setupMockClient(t)
+
+	var body map[string]any
+	httpmock.RegisterResponder("POST", baseURL+"/v1/update_two_bits", bodyResponder(&body, 200, ""))
+
+	request := &UpdateRequest{DeviceRequest: DeviceRequest{DeviceToken: "dGVzdA=="}, Bit0: true}
+	resp, err := svc.UpdateTwoBitsV1(context.Background(), request)
+
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	assert.Equal(t, true, body["bit0"])
+	assert.Equal(t, false, body["bit1"])
+	assert.Equal(t, "dGVzdA==", body["device_token"])
+	assert.NotEmpty(t, body["transaction_id"])
+	assert.Empty(t, request.TransactionID, "the caller's request must not be modified")
+}
+
+func TestValidateDeviceTokenV1_Success(t *testing.T) {
+	svc := setupMockClient(t)
+
+	var body map[string]any
+	httpmock.RegisterResponder("POST", baseURL+"/v1/validate_device_token", bodyResponder(&body, 200, ""))
+
+	_, err := svc.ValidateDeviceTokenV1(context.Background(), &DeviceRequest{DeviceToken: "dGVzdA==", Timestamp: 42})
+
+	require.NoError(t, err)
+	assert.Equal(t, float64(42), body["timestamp"])
+}
@@ -0,0 +1,43 @@
+package twobits
+
+import "time"
+
+// DeviceRequest identifies a device and the request made about it. It is the
+// body of the query and validate requests.
+type DeviceRequest struct {
+	// DeviceToken is the base64 token DCDevice generated on the device.
+	DeviceToken string `json:"device_token"`
+	// TransactionID is a unique ID for the request. Generated when empty.
+	TransactionID string `json:"transaction_id"`
+	// Timestamp is the request time in milliseconds since the Unix epoch.
+	// Set to the current time when zero.
+	Timestamp int64 `json:"timestamp"`
+}
+
+// UpdateRequest sets the two bits of a device.
+type UpdateRequest struct {
+	DeviceRequest
+	Bit0 bool `json:"bit0"`
+	Bit1 bool `json:"bit1"`
+}
+
+// BitState is the state Apple stores for a device on behalf of the developer.
+type BitState struct {
+	// Found is false when the bits of the device were never set; the other
+	// fields are then zero.
+	Found bool `json:"-"`
+	Bit0  bool `json:"bit0"`
+	Bit1  bool `json:"bit1"`
+	// LastUpdateTime is the month the bits were last set, as "YYYY-MM".
+	LastUpdateTime string `json:"last_update_time"`
+}
+
+// LastUpdated parses LastUpdateTime into the first day of that month, UTC.
+// It returns the zero time when the bits were never set.
+func (b *BitState) LastUpdated() time.Time {
+	t, err := time.Parse(lastUpdateTimeLayout, b.LastUpdateTime)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
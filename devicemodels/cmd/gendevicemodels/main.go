@@ -0,0 +1,157 @@
+// Command gendevicemodels regenerates the devicemodels lookup table from the
+// committed snapshot (devicemodels/metadata/models.json). Generation is
+// offline and deterministic; CI regenerates and diffs against the committed
+// tree.
+//
+//	go run ./devicemodels/cmd/gendevicemodels
+//
+// With -update it first fetches the Apple support pages listed in the
+// snapshot's sources, merges any model identifiers the snapshot lacks and
+// rewrites the snapshot. Existing entries are never changed, so curated
+// names and exact screen sizes survive a refresh.
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// snapshot is the on-disk form of metadata/models.json.
+type snapshot struct {
+	Sources []source        `json:"sources"`
+	Models  []snapshotModel `json:"models"`
+}
+
+// source is an Apple support page listing model identifiers.
+type source struct {
+	Family string `json:"family"`
+	URL    string `json:"url"`
+}
+
+type snapshotModel struct {
+	Identifier    string  `json:"identifier"`
+	MarketingName string  `json:"marketing_name"`
+	Family        string  `json:"family"`
+	ScreenSize    float64 `json:"screen_size,omitempty"`
+	Year          int     `json:"year"`
+}
+
+var families = map[string]string{
+	"Mac":          "FamilyMac",
+	"iPhone":       "FamilyIPhone",
+	"iPad":         "FamilyIPad",
+	"Apple TV":     "FamilyAppleTV",
+	"Apple Watch":  "FamilyAppleWatch",
+	"Apple Vision": "FamilyAppleVision",
+}
+
+func main() {
+	metadata := flag.String("metadata", filepath.Join("devicemodels", "metadata", "models.json"), "snapshot file")
+	out := flag.String("out", filepath.Join("devicemodels", "models_gen.go"), "generated Go file")
+	update := flag.Bool("update", false, "merge new models from the snapshot's Apple support pages before generating")
+	flag.Parse()
+
+	if err := run(*metadata, *out, *update); err != nil {
+		fmt.Fprintln(os.Stderr, "gendevicemodels:", err)
+		os.Exit(1)
+	}
+}
+
+func run(metadataPath, outPath string, update bool) error {
+	snap, err := loadSnapshot(metadataPath)
+	if err != nil {
+		return err
+	}
+
+	if update {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+		defer cancel()
+		added, err := refresh(ctx, snap)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("gendevicemodels: %d new models\n", added)
+		if err := writeSnapshot(metadataPath, snap); err != nil {
+			return err
+		}
+	}
+
+	if err := validate(snap); err != nil {
+		return err
+	}
+	src, err := generate(snap)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(outPath, src, 0o644)
+}
+
+func loadSnapshot(path string) (*snapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var snap snapshot
+	if err := json.Unmarshal(data, &snap); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &snap, nil
+}
+
+func writeSnapshot(path string, snap *snapshot) error {
+	data, err := json.MarshalIndent(snap, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+func validate(snap *snapshot) error {
+	seen := make(map[string]bool, len(snap.Models))
+	for _, m := range snap.Models {
+		switch {
+		case m.Identifier == "":
+			return fmt.Errorf("model %q has no identifier", m.MarketingName)
+		case seen[m.Identifier]:
+			return fmt.Errorf("duplicate identifier %s", m.Identifier)
+		case m.MarketingName == "":
+			return fmt.Errorf("%s has no marketing name", m.Identifier)
+		case families[m.Family] == "":
+			return fmt.Errorf("%s has unknown family %q", m.Identifier, m.Family)
+		case m.Year < 1984:
+			return fmt.Errorf("%s has invalid year %d", m.Identifier, m.Year)
+		}
+		seen[m.Identifier] = true
+	}
+	return nil
+}
+
+func generate(snap *snapshot) ([]byte, error) {
+	models := slices.Clone(snap.Models)
+	slices.SortFunc(models, func(a, b snapshotModel) int { return strings.Compare(a.Identifier, b.Identifier) })
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by gendevicemodels. DO NOT EDIT.\n\n")
+	b.WriteString("package devicemodels\n\n")
+	b.WriteString("var models = map[string]Model{\n")
+	for _, m := range models {
+		fmt.Fprintf(&b, "\t%q: {Identifier: %q, MarketingName: %q, Family: %s, ", m.Identifier, m.Identifier, m.MarketingName, families[m.Family])
+		if m.ScreenSize > 0 {
+			fmt.Fprintf(&b, "ScreenSize: %s, ", strconv.FormatFloat(m.ScreenSize, 'f', -1, 64))
+		}
+		fmt.Fprintf(&b, "Year: %d},\n", m.Year)
+	}
+	b.WriteString("}\n")
+
+	return format.Source(b.Bytes())
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	inlineTagPattern  = regexp.MustCompile(`(?i)</?(a|b|em|i|span|strong|sup)\b[^>]*>`)
+	tagPattern        = regexp.MustCompile(`<[^>]*>`)
+	identifierPattern = regexp.MustCompile(`[A-Za-z]+\d+,\d+`)
+	yearPattern       = regexp.MustCompile(`\b(19|20)\d{2}\b`)
+	inchPattern       = regexp.MustCompile(`(\d+(?:\.\d+)?)-inch`)
+	macNamePattern    = regexp.MustCompile(`^(MacBook Pro|MacBook Air|MacBook|iMac Pro|iMac|Mac mini|Mac Studio|Mac Pro)\b.*\(.*\d{4}.*\)$`)
+)
+
+// refresh fetches every source page and appends the models snap lacks. It
+// returns the number of models added.
+func refresh(ctx context.Context, snap *snapshot) (int, error) {
+	known := make(map[string]bool, len(snap.Models))
+	for _, m := range snap.Models {
+		known[m.Identifier] = true
+	}
+
+	added := 0
+	for _, src := range snap.Sources {
+		page, err := fetch(ctx, src.URL)
+		if err != nil {
+			return added, err
+		}
+		for _, m := range parseSupportPage(page, src.Family) {
+			if known[m.Identifier] {
+				continue
+			}
+			known[m.Identifier] = true
+			snap.Models = append(snap.Models, m)
+			added++
+		}
+	}
+	return added, nil
+}
+
+func fetch(ctx context.Context, url string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%s: HTTP %d", url, resp.StatusCode)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", url, err)
+	}
+	return string(body), nil
+}
+
+// parseSupportPage extracts models from an Apple "Identify your Mac model"
+// page, where each model is a heading such as "MacBook Air (M2, 2022)"
+// followed by a "Model Identifier: Mac14,2" line. The year and nominal
+// screen size are taken from the heading.
+func parseSupportPage(page, family string) []snapshotModel {
+	text := inlineTagPattern.ReplaceAllString(page, "")
+	text = tagPattern.ReplaceAllString(text, "\n")
+
+	var (
+		models []snapshotModel
+		name   string
+	)
+	for line := range strings.Lines(text) {
+		line = strings.Join(strings.Fields(html.UnescapeString(line)), " ")
+		if macNamePattern.MatchString(line) {
+			name = line
+			continue
+		}
+		rest, ok := strings.CutPrefix(line, "Model Identifier:")
+		if !ok || name == "" {
+			continue
+		}
+		years := yearPattern.FindAllString(name, -1)
+		if len(years) == 0 {
+			continue
+		}
+		year, _ := strconv.Atoi(years[len(years)-1])
+
+		var screen float64
+		if m := inchPattern.FindStringSubmatch(name); m != nil {
+			screen, _ = strconv.ParseFloat(m[1], 64)
+		}
+
+		for _, id := range identifierPattern.FindAllString(rest, -1) {
+			models = append(models, snapshotModel{
+				Identifier:    id,
+				MarketingName: name,
+				Family:        family,
+				ScreenSize:    screen,
+				Year:          year,
+			})
+		}
+		name = ""
+	}
+	return models
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const macSupportPage = `<html><body>
+<h1>Identify your MacBook Pro model</h1>
+<h2 class="gb-header">MacBook Pro (14-inch, M4&nbsp;Pro or M4 Max, 2024)</h2>
+<p>Colors: Space Black, Silver</p>
+<p><b>Model Identifier:</b> Mac16,6; Mac16,8</p>
+<p>Part Numbers: MX2H3xx/A</p>
+<h2>MacBook Pro (16-inch, 2024)</h2>
+<p>Model Identifier: Mac16,5; Mac16,7</p>
+<h2>Tech Specs</h2>
+<p>Model Identifier: Mac0,0</p>
+</body></html>`
+
+func TestParseSupportPage(t *testing.T) {
+	models := parseSupportPage(macSupportPage, "Mac")
+
+	require.Len(t, models, 4)
+	assert.Equal(t, snapshotModel{
+		Identifier:    "Mac16,6",
+		MarketingName: "MacBook Pro (14-inch, M4 Pro or M4 Max, 2024)",
+		Family:        "Mac",
+		ScreenSize:    14,
+		Year:          2024,
+	}, models[0])
+	assert.Equal(t, "Mac16,8", models[1].Identifier)
+	assert.Equal(t, "MacBook Pro (16-inch, 2024)", models[3].MarketingName)
+	assert.Equal(t, 16.0, models[3].ScreenSize)
+}
+
+func TestValidate(t *testing.T) {
+	snap := &snapshot{Models: []snapshotModel{
+		{Identifier: "Mac14,2", MarketingName: "MacBook Air (M2, 2022)", Family: "Mac", Year: 2022},
+		{Identifier: "Mac14,2", MarketingName: "MacBook Air (M2, 2022)", Family: "Mac", Year: 2022},
+	}}
+	err := validate(snap)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "duplicate identifier Mac14,2")
+
+	snap.Models[1] = snapshotModel{Identifier: "Foo1,1", MarketingName: "Foo", Family: "Toaster", Year: 2022}
+	err = validate(snap)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown family "Toaster"`)
+}
+
+func TestGenerate(t *testing.T) {
+	src, err := generate(&snapshot{Models: []snapshotModel{
+		{Identifier: "iPhone15,3", MarketingName: "iPhone 14 Pro Max", Family: "iPhone", ScreenSize: 6.7, Year: 2022},
+		{Identifier: "Mac14,3", MarketingName: "Mac mini (2023)", Family: "Mac", Year: 2023},
+	}})
+	require.NoError(t, err)
+
+	out := string(src)
+	assert.True(t, strings.HasPrefix(out, "// Code generated by gendevicemodels. DO NOT EDIT."))
+	assert.Contains(t, out, `"Mac14,3":    {Identifier: "Mac14,3", MarketingName: "Mac mini (2023)", Family: FamilyMac, Year: 2023},`)
+	assert.Contains(t, out, `ScreenSize: 6.7, Year: 2022}`)
+	assert.Less(t, strings.Index(out, "Mac14,3"), strings.Index(out, "iPhone15,3"))
+}
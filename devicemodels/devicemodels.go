@@ -0,0 +1,140 @@
+// Package devicemodels maps Apple hardware model identifiers — the
+// ProductType / "model" values devices report, such as "Mac14,2" or
+// "iPhone15,3" — to marketing names, screen sizes and introduction years.
+//
+// The table is generated from the committed snapshot in metadata/models.json:
+//
+//	go run ./devicemodels/cmd/gendevicemodels
+//
+// Pass -update to first merge newly published Mac models from Apple's
+// "Identify your Mac model" support pages into the snapshot.
+package devicemodels
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Device families.
+const (
+	FamilyMac         = "Mac"
+	FamilyIPhone      = "iPhone"
+	FamilyIPad        = "iPad"
+	FamilyAppleTV     = "Apple TV"
+	FamilyAppleWatch  = "Apple Watch"
+	FamilyAppleVision = "Apple Vision"
+)
+
+// Model describes one hardware model identifier.
+type Model struct {
+	// Identifier is the model identifier, e.g. "Mac14,2".
+	Identifier string
+	// MarketingName is the name Apple sells the model under, e.g.
+	// "MacBook Air (M2, 2022)". Several identifiers may share one name.
+	MarketingName string
+	// Family is one of the Family constants.
+	Family string
+	// ScreenSize is the diagonal of the built-in display in inches, or 0
+	// for models without one.
+	ScreenSize float64
+	// Year is the year the model was introduced.
+	Year int
+}
+
+// Lookup returns the model for identifier.
+func Lookup(identifier string) (Model, bool) {
+	m, ok := models[strings.TrimSpace(identifier)]
+	return m, ok
+}
+
+// MarketingName returns the marketing name for identifier, or identifier
+// itself when the model is unknown.
+func MarketingName(identifier string) string {
+	if m, ok := Lookup(identifier); ok {
+		return m.MarketingName
+	}
+	return identifier
+}
+
+// FamilyOf returns the device family of identifier from its prefix, so it
+// also classifies models newer than the table. It returns "" for
+// identifiers it does not recognize.
+func FamilyOf(identifier string) string {
+	identifier = strings.TrimSpace(identifier)
+	if m, ok := models[identifier]; ok {
+		return m.Family
+	}
+	prefix, _, ok := splitIdentifier(identifier)
+	if !ok {
+		return ""
+	}
+	switch prefix {
+	case "iPhone":
+		return FamilyIPhone
+	case "iPad":
+		return FamilyIPad
+	case "AppleTV":
+		return FamilyAppleTV
+	case "Watch":
+		return FamilyAppleWatch
+	case "RealityDevice":
+		return FamilyAppleVision
+	case "Mac", "MacBook", "MacBookAir", "MacBookPro", "Macmini", "MacPro", "iMac", "iMacPro":
+		return FamilyMac
+	}
+	return ""
+}
+
+// All returns every known model, ordered by family and then identifier,
+// with identifiers compared numerically ("Mac9,1" before "Mac14,2").
+func All() []Model {
+	out := make([]Model, 0, len(models))
+	for _, m := range models {
+		out = append(out, m)
+	}
+	slices.SortFunc(out, func(a, b Model) int {
+		if c := cmp.Compare(a.Family, b.Family); c != 0 {
+			return c
+		}
+		return compareIdentifiers(a.Identifier, b.Identifier)
+	})
+	return out
+}
+
+// splitIdentifier splits "Mac14,2" into "Mac" and [14 2].
+func splitIdentifier(identifier string) (string, [2]int, bool) {
+	var nums [2]int
+	i := strings.IndexAny(identifier, "0123456789")
+	if i <= 0 {
+		return "", nums, false
+	}
+	major, minor, ok := strings.Cut(identifier[i:], ",")
+	if !ok {
+		return "", nums, false
+	}
+	var err error
+	if nums[0], err = strconv.Atoi(major); err != nil {
+		return "", nums, false
+	}
+	if nums[1], err = strconv.Atoi(minor); err != nil {
+		return "", nums, false
+	}
+	return identifier[:i], nums, true
+}
+
+func compareIdentifiers(a, b string) int {
+	pa, na, oka := splitIdentifier(a)
+	pb, nb, okb := splitIdentifier(b)
+	if !oka || !okb {
+		return cmp.Compare(a, b)
+	}
+	if c := cmp.Compare(pa, pb); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(na[0], nb[0]); c != 0 {
+		return c
+	}
+	return cmp.Compare(na[1], nb[1])
+}
@@ -0,0 +1,73 @@
+package devicemodels
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookup(t *testing.T) {
+	m, ok := Lookup("Mac14,2")
+	require.True(t, ok)
+	assert.Equal(t, Model{Identifier: "Mac14,2", MarketingName: "MacBook Air (M2, 2022)", Family: FamilyMac, ScreenSize: 13.6, Year: 2022}, m)
+
+	m, ok = Lookup(" iPhone15,3 ")
+	require.True(t, ok)
+	assert.Equal(t, "iPhone 14 Pro Max", m.MarketingName)
+	assert.Equal(t, 6.7, m.ScreenSize)
+
+	m, ok = Lookup("Mac14,3")
+	require.True(t, ok)
+	assert.Zero(t, m.ScreenSize, "Mac mini has no built-in display")
+
+	_, ok = Lookup("Mac99,1")
+	assert.False(t, ok)
+}
+
+func TestMarketingName(t *testing.T) {
+	assert.Equal(t, "Apple Vision Pro", MarketingName("RealityDevice14,1"))
+	assert.Equal(t, "iPhone99,1", MarketingName("iPhone99,1"))
+}
+
+func TestFamilyOf(t *testing.T) {
+	assert.Equal(t, FamilyMac, FamilyOf("Mac14,2"))
+	assert.Equal(t, FamilyMac, FamilyOf("MacBookPro99,1"))
+	assert.Equal(t, FamilyIPhone, FamilyOf("iPhone99,1"))
+	assert.Equal(t, FamilyIPad, FamilyOf("iPad99,1"))
+	assert.Equal(t, FamilyAppleTV, FamilyOf("AppleTV99,1"))
+	assert.Equal(t, FamilyAppleWatch, FamilyOf("Watch99,1"))
+	assert.Equal(t, FamilyAppleVision, FamilyOf("RealityDevice99,1"))
+	assert.Empty(t, FamilyOf("VirtualMac2,1x"))
+	assert.Empty(t, FamilyOf("Unknown1,1"))
+	assert.Empty(t, FamilyOf(""))
+}
+
+func TestAll(t *testing.T) {
+	all := All()
+	require.Len(t, all, len(models))
+
+	for i := 1; i < len(all); i++ {
+		a, b := all[i-1], all[i]
+		if a.Family == b.Family {
+			assert.Negative(t, compareIdentifiers(a.Identifier, b.Identifier), "%s before %s", a.Identifier, b.Identifier)
+		} else {
+			assert.Less(t, a.Family, b.Family)
+		}
+	}
+}
+
+func TestTable(t *testing.T) {
+	for id, m := range models {
+		assert.Equal(t, id, m.Identifier)
+		assert.NotEmpty(t, m.MarketingName, id)
+		assert.Equal(t, FamilyOf(id), m.Family, "%s family matches its prefix", id)
+	}
+}
+
+func TestCompareIdentifiers(t *testing.T) {
+	assert.Negative(t, compareIdentifiers("Mac9,1", "Mac14,2"))
+	assert.Negative(t, compareIdentifiers("Mac14,2", "Mac14,10"))
+	assert.Zero(t, compareIdentifiers("iPad13,1", "iPad13,1"))
+	assert.Positive(t, compareIdentifiers("iPhone12,1", "iPad16,1"))
+}
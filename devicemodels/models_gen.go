@@ -0,0 +1,129 @@
+// Code generated by gendevicemodels. DO NOT EDIT.
+
+package devicemodels
+
+var models = map[string]Model{
+	"AppleTV11,1":       {Identifier: "AppleTV11,1", MarketingName: "Apple TV 4K (2nd generation)", Family: FamilyAppleTV, Year: 2021},
+	"AppleTV14,1":       {Identifier: "AppleTV14,1", MarketingName: "Apple TV 4K (3rd generation)", Family: FamilyAppleTV, Year: 2022},
+	"AppleTV5,3":        {Identifier: "AppleTV5,3", MarketingName: "Apple TV HD", Family: FamilyAppleTV, Year: 2015},
+	"AppleTV6,2":        {Identifier: "AppleTV6,2", MarketingName: "Apple TV 4K", Family: FamilyAppleTV, Year: 2017},
+	"Mac13,1":           {Identifier: "Mac13,1", MarketingName: "Mac Studio (2022)", Family: FamilyMac, Year: 2022},
+	"Mac13,2":           {Identifier: "Mac13,2", MarketingName: "Mac Studio (2022)", Family: FamilyMac, Year: 2022},
+	"Mac14,10":          {Identifier: "Mac14,10", MarketingName: "MacBook Pro (16-inch, 2023)", Family: FamilyMac, ScreenSize: 16.2, Year: 2023},
+	"Mac14,12":          {Identifier: "Mac14,12", MarketingName: "Mac mini (2023)", Family: FamilyMac, Year: 2023},
+	"Mac14,13":          {Identifier: "Mac14,13", MarketingName: "Mac Studio (2023)", Family: FamilyMac, Year: 2023},
+	"Mac14,14":          {Identifier: "Mac14,14", MarketingName: "Mac Studio (2023)", Family: FamilyMac, Year: 2023},
+	"Mac14,15":          {Identifier: "Mac14,15", MarketingName: "MacBook Air (15-inch, M2, 2023)", Family: FamilyMac, ScreenSize: 15.3, Year: 2023},
+	"Mac14,2":           {Identifier: "Mac14,2", MarketingName: "MacBook Air (M2, 2022)", Family: FamilyMac, ScreenSize: 13.6, Year: 2022},
+	"Mac14,3":           {Identifier: "Mac14,3", MarketingName: "Mac mini (2023)", Family: FamilyMac, Year: 2023},
+	"Mac14,5":           {Identifier: "Mac14,5", MarketingName: "MacBook Pro (14-inch, 2023)", Family: FamilyMac, ScreenSize: 14.2, Year: 2023},
+	"Mac14,6":           {Identifier: "Mac14,6", MarketingName: "MacBook Pro (16-inch, 2023)", Family: FamilyMac, ScreenSize: 16.2, Year: 2023},
+	"Mac14,7":           {Identifier: "Mac14,7", MarketingName: "MacBook Pro (13-inch, M2, 2022)", Family: FamilyMac, ScreenSize: 13.3, Year: 2022},
+	"Mac14,8":           {Identifier: "Mac14,8", MarketingName: "Mac Pro (2023)", Family: FamilyMac, Year: 2023},
+	"Mac14,9":           {Identifier: "Mac14,9", MarketingName: "MacBook Pro (14-inch, 2023)", Family: FamilyMac, ScreenSize: 14.2, Year: 2023},
+	"Mac15,10":          {Identifier: "Mac15,10", MarketingName: "MacBook Pro (14-inch, M3 Pro or M3 Max, Nov 2023)", Family: FamilyMac, ScreenSize: 14.2, Year: 2023},
+	"Mac15,11":          {Identifier: "Mac15,11", MarketingName: "MacBook Pro (16-inch, Nov 2023)", Family: FamilyMac, ScreenSize: 16.2, Year: 2023},
+	"Mac15,12":          {Identifier: "Mac15,12", MarketingName: "MacBook Air (13-inch, M3, 2024)", Family: FamilyMac, ScreenSize: 13.6, Year: 2024},
+	"Mac15,13":          {Identifier: "Mac15,13", MarketingName: "MacBook Air (15-inch, M3, 2024)", Family: FamilyMac, ScreenSize: 15.3, Year: 2024},
+	"Mac15,14":          {Identifier: "Mac15,14", MarketingName: "Mac Studio (2025)", Family: FamilyMac, Year: 2025},
+	"Mac15,3":           {Identifier: "Mac15,3", MarketingName: "MacBook Pro (14-inch, M3, Nov 2023)", Family: FamilyMac, ScreenSize: 14.2, Year: 2023},
+	"Mac15,4":           {Identifier: "Mac15,4", MarketingName: "iMac (24-inch, 2023)", Family: FamilyMac, ScreenSize: 24, Year: 2023},
+	"Mac15,5":           {Identifier: "Mac15,5", MarketingName: "iMac (24-inch, 2023)", Family: FamilyMac, ScreenSize: 24, Year: 2023},
+	"Mac15,6":           {Identifier: "Mac15,6", MarketingName: "MacBook Pro (14-inch, M3 Pro or M3 Max, Nov 2023)", Family: FamilyMac, ScreenSize: 14.2, Year: 2023},
+	"Mac15,7":           {Identifier: "Mac15,7", MarketingName: "MacBook Pro (16-inch, Nov 2023)", Family: FamilyMac, ScreenSize: 16.2, Year: 2023},
+	"Mac15,8":           {Identifier: "Mac15,8", MarketingName: "MacBook Pro (14-inch, M3 Pro or M3 Max, Nov 2023)", Family: FamilyMac, ScreenSize: 14.2, Year: 2023},
+	"Mac15,9":           {Identifier: "Mac15,9", MarketingName: "MacBook Pro (16-inch, Nov 2023)", Family: FamilyMac, ScreenSize: 16.2, Year: 2023},
+	"Mac16,1":           {Identifier: "Mac16,1", MarketingName: "MacBook Pro (14-inch, M4, 2024)", Family: FamilyMac, ScreenSize: 14.2, Year: 2024},
+	"Mac16,10":          {Identifier: "Mac16,10", MarketingName: "Mac mini (2024)", Family: FamilyMac, Year: 2024},
+	"Mac16,11":          {Identifier: "Mac16,11", MarketingName: "Mac mini (2024)", Family: FamilyMac, Year: 2024},
+	"Mac16,12":          {Identifier: "Mac16,12", MarketingName: "MacBook Air (13-inch, M4, 2025)", Family: FamilyMac, ScreenSize: 13.6, Year: 2025},
+	"Mac16,13":          {Identifier: "Mac16,13", MarketingName: "MacBook Air (15-inch, M4, 2025)", Family: FamilyMac, ScreenSize: 15.3, Year: 2025},
+	"Mac16,2":           {Identifier: "Mac16,2", MarketingName: "iMac (24-inch, 2024)", Family: FamilyMac, ScreenSize: 24, Year: 2024},
+	"Mac16,3":           {Identifier: "Mac16,3", MarketingName: "iMac (24-inch, 2024)", Family: FamilyMac, ScreenSize: 24, Year: 2024},
+	"Mac16,5":           {Identifier: "Mac16,5", MarketingName: "MacBook Pro (16-inch, 2024)", Family: FamilyMac, ScreenSize: 16.2, Year: 2024},
+	"Mac16,6":           {Identifier: "Mac16,6", MarketingName: "MacBook Pro (14-inch, M4 Pro or M4 Max, 2024)", Family: FamilyMac, ScreenSize: 14.2, Year: 2024},
+	"Mac16,7":           {Identifier: "Mac16,7", MarketingName: "MacBook Pro (16-inch, 2024)", Family: FamilyMac, ScreenSize: 16.2, Year: 2024},
+	"Mac16,8":           {Identifier: "Mac16,8", MarketingName: "MacBook Pro (14-inch, M4 Pro or M4 Max, 2024)", Family: FamilyMac, ScreenSize: 14.2, Year: 2024},
+	"Mac16,9":           {Identifier: "Mac16,9", MarketingName: "Mac Studio (2025)", Family: FamilyMac, Year: 2025},
+	"MacBookAir10,1":    {Identifier: "MacBookAir10,1", MarketingName: "MacBook Air (M1, 2020)", Family: FamilyMac, ScreenSize: 13.3, Year: 2020},
+	"MacBookAir9,1":     {Identifier: "MacBookAir9,1", MarketingName: "MacBook Air (Retina, 13-inch, 2020)", Family: FamilyMac, ScreenSize: 13.3, Year: 2020},
+	"MacBookPro16,1":    {Identifier: "MacBookPro16,1", MarketingName: "MacBook Pro (16-inch, 2019)", Family: FamilyMac, ScreenSize: 16, Year: 2019},
+	"MacBookPro16,4":    {Identifier: "MacBookPro16,4", MarketingName: "MacBook Pro (16-inch, 2019)", Family: FamilyMac, ScreenSize: 16, Year: 2019},
+	"MacBookPro17,1":    {Identifier: "MacBookPro17,1", MarketingName: "MacBook Pro (13-inch, M1, 2020)", Family: FamilyMac, ScreenSize: 13.3, Year: 2020},
+	"MacBookPro18,1":    {Identifier: "MacBookPro18,1", MarketingName: "MacBook Pro (16-inch, 2021)", Family: FamilyMac, ScreenSize: 16.2, Year: 2021},
+	"MacBookPro18,2":    {Identifier: "MacBookPro18,2", MarketingName: "MacBook Pro (16-inch, 2021)", Family: FamilyMac, ScreenSize: 16.2, Year: 2021},
+	"MacBookPro18,3":    {Identifier: "MacBookPro18,3", MarketingName: "MacBook Pro (14-inch, 2021)", Family: FamilyMac, ScreenSize: 14.2, Year: 2021},
+	"MacBookPro18,4":    {Identifier: "MacBookPro18,4", MarketingName: "MacBook Pro (14-inch, 2021)", Family: FamilyMac, ScreenSize: 14.2, Year: 2021},
+	"MacPro7,1":         {Identifier: "MacPro7,1", MarketingName: "Mac Pro (2019)", Family: FamilyMac, Year: 2019},
+	"Macmini8,1":        {Identifier: "Macmini8,1", MarketingName: "Mac mini (2018)", Family: FamilyMac, Year: 2018},
+	"Macmini9,1":        {Identifier: "Macmini9,1", MarketingName: "Mac mini (M1, 2020)", Family: FamilyMac, Year: 2020},
+	"RealityDevice14,1": {Identifier: "RealityDevice14,1", MarketingName: "Apple Vision Pro", Family: FamilyAppleVision, Year: 2024},
+	"Watch6,18":         {Identifier: "Watch6,18", MarketingName: "Apple Watch Ultra", Family: FamilyAppleWatch, Year: 2022},
+	"Watch7,1":          {Identifier: "Watch7,1", MarketingName: "Apple Watch Series 9", Family: FamilyAppleWatch, Year: 2023},
+	"Watch7,2":          {Identifier: "Watch7,2", MarketingName: "Apple Watch Series 9", Family: FamilyAppleWatch, Year: 2023},
+	"Watch7,3":          {Identifier: "Watch7,3", MarketingName: "Apple Watch Series 9", Family: FamilyAppleWatch, Year: 2023},
+	"Watch7,4":          {Identifier: "Watch7,4", MarketingName: "Apple Watch Series 9", Family: FamilyAppleWatch, Year: 2023},
+	"Watch7,5":          {Identifier: "Watch7,5", MarketingName: "Apple Watch Ultra 2", Family: FamilyAppleWatch, Year: 2023},
+	"iMac20,1":          {Identifier: "iMac20,1", MarketingName: "iMac (Retina 5K, 27-inch, 2020)", Family: FamilyMac, ScreenSize: 27, Year: 2020},
+	"iMac20,2":          {Identifier: "iMac20,2", MarketingName: "iMac (Retina 5K, 27-inch, 2020)", Family: FamilyMac, ScreenSize: 27, Year: 2020},
+	"iMac21,1":          {Identifier: "iMac21,1", MarketingName: "iMac (24-inch, M1, 2021)", Family: FamilyMac, ScreenSize: 24, Year: 2021},
+	"iMac21,2":          {Identifier: "iMac21,2", MarketingName: "iMac (24-inch, M1, 2021)", Family: FamilyMac, ScreenSize: 24, Year: 2021},
+	"iPad12,1":          {Identifier: "iPad12,1", MarketingName: "iPad (9th generation)", Family: FamilyIPad, ScreenSize: 10.2, Year: 2021},
+	"iPad12,2":          {Identifier: "iPad12,2", MarketingName: "iPad (9th generation)", Family: FamilyIPad, ScreenSize: 10.2, Year: 2021},
+	"iPad13,1":          {Identifier: "iPad13,1", MarketingName: "iPad Air (4th generation)", Family: FamilyIPad, ScreenSize: 10.9, Year: 2020},
+	"iPad13,10":         {Identifier: "iPad13,10", MarketingName: "iPad Pro 12.9-inch (5th generation)", Family: FamilyIPad, ScreenSize: 12.9, Year: 2021},
+	"iPad13,11":         {Identifier: "iPad13,11", MarketingName: "iPad Pro 12.9-inch (5th generation)", Family: FamilyIPad, ScreenSize: 12.9, Year: 2021},
+	"iPad13,16":         {Identifier: "iPad13,16", MarketingName: "iPad Air (5th generation)", Family: FamilyIPad, ScreenSize: 10.9, Year: 2022},
+	"iPad13,17":         {Identifier: "iPad13,17", MarketingName: "iPad Air (5th generation)", Family: FamilyIPad, ScreenSize: 10.9, Year: 2022},
+	"iPad13,18":         {Identifier: "iPad13,18", MarketingName: "iPad (10th generation)", Family: FamilyIPad, ScreenSize: 10.9, Year: 2022},
+	"iPad13,19":         {Identifier: "iPad13,19", MarketingName: "iPad (10th generation)", Family: FamilyIPad, ScreenSize: 10.9, Year: 2022},
+	"iPad13,2":          {Identifier: "iPad13,2", MarketingName: "iPad Air (4th generation)", Family: FamilyIPad, ScreenSize: 10.9, Year: 2020},
+	"iPad13,4":          {Identifier: "iPad13,4", MarketingName: "iPad Pro 11-inch (3rd generation)", Family: FamilyIPad, ScreenSize: 11, Year: 2021},
+	"iPad13,5":          {Identifier: "iPad13,5", MarketingName: "iPad Pro 11-inch (3rd generation)", Family: FamilyIPad, ScreenSize: 11, Year: 2021},
+	"iPad13,6":          {Identifier: "iPad13,6", MarketingName: "iPad Pro 11-inch (3rd generation)", Family: FamilyIPad, ScreenSize: 11, Year: 2021},
+	"iPad13,7":          {Identifier: "iPad13,7", MarketingName: "iPad Pro 11-inch (3rd generation)", Family: FamilyIPad, ScreenSize: 11, Year: 2021},
+	"iPad13,8":          {Identifier: "iPad13,8", MarketingName: "iPad Pro 12.9-inch (5th generation)", Family: FamilyIPad, ScreenSize: 12.9, Year: 2021},
+	"iPad13,9":          {Identifier: "iPad13,9", MarketingName: "iPad Pro 12.9-inch (5th generation)", Family: FamilyIPad, ScreenSize: 12.9, Year: 2021},
+	"iPad14,1":          {Identifier: "iPad14,1", MarketingName: "iPad mini (6th generation)", Family: FamilyIPad, ScreenSize: 8.3, Year: 2021},
+	"iPad14,10":         {Identifier: "iPad14,10", MarketingName: "iPad Air 13-inch (M2)", Family: FamilyIPad, ScreenSize: 13, Year: 2024},
+	"iPad14,11":         {Identifier: "iPad14,11", MarketingName: "iPad Air 13-inch (M2)", Family: FamilyIPad, ScreenSize: 13, Year: 2024},
+	"iPad14,2":          {Identifier: "iPad14,2", MarketingName: "iPad mini (6th generation)", Family: FamilyIPad, ScreenSize: 8.3, Year: 2021},
+	"iPad14,3":          {Identifier: "iPad14,3", MarketingName: "iPad Pro 11-inch (4th generation)", Family: FamilyIPad, ScreenSize: 11, Year: 2022},
+	"iPad14,4":          {Identifier: "iPad14,4", MarketingName: "iPad Pro 11-inch (4th generation)", Family: FamilyIPad, ScreenSize: 11, Year: 2022},
+	"iPad14,5":          {Identifier: "iPad14,5", MarketingName: "iPad Pro 12.9-inch (6th generation)", Family: FamilyIPad, ScreenSize: 12.9, Year: 2022},
+	"iPad14,6":          {Identifier: "iPad14,6", MarketingName: "iPad Pro 12.9-inch (6th generation)", Family: FamilyIPad, ScreenSize: 12.9, Year: 2022},
+	"iPad14,8":          {Identifier: "iPad14,8", MarketingName: "iPad Air 11-inch (M2)", Family: FamilyIPad, ScreenSize: 11, Year: 2024},
+	"iPad14,9":          {Identifier: "iPad14,9", MarketingName: "iPad Air 11-inch (M2)", Family: FamilyIPad, ScreenSize: 11, Year: 2024},
+	"iPad16,1":          {Identifier: "iPad16,1", MarketingName: "iPad mini (A17 Pro)", Family: FamilyIPad, ScreenSize: 8.3, Year: 2024},
+	"iPad16,2":          {Identifier: "iPad16,2", MarketingName: "iPad mini (A17 Pro)", Family: FamilyIPad, ScreenSize: 8.3, Year: 2024},
+	"iPad16,3":          {Identifier: "iPad16,3", MarketingName: "iPad Pro 11-inch (M4)", Family: FamilyIPad, ScreenSize: 11, Year: 2024},
+	"iPad16,4":          {Identifier: "iPad16,4", MarketingName: "iPad Pro 11-inch (M4)", Family: FamilyIPad, ScreenSize: 11, Year: 2024},
+	"iPad16,5":          {Identifier: "iPad16,5", MarketingName: "iPad Pro 13-inch (M4)", Family: FamilyIPad, ScreenSize: 13, Year: 2024},
+	"iPad16,6":          {Identifier: "iPad16,6", MarketingName: "iPad Pro 13-inch (M4)", Family: FamilyIPad, ScreenSize: 13, Year: 2024},
+	"iPhone12,1":        {Identifier: "iPhone12,1", MarketingName: "iPhone 11", Family: FamilyIPhone, ScreenSize: 6.1, Year: 2019},
+	"iPhone12,3":        {Identifier: "iPhone12,3", MarketingName: "iPhone 11 Pro", Family: FamilyIPhone, ScreenSize: 5.8, Year: 2019},
+	"iPhone12,5":        {Identifier: "iPhone12,5", MarketingName: "iPhone 11 Pro Max", Family: FamilyIPhone, ScreenSize: 6.5, Year: 2019},
+	"iPhone12,8":        {Identifier: "iPhone12,8", MarketingName: "iPhone SE (2nd generation)", Family: FamilyIPhone, ScreenSize: 4.7, Year: 2020},
+	"iPhone13,1":        {Identifier: "iPhone13,1", MarketingName: "iPhone 12 mini", Family: FamilyIPhone, ScreenSize: 5.4, Year: 2020},
+	"iPhone13,2":        {Identifier: "iPhone13,2", MarketingName: "iPhone 12", Family: FamilyIPhone, ScreenSize: 6.1, Year: 2020},
+	"iPhone13,3":        {Identifier: "iPhone13,3", MarketingName: "iPhone 12 Pro", Family: FamilyIPhone, ScreenSize: 6.1, Year: 2020},
+	"iPhone13,4":        {Identifier: "iPhone13,4", MarketingName: "iPhone 12 Pro Max", Family: FamilyIPhone, ScreenSize: 6.7, Year: 2020},
+	"iPhone14,2":        {Identifier: "iPhone14,2", MarketingName: "iPhone 13 Pro", Family: FamilyIPhone, ScreenSize: 6.1, Year: 2021},
+	"iPhone14,3":        {Identifier: "iPhone14,3", MarketingName: "iPhone 13 Pro Max", Family: FamilyIPhone, ScreenSize: 6.7, Year: 2021},
+	"iPhone14,4":        {Identifier: "iPhone14,4", MarketingName: "iPhone 13 mini", Family: FamilyIPhone, ScreenSize: 5.4, Year: 2021},
+	"iPhone14,5":        {Identifier: "iPhone14,5", MarketingName: "iPhone 13", Family: FamilyIPhone, ScreenSize: 6.1, Year: 2021},
+	"iPhone14,6":        {Identifier: "iPhone14,6", MarketingName: "iPhone SE (3rd generation)", Family: FamilyIPhone, ScreenSize: 4.7, Year: 2022},
+	"iPhone14,7":        {Identifier: "iPhone14,7", MarketingName: "iPhone 14", Family: FamilyIPhone, ScreenSize: 6.1, Year: 2022},
+	"iPhone14,8":        {Identifier: "iPhone14,8", MarketingName: "iPhone 14 Plus", Family: FamilyIPhone, ScreenSize: 6.7, Year: 2022},
+	"iPhone15,2":        {Identifier: "iPhone15,2", MarketingName: "iPhone 14 Pro", Family: FamilyIPhone, ScreenSize: 6.1, Year: 2022},
+	"iPhone15,3":        {Identifier: "iPhone15,3", MarketingName: "iPhone 14 Pro Max", Family: FamilyIPhone, ScreenSize: 6.7, Year: 2022},
+	"iPhone15,4":        {Identifier: "iPhone15,4", MarketingName: "iPhone 15", Family: FamilyIPhone, ScreenSize: 6.1, Year: 2023},
+	"iPhone15,5":        {Identifier: "iPhone15,5", MarketingName: "iPhone 15 Plus", Family: FamilyIPhone, ScreenSize: 6.7, Year: 2023},
+	"iPhone16,1":        {Identifier: "iPhone16,1", MarketingName: "iPhone 15 Pro", Family: FamilyIPhone, ScreenSize: 6.1, Year: 2023},
+	"iPhone16,2":        {Identifier: "iPhone16,2", MarketingName: "iPhone 15 Pro Max", Family: FamilyIPhone, ScreenSize: 6.7, Year: 2023},
+	"iPhone17,1":        {Identifier: "iPhone17,1", MarketingName: "iPhone 16 Pro", Family: FamilyIPhone, ScreenSize: 6.3, Year: 2024},
+	"iPhone17,2":        {Identifier: "iPhone17,2", MarketingName: "iPhone 16 Pro Max", Family: FamilyIPhone, ScreenSize: 6.9, Year: 2024},
+	"iPhone17,3":        {Identifier: "iPhone17,3", MarketingName: "iPhone 16", Family: FamilyIPhone, ScreenSize: 6.1, Year: 2024},
+	"iPhone17,4":        {Identifier: "iPhone17,4", MarketingName: "iPhone 16 Plus", Family: FamilyIPhone, ScreenSize: 6.7, Year: 2024},
+	"iPhone17,5":        {Identifier: "iPhone17,5", MarketingName: "iPhone 16e", Family: FamilyIPhone, ScreenSize: 6.1, Year: 2025},
+}
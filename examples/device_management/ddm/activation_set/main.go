@@ -1,10 +1,12 @@
 // Build a DDM activation that ties a set of configurations together — the
-// complete declaration set a DDM server would serve for a passcode policy.
+// complete declaration set a DDM server would serve for a passcode policy —
+// plus the declaration-items and tokens documents devices sync against.
 package main
 
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-apple/device_management/ddm"
 	"github.com/deploymenttheory/go-api-sdk-apple/device_management/ddm/activations"
@@ -13,22 +15,41 @@ import (
 )
 
 func main() {
-	config, err := ddm.BuildDeclaration("com.example.passcode-policy",
-		&configurations.PasscodeSettings{MinimumLength: ptr.To(int64(8))})
+	config, err := ddm.NewDeclaration("com.example.passcode-policy",
+		&configurations.PasscodeSettings{MinimumLength: ptr.To(int64(8))},
+		ddm.WithHashedServerToken())
 	if err != nil {
 		log.Fatalf("configuration: %v", err)
 	}
 
-	activation, err := ddm.BuildDeclaration("com.example.passcode-activation",
+	activation, err := ddm.NewDeclaration("com.example.passcode-activation",
 		&activations.Simple{
 			StandardConfigurations: []string{"com.example.passcode-policy"},
-		})
+		},
+		ddm.WithHashedServerToken())
 	if err != nil {
 		log.Fatalf("activation: %v", err)
 	}
 
-	fmt.Println("— configuration —")
-	fmt.Print(string(config))
-	fmt.Println("— activation —")
-	fmt.Print(string(activation))
+	items, err := ddm.NewDeclarationItems(config, activation)
+	if err != nil {
+		log.Fatalf("declaration-items: %v", err)
+	}
+
+	for _, doc := range []struct {
+		name   string
+		render func() ([]byte, error)
+	}{
+		{"configuration", config.JSON},
+		{"activation", activation.JSON},
+		{"declaration-items", items.JSON},
+		{"tokens", items.Tokens(time.Now()).JSON},
+	} {
+		out, err := doc.render()
+		if err != nil {
+			log.Fatalf("%s: %v", doc.name, err)
+		}
+		fmt.Printf("— %s —\n", doc.name)
+		fmt.Print(string(out))
+	}
 }
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	microsoft_updates "github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates"
+	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/microsoft_updates_api/standalone"
+)
+
+func main() {
+	c, err := microsoft_updates.NewDefaultClient()
+	if err != nil {
+		log.Fatalf("failed to create client: %v", err)
+	}
+	defer c.Close()
+
+	ctx := context.Background()
+
+	pkg, err := c.MicrosoftUpdatesAPI.Standalone.GetPackageByApplicationIDV1(ctx, standalone.AppIDWord)
+	if err != nil {
+		log.Fatalf("GetPackageByApplicationIDV1: %v", err)
+	}
+
+	result, err := c.MicrosoftUpdatesAPI.Standalone.DownloadPackageV1(ctx, pkg, os.TempDir(), &standalone.DownloadOptions{
+		Segments: 4,
+		Resume:   true,
+		Progress: func(written, total int64) {
+			fmt.Printf("\r  %d / %d bytes", written, total)
+		},
+	})
+	if err != nil {
+		log.Fatalf("DownloadPackageV1: %v", err)
+	}
+
+	fmt.Printf("\nDownloaded %s %s\n", pkg.Title, result.Version)
+	fmt.Printf("  path:     %s\n", result.DestPath)
+	fmt.Printf("  bytes:    %d\n", result.BytesWritten)
+	fmt.Printf("  sha256:   %s\n", result.SHA256)
+	fmt.Printf("  verified: %t\n", result.Verified)
+	fmt.Printf("  duration: %s\n", result.Duration)
+}
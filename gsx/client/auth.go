@@ -0,0 +1,91 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Credentials identify the GSX technician and the account requests are
+// made for.
+type Credentials struct {
+	// UserAppleID is the technician's Apple ID, as registered in GSX.
+	UserAppleID string
+	// AuthToken is the activation token generated in the GSX web UI. It is
+	// exchanged for a session auth token on first use.
+	AuthToken string
+	// SoldTo is the GSX sold-to account number.
+	SoldTo string
+	// ShipTo is the GSX ship-to location number.
+	ShipTo string
+}
+
+func (c Credentials) validate() error {
+	switch {
+	case c.UserAppleID == "":
+		return fmt.Errorf("GSX user Apple ID is required")
+	case c.AuthToken == "":
+		return fmt.Errorf("GSX activation token is required")
+	case c.SoldTo == "":
+		return fmt.Errorf("GSX sold-to account is required")
+	}
+	return nil
+}
+
+// tokenExchanger trades the current token for a fresh one.
+type tokenExchanger func(ctx context.Context, current string) (string, error)
+
+// authSession holds the rolling GSX auth token. GSX expires a token after
+// 30 minutes without use; each exchange at /authenticate/token returns a
+// new one, so the session refreshes the token once it has been idle for
+// the configured lifetime. It is safe for concurrent use, and exchanges are
+// serialized so concurrent requests share one new token.
+type authSession struct {
+	mu       sync.Mutex
+	token    string
+	lastUsed time.Time
+	lifetime time.Duration
+	now      func() time.Time
+}
+
+func newAuthSession(activationToken string) *authSession {
+	return &authSession{token: activationToken, lifetime: DefaultTokenLifetime, now: time.Now}
+}
+
+// Token returns a usable auth token, exchanging the activation token on
+// first use and refreshing an idle token.
+func (s *authSession) Token(ctx context.Context, exchange tokenExchanger) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.lastUsed.IsZero() && s.now().Sub(s.lastUsed) < s.lifetime {
+		return s.token, nil
+	}
+	token, err := exchange(ctx, s.token)
+	if err != nil {
+		return "", fmt.Errorf("failed to obtain GSX auth token: %w", err)
+	}
+	s.token = token
+	s.lastUsed = s.now()
+	return token, nil
+}
+
+// Touch records that the token was just accepted by GSX, extending its
+// idle window.
+func (s *authSession) Touch() {
+	s.mu.Lock()
+	s.lastUsed = s.now()
+	s.mu.Unlock()
+}
+
+// Expire forces the next request to exchange the token again, after GSX
+// rejected token. A token that has already been replaced is ignored, so
+// requests rejected together cause one exchange.
+func (s *authSession) Expire(token string) {
+	s.mu.Lock()
+	if token == s.token {
+		s.lastUsed = time.Time{}
+	}
+	s.mu.Unlock()
+}
@@ -0,0 +1,152 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// fakeExchanger records exchanges and issues numbered tokens.
+type fakeExchanger struct {
+	calls    []string
+	failWith error
+}
+
+func (f *fakeExchanger) exchange(ctx context.Context, current string) (string, error) {
+	f.calls = append(f.calls, current)
+	if f.failWith != nil {
+		return "", f.failWith
+	}
+	return "session-" + string(rune('0'+len(f.calls))), nil
+}
+
+func newTestSession(now *time.Time) *authSession {
+	s := newAuthSession("activation")
+	s.now = func() time.Time { return *now }
+	return s
+}
+
+func TestAuthSession_ExchangesActivationTokenOnFirstUse(t *testing.T) {
+	now := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	s := newTestSession(&now)
+	ex := &fakeExchanger{}
+
+	token, err := s.Token(context.Background(), ex.exchange)
+	require.NoError(t, err)
+	assert.Equal(t, "session-1", token)
+
+	token, err = s.Token(context.Background(), ex.exchange)
+	require.NoError(t, err)
+	assert.Equal(t, "session-1", token)
+	assert.Equal(t, []string{"activation"}, ex.calls)
+}
+
+func TestAuthSession_RefreshesIdleToken(t *testing.T) {
+	now := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	s := newTestSession(&now)
+	ex := &fakeExchanger{}
+
+	_, err := s.Token(context.Background(), ex.exchange)
+	require.NoError(t, err)
+
+	now = now.Add(20 * time.Minute)
+	s.Touch()
+	now = now.Add(20 * time.Minute)
+	token, err := s.Token(context.Background(), ex.exchange)
+	require.NoError(t, err)
+	assert.Equal(t, "session-1", token, "use within the lifetime keeps the token alive")
+
+	now = now.Add(DefaultTokenLifetime)
+	token, err = s.Token(context.Background(), ex.exchange)
+	require.NoError(t, err)
+	assert.Equal(t, "session-2", token)
+	assert.Equal(t, []string{"activation", "session-1"}, ex.calls)
+}
+
+func TestAuthSession_ExpireForcesExchange(t *testing.T) {
+	now := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	s := newTestSession(&now)
+	ex := &fakeExchanger{}
+
+	first, err := s.Token(context.Background(), ex.exchange)
+	require.NoError(t, err)
+	s.Expire(first)
+
+	token, err := s.Token(context.Background(), ex.exchange)
+	require.NoError(t, err)
+	assert.Equal(t, "session-2", token)
+
+	s.Expire(first)
+	token, err = s.Token(context.Background(), ex.exchange)
+	require.NoError(t, err)
+	assert.Equal(t, "session-2", token, "expiring a replaced token keeps its replacement")
+}
+
+func TestTransport_ConcurrentTokenRefresh(t *testing.T) {
+	transport, err := NewTransport(Credentials{UserAppleID: "tech@example.com", AuthToken: "activation", SoldTo: "1"},
+		WithLogger(zap.NewNop()), WithRetryCount(0))
+	require.NoError(t, err)
+	httpmock.ActivateNonDefault(transport.GetHTTPClient().Client())
+	t.Cleanup(httpmock.DeactivateAndReset)
+
+	var exchanges atomic.Int32
+	httpmock.RegisterResponder("POST", DefaultBaseURL+"/authenticate/token",
+		func(req *http.Request) (*http.Response, error) {
+			n := exchanges.Add(1)
+			return httpmock.NewJsonResponse(200, map[string]string{"authToken": fmt.Sprintf("session-%d", n)})
+		})
+	httpmock.RegisterResponder("GET", DefaultBaseURL+"/repair/summary",
+		func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get("X-Apple-Auth-Token") == "session-1" {
+				return httpmock.NewStringResponse(401, `{"errors":[{"code":"UNAUTHORIZED"}]}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{}`), nil
+		})
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Go(func() {
+			_, _ = transport.NewRequest(context.Background()).Get("/repair/summary")
+		})
+	}
+	wg.Wait()
+	_, err = transport.NewRequest(context.Background()).Get("/repair/summary")
+	require.NoError(t, err)
+
+	// Requests rejected with session-1 share one new token between them.
+	assert.Equal(t, int32(2), exchanges.Load())
+}
+
+func TestAuthSession_ExchangeError(t *testing.T) {
+	now := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	s := newTestSession(&now)
+	ex := &fakeExchanger{failWith: errors.New("boom")}
+
+	_, err := s.Token(context.Background(), ex.exchange)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to obtain GSX auth token")
+}
+
+func TestCredentials_Validate(t *testing.T) {
+	_, err := NewTransport(Credentials{AuthToken: "a", SoldTo: "1"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "user Apple ID is required")
+
+	_, err = NewTransport(Credentials{UserAppleID: "tech@example.com", SoldTo: "1"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "activation token is required")
+
+	_, err = NewTransport(Credentials{UserAppleID: "tech@example.com", AuthToken: "a"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "sold-to account is required")
+}
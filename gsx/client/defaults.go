@@ -0,0 +1,16 @@
+package client
+
+import "time"
+
+// DefaultUserAgent is the default User-Agent header value for all requests.
+const (
+	DefaultUserAgent      = "go-api-sdk-apple/1.0.0"
+	Version               = "1.0.0"
+	DefaultBaseURL        = "https://partner-connect.apple.com/gsx/api"
+	DefaultServiceVersion = "v5"
+	DefaultClientLocale   = "en-US"
+)
+
+// DefaultTokenLifetime is how long an auth token is reused before it is
+// refreshed. GSX expires idle tokens after 30 minutes.
+const DefaultTokenLifetime = 25 * time.Minute
@@ -0,0 +1,88 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+// ErrorCodeUnauthorized is the code GSX returns when the auth token is
+// missing, expired or not valid for the sold-to account.
+const ErrorCodeUnauthorized = "UNAUTHORIZED"
+
+// APIError represents an error returned by the GSX API. GSX reports one or
+// more coded errors per failed request.
+type APIError struct {
+	StatusCode int           `json:"-"`
+	Errors     []ErrorDetail `json:"errors"`
+}
+
+// ErrorDetail is a single coded GSX error.
+type ErrorDetail struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *APIError) Error() string {
+	parts := make([]string, 0, len(e.Errors))
+	for _, d := range e.Errors {
+		if d.Message != "" {
+			parts = append(parts, fmt.Sprintf("%s: %s", d.Code, d.Message))
+		} else {
+			parts = append(parts, d.Code)
+		}
+	}
+	return fmt.Sprintf("GSX API error (HTTP %d): %s", e.StatusCode, strings.Join(parts, "; "))
+}
+
+// HasCode reports whether the error carries the given GSX error code.
+func (e *APIError) HasCode(code string) bool {
+	for _, d := range e.Errors {
+		if d.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// ErrorHandler centralizes error handling for all GSX API requests.
+type ErrorHandler struct {
+	logger *zap.Logger
+}
+
+// NewErrorHandler creates a new error handler.
+func NewErrorHandler(logger *zap.Logger) *ErrorHandler {
+	return &ErrorHandler{logger: logger}
+}
+
+// HandleError processes a failed HTTP response and returns an *APIError when
+// the body carries structured errors, or a plain status error otherwise.
+func (eh *ErrorHandler) HandleError(resp *resty.Response) error {
+	statusCode := resp.StatusCode()
+
+	eh.logger.Error("GSX API request failed",
+		zap.Int("status_code", statusCode),
+		zap.String("url", resp.Request.URL),
+		zap.String("method", resp.Request.Method),
+		zap.String("response_body", resp.String()),
+	)
+
+	var apiErr APIError
+	if err := json.Unmarshal(resp.Bytes(), &apiErr); err == nil && len(apiErr.Errors) > 0 {
+		apiErr.StatusCode = statusCode
+		return &apiErr
+	}
+
+	return fmt.Errorf("HTTP %d: %s", statusCode, http.StatusText(statusCode))
+}
+
+// IsAPIError reports whether err wraps an *APIError carrying the given GSX error code.
+func IsAPIError(err error, code string) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.HasCode(code)
+}
@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// requestExecutor is the execution backend for a RequestBuilder.
+// Transport implements it directly; tests supply a mock via NewMockRequestBuilder.
+type requestExecutor interface {
+	execute(req *resty.Request, method, path string, result any) (*resty.Response, error)
+}
+
+// RequestBuilder constructs a single API request. The service layer owns the
+// full request shape — headers, body, result target — before handing the
+// completed request to the executor (transport) which handles auth, retry
+// and logging.
+//
+// Usage:
+//
+//	var result ProductDetailsResponse
+//	resp, err := s.client.NewRequest(ctx).
+//	    SetHeader("Accept", constants.ApplicationJSON).
+//	    SetBody(body).
+//	    SetResult(&result).
+//	    Post(constants.EndpointProductDetails)
+type RequestBuilder struct {
+	req      *resty.Request
+	executor requestExecutor
+	result   any
+}
+
+// SetHeader sets a request-level header. Empty values are ignored.
+func (b *RequestBuilder) SetHeader(key, value string) *RequestBuilder {
+	if value != "" {
+		b.req.SetHeader(key, value)
+	}
+	return b
+}
+
+// SetBody sets the request body, marshaled as JSON.
+func (b *RequestBuilder) SetBody(body any) *RequestBuilder {
+	b.req.SetBody(body)
+	return b
+}
+
+// SetResult sets the target for JSON unmarshaling of a successful response.
+func (b *RequestBuilder) SetResult(result any) *RequestBuilder {
+	b.result = result
+	return b
+}
+
+// Get executes the request as GET against path.
+func (b *RequestBuilder) Get(path string) (*resty.Response, error) {
+	return b.executor.execute(b.req, "GET", path, b.result)
+}
+
+// Post executes the request as POST against path.
+func (b *RequestBuilder) Post(path string) (*resty.Response, error) {
+	return b.executor.execute(b.req, "POST", path, b.result)
+}
+
+// mockRequestExecutor backs a RequestBuilder in tests, routing execution
+// through a caller-supplied dispatch function instead of a real Transport.
+type mockRequestExecutor struct {
+	fn func(method, path string, result any) (*resty.Response, error)
+}
+
+func (m *mockRequestExecutor) execute(req *resty.Request, method, path string, result any) (*resty.Response, error) {
+	return m.fn(method, path, result)
+}
+
+// NewMockRequestBuilder returns a RequestBuilder suitable for unit tests.
+// The fn callback receives the method, path and result pointer and returns a
+// pre-programmed response.
+func NewMockRequestBuilder(ctx context.Context, fn func(method, path string, result any) (*resty.Response, error)) *RequestBuilder {
+	return &RequestBuilder{
+		req:      resty.New().R().SetContext(ctx),
+		executor: &mockRequestExecutor{fn: fn},
+	}
+}
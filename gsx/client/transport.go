@@ -0,0 +1,201 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/gsx/constants"
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+// Transport represents the GSX API HTTP transport layer. Requests are sent
+// over mutual TLS with the Apple-issued GSX client certificate and carry the
+// account headers plus a rolling auth token, which the transport obtains and
+// refreshes from the activation token in Credentials.
+type Transport struct {
+	httpClient     *resty.Client
+	logger         *zap.Logger
+	errorHandler   *ErrorHandler
+	credentials    Credentials
+	session        *authSession
+	baseURL        string
+	serviceVersion string
+	clientLocale   string
+}
+
+// Ensure Transport implements Client interface.
+var _ Client = (*Transport)(nil)
+
+// NewTransport creates a new HTTP transport for the GSX API.
+// This is an internal function — users should use gsx.NewClient() instead.
+func NewTransport(credentials Credentials, options ...ClientOption) (*Transport, error) {
+	if err := credentials.validate(); err != nil {
+		return nil, err
+	}
+
+	logger := zap.NewNop()
+
+	httpClient := resty.New()
+	httpClient.
+		SetBaseURL(DefaultBaseURL).
+		SetTimeout(30*time.Second).
+		SetRetryCount(3).
+		SetRetryWaitTime(1*time.Second).
+		SetRetryMaxWaitTime(10*time.Second).
+		SetHeader("User-Agent", DefaultUserAgent)
+
+	transport := &Transport{
+		httpClient:     httpClient,
+		logger:         logger,
+		errorHandler:   NewErrorHandler(logger),
+		credentials:    credentials,
+		session:        newAuthSession(credentials.AuthToken),
+		baseURL:        DefaultBaseURL,
+		serviceVersion: DefaultServiceVersion,
+		clientLocale:   DefaultClientLocale,
+	}
+
+	for _, option := range options {
+		if err := option(transport); err != nil {
+			return nil, fmt.Errorf("failed to apply client option: %w", err)
+		}
+	}
+
+	// Account and version headers go on every request, including the token
+	// exchange itself.
+	httpClient.AddRequestMiddleware(func(c *resty.Client, req *resty.Request) error {
+		req.SetHeader("X-Apple-SoldTo", transport.credentials.SoldTo)
+		if transport.credentials.ShipTo != "" {
+			req.SetHeader("X-Apple-ShipTo", transport.credentials.ShipTo)
+		}
+		req.SetHeader("X-Operator-User-ID", transport.credentials.UserAppleID)
+		req.SetHeader("X-Apple-Service-Version", transport.serviceVersion)
+		req.SetHeader("X-Apple-Client-Locale", transport.clientLocale)
+		return nil
+	})
+
+	httpClient.AddRequestMiddleware(func(c *resty.Client, req *resty.Request) error {
+		transport.logger.Info("GSX API request",
+			zap.String("method", req.Method),
+			zap.String("url", req.URL),
+		)
+		return nil
+	})
+
+	httpClient.AddResponseMiddleware(func(c *resty.Client, resp *resty.Response) error {
+		transport.logger.Info("GSX API response",
+			zap.String("method", resp.Request.Method),
+			zap.String("url", resp.Request.URL),
+			zap.Int("status_code", resp.StatusCode()),
+			zap.String("status", resp.Status()),
+		)
+		return nil
+	})
+
+	transport.logger.Info("GSX API client created",
+		zap.String("base_url", transport.baseURL),
+		zap.String("sold_to", credentials.SoldTo))
+
+	return transport, nil
+}
+
+// NewRequest returns a new RequestBuilder for constructing API requests.
+func (t *Transport) NewRequest(ctx context.Context) *RequestBuilder {
+	return &RequestBuilder{
+		req:      t.httpClient.R().SetContext(ctx),
+		executor: t,
+	}
+}
+
+// GetLogger returns the configured logger.
+func (t *Transport) GetLogger() *zap.Logger {
+	return t.logger
+}
+
+// GetHTTPClient returns the underlying HTTP client for testing purposes.
+func (t *Transport) GetHTTPClient() *resty.Client {
+	return t.httpClient
+}
+
+// Close closes the HTTP client and cleans up resources.
+func (t *Transport) Close() error {
+	if t.httpClient != nil {
+		t.httpClient.Close()
+	}
+	return nil
+}
+
+// exchangeToken trades current for a fresh auth token at /authenticate/token.
+func (t *Transport) exchangeToken(ctx context.Context, current string) (string, error) {
+	var result struct {
+		AuthToken string `json:"authToken"`
+	}
+	resp, err := t.httpClient.R().
+		SetContext(ctx).
+		SetHeader("Content-Type", constants.ApplicationJSON).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetBody(map[string]string{
+			"userAppleId": t.credentials.UserAppleID,
+			"authToken":   current,
+		}).
+		Post(constants.EndpointAuthenticateToken)
+	if err != nil {
+		return "", fmt.Errorf("token request failed: %w", err)
+	}
+	if resp.IsStatusFailure() {
+		return "", t.errorHandler.HandleError(resp)
+	}
+	if err := json.Unmarshal(resp.Bytes(), &result); err != nil {
+		return "", fmt.Errorf("failed to unmarshal token response: %w", err)
+	}
+	if result.AuthToken == "" {
+		return "", fmt.Errorf("token response carried no authToken")
+	}
+	return result.AuthToken, nil
+}
+
+// execute implements requestExecutor — handles auth, all HTTP methods and
+// error processing.
+func (t *Transport) execute(req *resty.Request, method, path string, result any) (*resty.Response, error) {
+	token, err := t.session.Token(req.Context(), t.exchangeToken)
+	if err != nil {
+		return nil, err
+	}
+	req.SetHeader("X-Apple-Auth-Token", token)
+
+	var resp *resty.Response
+
+	switch method {
+	case "GET":
+		resp, err = req.Get(path)
+	case "POST":
+		req.SetHeader("Content-Type", constants.ApplicationJSON)
+		resp, err = req.Post(path)
+	default:
+		return nil, fmt.Errorf("unsupported HTTP method: %s", method)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	if resp.IsStatusFailure() {
+		if resp.StatusCode() == http.StatusUnauthorized {
+			t.session.Expire(token)
+		}
+		return resp, t.errorHandler.HandleError(resp)
+	}
+	t.session.Touch()
+
+	if result != nil {
+		if err := json.Unmarshal(resp.Bytes(), result); err != nil {
+			return resp, fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,17 @@
+package constants
+
+// API base URLs
+const (
+	// DefaultBaseURL is the base URL for the production GSX API.
+	DefaultBaseURL = "https://partner-connect.apple.com/gsx/api"
+	// UATBaseURL is the base URL for the GSX user acceptance testing
+	// environment.
+	UATBaseURL = "https://partner-connect-uat.apple.com/gsx/api"
+)
+
+// API endpoint paths
+const (
+	EndpointAuthenticateToken = "/authenticate/token"
+	EndpointProductDetails    = "/repair/product/details"
+	EndpointRepairEligibility = "/repair/eligibility"
+)
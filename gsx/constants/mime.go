@@ -0,0 +1,6 @@
+package constants
+
+// MIME type constants for HTTP headers
+const (
+	ApplicationJSON = "application/json"
+)
@@ -0,0 +1,54 @@
+package gsx
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-apple/gsx/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/gsx/gsx_api/warranty"
+)
+
+// Client is the main entry point for the Global Service Exchange (GSX) API SDK.
+// A Client is safe for concurrent use by multiple goroutines.
+type Client struct {
+	transport *client.Transport
+	GSXAPI    *GSXAPIClient
+}
+
+// GSXAPIClient groups all GSX API services.
+type GSXAPIClient struct {
+	Warranty *warranty.Warranty
+}
+
+// NewClient creates a new GSX API client.
+// Parameters:
+//   - credentials: The technician Apple ID, activation token and sold-to /
+//     ship-to accounts the requests are made for
+//   - options: Optional configuration options. GSX requires mutual TLS, so
+//     pass the Apple-issued certificate with WithCertificate or
+//     WithClientCertificate.
+//
+// Example:
+//
+//	cert, err := tls.LoadX509KeyPair("gsx.pem", "gsx.key")
+//	c, err := gsx.NewClient(gsx.Credentials{
+//	    UserAppleID: "tech@example.com",
+//	    AuthToken:   os.Getenv("GSX_ACTIVATION_TOKEN"),
+//	    SoldTo:      "0000123456",
+//	    ShipTo:      "0000123456",
+//	}, gsx.WithCertificate(cert))
+func NewClient(credentials Credentials, options ...client.ClientOption) (*Client, error) {
+	transport, err := client.NewTransport(credentials, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		transport: transport,
+		GSXAPI: &GSXAPIClient{
+			Warranty: warranty.NewService(transport),
+		},
+	}, nil
+}
+
+// Close releases resources held by the client.
+func (c *Client) Close() error {
+	return c.transport.Close()
+}
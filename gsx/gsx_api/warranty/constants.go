@@ -0,0 +1,30 @@
+package warranty
+
+// Warranty status codes reported in WarrantyInfo.WarrantyStatusCode.
+const (
+	WarrantyStatusAppleLimitedWarranty = "LP"
+	WarrantyStatusAppleCareProtection  = "PP"
+	WarrantyStatusOutOfWarranty        = "OO"
+)
+
+// Activation lock states reported in Device.ActivationLockStatus.
+const (
+	ActivationLockOn  = "ON"
+	ActivationLockOff = "OFF"
+)
+
+// Repair types accepted in EligibilityRequest.RepairType.
+const (
+	RepairTypeCarryIn   = "CIN"
+	RepairTypeMailIn    = "MINC"
+	RepairTypeOnsite    = "ONSR"
+	RepairTypeWholeUnit = "WUMS"
+	RepairTypeNonRepair = "SVNR"
+)
+
+// Eligibility outcome action codes.
+const (
+	ActionHold    = "HOLD"
+	ActionWarning = "WARNING"
+	ActionMessage = "MESSAGE"
+)
@@ -0,0 +1,79 @@
+package warranty
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/gsx/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/gsx/constants"
+	"resty.dev/v3"
+)
+
+// Warranty handles the warranty and repair eligibility methods of the GSX
+// API. It complements the AppleCare coverage reported by Apple Business
+// Manager with Apple's service view of a unit: limited warranty dates,
+// labor and parts coverage, activation lock state and repair holds.
+//
+// GSX API docs are published to authorized service providers in GSX.
+type (
+	Warranty struct {
+		client client.Client
+	}
+)
+
+// NewService creates a new warranty service.
+func NewService(c client.Client) *Warranty {
+	return &Warranty{client: c}
+}
+
+// GetProductDetailsV1 retrieves product and warranty details for the device
+// with the given serial number or IMEI, with coverage evaluated as of now.
+// URL: POST https://partner-connect.apple.com/gsx/api/repair/product/details
+func (s *Warranty) GetProductDetailsV1(ctx context.Context, serialNumber string) (*ProductDetailsResponse, *resty.Response, error) {
+	if serialNumber == "" {
+		return nil, nil, fmt.Errorf("serial number is required")
+	}
+
+	body := ProductDetailsRequest{
+		UnitReceivedDateTime: time.Now().UTC().Format(time.RFC3339),
+		Device:               DeviceID{ID: serialNumber},
+	}
+
+	var result ProductDetailsResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetBody(body).
+		SetResult(&result).
+		Post(constants.EndpointProductDetails)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// CheckRepairEligibilityV1 checks whether the device in request can be
+// repaired, returning any holds or warnings and the applicable coverage.
+// URL: POST https://partner-connect.apple.com/gsx/api/repair/eligibility
+func (s *Warranty) CheckRepairEligibilityV1(ctx context.Context, request *EligibilityRequest) (*EligibilityResponse, *resty.Response, error) {
+	if request == nil || request.Device.ID == "" {
+		return nil, nil, fmt.Errorf("device serial number is required")
+	}
+
+	var result EligibilityResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetBody(request).
+		SetResult(&result).
+		Post(constants.EndpointRepairEligibility)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
@@ -0,0 +1,213 @@
+package warranty
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/gsx/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/gsx/constants"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var testCredentials = client.Credentials{
+	UserAppleID: "tech@example.com",
+	AuthToken:   "activation-token",
+	SoldTo:      "0000123456",
+	ShipTo:      "0000654321",
+}
+
+// setupMockClient creates a GSX transport with httpmock enabled and a token
+// endpoint that exchanges the activation token, or a prior session token,
+// for "session-token".
+func setupMockClient(t *testing.T) *Warranty {
+	t.Helper()
+
+	transport, err := client.NewTransport(testCredentials,
+		client.WithLogger(zap.NewNop()),
+		client.WithRetryCount(0), // disable retries for tests
+	)
+	require.NoError(t, err)
+
+	httpmock.ActivateNonDefault(transport.GetHTTPClient().Client())
+	t.Cleanup(func() {
+		httpmock.DeactivateAndReset()
+	})
+
+	httpmock.RegisterResponder("POST", constants.DefaultBaseURL+constants.EndpointAuthenticateToken,
+		func(req *http.Request) (*http.Response, error) {
+			var body map[string]string
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				return httpmock.NewStringResponse(400, ""), nil
+			}
+			if body["userAppleId"] != "tech@example.com" || (body["authToken"] != "activation-token" && body["authToken"] != "session-token") {
+				return httpmock.NewStringResponse(401, `{"errors":[{"code":"UNAUTHORIZED"}]}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{"authToken":"session-token"}`), nil
+		})
+
+	return NewService(transport)
+}
+
+const productDetailsResponse = `{
+	"device": {
+		"identifiers": {"serial": "C02XK1JHJG5J", "productCode": "MNW83"},
+		"productDescription": "MacBook Pro (14-inch, 2023)",
+		"configDescription": "MBP 14 M3 8C 10C 8GB 512GB",
+		"soldToName": "Example Corp",
+		"activationLockStatus": "OFF",
+		"loaner": false,
+		"warrantyInfo": {
+			"warrantyStatusCode": "PP",
+			"warrantyStatusDescription": "AppleCare Protection Plan",
+			"coverageStartDate": "2024-02-01T00:00:00Z",
+			"coverageEndDate": "2027-02-01T00:00:00Z",
+			"daysRemaining": 840,
+			"purchaseCountryCode": "USA",
+			"onsiteCoverage": false,
+			"laborCovered": true,
+			"partCovered": true
+		}
+	}
+}`
+
+func TestGetProductDetailsV1_Success(t *testing.T) {
+	svc := setupMockClient(t)
+
+	httpmock.RegisterResponder("POST", constants.DefaultBaseURL+constants.EndpointProductDetails,
+		func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "session-token", req.Header.Get("X-Apple-Auth-Token"))
+			assert.Equal(t, "0000123456", req.Header.Get("X-Apple-SoldTo"))
+			assert.Equal(t, "0000654321", req.Header.Get("X-Apple-ShipTo"))
+			assert.Equal(t, "tech@example.com", req.Header.Get("X-Operator-User-ID"))
+			assert.Equal(t, "v5", req.Header.Get("X-Apple-Service-Version"))
+			assert.Equal(t, "en-US", req.Header.Get("X-Apple-Client-Locale"))
+
+			var body ProductDetailsRequest
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			assert.Equal(t, "C02XK1JHJG5J", body.Device.ID)
+			assert.NotEmpty(t, body.UnitReceivedDateTime)
+
+			return httpmock.NewStringResponse(200, productDetailsResponse), nil
+		})
+
+	result, resp, err := svc.GetProductDetailsV1(context.Background(), "C02XK1JHJG5J")
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, 200, resp.StatusCode())
+	assert.Equal(t, "C02XK1JHJG5J", result.Device.Identifiers.Serial)
+	assert.Equal(t, "MacBook Pro (14-inch, 2023)", result.Device.ProductDescription)
+	assert.Equal(t, ActivationLockOff, result.Device.ActivationLockStatus)
+	require.NotNil(t, result.Device.WarrantyInfo)
+	assert.Equal(t, WarrantyStatusAppleCareProtection, result.Device.WarrantyInfo.WarrantyStatusCode)
+	assert.Equal(t, 840, result.Device.WarrantyInfo.DaysRemaining)
+	assert.True(t, result.Device.WarrantyInfo.LaborCovered)
+
+	info := httpmock.GetCallCountInfo()
+	assert.Equal(t, 1, info["POST "+constants.DefaultBaseURL+constants.EndpointAuthenticateToken])
+}
+
+func TestGetProductDetailsV1_ReusesToken(t *testing.T) {
+	svc := setupMockClient(t)
+
+	httpmock.RegisterResponder("POST", constants.DefaultBaseURL+constants.EndpointProductDetails,
+		httpmock.NewStringResponder(200, productDetailsResponse))
+
+	for range 3 {
+		_, _, err := svc.GetProductDetailsV1(context.Background(), "C02XK1JHJG5J")
+		require.NoError(t, err)
+	}
+
+	info := httpmock.GetCallCountInfo()
+	assert.Equal(t, 1, info["POST "+constants.DefaultBaseURL+constants.EndpointAuthenticateToken])
+	assert.Equal(t, 3, info["POST "+constants.DefaultBaseURL+constants.EndpointProductDetails])
+}
+
+func TestGetProductDetailsV1_Unauthorized(t *testing.T) {
+	svc := setupMockClient(t)
+
+	httpmock.RegisterResponder("POST", constants.DefaultBaseURL+constants.EndpointProductDetails,
+		httpmock.NewStringResponder(401, `{"errors":[{"code":"UNAUTHORIZED","message":"Auth token expired"}]}`))
+
+	result, resp, err := svc.GetProductDetailsV1(context.Background(), "C02XK1JHJG5J")
+
+	require.Error(t, err)
+	require.NotNil(t, resp)
+	assert.Nil(t, result)
+	assert.True(t, client.IsAPIError(err, client.ErrorCodeUnauthorized))
+	assert.Contains(t, err.Error(), "Auth token expired")
+
+	// The rejected token is exchanged again before the next request.
+	_, _, _ = svc.GetProductDetailsV1(context.Background(), "C02XK1JHJG5J")
+	info := httpmock.GetCallCountInfo()
+	assert.Equal(t, 2, info["POST "+constants.DefaultBaseURL+constants.EndpointAuthenticateToken])
+}
+
+func TestGetProductDetailsV1_EmptySerial(t *testing.T) {
+	svc := setupMockClient(t)
+
+	_, _, err := svc.GetProductDetailsV1(context.Background(), "")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "serial number is required")
+}
+
+func TestCheckRepairEligibilityV1_Hold(t *testing.T) {
+	svc := setupMockClient(t)
+
+	httpmock.RegisterResponder("POST", constants.DefaultBaseURL+constants.EndpointRepairEligibility,
+		func(req *http.Request) (*http.Response, error) {
+			var body EligibilityRequest
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			assert.Equal(t, "C02XK1JHJG5J", body.Device.ID)
+			assert.Equal(t, RepairTypeCarryIn, body.RepairType)
+
+			return httpmock.NewStringResponse(200, `{
+				"eligibilityDetails": {
+					"outcome": [
+						{"action": {"code": "HOLD"}, "reasons": [{"type": "REPAIR_TYPE", "messages": ["Find My is enabled."]}]}
+					],
+					"coverageOptions": ["PP"]
+				}
+			}`), nil
+		})
+
+	result, _, err := svc.CheckRepairEligibilityV1(context.Background(), &EligibilityRequest{
+		Device:     DeviceID{ID: "C02XK1JHJG5J"},
+		RepairType: RepairTypeCarryIn,
+	})
+
+	require.NoError(t, err)
+	assert.False(t, result.EligibilityDetails.Eligible())
+	require.Len(t, result.EligibilityDetails.Outcome, 1)
+	assert.Equal(t, []string{"Find My is enabled."}, result.EligibilityDetails.Outcome[0].Reasons[0].Messages)
+	assert.Equal(t, []string{"PP"}, result.EligibilityDetails.CoverageOptions)
+}
+
+func TestCheckRepairEligibilityV1_Eligible(t *testing.T) {
+	svc := setupMockClient(t)
+
+	httpmock.RegisterResponder("POST", constants.DefaultBaseURL+constants.EndpointRepairEligibility,
+		httpmock.NewStringResponder(200, `{"eligibilityDetails": {"outcome": [{"action": {"code": "WARNING"}}]}}`))
+
+	result, _, err := svc.CheckRepairEligibilityV1(context.Background(), &EligibilityRequest{
+		Device: DeviceID{ID: "C02XK1JHJG5J"},
+	})
+
+	require.NoError(t, err)
+	assert.True(t, result.EligibilityDetails.Eligible())
+}
+
+func TestCheckRepairEligibilityV1_MissingDevice(t *testing.T) {
+	svc := setupMockClient(t)
+
+	_, _, err := svc.CheckRepairEligibilityV1(context.Background(), &EligibilityRequest{})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "device serial number is required")
+}
@@ -0,0 +1,132 @@
+package warranty
+
+// DeviceID identifies a device by serial number or IMEI.
+type DeviceID struct {
+	ID string `json:"id"`
+}
+
+// ProductDetailsRequest is the body of a product details lookup.
+type ProductDetailsRequest struct {
+	// UnitReceivedDateTime is when the unit was received for service, in
+	// RFC 3339 format. GSX evaluates coverage as of this time.
+	UnitReceivedDateTime string   `json:"unitReceivedDateTime"`
+	Device               DeviceID `json:"device"`
+}
+
+// ProductDetailsResponse is the response to a product details lookup.
+type ProductDetailsResponse struct {
+	Device Device `json:"device"`
+}
+
+// Device describes a unit as known to GSX.
+type Device struct {
+	Identifiers Identifiers `json:"identifiers"`
+	// ProductDescription is the marketing name, e.g. "MacBook Pro (14-inch, 2023)".
+	ProductDescription string `json:"productDescription"`
+	// ConfigDescription is the configuration, e.g. "MBP 14 M3 8C 10C 8GB 512GB".
+	ConfigDescription string `json:"configDescription"`
+	ConfigCode        string `json:"configCode,omitempty"`
+	ProductLine       string `json:"productLine,omitempty"`
+	SoldToName        string `json:"soldToName,omitempty"`
+	// ActivationLockStatus is ActivationLockOn or ActivationLockOff.
+	ActivationLockStatus string        `json:"activationLockStatus,omitempty"`
+	Loaner               bool          `json:"loaner"`
+	WarrantyInfo         *WarrantyInfo `json:"warrantyInfo,omitempty"`
+}
+
+// Identifiers lists the identifiers GSX holds for a device.
+type Identifiers struct {
+	Serial      string `json:"serial"`
+	IMEI        string `json:"imei,omitempty"`
+	IMEI2       string `json:"imei2,omitempty"`
+	MEID        string `json:"meid,omitempty"`
+	ProductCode string `json:"productCode,omitempty"`
+}
+
+// WarrantyInfo is the device's warranty and service coverage.
+type WarrantyInfo struct {
+	// WarrantyStatusCode is one of the WarrantyStatus constants.
+	WarrantyStatusCode        string `json:"warrantyStatusCode"`
+	WarrantyStatusDescription string `json:"warrantyStatusDescription"`
+	// CoverageStartDate and CoverageEndDate are RFC 3339 timestamps.
+	CoverageStartDate string `json:"coverageStartDate,omitempty"`
+	CoverageEndDate   string `json:"coverageEndDate,omitempty"`
+	DaysRemaining     int    `json:"daysRemaining"`
+	PurchaseDate      string `json:"purchaseDate,omitempty"`
+	PurchaseCountry   string `json:"purchaseCountryCode,omitempty"`
+	RegistrationDate  string `json:"registrationDate,omitempty"`
+	OnsiteCoverage    bool   `json:"onsiteCoverage"`
+	LaborCovered      bool   `json:"laborCovered"`
+	PartCovered       bool   `json:"partCovered"`
+	// PersonalizedProduct is true for engraved units.
+	PersonalizedProduct bool `json:"personalized"`
+	// ContractCoverageEndDate is when an AppleCare agreement ends, if any.
+	ContractCoverageEndDate string `json:"contractCoverageEndDate,omitempty"`
+	ContractType            string `json:"contractType,omitempty"`
+}
+
+// EligibilityRequest is the body of a repair eligibility check.
+type EligibilityRequest struct {
+	Device DeviceID `json:"device"`
+	// RepairType is one of the RepairType constants. GSX checks all repair
+	// types when it is empty.
+	RepairType      string           `json:"repairType,omitempty"`
+	ReportedSymptom *ReportedSymptom `json:"reportedSymptom,omitempty"`
+	ComponentIssues []ComponentIssue `json:"componentIssues,omitempty"`
+}
+
+// ReportedSymptom is the customer-reported symptom code.
+type ReportedSymptom struct {
+	Code string `json:"code"`
+}
+
+// ComponentIssue is a component and issue code pair diagnosed by the
+// technician.
+type ComponentIssue struct {
+	ComponentCode   string `json:"componentCode"`
+	IssueCode       string `json:"issueCode"`
+	Reproducibility string `json:"reproducibility,omitempty"`
+	Type            string `json:"type,omitempty"`
+}
+
+// EligibilityResponse is the response to a repair eligibility check.
+type EligibilityResponse struct {
+	EligibilityDetails EligibilityDetails `json:"eligibilityDetails"`
+}
+
+// EligibilityDetails summarizes which repairs GSX allows for the device.
+type EligibilityDetails struct {
+	// Outcome lists the actions GSX requires or advises, e.g. a hold or a
+	// warning, with their reasons.
+	Outcome []Outcome `json:"outcome"`
+	// CoverageOptions are the coverage types the repair may be billed
+	// under, e.g. "VMI" or "LP".
+	CoverageOptions []string `json:"coverageOptions,omitempty"`
+}
+
+// Outcome is a single eligibility outcome.
+type Outcome struct {
+	Action  Action   `json:"action"`
+	Reasons []Reason `json:"reasons,omitempty"`
+}
+
+// Action is the action GSX attaches to an outcome, e.g. "HOLD" or "WARNING".
+type Action struct {
+	Code string `json:"code"`
+}
+
+// Reason explains an outcome.
+type Reason struct {
+	Type     string   `json:"type"`
+	Messages []string `json:"messages,omitempty"`
+}
+
+// Eligible reports whether no outcome places the repair on hold.
+func (d *EligibilityDetails) Eligible() bool {
+	for _, o := range d.Outcome {
+		if o.Action.Code == ActionHold {
+			return false
+		}
+	}
+	return true
+}
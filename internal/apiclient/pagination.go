@@ -0,0 +1,64 @@
+package apiclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// Meta contains pagination metadata matching Apple's API format.
+type Meta struct {
+	Paging *Paging `json:"paging,omitempty"`
+}
+
+// Paging contains pagination information matching Apple's API format.
+type Paging struct {
+	Total      int    `json:"total,omitempty"`
+	Limit      int    `json:"limit,omitempty"`
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
+// Links contains pagination navigation links matching Apple's API format.
+type Links struct {
+	Self  string `json:"self,omitempty"`
+	First string `json:"first,omitempty"`
+	Next  string `json:"next,omitempty"`
+	Prev  string `json:"prev,omitempty"`
+	Last  string `json:"last,omitempty"`
+}
+
+// HasNextPage checks if there is a next page available.
+func HasNextPage(links *Links) bool {
+	return links != nil && links.Next != ""
+}
+
+// HasPrevPage checks if there is a previous page available.
+func HasPrevPage(links *Links) bool {
+	return links != nil && links.Prev != ""
+}
+
+// ParamsFromURL returns the query parameters of a URL string, such as a next
+// page link, keeping the first value of each.
+func ParamsFromURL(urlStr string) (map[string]string, error) {
+	parsedURL, err := url.Parse(urlStr)
+	if err != nil {
+		return nil, err
+	}
+
+	params := make(map[string]string)
+	for key, values := range parsedURL.Query() {
+		if len(values) > 0 {
+			params[key] = values[0]
+		}
+	}
+
+	return params, nil
+}
+
+// ParseJSON unmarshals raw JSON bytes into target.
+func ParseJSON(data []byte, target any) error {
+	if err := json.Unmarshal(data, target); err != nil {
+		return fmt.Errorf("json unmarshal failed: %w", err)
+	}
+	return nil
+}
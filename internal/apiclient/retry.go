@@ -0,0 +1,233 @@
+// Package apiclient holds the transport pieces the resty-based API clients
+// share: retry policies and the JSON:API pagination envelope. The client
+// packages re-export them under their own names.
+package apiclient
+
+import (
+	"math"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"resty.dev/v3"
+)
+
+// RetryPolicy decides whether a failed request attempt is retried and how long
+// to wait before the next attempt. Implementations must be safe for concurrent
+// use. The attempt argument is the 1-based number of the attempt that just
+// completed; resp may carry a zero status when no HTTP response was received.
+//
+// Clients install a policy with their WithRetryPolicy option. The maximum
+// number of attempts is still bounded by WithRetryCount.
+type RetryPolicy interface {
+	// ShouldRetry reports whether the attempt that produced resp/err should be retried.
+	ShouldRetry(resp *resty.Response, err error, attempt int) bool
+
+	// NextDelay returns how long to wait before the next attempt.
+	NextDelay(resp *resty.Response, err error, attempt int) time.Duration
+}
+
+// DefaultRetryPolicy retries transport errors, 429 Too Many Requests and 5xx
+// responses (except 501 Not Implemented) for idempotent methods only, waiting
+// with capped exponential backoff. A Retry-After header on 429/503 responses
+// takes precedence over the computed delay.
+type DefaultRetryPolicy struct {
+	// WaitTime is the initial delay before the first retry.
+	WaitTime time.Duration
+
+	// MaxWaitTime caps the delay between retries.
+	MaxWaitTime time.Duration
+}
+
+// Ensure DefaultRetryPolicy implements RetryPolicy.
+var _ RetryPolicy = (*DefaultRetryPolicy)(nil)
+
+// NewDefaultRetryPolicy returns the default retry policy using the transport's
+// default wait times (1s initial, 10s maximum).
+func NewDefaultRetryPolicy() *DefaultRetryPolicy {
+	return &DefaultRetryPolicy{
+		WaitTime:    1 * time.Second,
+		MaxWaitTime: 10 * time.Second,
+	}
+}
+
+// ShouldRetry implements RetryPolicy.
+func (p *DefaultRetryPolicy) ShouldRetry(resp *resty.Response, err error, attempt int) bool {
+	if resp != nil && resp.Request != nil && !isIdempotentMethod(resp.Request.Method) {
+		return false
+	}
+
+	if err != nil {
+		return true
+	}
+
+	if resp == nil {
+		return false
+	}
+
+	statusCode := resp.StatusCode()
+	return statusCode == http.StatusTooManyRequests ||
+		(statusCode >= 500 && statusCode != http.StatusNotImplemented)
+}
+
+// NextDelay implements RetryPolicy.
+func (p *DefaultRetryPolicy) NextDelay(resp *resty.Response, err error, attempt int) time.Duration {
+	if resp != nil {
+		if delay, ok := retryAfter(resp); ok {
+			return delay
+		}
+	}
+
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	delay := float64(p.WaitTime) * math.Exp2(float64(attempt-1))
+	if p.MaxWaitTime > 0 && delay > float64(p.MaxWaitTime) {
+		return p.MaxWaitTime
+	}
+	return time.Duration(delay)
+}
+
+// RetryRule declares which HTTP statuses are retryable for requests matching
+// Method and PathPrefix. Empty Method or PathPrefix match any request. A rule
+// with no Statuses and RetryOnError unset marks matching requests as never
+// retryable.
+type RetryRule struct {
+	// Method is the HTTP method the rule applies to, e.g. "POST". Empty matches any method.
+	Method string
+
+	// PathPrefix restricts the rule to request paths starting with this value,
+	// e.g. "/v1/orgDeviceActivities". Empty matches any path.
+	PathPrefix string
+
+	// Statuses lists the response status codes that should be retried.
+	Statuses []int
+
+	// RetryOnError retries attempts that failed without an HTTP response
+	// (connection resets, timeouts).
+	RetryOnError bool
+}
+
+// matches reports whether the rule applies to the given method and path.
+func (r RetryRule) matches(method, path string) bool {
+	if r.Method != "" && !strings.EqualFold(r.Method, method) {
+		return false
+	}
+	return r.PathPrefix == "" || strings.HasPrefix(path, r.PathPrefix)
+}
+
+// StatusMatrixRetryPolicy retries according to an explicit per-method/path
+// status matrix. Rules are evaluated in order and the first matching rule
+// decides; requests that match no rule fall back to DefaultRetryPolicy, which
+// also supplies the delay between attempts.
+type StatusMatrixRetryPolicy struct {
+	DefaultRetryPolicy
+	Rules []RetryRule
+}
+
+// Ensure StatusMatrixRetryPolicy implements RetryPolicy.
+var _ RetryPolicy = (*StatusMatrixRetryPolicy)(nil)
+
+// NewStatusMatrixRetryPolicy returns a StatusMatrixRetryPolicy with the given
+// rules and the default backoff.
+func NewStatusMatrixRetryPolicy(rules ...RetryRule) *StatusMatrixRetryPolicy {
+	return &StatusMatrixRetryPolicy{
+		DefaultRetryPolicy: *NewDefaultRetryPolicy(),
+		Rules:              rules,
+	}
+}
+
+// ShouldRetry implements RetryPolicy.
+func (p *StatusMatrixRetryPolicy) ShouldRetry(resp *resty.Response, err error, attempt int) bool {
+	if resp == nil || resp.Request == nil {
+		return p.DefaultRetryPolicy.ShouldRetry(resp, err, attempt)
+	}
+
+	method, path := resp.Request.Method, requestPath(resp.Request)
+	for _, rule := range p.Rules {
+		if !rule.matches(method, path) {
+			continue
+		}
+		if err != nil || resp.StatusCode() == 0 {
+			return rule.RetryOnError
+		}
+		return slices.Contains(rule.Statuses, resp.StatusCode())
+	}
+
+	return p.DefaultRetryPolicy.ShouldRetry(resp, err, attempt)
+}
+
+// requestPath returns the URL path of req, preferring the fully resolved raw request.
+func requestPath(req *resty.Request) string {
+	if req.RawRequest != nil && req.RawRequest.URL != nil {
+		return req.RawRequest.URL.Path
+	}
+	if parsed, err := url.Parse(req.URL); err == nil {
+		return parsed.Path
+	}
+	return req.URL
+}
+
+// InstallRetryPolicy makes c retry according to policy, replacing resty's
+// built-in retry conditions and backoff. Because the policy sees the request
+// method, retries are no longer restricted to idempotent methods — the policy
+// decides.
+func InstallRetryPolicy(c *resty.Client, policy RetryPolicy) {
+	c.SetRetryDefaultConditions(false).
+		SetRetryAllowNonIdempotent(true).
+		AddRetryConditions(func(resp *resty.Response, err error) bool {
+			return policy.ShouldRetry(resp, err, retryAttempt(resp))
+		}).
+		SetRetryDelayStrategy(func(resp *resty.Response, err error) (time.Duration, error) {
+			return policy.NextDelay(resp, err, retryAttempt(resp)), nil
+		})
+}
+
+// retryAttempt returns the 1-based attempt number carried by resp.
+func retryAttempt(resp *resty.Response) int {
+	if resp == nil || resp.Request == nil {
+		return 0
+	}
+	return resp.Request.Attempt
+}
+
+// retryAfter parses a Retry-After header (delay-seconds or HTTP-date) on 429
+// and 503 responses.
+func retryAfter(resp *resty.Response) (time.Duration, bool) {
+	statusCode := resp.StatusCode()
+	if statusCode != http.StatusTooManyRequests && statusCode != http.StatusServiceUnavailable {
+		return 0, false
+	}
+
+	value := resp.Header().Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if at, err := http.ParseTime(value); err == nil {
+		if delay := time.Until(at); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+
+	return 0, false
+}
+
+// isIdempotentMethod reports whether method is idempotent per RFC 9110.
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace,
+		http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,180 @@
+// Package es256 issues the ES256-signed JSON Web Tokens Apple services use
+// for provider authentication: a "kid" header naming the key and "iss" and
+// "iat" claims naming the team or issuer and the issue time, plus whatever
+// claims a service adds, such as App Store Connect's "aud" and "exp". APNs and
+// DeviceCheck accept such a token for up to an hour and reject providers that
+// replace it too often, so a Signer reuses each token until it is due for
+// replacement.
+package es256
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// DefaultRefreshAfter is how long a token is reused by default: past APNs'
+// 20 minute minimum between token updates and well inside the one hour
+// tokens are accepted for.
+const DefaultRefreshAfter = 30 * time.Minute
+
+// Signer issues and caches provider tokens. It is safe for concurrent use.
+type Signer struct {
+	keyID        string
+	issuer       string
+	key          *ecdsa.PrivateKey
+	refreshAfter time.Duration
+	lifetime     time.Duration
+	claims       map[string]any
+	now          func() time.Time
+
+	mu       sync.Mutex
+	token    string
+	issuedAt time.Time
+}
+
+// Option customizes the tokens a Signer issues.
+type Option func(*Signer)
+
+// WithClaims adds claims, such as "aud", to every token. They cannot
+// replace "iss", "iat" or "exp".
+func WithClaims(claims map[string]any) Option {
+	return func(s *Signer) {
+		if s.claims == nil {
+			s.claims = map[string]any{}
+		}
+		maps.Copy(s.claims, claims)
+	}
+}
+
+// WithExpiry adds an "exp" claim lifetime after the issue time. A token is
+// replaced before it expires: the refresh interval is capped at half the
+// lifetime.
+func WithExpiry(lifetime time.Duration) Option {
+	return func(s *Signer) { s.lifetime = lifetime }
+}
+
+// NewSigner returns a Signer for the key with the given key ID, issuing
+// tokens on behalf of issuer (the team ID). A refreshAfter of 0 selects
+// DefaultRefreshAfter.
+func NewSigner(keyID, issuer string, key *ecdsa.PrivateKey, refreshAfter time.Duration, opts ...Option) *Signer {
+	if refreshAfter <= 0 {
+		refreshAfter = DefaultRefreshAfter
+	}
+	s := &Signer{
+		keyID:        keyID,
+		issuer:       issuer,
+		key:          key,
+		refreshAfter: refreshAfter,
+		now:          time.Now,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.lifetime > 0 && s.refreshAfter >= s.lifetime {
+		s.refreshAfter = s.lifetime / 2
+	}
+	return s
+}
+
+// Token returns the current token, signing a new one when none was issued
+// yet or the current one is older than the refresh interval.
+func (s *Signer) Token() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if s.token != "" && now.Sub(s.issuedAt) < s.refreshAfter {
+		return s.token, nil
+	}
+
+	if err := ValidateKey(s.key); err != nil {
+		return "", err
+	}
+	claims := jwt.MapClaims{}
+	maps.Copy(claims, s.claims)
+	claims["iss"] = s.issuer
+	claims["iat"] = now.Unix()
+	if s.lifetime > 0 {
+		claims["exp"] = now.Add(s.lifetime).Unix()
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+	token.Header["kid"] = s.keyID
+
+	signed, err := token.SignedString(s.key)
+	if err != nil {
+		return "", fmt.Errorf("es256: sign token: %w", err)
+	}
+
+	s.token = signed
+	s.issuedAt = now
+	return signed, nil
+}
+
+// Refresh discards the current token, e.g. after the service rejected it
+// as expired, so that the next Token call signs a new one.
+func (s *Signer) Refresh() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token = ""
+	s.issuedAt = time.Time{}
+}
+
+// Invalidate discards token if it is still the current token and reports
+// whether it did. Concurrent requests rejected with the same token then sign
+// one replacement between them, rather than each discarding the replacement
+// another has just signed, which APNs answers with
+// TooManyProviderTokenUpdates.
+func (s *Signer) Invalidate(token string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if token == "" || token != s.token {
+		return false
+	}
+	s.token = ""
+	s.issuedAt = time.Time{}
+	return true
+}
+
+// ValidateKey reports whether key can sign ES256 tokens.
+func ValidateKey(key *ecdsa.PrivateKey) error {
+	if key == nil {
+		return fmt.Errorf("es256: private key is nil")
+	}
+	if key.Curve != elliptic.P256() {
+		return fmt.Errorf("es256: private key must use the P-256 curve, have %s", key.Curve.Params().Name)
+	}
+	return nil
+}
+
+// ParsePrivateKey parses a PEM-encoded P-256 private key, in the PKCS #8 form
+// of the .p8 files Apple issues or in SEC 1 ("EC PRIVATE KEY") form.
+func ParsePrivateKey(data []byte) (*ecdsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("es256: failed to decode PEM block")
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		ecKey, ecErr := x509.ParseECPrivateKey(block.Bytes)
+		if ecErr != nil {
+			return nil, fmt.Errorf("es256: failed to parse private key (tried PKCS8 and EC formats): %w", err)
+		}
+		parsed = ecKey
+	}
+	key, ok := parsed.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("es256: unsupported private key type %T, want an ECDSA P-256 key", parsed)
+	}
+	if err := ValidateKey(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
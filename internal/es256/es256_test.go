@@ -0,0 +1,151 @@
+package es256
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigner_Token(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := NewSigner("ABC123DEFG", "TEAM123456", key, 0)
+	s.now = func() time.Time { return now }
+
+	first, err := s.Token()
+	require.NoError(t, err)
+
+	parsed, err := jwt.Parse(first, func(*jwt.Token) (any, error) { return &key.PublicKey, nil },
+		jwt.WithValidMethods([]string{"ES256"}), jwt.WithTimeFunc(func() time.Time { return now }))
+	require.NoError(t, err)
+	assert.Equal(t, "ABC123DEFG", parsed.Header["kid"])
+	iss, _ := parsed.Claims.GetIssuer()
+	assert.Equal(t, "TEAM123456", iss)
+	iat, _ := parsed.Claims.GetIssuedAt()
+	assert.Equal(t, now, iat.UTC())
+
+	now = now.Add(DefaultRefreshAfter - time.Second)
+	again, err := s.Token()
+	require.NoError(t, err)
+	assert.Equal(t, first, again, "token is reused inside the refresh interval")
+
+	now = now.Add(time.Second)
+	renewed, err := s.Token()
+	require.NoError(t, err)
+	assert.NotEqual(t, first, renewed)
+
+	s.Refresh()
+	forced, err := s.Token()
+	require.NoError(t, err)
+	assert.NotEqual(t, renewed, forced)
+}
+
+func TestSigner_ClaimsAndExpiry(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := NewSigner("kid", "issuer", key, time.Hour,
+		WithClaims(map[string]any{"aud": "appstoreconnect-v1", "iss": "ignored"}), WithExpiry(20*time.Minute))
+	s.now = func() time.Time { return now }
+
+	first, err := s.Token()
+	require.NoError(t, err)
+	parsed, err := jwt.Parse(first, func(*jwt.Token) (any, error) { return &key.PublicKey, nil },
+		jwt.WithValidMethods([]string{"ES256"}), jwt.WithTimeFunc(func() time.Time { return now }),
+		jwt.WithAudience("appstoreconnect-v1"))
+	require.NoError(t, err)
+	iss, _ := parsed.Claims.GetIssuer()
+	assert.Equal(t, "issuer", iss)
+	exp, _ := parsed.Claims.GetExpirationTime()
+	assert.Equal(t, now.Add(20*time.Minute), exp.UTC())
+
+	now = now.Add(10 * time.Minute)
+	renewed, err := s.Token()
+	require.NoError(t, err)
+	assert.NotEqual(t, first, renewed, "refresh interval is capped at half the lifetime")
+}
+
+func TestParsePrivateKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	parsed, err := ParsePrivateKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8}))
+	require.NoError(t, err)
+	assert.True(t, key.Equal(parsed))
+
+	sec1, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	parsed, err = ParsePrivateKey(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: sec1}))
+	require.NoError(t, err)
+	assert.True(t, key.Equal(parsed))
+
+	_, err = ParsePrivateKey([]byte("not a key"))
+	assert.ErrorContains(t, err, "PEM")
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(rsaKey)
+	require.NoError(t, err)
+	_, err = ParsePrivateKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	assert.ErrorContains(t, err, "*rsa.PrivateKey")
+}
+
+func TestSigner_InvalidKey(t *testing.T) {
+	_, err := NewSigner("kid", "team", nil, 0).Token()
+	assert.ErrorContains(t, err, "private key is nil")
+
+	p384, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	_, err = NewSigner("kid", "team", p384, 0).Token()
+	assert.ErrorContains(t, err, "P-256")
+}
+
+func TestSigner_Invalidate(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	s := NewSigner("ABC123DEFG", "TEAM123456", key, 0)
+
+	first, err := s.Token()
+	require.NoError(t, err)
+	assert.False(t, s.Invalidate("stale"), "a token that is not current is ignored")
+	assert.True(t, s.Invalidate(first))
+
+	second, err := s.Token()
+	require.NoError(t, err)
+	assert.NotEqual(t, first, second)
+	assert.False(t, s.Invalidate(first), "invalidating a replaced token keeps its replacement")
+	current, err := s.Token()
+	require.NoError(t, err)
+	assert.Equal(t, second, current)
+}
+
+func TestSigner_ConcurrentUse(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	s := NewSigner("ABC123DEFG", "TEAM123456", key, 0)
+
+	var wg sync.WaitGroup
+	for i := range 20 {
+		wg.Go(func() {
+			token, err := s.Token()
+			assert.NoError(t, err)
+			if i%4 == 0 {
+				s.Invalidate(token)
+			}
+		})
+	}
+	wg.Wait()
+}
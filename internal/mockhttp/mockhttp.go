@@ -0,0 +1,27 @@
+// Package mockhttp provides httpmock responders shared by the service tests.
+package mockhttp
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+// JSONResponder returns a responder serving body as application/json.
+func JSONResponder(status int, body string) httpmock.Responder {
+	return httpmock.NewStringResponder(status, body).HeaderSet(http.Header{"Content-Type": {"application/json"}})
+}
+
+// CaptureBody wraps responder so the decoded JSON request body is stored in target.
+func CaptureBody(t *testing.T, target any, responder httpmock.Responder) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(body, target))
+		return responder(req)
+	}
+}
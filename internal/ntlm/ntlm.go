@@ -0,0 +1,202 @@
+// Package ntlm builds the client messages of the NTLM authentication
+// handshake (MS-NLMP) with NTLMv2 responses, for authenticating to NTLM
+// proxies. Only what a client needs to authenticate is implemented: there is
+// no session security (signing or sealing) and no MIC.
+package ntlm
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+var signature = []byte("NTLMSSP\x00")
+
+// Message types.
+const (
+	typeNegotiate    = 1
+	typeChallenge    = 2
+	typeAuthenticate = 3
+)
+
+// Negotiate flags.
+const (
+	flagUnicode                 = 0x00000001
+	flagRequestTarget           = 0x00000004
+	flagNTLM                    = 0x00000200
+	flagAlwaysSign              = 0x00008000
+	flagExtendedSessionSecurity = 0x00080000
+	flagTargetInfo              = 0x00800000
+	flag128                     = 0x20000000
+	flag56                      = 0x80000000
+
+	negotiateFlags = flagUnicode | flagRequestTarget | flagNTLM | flagAlwaysSign |
+		flagExtendedSessionSecurity | flagTargetInfo | flag128 | flag56
+)
+
+// avTimestamp is the AV_PAIR ID of the server's FILETIME in the target info.
+const (
+	avEOL       = 0
+	avTimestamp = 7
+)
+
+// Credentials are a Windows account's domain, user name and password.
+type Credentials struct {
+	Domain   string
+	Username string
+	Password string
+}
+
+// Negotiate returns the NEGOTIATE_MESSAGE that starts the handshake.
+func Negotiate() []byte {
+	msg := append([]byte{}, signature...)
+	msg = binary.LittleEndian.AppendUint32(msg, typeNegotiate)
+	msg = binary.LittleEndian.AppendUint32(msg, negotiateFlags)
+	// Empty domain and workstation fields.
+	return append(msg, make([]byte, 16)...)
+}
+
+// Authenticate returns the AUTHENTICATE_MESSAGE that answers challenge, the
+// server's CHALLENGE_MESSAGE, with an NTLMv2 response for creds.
+func Authenticate(challenge []byte, creds Credentials) ([]byte, error) {
+	var clientChallenge [8]byte
+	if _, err := rand.Read(clientChallenge[:]); err != nil {
+		return nil, fmt.Errorf("ntlm: %w", err)
+	}
+	return authenticate(challenge, creds, clientChallenge, time.Now())
+}
+
+func authenticate(challenge []byte, creds Credentials, clientChallenge [8]byte, now time.Time) ([]byte, error) {
+	c, err := parseChallenge(challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	// A server that sends its own timestamp expects it in the response, and
+	// no LMv2 response alongside.
+	timestamp, ok := c.timestamp()
+	lm := make([]byte, 24)
+	if !ok {
+		timestamp = fileTime(now)
+	}
+	key := ntowfv2(creds)
+	nt := ntlmv2Response(key, c.serverChallenge, clientChallenge, timestamp, c.targetInfo)
+	if !ok {
+		lm = lmv2Response(key, c.serverChallenge, clientChallenge)
+	}
+
+	flags := c.flags & negotiateFlags
+	fields := [][]byte{lm, nt, utf16le(creds.Domain), utf16le(creds.Username), nil, nil}
+	msg := append([]byte{}, signature...)
+	msg = binary.LittleEndian.AppendUint32(msg, typeAuthenticate)
+	offset := len(msg) + 8*len(fields) + 4
+	for _, f := range fields {
+		msg = binary.LittleEndian.AppendUint16(msg, uint16(len(f)))
+		msg = binary.LittleEndian.AppendUint16(msg, uint16(len(f)))
+		msg = binary.LittleEndian.AppendUint32(msg, uint32(offset))
+		offset += len(f)
+	}
+	msg = binary.LittleEndian.AppendUint32(msg, flags)
+	for _, f := range fields {
+		msg = append(msg, f...)
+	}
+	return msg, nil
+}
+
+// challengeMessage is the part of a CHALLENGE_MESSAGE the client uses.
+type challengeMessage struct {
+	flags           uint32
+	serverChallenge [8]byte
+	targetInfo      []byte
+}
+
+func parseChallenge(msg []byte) (*challengeMessage, error) {
+	if len(msg) < 32 || !bytes.Equal(msg[:8], signature) || binary.LittleEndian.Uint32(msg[8:]) != typeChallenge {
+		return nil, errors.New("ntlm: not a CHALLENGE_MESSAGE")
+	}
+	c := &challengeMessage{flags: binary.LittleEndian.Uint32(msg[20:])}
+	copy(c.serverChallenge[:], msg[24:32])
+	if c.flags&flagUnicode == 0 {
+		return nil, errors.New("ntlm: server does not support Unicode")
+	}
+	if len(msg) >= 48 {
+		n := int(binary.LittleEndian.Uint16(msg[40:]))
+		off := int(binary.LittleEndian.Uint32(msg[44:]))
+		if off+n > len(msg) {
+			return nil, errors.New("ntlm: target info is out of range")
+		}
+		c.targetInfo = msg[off : off+n]
+	}
+	return c, nil
+}
+
+// timestamp returns the MsvAvTimestamp pair of the target info, if any.
+func (c *challengeMessage) timestamp() ([8]byte, bool) {
+	var ts [8]byte
+	info := c.targetInfo
+	for len(info) >= 4 {
+		id := binary.LittleEndian.Uint16(info)
+		n := int(binary.LittleEndian.Uint16(info[2:]))
+		if id == avEOL || len(info) < 4+n {
+			break
+		}
+		if id == avTimestamp && n == 8 {
+			copy(ts[:], info[4:])
+			return ts, true
+		}
+		info = info[4+n:]
+	}
+	return ts, false
+}
+
+// ntowfv2 derives the NTLMv2 response key from creds.
+func ntowfv2(creds Credentials) []byte {
+	hash := md4(utf16le(creds.Password))
+	return hmacMD5(hash[:], utf16le(strings.ToUpper(creds.Username)+creds.Domain))
+}
+
+// ntlmv2Response computes the NtChallengeResponse: the NTProofStr followed by
+// the client blob it was computed over.
+func ntlmv2Response(key []byte, serverChallenge, clientChallenge, timestamp [8]byte, targetInfo []byte) []byte {
+	blob := []byte{1, 1, 0, 0, 0, 0, 0, 0}
+	blob = append(blob, timestamp[:]...)
+	blob = append(blob, clientChallenge[:]...)
+	blob = append(blob, 0, 0, 0, 0)
+	blob = append(blob, targetInfo...)
+	blob = append(blob, 0, 0, 0, 0)
+	proof := hmacMD5(key, append(serverChallenge[:], blob...))
+	return append(proof, blob...)
+}
+
+// lmv2Response computes the LmChallengeResponse.
+func lmv2Response(key []byte, serverChallenge, clientChallenge [8]byte) []byte {
+	return append(hmacMD5(key, append(serverChallenge[:], clientChallenge[:]...)), clientChallenge[:]...)
+}
+
+// fileTime encodes t as a Windows FILETIME: 100ns intervals since 1601.
+func fileTime(t time.Time) [8]byte {
+	var ft [8]byte
+	binary.LittleEndian.PutUint64(ft[:], uint64(t.UnixNano()/100+116444736000000000))
+	return ft
+}
+
+func utf16le(s string) []byte {
+	var b []byte
+	for _, u := range utf16.Encode([]rune(s)) {
+		b = binary.LittleEndian.AppendUint16(b, u)
+	}
+	return b
+}
+
+func hmacMD5(key, data []byte) []byte {
+	mac := hmac.New(md5.New, key)
+	mac.Write(data)
+	return mac.Sum(nil)
+}
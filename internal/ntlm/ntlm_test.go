@@ -0,0 +1,130 @@
+package ntlm
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMD4(t *testing.T) {
+	// RFC 1320, appendix A.5.
+	for input, want := range map[string]string{
+		"":    "31d6cfe0d16ae931b73c59d7e0c089c0",
+		"a":   "bde52cb31de33e46245e05fbdbd6fb24",
+		"abc": "a448017aaf21d8525fc10ae87aa6729d",
+		"12345678901234567890123456789012345678901234567890123456789012345678901234567890": "e33b4ddc9c38f2199c3e7b164fcc0536",
+	} {
+		sum := md4([]byte(input))
+		assert.Equal(t, want, hex.EncodeToString(sum[:]), input)
+	}
+}
+
+// The vectors below are from MS-NLMP section 4.2.4, "NTLMv2 Authentication".
+var (
+	specCreds           = Credentials{Domain: "Domain", Username: "User", Password: "Password"}
+	specServerChallenge = [8]byte{0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef}
+	specClientChallenge = [8]byte{0xaa, 0xaa, 0xaa, 0xaa, 0xaa, 0xaa, 0xaa, 0xaa}
+	specTargetInfo      = mustHex("02000c0044006f006d00610069006e00" + "01000c00530065007200760065007200" + "00000000")
+)
+
+func mustHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func TestNTLMv2_SpecVectors(t *testing.T) {
+	key := ntowfv2(specCreds)
+	assert.Equal(t, "0c868a403bfd7a93a3001ef22ef02e3f", hex.EncodeToString(key))
+
+	lm := lmv2Response(key, specServerChallenge, specClientChallenge)
+	assert.Equal(t, "86c35097ac9cec102554764a57cccc19aaaaaaaaaaaaaaaa", hex.EncodeToString(lm))
+
+	nt := ntlmv2Response(key, specServerChallenge, specClientChallenge, [8]byte{}, specTargetInfo)
+	assert.Equal(t, "68cd0ab851e51c96aabc927bebef6a1c", hex.EncodeToString(nt[:16]))
+}
+
+func TestNegotiate(t *testing.T) {
+	msg := Negotiate()
+	require.Len(t, msg, 32)
+	assert.Equal(t, "NTLMSSP\x00", string(msg[:8]))
+	assert.Equal(t, uint32(typeNegotiate), binary.LittleEndian.Uint32(msg[8:]))
+	assert.Equal(t, uint32(negotiateFlags), binary.LittleEndian.Uint32(msg[12:]))
+}
+
+// challengeMsg builds a CHALLENGE_MESSAGE carrying targetInfo.
+func challengeMsg(flags uint32, targetInfo []byte) []byte {
+	msg := append([]byte{}, signature...)
+	msg = binary.LittleEndian.AppendUint32(msg, typeChallenge)
+	msg = append(msg, 0, 0, 0, 0, 48, 0, 0, 0) // empty target name
+	msg = binary.LittleEndian.AppendUint32(msg, flags)
+	msg = append(msg, specServerChallenge[:]...)
+	msg = append(msg, make([]byte, 8)...)
+	msg = binary.LittleEndian.AppendUint16(msg, uint16(len(targetInfo)))
+	msg = binary.LittleEndian.AppendUint16(msg, uint16(len(targetInfo)))
+	msg = binary.LittleEndian.AppendUint32(msg, 48)
+	return append(msg, targetInfo...)
+}
+
+// field returns the payload of the security buffer at off in msg.
+func field(msg []byte, off int) []byte {
+	n := binary.LittleEndian.Uint16(msg[off:])
+	start := binary.LittleEndian.Uint32(msg[off+4:])
+	return msg[start : start+uint32(n)]
+}
+
+func TestAuthenticate(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	msg, err := authenticate(challengeMsg(negotiateFlags, specTargetInfo), specCreds, specClientChallenge, now)
+	require.NoError(t, err)
+
+	assert.Equal(t, "NTLMSSP\x00", string(msg[:8]))
+	assert.Equal(t, uint32(typeAuthenticate), binary.LittleEndian.Uint32(msg[8:]))
+	assert.Equal(t, uint32(negotiateFlags), binary.LittleEndian.Uint32(msg[60:]))
+
+	key := ntowfv2(specCreds)
+	ts := fileTime(now)
+	assert.Equal(t, lmv2Response(key, specServerChallenge, specClientChallenge), field(msg, 12))
+	assert.Equal(t, ntlmv2Response(key, specServerChallenge, specClientChallenge, ts, specTargetInfo), field(msg, 20))
+	assert.Equal(t, utf16le("Domain"), field(msg, 28))
+	assert.Equal(t, utf16le("User"), field(msg, 36))
+	assert.Empty(t, field(msg, 44))
+	assert.Empty(t, field(msg, 52))
+}
+
+func TestAuthenticate_ServerTimestamp(t *testing.T) {
+	ts := [8]byte{1, 2, 3, 4, 5, 6, 7, 8}
+	info := append(mustHex("07000800"), ts[:]...)
+	info = append(info, specTargetInfo...)
+
+	msg, err := authenticate(challengeMsg(negotiateFlags, info), specCreds, specClientChallenge, time.Now())
+	require.NoError(t, err)
+
+	assert.Equal(t, make([]byte, 24), field(msg, 12))
+	nt := field(msg, 20)
+	assert.Equal(t, ts[:], nt[24:32])
+}
+
+func TestAuthenticate_Errors(t *testing.T) {
+	_, err := authenticate([]byte("NTLMSSP\x00short"), specCreds, specClientChallenge, time.Now())
+	assert.ErrorContains(t, err, "not a CHALLENGE_MESSAGE")
+
+	_, err = authenticate(challengeMsg(flagNTLM, nil), specCreds, specClientChallenge, time.Now())
+	assert.ErrorContains(t, err, "Unicode")
+
+	bad := challengeMsg(negotiateFlags, specTargetInfo)
+	binary.LittleEndian.PutUint16(bad[40:], 200)
+	_, err = authenticate(bad, specCreds, specClientChallenge, time.Now())
+	assert.ErrorContains(t, err, "out of range")
+}
+
+func TestFileTime(t *testing.T) {
+	ft := fileTime(time.Unix(0, 0))
+	assert.Equal(t, uint64(116444736000000000), binary.LittleEndian.Uint64(ft[:]))
+}
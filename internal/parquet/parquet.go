@@ -0,0 +1,231 @@
+// Package parquet writes Apache Parquet files of nullable UTF-8 string
+// columns: enough for tabular exports that analytics tools load directly,
+// without a Parquet dependency. Values are PLAIN encoded and uncompressed, and
+// each row group holds a single data page per column.
+//
+// Row groups are written as they are added and the footer when the writer is
+// closed, so a file can be streamed. A file written to disk can also be
+// resumed after an interruption from the offset and row groups recorded after
+// the last complete row group.
+package parquet
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// magic starts and ends every Parquet file.
+const magic = "PAR1"
+
+// Parquet enum values used by the writer.
+const (
+	typeByteArray      = 6
+	repetitionOptional = 1
+	convertedUTF8      = 0
+	encodingPlain      = 0
+	encodingRLE        = 3
+	codecUncompressed  = 0
+	pageTypeData       = 0
+)
+
+// RowGroup records a row group already written, for the footer. It is
+// JSON-encodable so that it can be kept in a checkpoint.
+type RowGroup struct {
+	NumRows int64         `json:"numRows"`
+	Columns []ColumnChunk `json:"columns"`
+}
+
+// ColumnChunk locates one column of a row group in the file.
+type ColumnChunk struct {
+	Offset int64 `json:"offset"`
+	Size   int64 `json:"size"`
+}
+
+// Writer writes a Parquet file whose columns are all optional UTF-8 strings.
+type Writer struct {
+	w       io.Writer
+	columns []string
+	offset  int64
+	groups  []RowGroup
+}
+
+// NewWriter returns a writer of the named columns to w.
+func NewWriter(w io.Writer, columns []string) *Writer {
+	return &Writer{w: w, columns: columns}
+}
+
+// WriteHeader writes the magic number that starts the file. Call it once,
+// before the first row group, unless resuming.
+func (w *Writer) WriteHeader() error {
+	return w.write([]byte(magic))
+}
+
+// Resume continues a file whose first offset bytes hold the header and
+// groups, as returned by Offset and RowGroups after the last complete row
+// group. w must be positioned at offset.
+func (w *Writer) Resume(offset int64, groups []RowGroup) {
+	w.offset = offset
+	w.groups = groups
+}
+
+// Offset returns the number of bytes written to the file so far.
+func (w *Writer) Offset() int64 { return w.offset }
+
+// RowGroups returns the row groups written so far.
+func (w *Writer) RowGroups() []RowGroup { return w.groups }
+
+// WriteRowGroup writes rows as one row group. Each row has one value per
+// column; a nil value is null. An empty rows writes nothing.
+func (w *Writer) WriteRowGroup(rows [][]*string) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	group := RowGroup{NumRows: int64(len(rows))}
+	for col := range w.columns {
+		values := make([]*string, len(rows))
+		for i, row := range rows {
+			if len(row) != len(w.columns) {
+				return fmt.Errorf("parquet: row %d has %d values, want %d", i, len(row), len(w.columns))
+			}
+			values[i] = row[col]
+		}
+		start := w.offset
+		if err := w.writePage(values); err != nil {
+			return err
+		}
+		group.Columns = append(group.Columns, ColumnChunk{Offset: start, Size: w.offset - start})
+	}
+	w.groups = append(w.groups, group)
+	return nil
+}
+
+// Close writes the footer. It does not close the underlying writer.
+func (w *Writer) Close() error {
+	footer := w.fileMetaData()
+	var length [4]byte
+	binary.LittleEndian.PutUint32(length[:], uint32(len(footer)))
+	footer = append(footer, length[:]...)
+	return w.write(append(footer, magic...))
+}
+
+func (w *Writer) write(b []byte) error {
+	n, err := w.w.Write(b)
+	w.offset += int64(n)
+	if err != nil {
+		return fmt.Errorf("parquet: %w", err)
+	}
+	return nil
+}
+
+// writePage writes values as a data page: the definition levels, with a
+// length prefix, then the PLAIN encoded non-null values.
+func (w *Writer) writePage(values []*string) error {
+	levels := encodeLevels(values)
+	page := binary.LittleEndian.AppendUint32(nil, uint32(len(levels)))
+	page = append(page, levels...)
+	for _, v := range values {
+		if v != nil {
+			page = binary.LittleEndian.AppendUint32(page, uint32(len(*v)))
+			page = append(page, *v...)
+		}
+	}
+
+	var h thriftWriter
+	h.i32(1, pageTypeData)
+	h.i32(2, int32(len(page)))
+	h.i32(3, int32(len(page)))
+	h.beginStruct(5)
+	h.i32(1, int32(len(values)))
+	h.i32(2, encodingPlain)
+	h.i32(3, encodingRLE)
+	h.i32(4, encodingRLE)
+	h.endStruct()
+	h.stop()
+
+	if err := w.write(h.buf); err != nil {
+		return err
+	}
+	return w.write(page)
+}
+
+// encodeLevels encodes the definition levels of values, 1 for a value and 0
+// for null, as runs of the RLE/bit-packing hybrid with a bit width of 1.
+func encodeLevels(values []*string) []byte {
+	var out []byte
+	for i := 0; i < len(values); {
+		level := values[i] != nil
+		run := 1
+		for i+run < len(values) && (values[i+run] != nil) == level {
+			run++
+		}
+		out = binary.AppendUvarint(out, uint64(run)<<1)
+		if level {
+			out = append(out, 1)
+		} else {
+			out = append(out, 0)
+		}
+		i += run
+	}
+	return out
+}
+
+// fileMetaData encodes the footer's FileMetaData struct.
+func (w *Writer) fileMetaData() []byte {
+	var numRows int64
+	for _, g := range w.groups {
+		numRows += g.NumRows
+	}
+
+	var t thriftWriter
+	t.i32(1, 1)
+	t.beginList(2, thriftStruct, len(w.columns)+1)
+	t.beginElem()
+	t.binary(4, "schema")
+	t.i32(5, int32(len(w.columns)))
+	t.endStruct()
+	for _, name := range w.columns {
+		t.beginElem()
+		t.i32(1, typeByteArray)
+		t.i32(3, repetitionOptional)
+		t.binary(4, name)
+		t.i32(6, convertedUTF8)
+		t.beginStruct(10) // LogicalType union: STRING
+		t.beginStruct(1)
+		t.endStruct()
+		t.endStruct()
+		t.endStruct()
+	}
+	t.i64(3, numRows)
+	t.beginList(4, thriftStruct, len(w.groups))
+	for _, g := range w.groups {
+		var total int64
+		t.beginElem()
+		t.beginList(1, thriftStruct, len(g.Columns))
+		for i, c := range g.Columns {
+			total += c.Size
+			t.beginElem()
+			t.i64(2, c.Offset)
+			t.beginStruct(3)
+			t.i32(1, typeByteArray)
+			t.beginList(2, thriftI32, 2)
+			t.appendVarint(encodingPlain)
+			t.appendVarint(encodingRLE)
+			t.beginList(3, thriftBinary, 1)
+			t.appendString(w.columns[i])
+			t.i32(4, codecUncompressed)
+			t.i64(5, g.NumRows)
+			t.i64(6, c.Size)
+			t.i64(7, c.Size)
+			t.i64(9, c.Offset)
+			t.endStruct()
+			t.endStruct()
+		}
+		t.i64(2, total)
+		t.i64(3, g.NumRows)
+		t.endStruct()
+	}
+	t.binary(6, "go-api-sdk-apple")
+	t.stop()
+	return t.buf
+}
@@ -0,0 +1,215 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func str(s string) *string { return &s }
+
+// thriftReader decodes the compact protocol into maps of field ID to value,
+// independently of thriftWriter.
+type thriftReader struct {
+	t   *testing.T
+	buf []byte
+}
+
+func (r *thriftReader) byte() byte {
+	b := r.buf[0]
+	r.buf = r.buf[1:]
+	return b
+}
+
+func (r *thriftReader) varint() int64 {
+	v, n := binary.Varint(r.buf)
+	require.Positive(r.t, n)
+	r.buf = r.buf[n:]
+	return v
+}
+
+func (r *thriftReader) uvarint() uint64 {
+	v, n := binary.Uvarint(r.buf)
+	require.Positive(r.t, n)
+	r.buf = r.buf[n:]
+	return v
+}
+
+func (r *thriftReader) value(typ byte) any {
+	switch typ {
+	case thriftI32, thriftI64:
+		return r.varint()
+	case thriftBinary:
+		n := r.uvarint()
+		s := string(r.buf[:n])
+		r.buf = r.buf[n:]
+		return s
+	case thriftList:
+		h := r.byte()
+		n := uint64(h >> 4)
+		if n == 15 {
+			n = r.uvarint()
+		}
+		list := make([]any, n)
+		for i := range list {
+			list[i] = r.value(h & 0x0f)
+		}
+		return list
+	case thriftStruct:
+		return r.structure()
+	}
+	r.t.Fatalf("unexpected thrift type %d", typ)
+	return nil
+}
+
+func (r *thriftReader) structure() map[int16]any {
+	fields := map[int16]any{}
+	var id int16
+	for {
+		h := r.byte()
+		if h == 0 {
+			return fields
+		}
+		if delta := int16(h >> 4); delta != 0 {
+			id += delta
+		} else {
+			id = int16(r.varint())
+		}
+		fields[id] = r.value(h & 0x0f)
+	}
+}
+
+// readFile checks the file's framing and returns its FileMetaData.
+func readFile(t *testing.T, data []byte) map[int16]any {
+	t.Helper()
+	require.Greater(t, len(data), 12)
+	assert.Equal(t, magic, string(data[:4]))
+	assert.Equal(t, magic, string(data[len(data)-4:]))
+	n := int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	r := &thriftReader{t: t, buf: data[len(data)-8-n : len(data)-8]}
+	meta := r.structure()
+	assert.Empty(t, r.buf)
+	return meta
+}
+
+// readColumn decodes the values of a column chunk, with nil for null.
+func readColumn(t *testing.T, data []byte, chunk map[int16]any) []*string {
+	t.Helper()
+	meta := chunk[3].(map[int16]any)
+	offset := meta[9].(int64)
+	r := &thriftReader{t: t, buf: data[offset:]}
+	header := r.structure()
+	require.Equal(t, int64(pageTypeData), header[1])
+	page := r.buf[:header[3].(int64)]
+	numValues := header[5].(map[int16]any)[1].(int64)
+	assert.Equal(t, meta[5], numValues)
+	assert.Equal(t, meta[7], int64(len(data[offset:]))-int64(len(r.buf))+int64(len(page)))
+
+	levelsLen := binary.LittleEndian.Uint32(page)
+	levels := &thriftReader{t: t, buf: page[4 : 4+levelsLen]}
+	values := page[4+levelsLen:]
+	var out []*string
+	for len(levels.buf) > 0 {
+		h := levels.uvarint()
+		require.Zero(t, h&1, "bit-packed runs are not written")
+		defined := levels.byte() == 1
+		for range h >> 1 {
+			if !defined {
+				out = append(out, nil)
+				continue
+			}
+			n := binary.LittleEndian.Uint32(values)
+			out = append(out, str(string(values[4:4+n])))
+			values = values[4+n:]
+		}
+	}
+	assert.Empty(t, values)
+	return out
+}
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf, []string{"serialNumber", "color"})
+	require.NoError(t, w.WriteHeader())
+	require.NoError(t, w.WriteRowGroup([][]*string{
+		{str("C02ABC"), str("silver")},
+		{str("C02DEF"), nil},
+		{str(""), nil},
+	}))
+	require.NoError(t, w.WriteRowGroup([][]*string{{str("C02GHI"), str("späce grey")}}))
+	require.NoError(t, w.WriteRowGroup(nil))
+	require.NoError(t, w.Close())
+	assert.Equal(t, int64(buf.Len()), w.Offset())
+
+	data := buf.Bytes()
+	meta := readFile(t, data)
+	assert.Equal(t, int64(1), meta[1])
+	assert.Equal(t, int64(4), meta[3])
+	assert.Equal(t, "go-api-sdk-apple", meta[6])
+
+	schema := meta[2].([]any)
+	require.Len(t, schema, 3)
+	assert.Equal(t, int64(2), schema[0].(map[int16]any)[5])
+	for i, name := range []string{"serialNumber", "color"} {
+		col := schema[i+1].(map[int16]any)
+		assert.Equal(t, name, col[4])
+		assert.Equal(t, int64(typeByteArray), col[1])
+		assert.Equal(t, int64(repetitionOptional), col[3])
+		assert.Equal(t, int64(convertedUTF8), col[6])
+		assert.Equal(t, map[int16]any{1: map[int16]any{}}, col[10])
+	}
+
+	groups := meta[4].([]any)
+	require.Len(t, groups, 2)
+	first := groups[0].(map[int16]any)
+	assert.Equal(t, int64(3), first[3])
+	chunks := first[1].([]any)
+	assert.Equal(t, []*string{str("C02ABC"), str("C02DEF"), str("")}, readColumn(t, data, chunks[0].(map[int16]any)))
+	assert.Equal(t, []*string{str("silver"), nil, nil}, readColumn(t, data, chunks[1].(map[int16]any)))
+	assert.Equal(t, []any{"color"}, chunks[1].(map[int16]any)[3].(map[int16]any)[3])
+
+	second := groups[1].(map[int16]any)
+	assert.Equal(t, []*string{str("späce grey")}, readColumn(t, data, second[1].([]any)[1].(map[int16]any)))
+}
+
+func TestWriter_Resume(t *testing.T) {
+	columns := []string{"id"}
+	var want bytes.Buffer
+	w := NewWriter(&want, columns)
+	require.NoError(t, w.WriteHeader())
+	require.NoError(t, w.WriteRowGroup([][]*string{{str("a")}}))
+	require.NoError(t, w.WriteRowGroup([][]*string{{str("b")}}))
+	require.NoError(t, w.Close())
+
+	var got bytes.Buffer
+	w = NewWriter(&got, columns)
+	require.NoError(t, w.WriteHeader())
+	require.NoError(t, w.WriteRowGroup([][]*string{{str("a")}}))
+	offset, groups := w.Offset(), w.RowGroups()
+	require.NoError(t, w.WriteRowGroup([][]*string{{str("lost")}}))
+
+	got.Truncate(int(offset))
+	w = NewWriter(&got, columns)
+	w.Resume(offset, groups)
+	require.NoError(t, w.WriteRowGroup([][]*string{{str("b")}}))
+	require.NoError(t, w.Close())
+	assert.Equal(t, want.Bytes(), got.Bytes())
+}
+
+func TestWriter_RowLength(t *testing.T) {
+	w := NewWriter(&bytes.Buffer{}, []string{"a", "b"})
+	assert.ErrorContains(t, w.WriteRowGroup([][]*string{{str("x")}}), "row 0 has 1 values, want 2")
+}
+
+func TestWriter_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf, []string{"a"})
+	require.NoError(t, w.WriteHeader())
+	require.NoError(t, w.Close())
+	meta := readFile(t, buf.Bytes())
+	assert.Equal(t, int64(0), meta[3])
+	assert.Equal(t, []any{}, meta[4])
+}
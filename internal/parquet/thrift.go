@@ -0,0 +1,95 @@
+package parquet
+
+import "encoding/binary"
+
+// Thrift compact protocol type IDs.
+const (
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+// thriftWriter encodes structs with the Thrift compact protocol, which
+// Parquet uses for page headers and the footer. Fields must be written in
+// increasing ID order within each struct.
+type thriftWriter struct {
+	buf []byte
+	// last holds the ID of the last field written in each open struct.
+	last []int16
+}
+
+func (t *thriftWriter) field(id int16, typ byte) {
+	if len(t.last) == 0 {
+		t.last = []int16{0}
+	}
+	top := &t.last[len(t.last)-1]
+	if delta := id - *top; delta > 0 && delta <= 15 {
+		t.buf = append(t.buf, byte(delta)<<4|typ)
+	} else {
+		t.buf = append(t.buf, typ)
+		t.buf = binary.AppendVarint(t.buf, int64(id))
+	}
+	*top = id
+}
+
+func (t *thriftWriter) i32(id int16, v int32) {
+	t.field(id, thriftI32)
+	t.appendVarint(int64(v))
+}
+
+func (t *thriftWriter) i64(id int16, v int64) {
+	t.field(id, thriftI64)
+	t.appendVarint(v)
+}
+
+func (t *thriftWriter) binary(id int16, s string) {
+	t.field(id, thriftBinary)
+	t.appendString(s)
+}
+
+// beginStruct opens a struct-valued field; close it with endStruct.
+func (t *thriftWriter) beginStruct(id int16) {
+	t.field(id, thriftStruct)
+	t.last = append(t.last, 0)
+}
+
+// beginList writes the header of a list field of n elements of typ. Struct
+// elements are written with beginElem and endStruct, others with
+// appendVarint or appendString.
+func (t *thriftWriter) beginList(id int16, typ byte, n int) {
+	t.field(id, thriftList)
+	if n < 15 {
+		t.buf = append(t.buf, byte(n)<<4|typ)
+	} else {
+		t.buf = append(t.buf, 0xf0|typ)
+		t.buf = binary.AppendUvarint(t.buf, uint64(n))
+	}
+}
+
+// beginElem opens a struct element of a list.
+func (t *thriftWriter) beginElem() {
+	t.last = append(t.last, 0)
+}
+
+// endStruct closes the innermost open struct.
+func (t *thriftWriter) endStruct() {
+	t.stop()
+	t.last = t.last[:len(t.last)-1]
+}
+
+// stop ends the top-level struct.
+func (t *thriftWriter) stop() {
+	t.buf = append(t.buf, 0)
+}
+
+// appendVarint writes a zigzag varint, as compact protocol integers are.
+func (t *thriftWriter) appendVarint(v int64) {
+	t.buf = binary.AppendVarint(t.buf, v)
+}
+
+func (t *thriftWriter) appendString(s string) {
+	t.buf = binary.AppendUvarint(t.buf, uint64(len(s)))
+	t.buf = append(t.buf, s...)
+}
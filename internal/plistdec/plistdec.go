@@ -0,0 +1,316 @@
+// Package plistdec is the XML property-list decoder counterpart to
+// plistenc. It decodes device-sent plists (check-in messages, command
+// results) into generated payload structs using the same
+// `plist:"Name,omitempty"` tags the encoder emits.
+//
+// Decoding rules: dict keys match struct fields by tag name (falling back
+// to the field name); unknown keys are ignored; optional pointer fields are
+// allocated only when their key is present; any and map[string]any
+// targets receive generic values (map[string]any, []any, string, int64,
+// float64, bool, time.Time, []byte).
+package plistdec
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// Unmarshal decodes the plist document in data into v, which must be a
+// non-nil pointer.
+func Unmarshal(data []byte, v any) error {
+	value, err := Parse(data)
+	if err != nil {
+		return err
+	}
+	return Assign(value, v)
+}
+
+// Parse decodes the plist document in data into generic values.
+func Parse(data []byte) (any, error) {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return nil, fmt.Errorf("plistdec: document contains no value")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("plistdec: %w", err)
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			if start.Name.Local == "plist" {
+				continue
+			}
+			return parseValue(d, start)
+		}
+	}
+}
+
+// Assign stores a generic value produced by Parse into v, which must be a
+// non-nil pointer.
+func Assign(value any, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("plistdec: target must be a non-nil pointer, have %T", v)
+	}
+	return assign(rv.Elem(), value, "")
+}
+
+func parseValue(d *xml.Decoder, start xml.StartElement) (any, error) {
+	switch start.Name.Local {
+	case "dict":
+		return parseDict(d)
+	case "array":
+		return parseArray(d)
+	case "true", "false":
+		if err := d.Skip(); err != nil {
+			return nil, fmt.Errorf("plistdec: %w", err)
+		}
+		return start.Name.Local == "true", nil
+	}
+
+	var text string
+	if err := d.DecodeElement(&text, &start); err != nil {
+		return nil, fmt.Errorf("plistdec: <%s>: %w", start.Name.Local, err)
+	}
+	switch start.Name.Local {
+	case "string":
+		return text, nil
+	case "integer":
+		n, err := strconv.ParseInt(strings.TrimSpace(text), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("plistdec: invalid integer %q", text)
+		}
+		return n, nil
+	case "real":
+		f, err := strconv.ParseFloat(strings.TrimSpace(text), 64)
+		if err != nil {
+			return nil, fmt.Errorf("plistdec: invalid real %q", text)
+		}
+		return f, nil
+	case "date":
+		t, err := time.Parse(time.RFC3339, strings.TrimSpace(text))
+		if err != nil {
+			return nil, fmt.Errorf("plistdec: invalid date %q", text)
+		}
+		return t, nil
+	case "data":
+		b, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(text), ""))
+		if err != nil {
+			return nil, fmt.Errorf("plistdec: invalid data: %w", err)
+		}
+		return b, nil
+	}
+	return nil, fmt.Errorf("plistdec: unsupported element <%s>", start.Name.Local)
+}
+
+func parseDict(d *xml.Decoder) (map[string]any, error) {
+	dict := map[string]any{}
+	var key string
+	haveKey := false
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return nil, fmt.Errorf("plistdec: dict: %w", err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if t.Name.Local == "key" {
+				if err := d.DecodeElement(&key, &t); err != nil {
+					return nil, fmt.Errorf("plistdec: key: %w", err)
+				}
+				haveKey = true
+				continue
+			}
+			if !haveKey {
+				return nil, fmt.Errorf("plistdec: dict value <%s> has no key", t.Name.Local)
+			}
+			v, err := parseValue(d, t)
+			if err != nil {
+				return nil, err
+			}
+			dict[key] = v
+			haveKey = false
+		case xml.EndElement:
+			return dict, nil
+		}
+	}
+}
+
+func parseArray(d *xml.Decoder) ([]any, error) {
+	arr := []any{}
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return nil, fmt.Errorf("plistdec: array: %w", err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			v, err := parseValue(d, t)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, v)
+		case xml.EndElement:
+			return arr, nil
+		}
+	}
+}
+
+// assign stores value into rv. path names the destination for errors.
+func assign(rv reflect.Value, value any, path string) error {
+	if value == nil {
+		return nil
+	}
+
+	if rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			rv.Set(reflect.New(rv.Type().Elem()))
+		}
+		return assign(rv.Elem(), value, path)
+	}
+	if rv.Kind() == reflect.Interface && rv.NumMethod() == 0 {
+		rv.Set(reflect.ValueOf(value))
+		return nil
+	}
+	if rv.Type() == timeType {
+		t, ok := value.(time.Time)
+		if !ok {
+			return mismatch(path, "date", value)
+		}
+		rv.Set(reflect.ValueOf(t))
+		return nil
+	}
+
+	switch rv.Kind() {
+	case reflect.String:
+		s, ok := value.(string)
+		if !ok {
+			return mismatch(path, "string", value)
+		}
+		rv.SetString(s)
+	case reflect.Bool:
+		b, ok := value.(bool)
+		if !ok {
+			return mismatch(path, "boolean", value)
+		}
+		rv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, ok := value.(int64)
+		if !ok {
+			return mismatch(path, "integer", value)
+		}
+		rv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, ok := value.(int64)
+		if !ok || n < 0 {
+			return mismatch(path, "unsigned integer", value)
+		}
+		rv.SetUint(uint64(n))
+	case reflect.Float32, reflect.Float64:
+		switch n := value.(type) {
+		case float64:
+			rv.SetFloat(n)
+		case int64:
+			rv.SetFloat(float64(n))
+		default:
+			return mismatch(path, "real", value)
+		}
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			b, ok := value.([]byte)
+			if !ok {
+				return mismatch(path, "data", value)
+			}
+			rv.SetBytes(b)
+			return nil
+		}
+		arr, ok := value.([]any)
+		if !ok {
+			return mismatch(path, "array", value)
+		}
+		out := reflect.MakeSlice(rv.Type(), len(arr), len(arr))
+		for i, item := range arr {
+			if err := assign(out.Index(i), item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+		rv.Set(out)
+	case reflect.Map:
+		dict, ok := value.(map[string]any)
+		if !ok || rv.Type().Key().Kind() != reflect.String {
+			return mismatch(path, "dict", value)
+		}
+		out := reflect.MakeMapWithSize(rv.Type(), len(dict))
+		for k, item := range dict {
+			elem := reflect.New(rv.Type().Elem()).Elem()
+			if err := assign(elem, item, join(path, k)); err != nil {
+				return err
+			}
+			out.SetMapIndex(reflect.ValueOf(k).Convert(rv.Type().Key()), elem)
+		}
+		rv.Set(out)
+	case reflect.Struct:
+		dict, ok := value.(map[string]any)
+		if !ok {
+			return mismatch(path, "dict", value)
+		}
+		return assignStruct(rv, dict, path)
+	default:
+		return fmt.Errorf("plistdec: %s: unsupported target type %s", displayPath(path), rv.Type())
+	}
+	return nil
+}
+
+func assignStruct(rv reflect.Value, dict map[string]any, path string) error {
+	t := rv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name := f.Name
+		if tag := f.Tag.Get("plist"); tag != "" {
+			if tag == "-" {
+				continue
+			}
+			if n, _, _ := strings.Cut(tag, ","); n != "" {
+				name = n
+			}
+		}
+		value, ok := dict[name]
+		if !ok {
+			continue
+		}
+		if err := assign(rv.Field(i), value, join(path, name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func mismatch(path, want string, got any) error {
+	return fmt.Errorf("plistdec: %s: expected %s, have %T", displayPath(path), want, got)
+}
+
+func join(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func displayPath(path string) string {
+	if path == "" {
+		return "value"
+	}
+	return path
+}
@@ -0,0 +1,121 @@
+package plistdec
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/plistenc"
+)
+
+type mode string
+
+type nested struct {
+	Label string `plist:"Label"`
+}
+
+type demo struct {
+	Name    string         `plist:"Name"`
+	Count   int64          `plist:"Count"`
+	Ratio   float64        `plist:"Ratio"`
+	Enabled bool           `plist:"Enabled"`
+	Blob    []byte         `plist:"Blob,omitempty"`
+	When    time.Time      `plist:"When"`
+	Note    *string        `plist:"Note,omitempty"`
+	Missing *int64         `plist:"Missing,omitempty"`
+	Tags    []string       `plist:"Tags,omitempty"`
+	Mode    mode           `plist:"Mode"`
+	Child   *nested        `plist:"Child,omitempty"`
+	Items   []nested       `plist:"Items,omitempty"`
+	Extra   map[string]any `plist:"Extra,omitempty"`
+	Any     any            `plist:"Any,omitempty"`
+	Ignored string         `plist:"-"`
+}
+
+func TestRoundTrip(t *testing.T) {
+	note := "hi & <bye>"
+	in := demo{
+		Name:    "x",
+		Count:   3,
+		Ratio:   1.5,
+		Enabled: true,
+		Blob:    []byte{0x01, 0x02},
+		When:    time.Date(2026, 7, 17, 12, 0, 0, 0, time.UTC),
+		Note:    &note,
+		Tags:    []string{"a", "b"},
+		Mode:    "fast",
+		Child:   &nested{Label: "c"},
+		Items:   []nested{{Label: "i1"}, {Label: "i2"}},
+		Extra:   map[string]any{"k": int64(7)},
+		Any:     []any{"v", true},
+		Ignored: "nope",
+	}
+	fields, err := plistenc.Fields(&in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, err := plistenc.Document(fields)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out demo
+	if err := Unmarshal(doc, &out); err != nil {
+		t.Fatal(err)
+	}
+
+	if out.Name != "x" || out.Count != 3 || out.Ratio != 1.5 || !out.Enabled {
+		t.Errorf("scalars = %+v", out)
+	}
+	if string(out.Blob) != "\x01\x02" || !out.When.Equal(in.When) {
+		t.Errorf("blob/date = %v %v", out.Blob, out.When)
+	}
+	if out.Note == nil || *out.Note != note {
+		t.Errorf("Note = %v", out.Note)
+	}
+	if out.Missing != nil {
+		t.Error("absent optional key allocated a pointer")
+	}
+	if strings.Join(out.Tags, ",") != "a,b" || out.Mode != "fast" {
+		t.Errorf("Tags/Mode = %v %v", out.Tags, out.Mode)
+	}
+	if out.Child == nil || out.Child.Label != "c" || len(out.Items) != 2 || out.Items[1].Label != "i2" {
+		t.Errorf("nested = %+v %+v", out.Child, out.Items)
+	}
+	if out.Extra["k"] != int64(7) {
+		t.Errorf("Extra = %v", out.Extra)
+	}
+	if arr, ok := out.Any.([]any); !ok || len(arr) != 2 || arr[1] != true {
+		t.Errorf("Any = %#v", out.Any)
+	}
+	if out.Ignored != "" {
+		t.Error("plist:\"-\" field was decoded")
+	}
+}
+
+func TestTypeMismatch(t *testing.T) {
+	doc := []byte(`<plist><dict><key>Child</key><dict><key>Label</key><integer>1</integer></dict></dict></plist>`)
+	var out demo
+	err := Unmarshal(doc, &out)
+	if err == nil || !strings.Contains(err.Error(), "Child.Label: expected string") {
+		t.Fatalf("err = %v", err)
+	}
+}
+
+func TestInvalidDocuments(t *testing.T) {
+	for name, doc := range map[string]string{
+		"empty":   ``,
+		"integer": `<plist><integer>x</integer></plist>`,
+		"orphan":  `<plist><dict><string>v</string></dict></plist>`,
+		"element": `<plist><set/></plist>`,
+	} {
+		if _, err := Parse([]byte(doc)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+
+	var out demo
+	if err := Unmarshal([]byte(`<plist><dict/></plist>`), out); err == nil {
+		t.Error("non-pointer target accepted")
+	}
+}
@@ -1,5 +1,5 @@
-// Package plistenc is a deterministic XML property-list encoder for the
-// device-management SDK. It exists (instead of a third-party plist
+// Package plistenc is a deterministic XML property-list encoder shared by
+// the device-management SDK and the Microsoft Updates exporters. It exists (instead of a third-party plist
 // library) for two reasons: envelope building needs to merge common keys
 // (RequestType, PayloadType, …) with generated payload fields into a
 // single dict with stable ordering, and generated output must be
@@ -0,0 +1,36 @@
+// Package version orders Apple OS and firmware version strings, such as
+// "15.3.1" or the "15.3.1 (a)" of a rapid security response, so that every
+// update catalog in the SDK sorts releases the same way.
+package version
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Compare compares dotted version strings numerically, returning -1, 0 or
+// +1. Missing components count as zero, so "15.3" equals "15.3.0". A suffix
+// after the dotted version, such as the "(a)" of a rapid security response,
+// orders after the bare version and before the next one.
+func Compare(a, b string) int {
+	aNum, aSuffix, _ := strings.Cut(a, " ")
+	bNum, bSuffix, _ := strings.Cut(b, " ")
+
+	as, bs := strings.Split(aNum, "."), strings.Split(bNum, ".")
+	for i := range max(len(as), len(bs)) {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return strings.Compare(aSuffix, bSuffix)
+}
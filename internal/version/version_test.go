@@ -0,0 +1,17 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompare(t *testing.T) {
+	assert.Equal(t, 0, Compare("15.3", "15.3.0"))
+	assert.Equal(t, -1, Compare("15.2", "15.10"))
+	assert.Equal(t, 1, Compare("15.10", "15.9"))
+	assert.Equal(t, -1, Compare("14.7.3", "15"))
+	assert.Equal(t, 1, Compare("15.3 (a)", "15.3"))
+	assert.Equal(t, -1, Compare("15.3 (a)", "15.3.1"))
+	assert.Equal(t, -1, Compare("15.3 (a)", "15.3 (b)"))
+}
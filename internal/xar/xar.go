@@ -0,0 +1,394 @@
+// Package xar reads the table of contents and signature of a xar archive,
+// the container format of macOS installer packages (.pkg).
+//
+// A xar archive is a binary header, a zlib-compressed XML table of contents
+// (TOC) and a heap. The heap starts with a checksum of the compressed TOC,
+// followed by an RSA signature of that checksum when the archive is signed;
+// the signing certificates are listed in the TOC. Each file's data in the
+// heap carries its own checksum in the TOC, so a valid signature together
+// with matching file checksums authenticates the whole archive.
+//
+// Only the RSA signature is checked. The CMS signature (x-signature) that
+// productsign adds alongside it, which may carry a signing timestamp, is
+// ignored.
+package xar
+
+import (
+	"bytes"
+	"compress/bzip2"
+	"compress/zlib"
+	"crypto"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"slices"
+	"strings"
+
+	// Register the hashes checksumHash can return.
+	_ "crypto/sha1"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+)
+
+const (
+	magic      = "xar!"
+	headerSize = 28
+
+	// maxTOCSize bounds the uncompressed TOC; installer TOCs are a few
+	// megabytes at most.
+	maxTOCSize = 64 << 20
+)
+
+// Checksum algorithm codes in the header.
+const (
+	checksumNone  = 0
+	checksumSHA1  = 1
+	checksumMD5   = 2
+	checksumOther = 3
+)
+
+// ErrUnsigned is returned by VerifySignature when the archive has no RSA
+// signature.
+var ErrUnsigned = errors.New("xar: archive is not signed")
+
+// Archive is an opened xar archive.
+type Archive struct {
+	r        io.ReaderAt
+	heap     int64
+	hash     crypto.Hash
+	rawTOC   []byte
+	toc      toc
+	certs    []*x509.Certificate
+	certsErr error
+}
+
+// File is an entry in the table of contents. Directories have no data.
+type File struct {
+	// Path is the slash-separated path of the entry within the archive.
+	Path string
+
+	// Type is "file", "directory" or "symlink".
+	Type string
+
+	// Offset and Length locate the archived data within the heap.
+	Offset, Length int64
+
+	// Size is the length of the data once extracted.
+	Size int64
+
+	// Encoding is the MIME type the data is archived with, such as
+	// "application/x-gzip" for zlib-compressed data.
+	Encoding string
+
+	checksumStyle string
+	checksum      string
+	hasData       bool
+}
+
+type toc struct {
+	Checksum  tocChecksum   `xml:"toc>checksum"`
+	Signature *tocSignature `xml:"toc>signature"`
+	Files     []tocFile     `xml:"toc>file"`
+}
+
+type tocChecksum struct {
+	Style  string `xml:"style,attr"`
+	Offset int64  `xml:"offset"`
+	Size   int64  `xml:"size"`
+}
+
+type tocSignature struct {
+	Style        string   `xml:"style,attr"`
+	Offset       int64    `xml:"offset"`
+	Size         int64    `xml:"size"`
+	Certificates []string `xml:"KeyInfo>X509Data>X509Certificate"`
+}
+
+type tocFile struct {
+	Name  string    `xml:"name"`
+	Type  string    `xml:"type"`
+	Data  *tocData  `xml:"data"`
+	Files []tocFile `xml:"file"`
+}
+
+type tocData struct {
+	Offset   int64 `xml:"offset"`
+	Length   int64 `xml:"length"`
+	Size     int64 `xml:"size"`
+	Encoding struct {
+		Style string `xml:"style,attr"`
+	} `xml:"encoding"`
+	ArchivedChecksum struct {
+		Style string `xml:"style,attr"`
+		Value string `xml:",chardata"`
+	} `xml:"archived-checksum"`
+}
+
+// Open reads the header and table of contents of the size-byte archive in r.
+func Open(r io.ReaderAt, size int64) (*Archive, error) {
+	var hdr [headerSize]byte
+	n, err := r.ReadAt(hdr[:], 0)
+	if n < len(magic) || string(hdr[:4]) != magic {
+		return nil, fmt.Errorf("xar: not a xar archive")
+	}
+	if n < headerSize {
+		return nil, fmt.Errorf("xar: read header: %w", err)
+	}
+	hdrLen := int64(binary.BigEndian.Uint16(hdr[4:6]))
+	tocCompressed := binary.BigEndian.Uint64(hdr[8:16])
+	tocUncompressed := binary.BigEndian.Uint64(hdr[16:24])
+	alg := binary.BigEndian.Uint32(hdr[24:28])
+	if hdrLen < headerSize || size < hdrLen || tocUncompressed > maxTOCSize || tocCompressed > uint64(size-hdrLen) {
+		return nil, fmt.Errorf("xar: malformed header")
+	}
+
+	var algName string
+	switch alg {
+	case checksumNone:
+		algName = "none"
+	case checksumSHA1:
+		algName = "sha1"
+	case checksumMD5:
+		algName = "md5"
+	case checksumOther:
+		name := make([]byte, hdrLen-headerSize)
+		if _, err := r.ReadAt(name, headerSize); err != nil {
+			return nil, fmt.Errorf("xar: read header: %w", err)
+		}
+		algName = string(bytes.TrimRight(name, "\x00"))
+	default:
+		return nil, fmt.Errorf("xar: unknown checksum algorithm %d", alg)
+	}
+
+	rawTOC := make([]byte, tocCompressed)
+	if _, err := r.ReadAt(rawTOC, hdrLen); err != nil {
+		return nil, fmt.Errorf("xar: read table of contents: %w", err)
+	}
+	zr, err := zlib.NewReader(bytes.NewReader(rawTOC))
+	if err != nil {
+		return nil, fmt.Errorf("xar: decompress table of contents: %w", err)
+	}
+	tocXML, err := io.ReadAll(io.LimitReader(zr, maxTOCSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("xar: decompress table of contents: %w", err)
+	}
+	if uint64(len(tocXML)) != tocUncompressed {
+		return nil, fmt.Errorf("xar: table of contents is %d bytes, header says %d", len(tocXML), tocUncompressed)
+	}
+
+	a := &Archive{r: r, heap: hdrLen + int64(tocCompressed), rawTOC: rawTOC}
+	if err := xml.Unmarshal(tocXML, &a.toc); err != nil {
+		return nil, fmt.Errorf("xar: parse table of contents: %w", err)
+	}
+	if !strings.EqualFold(a.toc.Checksum.Style, algName) {
+		return nil, fmt.Errorf("xar: header checksum %s does not match table of contents checksum %s", algName, a.toc.Checksum.Style)
+	}
+	// A missing or weak TOC checksum is reported by VerifySignature, so
+	// that unsigned archives can still be listed.
+	a.hash, _ = checksumHash(algName)
+
+	if sig := a.toc.Signature; sig != nil {
+		for _, encoded := range sig.Certificates {
+			der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(encoded), ""))
+			if err != nil {
+				a.certsErr = fmt.Errorf("xar: decode signing certificate: %w", err)
+				break
+			}
+			cert, err := x509.ParseCertificate(der)
+			if err != nil {
+				a.certsErr = fmt.Errorf("xar: parse signing certificate: %w", err)
+				break
+			}
+			a.certs = append(a.certs, cert)
+		}
+	}
+	return a, nil
+}
+
+// Certificates returns the certificates listed with the RSA signature, the
+// signing certificate first. It is empty for an unsigned archive.
+func (a *Archive) Certificates() []*x509.Certificate {
+	return a.certs
+}
+
+// Files returns every entry in the table of contents, parents before their
+// children.
+func (a *Archive) Files() []File {
+	var out []File
+	var walk func(dir string, files []tocFile)
+	walk = func(dir string, files []tocFile) {
+		for _, f := range files {
+			file := File{Path: dir + f.Name, Type: f.Type}
+			if f.Data != nil {
+				file.Offset, file.Length, file.Size = f.Data.Offset, f.Data.Length, f.Data.Size
+				file.Encoding = f.Data.Encoding.Style
+				file.checksumStyle = f.Data.ArchivedChecksum.Style
+				file.checksum = strings.TrimSpace(f.Data.ArchivedChecksum.Value)
+				file.hasData = true
+			}
+			out = append(out, file)
+			walk(file.Path+"/", f.Files)
+		}
+	}
+	walk("", a.toc.Files)
+	return out
+}
+
+// VerifySignature checks that the heap holds the checksum of the table of
+// contents and that the RSA signature of that checksum was made by the key
+// of the first certificate. It does not check the certificates themselves,
+// nor the file data; see VerifyFiles.
+func (a *Archive) VerifySignature() error {
+	sig := a.toc.Signature
+	if sig == nil {
+		return ErrUnsigned
+	}
+	if sig.Style != "RSA" {
+		return fmt.Errorf("xar: unsupported signature style %q", sig.Style)
+	}
+	if a.hash == 0 {
+		return fmt.Errorf("xar: table of contents checksum %q cannot be signed securely", a.toc.Checksum.Style)
+	}
+	if a.certsErr != nil {
+		return a.certsErr
+	}
+	if len(a.certs) == 0 {
+		return fmt.Errorf("xar: signature lists no certificates")
+	}
+	pub, ok := a.certs[0].PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return fmt.Errorf("xar: signing certificate does not have an RSA key")
+	}
+
+	h := a.hash.New()
+	h.Write(a.rawTOC)
+	sum := h.Sum(nil)
+	if a.toc.Checksum.Size != int64(len(sum)) {
+		return fmt.Errorf("xar: table of contents checksum is %d bytes, expected %d", a.toc.Checksum.Size, len(sum))
+	}
+	stored, err := a.readHeap(a.toc.Checksum.Offset, a.toc.Checksum.Size)
+	if err != nil {
+		return fmt.Errorf("xar: read table of contents checksum: %w", err)
+	}
+	if !bytes.Equal(stored, sum) {
+		return fmt.Errorf("xar: table of contents checksum does not match")
+	}
+
+	signature, err := a.readHeap(sig.Offset, sig.Size)
+	if err != nil {
+		return fmt.Errorf("xar: read signature: %w", err)
+	}
+	if err := rsa.VerifyPKCS1v15(pub, a.hash, sum, signature); err != nil {
+		return fmt.Errorf("xar: signature does not match the signing certificate: %w", err)
+	}
+	return nil
+}
+
+// VerifyFiles checks the archived data of every file against the checksum
+// recorded for it in the table of contents.
+func (a *Archive) VerifyFiles() error {
+	for _, f := range a.Files() {
+		if !f.hasData {
+			continue
+		}
+		hash, err := checksumHash(f.checksumStyle)
+		if err != nil {
+			return fmt.Errorf("xar: %s: %w", f.Path, err)
+		}
+		want, err := hex.DecodeString(f.checksum)
+		if err != nil {
+			return fmt.Errorf("xar: %s: invalid checksum %q", f.Path, f.checksum)
+		}
+		if f.Offset < 0 || f.Length < 0 {
+			return fmt.Errorf("xar: %s: invalid data location", f.Path)
+		}
+		h := hash.New()
+		n, err := io.Copy(h, io.NewSectionReader(a.r, a.heap+f.Offset, f.Length))
+		if err != nil {
+			return fmt.Errorf("xar: %s: read data: %w", f.Path, err)
+		}
+		if n != f.Length {
+			return fmt.Errorf("xar: %s: data is truncated", f.Path)
+		}
+		if !bytes.Equal(h.Sum(nil), want) {
+			return fmt.Errorf("xar: %s: checksum does not match", f.Path)
+		}
+	}
+	return nil
+}
+
+// ReadFile returns the extracted content of the file at path, which may be
+// at most maxSize bytes.
+func (a *Archive) ReadFile(path string, maxSize int64) ([]byte, error) {
+	files := a.Files()
+	i := slices.IndexFunc(files, func(f File) bool { return f.Path == path })
+	if i < 0 {
+		return nil, fmt.Errorf("xar: %s: %w", path, fs.ErrNotExist)
+	}
+	f := files[i]
+	if !f.hasData {
+		return nil, fmt.Errorf("xar: %s: not a regular file", path)
+	}
+	if f.Size > maxSize {
+		return nil, fmt.Errorf("xar: %s: %d bytes exceeds the %d byte limit", path, f.Size, maxSize)
+	}
+
+	var r io.Reader = io.NewSectionReader(a.r, a.heap+f.Offset, f.Length)
+	switch f.Encoding {
+	case "", "application/octet-stream":
+	case "application/x-gzip":
+		// xar labels zlib streams as gzip.
+		zr, err := zlib.NewReader(r)
+		if err != nil {
+			return nil, fmt.Errorf("xar: %s: %w", path, err)
+		}
+		defer zr.Close()
+		r = zr
+	case "application/x-bzip2":
+		r = bzip2.NewReader(r)
+	default:
+		return nil, fmt.Errorf("xar: %s: unsupported encoding %q", path, f.Encoding)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r, maxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("xar: %s: %w", path, err)
+	}
+	if int64(len(data)) > maxSize {
+		return nil, fmt.Errorf("xar: %s: exceeds the %d byte limit", path, maxSize)
+	}
+	return data, nil
+}
+
+func (a *Archive) readHeap(offset, size int64) ([]byte, error) {
+	if offset < 0 || size <= 0 || size > 1<<16 {
+		return nil, fmt.Errorf("invalid heap location")
+	}
+	b := make([]byte, size)
+	if _, err := a.r.ReadAt(b, a.heap+offset); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// checksumHash maps a xar checksum style to its hash. MD5 and "none" are
+// rejected because they do not protect against tampering.
+func checksumHash(style string) (crypto.Hash, error) {
+	switch strings.ToLower(style) {
+	case "sha1":
+		return crypto.SHA1, nil
+	case "sha256":
+		return crypto.SHA256, nil
+	case "sha512":
+		return crypto.SHA512, nil
+	default:
+		return 0, fmt.Errorf("unsupported checksum %q", style)
+	}
+}
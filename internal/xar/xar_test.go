@@ -0,0 +1,186 @@
+package xar_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"io/fs"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/xar"
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/xar/xartest"
+)
+
+var testFiles = []xartest.File{
+	{Name: "Distribution", Data: []byte("<installer-gui-script/>")},
+	{Name: "Payload", Data: []byte("payload bytes")},
+}
+
+func newSigner(t *testing.T) (*rsa.PrivateKey, *x509.Certificate) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "xar test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key, cert
+}
+
+func open(t *testing.T, data []byte) *xar.Archive {
+	t.Helper()
+	a, err := xar.Open(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	return a
+}
+
+func TestVerify(t *testing.T) {
+	key, cert := newSigner(t)
+	for _, checksum := range []string{"sha1", "sha256"} {
+		t.Run(checksum, func(t *testing.T) {
+			data, err := xartest.Build(testFiles, xartest.Options{Checksum: checksum, Key: key, Certificates: []*x509.Certificate{cert}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			a := open(t, data)
+			if err := a.VerifySignature(); err != nil {
+				t.Errorf("VerifySignature: %v", err)
+			}
+			if err := a.VerifyFiles(); err != nil {
+				t.Errorf("VerifyFiles: %v", err)
+			}
+			if certs := a.Certificates(); len(certs) != 1 || !certs[0].Equal(cert) {
+				t.Errorf("Certificates = %v", certs)
+			}
+			files := a.Files()
+			if len(files) != 2 || files[0].Path != "Distribution" || files[1].Path != "Payload" {
+				t.Errorf("Files = %+v", files)
+			}
+		})
+	}
+}
+
+func TestVerify_Unsigned(t *testing.T) {
+	data, err := xartest.Build(testFiles, xartest.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := open(t, data)
+	if err := a.VerifySignature(); !errors.Is(err, xar.ErrUnsigned) {
+		t.Errorf("VerifySignature = %v, want ErrUnsigned", err)
+	}
+	if err := a.VerifyFiles(); err != nil {
+		t.Errorf("VerifyFiles: %v", err)
+	}
+}
+
+func TestVerify_WrongKey(t *testing.T) {
+	_, cert := newSigner(t)
+	other, _ := newSigner(t)
+	data, err := xartest.Build(testFiles, xartest.Options{Key: other, Certificates: []*x509.Certificate{cert}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = open(t, data).VerifySignature()
+	if err == nil || !strings.Contains(err.Error(), "signature does not match") {
+		t.Errorf("VerifySignature = %v", err)
+	}
+}
+
+func TestVerify_TamperedFile(t *testing.T) {
+	key, cert := newSigner(t)
+	data, err := xartest.Build(testFiles, xartest.Options{Key: key, Certificates: []*x509.Certificate{cert}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data[len(data)-1] ^= 0xff
+
+	a := open(t, data)
+	if err := a.VerifySignature(); err != nil {
+		t.Errorf("VerifySignature: %v", err)
+	}
+	err = a.VerifyFiles()
+	if err == nil || !strings.Contains(err.Error(), "Payload: checksum does not match") {
+		t.Errorf("VerifyFiles = %v", err)
+	}
+}
+
+func TestVerify_TamperedChecksum(t *testing.T) {
+	key, cert := newSigner(t)
+	data, err := xartest.Build(testFiles, xartest.Options{Key: key, Certificates: []*x509.Certificate{cert}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The heap holds the TOC checksum, the signature and then the files.
+	heap := bytes.Index(data, testFiles[1].Data) - len(testFiles[0].Data) - key.Size() - 20
+	data[heap] ^= 0xff
+
+	err = open(t, data).VerifySignature()
+	if err == nil || !strings.Contains(err.Error(), "table of contents checksum does not match") {
+		t.Errorf("VerifySignature = %v", err)
+	}
+}
+
+func TestOpen_Invalid(t *testing.T) {
+	for name, data := range map[string][]byte{
+		"not xar":   []byte("PK\x03\x04 this is a zip file, not a package"),
+		"truncated": []byte("xar!"),
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := xar.Open(bytes.NewReader(data), int64(len(data))); err == nil {
+				t.Error("Open succeeded")
+			}
+		})
+	}
+}
+
+func TestReadFile(t *testing.T) {
+	files := []xartest.File{
+		{Name: "Distribution", Data: []byte("<installer-gui-script/>")},
+		{Name: "Word.pkg/PackageInfo", Data: []byte("<pkg-info/>")},
+	}
+	for _, compress := range []bool{false, true} {
+		data, err := xartest.Build(files, xartest.Options{Compress: compress})
+		if err != nil {
+			t.Fatal(err)
+		}
+		a := open(t, data)
+		if err := a.VerifyFiles(); err != nil {
+			t.Errorf("compress=%v: VerifyFiles: %v", compress, err)
+		}
+
+		got, err := a.ReadFile("Word.pkg/PackageInfo", 1<<10)
+		if err != nil || string(got) != "<pkg-info/>" {
+			t.Errorf("compress=%v: ReadFile = %q, %v", compress, got, err)
+		}
+		if _, err := a.ReadFile("Word.pkg", 1<<10); err == nil {
+			t.Errorf("compress=%v: ReadFile of a directory succeeded", compress)
+		}
+		if _, err := a.ReadFile("Distribution", 4); err == nil || !strings.Contains(err.Error(), "byte limit") {
+			t.Errorf("compress=%v: ReadFile over the limit = %v", compress, err)
+		}
+		if _, err := a.ReadFile("Resources", 1<<10); !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("compress=%v: ReadFile of a missing file = %v", compress, err)
+		}
+	}
+}
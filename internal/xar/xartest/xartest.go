@@ -0,0 +1,180 @@
+// Package xartest builds small xar archives, signed like the output of
+// productsign, for tests of code that reads installer packages.
+package xartest
+
+import (
+	"bytes"
+	"compress/zlib"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	_ "crypto/sha1"
+	_ "crypto/sha256"
+)
+
+// File is a file stored in the archive. Name is a slash-separated path;
+// the directories it names are added to the archive.
+type File struct {
+	Name string
+	Data []byte
+}
+
+// Options configures Build.
+type Options struct {
+	// Checksum is the checksum of the table of contents and files, "sha1"
+	// (the default) or "sha256".
+	Checksum string
+
+	// Compress stores files zlib-compressed, as productbuild stores
+	// Distribution and PackageInfo files, rather than as they are.
+	Compress bool
+
+	// Key signs the archive when set. Certificates are listed in the
+	// signature, the signing certificate first.
+	Key          *rsa.PrivateKey
+	Certificates []*x509.Certificate
+}
+
+// Build returns a xar archive holding files.
+func Build(files []File, opts Options) ([]byte, error) {
+	style, hash, code := "sha1", crypto.SHA1, uint32(1)
+	if opts.Checksum == "sha256" {
+		style, hash, code = "sha256", crypto.SHA256, 3
+	}
+
+	var heap bytes.Buffer
+	heap.Write(make([]byte, hash.Size()))
+
+	var toc strings.Builder
+	toc.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n<xar>\n <toc>\n")
+	fmt.Fprintf(&toc, "  <checksum style=%q>\n   <offset>0</offset>\n   <size>%d</size>\n  </checksum>\n", style, hash.Size())
+	if opts.Key != nil {
+		size := opts.Key.Size()
+		fmt.Fprintf(&toc, "  <signature style=\"RSA\">\n   <offset>%d</offset>\n   <size>%d</size>\n", heap.Len(), size)
+		toc.WriteString("   <KeyInfo xmlns=\"http://www.w3.org/2000/09/xmldsig#\">\n    <X509Data>\n")
+		for _, cert := range opts.Certificates {
+			fmt.Fprintf(&toc, "     <X509Certificate>%s</X509Certificate>\n", base64.StdEncoding.EncodeToString(cert.Raw))
+		}
+		toc.WriteString("    </X509Data>\n   </KeyInfo>\n  </signature>\n")
+		heap.Write(make([]byte, size))
+	}
+	root := &dir{}
+	for _, f := range files {
+		root.add(strings.Split(f.Name, "/"), f.Data)
+	}
+	id := 0
+	var writeDir func(d *dir, indent string) error
+	writeDir = func(d *dir, indent string) error {
+		for _, name := range d.order {
+			id++
+			fmt.Fprintf(&toc, "%s<file id=\"%d\">\n", indent, id)
+			if sub, ok := d.dirs[name]; ok {
+				fmt.Fprintf(&toc, "%s <name>%s</name>\n%s <type>directory</type>\n", indent, name, indent)
+				if err := writeDir(sub, indent+" "); err != nil {
+					return err
+				}
+			} else {
+				data := d.files[name]
+				archived, encoding := data, "application/octet-stream"
+				if opts.Compress {
+					var buf bytes.Buffer
+					zw := zlib.NewWriter(&buf)
+					zw.Write(data)
+					if err := zw.Close(); err != nil {
+						return err
+					}
+					archived, encoding = buf.Bytes(), "application/x-gzip"
+				}
+				fmt.Fprintf(&toc, "%s <data>\n%s  <length>%d</length>\n%s  <offset>%d</offset>\n%s  <size>%d</size>\n",
+					indent, indent, len(archived), indent, heap.Len(), indent, len(data))
+				fmt.Fprintf(&toc, "%s  <encoding style=%q/>\n", indent, encoding)
+				fmt.Fprintf(&toc, "%s  <extracted-checksum style=%q>%s</extracted-checksum>\n", indent, style, hexSum(hash, data))
+				fmt.Fprintf(&toc, "%s  <archived-checksum style=%q>%s</archived-checksum>\n", indent, style, hexSum(hash, archived))
+				fmt.Fprintf(&toc, "%s </data>\n%s <name>%s</name>\n%s <type>file</type>\n", indent, indent, name, indent)
+				heap.Write(archived)
+			}
+			fmt.Fprintf(&toc, "%s</file>\n", indent)
+		}
+		return nil
+	}
+	if err := writeDir(root, "  "); err != nil {
+		return nil, err
+	}
+	toc.WriteString(" </toc>\n</xar>\n")
+
+	var compressed bytes.Buffer
+	zw := zlib.NewWriter(&compressed)
+	zw.Write([]byte(toc.String()))
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	h := hash.New()
+	h.Write(compressed.Bytes())
+	sum := h.Sum(nil)
+	out := heap.Bytes()
+	copy(out, sum)
+	if opts.Key != nil {
+		signature, err := rsa.SignPKCS1v15(rand.Reader, opts.Key, hash, sum)
+		if err != nil {
+			return nil, err
+		}
+		copy(out[hash.Size():], signature)
+	}
+
+	header := make([]byte, 28)
+	if code == 3 {
+		header = append(header, []byte("sha256\x00\x00")...)
+	}
+	copy(header, "xar!")
+	binary.BigEndian.PutUint16(header[4:], uint16(len(header)))
+	binary.BigEndian.PutUint16(header[6:], 1)
+	binary.BigEndian.PutUint64(header[8:], uint64(compressed.Len()))
+	binary.BigEndian.PutUint64(header[16:], uint64(len(toc.String())))
+	binary.BigEndian.PutUint32(header[24:], code)
+
+	return bytes.Join([][]byte{header, compressed.Bytes(), out}, nil), nil
+}
+
+// dir is a directory of the archive being built, with its entries in the
+// order they were added.
+type dir struct {
+	order []string
+	dirs  map[string]*dir
+	files map[string][]byte
+}
+
+func (d *dir) add(path []string, data []byte) {
+	name := path[0]
+	if len(path) == 1 {
+		if d.files == nil {
+			d.files = make(map[string][]byte)
+		}
+		d.order = append(d.order, name)
+		d.files[name] = data
+		return
+	}
+	sub, ok := d.dirs[name]
+	if !ok {
+		if d.dirs == nil {
+			d.dirs = make(map[string]*dir)
+		}
+		sub = &dir{}
+		d.dirs[name] = sub
+		d.order = append(d.order, name)
+	}
+	sub.add(path[1:], data)
+}
+
+func hexSum(hash crypto.Hash, data []byte) string {
+	h := hash.New()
+	h.Write(data)
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -2,6 +2,7 @@ package itunes
 
 import (
 	"github.com/deploymenttheory/go-api-sdk-apple/itunes/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/itunes/itunes_api/apps"
 	"github.com/deploymenttheory/go-api-sdk-apple/itunes/itunes_api/search"
 )
 
@@ -14,6 +15,7 @@ type Client struct {
 // ItunesAPIClient groups all iTunes API services.
 type ItunesAPIClient struct {
 	Search *search.SearchService
+	Apps   *apps.AppsService
 }
 
 // NewClient creates a new iTunes Search API client with optional configuration.
@@ -34,6 +36,7 @@ func NewClient(options ...client.ClientOption) (*Client, error) {
 		transport: transport,
 		ItunesAPI: &ItunesAPIClient{
 			Search: search.NewService(transport),
+			Apps:   apps.NewService(transport),
 		},
 	}, nil
 }
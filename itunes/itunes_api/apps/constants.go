@@ -0,0 +1,25 @@
+package apps
+
+// Platform values for LookupOptions.Platform.
+const (
+	// PlatformIOS looks up iPhone, iPad and universal apps.
+	PlatformIOS = "iOS"
+	// PlatformMacOS looks up Mac App Store apps.
+	PlatformMacOS = "macOS"
+)
+
+// Kind values reported in App.Kind.
+const (
+	KindIOSSoftware = "software"
+	KindMacSoftware = "mac-software"
+)
+
+// wrapperTypeSoftware marks app results; lookups by track ID may also return
+// music, books or other media, which are skipped.
+const wrapperTypeSoftware = "software"
+
+// platformEntities maps a Platform to the lookup entity that selects its apps.
+var platformEntities = map[string]string{
+	PlatformIOS:   "software",
+	PlatformMacOS: "macSoftware",
+}
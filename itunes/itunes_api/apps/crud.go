@@ -0,0 +1,134 @@
+package apps
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/itunes/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/itunes/constants"
+	"resty.dev/v3"
+)
+
+// ErrAppNotFound is returned when a single-app lookup matches no app in the
+// storefront.
+var ErrAppNotFound = errors.New("app not found")
+
+// AppsService looks up App Store apps through the iTunes Lookup API and
+// returns typed app metadata — version, icon, minimum OS and pricing. Its
+// track IDs are the adamIds used by Apps and Books for Organizations, so it
+// can enrich VPP assets with names and icons.
+//
+// iTunes Search API docs: https://developer.apple.com/library/archive/documentation/AudioVideo/Conceptual/iTuneSearchAPI/
+type AppsService struct {
+	client client.Client
+}
+
+// NewService creates a new App Store apps service.
+func NewService(c client.Client) *AppsService {
+	return &AppsService{client: c}
+}
+
+// GetByBundleIDV1 looks up the app with the given bundle identifier. It
+// returns ErrAppNotFound when the storefront has no such app.
+// URL: GET https://itunes.apple.com/lookup?bundleId={bundleID}
+func (s *AppsService) GetByBundleIDV1(ctx context.Context, bundleID string, opts *LookupOptions) (*App, *resty.Response, error) {
+	if bundleID == "" {
+		return nil, nil, fmt.Errorf("bundle ID is required")
+	}
+
+	result, resp, err := s.lookup(ctx, "bundleId", bundleID, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	if len(result.Results) == 0 {
+		return nil, resp, fmt.Errorf("bundle ID %s: %w", bundleID, ErrAppNotFound)
+	}
+
+	return &result.Results[0], resp, nil
+}
+
+// GetByTrackIDV1 looks up the app with the given App Store ID. It returns
+// ErrAppNotFound when the storefront has no such app.
+// URL: GET https://itunes.apple.com/lookup?id={trackID}
+func (s *AppsService) GetByTrackIDV1(ctx context.Context, trackID int64, opts *LookupOptions) (*App, *resty.Response, error) {
+	if trackID <= 0 {
+		return nil, nil, fmt.Errorf("track ID is required")
+	}
+
+	result, resp, err := s.lookup(ctx, "id", strconv.FormatInt(trackID, 10), opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	if len(result.Results) == 0 {
+		return nil, resp, fmt.Errorf("track ID %d: %w", trackID, ErrAppNotFound)
+	}
+
+	return &result.Results[0], resp, nil
+}
+
+// ListByTrackIDsV1 looks up several apps by App Store ID in one request.
+// IDs the storefront does not sell are left out of the response. The API
+// accepts at most constants.MaxLimit IDs per request.
+// URL: GET https://itunes.apple.com/lookup?id={id1},{id2}
+func (s *AppsService) ListByTrackIDsV1(ctx context.Context, trackIDs []int64, opts *LookupOptions) (*AppsResponse, *resty.Response, error) {
+	if len(trackIDs) == 0 {
+		return nil, nil, fmt.Errorf("at least one track ID is required")
+	}
+	if len(trackIDs) > constants.MaxLimit {
+		return nil, nil, fmt.Errorf("at most %d track IDs can be looked up at once, have %d", constants.MaxLimit, len(trackIDs))
+	}
+
+	ids := make([]string, len(trackIDs))
+	for i, id := range trackIDs {
+		ids[i] = strconv.FormatInt(id, 10)
+	}
+
+	return s.lookup(ctx, "id", strings.Join(ids, ","), opts)
+}
+
+// lookup runs a software lookup keyed by key=value and drops non-app results.
+func (s *AppsService) lookup(ctx context.Context, key, value string, opts *LookupOptions) (*AppsResponse, *resty.Response, error) {
+	if opts == nil {
+		opts = &LookupOptions{}
+	}
+
+	platform := opts.Platform
+	if platform == "" {
+		platform = PlatformIOS
+	}
+	entity, ok := platformEntities[platform]
+	if !ok {
+		return nil, nil, fmt.Errorf("unsupported platform %q", opts.Platform)
+	}
+
+	params := s.client.QueryBuilder().
+		AddString(key, value).
+		AddString("entity", entity).
+		AddString("country", opts.Country)
+
+	var result AppsResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParams(params.Build()).
+		SetResult(&result).
+		Get(constants.EndpointLookup)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	apps := result.Results[:0]
+	for _, app := range result.Results {
+		if app.WrapperType == wrapperTypeSoftware {
+			apps = append(apps, app)
+		}
+	}
+	result.Results = apps
+	result.ResultCount = len(apps)
+
+	return &result, resp, nil
+}
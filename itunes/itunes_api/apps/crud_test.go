@@ -0,0 +1,189 @@
+package apps
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/itunes/client"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// setupMockClient creates an iTunes transport with httpmock enabled.
+func setupMockClient(t *testing.T) *AppsService {
+	t.Helper()
+
+	transport, err := client.NewTransport(
+		client.WithLogger(zap.NewNop()),
+		client.WithRetryCount(0), // disable retries for tests
+	)
+	require.NoError(t, err)
+
+	httpmock.ActivateNonDefault(transport.GetHTTPClient().Client())
+	t.Cleanup(func() {
+		httpmock.DeactivateAndReset()
+	})
+
+	return NewService(transport)
+}
+
+// lookupResponder answers lookups with body and records the query string.
+func lookupResponder(body string, query *map[string]string) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		*query = make(map[string]string)
+		for k, v := range req.URL.Query() {
+			(*query)[k] = v[0]
+		}
+		resp := httpmock.NewStringResponse(200, body)
+		resp.Header.Set("Content-Type", "text/javascript; charset=utf-8")
+		return resp, nil
+	}
+}
+
+const pagesLookup = `{
+	"resultCount": 1,
+	"results": [{
+		"wrapperType": "software",
+		"kind": "software",
+		"trackId": 361309726,
+		"bundleId": "com.apple.Pages",
+		"trackName": "Pages",
+		"sellerName": "Apple Inc.",
+		"version": "14.2",
+		"minimumOsVersion": "17.0",
+		"releaseDate": "2010-04-01T20:55:54Z",
+		"currentVersionReleaseDate": "2024-09-16T17:54:23Z",
+		"fileSizeBytes": "561793024",
+		"artworkUrl60": "https://is1-ssl.mzstatic.com/pages/60x60bb.jpg",
+		"artworkUrl512": "https://is1-ssl.mzstatic.com/pages/512x512bb.jpg",
+		"price": 0,
+		"formattedPrice": "Free",
+		"supportedDevices": ["iPhone15-iPhone15", "iPadPro11M4-iPadPro11M4"]
+	}]
+}`
+
+func TestGetByBundleIDV1_Success(t *testing.T) {
+	svc := setupMockClient(t)
+
+	var query map[string]string
+	httpmock.RegisterResponder("GET", `=~^https://itunes\.apple\.com/lookup`, lookupResponder(pagesLookup, &query))
+
+	app, resp, err := svc.GetByBundleIDV1(context.Background(), "com.apple.Pages", &LookupOptions{Country: "gb"})
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, map[string]string{"bundleId": "com.apple.Pages", "entity": "software", "country": "gb"}, query)
+	assert.Equal(t, int64(361309726), app.TrackID)
+	assert.Equal(t, "Pages", app.TrackName)
+	assert.Equal(t, "14.2", app.Version)
+	assert.Equal(t, "17.0", app.MinimumOSVersion)
+	assert.Equal(t, KindIOSSoftware, app.Kind)
+	assert.Equal(t, time.Date(2024, 9, 16, 17, 54, 23, 0, time.UTC), app.CurrentVersionReleaseDate)
+	assert.Equal(t, "https://is1-ssl.mzstatic.com/pages/512x512bb.jpg", app.IconURL())
+	assert.Equal(t, int64(561793024), app.FileSize())
+}
+
+func TestGetByBundleIDV1_MacOS(t *testing.T) {
+	svc := setupMockClient(t)
+
+	var query map[string]string
+	httpmock.RegisterResponder("GET", `=~^https://itunes\.apple\.com/lookup`, lookupResponder(`{
+		"resultCount": 1,
+		"results": [{"wrapperType": "software", "kind": "mac-software", "trackId": 497799835, "bundleId": "com.apple.dt.Xcode", "trackName": "Xcode", "version": "16.0", "minimumOsVersion": "14.5"}]
+	}`, &query))
+
+	app, _, err := svc.GetByBundleIDV1(context.Background(), "com.apple.dt.Xcode", &LookupOptions{Platform: PlatformMacOS})
+
+	require.NoError(t, err)
+	assert.Equal(t, "macSoftware", query["entity"])
+	assert.Equal(t, KindMacSoftware, app.Kind)
+	assert.Equal(t, "14.5", app.MinimumOSVersion)
+}
+
+func TestGetByBundleIDV1_NotFound(t *testing.T) {
+	svc := setupMockClient(t)
+
+	var query map[string]string
+	httpmock.RegisterResponder("GET", `=~^https://itunes\.apple\.com/lookup`, lookupResponder(`{"resultCount": 0, "results": []}`, &query))
+
+	app, resp, err := svc.GetByBundleIDV1(context.Background(), "com.example.missing", nil)
+
+	require.Error(t, err)
+	require.NotNil(t, resp)
+	assert.Nil(t, app)
+	assert.True(t, errors.Is(err, ErrAppNotFound))
+	assert.Contains(t, err.Error(), "com.example.missing")
+}
+
+func TestGetByBundleIDV1_Validation(t *testing.T) {
+	svc := setupMockClient(t)
+
+	_, _, err := svc.GetByBundleIDV1(context.Background(), "", nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "bundle ID is required")
+
+	_, _, err = svc.GetByBundleIDV1(context.Background(), "com.apple.Pages", &LookupOptions{Platform: "tvOS"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unsupported platform "tvOS"`)
+}
+
+func TestGetByTrackIDV1_SkipsNonSoftware(t *testing.T) {
+	svc := setupMockClient(t)
+
+	var query map[string]string
+	httpmock.RegisterResponder("GET", `=~^https://itunes\.apple\.com/lookup`, lookupResponder(`{
+		"resultCount": 1,
+		"results": [{"wrapperType": "track", "kind": "song", "trackId": 1440857781, "trackName": "Flake"}]
+	}`, &query))
+
+	_, _, err := svc.GetByTrackIDV1(context.Background(), 1440857781, nil)
+
+	require.Error(t, err)
+	assert.Equal(t, "1440857781", query["id"])
+	assert.True(t, errors.Is(err, ErrAppNotFound))
+}
+
+func TestListByTrackIDsV1_Success(t *testing.T) {
+	svc := setupMockClient(t)
+
+	var query map[string]string
+	httpmock.RegisterResponder("GET", `=~^https://itunes\.apple\.com/lookup`, lookupResponder(`{
+		"resultCount": 2,
+		"results": [
+			{"wrapperType": "software", "kind": "software", "trackId": 361309726, "bundleId": "com.apple.Pages", "trackName": "Pages", "version": "14.2"},
+			{"wrapperType": "software", "kind": "software", "trackId": 361304891, "bundleId": "com.apple.Numbers", "trackName": "Numbers", "version": "14.2"}
+		]
+	}`, &query))
+
+	result, _, err := svc.ListByTrackIDsV1(context.Background(), []int64{361309726, 361304891, 1}, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, "361309726,361304891,1", query["id"])
+	assert.Equal(t, 2, result.ResultCount)
+	assert.Equal(t, "com.apple.Numbers", result.Results[1].BundleID)
+}
+
+func TestListByTrackIDsV1_Validation(t *testing.T) {
+	svc := setupMockClient(t)
+
+	_, _, err := svc.ListByTrackIDsV1(context.Background(), nil, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "at least one track ID is required")
+
+	_, _, err = svc.ListByTrackIDsV1(context.Background(), make([]int64, 201), nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "at most 200 track IDs")
+}
+
+func TestApp_Helpers(t *testing.T) {
+	app := App{ArtworkURL60: "small", ArtworkURL100: "medium", FileSizeBytes: "n/a"}
+
+	assert.Equal(t, "medium", app.IconURL())
+	assert.Equal(t, int64(0), app.FileSize())
+	assert.Empty(t, (&App{}).IconURL())
+}
@@ -0,0 +1,88 @@
+package apps
+
+import (
+	"strconv"
+	"time"
+)
+
+// LookupOptions scopes an app lookup.
+type LookupOptions struct {
+	// Country is the two-letter ISO 3166-1 storefront country code (e.g.
+	// "us", "gb"). Apps not sold in the storefront are not returned.
+	// Defaults to the US storefront.
+	Country string
+	// Platform selects iOS or macOS apps (PlatformIOS, PlatformMacOS).
+	// Defaults to PlatformIOS; Mac apps are only returned for PlatformMacOS.
+	Platform string
+}
+
+// AppsResponse is the result of a multi-app lookup.
+type AppsResponse struct {
+	ResultCount int   `json:"resultCount"`
+	Results     []App `json:"results"`
+}
+
+// App is the App Store metadata for a single app.
+type App struct {
+	WrapperType string `json:"wrapperType"`
+	// Kind is KindIOSSoftware or KindMacSoftware.
+	Kind string `json:"kind"`
+	// TrackID is the App Store ID of the app, the adamId used by Apps and
+	// Books for Organizations.
+	TrackID  int64  `json:"trackId"`
+	BundleID string `json:"bundleId"`
+	// TrackName is the app's display name.
+	TrackName  string `json:"trackName"`
+	ArtistID   int64  `json:"artistId,omitempty"`
+	ArtistName string `json:"artistName,omitempty"`
+	SellerName string `json:"sellerName,omitempty"`
+	// Version is the current App Store version.
+	Version string `json:"version"`
+	// MinimumOSVersion is the lowest OS version the current version installs on.
+	MinimumOSVersion          string    `json:"minimumOsVersion"`
+	ReleaseNotes              string    `json:"releaseNotes,omitempty"`
+	ReleaseDate               time.Time `json:"releaseDate"`
+	CurrentVersionReleaseDate time.Time `json:"currentVersionReleaseDate"`
+	Description               string    `json:"description,omitempty"`
+	// FileSizeBytes is the download size as reported by the store, a decimal
+	// string. See FileSize.
+	FileSizeBytes string `json:"fileSizeBytes,omitempty"`
+	// ArtworkURL60, ArtworkURL100 and ArtworkURL512 are app icon URLs at
+	// increasing resolutions. See IconURL.
+	ArtworkURL60          string   `json:"artworkUrl60,omitempty"`
+	ArtworkURL100         string   `json:"artworkUrl100,omitempty"`
+	ArtworkURL512         string   `json:"artworkUrl512,omitempty"`
+	TrackViewURL          string   `json:"trackViewUrl,omitempty"`
+	Price                 float64  `json:"price"`
+	FormattedPrice        string   `json:"formattedPrice,omitempty"`
+	Currency              string   `json:"currency,omitempty"`
+	ContentAdvisoryRating string   `json:"contentAdvisoryRating,omitempty"`
+	TrackContentRating    string   `json:"trackContentRating,omitempty"`
+	PrimaryGenreName      string   `json:"primaryGenreName,omitempty"`
+	Genres                []string `json:"genres,omitempty"`
+	SupportedDevices      []string `json:"supportedDevices,omitempty"`
+	LanguageCodesISO2A    []string `json:"languageCodesISO2A,omitempty"`
+	AverageUserRating     float64  `json:"averageUserRating,omitempty"`
+	UserRatingCount       int      `json:"userRatingCount,omitempty"`
+}
+
+// IconURL returns the highest resolution app icon URL available, or "" when
+// the store reports none.
+func (a *App) IconURL() string {
+	for _, u := range []string{a.ArtworkURL512, a.ArtworkURL100, a.ArtworkURL60} {
+		if u != "" {
+			return u
+		}
+	}
+	return ""
+}
+
+// FileSize returns FileSizeBytes as a number, or 0 when it is missing or
+// not a number.
+func (a *App) FileSize() int64 {
+	n, err := strconv.ParseInt(a.FileSizeBytes, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return n
+}
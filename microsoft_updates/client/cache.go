@@ -0,0 +1,132 @@
+package client
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"resty.dev/v3"
+)
+
+// responseCache holds successful GET response bodies for a fixed TTL, in
+// memory and optionally in a directory so the cache survives process restarts.
+type responseCache struct {
+	ttl time.Duration
+	dir string
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+// cacheEntry is a cached response body. It is also the on-disk JSON format.
+type cacheEntry struct {
+	URL      string      `json:"url"`
+	StoredAt time.Time   `json:"storedAt"`
+	Header   http.Header `json:"header"`
+	Body     []byte      `json:"body"`
+}
+
+func newResponseCache(ttl time.Duration, dir string) *responseCache {
+	return &responseCache{
+		ttl:     ttl,
+		dir:     dir,
+		now:     time.Now,
+		entries: make(map[string]*cacheEntry),
+	}
+}
+
+// cacheKey identifies a GET request by its URL and sorted query parameters.
+func cacheKey(req *resty.Request, path string) string {
+	if len(req.QueryParams) == 0 {
+		return path
+	}
+	return path + "?" + url.Values(req.QueryParams).Encode()
+}
+
+// get returns a fresh entry for key from memory or disk.
+func (c *responseCache) get(key string) (*cacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok && c.dir != "" {
+		entry, ok = c.load(key)
+		if ok {
+			c.entries[key] = entry
+		}
+	}
+	if !ok || c.now().Sub(entry.StoredAt) >= c.ttl {
+		return nil, false
+	}
+	return entry, true
+}
+
+// put stores body under key. Disk write failures are ignored; the entry is
+// still cached in memory.
+func (c *responseCache) put(key string, header http.Header, body []byte) {
+	entry := &cacheEntry{URL: key, StoredAt: c.now(), Header: header.Clone(), Body: body}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = entry
+	if c.dir != "" {
+		if data, err := json.Marshal(entry); err == nil {
+			_ = os.WriteFile(c.file(key), data, 0o600)
+		}
+	}
+}
+
+// clear drops every entry from memory and disk.
+func (c *responseCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[string]*cacheEntry)
+	if c.dir != "" {
+		files, _ := filepath.Glob(filepath.Join(c.dir, "*.json"))
+		for _, f := range files {
+			os.Remove(f)
+		}
+	}
+}
+
+func (c *responseCache) load(key string) (*cacheEntry, bool) {
+	data, err := os.ReadFile(c.file(key))
+	if err != nil {
+		return nil, false
+	}
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil || entry.URL != key {
+		return nil, false
+	}
+	return &entry, true
+}
+
+func (c *responseCache) file(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// response rebuilds a resty.Response for req from a cached entry.
+func (e *cacheEntry) response(req *resty.Request) *resty.Response {
+	return &resty.Response{
+		Request: req,
+		Body:    io.NopCloser(bytes.NewReader(e.Body)),
+		RawResponse: &http.Response{
+			Status:        "200 OK",
+			StatusCode:    http.StatusOK,
+			Header:        e.Header.Clone(),
+			ContentLength: int64(len(e.Body)),
+		},
+	}
+}
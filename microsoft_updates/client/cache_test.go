@@ -0,0 +1,107 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const cacheTestURL = "https://edgeupdates.microsoft.com/api/products/stable"
+
+func newCachedTransport(t *testing.T, opt ClientOption) (*Transport, *time.Time) {
+	t.Helper()
+	transport, err := NewTransport(opt, WithRetryCount(0))
+	require.NoError(t, err)
+
+	now := time.Date(2026, 4, 1, 12, 0, 0, 0, time.UTC)
+	transport.cache.now = func() time.Time { return now }
+
+	httpmock.ActivateNonDefault(transport.GetHTTPClient().Client())
+	t.Cleanup(httpmock.DeactivateAndReset)
+	httpmock.RegisterResponder("GET", cacheTestURL, httpmock.NewStringResponder(http.StatusOK, `[{"Product":"Stable"}]`))
+
+	return transport, &now
+}
+
+func TestWithCache(t *testing.T) {
+	transport, now := newCachedTransport(t, WithCache(time.Hour))
+	ctx := context.Background()
+
+	for range 3 {
+		var result []map[string]string
+		_, err := transport.NewRequest(ctx).SetResult(&result).Get(cacheTestURL)
+		require.NoError(t, err)
+		assert.Equal(t, "Stable", result[0]["Product"])
+	}
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+
+	resp, body, err := transport.NewRequest(ctx).GetBytes(cacheTestURL)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode())
+	assert.Equal(t, `[{"Product":"Stable"}]`, string(body))
+	assert.Equal(t, `[{"Product":"Stable"}]`, resp.String())
+
+	*now = now.Add(time.Hour)
+	_, _, err = transport.NewRequest(ctx).GetBytes(cacheTestURL)
+	require.NoError(t, err)
+	assert.Equal(t, 2, httpmock.GetTotalCallCount(), "expired entries are refetched")
+
+	transport.ClearCache()
+	_, _, err = transport.NewRequest(ctx).GetBytes(cacheTestURL)
+	require.NoError(t, err)
+	assert.Equal(t, 3, httpmock.GetTotalCallCount())
+}
+
+func TestWithCache_KeysOnQueryAndSkipsErrors(t *testing.T) {
+	transport, _ := newCachedTransport(t, WithCache(time.Hour))
+	httpmock.RegisterResponder("GET", "https://itunes.apple.com/search", httpmock.NewStringResponder(http.StatusOK, `{}`))
+	httpmock.RegisterResponder("GET", "https://learn.microsoft.com/missing", httpmock.NewStringResponder(http.StatusNotFound, "not found"))
+	ctx := context.Background()
+
+	for _, term := range []string{"Word", "Excel", "Word"} {
+		_, _, err := transport.NewRequest(ctx).SetQueryParam("term", term).GetBytes("https://itunes.apple.com/search")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+
+	for range 2 {
+		_, _, err := transport.NewRequest(ctx).GetBytes("https://learn.microsoft.com/missing")
+		require.Error(t, err)
+	}
+	assert.Equal(t, 4, httpmock.GetTotalCallCount(), "failed responses are not cached")
+}
+
+func TestWithDiskCache(t *testing.T) {
+	dir := t.TempDir()
+	first, _ := newCachedTransport(t, WithDiskCache(dir, time.Hour))
+
+	_, _, err := first.NewRequest(context.Background()).GetBytes(cacheTestURL)
+	require.NoError(t, err)
+	files, _ := os.ReadDir(dir)
+	assert.Len(t, files, 1)
+
+	// A second transport sharing the directory is served from disk.
+	second, _ := newCachedTransport(t, WithDiskCache(dir, time.Hour))
+	_, body, err := second.NewRequest(context.Background()).GetBytes(cacheTestURL)
+	require.NoError(t, err)
+	assert.Equal(t, `[{"Product":"Stable"}]`, string(body))
+	assert.Equal(t, 1, httpmock.GetTotalCallCount(), "only the first transport hit the network")
+
+	second.ClearCache()
+	files, _ = os.ReadDir(dir)
+	assert.Empty(t, files)
+}
+
+func TestWithCache_Validation(t *testing.T) {
+	_, err := NewTransport(WithCache(0))
+	assert.ErrorContains(t, err, "TTL must be positive")
+
+	_, err = NewTransport(WithDiskCache("", time.Hour))
+	assert.ErrorContains(t, err, "cache directory")
+}
@@ -0,0 +1,71 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// LinkCheck reports the health of a single download URL as observed by a
+// HEAD request.
+type LinkCheck struct {
+	// URL is the link as published in the feed.
+	URL string
+
+	// StatusCode is the HTTP status of the final response (0 if none was received).
+	StatusCode int
+
+	// FinalURL is the URL that answered after any redirects were followed.
+	FinalURL string
+
+	// Redirected reports whether FinalURL differs from URL.
+	Redirected bool
+
+	// ContentLength is the size reported by the server, or -1 when unknown.
+	ContentLength int64
+
+	// ExpectedSize is the size published by the feed, or 0 when the feed has none.
+	ExpectedSize int64
+
+	// SizeMismatch reports whether both sizes are known and disagree.
+	SizeMismatch bool
+
+	// Dead reports whether the link failed to resolve to a successful response.
+	Dead bool
+
+	// Err is the request or status error for dead links.
+	Err error
+}
+
+// CheckLink issues a HEAD request for url, following redirects, and reports
+// its status, final location and size. expectedSize is the size published by
+// the feed; pass 0 when the feed does not carry one.
+func CheckLink(ctx context.Context, c Client, url string, expectedSize int64) LinkCheck {
+	check := LinkCheck{
+		URL:           url,
+		FinalURL:      url,
+		ContentLength: -1,
+		ExpectedSize:  expectedSize,
+	}
+
+	resp, err := c.NewRequest(ctx).Head(url)
+	if resp != nil {
+		check.StatusCode = resp.StatusCode()
+		if resp.RawResponse != nil {
+			check.ContentLength = resp.RawResponse.ContentLength
+			if resp.RawResponse.Request != nil {
+				check.FinalURL = resp.RawResponse.Request.URL.String()
+			}
+		}
+	}
+	check.Redirected = check.FinalURL != url
+
+	if err != nil || check.StatusCode >= http.StatusBadRequest {
+		check.Dead = true
+		check.Err = err
+		return check
+	}
+
+	check.SizeMismatch = expectedSize > 0 && check.ContentLength >= 0 &&
+		check.ContentLength != expectedSize
+	return check
+}
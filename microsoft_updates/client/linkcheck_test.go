@@ -0,0 +1,70 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// headResponder answers with status and a fixed Content-Length.
+func headResponder(status int, size int64) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		resp := httpmock.NewBytesResponse(status, nil)
+		resp.ContentLength = size
+		resp.Request = req
+		return resp, nil
+	}
+}
+
+func TestCheckLink(t *testing.T) {
+	transport, err := NewTransport(WithRetryCount(0))
+	require.NoError(t, err)
+	httpmock.ActivateNonDefault(transport.GetHTTPClient().Client())
+	t.Cleanup(httpmock.DeactivateAndReset)
+
+	httpmock.RegisterResponder("HEAD", "https://cdn.example.com/ok.pkg", headResponder(200, 1024))
+	httpmock.RegisterResponder("HEAD", "https://cdn.example.com/moved.pkg",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewBytesResponse(http.StatusFound, nil)
+			resp.Header.Set("Location", "https://mirror.example.com/ok.pkg")
+			return resp, nil
+		})
+	httpmock.RegisterResponder("HEAD", "https://mirror.example.com/ok.pkg", headResponder(200, 2048))
+	httpmock.RegisterResponder("HEAD", "https://cdn.example.com/gone.pkg", headResponder(404, 0))
+
+	ctx := context.Background()
+
+	t.Run("healthy", func(t *testing.T) {
+		check := CheckLink(ctx, transport, "https://cdn.example.com/ok.pkg", 1024)
+		assert.False(t, check.Dead)
+		assert.False(t, check.Redirected)
+		assert.False(t, check.SizeMismatch)
+		assert.Equal(t, 200, check.StatusCode)
+		assert.Equal(t, int64(1024), check.ContentLength)
+	})
+
+	t.Run("redirect and size mismatch", func(t *testing.T) {
+		check := CheckLink(ctx, transport, "https://cdn.example.com/moved.pkg", 1024)
+		assert.False(t, check.Dead)
+		assert.True(t, check.Redirected)
+		assert.Equal(t, "https://mirror.example.com/ok.pkg", check.FinalURL)
+		assert.True(t, check.SizeMismatch)
+	})
+
+	t.Run("unknown expected size", func(t *testing.T) {
+		check := CheckLink(ctx, transport, "https://mirror.example.com/ok.pkg", 0)
+		assert.False(t, check.SizeMismatch)
+	})
+
+	t.Run("dead", func(t *testing.T) {
+		check := CheckLink(ctx, transport, "https://cdn.example.com/gone.pkg", 1024)
+		assert.True(t, check.Dead)
+		assert.Equal(t, 404, check.StatusCode)
+		assert.Error(t, check.Err)
+		assert.False(t, check.SizeMismatch)
+	})
+}
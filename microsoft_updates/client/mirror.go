@@ -0,0 +1,166 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// mirrorTransport is an http.RoundTripper that answers GET and HEAD requests
+// from a local directory instead of the network, for air-gapped environments
+// and tests. A request for https://{host}/{path}?{query} is served from
+// {root}/{host}/{path}?{query} — the layout `wget --force-directories`
+// produces — with "index.html" standing in for paths ending in "/".
+//
+// Single byte-range requests are honoured, so ranged and resumable downloads
+// work against the mirror as they do against the CDN.
+type mirrorTransport struct {
+	root *os.Root
+}
+
+func newMirrorTransport(dir string) (*mirrorTransport, error) {
+	root, err := os.OpenRoot(dir)
+	if err != nil {
+		return nil, fmt.Errorf("open offline mirror: %w", err)
+	}
+	return &mirrorTransport{root: root}, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (m *mirrorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return mirrorResponse(req, http.StatusMethodNotAllowed, "offline mirror only serves GET and HEAD"), nil
+	}
+
+	name := mirrorPath(req)
+	f, err := m.root.Open(name)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return mirrorResponse(req, http.StatusNotFound, "not found in offline mirror: "+name), nil
+		}
+		return nil, fmt.Errorf("offline mirror: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil || info.IsDir() {
+		f.Close()
+		return mirrorResponse(req, http.StatusNotFound, "not found in offline mirror: "+name), nil
+	}
+
+	size := info.Size()
+	resp := &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{},
+		ContentLength: size,
+		Request:       req,
+	}
+	resp.Header.Set("Accept-Ranges", "bytes")
+	resp.Header.Set("Last-Modified", info.ModTime().UTC().Format(http.TimeFormat))
+	if ct := mime.TypeByExtension(path.Ext(req.URL.Path)); ct != "" {
+		resp.Header.Set("Content-Type", ct)
+	}
+
+	var body io.Reader = f
+	if start, end, ok := parseRange(req.Header.Get("Range"), size); ok {
+		resp.Status = "206 Partial Content"
+		resp.StatusCode = http.StatusPartialContent
+		resp.ContentLength = end - start + 1
+		resp.Header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, size))
+		body = io.NewSectionReader(f, start, resp.ContentLength)
+	}
+	resp.Header.Set("Content-Length", strconv.FormatInt(resp.ContentLength, 10))
+
+	if req.Method == http.MethodHead {
+		f.Close()
+		resp.Body = http.NoBody
+		return resp, nil
+	}
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{body, f}
+	return resp, nil
+}
+
+// mirrorPath maps a request URL to its file name within the mirror root.
+func mirrorPath(req *http.Request) string {
+	p := req.URL.Path
+	if p == "" || strings.HasSuffix(p, "/") {
+		p += "index.html"
+	}
+	name := path.Join(req.URL.Host, path.Clean("/"+p))
+	if req.URL.RawQuery != "" {
+		name += "?" + req.URL.RawQuery
+	}
+	return name
+}
+
+// parseRange parses a single "bytes=start-end", "bytes=start-" or
+// "bytes=-suffix" range. Multiple or unsatisfiable ranges are reported as
+// not ok, and the whole file is served instead.
+func parseRange(header string, size int64) (start, end int64, ok bool) {
+	spec, found := strings.CutPrefix(header, "bytes=")
+	if !found || strings.Contains(spec, ",") {
+		return 0, 0, false
+	}
+	first, last, found := strings.Cut(spec, "-")
+	if !found {
+		return 0, 0, false
+	}
+
+	var err error
+	switch {
+	case first == "":
+		suffix, err := strconv.ParseInt(last, 10, 64)
+		if err != nil || suffix <= 0 {
+			return 0, 0, false
+		}
+		start, end = max(size-suffix, 0), size-1
+	case last == "":
+		if start, err = strconv.ParseInt(first, 10, 64); err != nil {
+			return 0, 0, false
+		}
+		end = size - 1
+	default:
+		if start, err = strconv.ParseInt(first, 10, 64); err != nil {
+			return 0, 0, false
+		}
+		if end, err = strconv.ParseInt(last, 10, 64); err != nil {
+			return 0, 0, false
+		}
+		end = min(end, size-1)
+	}
+
+	if start < 0 || start > end || start >= size {
+		return 0, 0, false
+	}
+	return start, end, true
+}
+
+// mirrorResponse builds a plain-text response for requests the mirror cannot serve.
+func mirrorResponse(req *http.Request, status int, msg string) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {"text/plain; charset=utf-8"}},
+		Body:          io.NopCloser(strings.NewReader(msg)),
+		ContentLength: int64(len(msg)),
+		Request:       req,
+	}
+}
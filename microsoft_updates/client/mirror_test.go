@@ -0,0 +1,132 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeMirrorFile(t *testing.T, dir, name string, data []byte) {
+	t.Helper()
+	p := filepath.Join(dir, filepath.FromSlash(name))
+	require.NoError(t, os.MkdirAll(filepath.Dir(p), 0o755))
+	require.NoError(t, os.WriteFile(p, data, 0o644))
+}
+
+func TestWithOfflineMirror(t *testing.T) {
+	dir := t.TempDir()
+	writeMirrorFile(t, dir, "officecdnmac.microsoft.com/pr/abc/MacAutoupdate/MSWD2019.xml", []byte("<plist/>"))
+	writeMirrorFile(t, dir, "itunes.apple.com/search?entity=macSoftware&term=Word", []byte(`{"resultCount":0}`))
+	writeMirrorFile(t, dir, "learn.microsoft.com/officeupdates/index.html", []byte("<html/>"))
+
+	transport, err := NewTransport(WithOfflineMirror(dir), WithRetryCount(0))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, body, err := transport.NewRequest(ctx).GetBytes("https://officecdnmac.microsoft.com/pr/abc/MacAutoupdate/MSWD2019.xml")
+	require.NoError(t, err)
+	assert.Equal(t, "<plist/>", string(body))
+
+	_, body, err = transport.NewRequest(ctx).
+		SetQueryParam("entity", "macSoftware").
+		SetQueryParam("term", "Word").
+		GetBytes("https://itunes.apple.com/search")
+	require.NoError(t, err)
+	assert.Equal(t, `{"resultCount":0}`, string(body))
+
+	_, body, err = transport.NewRequest(ctx).GetBytes("https://learn.microsoft.com/officeupdates/")
+	require.NoError(t, err)
+	assert.Equal(t, "<html/>", string(body))
+
+	resp, _, err := transport.NewRequest(ctx).GetBytes("https://officecdnmac.microsoft.com/pr/abc/MacAutoupdate/XCEL2019.xml")
+	require.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode())
+}
+
+func TestWithOfflineMirror_RangeAndHead(t *testing.T) {
+	dir := t.TempDir()
+	data := []byte("0123456789")
+	writeMirrorFile(t, dir, "cdn.example.com/app.pkg", data)
+
+	transport, err := NewTransport(WithOfflineMirror(dir), WithRetryCount(0))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	head, err := transport.NewRequest(ctx).Head("https://cdn.example.com/app.pkg")
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), head.RawResponse.ContentLength)
+	assert.Equal(t, "bytes", head.Header().Get("Accept-Ranges"))
+
+	var buf bytes.Buffer
+	resp, n, err := transport.NewRequest(ctx).
+		SetHeader("Range", "bytes=3-6").
+		Download("https://cdn.example.com/app.pkg", &buf)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusPartialContent, resp.StatusCode())
+	assert.Equal(t, "bytes 3-6/10", resp.Header().Get("Content-Range"))
+	assert.Equal(t, int64(4), n)
+	assert.Equal(t, "3456", buf.String())
+}
+
+func TestWithOfflineMirror_MissingDirectory(t *testing.T) {
+	_, err := NewTransport(WithOfflineMirror(filepath.Join(t.TempDir(), "missing")))
+	assert.ErrorContains(t, err, "open offline mirror")
+}
+
+func TestParseRange(t *testing.T) {
+	tests := []struct {
+		header     string
+		start, end int64
+		ok         bool
+	}{
+		{"bytes=0-4", 0, 4, true},
+		{"bytes=5-", 5, 9, true},
+		{"bytes=-3", 7, 9, true},
+		{"bytes=8-100", 8, 9, true},
+		{"bytes=10-12", 0, 0, false},
+		{"bytes=0-1,4-5", 0, 0, false},
+		{"items=0-1", 0, 0, false},
+		{"", 0, 0, false},
+	}
+	for _, tt := range tests {
+		start, end, ok := parseRange(tt.header, 10)
+		assert.Equal(t, tt.ok, ok, tt.header)
+		if tt.ok {
+			assert.Equal(t, tt.start, start, tt.header)
+			assert.Equal(t, tt.end, end, tt.header)
+		}
+	}
+}
+
+func TestWithFeedURL(t *testing.T) {
+	transport, err := NewTransport(
+		WithFeedURL("https://officecdnmac.microsoft.com/pr/abc/", "https://mirror.example.com/office/"),
+		WithRetryCount(0),
+	)
+	require.NoError(t, err)
+
+	httpmock.ActivateNonDefault(transport.GetHTTPClient().Client())
+	t.Cleanup(httpmock.DeactivateAndReset)
+	httpmock.RegisterResponder("GET", "https://mirror.example.com/office/MacAutoupdate/MSWD2019.xml",
+		httpmock.NewStringResponder(http.StatusOK, "mirrored"))
+	httpmock.RegisterResponder("GET", "https://edgeupdates.microsoft.com/api/products/stable",
+		httpmock.NewStringResponder(http.StatusOK, "direct"))
+
+	_, body, err := transport.NewRequest(context.Background()).GetBytes("https://officecdnmac.microsoft.com/pr/abc/MacAutoupdate/MSWD2019.xml")
+	require.NoError(t, err)
+	assert.Equal(t, "mirrored", string(body))
+
+	_, body, err = transport.NewRequest(context.Background()).GetBytes("https://edgeupdates.microsoft.com/api/products/stable")
+	require.NoError(t, err)
+	assert.Equal(t, "direct", string(body))
+
+	_, err = NewTransport(WithFeedURL("", "https://mirror.example.com/"))
+	assert.Error(t, err)
+}
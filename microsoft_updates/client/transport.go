@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	"go.uber.org/zap"
@@ -20,6 +21,14 @@ type Transport struct {
 	httpClient   *resty.Client
 	logger       *zap.Logger
 	errorHandler *ErrorHandler
+	feedURLs     []feedURL
+	cache        *responseCache
+}
+
+// feedURL replaces the original URL prefix of a feed with a mirror's.
+type feedURL struct {
+	original    string
+	replacement string
 }
 
 // Ensure Transport implements Client interface.
@@ -53,6 +62,7 @@ func NewTransport(options ...ClientOption) (*Transport, error) {
 	}
 
 	httpClient.AddRequestMiddleware(func(c *resty.Client, req *resty.Request) error {
+		req.URL = transport.rewriteURL(req.URL)
 		transport.logger.Info("Microsoft Updates API request",
 			zap.String("method", req.Method),
 			zap.String("url", req.URL),
@@ -75,6 +85,17 @@ func NewTransport(options ...ClientOption) (*Transport, error) {
 	return transport, nil
 }
 
+// rewriteURL applies the first WithFeedURL override whose original prefix
+// matches rawURL.
+func (t *Transport) rewriteURL(rawURL string) string {
+	for _, f := range t.feedURLs {
+		if rest, ok := strings.CutPrefix(rawURL, f.original); ok {
+			return f.replacement + rest
+		}
+	}
+	return rawURL
+}
+
 // NewRequest returns a new RequestBuilder for constructing API requests.
 func (t *Transport) NewRequest(ctx context.Context) *RequestBuilder {
 	return &RequestBuilder{
@@ -108,17 +129,13 @@ func (t *Transport) Close() error {
 
 // execute implements requestExecutor — handles GET requests and error processing.
 func (t *Transport) execute(req *resty.Request, path string, result any) (*resty.Response, error) {
-	resp, err := req.Get(path)
+	resp, body, err := t.executeGetBytes(req, path)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
-	}
-
-	if resp.IsStatusFailure() {
-		return resp, t.errorHandler.HandleError(resp)
+		return resp, err
 	}
 
 	if result != nil {
-		if err := json.Unmarshal(resp.Bytes(), result); err != nil {
+		if err := json.Unmarshal(body, result); err != nil {
 			return resp, fmt.Errorf("failed to unmarshal response: %w", err)
 		}
 	}
@@ -127,7 +144,18 @@ func (t *Transport) execute(req *resty.Request, path string, result any) (*resty
 }
 
 // executeGetBytes implements requestExecutor — returns raw response bytes.
+// With WithCache configured, a fresh cached body is returned without a
+// network request, and successful responses are added to the cache.
 func (t *Transport) executeGetBytes(req *resty.Request, path string) (*resty.Response, []byte, error) {
+	var key string
+	if t.cache != nil {
+		key = cacheKey(req, path)
+		if entry, ok := t.cache.get(key); ok {
+			t.logger.Debug("Microsoft Updates API cache hit", zap.String("url", key))
+			return entry.response(req), entry.Body, nil
+		}
+	}
+
 	resp, err := req.Get(path)
 	if err != nil {
 		return nil, nil, fmt.Errorf("request failed: %w", err)
@@ -137,7 +165,20 @@ func (t *Transport) executeGetBytes(req *resty.Request, path string) (*resty.Res
 		return resp, nil, t.errorHandler.HandleError(resp)
 	}
 
-	return resp, resp.Bytes(), nil
+	body := resp.Bytes()
+	if t.cache != nil {
+		t.cache.put(key, resp.Header(), body)
+	}
+
+	return resp, body, nil
+}
+
+// ClearCache discards every cached response, in memory and on disk. It is a
+// no-op when caching is not configured.
+func (t *Transport) ClearCache() {
+	if t.cache != nil {
+		t.cache.clear()
+	}
 }
 
 // executeHead implements requestExecutor — issues a HEAD request and returns
@@ -4,6 +4,7 @@ import (
 	"crypto/tls"
 	"fmt"
 	"net/http"
+	"os"
 	"time"
 
 	"go.uber.org/zap"
@@ -198,3 +199,72 @@ func WithMinTLSVersion(minVersion uint16) ClientOption {
 		return nil
 	}
 }
+
+// WithFeedURL redirects every request whose URL starts with original to the
+// same path under replacement, e.g. to serve the production Office CDN
+// channel (constants.StandaloneCDNBaseURL) from an internal mirror. It may be
+// given several times; the first matching prefix wins.
+func WithFeedURL(original, replacement string) ClientOption {
+	return func(c *Transport) error {
+		if original == "" || replacement == "" {
+			return fmt.Errorf("feed URL and replacement cannot be empty")
+		}
+		c.feedURLs = append(c.feedURLs, feedURL{original: original, replacement: replacement})
+		c.logger.Info("Feed URL override configured",
+			zap.String("original", original),
+			zap.String("replacement", replacement))
+		return nil
+	}
+}
+
+// WithOfflineMirror serves every request from the local directory dir instead
+// of the network. A request for https://{host}/{path}?{query} is answered from
+// {dir}/{host}/{path}?{query} — the layout `wget --force-directories`
+// produces — and files missing from the mirror return 404. GET, HEAD and
+// single byte-range requests are supported.
+func WithOfflineMirror(dir string) ClientOption {
+	return func(c *Transport) error {
+		mirror, err := newMirrorTransport(dir)
+		if err != nil {
+			return err
+		}
+		c.httpClient.SetTransport(mirror)
+		c.logger.Info("Offline mirror configured", zap.String("dir", dir))
+		return nil
+	}
+}
+
+// WithCache caches the body of every successful GET response in memory for
+// ttl, so repeated lookups (e.g. GetPackageByNameV1 in a loop) reuse the feed
+// instead of refetching it. Downloads and HEAD requests are never cached, and
+// pollers such as Standalone.WatchV1 see new releases at most once per ttl.
+func WithCache(ttl time.Duration) ClientOption {
+	return func(c *Transport) error {
+		if ttl <= 0 {
+			return fmt.Errorf("cache TTL must be positive")
+		}
+		c.cache = newResponseCache(ttl, "")
+		c.logger.Info("Response cache configured", zap.Duration("ttl", ttl))
+		return nil
+	}
+}
+
+// WithDiskCache behaves like WithCache but also persists cached responses as
+// files in dir, so they are reused across process restarts until ttl expires.
+// The directory is created if it does not exist.
+func WithDiskCache(dir string, ttl time.Duration) ClientOption {
+	return func(c *Transport) error {
+		if ttl <= 0 {
+			return fmt.Errorf("cache TTL must be positive")
+		}
+		if dir == "" {
+			return fmt.Errorf("cache directory cannot be empty")
+		}
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			return fmt.Errorf("create cache directory: %w", err)
+		}
+		c.cache = newResponseCache(ttl, dir)
+		c.logger.Info("Response cache configured", zap.Duration("ttl", ttl), zap.String("dir", dir))
+		return nil
+	}
+}
@@ -80,6 +80,11 @@ func NewDefaultClient() (*Client, error) {
 	return NewClient()
 }
 
+// ClearCache discards every response cached by WithCache or WithDiskCache.
+func (c *Client) ClearCache() {
+	c.transport.ClearCache()
+}
+
 // Close releases resources held by the client.
 func (c *Client) Close() error {
 	return c.transport.Close()
@@ -0,0 +1,41 @@
+package edge
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/client"
+)
+
+// VerifyLinksV1 fetches the latest macOS release for every channel and issues
+// a HEAD request for each artifact Location, reporting dead links, redirect
+// targets and artifacts whose served size differs from SizeInBytes.
+//
+// HEAD {Artifacts[].Location}
+func (s *EdgeService) VerifyLinksV1(ctx context.Context) ([]EdgeLinkCheck, error) {
+	all, err := s.GetAllChannelsV1(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var checks []EdgeLinkCheck
+	for _, rel := range []*EdgeRelease{all.Stable, all.Beta, all.Dev, all.Canary} {
+		if rel == nil {
+			continue
+		}
+		for _, a := range rel.Artifacts {
+			if a.Location == "" {
+				continue
+			}
+			if err := ctx.Err(); err != nil {
+				return checks, err
+			}
+			checks = append(checks, EdgeLinkCheck{
+				Channel:      rel.Channel,
+				Version:      rel.Version,
+				ArtifactName: a.ArtifactName,
+				LinkCheck:    client.CheckLink(ctx, s.client, a.Location, a.SizeInBytes),
+			})
+		}
+	}
+	return checks, nil
+}
@@ -0,0 +1,40 @@
+package edge_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/microsoft_updates_api/edge"
+	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/microsoft_updates_api/edge/mocks"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyLinksV1(t *testing.T) {
+	svc := setupMockClient(t)
+	mocks.RegisterAllChannelsMock()
+	httpmock.RegisterResponder("HEAD",
+		"https://msedge.sf.dl.delivery.mp.microsoft.com/filestreamingservice/files/test/MicrosoftEdge-147.0.3912.72.pkg",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewBytesResponse(200, nil)
+			resp.ContentLength = 1000
+			resp.Request = req
+			return resp, nil
+		})
+
+	checks, err := svc.VerifyLinksV1(context.Background())
+	require.NoError(t, err)
+	require.Len(t, checks, 4)
+
+	channels := make([]string, 0, len(checks))
+	for _, c := range checks {
+		channels = append(channels, c.Channel)
+		assert.Equal(t, "pkg", c.ArtifactName)
+		assert.False(t, c.Dead)
+		assert.Equal(t, int64(452345678), c.ExpectedSize)
+		assert.True(t, c.SizeMismatch)
+	}
+	assert.Equal(t, []string{edge.ChannelStable, edge.ChannelBeta, edge.ChannelDev, edge.ChannelCanary}, channels)
+}
@@ -26,3 +26,15 @@ func RegisterErrorMock(url string) {
 		httpmock.NewStringResponder(500, `Internal Server Error`),
 	)
 }
+
+// RegisterAllChannelsMock registers the stable fixture for all four channel
+// endpoints.
+func RegisterAllChannelsMock() {
+	for _, channel := range []string{"stable", "beta", "dev", "canary"} {
+		httpmock.RegisterResponder(
+			"GET",
+			"https://edgeupdates.microsoft.com/api/products/"+channel,
+			httpmock.NewBytesResponder(200, stableJSON),
+		)
+	}
+}
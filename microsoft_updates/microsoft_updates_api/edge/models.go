@@ -1,5 +1,7 @@
 package edge
 
+import "github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/client"
+
 // EdgeAllChannelsResponse aggregates the latest macOS release for each Edge channel.
 type EdgeAllChannelsResponse struct {
 	Stable *EdgeRelease
@@ -67,3 +69,17 @@ type edgeAPIArtifact struct {
 	Hash         string `json:"Hash"`
 	HashAlgorithm string `json:"HashAlgorithm"`
 }
+
+// EdgeLinkCheck is the health of one artifact download link.
+type EdgeLinkCheck struct {
+	// Channel is the distribution channel the artifact belongs to.
+	Channel string
+
+	// Version is the Edge version the artifact installs.
+	Version string
+
+	// ArtifactName identifies the package type (e.g. "pkg").
+	ArtifactName string
+
+	client.LinkCheck
+}
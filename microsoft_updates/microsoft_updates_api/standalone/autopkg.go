@@ -0,0 +1,147 @@
+package standalone
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/plistenc"
+)
+
+// AutoPkg recipe types an override can extend.
+const (
+	AutoPkgDownload = "download"
+	AutoPkgPkg      = "pkg"
+)
+
+// autoPkgDefaultPrefix is the identifier prefix AutoPkg's make-override uses.
+const autoPkgDefaultPrefix = "local"
+
+// AutoPkgOptions configures ToAutoPkgOverride.
+type AutoPkgOptions struct {
+	// RecipeType is AutoPkgDownload or AutoPkgPkg. Defaults to AutoPkgDownload.
+	RecipeType string
+
+	// ParentRecipes maps application ID to the identifier of the download or
+	// pkg recipe the override extends (e.g. "com.example.download.MicrosoftWord").
+	ParentRecipes map[string]string
+
+	// IdentifierPrefix prefixes the override identifier. Defaults to "local".
+	IdentifierPrefix string
+}
+
+// AutoPkgOverride is an AutoPkg recipe override that pins a parent recipe to
+// one standalone package release. Plist renders it as the XML property list
+// AutoPkg reads from its RecipeOverrides directory.
+//
+// The parent recipe must consume the DOWNLOAD_URL, VERSION and SHA256 input
+// variables, e.g. by passing %DOWNLOAD_URL% to URLDownloader.
+type AutoPkgOverride struct {
+	Identifier   string       `plist:"Identifier"`
+	Input        AutoPkgInput `plist:"Input"`
+	ParentRecipe string       `plist:"ParentRecipe"`
+
+	recipeType string
+}
+
+// AutoPkgInput is the Input dictionary of an AutoPkgOverride.
+type AutoPkgInput struct {
+	Name        string  `plist:"NAME"`
+	DownloadURL string  `plist:"DOWNLOAD_URL"`
+	Version     string  `plist:"VERSION"`
+	SHA256      *string `plist:"SHA256,omitempty"`
+	BundleID    string  `plist:"BUNDLE_ID"`
+}
+
+// ToAutoPkgOverride renders p as an AutoPkg recipe override whose inputs pin
+// the full installer URL, its hex SHA-256 and the short version. A nil opts
+// uses the defaults described on AutoPkgOptions.
+//
+// Returns an error if opts names no parent recipe for p's application ID, the
+// recipe type is unknown, or the feed did not provide a short version or
+// download location.
+func (p *Package) ToAutoPkgOverride(opts *AutoPkgOptions) (*AutoPkgOverride, error) {
+	if opts == nil {
+		opts = &AutoPkgOptions{}
+	}
+
+	recipeType := opts.RecipeType
+	if recipeType == "" {
+		recipeType = AutoPkgDownload
+	}
+	if recipeType != AutoPkgDownload && recipeType != AutoPkgPkg {
+		return nil, fmt.Errorf("unknown AutoPkg recipe type %q", recipeType)
+	}
+	parent := opts.ParentRecipes[p.ApplicationID]
+	if parent == "" {
+		return nil, fmt.Errorf("no parent recipe configured for application ID %q", p.ApplicationID)
+	}
+	bundleID, ok := AppIDBundleMap[p.ApplicationID]
+	if !ok {
+		return nil, fmt.Errorf("no bundle ID known for application ID %q", p.ApplicationID)
+	}
+	if p.ShortVersion == "" {
+		return nil, fmt.Errorf("package %s has no short version", p.ApplicationID)
+	}
+	if p.Location == "" {
+		return nil, fmt.Errorf("package %s has no download location", p.ApplicationID)
+	}
+
+	prefix := opts.IdentifierPrefix
+	if prefix == "" {
+		prefix = autoPkgDefaultPrefix
+	}
+	name := p.Title
+	if name == "" {
+		name = AppNames[p.ApplicationID]
+	}
+
+	override := &AutoPkgOverride{
+		Identifier: prefix + "." + recipeType + "." + munkiName(name),
+		Input: AutoPkgInput{
+			Name:        munkiName(name),
+			DownloadURL: p.Location,
+			Version:     p.ShortVersion,
+			BundleID:    bundleID,
+		},
+		ParentRecipe: parent,
+		recipeType:   recipeType,
+	}
+	if p.HashSHA256 != "" {
+		sum, err := decodeSHA256(p.HashSHA256)
+		if err != nil {
+			return nil, fmt.Errorf("invalid SHA-256 in feed for %s: %w", p.ApplicationID, err)
+		}
+		hash := hex.EncodeToString(sum)
+		override.Input.SHA256 = &hash
+	}
+
+	return override, nil
+}
+
+// ToAutoPkgOverrides renders every package in r as an AutoPkg recipe
+// override, skipping packages that cannot be rendered (including those with
+// no parent recipe in opts).
+func (r *StandaloneResponse) ToAutoPkgOverrides(opts *AutoPkgOptions) []*AutoPkgOverride {
+	overrides := make([]*AutoPkgOverride, 0, len(r.Packages))
+	for _, pkg := range r.Packages {
+		if override, err := pkg.ToAutoPkgOverride(opts); err == nil {
+			overrides = append(overrides, override)
+		}
+	}
+	return overrides
+}
+
+// Filename returns the override's file name within RecipeOverrides
+// (e.g. "MicrosoftWord.download.recipe").
+func (a *AutoPkgOverride) Filename() string {
+	return a.Input.Name + "." + a.recipeType + ".recipe"
+}
+
+// Plist renders a as a recipe override property list document.
+func (a *AutoPkgOverride) Plist() ([]byte, error) {
+	fields, err := plistenc.Fields(a)
+	if err != nil {
+		return nil, err
+	}
+	return plistenc.Document(fields)
+}
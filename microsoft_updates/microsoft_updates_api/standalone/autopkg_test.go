@@ -0,0 +1,81 @@
+package standalone_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/constants"
+	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/microsoft_updates_api/standalone"
+	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/microsoft_updates_api/standalone/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToAutoPkgOverride(t *testing.T) {
+	svc, _ := setupMockClient(t)
+	mocks.RegisterWordMock(constants.StandaloneCDNBaseURL)
+
+	pkg, err := svc.GetPackageByApplicationIDV1(context.Background(), standalone.AppIDWord)
+	require.NoError(t, err)
+
+	override, err := pkg.ToAutoPkgOverride(&standalone.AutoPkgOptions{
+		ParentRecipes: map[string]string{standalone.AppIDWord: "com.example.download.MicrosoftWord"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "MicrosoftWord.download.recipe", override.Filename())
+
+	body, err := override.Plist()
+	require.NoError(t, err)
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>Identifier</key>
+	<string>local.download.MicrosoftWord</string>
+	<key>Input</key>
+	<dict>
+		<key>NAME</key>
+		<string>MicrosoftWord</string>
+		<key>DOWNLOAD_URL</key>
+		<string>https://officecdnmac.microsoft.com/pr/C1297A47-86C4-4C1F-97FA-950631F94777/MacAutoupdate/Microsoft_Word_16.108.26041915_Updater.pkg</string>
+		<key>VERSION</key>
+		<string>16.108.1</string>
+		<key>SHA256</key>
+		<string>e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855</string>
+		<key>BUNDLE_ID</key>
+		<string>com.microsoft.word</string>
+	</dict>
+	<key>ParentRecipe</key>
+	<string>com.example.download.MicrosoftWord</string>
+</dict>
+</plist>
+`, string(body))
+}
+
+func TestToAutoPkgOverride_Options(t *testing.T) {
+	pkg := &standalone.Package{
+		ApplicationID: standalone.AppIDExcel,
+		ShortVersion:  "16.108.1",
+		Location:      testPkgURL,
+	}
+	parents := map[string]string{standalone.AppIDExcel: "com.example.pkg.MicrosoftExcel"}
+
+	override, err := pkg.ToAutoPkgOverride(&standalone.AutoPkgOptions{
+		RecipeType:       standalone.AutoPkgPkg,
+		ParentRecipes:    parents,
+		IdentifierPrefix: "com.acme",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "com.acme.pkg.MicrosoftExcel", override.Identifier)
+	assert.Equal(t, "MicrosoftExcel.pkg.recipe", override.Filename())
+	assert.Nil(t, override.Input.SHA256)
+
+	_, err = pkg.ToAutoPkgOverride(nil)
+	assert.ErrorContains(t, err, "no parent recipe")
+
+	_, err = pkg.ToAutoPkgOverride(&standalone.AutoPkgOptions{RecipeType: "munki", ParentRecipes: parents})
+	assert.ErrorContains(t, err, "unknown AutoPkg recipe type")
+
+	resp := &standalone.StandaloneResponse{Packages: []*standalone.Package{pkg, {ApplicationID: standalone.AppIDWord}}}
+	assert.Len(t, resp.ToAutoPkgOverrides(&standalone.AutoPkgOptions{ParentRecipes: parents}), 1)
+}
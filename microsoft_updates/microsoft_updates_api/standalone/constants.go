@@ -22,6 +22,10 @@ const (
 	AppIDLicensing      = "OLIC02"
 )
 
+// MicrosoftTeamID is the Apple Developer team ID in the Developer ID
+// Installer certificate Microsoft signs its installer packages with.
+const MicrosoftTeamID = "UBF8T346G9"
+
 // Human-readable display names for each application ID.
 var AppNames = map[string]string{
 	AppIDWord:          "Microsoft Word",
@@ -105,3 +109,48 @@ var AppIDBundleMap = map[string]string{
 	AppIDRemoteHelp:    BundleIDRemoteHelp,
 	AppIDLicensing:     BundleIDLicensing,
 }
+
+// Category groups standalone applications by product family.
+type Category string
+
+// Product-family categories for standalone applications.
+const (
+	CategoryOffice        Category = "office"
+	CategoryCollaboration Category = "collaboration"
+	CategorySecurity      Category = "security"
+	CategoryManagement    Category = "management"
+	CategoryRemoteAccess  Category = "remote_access"
+	CategoryAI            Category = "ai"
+)
+
+// AppCategories maps application ID to its product-family category.
+var AppCategories = map[string]Category{
+	AppIDWord:          CategoryOffice,
+	AppIDExcel:         CategoryOffice,
+	AppIDPowerPoint:    CategoryOffice,
+	AppIDOutlook:       CategoryOffice,
+	AppIDOneNote:       CategoryOffice,
+	AppIDTeams:         CategoryCollaboration,
+	AppIDSkypeForBiz:   CategoryCollaboration,
+	AppIDDefenderEP:    CategorySecurity,
+	AppIDDefenderCons:  CategorySecurity,
+	AppIDDefenderShim:  CategorySecurity,
+	AppIDCompanyPortal: CategoryManagement,
+	AppIDAutoUpdate:    CategoryManagement,
+	AppIDLicensing:     CategoryManagement,
+	AppIDWindowsApp:    CategoryRemoteAccess,
+	AppIDQuickAssist:   CategoryRemoteAccess,
+	AppIDRemoteHelp:    CategoryRemoteAccess,
+	AppIDCopilot:       CategoryAI,
+}
+
+// AppIDsByCategory returns the application IDs in category, in AllAppIDs order.
+func AppIDsByCategory(category Category) []string {
+	var ids []string
+	for _, appID := range AllAppIDs {
+		if AppCategories[appID] == category {
+			ids = append(ids, appID)
+		}
+	}
+	return ids
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/xml"
 	"fmt"
+	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/client"
 	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/constants"
@@ -65,8 +66,62 @@ func (s *StandaloneService) fetchPackage(ctx context.Context, appID string) (*Pa
 //
 // GET https://officecdnmac.microsoft.com/pr/{channelUUID}/MacAutoupdate/{AppID}.xml
 func (s *StandaloneService) GetLatestV1(ctx context.Context) (*StandaloneResponse, error) {
+	return s.fetchPackages(ctx, AllAppIDs), nil
+}
+
+// GetPackagesByCategoryV1 fetches the latest metadata for the standalone
+// applications in one product family (e.g. CategoryOffice for the Office
+// suite, CategorySecurity for the Defender agents). Only that category's
+// per-app plists are requested.
+//
+// GET https://officecdnmac.microsoft.com/pr/{channelUUID}/MacAutoupdate/{AppID}.xml
+func (s *StandaloneService) GetPackagesByCategoryV1(ctx context.Context, category Category) (*StandaloneResponse, error) {
+	appIDs := AppIDsByCategory(category)
+	if len(appIDs) == 0 {
+		return nil, fmt.Errorf("unknown category %q", category)
+	}
+	return s.fetchPackages(ctx, appIDs), nil
+}
+
+// FindPackagesV1 fetches the latest metadata for all known standalone
+// applications and returns the packages for which match returns true.
+//
+// GET https://officecdnmac.microsoft.com/pr/{channelUUID}/MacAutoupdate/{AppID}.xml
+func (s *StandaloneService) FindPackagesV1(ctx context.Context, match func(*Package) bool) (*StandaloneResponse, error) {
+	if match == nil {
+		return nil, fmt.Errorf("match function is required")
+	}
+
+	all := s.fetchPackages(ctx, AllAppIDs)
 	resp := &StandaloneResponse{}
-	for _, appID := range AllAppIDs {
+	for _, pkg := range all.Packages {
+		if match(pkg) {
+			resp.Packages = append(resp.Packages, pkg)
+		}
+	}
+	return resp, nil
+}
+
+// GetPackagesUpdatedSinceV1 fetches the latest metadata for all known
+// standalone applications and returns the packages released on or after
+// since. The CDN publishes release dates without a time of day, so since is
+// compared by calendar day in UTC: a package released on the same day as since
+// is included. Packages without a valid Date are omitted.
+//
+// GET https://officecdnmac.microsoft.com/pr/{channelUUID}/MacAutoupdate/{AppID}.xml
+func (s *StandaloneService) GetPackagesUpdatedSinceV1(ctx context.Context, since time.Time) (*StandaloneResponse, error) {
+	day := since.UTC().Truncate(24 * time.Hour)
+	return s.FindPackagesV1(ctx, func(pkg *Package) bool {
+		released, err := pkg.ReleaseDate()
+		return err == nil && !released.Before(day)
+	})
+}
+
+// fetchPackages fetches each application ID in turn, logging and skipping
+// any that fail.
+func (s *StandaloneService) fetchPackages(ctx context.Context, appIDs []string) *StandaloneResponse {
+	resp := &StandaloneResponse{}
+	for _, appID := range appIDs {
 		pkg, err := s.fetchPackage(ctx, appID)
 		if err != nil {
 			s.client.GetLogger().Sugar().Warnf("skipping %s: %v", appID, err)
@@ -74,7 +129,7 @@ func (s *StandaloneService) GetLatestV1(ctx context.Context) (*StandaloneRespons
 		}
 		resp.Packages = append(resp.Packages, pkg)
 	}
-	return resp, nil
+	return resp
 }
 
 // GetPackageByApplicationIDV1 fetches the latest metadata for a single application
@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/client"
 	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/constants"
@@ -110,6 +111,109 @@ func TestGetLatestV1_PartialSuccess(t *testing.T) {
 	assert.Equal(t, "Microsoft Word", resp.Packages[0].Title)
 }
 
+func TestGetPackagesByCategoryV1(t *testing.T) {
+	svc, _ := setupMockClient(t)
+	mocks.RegisterWordMock(constants.StandaloneCDNBaseURL)
+	httpmock.RegisterNoResponder(httpmock.NewStringResponder(http.StatusNotFound, "not found"))
+
+	resp, err := svc.GetPackagesByCategoryV1(context.Background(), standalone.CategoryOffice)
+
+	require.NoError(t, err)
+	require.Len(t, resp.Packages, 1)
+	assert.Equal(t, standalone.CategoryOffice, resp.Packages[0].Category)
+	assert.Equal(t, 5, httpmock.GetTotalCallCount(), "only the Office apps should be requested")
+
+	_, err = svc.GetPackagesByCategoryV1(context.Background(), "games")
+	assert.ErrorContains(t, err, "unknown category")
+}
+
+func TestFindPackagesV1(t *testing.T) {
+	svc, _ := setupMockClient(t)
+	mocks.RegisterWordMock(constants.StandaloneCDNBaseURL)
+	httpmock.RegisterNoResponder(httpmock.NewStringResponder(http.StatusNotFound, "not found"))
+
+	resp, err := svc.FindPackagesV1(context.Background(), func(p *standalone.Package) bool {
+		return p.MinimumOS == "14.0"
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Packages, 1)
+	assert.Equal(t, standalone.AppIDWord, resp.Packages[0].ApplicationID)
+
+	resp, err = svc.FindPackagesV1(context.Background(), func(p *standalone.Package) bool {
+		return p.Category == standalone.CategorySecurity
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Packages)
+
+	_, err = svc.FindPackagesV1(context.Background(), nil)
+	assert.ErrorContains(t, err, "match function is required")
+}
+
+func TestGetPackagesUpdatedSinceV1(t *testing.T) {
+	svc, _ := setupMockClient(t)
+	mocks.RegisterWordMock(constants.StandaloneCDNBaseURL)
+	httpmock.RegisterNoResponder(httpmock.NewStringResponder(http.StatusNotFound, "not found"))
+
+	ctx := context.Background()
+
+	// The Word fixture is dated 04/19/2026.
+	resp, err := svc.GetPackagesUpdatedSinceV1(ctx, time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Len(t, resp.Packages, 1)
+	assert.Equal(t, standalone.AppIDWord, resp.Packages[0].ApplicationID)
+
+	resp, err = svc.GetPackagesUpdatedSinceV1(ctx, time.Date(2026, time.April, 19, 15, 30, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Len(t, resp.Packages, 1, "same-day releases are included")
+
+	resp, err = svc.GetPackagesUpdatedSinceV1(ctx, time.Date(2026, time.April, 20, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Empty(t, resp.Packages)
+}
+
+func TestAppCategories(t *testing.T) {
+	for _, appID := range standalone.AllAppIDs {
+		assert.NotEmpty(t, standalone.AppCategories[appID], "application ID %s has no category", appID)
+	}
+	assert.Equal(t, []string{standalone.AppIDDefenderEP, standalone.AppIDDefenderCons, standalone.AppIDDefenderShim},
+		standalone.AppIDsByCategory(standalone.CategorySecurity))
+}
+
+func TestWatchV1_EmitsUpdateEvent(t *testing.T) {
+	svc, _ := setupMockClient(t)
+	mocks.RegisterWordUpdateMock(constants.StandaloneCDNBaseURL)
+	httpmock.RegisterNoResponder(httpmock.NewStringResponder(http.StatusNotFound, "not found"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := svc.WatchV1(ctx, 10*time.Millisecond)
+	require.NoError(t, err)
+
+	select {
+	case event := <-events:
+		assert.Equal(t, standalone.AppIDWord, event.ApplicationID)
+		assert.Equal(t, "Microsoft Word", event.Title)
+		assert.Equal(t, "16.108.1", event.OldVersion)
+		assert.Equal(t, "16.109", event.NewVersion)
+		assert.Equal(t, "16.109.26051012", event.Current.FullVersion)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for update event")
+	}
+
+	cancel()
+	for range events {
+	}
+}
+
+func TestWatchV1_InvalidInterval(t *testing.T) {
+	svc, _ := setupMockClient(t)
+
+	_, err := svc.WatchV1(context.Background(), 0)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "interval must be positive")
+}
+
 func TestAppIDConstants(t *testing.T) {
 	assert.Equal(t, "MSWD2019", standalone.AppIDWord)
 	assert.Equal(t, "XCEL2019", standalone.AppIDExcel)
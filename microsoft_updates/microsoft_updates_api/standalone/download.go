@@ -0,0 +1,400 @@
+package standalone
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DownloadPackageV1 downloads the full installer for pkg into destDir and
+// verifies it against the metadata published in the CDN feed.
+//
+// The installer is streamed to temporary ".part" files next to its final
+// location — the file is never held in memory — and moved into place only
+// after every byte range is complete and the SHA-256 digest matches
+// pkg.HashSHA256. On a checksum mismatch the partial files are removed.
+//
+// When the CDN reports the file size and accepts byte ranges, the download is
+// split into opts.Segments ranged requests fetched in parallel, and with
+// opts.Resume set an interrupted download continues from the bytes already on
+// disk. Otherwise the file is fetched in a single request and its length is
+// checked against the response Content-Length. A nil opts downloads in a single
+// segment without resuming.
+//
+// The feed's SHA-256 may be hex or base64 encoded; both are accepted. When the
+// feed provides no SHA-256 the file is kept and DownloadResult.Verified is false.
+//
+// With opts.Signature set, the installer's Developer ID signature is verified
+// as well, which authenticates a package fetched from a mirror even when the
+// feed it came with cannot be trusted. A package that fails is removed.
+//
+// GET {pkg.Location}
+func (s *StandaloneService) DownloadPackageV1(ctx context.Context, pkg *Package, destDir string, opts *DownloadOptions) (*DownloadResult, error) {
+	if pkg == nil || pkg.Location == "" {
+		return nil, fmt.Errorf("package with a download location is required")
+	}
+	if destDir == "" {
+		return nil, fmt.Errorf("destination directory is required")
+	}
+	if opts == nil {
+		opts = &DownloadOptions{}
+	}
+
+	var expected []byte
+	if pkg.HashSHA256 != "" {
+		var err error
+		if expected, err = decodeSHA256(pkg.HashSHA256); err != nil {
+			return nil, fmt.Errorf("invalid SHA-256 in feed for %s: %w", pkg.ApplicationID, err)
+		}
+	}
+
+	u, err := url.Parse(pkg.Location)
+	if err != nil {
+		return nil, fmt.Errorf("invalid download location %q: %w", pkg.Location, err)
+	}
+	name := path.Base(u.Path)
+	if name == "." || name == "/" {
+		return nil, fmt.Errorf("download location %q has no file name", pkg.Location)
+	}
+
+	if err := os.MkdirAll(destDir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create destination directory: %w", err)
+	}
+	destPath := filepath.Join(destDir, name)
+	partPath := destPath + ".part"
+
+	if !opts.Resume {
+		removeParts(partPath)
+	}
+
+	start := time.Now()
+	size, ranged := s.probe(ctx, pkg.Location)
+	progress := newProgress(size, opts.Progress)
+
+	var sum []byte
+	var n int64
+	if ranged {
+		n, sum, err = s.downloadSegments(ctx, pkg.Location, partPath, size, opts.segments(), progress)
+	} else {
+		n, sum, err = s.downloadStream(ctx, pkg.Location, partPath, progress)
+	}
+	if err != nil {
+		if !opts.Resume {
+			removeParts(partPath)
+		}
+		return nil, fmt.Errorf("download %s: %w", pkg.ApplicationID, err)
+	}
+
+	if expected != nil && !bytes.Equal(expected, sum) {
+		removeParts(partPath)
+		return nil, fmt.Errorf("checksum mismatch for %s: expected sha256=%s, got sha256=%s",
+			pkg.ApplicationID, hex.EncodeToString(expected), hex.EncodeToString(sum))
+	}
+
+	var signature *PackageSignature
+	if opts.Signature != nil {
+		if signature, err = VerifyPackageSignature(partPath, opts.Signature); err != nil {
+			removeParts(partPath)
+			return nil, fmt.Errorf("signature check for %s failed: %w", pkg.ApplicationID, err)
+		}
+	}
+
+	if err := os.Rename(partPath, destPath); err != nil {
+		removeParts(partPath)
+		return nil, fmt.Errorf("failed to move download into place: %w", err)
+	}
+	removeParts(partPath)
+
+	return &DownloadResult{
+		ApplicationID: pkg.ApplicationID,
+		Version:       pkg.ShortVersion,
+		URL:           pkg.Location,
+		DestPath:      destPath,
+		BytesWritten:  n,
+		SHA256:        hex.EncodeToString(sum),
+		Duration:      time.Since(start),
+		Verified:      expected != nil,
+		Signature:     signature,
+	}, nil
+}
+
+// DownloadPackagesV1 downloads the full installers for pkgs into destDir,
+// running at most concurrency downloads at a time (1 if concurrency < 1).
+// Each download behaves as DownloadPackageV1 with the same opts.
+//
+// Results are returned in the same order as pkgs; a failed download is
+// reported in its result and does not stop the others.
+func (s *StandaloneService) DownloadPackagesV1(ctx context.Context, pkgs []*Package, destDir string, concurrency int, opts *DownloadOptions) []PackageDownload {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	results := make([]PackageDownload, len(pkgs))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, pkg := range pkgs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			result, err := s.DownloadPackageV1(ctx, pkg, destDir, opts)
+			results[i] = PackageDownload{Package: pkg, Result: result, Err: err}
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
+
+// probe issues a HEAD request for rawURL and reports the file size and whether
+// the CDN accepts byte-range requests. Any failure is treated as an unknown
+// size without range support, so the caller falls back to a single GET.
+func (s *StandaloneService) probe(ctx context.Context, rawURL string) (int64, bool) {
+	resp, err := s.client.NewRequest(ctx).Head(rawURL)
+	if err != nil || resp == nil || resp.RawResponse == nil {
+		return 0, false
+	}
+	size := resp.RawResponse.ContentLength
+	if size <= 0 {
+		return 0, false
+	}
+	return size, strings.EqualFold(resp.Header().Get("Accept-Ranges"), "bytes")
+}
+
+// downloadStream fetches rawURL in a single request into partPath, replacing
+// any previous content, and checks the length against Content-Length.
+func (s *StandaloneService) downloadStream(ctx context.Context, rawURL, partPath string, progress *progress) (int64, []byte, error) {
+	f, err := os.Create(partPath)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to create destination file: %w", err)
+	}
+
+	h := sha256.New()
+	resp, n, err := s.client.NewRequest(ctx).Download(rawURL, io.MultiWriter(f, h, progress))
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return n, nil, err
+	}
+
+	if resp != nil && resp.RawResponse != nil && resp.RawResponse.ContentLength >= 0 && resp.RawResponse.ContentLength != n {
+		return n, nil, fmt.Errorf("received %d bytes, Content-Length was %d", n, resp.RawResponse.ContentLength)
+	}
+
+	return n, h.Sum(nil), nil
+}
+
+// downloadSegments fetches size bytes of rawURL as parallel ranged requests,
+// one part file per segment, then joins the segments into partPath while
+// hashing them.
+func (s *StandaloneService) downloadSegments(ctx context.Context, rawURL, partPath string, size int64, segments int, progress *progress) (int64, []byte, error) {
+	ranges := splitRanges(size, segments)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// The first failing segment cancels the rest; only its error is reported.
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	for _, r := range ranges {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := s.downloadRange(ctx, rawURL, r.path(partPath), r, progress); err != nil {
+				errOnce.Do(func() { firstErr = err })
+				cancel()
+			}
+		}()
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return 0, nil, firstErr
+	}
+
+	out, err := os.Create(partPath)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to create destination file: %w", err)
+	}
+	h := sha256.New()
+	w := io.MultiWriter(out, h)
+
+	var n int64
+	for _, r := range ranges {
+		in, err := os.Open(r.path(partPath))
+		if err != nil {
+			out.Close()
+			return n, nil, fmt.Errorf("failed to open segment: %w", err)
+		}
+		copied, err := io.Copy(w, in)
+		in.Close()
+		n += copied
+		if err != nil {
+			out.Close()
+			return n, nil, fmt.Errorf("failed to join segments: %w", err)
+		}
+	}
+	if err := out.Close(); err != nil {
+		return n, nil, err
+	}
+
+	return n, h.Sum(nil), nil
+}
+
+// downloadRange fetches the bytes of r into segPath, continuing from whatever
+// the file already holds.
+func (s *StandaloneService) downloadRange(ctx context.Context, rawURL, segPath string, r byteRange, progress *progress) error {
+	f, err := os.OpenFile(segPath, os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to create segment file: %w", err)
+	}
+	defer f.Close()
+
+	have, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	if have > r.length() {
+		if err := f.Truncate(0); err != nil {
+			return err
+		}
+		if have, err = f.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+	}
+	progress.add(have)
+
+	remaining := r.length() - have
+	if remaining == 0 {
+		return nil
+	}
+
+	w := &limitedWriter{w: io.MultiWriter(f, progress), remaining: remaining}
+	resp, n, err := s.client.NewRequest(ctx).
+		SetHeader("Range", fmt.Sprintf("bytes=%d-%d", r.start+have, r.end)).
+		Download(rawURL, w)
+	if err != nil {
+		return err
+	}
+	if resp != nil && resp.StatusCode() != http.StatusPartialContent {
+		return fmt.Errorf("range %d-%d: expected status 206, got %d", r.start, r.end, resp.StatusCode())
+	}
+	if n != remaining {
+		return fmt.Errorf("range %d-%d: received %d bytes, expected %d", r.start, r.end, n, remaining)
+	}
+
+	return nil
+}
+
+// byteRange is an inclusive range of byte offsets within a file.
+type byteRange struct {
+	start, end int64
+}
+
+func (r byteRange) length() int64 { return r.end - r.start + 1 }
+
+// path returns the part file that holds this range. The offsets are part of the
+// name, so a resumed download only reuses segments with identical boundaries.
+func (r byteRange) path(partPath string) string {
+	return fmt.Sprintf("%s.%d-%d", partPath, r.start, r.end)
+}
+
+// splitRanges divides size bytes into at most n contiguous ranges.
+func splitRanges(size int64, n int) []byteRange {
+	if int64(n) > size {
+		n = int(size)
+	}
+	chunk := (size + int64(n) - 1) / int64(n)
+
+	var ranges []byteRange
+	for start := int64(0); start < size; start += chunk {
+		ranges = append(ranges, byteRange{start: start, end: min(start+chunk, size) - 1})
+	}
+	return ranges
+}
+
+// removeParts deletes partPath and any segment files derived from it.
+func removeParts(partPath string) {
+	os.Remove(partPath)
+	segments, _ := filepath.Glob(globEscape(partPath) + ".*-*")
+	for _, segment := range segments {
+		os.Remove(segment)
+	}
+}
+
+// globEscape escapes the filepath.Match metacharacters in s.
+func globEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `*`, `\*`, `?`, `\?`, `[`, `\[`).Replace(s)
+}
+
+// limitedWriter fails once more than remaining bytes are written, so a server
+// that ignores the Range header cannot write past the end of a segment.
+type limitedWriter struct {
+	w         io.Writer
+	remaining int64
+}
+
+func (lw *limitedWriter) Write(p []byte) (int, error) {
+	if int64(len(p)) > lw.remaining {
+		return 0, fmt.Errorf("server sent more bytes than the requested range")
+	}
+	n, err := lw.w.Write(p)
+	lw.remaining -= int64(n)
+	return n, err
+}
+
+// progress aggregates bytes written across segments and forwards the running
+// total to an optional ProgressFunc, one call at a time.
+type progress struct {
+	mu      sync.Mutex
+	written int64
+	total   int64
+	fn      ProgressFunc
+}
+
+func newProgress(total int64, fn ProgressFunc) *progress {
+	return &progress{total: total, fn: fn}
+}
+
+func (p *progress) Write(b []byte) (int, error) {
+	p.add(int64(len(b)))
+	return len(b), nil
+}
+
+func (p *progress) add(n int64) {
+	if p.fn == nil || n == 0 {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.written += n
+	p.fn(p.written, p.total)
+}
+
+// decodeSHA256 decodes a SHA-256 digest published as either hex or base64.
+func decodeSHA256(s string) ([]byte, error) {
+	s = strings.TrimSpace(s)
+	if b, err := hex.DecodeString(s); err == nil && len(b) == sha256.Size {
+		return b, nil
+	}
+	if b, err := base64.StdEncoding.DecodeString(s); err == nil && len(b) == sha256.Size {
+		return b, nil
+	}
+	return nil, fmt.Errorf("%q is not a hex or base64 SHA-256 digest", s)
+}
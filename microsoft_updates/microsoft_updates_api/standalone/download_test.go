@@ -0,0 +1,233 @@
+package standalone_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/microsoft_updates_api/standalone"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPkgURL = "https://officecdnmac.microsoft.com/pr/test/MacAutoupdate/Microsoft_Word_16.108_Updater.pkg"
+
+var testPkgBody = []byte("xar! not really a pkg")
+
+func testPackage(sha256Value string) *standalone.Package {
+	return &standalone.Package{
+		ApplicationID: standalone.AppIDWord,
+		ShortVersion:  "16.108.1",
+		Location:      testPkgURL,
+		HashSHA256:    sha256Value,
+	}
+}
+
+func registerPkg(contentLength int64) {
+	httpmock.RegisterResponder("GET", testPkgURL, func(*http.Request) (*http.Response, error) {
+		resp := httpmock.NewBytesResponse(http.StatusOK, testPkgBody)
+		resp.ContentLength = contentLength
+		return resp, nil
+	})
+}
+
+func TestDownloadPackageV1_Verified(t *testing.T) {
+	sum := sha256.Sum256(testPkgBody)
+
+	for name, digest := range map[string]string{
+		"hex":    hex.EncodeToString(sum[:]),
+		"base64": base64.StdEncoding.EncodeToString(sum[:]),
+	} {
+		t.Run(name, func(t *testing.T) {
+			svc, _ := setupMockClient(t)
+			registerPkg(int64(len(testPkgBody)))
+			dir := t.TempDir()
+
+			result, err := svc.DownloadPackageV1(context.Background(), testPackage(digest), dir, nil)
+
+			require.NoError(t, err)
+			assert.True(t, result.Verified)
+			assert.Equal(t, filepath.Join(dir, "Microsoft_Word_16.108_Updater.pkg"), result.DestPath)
+			assert.Equal(t, int64(len(testPkgBody)), result.BytesWritten)
+			assert.Equal(t, hex.EncodeToString(sum[:]), result.SHA256)
+			assert.Equal(t, "16.108.1", result.Version)
+
+			data, err := os.ReadFile(result.DestPath)
+			require.NoError(t, err)
+			assert.Equal(t, testPkgBody, data)
+		})
+	}
+}
+
+func TestDownloadPackageV1_NoChecksumInFeed(t *testing.T) {
+	svc, _ := setupMockClient(t)
+	registerPkg(-1)
+
+	result, err := svc.DownloadPackageV1(context.Background(), testPackage(""), t.TempDir(), nil)
+
+	require.NoError(t, err)
+	assert.False(t, result.Verified)
+	assert.FileExists(t, result.DestPath)
+}
+
+func TestDownloadPackageV1_ChecksumMismatch(t *testing.T) {
+	svc, _ := setupMockClient(t)
+	registerPkg(int64(len(testPkgBody)))
+	dir := t.TempDir()
+	other := sha256.Sum256([]byte("something else"))
+
+	_, err := svc.DownloadPackageV1(context.Background(), testPackage(hex.EncodeToString(other[:])), dir, nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "checksum mismatch")
+	entries, _ := os.ReadDir(dir)
+	assert.Empty(t, entries, "partial download should be removed")
+}
+
+func TestDownloadPackageV1_ContentLengthMismatch(t *testing.T) {
+	svc, _ := setupMockClient(t)
+	registerPkg(int64(len(testPkgBody)) + 100)
+	dir := t.TempDir()
+
+	_, err := svc.DownloadPackageV1(context.Background(), testPackage(""), dir, nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Content-Length")
+	entries, _ := os.ReadDir(dir)
+	assert.Empty(t, entries)
+}
+
+func TestDownloadPackageV1_Validation(t *testing.T) {
+	svc, _ := setupMockClient(t)
+
+	_, err := svc.DownloadPackageV1(context.Background(), nil, t.TempDir(), nil)
+	assert.ErrorContains(t, err, "download location is required")
+
+	_, err = svc.DownloadPackageV1(context.Background(), testPackage(""), "", nil)
+	assert.ErrorContains(t, err, "destination directory is required")
+
+	_, err = svc.DownloadPackageV1(context.Background(), testPackage("not-a-digest"), t.TempDir(), nil)
+	assert.ErrorContains(t, err, "invalid SHA-256")
+}
+
+// registerRangedPkg serves body at rawURL with HEAD and byte-range support,
+// recording the Range header of every GET.
+func registerRangedPkg(rawURL string, body []byte, ranges *[]string) {
+	var mu sync.Mutex
+	httpmock.RegisterResponder("HEAD", rawURL, func(*http.Request) (*http.Response, error) {
+		resp := httpmock.NewBytesResponse(http.StatusOK, nil)
+		resp.ContentLength = int64(len(body))
+		resp.Header.Set("Accept-Ranges", "bytes")
+		return resp, nil
+	})
+	httpmock.RegisterResponder("GET", rawURL, func(req *http.Request) (*http.Response, error) {
+		header := req.Header.Get("Range")
+		mu.Lock()
+		*ranges = append(*ranges, header)
+		mu.Unlock()
+
+		bounds := strings.SplitN(strings.TrimPrefix(header, "bytes="), "-", 2)
+		start, _ := strconv.Atoi(bounds[0])
+		end, _ := strconv.Atoi(bounds[1])
+		resp := httpmock.NewBytesResponse(http.StatusPartialContent, body[start:end+1])
+		resp.ContentLength = int64(end - start + 1)
+		return resp, nil
+	})
+}
+
+func TestDownloadPackageV1_Segmented(t *testing.T) {
+	svc, _ := setupMockClient(t)
+	var ranges []string
+	registerRangedPkg(testPkgURL, testPkgBody, &ranges)
+	dir := t.TempDir()
+	sum := sha256.Sum256(testPkgBody)
+
+	var last, total int64
+	result, err := svc.DownloadPackageV1(context.Background(), testPackage(hex.EncodeToString(sum[:])), dir, &standalone.DownloadOptions{
+		Segments: 4,
+		Progress: func(written, expected int64) { last, total = written, expected },
+	})
+
+	require.NoError(t, err)
+	assert.True(t, result.Verified)
+	assert.Len(t, ranges, 4)
+	assert.Equal(t, int64(len(testPkgBody)), last)
+	assert.Equal(t, int64(len(testPkgBody)), total)
+
+	data, err := os.ReadFile(result.DestPath)
+	require.NoError(t, err)
+	assert.Equal(t, testPkgBody, data)
+
+	entries, _ := os.ReadDir(dir)
+	assert.Len(t, entries, 1, "segment files should be removed")
+}
+
+func TestDownloadPackageV1_Resume(t *testing.T) {
+	svc, _ := setupMockClient(t)
+	var ranges []string
+	registerRangedPkg(testPkgURL, testPkgBody, &ranges)
+	dir := t.TempDir()
+	sum := sha256.Sum256(testPkgBody)
+
+	// Simulate an interrupted single-segment download holding the first 5 bytes.
+	end := len(testPkgBody) - 1
+	segment := filepath.Join(dir, "Microsoft_Word_16.108_Updater.pkg.part.0-"+strconv.Itoa(end))
+	require.NoError(t, os.WriteFile(segment, testPkgBody[:5], 0o644))
+
+	result, err := svc.DownloadPackageV1(context.Background(), testPackage(hex.EncodeToString(sum[:])), dir, &standalone.DownloadOptions{Resume: true})
+
+	require.NoError(t, err)
+	assert.True(t, result.Verified)
+	assert.Equal(t, []string{"bytes=5-" + strconv.Itoa(end)}, ranges)
+
+	data, err := os.ReadFile(result.DestPath)
+	require.NoError(t, err)
+	assert.Equal(t, testPkgBody, data)
+}
+
+func TestDownloadPackageV1_RangeIgnored(t *testing.T) {
+	svc, _ := setupMockClient(t)
+	httpmock.RegisterResponder("HEAD", testPkgURL, func(*http.Request) (*http.Response, error) {
+		resp := httpmock.NewBytesResponse(http.StatusOK, nil)
+		resp.ContentLength = int64(len(testPkgBody))
+		resp.Header.Set("Accept-Ranges", "bytes")
+		return resp, nil
+	})
+	registerPkg(int64(len(testPkgBody)))
+	dir := t.TempDir()
+
+	_, err := svc.DownloadPackageV1(context.Background(), testPackage(""), dir, &standalone.DownloadOptions{Segments: 2})
+
+	require.Error(t, err)
+	entries, _ := os.ReadDir(dir)
+	assert.Empty(t, entries, "partial segments should be removed without Resume")
+}
+
+func TestDownloadPackagesV1(t *testing.T) {
+	svc, _ := setupMockClient(t)
+	registerPkg(int64(len(testPkgBody)))
+	otherURL := strings.Replace(testPkgURL, "Word", "Excel", 1)
+	httpmock.RegisterResponder("GET", otherURL, httpmock.NewStringResponder(http.StatusNotFound, "not found"))
+
+	missing := testPackage("")
+	missing.Location = otherURL
+	pkgs := []*standalone.Package{testPackage(""), missing}
+
+	results := svc.DownloadPackagesV1(context.Background(), pkgs, t.TempDir(), 2, nil)
+
+	require.Len(t, results, 2)
+	assert.Same(t, pkgs[0], results[0].Package)
+	assert.NoError(t, results[0].Err)
+	assert.NotNil(t, results[0].Result)
+	assert.Same(t, pkgs[1], results[1].Package)
+	assert.Error(t, results[1].Err)
+}
@@ -0,0 +1,134 @@
+package standalone
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/plistdec"
+	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/version"
+)
+
+// DefaultApplicationsDir is the directory ReadInstalledVersions scans when no
+// directories are given.
+const DefaultApplicationsDir = "/Applications"
+
+// VersionComparison reports how an installed application compares with the
+// latest package on the CDN.
+type VersionComparison struct {
+	// ApplicationID is the Microsoft CDN application identifier (e.g. "MSWD2019").
+	ApplicationID string
+
+	// Title is the human-readable application name.
+	Title string
+
+	// BundleID is the bundle identifier as reported by the installed app.
+	BundleID string
+
+	// InstalledVersion is the installed CFBundleShortVersionString.
+	InstalledVersion string
+
+	// LatestVersion is the short version of the latest CDN package.
+	LatestVersion string
+
+	// Outdated is true when InstalledVersion is older than LatestVersion.
+	Outdated bool
+
+	// VersionsBehind is the difference in the first version component that
+	// differs, e.g. 3 for 16.105.2 → 16.108.1 (three monthly Office releases).
+	// It is 0 when the installed version is current or newer.
+	VersionsBehind int
+
+	// Package is the latest CDN package.
+	Package *Package
+}
+
+// infoPlist holds the Info.plist keys ReadInstalledVersions needs.
+type infoPlist struct {
+	BundleID     string `plist:"CFBundleIdentifier"`
+	ShortVersion string `plist:"CFBundleShortVersionString"`
+}
+
+// ReadInstalledVersions scans the .app bundles directly inside each of dirs
+// (DefaultApplicationsDir if none are given) and returns a map of bundle ID to
+// CFBundleShortVersionString, suitable for CompareInstalled.
+//
+// Bundles without a readable XML Info.plist are skipped. A directory that does
+// not exist is an error.
+func ReadInstalledVersions(dirs ...string) (map[string]string, error) {
+	if len(dirs) == 0 {
+		dirs = []string{DefaultApplicationsDir}
+	}
+
+	installed := make(map[string]string)
+	for _, dir := range dirs {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil, fmt.Errorf("read applications directory: %w", err)
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() || filepath.Ext(entry.Name()) != ".app" {
+				continue
+			}
+			data, err := os.ReadFile(filepath.Join(dir, entry.Name(), "Contents", "Info.plist"))
+			if err != nil {
+				continue
+			}
+			var info infoPlist
+			if err := plistdec.Unmarshal(data, &info); err != nil || info.BundleID == "" {
+				continue
+			}
+			installed[info.BundleID] = info.ShortVersion
+		}
+	}
+	return installed, nil
+}
+
+// CompareInstalled compares the packages in r with installed, a map of bundle
+// ID to installed version such as ReadInstalledVersions returns. Bundle IDs
+// match case-insensitively. Packages that are not installed are omitted.
+func (r *StandaloneResponse) CompareInstalled(installed map[string]string) []VersionComparison {
+	byBundleID := make(map[string]string, len(installed))
+	for bundleID := range installed {
+		byBundleID[strings.ToLower(bundleID)] = bundleID
+	}
+
+	var comparisons []VersionComparison
+	for _, pkg := range r.Packages {
+		bundleID, ok := byBundleID[strings.ToLower(AppIDBundleMap[pkg.ApplicationID])]
+		if !ok {
+			continue
+		}
+		version := installed[bundleID]
+		behind := versionsBehind(version, pkg.ShortVersion)
+		comparisons = append(comparisons, VersionComparison{
+			ApplicationID:    pkg.ApplicationID,
+			Title:            pkg.Title,
+			BundleID:         bundleID,
+			InstalledVersion: version,
+			LatestVersion:    pkg.ShortVersion,
+			Outdated:         behind > 0,
+			VersionsBehind:   behind,
+			Package:          pkg,
+		})
+	}
+	return comparisons
+}
+
+// versionsBehind returns how far installed trails latest in the first
+// component where they differ, or 0 if installed is current, newer or
+// unparseable.
+func versionsBehind(installed, latest string) int {
+	a, errA := version.Parse(installed)
+	b, errB := version.Parse(latest)
+	if errA != nil || errB != nil || !a.OlderThan(b) {
+		return 0
+	}
+	for i := range max(len(a.Segments), len(b.Segments)) {
+		if d := b.Segment(i) - a.Segment(i); d != 0 {
+			return d
+		}
+	}
+	return 0
+}
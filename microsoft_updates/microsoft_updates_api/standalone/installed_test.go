@@ -0,0 +1,82 @@
+package standalone_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/microsoft_updates_api/standalone"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeApp(t *testing.T, dir, name, infoPlist string) {
+	t.Helper()
+	contents := filepath.Join(dir, name, "Contents")
+	require.NoError(t, os.MkdirAll(contents, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(contents, "Info.plist"), []byte(infoPlist), 0o644))
+}
+
+func TestReadInstalledVersions(t *testing.T) {
+	dir := t.TempDir()
+	writeApp(t, dir, "Microsoft Word.app", `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>CFBundleIdentifier</key>
+	<string>com.microsoft.Word</string>
+	<key>CFBundleShortVersionString</key>
+	<string>16.105.2</string>
+	<key>LSMinimumSystemVersion</key>
+	<string>14.0</string>
+</dict>
+</plist>`)
+	writeApp(t, dir, "Binary.app", "bplist00\x00\x01")
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "Utilities"), 0o755))
+
+	installed, err := standalone.ReadInstalledVersions(dir)
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"com.microsoft.Word": "16.105.2"}, installed)
+
+	_, err = standalone.ReadInstalledVersions(filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+}
+
+func TestCompareInstalled(t *testing.T) {
+	resp := &standalone.StandaloneResponse{Packages: []*standalone.Package{
+		{ApplicationID: standalone.AppIDWord, Title: "Microsoft Word", ShortVersion: "16.108.1"},
+		{ApplicationID: standalone.AppIDExcel, Title: "Microsoft Excel", ShortVersion: "16.108.1"},
+		{ApplicationID: standalone.AppIDOutlook, Title: "Microsoft Outlook", ShortVersion: "16.108.1"},
+		{ApplicationID: standalone.AppIDTeams, Title: "Microsoft Teams", ShortVersion: "25290.205"},
+		{ApplicationID: standalone.AppIDOneNote, Title: "Microsoft OneNote", ShortVersion: "16.108.1"},
+	}}
+
+	comparisons := resp.CompareInstalled(map[string]string{
+		"com.microsoft.Word":        "16.105.2",
+		"com.microsoft.Excel":       "16.108.1",
+		"com.microsoft.Outlook":     "16.108",
+		"com.microsoft.teams2":      "25300.100",
+		"com.example.unrelated.app": "1.0",
+	})
+
+	require.Len(t, comparisons, 4)
+	byID := make(map[string]standalone.VersionComparison)
+	for _, c := range comparisons {
+		byID[c.ApplicationID] = c
+	}
+
+	word := byID[standalone.AppIDWord]
+	assert.Equal(t, "com.microsoft.Word", word.BundleID)
+	assert.True(t, word.Outdated)
+	assert.Equal(t, 3, word.VersionsBehind)
+	assert.Equal(t, "16.108.1", word.LatestVersion)
+
+	assert.False(t, byID[standalone.AppIDExcel].Outdated)
+	assert.Equal(t, 0, byID[standalone.AppIDExcel].VersionsBehind)
+
+	assert.True(t, byID[standalone.AppIDOutlook].Outdated)
+	assert.Equal(t, 1, byID[standalone.AppIDOutlook].VersionsBehind)
+
+	assert.False(t, byID[standalone.AppIDTeams].Outdated, "newer installed builds are not outdated")
+}
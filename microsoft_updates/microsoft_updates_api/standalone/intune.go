@@ -0,0 +1,137 @@
+package standalone
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// Microsoft Graph OData types used in Intune macOS PKG app metadata.
+const (
+	odataTypeMacOSPkgApp           = "#microsoft.graph.macOSPkgApp"
+	odataTypeMacOSIncludedApp      = "#microsoft.graph.macOSIncludedApp"
+	odataTypeMacOSMinimumOSVersion = "#microsoft.graph.macOSMinimumOperatingSystem"
+	intunePublisher                = "Microsoft"
+)
+
+// IntuneMacOSPkgApp is the metadata Intune expects when creating a macOS PKG
+// app through Microsoft Graph (POST /deviceAppManagement/mobileApps). It
+// marshals to the macOSPkgApp request body; the package content itself is
+// uploaded separately as a mobileAppContentFile.
+type IntuneMacOSPkgApp struct {
+	ODataType   string `json:"@odata.type"`
+	DisplayName string `json:"displayName"`
+	Description string `json:"description"`
+	Publisher   string `json:"publisher"`
+
+	// FileName is the name of the .pkg installer that will be uploaded.
+	FileName string `json:"fileName"`
+
+	// PrimaryBundleID and PrimaryBundleVersion identify the app Intune reports
+	// as installed.
+	PrimaryBundleID      string `json:"primaryBundleId"`
+	PrimaryBundleVersion string `json:"primaryBundleVersion"`
+
+	// IncludedApps are the detection rules: Intune treats the app as installed
+	// when each bundle ID is present with at least the given
+	// CFBundleShortVersionString.
+	IncludedApps []IntuneIncludedApp `json:"includedApps"`
+
+	// IgnoreVersionDetection makes detection match on bundle ID alone.
+	IgnoreVersionDetection bool `json:"ignoreVersionDetection"`
+
+	// MinimumSupportedOperatingSystem is nil when the feed has no minimum OS.
+	MinimumSupportedOperatingSystem IntuneMinimumOS `json:"minimumSupportedOperatingSystem,omitempty"`
+}
+
+// IntuneIncludedApp is a single bundle detection rule in an IntuneMacOSPkgApp.
+type IntuneIncludedApp struct {
+	ODataType     string `json:"@odata.type"`
+	BundleID      string `json:"bundleId"`
+	BundleVersion string `json:"bundleVersion"`
+}
+
+// IntuneMinimumOS is a macOSMinimumOperatingSystem value: the OData type plus a
+// single true flag such as "v14_0" or "v10_15".
+type IntuneMinimumOS map[string]any
+
+// ToIntuneMacOSPkgApp renders p as Intune macOS PKG app metadata, with a
+// detection rule on the package's bundle ID and CFBundleShortVersionString.
+//
+// Returns an error if p's application ID has no known bundle ID, or the feed
+// did not provide a short version or download location.
+func (p *Package) ToIntuneMacOSPkgApp() (*IntuneMacOSPkgApp, error) {
+	bundleID, ok := AppIDBundleMap[p.ApplicationID]
+	if !ok {
+		return nil, fmt.Errorf("no bundle ID known for application ID %q", p.ApplicationID)
+	}
+	if p.ShortVersion == "" {
+		return nil, fmt.Errorf("package %s has no short version", p.ApplicationID)
+	}
+	u, err := url.Parse(p.Location)
+	if err != nil || p.Location == "" {
+		return nil, fmt.Errorf("package %s has no valid download location", p.ApplicationID)
+	}
+
+	displayName := p.Title
+	if displayName == "" {
+		displayName = AppNames[p.ApplicationID]
+	}
+
+	return &IntuneMacOSPkgApp{
+		ODataType:            odataTypeMacOSPkgApp,
+		DisplayName:          displayName,
+		Description:          fmt.Sprintf("%s %s", displayName, p.ShortVersion),
+		Publisher:            intunePublisher,
+		FileName:             path.Base(u.Path),
+		PrimaryBundleID:      bundleID,
+		PrimaryBundleVersion: p.ShortVersion,
+		IncludedApps: []IntuneIncludedApp{{
+			ODataType:     odataTypeMacOSIncludedApp,
+			BundleID:      bundleID,
+			BundleVersion: p.ShortVersion,
+		}},
+		MinimumSupportedOperatingSystem: intuneMinimumOS(p.MinimumOS),
+	}, nil
+}
+
+// ToIntuneMacOSPkgApps renders every package in r as Intune metadata, skipping
+// packages that cannot be rendered.
+func (r *StandaloneResponse) ToIntuneMacOSPkgApps() []*IntuneMacOSPkgApp {
+	apps := make([]*IntuneMacOSPkgApp, 0, len(r.Packages))
+	for _, pkg := range r.Packages {
+		if app, err := pkg.ToIntuneMacOSPkgApp(); err == nil {
+			apps = append(apps, app)
+		}
+	}
+	return apps
+}
+
+// intuneMinimumOS maps a macOS version string such as "14.0" or "10.15" to
+// the matching macOSMinimumOperatingSystem flag. Intune names macOS 10.x
+// releases by minor version and macOS 11 onwards by major version only.
+func intuneMinimumOS(version string) IntuneMinimumOS {
+	parts := strings.Split(strings.TrimSpace(version), ".")
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return nil
+	}
+
+	flag := fmt.Sprintf("v%d_0", major)
+	if major == 10 {
+		minor := 0
+		if len(parts) > 1 {
+			if minor, err = strconv.Atoi(parts[1]); err != nil {
+				return nil
+			}
+		}
+		flag = fmt.Sprintf("v10_%d", minor)
+	}
+
+	return IntuneMinimumOS{
+		"@odata.type": odataTypeMacOSMinimumOSVersion,
+		flag:          true,
+	}
+}
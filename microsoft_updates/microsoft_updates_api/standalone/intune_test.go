@@ -0,0 +1,89 @@
+package standalone_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/constants"
+	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/microsoft_updates_api/standalone"
+	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/microsoft_updates_api/standalone/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToIntuneMacOSPkgApp(t *testing.T) {
+	svc, _ := setupMockClient(t)
+	mocks.RegisterWordMock(constants.StandaloneCDNBaseURL)
+
+	pkg, err := svc.GetPackageByApplicationIDV1(context.Background(), standalone.AppIDWord)
+	require.NoError(t, err)
+
+	app, err := pkg.ToIntuneMacOSPkgApp()
+	require.NoError(t, err)
+
+	body, err := json.Marshal(app)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"@odata.type": "#microsoft.graph.macOSPkgApp",
+		"displayName": "Microsoft Word",
+		"description": "Microsoft Word 16.108.1",
+		"publisher": "Microsoft",
+		"fileName": "Microsoft_Word_16.108.26041915_Updater.pkg",
+		"primaryBundleId": "com.microsoft.word",
+		"primaryBundleVersion": "16.108.1",
+		"includedApps": [{
+			"@odata.type": "#microsoft.graph.macOSIncludedApp",
+			"bundleId": "com.microsoft.word",
+			"bundleVersion": "16.108.1"
+		}],
+		"ignoreVersionDetection": false,
+		"minimumSupportedOperatingSystem": {
+			"@odata.type": "#microsoft.graph.macOSMinimumOperatingSystem",
+			"v14_0": true
+		}
+	}`, string(body))
+}
+
+func TestToIntuneMacOSPkgApp_MinimumOS(t *testing.T) {
+	tests := map[string]string{
+		"10.15":  "v10_15",
+		"11.0":   "v11_0",
+		"13.5.1": "v13_0",
+	}
+	for minOS, flag := range tests {
+		pkg := &standalone.Package{ApplicationID: standalone.AppIDExcel, ShortVersion: "16.1", Location: testPkgURL, MinimumOS: minOS}
+
+		app, err := pkg.ToIntuneMacOSPkgApp()
+		require.NoError(t, err)
+		assert.Equal(t, true, app.MinimumSupportedOperatingSystem[flag], minOS)
+	}
+
+	pkg := &standalone.Package{ApplicationID: standalone.AppIDExcel, ShortVersion: "16.1", Location: testPkgURL}
+	app, err := pkg.ToIntuneMacOSPkgApp()
+	require.NoError(t, err)
+	assert.Nil(t, app.MinimumSupportedOperatingSystem)
+}
+
+func TestToIntuneMacOSPkgApp_Errors(t *testing.T) {
+	_, err := (&standalone.Package{ApplicationID: "UNKNOWN", ShortVersion: "1.0", Location: testPkgURL}).ToIntuneMacOSPkgApp()
+	assert.ErrorContains(t, err, "no bundle ID")
+
+	_, err = (&standalone.Package{ApplicationID: standalone.AppIDWord, Location: testPkgURL}).ToIntuneMacOSPkgApp()
+	assert.ErrorContains(t, err, "no short version")
+
+	_, err = (&standalone.Package{ApplicationID: standalone.AppIDWord, ShortVersion: "1.0"}).ToIntuneMacOSPkgApp()
+	assert.ErrorContains(t, err, "download location")
+}
+
+func TestStandaloneResponse_ToIntuneMacOSPkgApps(t *testing.T) {
+	resp := &standalone.StandaloneResponse{Packages: []*standalone.Package{
+		{ApplicationID: standalone.AppIDWord, Title: "Microsoft Word", ShortVersion: "16.108.1", Location: testPkgURL},
+		{ApplicationID: "UNKNOWN", ShortVersion: "1.0", Location: testPkgURL},
+	}}
+
+	apps := resp.ToIntuneMacOSPkgApps()
+
+	require.Len(t, apps, 1)
+	assert.Equal(t, "Microsoft Word", apps[0].DisplayName)
+}
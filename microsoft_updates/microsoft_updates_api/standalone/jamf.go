@@ -0,0 +1,184 @@
+package standalone
+
+import (
+	"fmt"
+	"time"
+)
+
+// Jamf Pro patch criteria used in generated patch definitions.
+const (
+	jamfCriteriaBundleID   = "Application Bundle ID"
+	jamfCriteriaAppVersion = "Application Version"
+	jamfCriteriaOSVersion  = "Operating System Version"
+	jamfOperatorIs         = "is"
+	jamfOperatorGTE        = "greater than or equal"
+	jamfCriteriaTypeRecon  = "recon"
+	jamfPublisher          = "Microsoft"
+)
+
+// JamfSoftwareTitle is a Jamf Pro external patch source software title
+// definition, as served from {patchSource}/patch/{id}. Hosting the marshalled
+// JSON of these titles (and their Summary values at {patchSource}/software)
+// lets Jamf Pro track the packages as a patch feed.
+type JamfSoftwareTitle struct {
+	ID                  string              `json:"id"`
+	Name                string              `json:"name"`
+	Publisher           string              `json:"publisher"`
+	AppName             string              `json:"appName"`
+	BundleID            string              `json:"bundleId"`
+	LastModified        time.Time           `json:"lastModified"`
+	CurrentVersion      string              `json:"currentVersion"`
+	Requirements        []JamfCriterion     `json:"requirements"`
+	Patches             []JamfPatch         `json:"patches"`
+	ExtensionAttributes []JamfExtensionAttr `json:"extensionAttributes"`
+}
+
+// JamfSoftwareTitleSummary is the entry for a title in the patch source's
+// software title list.
+type JamfSoftwareTitleSummary struct {
+	ID             string    `json:"id"`
+	Name           string    `json:"name"`
+	Publisher      string    `json:"publisher"`
+	CurrentVersion string    `json:"currentVersion"`
+	LastModified   time.Time `json:"lastModified"`
+}
+
+// JamfPatch is a single version of a software title.
+type JamfPatch struct {
+	Version                string          `json:"version"`
+	ReleaseDate            time.Time       `json:"releaseDate"`
+	Standalone             bool            `json:"standalone"`
+	MinimumOperatingSystem string          `json:"minimumOperatingSystem"`
+	Reboot                 bool            `json:"reboot"`
+	KillApps               []JamfKillApp   `json:"killApps"`
+	Components             []JamfComponent `json:"components"`
+	Capabilities           []JamfCriterion `json:"capabilities"`
+	Dependencies           []JamfCriterion `json:"dependencies"`
+}
+
+// JamfKillApp is an application Jamf Pro quits before installing a patch.
+type JamfKillApp struct {
+	BundleID string `json:"bundleId"`
+	AppName  string `json:"appName"`
+}
+
+// JamfComponent describes how Jamf Pro detects that a patch version is installed.
+type JamfComponent struct {
+	Name     string          `json:"name"`
+	Version  string          `json:"version"`
+	Criteria []JamfCriterion `json:"criteria"`
+}
+
+// JamfCriterion is a single inventory criterion in a patch definition.
+type JamfCriterion struct {
+	Name     string `json:"name"`
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+	Type     string `json:"type"`
+	And      *bool  `json:"and,omitempty"`
+}
+
+// JamfExtensionAttr is an extension attribute shipped with a patch definition.
+// Generated definitions rely on inventory criteria only and never include one.
+type JamfExtensionAttr struct {
+	Key         string `json:"key"`
+	Value       string `json:"value"`
+	DisplayName string `json:"displayName"`
+}
+
+// ToJamfPatchDefinition renders p as a Jamf Pro patch definition with a single
+// patch for the current version. Installation is detected by bundle ID and
+// CFBundleShortVersionString, and the app is quit before the patch installs.
+//
+// Returns an error if p's application ID has no known bundle ID, or the feed
+// did not provide a short version or a parseable release date.
+func (p *Package) ToJamfPatchDefinition() (*JamfSoftwareTitle, error) {
+	bundleID, ok := AppIDBundleMap[p.ApplicationID]
+	if !ok {
+		return nil, fmt.Errorf("no bundle ID known for application ID %q", p.ApplicationID)
+	}
+	if p.ShortVersion == "" {
+		return nil, fmt.Errorf("package %s has no short version", p.ApplicationID)
+	}
+	released, err := p.ReleaseDate()
+	if err != nil {
+		return nil, fmt.Errorf("package %s has no valid release date: %w", p.ApplicationID, err)
+	}
+
+	name := p.Title
+	if name == "" {
+		name = AppNames[p.ApplicationID]
+	}
+	appName := name + ".app"
+	and := true
+
+	bundleCriterion := JamfCriterion{
+		Name:     jamfCriteriaBundleID,
+		Operator: jamfOperatorIs,
+		Value:    bundleID,
+		Type:     jamfCriteriaTypeRecon,
+		And:      &and,
+	}
+
+	patch := JamfPatch{
+		Version:                p.ShortVersion,
+		ReleaseDate:            released,
+		Standalone:             true,
+		MinimumOperatingSystem: p.MinimumOS,
+		KillApps:               []JamfKillApp{{BundleID: bundleID, AppName: appName}},
+		Components: []JamfComponent{{
+			Name:    name,
+			Version: p.ShortVersion,
+			Criteria: []JamfCriterion{
+				bundleCriterion,
+				{Name: jamfCriteriaAppVersion, Operator: jamfOperatorIs, Value: p.ShortVersion, Type: jamfCriteriaTypeRecon},
+			},
+		}},
+		Capabilities: []JamfCriterion{},
+		Dependencies: []JamfCriterion{},
+	}
+	if p.MinimumOS != "" {
+		patch.Capabilities = append(patch.Capabilities, JamfCriterion{
+			Name:     jamfCriteriaOSVersion,
+			Operator: jamfOperatorGTE,
+			Value:    p.MinimumOS,
+			Type:     jamfCriteriaTypeRecon,
+		})
+	}
+
+	return &JamfSoftwareTitle{
+		ID:                  p.ApplicationID,
+		Name:                name,
+		Publisher:           jamfPublisher,
+		AppName:             appName,
+		BundleID:            bundleID,
+		LastModified:        released,
+		CurrentVersion:      p.ShortVersion,
+		Requirements:        []JamfCriterion{bundleCriterion},
+		Patches:             []JamfPatch{patch},
+		ExtensionAttributes: []JamfExtensionAttr{},
+	}, nil
+}
+
+// ToJamfPatchDefinitions renders every package in r as a Jamf Pro patch
+// definition, skipping packages that cannot be rendered.
+func (r *StandaloneResponse) ToJamfPatchDefinitions() []*JamfSoftwareTitle {
+	titles := make([]*JamfSoftwareTitle, 0, len(r.Packages))
+	for _, pkg := range r.Packages {
+		if title, err := pkg.ToJamfPatchDefinition(); err == nil {
+			titles = append(titles, title)
+		}
+	}
+	return titles
+}
+
+// Summary returns the software title list entry for t.
+func (t *JamfSoftwareTitle) Summary() JamfSoftwareTitleSummary {
+	return JamfSoftwareTitleSummary{
+		ID:             t.ID,
+		Name:           t.Name,
+		Publisher:      t.Publisher,
+		CurrentVersion: t.CurrentVersion,
+		LastModified:   t.LastModified,
+	}
+}
@@ -0,0 +1,94 @@
+package standalone_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/constants"
+	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/microsoft_updates_api/standalone"
+	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/microsoft_updates_api/standalone/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToJamfPatchDefinition(t *testing.T) {
+	svc, _ := setupMockClient(t)
+	mocks.RegisterWordMock(constants.StandaloneCDNBaseURL)
+
+	pkg, err := svc.GetPackageByApplicationIDV1(context.Background(), standalone.AppIDWord)
+	require.NoError(t, err)
+
+	title, err := pkg.ToJamfPatchDefinition()
+	require.NoError(t, err)
+
+	body, err := json.Marshal(title)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"id": "MSWD2019",
+		"name": "Microsoft Word",
+		"publisher": "Microsoft",
+		"appName": "Microsoft Word.app",
+		"bundleId": "com.microsoft.word",
+		"lastModified": "2026-04-19T00:00:00Z",
+		"currentVersion": "16.108.1",
+		"requirements": [
+			{"name": "Application Bundle ID", "operator": "is", "value": "com.microsoft.word", "type": "recon", "and": true}
+		],
+		"patches": [{
+			"version": "16.108.1",
+			"releaseDate": "2026-04-19T00:00:00Z",
+			"standalone": true,
+			"minimumOperatingSystem": "14.0",
+			"reboot": false,
+			"killApps": [{"bundleId": "com.microsoft.word", "appName": "Microsoft Word.app"}],
+			"components": [{
+				"name": "Microsoft Word",
+				"version": "16.108.1",
+				"criteria": [
+					{"name": "Application Bundle ID", "operator": "is", "value": "com.microsoft.word", "type": "recon", "and": true},
+					{"name": "Application Version", "operator": "is", "value": "16.108.1", "type": "recon"}
+				]
+			}],
+			"capabilities": [
+				{"name": "Operating System Version", "operator": "greater than or equal", "value": "14.0", "type": "recon"}
+			],
+			"dependencies": []
+		}],
+		"extensionAttributes": []
+	}`, string(body))
+
+	summary, err := json.Marshal(title.Summary())
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"id": "MSWD2019",
+		"name": "Microsoft Word",
+		"publisher": "Microsoft",
+		"currentVersion": "16.108.1",
+		"lastModified": "2026-04-19T00:00:00Z"
+	}`, string(summary))
+}
+
+func TestToJamfPatchDefinition_Errors(t *testing.T) {
+	_, err := (&standalone.Package{ApplicationID: "UNKNOWN", ShortVersion: "1.0", Date: "04/19/2026"}).ToJamfPatchDefinition()
+	assert.ErrorContains(t, err, "no bundle ID")
+
+	_, err = (&standalone.Package{ApplicationID: standalone.AppIDWord, Date: "04/19/2026"}).ToJamfPatchDefinition()
+	assert.ErrorContains(t, err, "no short version")
+
+	_, err = (&standalone.Package{ApplicationID: standalone.AppIDWord, ShortVersion: "1.0", Date: "yesterday"}).ToJamfPatchDefinition()
+	assert.ErrorContains(t, err, "release date")
+}
+
+func TestStandaloneResponse_ToJamfPatchDefinitions(t *testing.T) {
+	resp := &standalone.StandaloneResponse{Packages: []*standalone.Package{
+		{ApplicationID: standalone.AppIDExcel, Title: "Microsoft Excel", ShortVersion: "16.108.1", Date: "04/19/2026"},
+		{ApplicationID: standalone.AppIDExcel, ShortVersion: "16.108.1"},
+	}}
+
+	titles := resp.ToJamfPatchDefinitions()
+
+	require.Len(t, titles, 1)
+	assert.Equal(t, "XCEL2019", titles[0].ID)
+	assert.Empty(t, titles[0].Patches[0].Capabilities)
+}
@@ -0,0 +1,43 @@
+package standalone
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/client"
+)
+
+// VerifyLinksV1 fetches the latest metadata for every standalone application
+// and issues a HEAD request for each Location and AppOnlyLocation, reporting
+// dead links and redirect targets. The CDN feed does not publish package
+// sizes, so SizeMismatch is never set for standalone links.
+//
+// HEAD {Location}, {AppOnlyLocation}
+func (s *StandaloneService) VerifyLinksV1(ctx context.Context) ([]PackageLinkCheck, error) {
+	resp, err := s.GetLatestV1(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var checks []PackageLinkCheck
+	for _, pkg := range resp.Packages {
+		links := []struct{ kind, url string }{
+			{LinkKindFull, pkg.Location},
+			{LinkKindAppOnly, pkg.AppOnlyLocation},
+		}
+		for _, l := range links {
+			if l.url == "" {
+				continue
+			}
+			if err := ctx.Err(); err != nil {
+				return checks, err
+			}
+			checks = append(checks, PackageLinkCheck{
+				ApplicationID: pkg.ApplicationID,
+				Title:         pkg.Title,
+				Kind:          l.kind,
+				LinkCheck:     client.CheckLink(ctx, s.client, l.url, 0),
+			})
+		}
+	}
+	return checks, nil
+}
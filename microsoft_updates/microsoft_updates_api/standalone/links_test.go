@@ -0,0 +1,36 @@
+package standalone_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/constants"
+	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/microsoft_updates_api/standalone"
+	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/microsoft_updates_api/standalone/mocks"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyLinksV1(t *testing.T) {
+	svc, _ := setupMockClient(t)
+	mocks.RegisterWordMock(constants.StandaloneCDNBaseURL)
+	const base = "https://officecdnmac.microsoft.com/pr/C1297A47-86C4-4C1F-97FA-950631F94777/MacAutoupdate/"
+	httpmock.RegisterResponder("HEAD", base+"Microsoft_Word_16.108.26041915_Updater.pkg",
+		httpmock.NewStringResponder(200, ""))
+	httpmock.RegisterResponder("HEAD", base+"Microsoft_Word_16.108.26041915_AppOnly_Updater.pkg",
+		httpmock.NewStringResponder(404, ""))
+
+	checks, err := svc.VerifyLinksV1(context.Background())
+	require.NoError(t, err)
+	require.Len(t, checks, 2)
+
+	assert.Equal(t, standalone.AppIDWord, checks[0].ApplicationID)
+	assert.Equal(t, standalone.LinkKindFull, checks[0].Kind)
+	assert.False(t, checks[0].Dead)
+	assert.False(t, checks[0].SizeMismatch)
+
+	assert.Equal(t, standalone.LinkKindAppOnly, checks[1].Kind)
+	assert.True(t, checks[1].Dead)
+	assert.Equal(t, 404, checks[1].StatusCode)
+}
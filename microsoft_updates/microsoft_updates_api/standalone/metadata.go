@@ -0,0 +1,326 @@
+package standalone
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/xar"
+	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/version"
+)
+
+// maxMetadataSize bounds the Distribution and PackageInfo files read from a
+// package; real ones are a few kilobytes.
+const maxMetadataSize = 4 << 20
+
+// PackageMetadata is the installer metadata read from a flat package's
+// Distribution and PackageInfo files.
+type PackageMetadata struct {
+	// Title is the installer title from the Distribution (e.g. "Microsoft Word").
+	Title string
+
+	// ProductID and ProductVersion are the Distribution's product element,
+	// when present.
+	ProductID      string
+	ProductVersion string
+
+	// MinimumOS is the lowest macOS version the Distribution allows (e.g. "14.0").
+	MinimumOS string
+
+	// HostArchitectures lists the architectures the installer runs on
+	// (e.g. "x86_64", "arm64"), empty when it does not restrict them.
+	HostArchitectures []string
+
+	// Components are the component packages the installer contains, in
+	// Distribution order. A component package built with pkgbuild has a
+	// single component and no Distribution.
+	Components []PackageComponent
+}
+
+// PackageComponent is one component package within an installer.
+type PackageComponent struct {
+	// Identifier is the package identifier (e.g. "com.microsoft.package.Microsoft_Word.app").
+	Identifier string
+
+	// Version is the component package version.
+	Version string
+
+	// InstallKBytes is the installed size of the payload in kilobytes.
+	InstallKBytes int64
+
+	// InstallLocation is where the payload is installed (e.g. "/Applications").
+	InstallLocation string
+
+	// Bundles are the application and plug-in bundles the payload installs.
+	Bundles []PackageBundle
+}
+
+// PackageBundle is a bundle installed by a component package.
+type PackageBundle struct {
+	// Path is the bundle path relative to the install location.
+	Path string
+
+	// BundleID is the CFBundleIdentifier.
+	BundleID string
+
+	// ShortVersion is the CFBundleShortVersionString (e.g. "16.108.1").
+	ShortVersion string
+
+	// Version is the CFBundleVersion (e.g. "16.108.26041915").
+	Version string
+}
+
+// Bundle returns the bundle with bundleID from any component, matching
+// case-insensitively.
+func (m *PackageMetadata) Bundle(bundleID string) (PackageBundle, bool) {
+	for _, c := range m.Components {
+		for _, b := range c.Bundles {
+			if strings.EqualFold(b.BundleID, bundleID) {
+				return b, true
+			}
+		}
+	}
+	return PackageBundle{}, false
+}
+
+// MetadataMismatch is a difference between a package's CDN feed entry and
+// the metadata of the installer it points to.
+type MetadataMismatch struct {
+	// Field is the compared value: "ShortVersion" or "MinimumOS".
+	Field string
+
+	// Feed is the value in the CDN feed.
+	Feed string
+
+	// Installer is the value in the installer's metadata.
+	Installer string
+}
+
+func (m MetadataMismatch) String() string {
+	return fmt.Sprintf("%s: feed has %q, installer has %q", m.Field, m.Feed, m.Installer)
+}
+
+// CheckMetadata compares p with the metadata of its downloaded installer.
+// The feed's ShortVersion must match the CFBundleShortVersionString of the
+// application's bundle (AppIDBundleMap), and its MinimumOS the installer's.
+// Versions are compared numerically, so "14" matches "14.0". Values missing
+// from either side are not compared. It returns nil when nothing differs.
+func (p *Package) CheckMetadata(m *PackageMetadata) []MetadataMismatch {
+	var mismatches []MetadataMismatch
+	check := func(field, feed, installer string) {
+		if feed == "" || installer == "" {
+			return
+		}
+		if c, err := version.Compare(feed, installer); feed == installer || (err == nil && c == 0) {
+			return
+		}
+		mismatches = append(mismatches, MetadataMismatch{Field: field, Feed: feed, Installer: installer})
+	}
+
+	if bundle, ok := m.Bundle(AppIDBundleMap[p.ApplicationID]); ok {
+		check("ShortVersion", p.ShortVersion, bundle.ShortVersion)
+	}
+	check("MinimumOS", p.MinimumOS, m.MinimumOS)
+	return mismatches
+}
+
+// distribution holds the Distribution elements ReadPackageMetadata needs.
+type distribution struct {
+	Title   string `xml:"title"`
+	Options struct {
+		HostArchitectures string `xml:"hostArchitectures,attr"`
+	} `xml:"options"`
+	Product *struct {
+		ID      string `xml:"id,attr"`
+		Version string `xml:"version,attr"`
+	} `xml:"product"`
+	OSVersions []struct {
+		Min string `xml:"min,attr"`
+	} `xml:"volume-check>allowed-os-versions>os-version"`
+	PkgRefs []struct {
+		ID            string      `xml:"id,attr"`
+		Version       string      `xml:"version,attr"`
+		InstallKBytes int64       `xml:"installKBytes,attr"`
+		Bundles       []pkgBundle `xml:"bundle-version>bundle"`
+	} `xml:"pkg-ref"`
+}
+
+// packageInfo holds the PackageInfo elements ReadPackageMetadata needs.
+type packageInfo struct {
+	Identifier      string `xml:"identifier,attr"`
+	Version         string `xml:"version,attr"`
+	InstallLocation string `xml:"install-location,attr"`
+	Payload         struct {
+		InstallKBytes int64 `xml:"installKBytes,attr"`
+	} `xml:"payload"`
+	Bundles []pkgBundle `xml:"bundle"`
+}
+
+// pkgBundle is a bundle element; in PackageInfo, bundles nest inside the
+// bundle that contains them.
+type pkgBundle struct {
+	Path         string      `xml:"path,attr"`
+	ID           string      `xml:"id,attr"`
+	ShortVersion string      `xml:"CFBundleShortVersionString,attr"`
+	Version      string      `xml:"CFBundleVersion,attr"`
+	Bundles      []pkgBundle `xml:"bundle"`
+}
+
+// ReadPackageMetadata reads the metadata of the flat installer package
+// (.pkg) at pkgPath, such as one saved by DownloadPackageV1: the Distribution
+// of a product archive built with productbuild, and the PackageInfo of each
+// component package it contains. For a component package built with
+// pkgbuild, only its PackageInfo is read.
+//
+// Values in PackageInfo describe the payload itself and take precedence over
+// the Distribution's. Use Package.CheckMetadata to compare the result with
+// the CDN feed. The package's signature is not checked; see
+// VerifyPackageSignature.
+func ReadPackageMetadata(pkgPath string) (*PackageMetadata, error) {
+	f, err := os.Open(pkgPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	archive, err := xar.Open(f, info.Size())
+	if err != nil {
+		return nil, fmt.Errorf("read package %s: %w", pkgPath, err)
+	}
+
+	m, err := readPackageMetadata(archive)
+	if err != nil {
+		return nil, fmt.Errorf("read package %s: %w", pkgPath, err)
+	}
+	return m, nil
+}
+
+func readPackageMetadata(archive *xar.Archive) (*PackageMetadata, error) {
+	m := &PackageMetadata{}
+	index := make(map[string]int)
+	// component returns the component with id, adding it if needed. The
+	// pointer is only valid until the next call.
+	component := func(id string) *PackageComponent {
+		i, ok := index[id]
+		if !ok {
+			i = len(m.Components)
+			index[id] = i
+			m.Components = append(m.Components, PackageComponent{Identifier: id})
+		}
+		return &m.Components[i]
+	}
+
+	data, err := archive.ReadFile("Distribution", maxMetadataSize)
+	switch {
+	case err == nil:
+		var d distribution
+		if err := xml.Unmarshal(data, &d); err != nil {
+			return nil, fmt.Errorf("parse Distribution: %w", err)
+		}
+		m.Title = strings.TrimSpace(d.Title)
+		if d.Product != nil {
+			m.ProductID, m.ProductVersion = d.Product.ID, d.Product.Version
+		}
+		for _, v := range d.OSVersions {
+			if v.Min != "" && (m.MinimumOS == "" || olderVersion(v.Min, m.MinimumOS)) {
+				m.MinimumOS = v.Min
+			}
+		}
+		for arch := range strings.SplitSeq(d.Options.HostArchitectures, ",") {
+			if arch = strings.TrimSpace(arch); arch != "" {
+				m.HostArchitectures = append(m.HostArchitectures, arch)
+			}
+		}
+		for _, ref := range d.PkgRefs {
+			if ref.ID == "" {
+				continue
+			}
+			c := component(ref.ID)
+			if ref.Version != "" {
+				c.Version = ref.Version
+			}
+			if ref.InstallKBytes != 0 {
+				c.InstallKBytes = ref.InstallKBytes
+			}
+			c.Bundles = mergeBundles(c.Bundles, ref.Bundles)
+		}
+	case errors.Is(err, fs.ErrNotExist):
+	default:
+		return nil, err
+	}
+
+	var infoPaths []string
+	for _, f := range archive.Files() {
+		if path.Base(f.Path) == "PackageInfo" && strings.Count(f.Path, "/") <= 1 {
+			infoPaths = append(infoPaths, f.Path)
+		}
+	}
+	if len(infoPaths) == 0 && len(m.Components) == 0 {
+		return nil, fmt.Errorf("no Distribution or PackageInfo found")
+	}
+	for _, infoPath := range infoPaths {
+		data, err := archive.ReadFile(infoPath, maxMetadataSize)
+		if err != nil {
+			return nil, err
+		}
+		var info packageInfo
+		if err := xml.Unmarshal(data, &info); err != nil {
+			return nil, fmt.Errorf("parse %s: %w", infoPath, err)
+		}
+		if info.Identifier == "" {
+			continue
+		}
+		c := component(info.Identifier)
+		if info.Version != "" {
+			c.Version = info.Version
+		}
+		if info.Payload.InstallKBytes != 0 {
+			c.InstallKBytes = info.Payload.InstallKBytes
+		}
+		c.InstallLocation = info.InstallLocation
+		c.Bundles = mergeBundles(c.Bundles, info.Bundles)
+	}
+	return m, nil
+}
+
+// mergeBundles adds bundles, and the bundles nested in them, to have.
+// A bundle already present by ID has its empty fields filled in and its
+// versions replaced.
+func mergeBundles(have []PackageBundle, bundles []pkgBundle) []PackageBundle {
+	for _, b := range bundles {
+		if b.ID != "" {
+			i := slices.IndexFunc(have, func(h PackageBundle) bool { return h.BundleID == b.ID })
+			if i < 0 {
+				have = append(have, PackageBundle{BundleID: b.ID})
+				i = len(have) - 1
+			}
+			h := &have[i]
+			if b.Path != "" {
+				h.Path = strings.TrimPrefix(b.Path, "./")
+			}
+			if b.ShortVersion != "" {
+				h.ShortVersion = b.ShortVersion
+			}
+			if b.Version != "" {
+				h.Version = b.Version
+			}
+		}
+		have = mergeBundles(have, b.Bundles)
+	}
+	return have
+}
+
+// olderVersion reports whether a is an older version than b. Unparseable
+// versions are never older.
+func olderVersion(a, b string) bool {
+	c, err := version.Compare(a, b)
+	return err == nil && c < 0
+}
@@ -0,0 +1,144 @@
+package standalone_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/xar/xartest"
+	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/microsoft_updates_api/standalone"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDistribution = `<?xml version="1.0" encoding="utf-8"?>
+<installer-gui-script minSpecVersion="2">
+    <title>Microsoft Word</title>
+    <options customize="never" require-scripts="false" hostArchitectures="x86_64,arm64"/>
+    <volume-check>
+        <allowed-os-versions>
+            <os-version min="14.0"/>
+        </allowed-os-versions>
+    </volume-check>
+    <product id="com.microsoft.word.standalone.365" version="16.108.1"/>
+    <pkg-ref id="com.microsoft.package.Microsoft_Word.app" version="16.108.26041915" installKBytes="2450000" onConclusion="none">#Microsoft_Word.pkg</pkg-ref>
+    <pkg-ref id="com.microsoft.package.Microsoft_Word.app">
+        <bundle-version>
+            <bundle CFBundleShortVersionString="16.108.1" CFBundleVersion="16.108.26041915" id="com.microsoft.Word" path="Microsoft Word.app"/>
+        </bundle-version>
+    </pkg-ref>
+    <pkg-ref id="com.microsoft.package.Microsoft_AutoUpdate.app" version="4.80.26040513" installKBytes="18000" onConclusion="none">#Microsoft_AutoUpdate.pkg</pkg-ref>
+</installer-gui-script>`
+
+const testWordPackageInfo = `<?xml version="1.0" encoding="utf-8"?>
+<pkg-info format-version="2" identifier="com.microsoft.package.Microsoft_Word.app" version="16.108.26041915" install-location="/Applications" auth="root">
+    <payload numberOfFiles="5321" installKBytes="2451234"/>
+    <bundle path="./Microsoft Word.app" id="com.microsoft.Word" CFBundleShortVersionString="16.108.1" CFBundleVersion="16.108.26041915">
+        <bundle path="./Contents/Library/LaunchServices/com.microsoft.Word.helper" id="com.microsoft.Word.helper" CFBundleShortVersionString="16.108.1" CFBundleVersion="16.108.26041915"/>
+    </bundle>
+</pkg-info>`
+
+const testAutoUpdatePackageInfo = `<?xml version="1.0" encoding="utf-8"?>
+<pkg-info format-version="2" identifier="com.microsoft.package.Microsoft_AutoUpdate.app" version="4.80.26040513" install-location="/Library/Application Support/Microsoft/MAU2.0" auth="root">
+    <payload numberOfFiles="120" installKBytes="18011"/>
+    <bundle path="./Microsoft AutoUpdate.app" id="com.microsoft.autoupdate2" CFBundleShortVersionString="4.80" CFBundleVersion="4.80.26040513"/>
+</pkg-info>`
+
+func writePkg(t *testing.T, files []xartest.File) string {
+	t.Helper()
+	data, err := xartest.Build(files, xartest.Options{Compress: true})
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "Microsoft_Word_Installer.pkg")
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+	return path
+}
+
+func TestReadPackageMetadata_ProductArchive(t *testing.T) {
+	path := writePkg(t, []xartest.File{
+		{Name: "Distribution", Data: []byte(testDistribution)},
+		{Name: "Microsoft_Word.pkg/PackageInfo", Data: []byte(testWordPackageInfo)},
+		{Name: "Microsoft_Word.pkg/Payload", Data: []byte("payload")},
+		{Name: "Microsoft_AutoUpdate.pkg/PackageInfo", Data: []byte(testAutoUpdatePackageInfo)},
+	})
+
+	m, err := standalone.ReadPackageMetadata(path)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Microsoft Word", m.Title)
+	assert.Equal(t, "com.microsoft.word.standalone.365", m.ProductID)
+	assert.Equal(t, "16.108.1", m.ProductVersion)
+	assert.Equal(t, "14.0", m.MinimumOS)
+	assert.Equal(t, []string{"x86_64", "arm64"}, m.HostArchitectures)
+
+	require.Len(t, m.Components, 2)
+	word := m.Components[0]
+	assert.Equal(t, "com.microsoft.package.Microsoft_Word.app", word.Identifier)
+	assert.Equal(t, "16.108.26041915", word.Version)
+	assert.Equal(t, int64(2451234), word.InstallKBytes, "PackageInfo takes precedence over the Distribution")
+	assert.Equal(t, "/Applications", word.InstallLocation)
+	assert.Equal(t, []standalone.PackageBundle{
+		{Path: "Microsoft Word.app", BundleID: "com.microsoft.Word", ShortVersion: "16.108.1", Version: "16.108.26041915"},
+		{Path: "Contents/Library/LaunchServices/com.microsoft.Word.helper", BundleID: "com.microsoft.Word.helper", ShortVersion: "16.108.1", Version: "16.108.26041915"},
+	}, word.Bundles)
+
+	mau := m.Components[1]
+	assert.Equal(t, "com.microsoft.package.Microsoft_AutoUpdate.app", mau.Identifier)
+	assert.Equal(t, "/Library/Application Support/Microsoft/MAU2.0", mau.InstallLocation)
+
+	bundle, ok := m.Bundle("COM.MICROSOFT.AUTOUPDATE2")
+	require.True(t, ok)
+	assert.Equal(t, "4.80", bundle.ShortVersion)
+}
+
+func TestReadPackageMetadata_ComponentPackage(t *testing.T) {
+	path := writePkg(t, []xartest.File{
+		{Name: "PackageInfo", Data: []byte(testAutoUpdatePackageInfo)},
+		{Name: "Payload", Data: []byte("payload")},
+	})
+
+	m, err := standalone.ReadPackageMetadata(path)
+
+	require.NoError(t, err)
+	assert.Empty(t, m.Title)
+	require.Len(t, m.Components, 1)
+	assert.Equal(t, int64(18011), m.Components[0].InstallKBytes)
+	assert.Equal(t, "com.microsoft.autoupdate2", m.Components[0].Bundles[0].BundleID)
+}
+
+func TestReadPackageMetadata_Invalid(t *testing.T) {
+	notPkg := filepath.Join(t.TempDir(), "not.pkg")
+	require.NoError(t, os.WriteFile(notPkg, []byte("PK\x03\x04 not a package at all"), 0o644))
+	_, err := standalone.ReadPackageMetadata(notPkg)
+	assert.ErrorContains(t, err, "not a xar archive")
+
+	empty := writePkg(t, []xartest.File{{Name: "Payload", Data: []byte("payload")}})
+	_, err = standalone.ReadPackageMetadata(empty)
+	assert.ErrorContains(t, err, "no Distribution or PackageInfo found")
+
+	malformed := writePkg(t, []xartest.File{{Name: "Distribution", Data: []byte("<installer-gui-script>")}})
+	_, err = standalone.ReadPackageMetadata(malformed)
+	assert.ErrorContains(t, err, "parse Distribution")
+}
+
+func TestCheckMetadata(t *testing.T) {
+	m := &standalone.PackageMetadata{
+		MinimumOS: "14",
+		Components: []standalone.PackageComponent{{
+			Bundles: []standalone.PackageBundle{{BundleID: standalone.BundleIDWord, ShortVersion: "16.108.1"}},
+		}},
+	}
+
+	pkg := &standalone.Package{ApplicationID: standalone.AppIDWord, ShortVersion: "16.108.1", MinimumOS: "14.0"}
+	assert.Empty(t, pkg.CheckMetadata(m))
+
+	stale := &standalone.Package{ApplicationID: standalone.AppIDWord, ShortVersion: "16.109", MinimumOS: "15.0"}
+	mismatches := stale.CheckMetadata(m)
+	assert.Equal(t, []standalone.MetadataMismatch{
+		{Field: "ShortVersion", Feed: "16.109", Installer: "16.108.1"},
+		{Field: "MinimumOS", Feed: "15.0", Installer: "14"},
+	}, mismatches)
+	assert.Equal(t, `ShortVersion: feed has "16.109", installer has "16.108.1"`, mismatches[0].String())
+
+	other := &standalone.Package{ApplicationID: standalone.AppIDExcel, ShortVersion: "16.109"}
+	assert.Empty(t, other.CheckMetadata(m), "a bundle the installer does not contain is not compared")
+}
@@ -1,7 +1,10 @@
 package mocks
 
 import (
+	"bytes"
 	_ "embed"
+	"net/http"
+	"sync/atomic"
 
 	"github.com/jarcoal/httpmock"
 )
@@ -18,6 +21,26 @@ func RegisterWordMock(baseURL string) {
 	)
 }
 
+// RegisterWordUpdateMock registers a responder for the Microsoft Word CDN
+// endpoint that serves version 16.108.1 on the first request and 16.109 on
+// every request after it, simulating a new release between two polls.
+func RegisterWordUpdateMock(baseURL string) {
+	updated := bytes.ReplaceAll(wordPlistXML, []byte("16.108.1<"), []byte("16.109<"))
+	updated = bytes.ReplaceAll(updated, []byte("16.108.26041915"), []byte("16.109.26051012"))
+
+	var calls atomic.Int32
+	httpmock.RegisterResponder(
+		"GET",
+		baseURL+"MSWD2019.xml",
+		func(req *http.Request) (*http.Response, error) {
+			if calls.Add(1) == 1 {
+				return httpmock.NewBytesResponse(200, wordPlistXML), nil
+			}
+			return httpmock.NewBytesResponse(200, updated), nil
+		},
+	)
+}
+
 // RegisterErrorMock registers a 500 error responder for the given URL.
 func RegisterErrorMock(url string) {
 	httpmock.RegisterResponder(
@@ -1,6 +1,12 @@
 package standalone
 
-import "encoding/xml"
+import (
+	"crypto/x509"
+	"encoding/xml"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/client"
+)
 
 // StandaloneResponse holds all packages fetched across one CDN channel.
 type StandaloneResponse struct {
@@ -17,6 +23,9 @@ type Package struct {
 	// Title is the human-readable application name from the plist (e.g. "Microsoft Word").
 	Title string
 
+	// Category is the product family the application belongs to (e.g. CategoryOffice).
+	Category Category
+
 	// ShortVersion is the user-facing version string (e.g. "16.108.1").
 	ShortVersion string
 
@@ -74,7 +83,7 @@ type plistNode struct {
 // toPackage converts a raw plist dict into a typed Package. It iterates the
 // alternating key/value children and maps known keys to Package fields.
 func (d *plistDict) toPackage(appID string) *Package {
-	p := &Package{ApplicationID: appID}
+	p := &Package{ApplicationID: appID, Category: AppCategories[appID]}
 	children := d.Children
 	for i := 0; i+1 < len(children); i += 2 {
 		key := children[i].Value
@@ -112,3 +121,159 @@ func (d *plistDict) toPackage(appID string) *Package {
 	}
 	return p
 }
+
+// cdnDateLayout is the format of the Date key in the Office CDN plist (e.g. "04/19/2026").
+const cdnDateLayout = "01/02/2006"
+
+// ReleaseDate parses Date, which the CDN publishes with day precision, as
+// midnight UTC on the release day.
+func (p *Package) ReleaseDate() (time.Time, error) {
+	return time.Parse(cdnDateLayout, p.Date)
+}
+
+// UpdateEvent reports a standalone application whose CDN entry changed
+// between two polls of WatchV1.
+type UpdateEvent struct {
+	// ApplicationID is the Microsoft CDN application identifier (e.g. "MSWD2019").
+	ApplicationID string
+
+	// Title is the human-readable application name (e.g. "Microsoft Word").
+	Title string
+
+	// OldVersion is the short version seen on the previous poll.
+	OldVersion string
+
+	// NewVersion is the short version now published on the CDN.
+	NewVersion string
+
+	// Previous is the package as seen on the previous poll.
+	Previous *Package
+
+	// Current is the package now published on the CDN.
+	Current *Package
+}
+
+// DownloadResult contains the outcome of a completed DownloadPackageV1 call.
+type DownloadResult struct {
+	// ApplicationID is the Microsoft CDN application identifier of the package.
+	ApplicationID string
+
+	// Version is the short version of the downloaded package.
+	Version string
+
+	// URL is the CDN URL that was downloaded.
+	URL string
+
+	// DestPath is the local filesystem path where the installer was written.
+	DestPath string
+
+	// BytesWritten is the total number of bytes streamed to disk.
+	BytesWritten int64
+
+	// SHA256 is the hex-encoded SHA-256 checksum of the downloaded bytes.
+	SHA256 string
+
+	// Duration is the wall-clock time elapsed during the download.
+	Duration time.Duration
+
+	// Verified is true when the feed provided a SHA-256 checksum and it
+	// matched the downloaded content.
+	Verified bool
+
+	// Signature is the verified Developer ID signature of the installer,
+	// set when DownloadOptions.Signature was.
+	Signature *PackageSignature
+}
+
+// ProgressFunc is called during a download with the cumulative number of bytes
+// written and the total expected bytes (0 if unknown). Calls are serialised,
+// even when segments are fetched in parallel.
+type ProgressFunc func(bytesWritten, totalBytes int64)
+
+// DownloadOptions configures DownloadPackageV1 and DownloadPackagesV1.
+type DownloadOptions struct {
+	// Segments is the number of byte ranges fetched in parallel when the CDN
+	// supports range requests. Values below 1 download in a single segment.
+	Segments int
+
+	// Resume keeps partial segment files when a download fails, and continues
+	// from them on the next call with the same package and destination.
+	Resume bool
+
+	// Progress, if set, receives the running byte count.
+	Progress ProgressFunc
+
+	// Signature, if set, also verifies the installer's Developer ID
+	// signature with VerifyPackageSignature before it is moved into place.
+	Signature *SignatureOptions
+}
+
+// SignatureOptions configures VerifyPackageSignature.
+type SignatureOptions struct {
+	// Roots are the trusted root certificates. Empty trusts only Apple Inc.
+	// Root (AppleIncRoot), under which Developer ID certificates are issued.
+	Roots []*x509.Certificate
+
+	// TeamIDs are the Apple Developer team IDs whose signatures are
+	// accepted. Empty accepts only MicrosoftTeamID.
+	TeamIDs []string
+
+	// Time is when the certificate chain must have been valid. Zero means
+	// now.
+	Time time.Time
+}
+
+// PackageSignature describes a verified installer signature.
+type PackageSignature struct {
+	// TeamID is the Apple Developer team ID of the signer (e.g. MicrosoftTeamID).
+	TeamID string
+
+	// Signer is the common name of the signing certificate (e.g.
+	// "Developer ID Installer: Microsoft Corporation (UBF8T346G9)").
+	Signer string
+
+	// Certificate is the Developer ID Installer certificate.
+	Certificate *x509.Certificate
+
+	// Chain is the verified chain from Certificate to a trusted root.
+	Chain []*x509.Certificate
+}
+
+func (o *DownloadOptions) segments() int {
+	if o.Segments < 1 {
+		return 1
+	}
+	return o.Segments
+}
+
+// PackageDownload is the outcome of one download in a DownloadPackagesV1 call.
+type PackageDownload struct {
+	// Package is the package that was downloaded.
+	Package *Package
+
+	// Result is set when the download succeeded.
+	Result *DownloadResult
+
+	// Err is set when the download failed.
+	Err error
+}
+
+// Link kinds reported by VerifyLinksV1.
+const (
+	LinkKindFull    = "full"
+	LinkKindAppOnly = "app-only"
+)
+
+// PackageLinkCheck is the health of one download link published for a package.
+type PackageLinkCheck struct {
+	// ApplicationID is the CDN application ID the link belongs to.
+	ApplicationID string
+
+	// Title is the application name.
+	Title string
+
+	// Kind is LinkKindFull for Location or LinkKindAppOnly for AppOnlyLocation.
+	Kind string
+
+	client.LinkCheck
+}
@@ -0,0 +1,152 @@
+package standalone
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/plistenc"
+)
+
+// Munki pkginfo defaults used when MunkiOptions leaves them unset.
+const (
+	munkiDefaultCatalog  = "testing"
+	munkiDeveloper       = "Microsoft"
+	munkiInstallsType    = "application"
+	munkiVersionCompare  = "CFBundleShortVersionString"
+	munkiApplicationsDir = "/Applications"
+)
+
+// MunkiOptions configures ToMunkiPkginfo.
+type MunkiOptions struct {
+	// Catalogs lists the Munki catalogs the item is added to. Defaults to ["testing"].
+	Catalogs []string
+
+	// RepoSubdirectory is the directory under the repo's pkgs/ directory the
+	// installer is imported into (e.g. "apps/microsoft").
+	RepoSubdirectory string
+
+	// Category is the optional Managed Software Center category.
+	Category string
+}
+
+// MunkiPkginfo is a Munki pkginfo for a standalone package's full installer.
+// Plist renders it as the XML property list Munki stores in pkgsinfo/.
+type MunkiPkginfo struct {
+	Name                  string         `plist:"name"`
+	DisplayName           string         `plist:"display_name"`
+	Version               string         `plist:"version"`
+	Developer             string         `plist:"developer"`
+	Category              string         `plist:"category,omitempty"`
+	Catalogs              []string       `plist:"catalogs"`
+	MinimumOSVersion      *string        `plist:"minimum_os_version,omitempty"`
+	InstallerItemLocation string         `plist:"installer_item_location"`
+	InstallerItemHash     *string        `plist:"installer_item_hash,omitempty"`
+	Installs              []MunkiInstall `plist:"installs"`
+	UnattendedInstall     bool           `plist:"unattended_install"`
+	Uninstallable         bool           `plist:"uninstallable"`
+}
+
+// MunkiInstall is an entry in a pkginfo's installs array: Munki treats the
+// item as installed when the bundle at Path has at least CFBundleShortVersionString.
+type MunkiInstall struct {
+	Type                       string `plist:"type"`
+	Path                       string `plist:"path"`
+	CFBundleIdentifier         string `plist:"CFBundleIdentifier"`
+	CFBundleShortVersionString string `plist:"CFBundleShortVersionString"`
+	VersionComparisonKey       string `plist:"version_comparison_key"`
+}
+
+// ToMunkiPkginfo renders p as a Munki pkginfo for its full installer, with
+// installer_item_hash taken from the feed's SHA-256 and an installs entry for
+// the app bundle. A nil opts uses the defaults described on MunkiOptions.
+//
+// Returns an error if p's application ID has no known bundle ID, or the feed
+// did not provide a short version or download location.
+func (p *Package) ToMunkiPkginfo(opts *MunkiOptions) (*MunkiPkginfo, error) {
+	if opts == nil {
+		opts = &MunkiOptions{}
+	}
+
+	bundleID, ok := AppIDBundleMap[p.ApplicationID]
+	if !ok {
+		return nil, fmt.Errorf("no bundle ID known for application ID %q", p.ApplicationID)
+	}
+	if p.ShortVersion == "" {
+		return nil, fmt.Errorf("package %s has no short version", p.ApplicationID)
+	}
+	u, err := url.Parse(p.Location)
+	if err != nil || p.Location == "" {
+		return nil, fmt.Errorf("package %s has no valid download location", p.ApplicationID)
+	}
+
+	displayName := p.Title
+	if displayName == "" {
+		displayName = AppNames[p.ApplicationID]
+	}
+
+	catalogs := opts.Catalogs
+	if len(catalogs) == 0 {
+		catalogs = []string{munkiDefaultCatalog}
+	}
+
+	info := &MunkiPkginfo{
+		Name:                  munkiName(displayName),
+		DisplayName:           displayName,
+		Version:               p.ShortVersion,
+		Developer:             munkiDeveloper,
+		Category:              opts.Category,
+		Catalogs:              catalogs,
+		InstallerItemLocation: path.Join(opts.RepoSubdirectory, path.Base(u.Path)),
+		Installs: []MunkiInstall{{
+			Type:                       munkiInstallsType,
+			Path:                       path.Join(munkiApplicationsDir, displayName+".app"),
+			CFBundleIdentifier:         bundleID,
+			CFBundleShortVersionString: p.ShortVersion,
+			VersionComparisonKey:       munkiVersionCompare,
+		}},
+		UnattendedInstall: true,
+	}
+	if p.MinimumOS != "" {
+		info.MinimumOSVersion = &p.MinimumOS
+	}
+	if p.HashSHA256 != "" {
+		sum, err := decodeSHA256(p.HashSHA256)
+		if err != nil {
+			return nil, fmt.Errorf("invalid SHA-256 in feed for %s: %w", p.ApplicationID, err)
+		}
+		hash := hex.EncodeToString(sum)
+		info.InstallerItemHash = &hash
+	}
+
+	return info, nil
+}
+
+// ToMunkiPkginfos renders every package in r as a Munki pkginfo, skipping
+// packages that cannot be rendered.
+func (r *StandaloneResponse) ToMunkiPkginfos(opts *MunkiOptions) []*MunkiPkginfo {
+	infos := make([]*MunkiPkginfo, 0, len(r.Packages))
+	for _, pkg := range r.Packages {
+		if info, err := pkg.ToMunkiPkginfo(opts); err == nil {
+			infos = append(infos, info)
+		}
+	}
+	return infos
+}
+
+// Plist renders m as a pkginfo property list document.
+func (m *MunkiPkginfo) Plist() ([]byte, error) {
+	fields, err := plistenc.Fields(m)
+	if err != nil {
+		return nil, err
+	}
+	return plistenc.Document(fields)
+}
+
+// munkiName derives a Munki item name from a display name by dropping spaces
+// and parentheses (e.g. "Microsoft Defender (Endpoint)" → "MicrosoftDefenderEndpoint").
+func munkiName(displayName string) string {
+	return strings.NewReplacer(" ", "", "(", "", ")", "").Replace(displayName)
+}
@@ -0,0 +1,110 @@
+package standalone_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/constants"
+	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/microsoft_updates_api/standalone"
+	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/microsoft_updates_api/standalone/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToMunkiPkginfo(t *testing.T) {
+	svc, _ := setupMockClient(t)
+	mocks.RegisterWordMock(constants.StandaloneCDNBaseURL)
+
+	pkg, err := svc.GetPackageByApplicationIDV1(context.Background(), standalone.AppIDWord)
+	require.NoError(t, err)
+
+	info, err := pkg.ToMunkiPkginfo(&standalone.MunkiOptions{RepoSubdirectory: "apps/microsoft", Category: "Productivity"})
+	require.NoError(t, err)
+
+	body, err := info.Plist()
+	require.NoError(t, err)
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>name</key>
+	<string>MicrosoftWord</string>
+	<key>display_name</key>
+	<string>Microsoft Word</string>
+	<key>version</key>
+	<string>16.108.1</string>
+	<key>developer</key>
+	<string>Microsoft</string>
+	<key>category</key>
+	<string>Productivity</string>
+	<key>catalogs</key>
+	<array>
+		<string>testing</string>
+	</array>
+	<key>minimum_os_version</key>
+	<string>14.0</string>
+	<key>installer_item_location</key>
+	<string>apps/microsoft/Microsoft_Word_16.108.26041915_Updater.pkg</string>
+	<key>installer_item_hash</key>
+	<string>e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855</string>
+	<key>installs</key>
+	<array>
+		<dict>
+			<key>type</key>
+			<string>application</string>
+			<key>path</key>
+			<string>/Applications/Microsoft Word.app</string>
+			<key>CFBundleIdentifier</key>
+			<string>com.microsoft.word</string>
+			<key>CFBundleShortVersionString</key>
+			<string>16.108.1</string>
+			<key>version_comparison_key</key>
+			<string>CFBundleShortVersionString</string>
+		</dict>
+	</array>
+	<key>unattended_install</key>
+	<true/>
+	<key>uninstallable</key>
+	<false/>
+</dict>
+</plist>
+`, string(body))
+}
+
+func TestToMunkiPkginfo_Defaults(t *testing.T) {
+	pkg := &standalone.Package{
+		ApplicationID: standalone.AppIDDefenderEP,
+		Title:         "Microsoft Defender (Endpoint)",
+		ShortVersion:  "101.25012.0001",
+		Location:      testPkgURL,
+	}
+
+	info, err := pkg.ToMunkiPkginfo(nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "MicrosoftDefenderEndpoint", info.Name)
+	assert.Equal(t, []string{"testing"}, info.Catalogs)
+	assert.Equal(t, "Microsoft_Word_16.108_Updater.pkg", info.InstallerItemLocation)
+	assert.Nil(t, info.MinimumOSVersion)
+	assert.Nil(t, info.InstallerItemHash)
+}
+
+func TestToMunkiPkginfo_Errors(t *testing.T) {
+	_, err := (&standalone.Package{ApplicationID: "UNKNOWN", ShortVersion: "1.0", Location: testPkgURL}).ToMunkiPkginfo(nil)
+	assert.ErrorContains(t, err, "no bundle ID")
+
+	_, err = (&standalone.Package{ApplicationID: standalone.AppIDWord, ShortVersion: "1.0", Location: testPkgURL, HashSHA256: "zz"}).ToMunkiPkginfo(nil)
+	assert.ErrorContains(t, err, "invalid SHA-256")
+}
+
+func TestStandaloneResponse_ToMunkiPkginfos(t *testing.T) {
+	resp := &standalone.StandaloneResponse{Packages: []*standalone.Package{
+		{ApplicationID: standalone.AppIDOutlook, Title: "Microsoft Outlook", ShortVersion: "16.108.1", Location: testPkgURL},
+		{ApplicationID: standalone.AppIDOutlook, Location: testPkgURL},
+	}}
+
+	infos := resp.ToMunkiPkginfos(&standalone.MunkiOptions{Catalogs: []string{"production"}})
+
+	require.Len(t, infos, 1)
+	assert.Equal(t, []string{"production"}, infos[0].Catalogs)
+}
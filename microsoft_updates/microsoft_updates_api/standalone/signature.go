@@ -0,0 +1,170 @@
+package standalone
+
+import (
+	"crypto/x509"
+	_ "embed"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/xar"
+)
+
+// Marker extensions Apple sets on Developer ID certificates. The signing
+// certificate must carry oidDeveloperIDInstaller and its issuer
+// oidDeveloperIDCA, so that other certificates issued under an Apple root,
+// such as Mac App Store or development certificates, are not accepted.
+var (
+	oidDeveloperIDInstaller = asn1.ObjectIdentifier{1, 2, 840, 113635, 100, 6, 1, 14}
+	oidDeveloperIDCA        = asn1.ObjectIdentifier{1, 2, 840, 113635, 100, 6, 2, 6}
+)
+
+// appleIncRoot is Apple Inc. Root in DER form, as published at
+// https://www.apple.com/appleca/AppleIncRootCertificate.cer. Its SHA-256
+// fingerprint is B0B1730ECBC7FF4505142C49F1295E6EDA6BCAED7E2C68C5BE91B5A11001F024.
+//
+//go:embed AppleIncRootCertificate.cer
+var appleIncRoot []byte
+
+// AppleIncRoot returns the Apple Inc. Root certificate, which
+// VerifyPackageSignature trusts when SignatureOptions.Roots is empty.
+func AppleIncRoot() *x509.Certificate {
+	cert, err := x509.ParseCertificate(appleIncRoot)
+	if err != nil {
+		panic("standalone: parse embedded Apple Inc. Root: " + err.Error())
+	}
+	return cert
+}
+
+// ErrPackageNotSigned is returned (wrapped) when an installer carries no
+// signature.
+var ErrPackageNotSigned = errors.New("package is not signed")
+
+// VerifyPackageSignature checks the Developer ID signature of the installer
+// package at path, as Gatekeeper would, without relying on macOS tools:
+//
+//   - the signature over the package's table of contents was made by the
+//     key of its signing certificate;
+//   - the signing certificate is a Developer ID Installer certificate that
+//     chains, through a Developer ID intermediate, to one of opts.Roots, or
+//     to Apple Inc. Root when opts.Roots is empty;
+//   - the certificate belongs to one of opts.TeamIDs;
+//   - every file in the package matches the checksum recorded in the signed
+//     table of contents.
+//
+// The certificate chain is checked at opts.Time. productsign's timestamp is
+// not read, so an older installer whose certificate has since expired only
+// verifies with opts.Time set to a time when the certificate was valid. A nil
+// opts uses the defaults.
+func VerifyPackageSignature(path string, opts *SignatureOptions) (*PackageSignature, error) {
+	if opts == nil {
+		opts = &SignatureOptions{}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	archive, err := xar.Open(f, info.Size())
+	if err != nil {
+		return nil, fmt.Errorf("read package %s: %w", path, err)
+	}
+	if err := archive.VerifySignature(); err != nil {
+		if errors.Is(err, xar.ErrUnsigned) {
+			return nil, fmt.Errorf("%s: %w", path, ErrPackageNotSigned)
+		}
+		return nil, fmt.Errorf("verify package %s: %w", path, err)
+	}
+
+	certs := archive.Certificates()
+	chain, err := verifyDeveloperIDChain(certs, opts)
+	if err != nil {
+		return nil, fmt.Errorf("verify package %s: %w", path, err)
+	}
+
+	var teamID string
+	if ou := certs[0].Subject.OrganizationalUnit; len(ou) > 0 {
+		teamID = ou[0]
+	}
+	teamIDs := opts.TeamIDs
+	if len(teamIDs) == 0 {
+		teamIDs = []string{MicrosoftTeamID}
+	}
+	if !slices.Contains(teamIDs, teamID) {
+		return nil, fmt.Errorf("verify package %s: signed by team %q, expected one of %v", path, teamID, teamIDs)
+	}
+
+	if err := archive.VerifyFiles(); err != nil {
+		return nil, fmt.Errorf("verify package %s: %w", path, err)
+	}
+
+	return &PackageSignature{
+		TeamID:      teamID,
+		Signer:      certs[0].Subject.CommonName,
+		Certificate: certs[0],
+		Chain:       chain,
+	}, nil
+}
+
+// verifyDeveloperIDChain checks that certs[0] is a Developer ID Installer
+// certificate chaining to a trusted root through a Developer ID
+// intermediate, using the other certificates in certs as intermediates. It
+// returns the verified chain.
+func verifyDeveloperIDChain(certs []*x509.Certificate, opts *SignatureOptions) ([]*x509.Certificate, error) {
+	// The marker extensions may be marked critical, which x509 would
+	// otherwise reject as unhandled; they are checked below.
+	for _, cert := range certs {
+		cert.UnhandledCriticalExtensions = slices.DeleteFunc(cert.UnhandledCriticalExtensions, func(oid asn1.ObjectIdentifier) bool {
+			return oid.Equal(oidDeveloperIDInstaller) || oid.Equal(oidDeveloperIDCA)
+		})
+	}
+
+	leaf := certs[0]
+	if !hasExtension(leaf, oidDeveloperIDInstaller) {
+		return nil, fmt.Errorf("signing certificate %q is not a Developer ID Installer certificate", leaf.Subject.CommonName)
+	}
+
+	roots := x509.NewCertPool()
+	for _, root := range opts.Roots {
+		roots.AddCert(root)
+	}
+	if len(opts.Roots) == 0 {
+		roots.AddCert(AppleIncRoot())
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	chains, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   opts.Time,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("signing certificate %q: %w", leaf.Subject.CommonName, err)
+	}
+	for _, chain := range chains {
+		if len(chain) >= 3 && hasExtension(chain[1], oidDeveloperIDCA) {
+			return chain, nil
+		}
+	}
+	return nil, fmt.Errorf("signing certificate %q is not issued by a Developer ID certificate authority", leaf.Subject.CommonName)
+}
+
+func hasExtension(cert *x509.Certificate, oid asn1.ObjectIdentifier) bool {
+	for _, ext := range cert.Extensions {
+		if ext.Id.Equal(oid) {
+			return true
+		}
+	}
+	return false
+}
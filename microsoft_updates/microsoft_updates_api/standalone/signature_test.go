@@ -0,0 +1,291 @@
+package standalone_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/hex"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/xar/xartest"
+	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/microsoft_updates_api/standalone"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	oidDeveloperIDInstaller = asn1.ObjectIdentifier{1, 2, 840, 113635, 100, 6, 1, 14}
+	oidDeveloperIDCA        = asn1.ObjectIdentifier{1, 2, 840, 113635, 100, 6, 2, 6}
+)
+
+// testPKI stands in for Apple Inc. Root, a Developer ID intermediate and a
+// Developer ID Installer certificate.
+type testPKI struct {
+	root, intermediate, leaf *x509.Certificate
+	leafKey                  *rsa.PrivateKey
+}
+
+type pkiOptions struct {
+	teamID             string
+	leafMarker         bool
+	intermediateMarker bool
+	// appleRootName issues the root under Apple Inc. Root's name and key
+	// identifier, but with a key of its own.
+	appleRootName bool
+}
+
+func newTestPKI(t *testing.T, opts pkiOptions) *testPKI {
+	t.Helper()
+	issue := func(tmpl, parent *x509.Certificate, parentKey *rsa.PrivateKey) (*x509.Certificate, *rsa.PrivateKey) {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		if parent == nil {
+			parent, parentKey = tmpl, key
+		}
+		der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+		require.NoError(t, err)
+		cert, err := x509.ParseCertificate(der)
+		require.NoError(t, err)
+		return cert, key
+	}
+	marker := func(oid asn1.ObjectIdentifier, set bool) []pkix.Extension {
+		if !set {
+			return nil
+		}
+		return []pkix.Extension{{Id: oid, Critical: true, Value: []byte{0x05, 0x00}}}
+	}
+	notBefore, notAfter := time.Now().Add(-time.Hour), time.Now().Add(365*24*time.Hour)
+
+	rootTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test Root CA"},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter.Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	if opts.appleRootName {
+		apple := standalone.AppleIncRoot()
+		rootTmpl.Subject = apple.Subject
+		rootTmpl.SubjectKeyId = apple.SubjectKeyId
+	}
+	root, rootKey := issue(rootTmpl, nil, nil)
+	intermediate, intermediateKey := issue(&x509.Certificate{
+		SerialNumber:          big.NewInt(2),
+		Subject:               pkix.Name{CommonName: "Developer ID Certification Authority"},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter.Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		ExtraExtensions:       marker(oidDeveloperIDCA, opts.intermediateMarker),
+	}, root, rootKey)
+	leaf, leafKey := issue(&x509.Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject: pkix.Name{
+			CommonName:         "Developer ID Installer: Microsoft Corporation (" + opts.teamID + ")",
+			OrganizationalUnit: []string{opts.teamID},
+			Organization:       []string{"Microsoft Corporation"},
+		},
+		NotBefore:       notBefore,
+		NotAfter:        notAfter,
+		KeyUsage:        x509.KeyUsageDigitalSignature,
+		ExtraExtensions: marker(oidDeveloperIDInstaller, opts.leafMarker),
+	}, intermediate, intermediateKey)
+
+	return &testPKI{root: root, intermediate: intermediate, leaf: leaf, leafKey: leafKey}
+}
+
+func microsoftPKI(t *testing.T) *testPKI {
+	return newTestPKI(t, pkiOptions{teamID: standalone.MicrosoftTeamID, leafMarker: true, intermediateMarker: true})
+}
+
+// signedPkg writes a package signed by pki to a temporary file.
+func (pki *testPKI) signedPkg(t *testing.T) string {
+	t.Helper()
+	data, err := xartest.Build([]xartest.File{
+		{Name: "Distribution", Data: []byte("<installer-gui-script/>")},
+		{Name: "Payload", Data: []byte("payload")},
+	}, xartest.Options{Key: pki.leafKey, Certificates: []*x509.Certificate{pki.leaf, pki.intermediate, pki.root}})
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "Microsoft_Word.pkg")
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+	return path
+}
+
+func (pki *testPKI) options() *standalone.SignatureOptions {
+	return &standalone.SignatureOptions{Roots: []*x509.Certificate{pki.root}}
+}
+
+func TestVerifyPackageSignature(t *testing.T) {
+	pki := microsoftPKI(t)
+
+	sig, err := standalone.VerifyPackageSignature(pki.signedPkg(t), pki.options())
+
+	require.NoError(t, err)
+	assert.Equal(t, standalone.MicrosoftTeamID, sig.TeamID)
+	assert.Equal(t, "Developer ID Installer: Microsoft Corporation (UBF8T346G9)", sig.Signer)
+	assert.True(t, sig.Certificate.Equal(pki.leaf))
+	require.Len(t, sig.Chain, 3)
+	assert.True(t, sig.Chain[2].Equal(pki.root))
+}
+
+func TestVerifyPackageSignature_Rejected(t *testing.T) {
+	tests := []struct {
+		name    string
+		pki     pkiOptions
+		modify  func(*standalone.SignatureOptions)
+		wantErr string
+	}{
+		{
+			name:    "other team",
+			pki:     pkiOptions{teamID: "ABCDE12345", leafMarker: true, intermediateMarker: true},
+			wantErr: `signed by team "ABCDE12345"`,
+		},
+		{
+			name:    "not a Developer ID Installer certificate",
+			pki:     pkiOptions{teamID: standalone.MicrosoftTeamID, intermediateMarker: true},
+			wantErr: "is not a Developer ID Installer certificate",
+		},
+		{
+			name:    "not issued by Developer ID",
+			pki:     pkiOptions{teamID: standalone.MicrosoftTeamID, leafMarker: true},
+			wantErr: "is not issued by a Developer ID certificate authority",
+		},
+		{
+			name:    "untrusted root",
+			pki:     pkiOptions{teamID: standalone.MicrosoftTeamID, leafMarker: true, intermediateMarker: true},
+			modify:  func(o *standalone.SignatureOptions) { o.Roots = []*x509.Certificate{microsoftPKI(t).root} },
+			wantErr: "certificate signed by unknown authority",
+		},
+		{
+			name:    "expired",
+			pki:     pkiOptions{teamID: standalone.MicrosoftTeamID, leafMarker: true, intermediateMarker: true},
+			modify:  func(o *standalone.SignatureOptions) { o.Time = time.Now().AddDate(2, 0, 0) },
+			wantErr: "expired",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pki := newTestPKI(t, tt.pki)
+			opts := pki.options()
+			if tt.modify != nil {
+				tt.modify(opts)
+			}
+
+			_, err := standalone.VerifyPackageSignature(pki.signedPkg(t), opts)
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestVerifyPackageSignature_TeamIDs(t *testing.T) {
+	pki := newTestPKI(t, pkiOptions{teamID: "ABCDE12345", leafMarker: true, intermediateMarker: true})
+	opts := pki.options()
+	opts.TeamIDs = []string{"ABCDE12345"}
+
+	sig, err := standalone.VerifyPackageSignature(pki.signedPkg(t), opts)
+
+	require.NoError(t, err)
+	assert.Equal(t, "ABCDE12345", sig.TeamID)
+}
+
+func TestVerifyPackageSignature_TamperedPayload(t *testing.T) {
+	pki := microsoftPKI(t)
+	path := pki.signedPkg(t)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	data[len(data)-1] ^= 0xff
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+
+	_, err = standalone.VerifyPackageSignature(path, pki.options())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Payload: checksum does not match")
+}
+
+func TestVerifyPackageSignature_Unsigned(t *testing.T) {
+	data, err := xartest.Build([]xartest.File{{Name: "Distribution", Data: []byte("x")}}, xartest.Options{})
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "unsigned.pkg")
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+
+	_, err = standalone.VerifyPackageSignature(path, microsoftPKI(t).options())
+
+	assert.ErrorIs(t, err, standalone.ErrPackageNotSigned)
+}
+
+func TestAppleIncRoot(t *testing.T) {
+	root := standalone.AppleIncRoot()
+
+	sum := sha256.Sum256(root.Raw)
+	assert.Equal(t, "b0b1730ecbc7ff4505142c49f1295e6eda6bcaed7e2c68c5be91b5a11001f024", hex.EncodeToString(sum[:]))
+	assert.Equal(t, "Apple Root CA", root.Subject.CommonName)
+	assert.Equal(t, []string{"Apple Inc."}, root.Subject.Organization)
+	assert.True(t, root.IsCA)
+	assert.Equal(t, root.Subject.String(), root.Issuer.String())
+	assert.True(t, root.NotAfter.After(time.Now()))
+}
+
+func TestVerifyPackageSignature_DefaultRoot(t *testing.T) {
+	t.Run("test root is not trusted", func(t *testing.T) {
+		_, err := standalone.VerifyPackageSignature(microsoftPKI(t).signedPkg(t), nil)
+
+		var unknown x509.UnknownAuthorityError
+		assert.ErrorAs(t, err, &unknown)
+	})
+
+	t.Run("chain is checked against Apple Inc. Root", func(t *testing.T) {
+		pki := newTestPKI(t, pkiOptions{teamID: standalone.MicrosoftTeamID, leafMarker: true, intermediateMarker: true, appleRootName: true})
+
+		_, err := standalone.VerifyPackageSignature(pki.signedPkg(t), &standalone.SignatureOptions{})
+
+		var unknown x509.UnknownAuthorityError
+		require.ErrorAs(t, err, &unknown)
+		assert.Contains(t, err.Error(), `candidate authority certificate "Apple Root CA"`)
+	})
+}
+
+func TestDownloadPackageV1_Signature(t *testing.T) {
+	pki := microsoftPKI(t)
+	signed, err := os.ReadFile(pki.signedPkg(t))
+	require.NoError(t, err)
+
+	t.Run("verified", func(t *testing.T) {
+		svc, _ := setupMockClient(t)
+		httpmock.RegisterResponder("GET", testPkgURL, httpmock.NewBytesResponder(http.StatusOK, signed))
+
+		result, err := svc.DownloadPackageV1(context.Background(), testPackage(""), t.TempDir(), &standalone.DownloadOptions{Signature: pki.options()})
+
+		require.NoError(t, err)
+		require.NotNil(t, result.Signature)
+		assert.Equal(t, standalone.MicrosoftTeamID, result.Signature.TeamID)
+		assert.FileExists(t, result.DestPath)
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		svc, _ := setupMockClient(t)
+		registerPkg(int64(len(testPkgBody)))
+		dir := t.TempDir()
+
+		_, err := svc.DownloadPackageV1(context.Background(), testPackage(""), dir, &standalone.DownloadOptions{Signature: pki.options()})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "signature check for MSWD2019 failed")
+		entries, _ := os.ReadDir(dir)
+		assert.Empty(t, entries, "a package that fails the signature check should be removed")
+	})
+}
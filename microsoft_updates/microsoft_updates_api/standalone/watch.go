@@ -0,0 +1,69 @@
+package standalone
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// WatchV1 polls the CDN channel every interval and emits an UpdateEvent on the
+// returned channel whenever an application's short or full version changes.
+//
+// The first poll records the current versions as a baseline and emits nothing.
+// Applications that fail to fetch on a poll keep their previous state, so a
+// transient CDN error never produces a spurious event. The channel is closed
+// once ctx is done.
+func (s *StandaloneService) WatchV1(ctx context.Context, interval time.Duration) (<-chan UpdateEvent, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("watch interval must be positive, got %s", interval)
+	}
+
+	events := make(chan UpdateEvent)
+	go func() {
+		defer close(events)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		seen := make(map[string]*Package)
+		baseline := true
+		for {
+			resp, _ := s.GetLatestV1(ctx)
+			for _, pkg := range resp.Packages {
+				prev, ok := seen[pkg.ApplicationID]
+				seen[pkg.ApplicationID] = pkg
+				if baseline || !ok || !versionChanged(prev, pkg) {
+					continue
+				}
+
+				event := UpdateEvent{
+					ApplicationID: pkg.ApplicationID,
+					Title:         pkg.Title,
+					OldVersion:    prev.ShortVersion,
+					NewVersion:    pkg.ShortVersion,
+					Previous:      prev,
+					Current:       pkg,
+				}
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+			baseline = false
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// versionChanged reports whether next is a different release than prev.
+func versionChanged(prev, next *Package) bool {
+	return prev.ShortVersion != next.ShortVersion || prev.FullVersion != next.FullVersion
+}
@@ -0,0 +1,15 @@
+package update_history
+
+// Bundle identifiers of the Office for Mac apps that have a column in the
+// update history table.
+const (
+	BundleIDWord       = "com.microsoft.Word"
+	BundleIDExcel      = "com.microsoft.Excel"
+	BundleIDPowerPoint = "com.microsoft.Powerpoint"
+	BundleIDOutlook    = "com.microsoft.Outlook"
+	BundleIDOneNote    = "com.microsoft.onenote.mac"
+)
+
+// releaseDateLayout is the date format used in the update history table
+// (e.g. "April 15, 2025").
+const releaseDateLayout = "January 2, 2006"
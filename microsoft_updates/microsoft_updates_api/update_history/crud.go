@@ -3,6 +3,7 @@ package update_history
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/client"
 	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/constants"
@@ -46,3 +47,46 @@ func (s *UpdateHistoryService) GetUpdateHistoryV1(ctx context.Context) (*UpdateH
 
 	return &UpdateHistoryResponse{Entries: entries}, nil
 }
+
+// GetAppVersionHistoryV1 returns the release history of a single Office for Mac
+// app, identified by its bundle ID (e.g. BundleIDWord), newest first.
+//
+// Every Office suite release ships every app, so each row of the update history
+// becomes one version. UpdateURL is set when the row still links the app's
+// individual updater.
+//
+// GET https://learn.microsoft.com/en-us/officeupdates/update-history-office-for-mac
+func (s *UpdateHistoryService) GetAppVersionHistoryV1(ctx context.Context, bundleID string) (*AppVersionHistoryResponse, error) {
+	updateURL, ok := appUpdateURLs[bundleID]
+	if !ok {
+		return nil, fmt.Errorf("bundle ID %q is not an Office for Mac app in the update history", bundleID)
+	}
+
+	history, err := s.GetUpdateHistoryV1(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]AppVersion, 0, len(history.Entries))
+	for _, entry := range history.Entries {
+		released, _ := time.Parse(releaseDateLayout, entry.ReleaseDate)
+		versions = append(versions, AppVersion{
+			Version:     entry.Version,
+			ReleaseDate: entry.ReleaseDate,
+			Released:    released,
+			UpdateURL:   updateURL(entry),
+			Archived:    entry.Archived,
+		})
+	}
+
+	return &AppVersionHistoryResponse{BundleID: bundleID, Versions: versions}, nil
+}
+
+// appUpdateURLs maps each supported bundle ID to its updater column.
+var appUpdateURLs = map[string]func(UpdateHistoryEntry) string{
+	BundleIDWord:       func(e UpdateHistoryEntry) string { return e.WordUpdate },
+	BundleIDExcel:      func(e UpdateHistoryEntry) string { return e.ExcelUpdate },
+	BundleIDPowerPoint: func(e UpdateHistoryEntry) string { return e.PowerPointUpdate },
+	BundleIDOutlook:    func(e UpdateHistoryEntry) string { return e.OutlookUpdate },
+	BundleIDOneNote:    func(e UpdateHistoryEntry) string { return e.OneNoteUpdate },
+}
@@ -3,6 +3,7 @@ package update_history_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/client"
 	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/microsoft_updates_api/update_history"
@@ -89,3 +90,31 @@ func TestGetUpdateHistoryV1_HasPerAppLinks(t *testing.T) {
 	assert.NotEmpty(t, fullEntry.OneNoteUpdate)
 	assert.False(t, fullEntry.Archived)
 }
+
+func TestGetAppVersionHistoryV1_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mocks.RegisterUpdateHistoryMock()
+
+	resp, err := svc.GetAppVersionHistoryV1(context.Background(), update_history.BundleIDExcel)
+
+	require.NoError(t, err)
+	assert.Equal(t, update_history.BundleIDExcel, resp.BundleID)
+	require.Len(t, resp.Versions, 2)
+
+	latest := resp.Versions[0]
+	assert.Equal(t, "16.108", latest.Version)
+	assert.Equal(t, time.Date(2026, time.April, 15, 0, 0, 0, 0, time.UTC), latest.Released)
+	assert.Equal(t, "https://go.microsoft.com/fwlink/p/?linkid=525135", latest.UpdateURL)
+
+	assert.Equal(t, "16.105", resp.Versions[1].Version)
+	assert.Empty(t, resp.Versions[1].UpdateURL)
+}
+
+func TestGetAppVersionHistoryV1_UnknownBundleID(t *testing.T) {
+	svc := setupMockClient(t)
+
+	_, err := svc.GetAppVersionHistoryV1(context.Background(), "com.microsoft.teams2")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "com.microsoft.teams2")
+}
@@ -1,5 +1,7 @@
 package update_history
 
+import "time"
+
 // UpdateHistoryResponse holds the full Office for Mac update history.
 type UpdateHistoryResponse struct {
 	Entries []UpdateHistoryEntry
@@ -38,3 +40,31 @@ type UpdateHistoryEntry struct {
 	// Archived indicates whether the download links are no longer available.
 	Archived bool
 }
+
+// AppVersionHistoryResponse holds the release history of a single Office for Mac app.
+type AppVersionHistoryResponse struct {
+	// BundleID is the bundle identifier the history was requested for.
+	BundleID string
+
+	// Versions lists every release of the app, newest first.
+	Versions []AppVersion
+}
+
+// AppVersion is a single release of an Office for Mac app.
+type AppVersion struct {
+	// Version is the Office version string (e.g. "16.108").
+	Version string
+
+	// ReleaseDate is the release date string as scraped from the table.
+	ReleaseDate string
+
+	// Released is ReleaseDate parsed as a date. It is zero if the date could not be parsed.
+	Released time.Time
+
+	// UpdateURL is the download URL for the app's individual updater, if the
+	// release still has one.
+	UpdateURL string
+
+	// Archived indicates whether the download links are no longer available.
+	Archived bool
+}
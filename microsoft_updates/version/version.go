@@ -0,0 +1,144 @@
+// Package version parses and orders the version strings Microsoft publishes
+// for its macOS applications.
+//
+// Microsoft uses two forms for the same release: a short version such as
+// "16.90.1" and a build version such as "16.90.24101020", whose third
+// component encodes the build date (YYMMDD) followed by a two-digit build
+// counter. Other products use plain dotted versions (Edge "147.0.3912.72",
+// OneDrive "24.180.0905.0003").
+package version
+
+import (
+	"cmp"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// buildDateLayout is the date prefix of a build-stamped third component.
+const buildDateLayout = "060102"
+
+// Version is a parsed Microsoft version string.
+type Version struct {
+	// Segments holds the numeric dot-separated components in order.
+	Segments []int
+
+	// BuildDate is the date encoded in a build-stamped third component, or
+	// the zero time for short and plain versions.
+	BuildDate time.Time
+
+	raw string
+}
+
+// Parse parses a dotted numeric version string. Surrounding whitespace is
+// ignored; empty or non-numeric components are an error.
+func Parse(s string) (Version, error) {
+	raw := strings.TrimSpace(s)
+	if raw == "" {
+		return Version{}, fmt.Errorf("version is empty")
+	}
+
+	fields := strings.Split(raw, ".")
+	v := Version{Segments: make([]int, len(fields)), raw: raw}
+	for i, field := range fields {
+		n, err := strconv.Atoi(field)
+		if err != nil || n < 0 {
+			return Version{}, fmt.Errorf("invalid version %q: component %q is not a number", s, field)
+		}
+		v.Segments[i] = n
+	}
+
+	if len(fields) == 3 && len(fields[2]) == 8 {
+		if date, err := time.Parse(buildDateLayout, fields[2][:6]); err == nil {
+			v.BuildDate = date
+		}
+	}
+	return v, nil
+}
+
+// MustParse is like Parse but panics on error. Use for constants in tests.
+func MustParse(s string) Version {
+	v, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns the version as it was parsed.
+func (v Version) String() string {
+	return v.raw
+}
+
+// Major returns the first component, or 0 if there is none.
+func (v Version) Major() int {
+	return v.Segment(0)
+}
+
+// Minor returns the second component, or 0 if there is none.
+func (v Version) Minor() int {
+	return v.Segment(1)
+}
+
+// IsBuild reports whether v is a build-stamped version such as "16.90.24101020".
+func (v Version) IsBuild() bool {
+	return !v.BuildDate.IsZero()
+}
+
+// Compare returns -1, 0 or +1 depending on whether v is older than, the same
+// release as, or newer than w.
+//
+// Components compare numerically, with missing trailing components counting
+// as 0. When exactly one side is build-stamped, only major and minor are
+// compared: "16.90.1" and "16.90.24101020" name the same release line, and a
+// patch number cannot be ordered against a build date.
+func (v Version) Compare(w Version) int {
+	n := max(len(v.Segments), len(w.Segments))
+	if v.IsBuild() != w.IsBuild() {
+		n = 2
+	}
+	for i := range n {
+		if c := cmp.Compare(v.Segment(i), w.Segment(i)); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// NewerThan reports whether v is a later release than w.
+func (v Version) NewerThan(w Version) bool {
+	return v.Compare(w) > 0
+}
+
+// OlderThan reports whether v is an earlier release than w.
+func (v Version) OlderThan(w Version) bool {
+	return v.Compare(w) < 0
+}
+
+// Segment returns the i-th component, or 0 if v has fewer components.
+func (v Version) Segment(i int) int {
+	if i < len(v.Segments) {
+		return v.Segments[i]
+	}
+	return 0
+}
+
+// Compare parses a and b and returns -1, 0 or +1 as Version.Compare does.
+func Compare(a, b string) (int, error) {
+	va, err := Parse(a)
+	if err != nil {
+		return 0, err
+	}
+	vb, err := Parse(b)
+	if err != nil {
+		return 0, err
+	}
+	return va.Compare(vb), nil
+}
+
+// IsNewer reports whether version a is a later release than version b.
+func IsNewer(a, b string) (bool, error) {
+	c, err := Compare(a, b)
+	return c > 0, err
+}
@@ -0,0 +1,73 @@
+package version_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/version"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	v, err := version.Parse(" 16.90.24101020 ")
+	require.NoError(t, err)
+	assert.Equal(t, []int{16, 90, 24101020}, v.Segments)
+	assert.Equal(t, 16, v.Major())
+	assert.Equal(t, 90, v.Minor())
+	assert.True(t, v.IsBuild())
+	assert.Equal(t, time.Date(2024, time.October, 10, 0, 0, 0, 0, time.UTC), v.BuildDate)
+	assert.Equal(t, "16.90.24101020", v.String())
+
+	short := version.MustParse("16.90.1")
+	assert.False(t, short.IsBuild())
+
+	// An eight-digit component that is not a date is not a build stamp.
+	assert.False(t, version.MustParse("16.90.24991020").IsBuild())
+	// OneDrive-style four-component versions are plain.
+	assert.False(t, version.MustParse("24.180.0905.0003").IsBuild())
+
+	for _, bad := range []string{"", "16..1", "16.90.1-beta", "v16.90"} {
+		_, err := version.Parse(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"16.89.24090815", "16.90.1", -1},
+		{"16.90.1", "16.89.24090815", 1},
+		{"16.90.1", "16.90.24101020", 0},
+		{"16.90.24101020", "16.90.24101021", -1},
+		{"16.90.24101020", "16.90.24092915", 1},
+		{"16.90", "16.90.0", 0},
+		{"16.90", "16.90.1", -1},
+		{"16.100.1", "16.99.3", 1},
+		{"147.0.3912.72", "147.0.3912.100", -1},
+		{"24.180.0905.0003", "24.180.0905.0003", 0},
+	}
+	for _, tt := range tests {
+		got, err := version.Compare(tt.a, tt.b)
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, got, "%s vs %s", tt.a, tt.b)
+	}
+
+	_, err := version.Compare("16.90", "latest")
+	assert.Error(t, err)
+}
+
+func TestIsNewer(t *testing.T) {
+	newer, err := version.IsNewer("16.90.1", "16.89.24090815")
+	require.NoError(t, err)
+	assert.True(t, newer)
+
+	newer, err = version.IsNewer("16.90.1", "16.90.24101020")
+	require.NoError(t, err)
+	assert.False(t, newer)
+
+	assert.True(t, version.MustParse("16.89").OlderThan(version.MustParse("16.90")))
+	assert.True(t, version.MustParse("16.90").NewerThan(version.MustParse("16.89.24090815")))
+}
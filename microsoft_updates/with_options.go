@@ -97,3 +97,25 @@ func WithInsecureSkipVerify() ClientOption {
 func WithMinTLSVersion(minVersion uint16) ClientOption {
 	return client.WithMinTLSVersion(minVersion)
 }
+
+// WithFeedURL redirects every request whose URL starts with original to the
+// same path under replacement, e.g. an internal mirror of the Office CDN.
+func WithFeedURL(original, replacement string) ClientOption {
+	return client.WithFeedURL(original, replacement)
+}
+
+// WithOfflineMirror serves every request from a local directory laid out as
+// {dir}/{host}/{path} instead of the network.
+func WithOfflineMirror(dir string) ClientOption {
+	return client.WithOfflineMirror(dir)
+}
+
+// WithCache caches successful GET responses in memory for ttl.
+func WithCache(ttl time.Duration) ClientOption {
+	return client.WithCache(ttl)
+}
+
+// WithDiskCache caches successful GET responses in memory and in dir for ttl.
+func WithDiskCache(dir string, ttl time.Duration) ClientOption {
+	return client.WithDiskCache(dir, ttl)
+}
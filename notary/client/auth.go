@@ -18,7 +18,8 @@ type AuthProvider interface {
 
 // JWTAuth implements direct JWT Bearer authentication for the Apple Notary API.
 // The Notary API uses App Store Connect API keys — a signed JWT is used directly
-// as a Bearer token without an OAuth token exchange step.
+// as a Bearer token without an OAuth token exchange step. It is safe for
+// concurrent use.
 type JWTAuth struct {
 	keyID       string
 	issuerID    string
@@ -127,6 +128,20 @@ func (j *JWTAuth) ForceRefresh() {
 	j.tokenExpiry = time.Time{}
 }
 
+// invalidate discards token if it is still the current token and reports
+// whether it did, so concurrent requests rejected with the same token sign
+// one replacement between them.
+func (j *JWTAuth) invalidate(token string) bool {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	if token == "" || token != j.token {
+		return false
+	}
+	j.token = ""
+	j.tokenExpiry = time.Time{}
+	return true
+}
+
 // APIKeyAuth implements simple API key authentication
 type APIKeyAuth struct {
 	apiKey string
@@ -99,9 +99,8 @@ func NewTransport(keyID, issuerID string, privateKey any, options ...ClientOptio
 		)
 
 		if resp.StatusCode() == 401 {
-			if jwtAuth, ok := transport.auth.(*JWTAuth); ok {
+			if jwtAuth, ok := transport.auth.(*JWTAuth); ok && jwtAuth.invalidate(resp.Request.AuthToken) {
 				transport.logger.Info("Received 401 response, forcing JWT token refresh")
-				jwtAuth.ForceRefresh()
 			}
 		}
 
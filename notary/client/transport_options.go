@@ -19,6 +19,7 @@ func WithBaseURL(urlStr string) ClientOption {
 			return fmt.Errorf("base URL cannot be empty")
 		}
 		c.baseURL = urlStr
+		c.httpClient.SetBaseURL(urlStr)
 		c.logger.Info("Base URL configured", zap.String("base_url", urlStr))
 		return nil
 	}
@@ -8,7 +8,10 @@ import (
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
+	"net/http"
 	"os"
+	"slices"
+	"sync"
 	"testing"
 
 	"github.com/jarcoal/httpmock"
@@ -302,6 +305,32 @@ func TestNewTransport_AuthMiddlewareSetup(t *testing.T) {
 	_, _ = c.NewRequest(ctx).SetResult(&result).Get("/notary/v2/test")
 }
 
+func TestWithBaseURL_AppliesToRequests(t *testing.T) {
+	privateKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	c, err := NewTransport("key", "issuer", privateKey,
+		WithAuth(&MockAuthProvider{}),
+		WithBaseURL("http://localhost:8089"),
+	)
+	if err != nil {
+		t.Fatalf("NewTransport failed: %v", err)
+	}
+
+	httpmock.ActivateNonDefault(c.httpClient.Client())
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "http://localhost:8089/notary/v2/test",
+		httpmock.NewJsonResponderOrPanic(200, map[string]string{"status": "ok"}))
+
+	var result map[string]string
+	if _, err := c.NewRequest(context.Background()).SetResult(&result).Get("/notary/v2/test"); err != nil {
+		t.Fatalf("request to custom base URL failed: %v", err)
+	}
+	if result["status"] != "ok" {
+		t.Errorf("result = %v, want status ok", result)
+	}
+}
+
 // savePrivateKeyToFile is a test helper that serializes an ECDSA key to a PEM file.
 func savePrivateKeyToFile(key *ecdsa.PrivateKey, path string) error {
 	keyBytes, err := x509.MarshalPKCS8PrivateKey(key)
@@ -316,3 +345,53 @@ func savePrivateKeyToFile(key *ecdsa.PrivateKey, path string) error {
 
 	return os.WriteFile(path, pemData, 0600)
 }
+
+func TestTransport_ConcurrentTokenRefresh(t *testing.T) {
+	privateKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	c, err := NewTransport("key", "issuer", privateKey, WithLogger(zap.NewNop()), WithRetryCount(0))
+	if err != nil {
+		t.Fatalf("NewTransport failed: %v", err)
+	}
+	httpmock.ActivateNonDefault(c.GetHTTPClient().Client())
+	t.Cleanup(httpmock.DeactivateAndReset)
+
+	server := &rejectFirstToken{}
+	httpmock.RegisterResponder("GET", DefaultBaseURL+"/notary/v2/submissions", server.respond)
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Go(func() {
+			_, _ = c.NewRequest(context.Background()).Get("/notary/v2/submissions")
+		})
+	}
+	wg.Wait()
+	if _, err := c.NewRequest(context.Background()).Get("/notary/v2/submissions"); err != nil {
+		t.Fatalf("request after refresh failed: %v", err)
+	}
+
+	// Requests rejected with the first token share one replacement rather
+	// than each discarding the one another has just signed.
+	if len(server.tokens) != 2 {
+		t.Errorf("distinct tokens = %d, want 2", len(server.tokens))
+	}
+}
+
+// rejectFirstToken answers 401 to requests bearing the first token it sees
+// and 200 to any other, recording each distinct token.
+type rejectFirstToken struct {
+	mu     sync.Mutex
+	tokens []string
+}
+
+func (r *rejectFirstToken) respond(req *http.Request) (*http.Response, error) {
+	token := req.Header.Get("Authorization")
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !slices.Contains(r.tokens, token) {
+		r.tokens = append(r.tokens, token)
+	}
+	if token == r.tokens[0] {
+		return httpmock.NewStringResponse(401, `{"errors":[{"status":"401","code":"NOT_AUTHORIZED"}]}`), nil
+	}
+	return httpmock.NewStringResponse(200, `{}`), nil
+}
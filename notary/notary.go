@@ -6,6 +6,7 @@ import (
 )
 
 // Client is the main entry point for the Apple Notary API SDK.
+// A Client is safe for concurrent use by multiple goroutines.
 type Client struct {
 	transport *client.Transport
 	NotaryAPI *NotaryAPIClient
@@ -1,5 +1,7 @@
 package submissions
 
+import "time"
+
 // Submission status values returned by the Notary API
 const (
 	SubmissionStatusAccepted   = "Accepted"
@@ -10,3 +12,14 @@ const (
 
 // NotificationChannelWebhook is the only supported notification channel
 const NotificationChannelWebhook = "webhook"
+
+// Notarization workflow defaults.
+const (
+	// DefaultS3Region is the AWS region of the bucket the notary service returns
+	// upload credentials for.
+	DefaultS3Region = "us-west-2"
+
+	// DefaultPollInterval is how often submission status is polled while
+	// waiting for notarization to finish.
+	DefaultPollInterval = 15 * time.Second
+)
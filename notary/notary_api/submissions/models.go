@@ -1,5 +1,10 @@
 package submissions
 
+import (
+	"net/http"
+	"time"
+)
+
 // NewSubmissionRequest is the request body for the Submit Software endpoint.
 type NewSubmissionRequest struct {
 	SHA256         string                             `json:"sha256"`
@@ -57,6 +62,16 @@ type SubmissionAttributes struct {
 	Status      string `json:"status"`
 }
 
+// IsInProgress reports whether the notary service is still processing the submission.
+func (a SubmissionAttributes) IsInProgress() bool {
+	return a.Status == SubmissionStatusInProgress
+}
+
+// IsAccepted reports whether the software was notarized successfully.
+func (a SubmissionAttributes) IsAccepted() bool {
+	return a.Status == SubmissionStatusAccepted
+}
+
 // SubmissionLogURLResponse is returned by the Get Submission Log endpoint.
 type SubmissionLogURLResponse struct {
 	Data SubmissionLogURLResponseData `json:"data"`
@@ -87,3 +102,64 @@ type SubmissionListResponseData struct {
 	ID         string               `json:"id"`
 	Type       string               `json:"type"`
 }
+
+// SubmissionLog is the developer log downloaded from the URL returned by the
+// Get Submission Log endpoint.
+type SubmissionLog struct {
+	LogFormatVersion int                   `json:"logFormatVersion"`
+	JobID            string                `json:"jobId"`
+	Status           string                `json:"status"`
+	StatusSummary    string                `json:"statusSummary"`
+	StatusCode       int                   `json:"statusCode"`
+	ArchiveFilename  string                `json:"archiveFilename"`
+	UploadDate       string                `json:"uploadDate"`
+	SHA256           string                `json:"sha256"`
+	TicketContents   []SubmissionLogTicket `json:"ticketContents"`
+	Issues           []SubmissionLogIssue  `json:"issues"`
+}
+
+// SubmissionLogTicket describes one signed item included in the notarization ticket.
+type SubmissionLogTicket struct {
+	Path            string `json:"path"`
+	DigestAlgorithm string `json:"digestAlgorithm"`
+	CDHash          string `json:"cdhash"`
+	Arch            string `json:"arch"`
+}
+
+// SubmissionLogIssue describes a problem the notary service found in the software.
+type SubmissionLogIssue struct {
+	Severity     string `json:"severity"`
+	Code         *int   `json:"code"`
+	Path         string `json:"path"`
+	Message      string `json:"message"`
+	DocURL       string `json:"docUrl"`
+	Architecture string `json:"architecture"`
+}
+
+// NotarizeOptions controls how the software is uploaded and how the workflow
+// helpers wait for and fetch notarization results.
+type NotarizeOptions struct {
+	// HTTPClient performs the S3 upload and log download, which are not
+	// authenticated with the notary API token. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+
+	// S3Region is the region used to sign the upload. Defaults to DefaultS3Region.
+	S3Region string
+
+	// S3Endpoint overrides the S3 endpoint, e.g. for a proxy. When set, the
+	// object is uploaded path-style to S3Endpoint/bucket/object.
+	S3Endpoint string
+
+	// PollInterval is how often submission status is polled. Defaults to DefaultPollInterval.
+	PollInterval time.Duration
+
+	// Notifications are passed to the Submit Software endpoint by NotarizeFile.
+	Notifications []NewSubmissionRequestNotification
+}
+
+// NotarizationResult is the outcome of NotarizeFile.
+type NotarizationResult struct {
+	SubmissionID string
+	Submission   *SubmissionResponse
+	Log          *SubmissionLog
+}
@@ -0,0 +1,92 @@
+package submissions
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/sigv4"
+)
+
+// UploadSoftware uploads the software for a submission to the S3 bucket
+// returned by SubmitSoftwareV2, signing the request with the temporary AWS
+// credentials from the submission response.
+//
+// sha256Hex must be the hash that was sent with the submission; it is also
+// used as the signed payload hash, so S3 rejects a body that doesn't match.
+func (s *Submissions) UploadSoftware(ctx context.Context, submission *NewSubmissionResponse, body io.Reader, size int64, sha256Hex string, opts *NotarizeOptions) error {
+	if submission == nil {
+		return fmt.Errorf("submission is required")
+	}
+	attrs := submission.Data.Attributes
+	if attrs.AWSAccessKeyID == "" || attrs.AWSSecretAccessKey == "" {
+		return fmt.Errorf("submission does not contain upload credentials")
+	}
+	if attrs.Bucket == "" || attrs.Object == "" {
+		return fmt.Errorf("submission does not contain an upload bucket and object")
+	}
+	if body == nil {
+		return fmt.Errorf("body is required")
+	}
+	if !sha256Re.MatchString(sha256Hex) {
+		return fmt.Errorf("sha256 must be a 64-character hexadecimal string")
+	}
+
+	opts = withNotarizeDefaults(opts)
+
+	uploadURL := "https://" + attrs.Bucket + ".s3." + opts.S3Region + ".amazonaws.com/" + sigv4.EscapePath(attrs.Object)
+	if opts.S3Endpoint != "" {
+		uploadURL = strings.TrimSuffix(opts.S3Endpoint, "/") + "/" + sigv4.EscapePath(attrs.Bucket) + "/" + sigv4.EscapePath(attrs.Object)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uploadURL, body)
+	if err != nil {
+		return fmt.Errorf("failed to create upload request: %w", err)
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", "application/octet-stream")
+	payloadHash := strings.ToLower(sha256Hex)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	creds := sigv4.Credentials{
+		AccessKeyID:     attrs.AWSAccessKeyID,
+		SecretAccessKey: attrs.AWSSecretAccessKey,
+		SessionToken:    attrs.AWSSessionToken,
+	}
+	sigv4.Sign(req, creds, opts.S3Region, "s3", payloadHash, time.Now())
+
+	resp, err := opts.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to upload software: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("software upload failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(detail)))
+	}
+
+	s.client.GetLogger().Debug("Uploaded software for notarization")
+	return nil
+}
+
+// withNotarizeDefaults returns a copy of opts with unset fields defaulted.
+func withNotarizeDefaults(opts *NotarizeOptions) *NotarizeOptions {
+	resolved := NotarizeOptions{}
+	if opts != nil {
+		resolved = *opts
+	}
+	if resolved.HTTPClient == nil {
+		resolved.HTTPClient = http.DefaultClient
+	}
+	if resolved.S3Region == "" {
+		resolved.S3Region = DefaultS3Region
+	}
+	if resolved.PollInterval <= 0 {
+		resolved.PollInterval = DefaultPollInterval
+	}
+	return &resolved
+}
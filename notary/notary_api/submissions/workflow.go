@@ -0,0 +1,137 @@
+package submissions
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// WaitForNotarization polls a submission until the notary service finishes
+// processing it and returns the final status. A submission that ends Invalid
+// or Rejected is not an error; check IsAccepted on the returned attributes and
+// fetch the log with DownloadSubmissionLog for details.
+//
+// The wait is bounded only by ctx; use context.WithTimeout to limit it.
+func (s *Submissions) WaitForNotarization(ctx context.Context, submissionID string, opts *NotarizeOptions) (*SubmissionResponse, error) {
+	if submissionID == "" {
+		return nil, fmt.Errorf("submissionID is required")
+	}
+
+	opts = withNotarizeDefaults(opts)
+
+	ticker := time.NewTicker(opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		status, _, err := s.GetSubmissionStatusV2(ctx, submissionID)
+		if err != nil {
+			return nil, err
+		}
+		if !status.Data.Attributes.IsInProgress() {
+			return status, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// DownloadSubmissionLog fetches a fresh log URL for a completed submission and
+// downloads and decodes the developer log it points to.
+func (s *Submissions) DownloadSubmissionLog(ctx context.Context, submissionID string, opts *NotarizeOptions) (*SubmissionLog, error) {
+	logURL, _, err := s.GetSubmissionLogV2(ctx, submissionID)
+	if err != nil {
+		return nil, err
+	}
+	if logURL.Data.Attributes.DeveloperLogURL == "" {
+		return nil, fmt.Errorf("submission %s has no developer log URL", submissionID)
+	}
+
+	opts = withNotarizeDefaults(opts)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, logURL.Data.Attributes.DeveloperLogURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create log request: %w", err)
+	}
+
+	resp, err := opts.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download submission log: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("submission log download failed with status %d", resp.StatusCode)
+	}
+
+	var log SubmissionLog
+	if err := json.NewDecoder(resp.Body).Decode(&log); err != nil {
+		return nil, fmt.Errorf("failed to decode submission log: %w", err)
+	}
+
+	return &log, nil
+}
+
+// NotarizeFile runs the full notarization workflow for the file at path:
+// it hashes the file, creates a submission, uploads the file to S3, waits for
+// the notary service to finish and downloads the developer log.
+//
+// The returned result carries the submission ID as soon as it is known, so it
+// is non-nil on errors after the submission was created.
+func (s *Submissions) NotarizeFile(ctx context.Context, path string, opts *NotarizeOptions) (*NotarizationResult, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash %s: %w", path, err)
+	}
+	sha256Hex := hex.EncodeToString(hash.Sum(nil))
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("failed to rewind %s: %w", path, err)
+	}
+
+	opts = withNotarizeDefaults(opts)
+
+	submission, _, err := s.SubmitSoftwareV2(ctx, &NewSubmissionRequest{
+		SHA256:         sha256Hex,
+		SubmissionName: filepath.Base(path),
+		Notifications:  opts.Notifications,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create submission: %w", err)
+	}
+
+	result := &NotarizationResult{SubmissionID: submission.Data.ID}
+
+	if err := s.UploadSoftware(ctx, submission, file, size, sha256Hex, opts); err != nil {
+		return result, err
+	}
+
+	result.Submission, err = s.WaitForNotarization(ctx, result.SubmissionID, opts)
+	if err != nil {
+		return result, fmt.Errorf("failed waiting for submission %s: %w", result.SubmissionID, err)
+	}
+
+	result.Log, err = s.DownloadSubmissionLog(ctx, result.SubmissionID, opts)
+	if err != nil {
+		return result, fmt.Errorf("failed to fetch log for submission %s: %w", result.SubmissionID, err)
+	}
+
+	return result, nil
+}
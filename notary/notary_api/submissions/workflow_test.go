@@ -0,0 +1,143 @@
+package submissions
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/notary/notary_api/submissions/mocks"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// statusResponder returns submission status responses with the given statuses in order,
+// repeating the last one.
+func statusResponder(statuses ...string) (httpmock.Responder, *int) {
+	calls := 0
+	return func(req *http.Request) (*http.Response, error) {
+		status := statuses[min(calls, len(statuses)-1)]
+		calls++
+		return httpmock.NewJsonResponse(200, map[string]any{
+			"data": map[string]any{
+				"id":         "2efe2717-52ef-43a5-96dc-0797e4ca1041",
+				"type":       "submissions",
+				"attributes": map[string]any{"name": "OvernightTextEditor_11.6.8.zip", "status": status},
+			},
+		})
+	}, &calls
+}
+
+func TestWaitForNotarization_PollsUntilDone(t *testing.T) {
+	svc := setupMockClient(t)
+
+	responder, calls := statusResponder(SubmissionStatusInProgress, SubmissionStatusInProgress, SubmissionStatusInvalid)
+	httpmock.RegisterResponder("GET", "https://appstoreconnect.apple.com/notary/v2/submissions/2efe2717-52ef-43a5-96dc-0797e4ca1041", responder)
+
+	result, err := svc.WaitForNotarization(context.Background(), "2efe2717-52ef-43a5-96dc-0797e4ca1041",
+		&NotarizeOptions{PollInterval: time.Millisecond})
+
+	require.NoError(t, err)
+	assert.Equal(t, SubmissionStatusInvalid, result.Data.Attributes.Status)
+	assert.False(t, result.Data.Attributes.IsAccepted())
+	assert.Equal(t, 3, *calls)
+}
+
+func TestWaitForNotarization_ContextCancelled(t *testing.T) {
+	svc := setupMockClient(t)
+
+	responder, _ := statusResponder(SubmissionStatusInProgress)
+	httpmock.RegisterResponder("GET", "https://appstoreconnect.apple.com/notary/v2/submissions/2efe2717-52ef-43a5-96dc-0797e4ca1041", responder)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := svc.WaitForNotarization(ctx, "2efe2717-52ef-43a5-96dc-0797e4ca1041", &NotarizeOptions{PollInterval: time.Millisecond})
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestWaitForNotarization_EmptyID(t *testing.T) {
+	svc := setupMockClient(t)
+
+	_, err := svc.WaitForNotarization(context.Background(), "", nil)
+
+	assert.ErrorContains(t, err, "submissionID is required")
+}
+
+func TestDownloadSubmissionLog_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.SubmissionsMock{}
+	mockHandler.RegisterMocks()
+	mockHandler.RegisterWorkflowMocks()
+	defer mockHandler.CleanupMockState()
+
+	log, err := svc.DownloadSubmissionLog(context.Background(), "2efe2717-52ef-43a5-96dc-0797e4ca1041",
+		&NotarizeOptions{HTTPClient: &http.Client{Transport: httpmock.DefaultTransport}})
+
+	require.NoError(t, err)
+	assert.Equal(t, SubmissionStatusAccepted, log.Status)
+	assert.Equal(t, "Ready for distribution", log.StatusSummary)
+	require.Len(t, log.TicketContents, 1)
+	assert.Equal(t, "arm64", log.TicketContents[0].Arch)
+	require.Len(t, log.Issues, 1)
+	assert.Equal(t, "warning", log.Issues[0].Severity)
+	assert.Nil(t, log.Issues[0].Code)
+}
+
+func TestNotarizeFile_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.SubmissionsMock{}
+	mockHandler.RegisterMocks()
+	mockHandler.RegisterWorkflowMocks()
+	defer mockHandler.CleanupMockState()
+
+	path := filepath.Join(t.TempDir(), "OvernightTextEditor_11.6.8.zip")
+	require.NoError(t, os.WriteFile(path, []byte("not really a zip"), 0o600))
+
+	result, err := svc.NotarizeFile(context.Background(), path, &NotarizeOptions{
+		HTTPClient:   &http.Client{Transport: httpmock.DefaultTransport},
+		PollInterval: time.Millisecond,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "2efe2717-52ef-43a5-96dc-0797e4ca1041", result.SubmissionID)
+	assert.True(t, result.Submission.Data.Attributes.IsAccepted())
+	assert.Equal(t, "Ready for distribution", result.Log.StatusSummary)
+
+	info := httpmock.GetCallCountInfo()
+	assert.Equal(t, 1, info["POST https://appstoreconnect.apple.com/notary/v2/submissions"])
+	assert.Equal(t, 1, info["PUT https://EXAMPLE-BUCKET.s3.us-west-2.amazonaws.com/EXAMPLE-KEY-NAME"])
+}
+
+func TestNotarizeFile_UploadFailureKeepsSubmissionID(t *testing.T) {
+	svc := setupMockClient(t)
+	mockHandler := &mocks.SubmissionsMock{}
+	mockHandler.RegisterMocks()
+	defer mockHandler.CleanupMockState()
+
+	httpmock.RegisterResponder("PUT", "https://EXAMPLE-BUCKET.s3.us-west-2.amazonaws.com/EXAMPLE-KEY-NAME", httpmock.NewStringResponder(500, ""))
+
+	path := filepath.Join(t.TempDir(), "app.zip")
+	require.NoError(t, os.WriteFile(path, []byte("zip"), 0o600))
+
+	result, err := svc.NotarizeFile(context.Background(), path, &NotarizeOptions{
+		HTTPClient: &http.Client{Transport: httpmock.DefaultTransport},
+	})
+
+	require.Error(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "2efe2717-52ef-43a5-96dc-0797e4ca1041", result.SubmissionID)
+}
+
+func TestNotarizeFile_MissingFile(t *testing.T) {
+	svc := setupMockClient(t)
+
+	_, err := svc.NotarizeFile(context.Background(), filepath.Join(t.TempDir(), "missing.zip"), nil)
+
+	assert.ErrorContains(t, err, "failed to open")
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
@@ -0,0 +1,96 @@
+package client
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/internal/es256"
+	"resty.dev/v3"
+)
+
+// AuthProvider interface for different authentication methods
+type AuthProvider interface {
+	ApplyAuth(req *resty.Request) error
+}
+
+// ClientSecretAuth authenticates token endpoint requests with a client secret:
+// an ES256 JWT signed by a Sign in with Apple private key, sent with the
+// client ID as the client_id and client_secret form fields. It is safe for
+// concurrent use.
+type ClientSecretAuth struct {
+	config ClientSecretAuthConfig
+	signer *es256.Signer
+}
+
+// ClientSecretAuthConfig holds configuration for client secret authentication
+type ClientSecretAuthConfig struct {
+	TeamID     string
+	ClientID   string // Services ID for web sign-in, or the App ID for native apps
+	KeyID      string
+	PrivateKey *ecdsa.PrivateKey
+	Lifetime   time.Duration // Defaults to DefaultClientSecretLifetime
+}
+
+// NewClientSecretAuth creates a new client secret authentication provider.
+// A client secret is replaced 5 minutes before it expires.
+func NewClientSecretAuth(config ClientSecretAuthConfig) *ClientSecretAuth {
+	if config.Lifetime == 0 {
+		config.Lifetime = DefaultClientSecretLifetime
+	}
+
+	a := &ClientSecretAuth{}
+	a.configure(config)
+	return a
+}
+
+// configure replaces the client secret configuration, discarding the current
+// secret.
+func (a *ClientSecretAuth) configure(config ClientSecretAuthConfig) {
+	a.config = config
+	a.signer = es256.NewSigner(config.KeyID, config.TeamID, config.PrivateKey, config.Lifetime-5*time.Minute,
+		es256.WithClaims(map[string]any{"aud": Issuer, "sub": config.ClientID}),
+		es256.WithExpiry(config.Lifetime))
+}
+
+// ApplyAuth adds the client credentials to POST requests. The public key
+// endpoint is unauthenticated, so GET requests are left untouched.
+func (a *ClientSecretAuth) ApplyAuth(req *resty.Request) error {
+	if req.Method != http.MethodPost {
+		return nil
+	}
+
+	secret, err := a.ClientSecret()
+	if err != nil {
+		return fmt.Errorf("failed to get client secret: %w", err)
+	}
+
+	req.SetFormData(map[string]string{
+		"client_id":     a.config.ClientID,
+		"client_secret": secret,
+	})
+	return nil
+}
+
+// ClientSecret returns a valid client secret, generating a new one when the
+// current one is within 5 minutes of expiry.
+func (a *ClientSecretAuth) ClientSecret() (string, error) {
+	secret, err := a.signer.Token()
+	if err != nil {
+		return "", fmt.Errorf("failed to sign client secret: %w", err)
+	}
+	return secret, nil
+}
+
+// ForceRefresh forces a new client secret on the next request
+func (a *ClientSecretAuth) ForceRefresh() {
+	a.signer.Refresh()
+}
+
+// invalidate discards secret if it is still the current client secret and
+// reports whether it did, so concurrent requests rejected with the same
+// secret sign one replacement between them.
+func (a *ClientSecretAuth) invalidate(secret string) bool {
+	return a.signer.Invalidate(secret)
+}
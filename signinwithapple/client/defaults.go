@@ -0,0 +1,24 @@
+package client
+
+import "time"
+
+// DefaultUserAgent is the default User-Agent header value for all requests.
+const (
+	DefaultUserAgent = "go-api-sdk-apple/1.0.0"
+	Version          = "1.0.0"
+)
+
+// The following constants are re-exported from the constants package so that
+// code and tests in the client package can reference them without importing
+// the constants package directly.
+const (
+	DefaultBaseURL = "https://appleid.apple.com"
+	Issuer         = "https://appleid.apple.com"
+)
+
+// Client secret lifetimes.
+const (
+	DefaultClientSecretLifetime = time.Hour
+	// MaxClientSecretLifetime is the longest lifetime Apple accepts, 6 months.
+	MaxClientSecretLifetime = 15777000 * time.Second
+)
@@ -0,0 +1,95 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+// OAuth 2.0 error codes returned by the token and revoke endpoints in
+// ErrorResponse.Error.
+const (
+	ErrorCodeInvalidRequest       = "invalid_request"
+	ErrorCodeInvalidClient        = "invalid_client"
+	ErrorCodeInvalidGrant         = "invalid_grant"
+	ErrorCodeUnauthorizedClient   = "unauthorized_client"
+	ErrorCodeUnsupportedGrantType = "unsupported_grant_type"
+	ErrorCodeInvalidScope         = "invalid_scope"
+)
+
+// APIError represents an error from the Sign in with Apple REST API.
+// The API returns errors as { "error": "invalid_grant" }, sometimes with an
+// "error_description".
+type APIError struct {
+	Code        string
+	Description string
+	StatusCode  int
+}
+
+func (e *APIError) Error() string {
+	switch {
+	case e.Code != "" && e.Description != "":
+		return fmt.Sprintf("API error %d: %s - %s", e.StatusCode, e.Code, e.Description)
+	case e.Code != "":
+		return fmt.Sprintf("API error %d: %s", e.StatusCode, e.Code)
+	}
+	return fmt.Sprintf("API error %d: %s", e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+// ErrorResponse represents the OAuth 2.0 error response structure returned by
+// the Sign in with Apple REST API.
+type ErrorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+// IsAPIError reports whether err wraps an *APIError carrying the given error code.
+func IsAPIError(err error, code string) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.Code == code
+}
+
+// ErrorHandler centralizes error handling for all API requests
+type ErrorHandler struct {
+	logger *zap.Logger
+}
+
+// NewErrorHandler creates a new error handler
+func NewErrorHandler(logger *zap.Logger) *ErrorHandler {
+	return &ErrorHandler{
+		logger: logger,
+	}
+}
+
+// HandleError processes Sign in with Apple error responses and returns structured errors
+func (eh *ErrorHandler) HandleError(resp *resty.Response, errorResp *ErrorResponse) error {
+	statusCode := resp.StatusCode()
+
+	if errorResp != nil && errorResp.Error != "" {
+		eh.logger.Error("API request failed",
+			zap.Int("status_code", statusCode),
+			zap.String("error", errorResp.Error),
+			zap.String("error_description", errorResp.ErrorDescription),
+			zap.String("url", resp.Request.URL),
+			zap.String("method", resp.Request.Method),
+		)
+
+		return &APIError{
+			Code:        errorResp.Error,
+			Description: errorResp.ErrorDescription,
+			StatusCode:  statusCode,
+		}
+	}
+
+	eh.logger.Error("API request failed (no structured error)",
+		zap.Int("status_code", statusCode),
+		zap.String("url", resp.Request.URL),
+		zap.String("method", resp.Request.Method),
+		zap.String("response_body", resp.String()),
+	)
+
+	return &APIError{StatusCode: statusCode}
+}
@@ -0,0 +1,146 @@
+package client
+
+import (
+	"context"
+
+	"resty.dev/v3"
+)
+
+// requestExecutor is the execution backend for a RequestBuilder.
+// Transport implements it directly; tests supply a mock via NewMockRequestBuilder.
+type requestExecutor interface {
+	execute(req *resty.Request, method, path string, result any) (*resty.Response, error)
+}
+
+// RequestBuilder constructs a single API request. The service layer owns the
+// full request shape — headers, body, query params, result target — before
+// handing the completed request to the executor (transport) which handles
+// auth, retry, and error handling.
+//
+// Usage:
+//
+//	resp, err := s.client.NewRequest(ctx).
+//	    SetFormData(map[string]string{"grant_type": "authorization_code", "code": code}).
+//	    SetResult(&result).
+//	    Post(constants.EndpointToken)
+type RequestBuilder struct {
+	req      *resty.Request
+	executor requestExecutor
+	result   any
+}
+
+// SetHeader sets a request-level header. Empty values are ignored.
+func (b *RequestBuilder) SetHeader(key, value string) *RequestBuilder {
+	if value != "" {
+		b.req.SetHeader(key, value)
+	}
+	return b
+}
+
+// SetQueryParam adds a URL query parameter. Empty values are ignored.
+func (b *RequestBuilder) SetQueryParam(key, value string) *RequestBuilder {
+	if value != "" {
+		b.req.SetQueryParam(key, value)
+	}
+	return b
+}
+
+// AddQueryParam appends a value to a repeatable URL query parameter, e.g.
+// productId=a&productId=b. Empty values are ignored.
+func (b *RequestBuilder) AddQueryParam(key, value string) *RequestBuilder {
+	if value != "" {
+		b.req.QueryParams.Add(key, value)
+	}
+	return b
+}
+
+// SetQueryParams adds multiple URL query parameters in bulk. Empty values are ignored.
+func (b *RequestBuilder) SetQueryParams(params map[string]string) *RequestBuilder {
+	for k, v := range params {
+		if v != "" {
+			b.req.SetQueryParam(k, v)
+		}
+	}
+	return b
+}
+
+// SetFormData sets form fields for an application/x-www-form-urlencoded
+// body. Empty values are ignored.
+func (b *RequestBuilder) SetFormData(data map[string]string) *RequestBuilder {
+	for k, v := range data {
+		if v != "" {
+			b.req.FormData.Set(k, v)
+		}
+	}
+	return b
+}
+
+// SetBody sets the request body. Nil is ignored.
+func (b *RequestBuilder) SetBody(body any) *RequestBuilder {
+	if body != nil {
+		b.req.SetBody(body)
+	}
+	return b
+}
+
+// SetResult sets the target for JSON unmarshaling of a successful response.
+func (b *RequestBuilder) SetResult(result any) *RequestBuilder {
+	b.result = result
+	b.req.SetResult(result)
+	return b
+}
+
+// Get executes the request as GET against path.
+func (b *RequestBuilder) Get(path string) (*resty.Response, error) {
+	return b.executor.execute(b.req, "GET", path, b.result)
+}
+
+// Post executes the request as POST against path.
+func (b *RequestBuilder) Post(path string) (*resty.Response, error) {
+	return b.executor.execute(b.req, "POST", path, b.result)
+}
+
+// mockRequestExecutor backs a RequestBuilder in tests, routing execution
+// through a caller-supplied dispatch function instead of a real Transport.
+type mockRequestExecutor struct {
+	fn              func(method, path string, result any) (*resty.Response, error)
+	queryParamStore *map[string]string
+}
+
+func (m *mockRequestExecutor) execute(req *resty.Request, method, path string, result any) (*resty.Response, error) {
+	m.captureQueryParams(req)
+	return m.fn(method, path, result)
+}
+
+func (m *mockRequestExecutor) captureQueryParams(req *resty.Request) {
+	if m.queryParamStore != nil && req != nil {
+		params := make(map[string]string)
+		for k, v := range req.QueryParams {
+			if len(v) > 0 {
+				params[k] = v[0]
+			}
+		}
+		if len(params) > 0 {
+			*m.queryParamStore = params
+		}
+	}
+}
+
+// NewMockRequestBuilder returns a RequestBuilder suitable for unit tests.
+// The fn callback receives the HTTP method, path, and result pointer and
+// returns a pre-programmed response.
+func NewMockRequestBuilder(ctx context.Context, fn func(method, path string, result any) (*resty.Response, error)) *RequestBuilder {
+	return &RequestBuilder{
+		req:      resty.New().R().SetContext(ctx),
+		executor: &mockRequestExecutor{fn: fn, queryParamStore: nil},
+	}
+}
+
+// NewMockRequestBuilderWithQueryCapture returns a RequestBuilder suitable for
+// unit tests that also captures query parameters into the provided map pointer.
+func NewMockRequestBuilderWithQueryCapture(ctx context.Context, fn func(method, path string, result any) (*resty.Response, error), queryStore *map[string]string) *RequestBuilder {
+	return &RequestBuilder{
+		req:      resty.New().R().SetContext(ctx),
+		executor: &mockRequestExecutor{fn: fn, queryParamStore: queryStore},
+	}
+}
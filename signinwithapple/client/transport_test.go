@@ -0,0 +1,217 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jarcoal/httpmock"
+	"go.uber.org/zap"
+)
+
+func newTestKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	return key
+}
+
+func TestNewTransport_RequiredArguments(t *testing.T) {
+	key := newTestKey(t)
+
+	tests := []struct {
+		name                  string
+		teamID, clientID, kid string
+		privateKey            any
+		wantErr               string
+	}{
+		{"missing team ID", "", "com.example.web", "kid", key, "teamID is required"},
+		{"missing client ID", "TEAM123456", "", "kid", key, "clientID is required"},
+		{"missing key ID", "TEAM123456", "com.example.web", "", key, "keyID is required"},
+		{"missing key", "TEAM123456", "com.example.web", "kid", nil, "privateKey is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewTransport(tt.teamID, tt.clientID, tt.kid, tt.privateKey)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewTransport_RejectsNonP256Keys(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	if _, err := NewTransport("TEAM123456", "com.example.web", "kid", rsaKey); err == nil {
+		t.Error("expected RSA key to be rejected")
+	}
+}
+
+func TestWithClientSecretLifetime_Bounds(t *testing.T) {
+	key := newTestKey(t)
+	if _, err := NewTransport("TEAM123456", "com.example.web", "kid", key, WithClientSecretLifetime(0)); err == nil {
+		t.Error("expected zero lifetime to be rejected")
+	}
+	if _, err := NewTransport("TEAM123456", "com.example.web", "kid", key, WithClientSecretLifetime(MaxClientSecretLifetime+time.Second)); err == nil {
+		t.Error("expected lifetime over 6 months to be rejected")
+	}
+}
+
+func TestClientSecretAuth_PostsClientCredentials(t *testing.T) {
+	key := newTestKey(t)
+	transport, err := NewTransport("TEAM123456", "com.example.web", "ABC123DEFG", key,
+		WithLogger(zap.NewNop()), WithRetryCount(0), WithClientSecretLifetime(2*time.Hour))
+	if err != nil {
+		t.Fatalf("NewTransport failed: %v", err)
+	}
+
+	httpmock.ActivateNonDefault(transport.GetHTTPClient().Client())
+	t.Cleanup(httpmock.DeactivateAndReset)
+
+	var form map[string][]string
+	httpmock.RegisterResponder("POST", DefaultBaseURL+"/auth/token", func(req *http.Request) (*http.Response, error) {
+		if err := req.ParseForm(); err != nil {
+			return nil, err
+		}
+		form = req.PostForm
+		return httpmock.NewStringResponse(200, `{}`), nil
+	})
+	var getQuery string
+	httpmock.RegisterResponder("GET", DefaultBaseURL+"/auth/keys", func(req *http.Request) (*http.Response, error) {
+		getQuery = req.URL.RawQuery
+		return httpmock.NewStringResponse(200, `{"keys": []}`), nil
+	})
+
+	if _, err := transport.NewRequest(context.Background()).
+		SetFormData(map[string]string{"grant_type": "authorization_code", "code": "c1"}).
+		Post("/auth/token"); err != nil {
+		t.Fatalf("POST failed: %v", err)
+	}
+
+	if got := form["client_id"]; len(got) != 1 || got[0] != "com.example.web" {
+		t.Errorf("client_id = %v", got)
+	}
+	if got := form["code"]; len(got) != 1 || got[0] != "c1" {
+		t.Errorf("code = %v", got)
+	}
+
+	secret := form["client_secret"][0]
+	token, err := jwt.Parse(secret, func(*jwt.Token) (any, error) { return &key.PublicKey, nil },
+		jwt.WithValidMethods([]string{"ES256"}))
+	if err != nil {
+		t.Fatalf("client secret does not verify: %v", err)
+	}
+	if token.Header["kid"] != "ABC123DEFG" {
+		t.Errorf("kid = %v", token.Header["kid"])
+	}
+	claims := token.Claims.(jwt.MapClaims)
+	if claims["iss"] != "TEAM123456" || claims["sub"] != "com.example.web" || claims["aud"] != Issuer {
+		t.Errorf("unexpected claims: %v", claims)
+	}
+	iat, _ := claims.GetIssuedAt()
+	exp, _ := claims.GetExpirationTime()
+	if exp.Sub(iat.Time) != 2*time.Hour {
+		t.Errorf("lifetime = %v, want 2h", exp.Sub(iat.Time))
+	}
+
+	if _, err := transport.NewRequest(context.Background()).Get("/auth/keys"); err != nil {
+		t.Fatalf("GET failed: %v", err)
+	}
+	if strings.Contains(getQuery, "client_secret") {
+		t.Error("client secret must not be sent on GET requests")
+	}
+}
+
+func TestExecute_InvalidClientForcesNewSecret(t *testing.T) {
+	transport, err := NewTransport("TEAM123456", "com.example.web", "kid", newTestKey(t),
+		WithLogger(zap.NewNop()), WithRetryCount(0))
+	if err != nil {
+		t.Fatalf("NewTransport failed: %v", err)
+	}
+
+	httpmock.ActivateNonDefault(transport.GetHTTPClient().Client())
+	t.Cleanup(httpmock.DeactivateAndReset)
+
+	var sent string
+	httpmock.RegisterResponder("POST", DefaultBaseURL+"/auth/token", func(req *http.Request) (*http.Response, error) {
+		if err := req.ParseForm(); err != nil {
+			return nil, err
+		}
+		sent = req.PostForm.Get("client_secret")
+		resp := httpmock.NewStringResponse(400, `{"error": "invalid_client"}`)
+		resp.Header.Set("Content-Type", "application/json")
+		return resp, nil
+	})
+
+	auth := transport.auth.(*ClientSecretAuth)
+	_, err = transport.NewRequest(context.Background()).Post("/auth/token")
+	if !IsAPIError(err, ErrorCodeInvalidClient) {
+		t.Fatalf("error = %v, want invalid_client", err)
+	}
+	if sent == "" || auth.invalidate(sent) {
+		t.Error("expected the cached client secret to be discarded")
+	}
+}
+
+func TestExecute_ConcurrentInvalidClientSignsOneSecret(t *testing.T) {
+	transport, err := NewTransport("TEAM123456", "com.example.web", "kid", newTestKey(t),
+		WithLogger(zap.NewNop()), WithRetryCount(0))
+	if err != nil {
+		t.Fatalf("NewTransport failed: %v", err)
+	}
+
+	httpmock.ActivateNonDefault(transport.GetHTTPClient().Client())
+	t.Cleanup(httpmock.DeactivateAndReset)
+
+	var mu sync.Mutex
+	var secrets []string
+	httpmock.RegisterResponder("POST", DefaultBaseURL+"/auth/token", func(req *http.Request) (*http.Response, error) {
+		if err := req.ParseForm(); err != nil {
+			return nil, err
+		}
+		secret := req.PostForm.Get("client_secret")
+		mu.Lock()
+		defer mu.Unlock()
+		if !slices.Contains(secrets, secret) {
+			secrets = append(secrets, secret)
+		}
+		status, body := 200, `{}`
+		if secret == secrets[0] {
+			status, body = 400, `{"error": "invalid_client"}`
+		}
+		resp := httpmock.NewStringResponse(status, body)
+		resp.Header.Set("Content-Type", "application/json")
+		return resp, nil
+	})
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Go(func() {
+			_, _ = transport.NewRequest(context.Background()).Post("/auth/token")
+		})
+	}
+	wg.Wait()
+	if _, err := transport.NewRequest(context.Background()).Post("/auth/token"); err != nil {
+		t.Fatalf("request after refresh failed: %v", err)
+	}
+
+	// Requests rejected with the first secret share one replacement.
+	if len(secrets) != 2 {
+		t.Errorf("distinct client secrets = %d, want 2", len(secrets))
+	}
+}
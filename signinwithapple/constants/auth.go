@@ -0,0 +1,14 @@
+package constants
+
+// Token issuer and client secret audience for Sign in with Apple
+const (
+	// Issuer is the "iss" claim of identity tokens and the "aud" claim of
+	// client secrets.
+	Issuer = "https://appleid.apple.com"
+)
+
+// OAuth 2.0 grant types accepted by the token endpoint
+const (
+	GrantTypeAuthorizationCode = "authorization_code"
+	GrantTypeRefreshToken      = "refresh_token"
+)
@@ -0,0 +1,14 @@
+package constants
+
+// API base URLs
+const (
+	// DefaultBaseURL is the base URL of the Sign in with Apple REST API.
+	DefaultBaseURL = "https://appleid.apple.com"
+)
+
+// Endpoint path constants for the Sign in with Apple REST API
+const (
+	EndpointKeys   = "/auth/keys"
+	EndpointToken  = "/auth/token"
+	EndpointRevoke = "/auth/revoke"
+)
@@ -0,0 +1,7 @@
+package constants
+
+// MIME type constants for HTTP headers
+const (
+	ApplicationJSON           = "application/json"
+	ApplicationFormURLEncoded = "application/x-www-form-urlencoded"
+)
@@ -0,0 +1,85 @@
+package signinwithapple
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-apple/signinwithapple/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/signinwithapple/signinwithapple_api/identity"
+	"github.com/deploymenttheory/go-api-sdk-apple/signinwithapple/signinwithapple_api/tokens"
+)
+
+// Client is the main entry point for the Sign in with Apple SDK.
+// A Client is safe for concurrent use by multiple goroutines.
+type Client struct {
+	transport          *client.Transport
+	SignInWithAppleAPI *SignInWithAppleAPIClient
+}
+
+// SignInWithAppleAPIClient groups all Sign in with Apple services.
+type SignInWithAppleAPIClient struct {
+	Identity *identity.Identity
+	Tokens   *tokens.Tokens
+}
+
+// NewClient creates a new Sign in with Apple client.
+// Parameters:
+//   - teamID: Your Apple Developer team ID
+//   - clientID: The Services ID (web) or App ID (native apps) users sign in to
+//   - keyID: The ID of a key with Sign in with Apple enabled
+//   - privateKey: The key's private key (*ecdsa.PrivateKey, P-256)
+//   - options: Optional configuration options (WithLogger, WithClientSecretLifetime, etc.)
+//
+// Example:
+//
+//	c, err := signinwithapple.NewClientFromFile(teamID, "com.example.web", keyID, "AuthKey.p8")
+//	tokens, _, err := c.SignInWithAppleAPI.Tokens.ExchangeCodeV1(ctx, code, redirectURI)
+//	claims, err := c.SignInWithAppleAPI.Identity.ValidateIdentityToken(ctx, tokens.IDToken, &identity.ValidateOptions{Nonce: nonce})
+func NewClient(teamID, clientID, keyID string, privateKey any, options ...client.ClientOption) (*Client, error) {
+	transport, err := client.NewTransport(teamID, clientID, keyID, privateKey, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return newClient(transport), nil
+}
+
+// NewClientFromFile creates a client using a private key from file.
+// Parameters:
+//   - teamID: Your Apple Developer team ID
+//   - clientID: The Services ID (web) or App ID (native apps) users sign in to
+//   - keyID: The ID of a key with Sign in with Apple enabled
+//   - privateKeyPath: Path to the key's private key file (.p8)
+//   - options: Optional configuration options (WithLogger, WithClientSecretLifetime, etc.)
+func NewClientFromFile(teamID, clientID, keyID, privateKeyPath string, options ...client.ClientOption) (*Client, error) {
+	transport, err := client.NewTransportFromFile(teamID, clientID, keyID, privateKeyPath, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return newClient(transport), nil
+}
+
+// NewClientFromEnv creates a client using environment variables.
+// Expects: APPLE_TEAM_ID, APPLE_CLIENT_ID, APPLE_KEY_ID, and one of
+// APPLE_PRIVATE_KEY_PEM or APPLE_PRIVATE_KEY_PATH.
+func NewClientFromEnv(options ...client.ClientOption) (*Client, error) {
+	transport, err := client.NewTransportFromEnv(options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return newClient(transport), nil
+}
+
+func newClient(transport *client.Transport) *Client {
+	return &Client{
+		transport: transport,
+		SignInWithAppleAPI: &SignInWithAppleAPIClient{
+			Identity: identity.NewService(transport),
+			Tokens:   tokens.NewService(transport),
+		},
+	}
+}
+
+// Close releases resources held by the client.
+func (c *Client) Close() error {
+	return c.transport.Close()
+}
@@ -0,0 +1,20 @@
+package identity
+
+import "time"
+
+// Real user indicator values reported in Claims.RealUserStatus.
+const (
+	RealUserStatusUnsupported = 0
+	RealUserStatusUnknown     = 1
+	RealUserStatusLikelyReal  = 2
+)
+
+// Key cache timings.
+const (
+	// DefaultKeyCacheTTL is how long fetched public keys are used before they
+	// are fetched again.
+	DefaultKeyCacheTTL = 24 * time.Hour
+	// keyRefetchInterval limits how often an unknown key ID triggers a fetch,
+	// so that tokens with made-up key IDs cannot flood Apple's endpoint.
+	keyRefetchInterval = time.Minute
+)
@@ -0,0 +1,165 @@
+package identity
+
+import (
+	"context"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/signinwithapple/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/signinwithapple/constants"
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+// ErrInvalidIdentityToken is returned (wrapped) when an identity token fails
+// validation.
+var ErrInvalidIdentityToken = errors.New("invalid identity token")
+
+// Identity validates Sign in with Apple identity tokens against Apple's
+// public keys, which it fetches and caches.
+//
+// Sign in with Apple REST API docs: https://developer.apple.com/documentation/signinwithapplerestapi
+type (
+	Identity struct {
+		client client.Client
+
+		mu        sync.Mutex
+		keys      map[string]*rsa.PublicKey
+		fetchedAt time.Time
+		cacheTTL  time.Duration
+		now       func() time.Time
+	}
+)
+
+// NewService creates a new identity service.
+func NewService(c client.Client) *Identity {
+	return &Identity{
+		client:   c,
+		cacheTTL: DefaultKeyCacheTTL,
+		now:      time.Now,
+	}
+}
+
+// GetKeysV1 fetches the public keys Apple currently signs identity tokens
+// with. ValidateIdentityToken caches them; call this only to inspect them.
+// URL: GET https://appleid.apple.com/auth/keys
+// https://developer.apple.com/documentation/signinwithapplerestapi
+func (s *Identity) GetKeysV1(ctx context.Context) (*JWKSet, *resty.Response, error) {
+	var result JWKSet
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetResult(&result).
+		Get(constants.EndpointKeys)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// ValidateIdentityToken verifies an identity token's RS256 signature against
+// Apple's public keys and checks its issuer, audience, expiry and, when
+// opts.Nonce is set, its nonce. Validation failures wrap
+// ErrInvalidIdentityToken; failures to fetch the keys do not.
+func (s *Identity) ValidateIdentityToken(ctx context.Context, idToken string, opts *ValidateOptions) (*Claims, error) {
+	if idToken == "" {
+		return nil, fmt.Errorf("identity token is required")
+	}
+	if opts == nil {
+		opts = &ValidateOptions{}
+	}
+	audiences := opts.Audiences
+	if len(audiences) == 0 {
+		audiences = []string{s.client.ClientID()}
+	}
+
+	var fetchErr error
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(idToken, claims,
+		func(token *jwt.Token) (any, error) {
+			kid, _ := token.Header["kid"].(string)
+			if kid == "" {
+				return nil, fmt.Errorf("token has no key ID")
+			}
+			key, err := s.key(ctx, kid)
+			if err != nil {
+				fetchErr = err
+			}
+			return key, err
+		},
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}),
+		jwt.WithIssuer(constants.Issuer),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(opts.Leeway),
+		jwt.WithTimeFunc(s.now),
+	)
+	if fetchErr != nil {
+		return nil, fetchErr
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidIdentityToken, err)
+	}
+
+	if !slices.ContainsFunc(claims.Audience, func(aud string) bool { return slices.Contains(audiences, aud) }) {
+		return nil, fmt.Errorf("%w: audience %v is not one of %v", ErrInvalidIdentityToken, []string(claims.Audience), audiences)
+	}
+	if opts.Nonce != "" && claims.Nonce != opts.Nonce {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrInvalidIdentityToken)
+	}
+
+	return claims, nil
+}
+
+// errUnknownKey is returned by key when Apple does not publish the key ID.
+var errUnknownKey = errors.New("unknown key ID")
+
+// key returns the public key with the given ID, fetching Apple's keys when
+// the cache is stale or does not know the ID.
+func (s *Identity) key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	key, known := s.keys[kid]
+	stale := now.Sub(s.fetchedAt) >= s.cacheTTL
+	if known && !stale {
+		return key, nil
+	}
+	if !known && !stale && now.Sub(s.fetchedAt) < keyRefetchInterval {
+		return nil, fmt.Errorf("%w: %w %q", ErrInvalidIdentityToken, errUnknownKey, kid)
+	}
+
+	set, _, err := s.GetKeysV1(ctx)
+	if err != nil {
+		if known {
+			s.client.GetLogger().Warn("Failed to refresh Sign in with Apple keys, using cached keys", zap.Error(err))
+			return key, nil
+		}
+		return nil, fmt.Errorf("failed to fetch Sign in with Apple keys: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		publicKey, err := jwk.PublicKey()
+		if err != nil {
+			s.client.GetLogger().Warn("Skipping unusable Sign in with Apple key", zap.Error(err))
+			continue
+		}
+		keys[jwk.Kid] = publicKey
+	}
+	s.keys = keys
+	s.fetchedAt = now
+
+	if key, ok := keys[kid]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("%w: %w %q", ErrInvalidIdentityToken, errUnknownKey, kid)
+}
@@ -0,0 +1,261 @@
+package identity
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/signinwithapple/client"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+const keysURL = "https://appleid.apple.com/auth/keys"
+
+// setupMockClient creates a client with httpmock enabled.
+func setupMockClient(t *testing.T) *Identity {
+	coreClient, err := client.NewTransport(
+		"TEAM123456",
+		"com.example.web",
+		"test-key-id",
+		"dummy-key",
+		client.WithAuth(&MockAuthProvider{}),
+		client.WithLogger(zap.NewNop()),
+		client.WithRetryCount(0),
+	)
+	require.NoError(t, err)
+
+	httpmock.ActivateNonDefault(coreClient.GetHTTPClient().Client())
+
+	t.Cleanup(func() {
+		httpmock.DeactivateAndReset()
+	})
+
+	return NewService(coreClient)
+}
+
+// MockAuthProvider implements the AuthProvider interface for testing.
+type MockAuthProvider struct{}
+
+func (m *MockAuthProvider) ApplyAuth(req *resty.Request) error {
+	return nil
+}
+
+// signingKey is an RSA key published in the mock JWKS under kid.
+type signingKey struct {
+	kid string
+	key *rsa.PrivateKey
+}
+
+func newSigningKey(t *testing.T, kid string) signingKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return signingKey{kid: kid, key: key}
+}
+
+func (k signingKey) jwk() JWK {
+	return JWK{
+		Kty: "RSA",
+		Kid: k.kid,
+		Use: "sig",
+		Alg: "RS256",
+		N:   base64.RawURLEncoding.EncodeToString(k.key.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(k.key.E)).Bytes()),
+	}
+}
+
+func (k signingKey) sign(t *testing.T, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = k.kid
+	signed, err := token.SignedString(k.key)
+	require.NoError(t, err)
+	return signed
+}
+
+// serveKeys publishes keys at the JWKS endpoint and returns a pointer to the
+// number of times it was fetched.
+func serveKeys(keys ...signingKey) *int {
+	calls := 0
+	httpmock.RegisterResponder("GET", keysURL, func(req *http.Request) (*http.Response, error) {
+		calls++
+		set := JWKSet{}
+		for _, k := range keys {
+			set.Keys = append(set.Keys, k.jwk())
+		}
+		body, _ := json.Marshal(set)
+		resp := httpmock.NewBytesResponse(200, body)
+		resp.Header.Set("Content-Type", "application/json")
+		return resp, nil
+	})
+	return &calls
+}
+
+func validClaims(now time.Time) jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":              "https://appleid.apple.com",
+		"aud":              "com.example.web",
+		"sub":              "001234.abcdef0123456789.0123",
+		"iat":              now.Unix(),
+		"exp":              now.Add(10 * time.Minute).Unix(),
+		"email":            "abc123@privaterelay.appleid.com",
+		"email_verified":   "true",
+		"is_private_email": true,
+		"nonce":            "n-0S6_WzA2Mj",
+		"nonce_supported":  true,
+		"real_user_status": 2,
+	}
+}
+
+func TestValidateIdentityToken_Success(t *testing.T) {
+	svc := setupMockClient(t)
+	key := newSigningKey(t, "W6WcOKB")
+	serveKeys(key)
+
+	claims, err := svc.ValidateIdentityToken(context.Background(), key.sign(t, validClaims(time.Now())), &ValidateOptions{Nonce: "n-0S6_WzA2Mj"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "001234.abcdef0123456789.0123", claims.UserID())
+	assert.Equal(t, "abc123@privaterelay.appleid.com", claims.Email)
+	assert.True(t, bool(claims.EmailVerified))
+	assert.True(t, bool(claims.IsPrivateEmail))
+	assert.Equal(t, RealUserStatusLikelyReal, claims.RealUserStatus)
+}
+
+func TestValidateIdentityToken_Rejects(t *testing.T) {
+	now := time.Now()
+	key := newSigningKey(t, "W6WcOKB")
+
+	tests := []struct {
+		name    string
+		mutate  func(jwt.MapClaims)
+		opts    *ValidateOptions
+		wantErr string
+	}{
+		{"expired", func(c jwt.MapClaims) { c["exp"] = now.Add(-time.Minute).Unix() }, nil, "expired"},
+		{"missing expiry", func(c jwt.MapClaims) { delete(c, "exp") }, nil, "exp claim is required"},
+		{"wrong issuer", func(c jwt.MapClaims) { c["iss"] = "https://example.com" }, nil, "issuer"},
+		{"wrong audience", func(c jwt.MapClaims) { c["aud"] = "com.example.other" }, nil, "audience"},
+		{"nonce mismatch", func(c jwt.MapClaims) {}, &ValidateOptions{Nonce: "other"}, "nonce mismatch"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := setupMockClient(t)
+			serveKeys(key)
+
+			claims := validClaims(now)
+			tt.mutate(claims)
+			_, err := svc.ValidateIdentityToken(context.Background(), key.sign(t, claims), tt.opts)
+
+			require.ErrorIs(t, err, ErrInvalidIdentityToken)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestValidateIdentityToken_AcceptsConfiguredAudiences(t *testing.T) {
+	svc := setupMockClient(t)
+	key := newSigningKey(t, "W6WcOKB")
+	serveKeys(key)
+
+	claims := validClaims(time.Now())
+	claims["aud"] = "com.example.app"
+	_, err := svc.ValidateIdentityToken(context.Background(), key.sign(t, claims), &ValidateOptions{
+		Audiences: []string{"com.example.web", "com.example.app"},
+	})
+
+	require.NoError(t, err)
+}
+
+func TestValidateIdentityToken_RejectsOtherSigners(t *testing.T) {
+	svc := setupMockClient(t)
+	published := newSigningKey(t, "W6WcOKB")
+	serveKeys(published)
+
+	forged := signingKey{kid: "W6WcOKB", key: newSigningKey(t, "forged").key}
+	_, err := svc.ValidateIdentityToken(context.Background(), forged.sign(t, validClaims(time.Now())), nil)
+
+	assert.ErrorIs(t, err, ErrInvalidIdentityToken)
+}
+
+func TestValidateIdentityToken_CachesKeys(t *testing.T) {
+	svc := setupMockClient(t)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+	key := newSigningKey(t, "W6WcOKB")
+	calls := serveKeys(key)
+
+	for range 3 {
+		_, err := svc.ValidateIdentityToken(context.Background(), key.sign(t, validClaims(now)), nil)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, *calls)
+
+	now = now.Add(DefaultKeyCacheTTL)
+	_, err := svc.ValidateIdentityToken(context.Background(), key.sign(t, validClaims(now)), nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, *calls)
+}
+
+func TestValidateIdentityToken_RotatedKeyRefetchIsRateLimited(t *testing.T) {
+	svc := setupMockClient(t)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+	oldKey := newSigningKey(t, "old")
+	newKey := newSigningKey(t, "new")
+	calls := serveKeys(oldKey)
+
+	_, err := svc.ValidateIdentityToken(context.Background(), oldKey.sign(t, validClaims(now)), nil)
+	require.NoError(t, err)
+
+	// An unknown key ID right after a fetch is rejected without a new fetch.
+	_, err = svc.ValidateIdentityToken(context.Background(), newKey.sign(t, validClaims(now)), nil)
+	require.ErrorIs(t, err, ErrInvalidIdentityToken)
+	assert.Contains(t, err.Error(), "unknown key ID")
+	assert.Equal(t, 1, *calls)
+
+	// Once Apple publishes the rotated key, it is picked up after the
+	// refetch interval.
+	calls = serveKeys(oldKey, newKey)
+	now = now.Add(keyRefetchInterval)
+	_, err = svc.ValidateIdentityToken(context.Background(), newKey.sign(t, validClaims(now)), nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, *calls)
+}
+
+func TestValidateIdentityToken_KeyFetchFailure(t *testing.T) {
+	svc := setupMockClient(t)
+	httpmock.RegisterResponder("GET", keysURL, httpmock.NewStringResponder(503, ""))
+	key := newSigningKey(t, "W6WcOKB")
+
+	_, err := svc.ValidateIdentityToken(context.Background(), key.sign(t, validClaims(time.Now())), nil)
+
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrInvalidIdentityToken)
+	assert.Contains(t, err.Error(), "failed to fetch Sign in with Apple keys")
+}
+
+func TestBool_UnmarshalJSON(t *testing.T) {
+	var v struct {
+		A, B, C, D Bool
+	}
+	require.NoError(t, json.Unmarshal([]byte(`{"A": true, "B": "true", "C": "false", "D": false}`), &v))
+	assert.True(t, bool(v.A))
+	assert.True(t, bool(v.B))
+	assert.False(t, bool(v.C))
+	assert.False(t, bool(v.D))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"A": 1}`), &v))
+}
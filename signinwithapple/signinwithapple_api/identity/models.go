@@ -0,0 +1,110 @@
+package identity
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// JWKSet is the set of public keys Apple signs identity tokens with.
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWK is a single RSA public key in JSON Web Key form.
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// PublicKey decodes the key's modulus and exponent.
+func (k *JWK) PublicKey() (*rsa.PublicKey, error) {
+	if k.Kty != "RSA" {
+		return nil, fmt.Errorf("key %s: unsupported key type %q", k.Kid, k.Kty)
+	}
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, fmt.Errorf("key %s: invalid modulus: %w", k.Kid, err)
+	}
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, fmt.Errorf("key %s: invalid exponent: %w", k.Kid, err)
+	}
+	exponent := new(big.Int).SetBytes(e)
+	if !exponent.IsInt64() || exponent.Int64() > 1<<31-1 {
+		return nil, fmt.Errorf("key %s: exponent too large", k.Kid)
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+}
+
+// ValidateOptions are the checks ValidateIdentityToken applies beyond the
+// signature, issuer and expiry.
+type ValidateOptions struct {
+	// Nonce is the nonce sent in the authorization request. When set, the
+	// token's nonce claim must equal it. Native apps usually send the SHA-256
+	// hash of their nonce, so pass the same hash here.
+	Nonce string
+	// Audiences are the accepted client IDs. Defaults to the client's own
+	// client ID; set it to accept tokens for both an App ID and a Services ID.
+	Audiences []string
+	// Leeway is the clock skew tolerated on the exp and iat claims.
+	Leeway time.Duration
+}
+
+// Claims are the claims of a Sign in with Apple identity token.
+type Claims struct {
+	jwt.RegisteredClaims
+	// Email is the user's email address, possibly a private relay address.
+	Email          string `json:"email,omitempty"`
+	EmailVerified  Bool   `json:"email_verified,omitempty"`
+	IsPrivateEmail Bool   `json:"is_private_email,omitempty"`
+	Nonce          string `json:"nonce,omitempty"`
+	NonceSupported bool   `json:"nonce_supported,omitempty"`
+	// RealUserStatus is one of the RealUserStatus constants. It is only
+	// reported on the first sign-in from an Apple device.
+	RealUserStatus int    `json:"real_user_status,omitempty"`
+	AuthTime       int64  `json:"auth_time,omitempty"`
+	CHash          string `json:"c_hash,omitempty"`
+	AtHash         string `json:"at_hash,omitempty"`
+	// TransferSub is set when the app was transferred to another team and the
+	// user has not yet been migrated.
+	TransferSub string `json:"transfer_sub,omitempty"`
+}
+
+// UserID returns the stable, team-scoped identifier of the user (the sub
+// claim).
+func (c *Claims) UserID() string {
+	return c.Subject
+}
+
+// Bool is a boolean claim that Apple encodes either as a JSON boolean or as
+// the string "true" or "false".
+type Bool bool
+
+// UnmarshalJSON accepts true, false, "true" and "false".
+func (b *Bool) UnmarshalJSON(data []byte) error {
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch t := v.(type) {
+	case bool:
+		*b = Bool(t)
+	case string:
+		*b = Bool(t == "true")
+	case nil:
+		*b = false
+	default:
+		return fmt.Errorf("invalid boolean claim %s", data)
+	}
+	return nil
+}
@@ -0,0 +1,7 @@
+package tokens
+
+// Token type hints accepted by RevokeTokenV1.
+const (
+	TokenTypeHintRefreshToken = "refresh_token"
+	TokenTypeHintAccessToken  = "access_token"
+)
@@ -0,0 +1,96 @@
+package tokens
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/signinwithapple/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/signinwithapple/constants"
+	"resty.dev/v3"
+)
+
+// Tokens handles the token endpoints of the Sign in with Apple REST API.
+// Every request is authenticated with the client ID and a generated client
+// secret.
+//
+// Sign in with Apple REST API docs: https://developer.apple.com/documentation/signinwithapplerestapi
+type (
+	Tokens struct {
+		client client.Client
+	}
+)
+
+// NewService creates a new tokens service.
+func NewService(c client.Client) *Tokens {
+	return &Tokens{client: c}
+}
+
+// ExchangeCodeV1 exchanges an authorization code received from Sign in with
+// Apple for an access token, a refresh token and an identity token. An
+// authorization code is valid for five minutes and can be used once.
+// redirectURI must match the one used in the authorization request; pass an
+// empty string for codes from native apps.
+// URL: POST https://appleid.apple.com/auth/token
+// https://developer.apple.com/documentation/signinwithapplerestapi/generate-and-validate-tokens
+func (s *Tokens) ExchangeCodeV1(ctx context.Context, code, redirectURI string) (*TokenResponse, *resty.Response, error) {
+	if code == "" {
+		return nil, nil, fmt.Errorf("authorization code is required")
+	}
+
+	return s.token(ctx, map[string]string{
+		"grant_type":   constants.GrantTypeAuthorizationCode,
+		"code":         code,
+		"redirect_uri": redirectURI,
+	})
+}
+
+// RefreshTokenV1 uses a refresh token to get a new access token and identity
+// token, confirming the user's Apple Account is still in good standing.
+// Apple does not return a new refresh token.
+// URL: POST https://appleid.apple.com/auth/token
+// https://developer.apple.com/documentation/signinwithapplerestapi/generate-and-validate-tokens
+func (s *Tokens) RefreshTokenV1(ctx context.Context, refreshToken string) (*TokenResponse, *resty.Response, error) {
+	if refreshToken == "" {
+		return nil, nil, fmt.Errorf("refresh token is required")
+	}
+
+	return s.token(ctx, map[string]string{
+		"grant_type":    constants.GrantTypeRefreshToken,
+		"refresh_token": refreshToken,
+	})
+}
+
+// RevokeTokenV1 invalidates a refresh token or access token, e.g. when the
+// user deletes their account. tokenTypeHint is one of the TokenTypeHint
+// constants, or empty.
+// URL: POST https://appleid.apple.com/auth/revoke
+// https://developer.apple.com/documentation/signinwithapplerestapi/revoke-tokens
+func (s *Tokens) RevokeTokenV1(ctx context.Context, token, tokenTypeHint string) (*resty.Response, error) {
+	if token == "" {
+		return nil, fmt.Errorf("token is required")
+	}
+
+	return s.client.NewRequest(ctx).
+		SetFormData(map[string]string{
+			"token":           token,
+			"token_type_hint": tokenTypeHint,
+		}).
+		Post(constants.EndpointRevoke)
+}
+
+// token posts a grant to the token endpoint.
+func (s *Tokens) token(ctx context.Context, form map[string]string) (*TokenResponse, *resty.Response, error) {
+	var result TokenResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetFormData(form).
+		SetResult(&result).
+		Post(constants.EndpointToken)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
@@ -0,0 +1,160 @@
+package tokens
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/signinwithapple/client"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+const baseURL = "https://appleid.apple.com"
+
+// setupMockClient creates a client with httpmock enabled.
+func setupMockClient(t *testing.T) *Tokens {
+	coreClient, err := client.NewTransport(
+		"TEAM123456",
+		"com.example.web",
+		"test-key-id",
+		"dummy-key",
+		client.WithAuth(&MockAuthProvider{}),
+		client.WithLogger(zap.NewNop()),
+		client.WithRetryCount(0),
+	)
+	require.NoError(t, err)
+
+	httpmock.ActivateNonDefault(coreClient.GetHTTPClient().Client())
+
+	t.Cleanup(func() {
+		httpmock.DeactivateAndReset()
+	})
+
+	return NewService(coreClient)
+}
+
+// MockAuthProvider implements the AuthProvider interface for testing.
+type MockAuthProvider struct{}
+
+func (m *MockAuthProvider) ApplyAuth(req *resty.Request) error {
+	req.SetFormData(map[string]string{"client_id": "com.example.web", "client_secret": "test-secret"})
+	return nil
+}
+
+// formResponder records the posted form and answers with body as JSON.
+func formResponder(form *url.Values, status int, body string) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		if err := req.ParseForm(); err != nil {
+			return nil, err
+		}
+		*form = req.PostForm
+		resp := httpmock.NewStringResponse(status, body)
+		resp.Header.Set("Content-Type", "application/json")
+		return resp, nil
+	}
+}
+
+func TestExchangeCodeV1_Success(t *testing.T) {
+	svc := setupMockClient(t)
+
+	var form url.Values
+	httpmock.RegisterResponder("POST", baseURL+"/auth/token", formResponder(&form, 200, `{
+		"access_token": "a1",
+		"token_type": "Bearer",
+		"expires_in": 3600,
+		"refresh_token": "r1",
+		"id_token": "eyJ.id.token"
+	}`))
+
+	result, resp, err := svc.ExchangeCodeV1(context.Background(), "c1", "https://example.com/callback")
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, "authorization_code", form.Get("grant_type"))
+	assert.Equal(t, "c1", form.Get("code"))
+	assert.Equal(t, "https://example.com/callback", form.Get("redirect_uri"))
+	assert.Equal(t, "com.example.web", form.Get("client_id"))
+	assert.Equal(t, "test-secret", form.Get("client_secret"))
+	assert.Equal(t, "r1", result.RefreshToken)
+	assert.Equal(t, "eyJ.id.token", result.IDToken)
+	assert.Equal(t, 3600, result.ExpiresIn)
+}
+
+func TestExchangeCodeV1_NativeAppOmitsRedirectURI(t *testing.T) {
+	svc := setupMockClient(t)
+
+	var form url.Values
+	httpmock.RegisterResponder("POST", baseURL+"/auth/token", formResponder(&form, 200, `{"id_token": "t"}`))
+
+	_, _, err := svc.ExchangeCodeV1(context.Background(), "c1", "")
+
+	require.NoError(t, err)
+	assert.NotContains(t, form, "redirect_uri")
+}
+
+func TestExchangeCodeV1_InvalidGrant(t *testing.T) {
+	svc := setupMockClient(t)
+
+	var form url.Values
+	httpmock.RegisterResponder("POST", baseURL+"/auth/token", formResponder(&form, 400, `{"error": "invalid_grant", "error_description": "The code has expired or has been revoked."}`))
+
+	result, _, err := svc.ExchangeCodeV1(context.Background(), "used", "")
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.True(t, client.IsAPIError(err, client.ErrorCodeInvalidGrant))
+	assert.Contains(t, err.Error(), "has expired")
+}
+
+func TestExchangeCodeV1_EmptyCode(t *testing.T) {
+	svc := setupMockClient(t)
+
+	_, _, err := svc.ExchangeCodeV1(context.Background(), "", "")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "authorization code is required")
+}
+
+func TestRefreshTokenV1_Success(t *testing.T) {
+	svc := setupMockClient(t)
+
+	var form url.Values
+	httpmock.RegisterResponder("POST", baseURL+"/auth/token", formResponder(&form, 200, `{"access_token": "a2", "token_type": "Bearer", "expires_in": 3600, "id_token": "t"}`))
+
+	result, _, err := svc.RefreshTokenV1(context.Background(), "r1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "refresh_token", form.Get("grant_type"))
+	assert.Equal(t, "r1", form.Get("refresh_token"))
+	assert.Equal(t, "a2", result.AccessToken)
+	assert.Empty(t, result.RefreshToken)
+}
+
+func TestRevokeTokenV1_Success(t *testing.T) {
+	svc := setupMockClient(t)
+
+	var form url.Values
+	httpmock.RegisterResponder("POST", baseURL+"/auth/revoke", formResponder(&form, 200, ``))
+
+	resp, err := svc.RevokeTokenV1(context.Background(), "r1", TokenTypeHintRefreshToken)
+
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	assert.Equal(t, "r1", form.Get("token"))
+	assert.Equal(t, "refresh_token", form.Get("token_type_hint"))
+	assert.Equal(t, "com.example.web", form.Get("client_id"))
+}
+
+func TestRevokeTokenV1_EmptyToken(t *testing.T) {
+	svc := setupMockClient(t)
+
+	_, err := svc.RevokeTokenV1(context.Background(), "", "")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "token is required")
+}
@@ -0,0 +1,15 @@
+package tokens
+
+// TokenResponse is the response of the token endpoint for both the
+// authorization code and refresh token grants.
+type TokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	// ExpiresIn is the access token lifetime in seconds.
+	ExpiresIn int `json:"expires_in"`
+	// RefreshToken is only returned when exchanging an authorization code.
+	RefreshToken string `json:"refresh_token,omitempty"`
+	// IDToken is the identity token of the user; validate it with the
+	// identity service before trusting its claims.
+	IDToken string `json:"id_token"`
+}
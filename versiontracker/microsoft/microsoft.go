@@ -0,0 +1,80 @@
+// Package microsoft provides a versiontracker.VendorSource for Microsoft's
+// macOS applications, backed by the Microsoft Updates client.
+package microsoft
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates"
+	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/microsoft_updates_api/onedrive"
+	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/microsoft_updates_api/standalone"
+	"github.com/deploymenttheory/go-api-sdk-apple/versiontracker"
+)
+
+// Vendor is the source name reported by Source.
+const Vendor = "microsoft"
+
+// Source reports the standalone applications on the Office CDN production
+// channel and the OneDrive production ring.
+type Source struct {
+	client *microsoft_updates.Client
+}
+
+// NewSource returns a Source that queries c.
+func NewSource(c *microsoft_updates.Client) *Source {
+	return &Source{client: c}
+}
+
+// Name implements versiontracker.VendorSource.
+func (s *Source) Name() string {
+	return Vendor
+}
+
+// Apps implements versiontracker.VendorSource. Like individual standalone
+// applications the CDN fails to serve, OneDrive is omitted when its ring
+// cannot be fetched.
+func (s *Source) Apps(ctx context.Context) ([]versiontracker.App, error) {
+	api := s.client.MicrosoftUpdatesAPI
+
+	resp, err := api.Standalone.GetLatestV1(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apps := make([]versiontracker.App, 0, len(resp.Packages)+1)
+	for _, pkg := range resp.Packages {
+		apps = append(apps, fromPackage(pkg))
+	}
+
+	ring, err := api.OneDrive.GetProductionRingV1(ctx)
+	if err != nil {
+		return apps, nil
+	}
+	return append(apps, fromOneDriveRing(ring)), nil
+}
+
+func fromPackage(pkg *standalone.Package) versiontracker.App {
+	released, _ := pkg.ReleaseDate()
+	return versiontracker.App{
+		ID:          pkg.ApplicationID,
+		Name:        pkg.Title,
+		BundleID:    standalone.AppIDBundleMap[pkg.ApplicationID],
+		Version:     pkg.ShortVersion,
+		Build:       pkg.FullVersion,
+		Released:    released,
+		MinimumOS:   pkg.MinimumOS,
+		DownloadURL: pkg.Location,
+		SHA256:      pkg.HashSHA256,
+	}
+}
+
+func fromOneDriveRing(ring *onedrive.OneDriveRing) versiontracker.App {
+	return versiontracker.App{
+		ID:          ring.ApplicationID,
+		Name:        "OneDrive",
+		BundleID:    ring.BundleID,
+		Version:     ring.Version,
+		Build:       ring.BuildVersion,
+		DownloadURL: ring.DownloadURL,
+	}
+}
@@ -0,0 +1,52 @@
+package microsoft_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates"
+	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/constants"
+	onedrivemocks "github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/microsoft_updates_api/onedrive/mocks"
+	"github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/microsoft_updates_api/standalone"
+	standalonemocks "github.com/deploymenttheory/go-api-sdk-apple/microsoft_updates/microsoft_updates_api/standalone/mocks"
+	"github.com/deploymenttheory/go-api-sdk-apple/versiontracker"
+	"github.com/deploymenttheory/go-api-sdk-apple/versiontracker/microsoft"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSource_Apps(t *testing.T) {
+	t.Cleanup(httpmock.Reset)
+	standalonemocks.RegisterWordMock(constants.StandaloneCDNBaseURL)
+	onedrivemocks.RegisterProductionManifestMock()
+	httpmock.RegisterNoResponder(httpmock.NewStringResponder(http.StatusNotFound, "not found"))
+
+	c, err := microsoft_updates.NewClient(
+		microsoft_updates.WithTransport(httpmock.DefaultTransport),
+		microsoft_updates.WithRetryCount(0),
+	)
+	require.NoError(t, err)
+
+	tracker, err := versiontracker.New(microsoft.NewSource(c))
+	require.NoError(t, err)
+
+	apps, err := tracker.VendorApps(context.Background(), microsoft.Vendor)
+	require.NoError(t, err)
+	require.Len(t, apps, 2)
+
+	word := apps[0]
+	assert.Equal(t, microsoft.Vendor, word.Vendor)
+	assert.Equal(t, standalone.AppIDWord, word.ID)
+	assert.Equal(t, standalone.BundleIDWord, word.BundleID)
+	assert.Equal(t, "16.108.1", word.Version)
+	assert.Equal(t, "16.108.26041915", word.Build)
+	assert.Equal(t, time.Date(2026, time.April, 19, 0, 0, 0, 0, time.UTC), word.Released)
+	assert.NotEmpty(t, word.DownloadURL)
+
+	onedrive := apps[1]
+	assert.Equal(t, "com.microsoft.OneDrive", onedrive.BundleID)
+	assert.Equal(t, "26.062.0402", onedrive.Version)
+}
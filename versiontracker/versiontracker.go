@@ -0,0 +1,202 @@
+// Package versiontracker queries the latest macOS application releases from
+// several software vendors through one API.
+//
+// Each vendor feed is a VendorSource. The microsoft subpackage provides one
+// backed by the Microsoft Updates client; other vendors (Adobe, Google, Zoom,
+// …) plug in by implementing the interface or wrapping a function with
+// NewSource:
+//
+//	tracker, err := versiontracker.New(
+//	    microsoft.NewSource(msClient),
+//	    versiontracker.NewSource("zoom", fetchZoomApps),
+//	)
+//	apps, err := tracker.Apps(ctx)
+package versiontracker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// App is the latest release of one application as reported by a vendor feed.
+type App struct {
+	// Vendor is the Name of the source that reported the app.
+	Vendor string
+	// ID is the vendor's identifier for the app, e.g. "MSWD2019".
+	ID string
+	// Name is the human-readable application name.
+	Name string
+	// BundleID is the macOS bundle identifier, if the feed provides one.
+	BundleID string
+	// Version is the user-facing version string.
+	Version string
+	// Build is the build version string, if the feed provides one.
+	Build string
+	// Released is the release date, or the zero time when unknown.
+	Released time.Time
+	// MinimumOS is the minimum macOS version, if the feed provides one.
+	MinimumOS string
+	// DownloadURL is the installer download URL.
+	DownloadURL string
+	// SHA256 is the installer checksum in the encoding the feed publishes.
+	SHA256 string
+}
+
+// VendorSource is a feed of the latest application releases from one vendor.
+type VendorSource interface {
+	// Name returns the vendor name, unique within a Tracker (e.g. "microsoft").
+	Name() string
+	// Apps returns the latest release of every app the feed tracks.
+	Apps(ctx context.Context) ([]App, error)
+}
+
+// NewSource returns a VendorSource named name whose Apps method calls fn.
+func NewSource(name string, fn func(ctx context.Context) ([]App, error)) VendorSource {
+	return &funcSource{name: name, fn: fn}
+}
+
+type funcSource struct {
+	name string
+	fn   func(ctx context.Context) ([]App, error)
+}
+
+func (s *funcSource) Name() string { return s.name }
+
+func (s *funcSource) Apps(ctx context.Context) ([]App, error) { return s.fn(ctx) }
+
+// SourceError reports a vendor source that failed during a Tracker query.
+type SourceError struct {
+	Vendor string
+	Err    error
+}
+
+func (e *SourceError) Error() string {
+	return fmt.Sprintf("versiontracker: %s: %v", e.Vendor, e.Err)
+}
+
+func (e *SourceError) Unwrap() error { return e.Err }
+
+// Tracker aggregates vendor sources. It is safe for concurrent use.
+type Tracker struct {
+	mu      sync.RWMutex
+	sources []VendorSource
+}
+
+// New returns a Tracker over sources. Source names must be non-empty and
+// unique.
+func New(sources ...VendorSource) (*Tracker, error) {
+	t := &Tracker{}
+	for _, src := range sources {
+		if err := t.Register(src); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// Register adds src to the tracker.
+func (t *Tracker) Register(src VendorSource) error {
+	if src == nil {
+		return fmt.Errorf("versiontracker: source is nil")
+	}
+	name := src.Name()
+	if name == "" {
+		return fmt.Errorf("versiontracker: source name is empty")
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.lookup(name) != nil {
+		return fmt.Errorf("versiontracker: source %q already registered", name)
+	}
+	t.sources = append(t.sources, src)
+	return nil
+}
+
+// Vendors returns the registered source names in registration order.
+func (t *Tracker) Vendors() []string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	names := make([]string, len(t.sources))
+	for i, src := range t.sources {
+		names[i] = src.Name()
+	}
+	return names
+}
+
+// Apps queries every source concurrently and returns their apps in
+// registration order. Sources that fail are skipped; their errors are joined
+// as *SourceError values in the returned error, alongside the apps from the
+// sources that succeeded.
+func (t *Tracker) Apps(ctx context.Context) ([]App, error) {
+	t.mu.RLock()
+	sources := slices.Clone(t.sources)
+	t.mu.RUnlock()
+
+	results := make([][]App, len(sources))
+	errs := make([]error, len(sources))
+	var wg sync.WaitGroup
+	for i, src := range sources {
+		wg.Go(func() {
+			results[i], errs[i] = fetch(ctx, src)
+		})
+	}
+	wg.Wait()
+
+	var apps []App
+	for _, r := range results {
+		apps = append(apps, r...)
+	}
+	return apps, errors.Join(errs...)
+}
+
+// VendorApps returns the apps reported by the source named vendor.
+func (t *Tracker) VendorApps(ctx context.Context, vendor string) ([]App, error) {
+	t.mu.RLock()
+	src := t.lookup(vendor)
+	t.mu.RUnlock()
+	if src == nil {
+		return nil, fmt.Errorf("versiontracker: unknown vendor %q", vendor)
+	}
+	return fetch(ctx, src)
+}
+
+// FindByBundleID queries every source and returns the first app whose bundle
+// ID matches bundleID case-insensitively. ok is false when no source reports
+// it; err carries any source failures as Apps does.
+func (t *Tracker) FindByBundleID(ctx context.Context, bundleID string) (app App, ok bool, err error) {
+	apps, err := t.Apps(ctx)
+	for _, a := range apps {
+		if strings.EqualFold(a.BundleID, bundleID) {
+			return a, true, err
+		}
+	}
+	return App{}, false, err
+}
+
+// lookup returns the source named name. The caller must hold t.mu.
+func (t *Tracker) lookup(name string) VendorSource {
+	for _, src := range t.sources {
+		if src.Name() == name {
+			return src
+		}
+	}
+	return nil
+}
+
+// fetch calls src.Apps and stamps each app with the source's vendor name.
+func fetch(ctx context.Context, src VendorSource) ([]App, error) {
+	apps, err := src.Apps(ctx)
+	if err != nil {
+		return nil, &SourceError{Vendor: src.Name(), Err: err}
+	}
+	for i := range apps {
+		apps[i].Vendor = src.Name()
+	}
+	return apps, nil
+}
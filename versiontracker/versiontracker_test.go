@@ -0,0 +1,81 @@
+package versiontracker_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/versiontracker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func staticSource(name string, apps ...versiontracker.App) versiontracker.VendorSource {
+	return versiontracker.NewSource(name, func(context.Context) ([]versiontracker.App, error) {
+		return apps, nil
+	})
+}
+
+func TestTracker_Apps(t *testing.T) {
+	errFeed := errors.New("feed unavailable")
+	tracker, err := versiontracker.New(
+		staticSource("adobe", versiontracker.App{ID: "acrobat", BundleID: "com.adobe.Reader", Version: "25.1"}),
+		versiontracker.NewSource("zoom", func(context.Context) ([]versiontracker.App, error) {
+			return nil, errFeed
+		}),
+		staticSource("google", versiontracker.App{ID: "chrome", BundleID: "com.google.Chrome", Version: "141.0"}),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"adobe", "zoom", "google"}, tracker.Vendors())
+
+	apps, err := tracker.Apps(context.Background())
+	require.Len(t, apps, 2)
+	assert.Equal(t, "adobe", apps[0].Vendor)
+	assert.Equal(t, "google", apps[1].Vendor)
+
+	var srcErr *versiontracker.SourceError
+	require.ErrorAs(t, err, &srcErr)
+	assert.Equal(t, "zoom", srcErr.Vendor)
+	assert.ErrorIs(t, err, errFeed)
+}
+
+func TestTracker_VendorApps(t *testing.T) {
+	tracker, err := versiontracker.New(staticSource("google", versiontracker.App{ID: "chrome"}))
+	require.NoError(t, err)
+
+	apps, err := tracker.VendorApps(context.Background(), "google")
+	require.NoError(t, err)
+	require.Len(t, apps, 1)
+	assert.Equal(t, "google", apps[0].Vendor)
+
+	_, err = tracker.VendorApps(context.Background(), "adobe")
+	assert.ErrorContains(t, err, `unknown vendor "adobe"`)
+}
+
+func TestTracker_FindByBundleID(t *testing.T) {
+	tracker, err := versiontracker.New(
+		staticSource("google", versiontracker.App{ID: "chrome", BundleID: "com.google.Chrome"}),
+	)
+	require.NoError(t, err)
+
+	app, ok, err := tracker.FindByBundleID(context.Background(), "COM.GOOGLE.CHROME")
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "chrome", app.ID)
+
+	_, ok, err = tracker.FindByBundleID(context.Background(), "us.zoom.xos")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestTracker_Register(t *testing.T) {
+	tracker, err := versiontracker.New(staticSource("google"))
+	require.NoError(t, err)
+
+	assert.ErrorContains(t, tracker.Register(staticSource("google")), "already registered")
+	assert.ErrorContains(t, tracker.Register(staticSource("")), "name is empty")
+	assert.ErrorContains(t, tracker.Register(nil), "source is nil")
+
+	_, err = versiontracker.New(staticSource("a"), staticSource("a"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,8 @@
+package client
+
+// DefaultUserAgent is the default User-Agent header value for all requests.
+const (
+	DefaultUserAgent = "go-api-sdk-apple/1.0.0"
+	Version          = "1.0.0"
+	DefaultBaseURL   = "https://vpp.itunes.apple.com/mdm/v2"
+)
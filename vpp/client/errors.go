@@ -0,0 +1,64 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+// APIError represents an error returned by the Apps and Books for Organizations API.
+// See https://developer.apple.com/documentation/devicemanagement/app_and_book_management/handling_error_responses
+type APIError struct {
+	StatusCode   int            `json:"-"`
+	ErrorNumber  int            `json:"errorNumber"`
+	ErrorMessage string         `json:"errorMessage"`
+	ErrorInfo    map[string]any `json:"errorInfo,omitempty"`
+}
+
+func (e *APIError) Error() string {
+	if e.ErrorNumber != 0 {
+		return fmt.Sprintf("VPP API error %d (HTTP %d): %s", e.ErrorNumber, e.StatusCode, e.ErrorMessage)
+	}
+	return fmt.Sprintf("VPP API error (HTTP %d): %s", e.StatusCode, e.ErrorMessage)
+}
+
+// ErrorHandler centralizes error handling for all VPP API requests.
+type ErrorHandler struct {
+	logger *zap.Logger
+}
+
+// NewErrorHandler creates a new error handler.
+func NewErrorHandler(logger *zap.Logger) *ErrorHandler {
+	return &ErrorHandler{logger: logger}
+}
+
+// HandleError processes a failed HTTP response and returns an *APIError when
+// the body carries a structured error, or a plain status error otherwise.
+func (eh *ErrorHandler) HandleError(resp *resty.Response) error {
+	statusCode := resp.StatusCode()
+
+	eh.logger.Error("VPP API request failed",
+		zap.Int("status_code", statusCode),
+		zap.String("url", resp.Request.URL),
+		zap.String("method", resp.Request.Method),
+		zap.String("response_body", resp.String()),
+	)
+
+	var apiErr APIError
+	if err := json.Unmarshal(resp.Bytes(), &apiErr); err == nil && (apiErr.ErrorNumber != 0 || apiErr.ErrorMessage != "") {
+		apiErr.StatusCode = statusCode
+		return &apiErr
+	}
+
+	return fmt.Errorf("HTTP %d: %s", statusCode, http.StatusText(statusCode))
+}
+
+// IsAPIError reports whether err wraps an *APIError carrying the given VPP error number.
+func IsAPIError(err error, errorNumber int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.ErrorNumber == errorNumber
+}
@@ -0,0 +1,162 @@
+package client
+
+import (
+	"strconv"
+	"time"
+)
+
+// QueryBuilder provides a fluent interface for building query parameters.
+type QueryBuilder struct {
+	params map[string]string
+}
+
+// NewQueryBuilder creates a new query builder.
+func NewQueryBuilder() *QueryBuilder {
+	return &QueryBuilder{
+		params: make(map[string]string),
+	}
+}
+
+// AddString adds a string parameter if the value is not empty.
+func (qb *QueryBuilder) AddString(key, value string) *QueryBuilder {
+	if value != "" {
+		qb.params[key] = value
+	}
+	return qb
+}
+
+// AddInt adds an integer parameter if the value is greater than 0.
+func (qb *QueryBuilder) AddInt(key string, value int) *QueryBuilder {
+	if value > 0 {
+		qb.params[key] = strconv.Itoa(value)
+	}
+	return qb
+}
+
+// AddInt64 adds an int64 parameter if the value is greater than 0.
+func (qb *QueryBuilder) AddInt64(key string, value int64) *QueryBuilder {
+	if value > 0 {
+		qb.params[key] = strconv.FormatInt(value, 10)
+	}
+	return qb
+}
+
+// AddBool adds a boolean parameter.
+func (qb *QueryBuilder) AddBool(key string, value bool) *QueryBuilder {
+	qb.params[key] = strconv.FormatBool(value)
+	return qb
+}
+
+// AddTime adds a time parameter in RFC3339 format if the time is not zero.
+func (qb *QueryBuilder) AddTime(key string, value time.Time) *QueryBuilder {
+	if !value.IsZero() {
+		qb.params[key] = value.Format(time.RFC3339)
+	}
+	return qb
+}
+
+// AddStringSlice adds a string slice parameter as comma-separated values.
+func (qb *QueryBuilder) AddStringSlice(key string, values []string) *QueryBuilder {
+	if len(values) > 0 {
+		result := ""
+		for i, v := range values {
+			if v != "" {
+				if i > 0 {
+					result += ","
+				}
+				result += v
+			}
+		}
+		if result != "" {
+			qb.params[key] = result
+		}
+	}
+	return qb
+}
+
+// AddIntSlice adds an integer slice parameter as comma-separated values.
+func (qb *QueryBuilder) AddIntSlice(key string, values []int) *QueryBuilder {
+	if len(values) > 0 {
+		result := ""
+		for i, v := range values {
+			if i > 0 {
+				result += ","
+			}
+			result += strconv.Itoa(v)
+		}
+		qb.params[key] = result
+	}
+	return qb
+}
+
+// AddCustom adds a custom parameter with any value.
+func (qb *QueryBuilder) AddCustom(key, value string) *QueryBuilder {
+	qb.params[key] = value
+	return qb
+}
+
+// AddIfNotEmpty adds a parameter only if the value is not empty.
+func (qb *QueryBuilder) AddIfNotEmpty(key, value string) *QueryBuilder {
+	if value != "" {
+		qb.params[key] = value
+	}
+	return qb
+}
+
+// AddIfTrue adds a parameter only if the condition is true.
+func (qb *QueryBuilder) AddIfTrue(condition bool, key, value string) *QueryBuilder {
+	if condition {
+		qb.params[key] = value
+	}
+	return qb
+}
+
+// Merge merges parameters from another map.
+func (qb *QueryBuilder) Merge(other map[string]string) *QueryBuilder {
+	for k, v := range other {
+		qb.params[k] = v
+	}
+	return qb
+}
+
+// Remove removes a parameter.
+func (qb *QueryBuilder) Remove(key string) *QueryBuilder {
+	delete(qb.params, key)
+	return qb
+}
+
+// Has checks if a parameter exists.
+func (qb *QueryBuilder) Has(key string) bool {
+	_, exists := qb.params[key]
+	return exists
+}
+
+// Get retrieves a parameter value.
+func (qb *QueryBuilder) Get(key string) string {
+	return qb.params[key]
+}
+
+// Build returns a copy of the final query parameter map.
+func (qb *QueryBuilder) Build() map[string]string {
+	result := make(map[string]string, len(qb.params))
+	for k, v := range qb.params {
+		result[k] = v
+	}
+	return result
+}
+
+// Clear removes all parameters.
+func (qb *QueryBuilder) Clear() *QueryBuilder {
+	qb.params = make(map[string]string)
+	return qb
+}
+
+// Count returns the number of parameters.
+func (qb *QueryBuilder) Count() int {
+	return len(qb.params)
+}
+
+// IsEmpty returns true if no parameters are set.
+func (qb *QueryBuilder) IsEmpty() bool {
+	return len(qb.params) == 0
+}
@@ -0,0 +1,111 @@
+package client
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// sTokenExpDateLayout is the layout Apple uses for the expDate field of a
+// location token, e.g. "2027-01-01T12:00:00-0800".
+const sTokenExpDateLayout = "2006-01-02T15:04:05-0700"
+
+// SToken is a decoded Apps and Books location content token. The raw token
+// downloaded from Apple Business Manager or Apple School Manager is a base64
+// encoded JSON document; Raw keeps the original value sent to the API.
+type SToken struct {
+	Raw     string
+	Token   string
+	OrgName string
+	ExpDate time.Time
+}
+
+// sTokenPayload is the JSON document inside a base64 encoded location token.
+type sTokenPayload struct {
+	Token   string `json:"token"`
+	ExpDate string `json:"expDate"`
+	OrgName string `json:"orgName"`
+}
+
+// ParseSToken decodes a base64 encoded location token and parses its
+// expiry date. Surrounding whitespace (as found in downloaded .vpptoken
+// files) is ignored. It does not check expiry; use Validate for that.
+func ParseSToken(raw string) (*SToken, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, fmt.Errorf("location token (sToken) is empty")
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode location token: %w", err)
+	}
+
+	var payload sTokenPayload
+	if err := json.Unmarshal(decoded, &payload); err != nil {
+		return nil, fmt.Errorf("failed to parse location token: %w", err)
+	}
+
+	if payload.Token == "" {
+		return nil, fmt.Errorf("location token is missing the token field")
+	}
+
+	token := &SToken{
+		Raw:     raw,
+		Token:   payload.Token,
+		OrgName: payload.OrgName,
+	}
+
+	if payload.ExpDate != "" {
+		expDate, err := time.Parse(sTokenExpDateLayout, payload.ExpDate)
+		if err != nil {
+			expDate, err = time.Parse(time.RFC3339, payload.ExpDate)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse location token expiry %q: %w", payload.ExpDate, err)
+		}
+		token.ExpDate = expDate
+	}
+
+	return token, nil
+}
+
+// LoadSTokenFromFile reads and parses a location token from a .vpptoken file.
+func LoadSTokenFromFile(filePath string) (*SToken, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read location token file: %w", err)
+	}
+	return ParseSToken(string(data))
+}
+
+// IsExpired reports whether the token's expiry date has passed. Tokens
+// without an expiry date are never considered expired.
+func (t *SToken) IsExpired() bool {
+	return !t.ExpDate.IsZero() && !time.Now().Before(t.ExpDate)
+}
+
+// ExpiresWithin reports whether the token expires within d from now.
+func (t *SToken) ExpiresWithin(d time.Duration) bool {
+	return !t.ExpDate.IsZero() && time.Until(t.ExpDate) < d
+}
+
+// TimeUntilExpiry returns the time remaining before the token expires, or
+// zero if it has already expired or carries no expiry date.
+func (t *SToken) TimeUntilExpiry() time.Duration {
+	if t.ExpDate.IsZero() {
+		return 0
+	}
+	return max(time.Until(t.ExpDate), 0)
+}
+
+// Validate returns an error if the token is expired.
+func (t *SToken) Validate() error {
+	if t.IsExpired() {
+		return fmt.Errorf("location token for %q expired on %s", t.OrgName, t.ExpDate.Format(time.RFC3339))
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"go.uber.org/zap"
+)
+
+// encodeSToken builds a base64 location token with the given expiry.
+func encodeSToken(token, orgName string, expDate time.Time) string {
+	payload := `{"token":"` + token + `","expDate":"` + expDate.Format(sTokenExpDateLayout) + `","orgName":"` + orgName + `"}`
+	return base64.StdEncoding.EncodeToString([]byte(payload))
+}
+
+func TestParseSToken(t *testing.T) {
+	expDate := time.Date(2027, 1, 1, 12, 0, 0, 0, time.FixedZone("PST", -8*3600))
+	raw := encodeSToken("abc123", "Example School", expDate)
+
+	token, err := ParseSToken("  " + raw + "\n")
+	if err != nil {
+		t.Fatalf("ParseSToken() error = %v", err)
+	}
+	if token.Token != "abc123" {
+		t.Errorf("Token = %q, want %q", token.Token, "abc123")
+	}
+	if token.OrgName != "Example School" {
+		t.Errorf("OrgName = %q, want %q", token.OrgName, "Example School")
+	}
+	if !token.ExpDate.Equal(expDate) {
+		t.Errorf("ExpDate = %v, want %v", token.ExpDate, expDate)
+	}
+	if token.Raw != raw {
+		t.Errorf("Raw should be the trimmed input")
+	}
+}
+
+func TestParseSToken_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"empty":         "",
+		"not base64":    "!!!",
+		"not json":      base64.StdEncoding.EncodeToString([]byte("nope")),
+		"missing token": base64.StdEncoding.EncodeToString([]byte(`{"orgName":"x"}`)),
+		"bad expDate":   base64.StdEncoding.EncodeToString([]byte(`{"token":"x","expDate":"soon"}`)),
+	}
+
+	for name, raw := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ParseSToken(raw); err == nil {
+				t.Errorf("ParseSToken(%q) expected error", raw)
+			}
+		})
+	}
+}
+
+func TestSToken_Expiry(t *testing.T) {
+	valid, _ := ParseSToken(encodeSToken("a", "Org", time.Now().Add(48*time.Hour)))
+	if valid.IsExpired() {
+		t.Error("token expiring in 48h should not be expired")
+	}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+	if !valid.ExpiresWithin(72 * time.Hour) {
+		t.Error("ExpiresWithin(72h) should be true")
+	}
+	if valid.ExpiresWithin(time.Hour) {
+		t.Error("ExpiresWithin(1h) should be false")
+	}
+	if valid.TimeUntilExpiry() <= 0 {
+		t.Error("TimeUntilExpiry() should be positive")
+	}
+
+	expired, _ := ParseSToken(encodeSToken("a", "Org", time.Now().Add(-time.Hour)))
+	if !expired.IsExpired() {
+		t.Error("token expired an hour ago should be expired")
+	}
+	if err := expired.Validate(); err == nil {
+		t.Error("Validate() expected error for expired token")
+	}
+	if expired.TimeUntilExpiry() != 0 {
+		t.Error("TimeUntilExpiry() should be zero for expired token")
+	}
+}
+
+func TestLoadSTokenFromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "location.vpptoken")
+	if err := os.WriteFile(path, []byte(encodeSToken("file-token", "Org", time.Now().Add(time.Hour))), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := LoadSTokenFromFile(path)
+	if err != nil {
+		t.Fatalf("LoadSTokenFromFile() error = %v", err)
+	}
+	if token.Token != "file-token" {
+		t.Errorf("Token = %q, want %q", token.Token, "file-token")
+	}
+}
+
+func TestLocationTokenSource(t *testing.T) {
+	north := encodeSToken("north", "North Campus", time.Now().Add(time.Hour))
+	south := encodeSToken("south", "South Campus", time.Now().Add(time.Hour))
+	old := encodeSToken("old", "Old Campus", time.Now().Add(-time.Hour))
+
+	source, err := NewLocationTokenSource("north", map[string]string{"north": north, "south": south, "old": old})
+	if err != nil {
+		t.Fatalf("NewLocationTokenSource() error = %v", err)
+	}
+
+	if got := source.Locations(); len(got) != 3 || got[0] != "north" {
+		t.Errorf("Locations() = %v", got)
+	}
+
+	token, err := source.Token(context.Background())
+	if err != nil || token != north {
+		t.Errorf("default Token() = %q, %v; want north token", token, err)
+	}
+
+	token, err = source.Token(ContextWithLocation(context.Background(), "south"))
+	if err != nil || token != south {
+		t.Errorf("south Token() = %q, %v; want south token", token, err)
+	}
+
+	if _, err := source.Token(ContextWithLocation(context.Background(), "old")); err == nil {
+		t.Error("expected error for expired location token")
+	}
+	if _, err := source.Token(ContextWithLocation(context.Background(), "east")); err == nil {
+		t.Error("expected error for unknown location")
+	}
+
+	if _, err := NewLocationTokenSource("east", map[string]string{"north": north}); err == nil {
+		t.Error("expected error for missing default location")
+	}
+	if _, err := NewLocationTokenSource("north", nil); err == nil {
+		t.Error("expected error for no tokens")
+	}
+}
+
+func TestTransport_TokenSourcePerRequest(t *testing.T) {
+	north := encodeSToken("north", "North Campus", time.Now().Add(time.Hour))
+	south := encodeSToken("south", "South Campus", time.Now().Add(time.Hour))
+
+	source, err := NewLocationTokenSource("north", map[string]string{"north": north, "south": south})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	transport, err := NewTransport("", WithTokenSource(source), WithLogger(zap.NewNop()), WithRetryCount(0))
+	if err != nil {
+		t.Fatalf("NewTransport() error = %v", err)
+	}
+
+	httpmock.ActivateNonDefault(transport.GetHTTPClient().Client())
+	defer httpmock.DeactivateAndReset()
+
+	var authHeaders []string
+	httpmock.RegisterResponder("GET", "https://vpp.itunes.apple.com/mdm/v2/client/config",
+		func(req *http.Request) (*http.Response, error) {
+			authHeaders = append(authHeaders, req.Header.Get("Authorization"))
+			return httpmock.NewStringResponse(200, `{}`), nil
+		})
+
+	if _, err := transport.NewRequest(context.Background()).Get("/client/config"); err != nil {
+		t.Fatalf("default request error = %v", err)
+	}
+	if _, err := transport.NewRequest(ContextWithLocation(context.Background(), "south")).Get("/client/config"); err != nil {
+		t.Fatalf("south request error = %v", err)
+	}
+	if _, err := transport.NewRequest(ContextWithLocation(context.Background(), "east")).Get("/client/config"); err == nil {
+		t.Error("expected error for unknown location")
+	}
+
+	want := []string{"Bearer " + north, "Bearer " + south}
+	if len(authHeaders) != len(want) || authHeaders[0] != want[0] || authHeaders[1] != want[1] {
+		t.Errorf("Authorization headers = %v, want %v", authHeaders, want)
+	}
+}
+
+func TestNewTransport_RequiresToken(t *testing.T) {
+	if _, err := NewTransport(""); err == nil {
+		t.Error("expected error without sToken or token source")
+	}
+	if _, err := NewTransport("", WithTokenSource(nil)); err == nil {
+		t.Error("expected error for nil token source")
+	}
+}
@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// TokenSource supplies the location token used to authenticate a request.
+// It is consulted on every request attempt with the request's context, so
+// an implementation may choose a token per request. Implementations must be
+// safe for concurrent use.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// TokenSourceFunc adapts an ordinary function to the TokenSource interface.
+type TokenSourceFunc func(ctx context.Context) (string, error)
+
+// Token implements TokenSource.
+func (f TokenSourceFunc) Token(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// NewStaticTokenSource returns a TokenSource that always supplies sToken.
+func NewStaticTokenSource(sToken string) TokenSource {
+	return TokenSourceFunc(func(ctx context.Context) (string, error) {
+		return sToken, nil
+	})
+}
+
+// locationContextKey is the context key for the selected location name.
+type locationContextKey struct{}
+
+// ContextWithLocation returns a context that selects the named location's
+// token when used with a LocationTokenSource.
+func ContextWithLocation(ctx context.Context, location string) context.Context {
+	return context.WithValue(ctx, locationContextKey{}, location)
+}
+
+// LocationFromContext returns the location selected with ContextWithLocation.
+func LocationFromContext(ctx context.Context) (string, bool) {
+	location, ok := ctx.Value(locationContextKey{}).(string)
+	return location, ok && location != ""
+}
+
+// LocationTokenSource holds the location tokens of a multi-location
+// organization and picks one per request: the location named in the request
+// context (see ContextWithLocation), or the default location otherwise.
+// Expired tokens are rejected before a request is sent.
+type LocationTokenSource struct {
+	mu              sync.RWMutex
+	tokens          map[string]*SToken
+	defaultLocation string
+}
+
+// Ensure LocationTokenSource implements TokenSource.
+var _ TokenSource = (*LocationTokenSource)(nil)
+
+// NewLocationTokenSource parses the raw location tokens keyed by location
+// name. defaultLocation must be one of the keys.
+func NewLocationTokenSource(defaultLocation string, rawTokens map[string]string) (*LocationTokenSource, error) {
+	if len(rawTokens) == 0 {
+		return nil, fmt.Errorf("at least one location token is required")
+	}
+
+	source := &LocationTokenSource{tokens: make(map[string]*SToken, len(rawTokens))}
+	for location, raw := range rawTokens {
+		if err := source.Set(location, raw); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, ok := source.tokens[defaultLocation]; !ok {
+		return nil, fmt.Errorf("default location %q has no token", defaultLocation)
+	}
+	source.defaultLocation = defaultLocation
+
+	return source, nil
+}
+
+// Set adds or replaces the token for a location, e.g. after renewal.
+func (s *LocationTokenSource) Set(location, rawToken string) error {
+	if location == "" {
+		return fmt.Errorf("location name cannot be empty")
+	}
+
+	token, err := ParseSToken(rawToken)
+	if err != nil {
+		return fmt.Errorf("location %q: %w", location, err)
+	}
+
+	s.mu.Lock()
+	s.tokens[location] = token
+	s.mu.Unlock()
+	return nil
+}
+
+// Get returns the parsed token for a location.
+func (s *LocationTokenSource) Get(location string) (*SToken, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	token, ok := s.tokens[location]
+	return token, ok
+}
+
+// Locations returns the configured location names in sorted order.
+func (s *LocationTokenSource) Locations() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	locations := make([]string, 0, len(s.tokens))
+	for location := range s.tokens {
+		locations = append(locations, location)
+	}
+	sort.Strings(locations)
+	return locations
+}
+
+// Token implements TokenSource.
+func (s *LocationTokenSource) Token(ctx context.Context) (string, error) {
+	location, ok := LocationFromContext(ctx)
+	if !ok {
+		location = s.defaultLocation
+	}
+
+	token, ok := s.Get(location)
+	if !ok {
+		return "", fmt.Errorf("no location token configured for %q", location)
+	}
+	if err := token.Validate(); err != nil {
+		return "", err
+	}
+
+	return token.Raw, nil
+}
@@ -0,0 +1,206 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+// Transport represents the Apps and Books for Organizations (VPP) API HTTP
+// transport layer. Requests are authenticated with a location token (sToken)
+// sent as a bearer token, supplied per request by a TokenSource.
+type Transport struct {
+	httpClient   *resty.Client
+	logger       *zap.Logger
+	errorHandler *ErrorHandler
+	tokenSource  TokenSource
+	baseURL      string
+}
+
+// Ensure Transport implements Client interface.
+var _ Client = (*Transport)(nil)
+
+// NewTransport creates a new HTTP transport for the VPP API.
+// sToken may be empty when a token source is configured with WithTokenSource.
+// This is an internal function — users should use vpp.NewClient() instead.
+func NewTransport(sToken string, options ...ClientOption) (*Transport, error) {
+	logger := zap.NewNop()
+
+	httpClient := resty.New()
+	httpClient.
+		SetBaseURL(DefaultBaseURL).
+		SetTimeout(30*time.Second).
+		SetRetryCount(3).
+		SetRetryWaitTime(1*time.Second).
+		SetRetryMaxWaitTime(10*time.Second).
+		SetHeader("User-Agent", DefaultUserAgent)
+
+	errorHandler := NewErrorHandler(logger)
+
+	transport := &Transport{
+		httpClient:   httpClient,
+		logger:       logger,
+		errorHandler: errorHandler,
+		baseURL:      DefaultBaseURL,
+	}
+
+	if sToken != "" {
+		transport.tokenSource = NewStaticTokenSource(sToken)
+	}
+
+	for _, option := range options {
+		if err := option(transport); err != nil {
+			return nil, fmt.Errorf("failed to apply client option: %w", err)
+		}
+	}
+
+	if transport.tokenSource == nil {
+		return nil, fmt.Errorf("location token (sToken) is required")
+	}
+
+	// The location token is resolved per request and sent as a bearer token.
+	httpClient.AddRequestMiddleware(func(c *resty.Client, req *resty.Request) error {
+		token, err := transport.tokenSource.Token(req.Context())
+		if err != nil {
+			return fmt.Errorf("failed to obtain location token: %w", err)
+		}
+		req.SetAuthToken(token)
+		return nil
+	})
+
+	httpClient.AddRequestMiddleware(func(c *resty.Client, req *resty.Request) error {
+		transport.logger.Info("VPP API request",
+			zap.String("method", req.Method),
+			zap.String("url", req.URL),
+		)
+		return nil
+	})
+
+	httpClient.AddResponseMiddleware(func(c *resty.Client, resp *resty.Response) error {
+		transport.logger.Info("VPP API response",
+			zap.String("method", resp.Request.Method),
+			zap.String("url", resp.Request.URL),
+			zap.Int("status_code", resp.StatusCode()),
+			zap.String("status", resp.Status()),
+		)
+		return nil
+	})
+
+	transport.logger.Info("VPP API client created",
+		zap.String("base_url", transport.baseURL))
+
+	return transport, nil
+}
+
+// NewRequest returns a new RequestBuilder for constructing API requests.
+func (t *Transport) NewRequest(ctx context.Context) *RequestBuilder {
+	return &RequestBuilder{
+		req:      t.httpClient.R().SetContext(ctx),
+		executor: t,
+	}
+}
+
+// QueryBuilder returns a new query builder instance.
+func (t *Transport) QueryBuilder() *QueryBuilder {
+	return NewQueryBuilder()
+}
+
+// GetLogger returns the configured logger.
+func (t *Transport) GetLogger() *zap.Logger {
+	return t.logger
+}
+
+// GetHTTPClient returns the underlying HTTP client for testing purposes.
+func (t *Transport) GetHTTPClient() *resty.Client {
+	return t.httpClient
+}
+
+// Close closes the HTTP client and cleans up resources.
+func (t *Transport) Close() error {
+	if t.httpClient != nil {
+		t.httpClient.Close()
+	}
+	return nil
+}
+
+// execute implements requestExecutor — handles all HTTP methods and error processing.
+func (t *Transport) execute(req *resty.Request, method, path string, result any) (*resty.Response, error) {
+	var resp *resty.Response
+	var err error
+
+	switch method {
+	case "GET":
+		resp, err = req.Get(path)
+	case "POST":
+		req.SetHeader("Content-Type", "application/json")
+		resp, err = req.Post(path)
+	default:
+		return nil, fmt.Errorf("unsupported HTTP method: %s", method)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	if resp.IsStatusFailure() {
+		return resp, t.errorHandler.HandleError(resp)
+	}
+
+	if result != nil {
+		if err := json.Unmarshal(resp.Bytes(), result); err != nil {
+			return resp, fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+	}
+
+	return resp, nil
+}
+
+// executePaginated implements requestExecutor — pageIndex-based pagination loop.
+// The API reports the index of the following page in nextPageIndex and omits
+// it on the last page.
+func (t *Transport) executePaginated(req *resty.Request, path string, mergePage func([]byte) error) (*resty.Response, error) {
+	pageReq := req
+
+	for {
+		resp, err := t.execute(pageReq, "GET", path, nil)
+		if err != nil {
+			return resp, err
+		}
+
+		rawResponse := resp.Bytes()
+
+		var pageInfo struct {
+			NextPageIndex *int `json:"nextPageIndex,omitempty"`
+		}
+		if err := json.Unmarshal(rawResponse, &pageInfo); err != nil {
+			return resp, fmt.Errorf("failed to parse pagination info: %w", err)
+		}
+
+		if err := mergePage(rawResponse); err != nil {
+			return resp, err
+		}
+
+		if pageInfo.NextPageIndex == nil {
+			return resp, nil
+		}
+
+		// Build a fresh request for each page (reuse headers and filters)
+		pageReq = t.httpClient.R().SetContext(req.Context())
+		for k, v := range req.Header {
+			if len(v) > 0 {
+				pageReq.SetHeader(k, v[0])
+			}
+		}
+		for k, v := range req.QueryParams {
+			if len(v) > 0 {
+				pageReq.SetQueryParam(k, v[0])
+			}
+		}
+		pageReq.SetQueryParam("pageIndex", strconv.Itoa(*pageInfo.NextPageIndex))
+	}
+}
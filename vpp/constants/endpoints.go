@@ -0,0 +1,16 @@
+package constants
+
+// API base URL
+const (
+	// DefaultBaseURL is the base URL for the Apps and Books for Organizations API.
+	DefaultBaseURL = "https://vpp.itunes.apple.com/mdm/v2"
+)
+
+// API endpoint paths
+const (
+	EndpointClientConfig = "/client/config"
+	EndpointAssets       = "/assets"
+	EndpointAssociate    = "/assets/associate"
+	EndpointDisassociate = "/assets/disassociate"
+	EndpointEventStatus  = "/status/eventStatus"
+)
@@ -0,0 +1,68 @@
+package vpp
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-apple/vpp/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/vpp/vpp_api/assets"
+	"github.com/deploymenttheory/go-api-sdk-apple/vpp/vpp_api/assignments"
+)
+
+// Client is the main entry point for the Apps and Books for Organizations (VPP) API SDK.
+// A Client is safe for concurrent use by multiple goroutines.
+type Client struct {
+	transport *client.Transport
+	VPPAPI    *VPPAPIClient
+}
+
+// VPPAPIClient groups all Apps and Books for Organizations API services.
+type VPPAPIClient struct {
+	Assets      *assets.Assets
+	Assignments *assignments.Assignments
+}
+
+// NewClient creates a new Apps and Books for Organizations API client.
+// Parameters:
+//   - sToken: The content token for a location, downloaded from Apple Business
+//     Manager or Apple School Manager (Settings > Payments and Billing)
+//   - options: Optional configuration options (WithLogger, WithTimeout, etc.)
+//
+// Example:
+//
+//	c, err := vpp.NewClient(os.Getenv("VPP_STOKEN"),
+//	    vpp.WithTimeout(15 * time.Second),
+//	    vpp.WithLogger(logger),
+//	)
+func NewClient(sToken string, options ...client.ClientOption) (*Client, error) {
+	transport, err := client.NewTransport(sToken, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		transport: transport,
+		VPPAPI: &VPPAPIClient{
+			Assets:      assets.NewService(transport),
+			Assignments: assignments.NewService(transport),
+		},
+	}, nil
+}
+
+// NewClientFromTokenSource creates a client that obtains location tokens from
+// source on every request, e.g. a LocationTokenSource for multi-location
+// organizations.
+//
+// Example:
+//
+//	source, err := vpp.NewLocationTokenSource("north", map[string]string{
+//	    "north": northToken,
+//	    "south": southToken,
+//	})
+//	c, err := vpp.NewClientFromTokenSource(source)
+//	assets, _, err := c.VPPAPI.Assets.GetV1(vpp.ContextWithLocation(ctx, "south"), nil)
+func NewClientFromTokenSource(source TokenSource, options ...client.ClientOption) (*Client, error) {
+	return NewClient("", append([]client.ClientOption{client.WithTokenSource(source)}, options...)...)
+}
+
+// Close releases resources held by the client.
+func (c *Client) Close() error {
+	return c.transport.Close()
+}
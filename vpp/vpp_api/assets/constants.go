@@ -0,0 +1,22 @@
+package assets
+
+// ProductType constants for the productType field and filter.
+const (
+	ProductTypeApp  = "App"
+	ProductTypeBook = "Book"
+)
+
+// PricingParam constants for the pricingParam field and filter.
+const (
+	PricingParamStandardQuality = "STDQ"
+	PricingParamHighQuality     = "PLUS"
+)
+
+// Platform constants for the supportedPlatforms field.
+const (
+	PlatformIOS      = "iOS"
+	PlatformMacOS    = "macOS"
+	PlatformTVOS     = "tvOS"
+	PlatformVisionOS = "visionOS"
+	PlatformWatchOS  = "watchOS"
+)
@@ -0,0 +1,96 @@
+package assets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/vpp/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/vpp/constants"
+	"resty.dev/v3"
+)
+
+// Assets handles communication with the asset related
+// methods of the Apps and Books for Organizations API.
+//
+// Apps and Books for Organizations API docs: https://developer.apple.com/documentation/devicemanagement/app-and-book-management
+type (
+	Assets struct {
+		client client.Client
+	}
+)
+
+// NewService creates a new assets service.
+func NewService(c client.Client) *Assets {
+	return &Assets{client: c}
+}
+
+// GetV1 retrieves all assets purchased by the location, following every page.
+// URL: GET https://vpp.itunes.apple.com/mdm/v2/assets
+// https://developer.apple.com/documentation/devicemanagement/get-assets
+func (s *Assets) GetV1(ctx context.Context, opts *RequestQueryOptions) (*AssetsResponse, *resty.Response, error) {
+	if opts == nil {
+		opts = &RequestQueryOptions{}
+	}
+
+	params := s.client.QueryBuilder().
+		AddString("adamId", opts.AdamID).
+		AddString("pricingParam", opts.PricingParam).
+		AddString("productType", opts.ProductType).
+		AddInt("maxAvailableCount", opts.MaxAvailableCount).
+		AddInt("minAvailableCount", opts.MinAvailableCount).
+		AddInt("maxAssignedCount", opts.MaxAssignedCount).
+		AddInt("minAssignedCount", opts.MinAssignedCount)
+
+	if opts.DeviceAssignable != nil {
+		params.AddBool("deviceAssignable", *opts.DeviceAssignable)
+	}
+	if opts.Revocable != nil {
+		params.AddBool("revocable", *opts.Revocable)
+	}
+
+	var result AssetsResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParams(params.Build()).
+		GetPaginated(constants.EndpointAssets, func(pageData []byte) error {
+			var page AssetsResponse
+			if err := json.Unmarshal(pageData, &page); err != nil {
+				return fmt.Errorf("failed to unmarshal page: %w", err)
+			}
+			assets := append(result.Assets, page.Assets...)
+			result = page
+			result.Assets = assets
+			return nil
+		})
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// GetByAdamIDV1 retrieves a single asset, including its available and
+// assigned license counts, by its App Store product identifier.
+// URL: GET https://vpp.itunes.apple.com/mdm/v2/assets?adamId={adamId}
+// https://developer.apple.com/documentation/devicemanagement/get-assets
+func (s *Assets) GetByAdamIDV1(ctx context.Context, adamID string) (*Asset, *resty.Response, error) {
+	if adamID == "" {
+		return nil, nil, fmt.Errorf("adam ID is required")
+	}
+
+	result, resp, err := s.GetV1(ctx, &RequestQueryOptions{AdamID: adamID})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	for i := range result.Assets {
+		if result.Assets[i].AdamID == adamID {
+			return &result.Assets[i], resp, nil
+		}
+	}
+
+	return nil, resp, fmt.Errorf("asset %s not found", adamID)
+}
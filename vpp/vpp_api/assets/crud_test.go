@@ -0,0 +1,181 @@
+package assets
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/vpp/client"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// setupMockClient creates a VPP transport with httpmock enabled.
+func setupMockClient(t *testing.T) *Assets {
+	t.Helper()
+
+	transport, err := client.NewTransport("test-stoken",
+		client.WithLogger(zap.NewNop()),
+		client.WithRetryCount(0), // disable retries for tests
+	)
+	require.NoError(t, err)
+
+	httpmock.ActivateNonDefault(transport.GetHTTPClient().Client())
+	t.Cleanup(func() {
+		httpmock.DeactivateAndReset()
+	})
+
+	return NewService(transport)
+}
+
+const (
+	assetsPage0 = `{
+		"assets": [
+			{"adamId": "361309726", "pricingParam": "STDQ", "productType": "App", "revocable": true, "deviceAssignable": true, "supportedPlatforms": ["iOS", "macOS"], "assignedCount": 10, "availableCount": 90, "retiredCount": 0, "totalCount": 100}
+		],
+		"currentPageIndex": 0,
+		"nextPageIndex": 1,
+		"size": 1,
+		"totalPages": 2,
+		"tokenExpirationDate": "2027-01-01T00:00:00+0000",
+		"uId": "2049025000431439",
+		"versionId": "1"
+	}`
+	assetsPage1 = `{
+		"assets": [
+			{"adamId": "408709785", "pricingParam": "STDQ", "productType": "Book", "revocable": false, "deviceAssignable": false, "assignedCount": 5, "availableCount": 0, "retiredCount": 1, "totalCount": 6}
+		],
+		"currentPageIndex": 1,
+		"size": 1,
+		"totalPages": 2,
+		"tokenExpirationDate": "2027-01-01T00:00:00+0000",
+		"uId": "2049025000431439",
+		"versionId": "2"
+	}`
+)
+
+func TestGetAssets_Success(t *testing.T) {
+	svc := setupMockClient(t)
+
+	httpmock.RegisterResponder("GET", "https://vpp.itunes.apple.com/mdm/v2/assets",
+		func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "Bearer test-stoken", req.Header.Get("Authorization"))
+			if req.URL.Query().Get("pageIndex") == "1" {
+				return httpmock.NewStringResponse(200, assetsPage1), nil
+			}
+			return httpmock.NewStringResponse(200, assetsPage0), nil
+		})
+
+	result, resp, err := svc.GetV1(context.Background(), nil)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, 200, resp.StatusCode())
+	require.NotNil(t, result)
+	require.Len(t, result.Assets, 2, "both pages should be merged")
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+
+	app := result.Assets[0]
+	assert.Equal(t, "361309726", app.AdamID)
+	assert.Equal(t, ProductTypeApp, app.ProductType)
+	assert.Equal(t, PricingParamStandardQuality, app.PricingParam)
+	assert.True(t, app.DeviceAssignable)
+	assert.Equal(t, []string{PlatformIOS, PlatformMacOS}, app.SupportedPlatforms)
+	assert.Equal(t, "408709785", result.Assets[1].AdamID)
+
+	assert.Equal(t, "2", result.VersionID)
+	assert.Nil(t, result.NextPageIndex)
+
+	counts := result.LicenseCounts()
+	assert.Equal(t, LicenseCounts{Assets: 2, Total: 106, Assigned: 15, Available: 90, Retired: 1}, counts)
+}
+
+func TestGetAssets_QueryParamsForwarded(t *testing.T) {
+	svc := setupMockClient(t)
+
+	var captured map[string]string
+	httpmock.RegisterResponder("GET", "https://vpp.itunes.apple.com/mdm/v2/assets",
+		func(req *http.Request) (*http.Response, error) {
+			captured = map[string]string{}
+			for k, v := range req.URL.Query() {
+				captured[k] = v[0]
+			}
+			return httpmock.NewStringResponse(200, `{"assets": [], "currentPageIndex": 0, "size": 0, "totalPages": 1}`), nil
+		})
+
+	deviceAssignable := true
+	_, _, err := svc.GetV1(context.Background(), &RequestQueryOptions{
+		ProductType:       ProductTypeApp,
+		PricingParam:      PricingParamStandardQuality,
+		DeviceAssignable:  &deviceAssignable,
+		MinAvailableCount: 1,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "App", captured["productType"])
+	assert.Equal(t, "STDQ", captured["pricingParam"])
+	assert.Equal(t, "true", captured["deviceAssignable"])
+	assert.Equal(t, "1", captured["minAvailableCount"])
+	assert.NotContains(t, captured, "revocable")
+}
+
+func TestGetAssets_APIError(t *testing.T) {
+	svc := setupMockClient(t)
+
+	httpmock.RegisterResponder("GET", "https://vpp.itunes.apple.com/mdm/v2/assets",
+		httpmock.NewStringResponder(401, `{"errorNumber": 9622, "errorMessage": "Invalid authentication token"}`))
+
+	result, resp, err := svc.GetV1(context.Background(), nil)
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+	require.NotNil(t, resp)
+	assert.Equal(t, 401, resp.StatusCode())
+
+	var apiErr *client.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, 9622, apiErr.ErrorNumber)
+	assert.True(t, client.IsAPIError(err, 9622))
+}
+
+func TestGetAssetByAdamID_Success(t *testing.T) {
+	svc := setupMockClient(t)
+
+	httpmock.RegisterResponder("GET", "https://vpp.itunes.apple.com/mdm/v2/assets",
+		func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "361309726", req.URL.Query().Get("adamId"))
+			return httpmock.NewStringResponse(200, `{"assets": [{"adamId": "361309726", "pricingParam": "STDQ", "assignedCount": 3, "availableCount": 7, "totalCount": 10}], "currentPageIndex": 0, "size": 1, "totalPages": 1}`), nil
+		})
+
+	asset, _, err := svc.GetByAdamIDV1(context.Background(), "361309726")
+
+	require.NoError(t, err)
+	require.NotNil(t, asset)
+	assert.Equal(t, 3, asset.AssignedCount)
+	assert.Equal(t, 7, asset.AvailableCount)
+}
+
+func TestGetAssetByAdamID_NotFound(t *testing.T) {
+	svc := setupMockClient(t)
+
+	httpmock.RegisterResponder("GET", "https://vpp.itunes.apple.com/mdm/v2/assets",
+		httpmock.NewStringResponder(200, `{"assets": [], "currentPageIndex": 0, "size": 0, "totalPages": 0}`))
+
+	asset, _, err := svc.GetByAdamIDV1(context.Background(), "1")
+
+	require.Error(t, err)
+	assert.Nil(t, asset)
+	assert.Contains(t, err.Error(), "not found")
+}
+
+func TestGetAssetByAdamID_EmptyAdamID(t *testing.T) {
+	svc := setupMockClient(t)
+
+	asset, resp, err := svc.GetByAdamIDV1(context.Background(), "")
+
+	require.Error(t, err)
+	assert.Nil(t, asset)
+	assert.Nil(t, resp)
+}
@@ -0,0 +1,71 @@
+package assets
+
+// AssetsResponse is the response for a list of assets.
+// https://developer.apple.com/documentation/devicemanagement/getassetsresponse
+type AssetsResponse struct {
+	Assets              []Asset `json:"assets"`
+	CurrentPageIndex    int     `json:"currentPageIndex"`
+	NextPageIndex       *int    `json:"nextPageIndex,omitempty"`
+	Size                int     `json:"size"`
+	TotalPages          int     `json:"totalPages"`
+	TokenExpirationDate string  `json:"tokenExpirationDate,omitempty"`
+	UID                 string  `json:"uId,omitempty"`
+	VersionID           string  `json:"versionId,omitempty"`
+}
+
+// Asset represents an app or book purchased in volume by the location.
+// https://developer.apple.com/documentation/devicemanagement/asset
+type Asset struct {
+	AdamID             string   `json:"adamId"`
+	PricingParam       string   `json:"pricingParam"`
+	ProductType        string   `json:"productType,omitempty"`
+	Revocable          bool     `json:"revocable"`
+	DeviceAssignable   bool     `json:"deviceAssignable"`
+	SupportedPlatforms []string `json:"supportedPlatforms,omitempty"`
+	AssignedCount      int      `json:"assignedCount"`
+	AvailableCount     int      `json:"availableCount"`
+	RetiredCount       int      `json:"retiredCount"`
+	TotalCount         int      `json:"totalCount"`
+}
+
+// LicenseCounts summarizes license usage across a set of assets.
+type LicenseCounts struct {
+	Assets    int
+	Total     int
+	Assigned  int
+	Available int
+	Retired   int
+}
+
+// LicenseCounts returns the license totals across all assets in the response.
+func (r *AssetsResponse) LicenseCounts() LicenseCounts {
+	counts := LicenseCounts{Assets: len(r.Assets)}
+	for _, asset := range r.Assets {
+		counts.Total += asset.TotalCount
+		counts.Assigned += asset.AssignedCount
+		counts.Available += asset.AvailableCount
+		counts.Retired += asset.RetiredCount
+	}
+	return counts
+}
+
+// RequestQueryOptions represents the filters for the assets endpoint.
+// Zero values are omitted from the request.
+type RequestQueryOptions struct {
+	// AdamID restricts the results to a single product.
+	AdamID string
+	// PricingParam filters by quality. Use PricingParam* constants.
+	PricingParam string
+	// ProductType filters by product type. Use ProductType* constants.
+	ProductType string
+	// DeviceAssignable, when set, filters by whether the asset can be assigned to devices.
+	DeviceAssignable *bool
+	// Revocable, when set, filters by whether licenses can be revoked.
+	Revocable *bool
+	// MaxAvailableCount and MinAvailableCount filter by available license count.
+	MaxAvailableCount int
+	MinAvailableCount int
+	// MaxAssignedCount and MinAssignedCount filter by assigned license count.
+	MaxAssignedCount int
+	MinAssignedCount int
+}
@@ -0,0 +1,34 @@
+package assignments
+
+import "time"
+
+// EventStatus constants for the eventStatus field values.
+const (
+	EventStatusPending  = "PENDING"
+	EventStatusComplete = "COMPLETE"
+	EventStatusFailed   = "FAILED"
+)
+
+// EventType constants for the eventType field values.
+const (
+	EventTypeAssociate    = "ASSOCIATE"
+	EventTypeDisassociate = "DISASSOCIATE"
+)
+
+// DeviceStatus constants for DeviceResult.Status.
+const (
+	DeviceStatusSucceeded = "SUCCEEDED"
+	DeviceStatusFailed    = "FAILED"
+	DeviceStatusPending   = "PENDING"
+)
+
+// API limits for association requests.
+const (
+	// MaxSerialNumbersPerRequest is the maximum number of serial numbers the
+	// API accepts in a single associate or disassociate request.
+	MaxSerialNumbersPerRequest = 1000
+
+	// DefaultPollInterval is how often event status is polled while waiting
+	// for a batch to complete.
+	DefaultPollInterval = 5 * time.Second
+)
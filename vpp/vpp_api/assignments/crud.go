@@ -0,0 +1,219 @@
+package assignments
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/vpp/client"
+	"github.com/deploymenttheory/go-api-sdk-apple/vpp/constants"
+	"resty.dev/v3"
+)
+
+// Assignments handles communication with the license assignment
+// related methods of the Apps and Books for Organizations API.
+//
+// Apps and Books for Organizations API docs: https://developer.apple.com/documentation/devicemanagement/app-and-book-management
+type (
+	Assignments struct {
+		client client.Client
+	}
+)
+
+// NewService creates a new assignments service.
+func NewService(c client.Client) *Assignments {
+	return &Assignments{client: c}
+}
+
+// AssociateV1 asynchronously associates assets with devices or users.
+// URL: POST https://vpp.itunes.apple.com/mdm/v2/assets/associate
+// https://developer.apple.com/documentation/devicemanagement/associate-assets
+func (s *Assignments) AssociateV1(ctx context.Context, request *AssociateAssetsRequest) (*EventResponse, *resty.Response, error) {
+	return s.submit(ctx, constants.EndpointAssociate, request)
+}
+
+// DisassociateV1 asynchronously disassociates assets from devices or users.
+// URL: POST https://vpp.itunes.apple.com/mdm/v2/assets/disassociate
+// https://developer.apple.com/documentation/devicemanagement/disassociate-assets
+func (s *Assignments) DisassociateV1(ctx context.Context, request *AssociateAssetsRequest) (*EventResponse, *resty.Response, error) {
+	return s.submit(ctx, constants.EndpointDisassociate, request)
+}
+
+// GetEventStatusV1 retrieves the status of an asynchronous event.
+// URL: GET https://vpp.itunes.apple.com/mdm/v2/status/eventStatus?eventId={eventId}
+// https://developer.apple.com/documentation/devicemanagement/get-event-status
+func (s *Assignments) GetEventStatusV1(ctx context.Context, eventID string) (*EventStatusResponse, *resty.Response, error) {
+	if eventID == "" {
+		return nil, nil, fmt.Errorf("event ID is required")
+	}
+
+	var result EventStatusResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetQueryParam("eventId", eventID).
+		SetResult(&result).
+		Get(constants.EndpointEventStatus)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// AssignAssetToSerialsV1 assigns an asset's licenses to devices by serial
+// number. Serial numbers are split into batches, each submitted as its own
+// associate event; unless opts.NoWait is set the events are polled until they
+// finish and a result is reported per device.
+func (s *Assignments) AssignAssetToSerialsV1(ctx context.Context, asset AssetRef, serialNumbers []string, opts *AssignmentOptions) (*AssignmentResult, error) {
+	return s.applyToSerials(ctx, constants.EndpointAssociate, asset, serialNumbers, opts)
+}
+
+// RevokeAssetFromSerialsV1 revokes an asset's licenses from devices by serial
+// number, with the same batching and per-device reporting as AssignAssetToSerialsV1.
+func (s *Assignments) RevokeAssetFromSerialsV1(ctx context.Context, asset AssetRef, serialNumbers []string, opts *AssignmentOptions) (*AssignmentResult, error) {
+	return s.applyToSerials(ctx, constants.EndpointDisassociate, asset, serialNumbers, opts)
+}
+
+// submit posts an associate or disassociate request.
+func (s *Assignments) submit(ctx context.Context, endpoint string, request *AssociateAssetsRequest) (*EventResponse, *resty.Response, error) {
+	if request == nil {
+		return nil, nil, fmt.Errorf("request is required")
+	}
+	if len(request.Assets) == 0 {
+		return nil, nil, fmt.Errorf("at least one asset is required")
+	}
+	if len(request.SerialNumbers) == 0 && len(request.ClientUserIDs) == 0 {
+		return nil, nil, fmt.Errorf("at least one serial number or client user ID is required")
+	}
+	if len(request.SerialNumbers) > MaxSerialNumbersPerRequest {
+		return nil, nil, fmt.Errorf("at most %d serial numbers are allowed per request, got %d", MaxSerialNumbersPerRequest, len(request.SerialNumbers))
+	}
+
+	var result EventResponse
+
+	resp, err := s.client.NewRequest(ctx).
+		SetHeader("Accept", constants.ApplicationJSON).
+		SetBody(request).
+		SetResult(&result).
+		Post(endpoint)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// applyToSerials submits batches of serial numbers to endpoint and collects per-device results.
+func (s *Assignments) applyToSerials(ctx context.Context, endpoint string, asset AssetRef, serialNumbers []string, opts *AssignmentOptions) (*AssignmentResult, error) {
+	if asset.AdamID == "" {
+		return nil, fmt.Errorf("asset adam ID is required")
+	}
+	if len(serialNumbers) == 0 {
+		return nil, fmt.Errorf("at least one serial number is required")
+	}
+
+	if opts == nil {
+		opts = &AssignmentOptions{}
+	}
+	batchSize := opts.BatchSize
+	if batchSize <= 0 || batchSize > MaxSerialNumbersPerRequest {
+		batchSize = MaxSerialNumbersPerRequest
+	}
+	pollInterval := opts.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = DefaultPollInterval
+	}
+
+	result := &AssignmentResult{Asset: asset}
+
+	for start := 0; start < len(serialNumbers); start += batchSize {
+		batch := serialNumbers[start:min(start+batchSize, len(serialNumbers))]
+
+		event, _, err := s.submit(ctx, endpoint, &AssociateAssetsRequest{
+			Assets:        []AssetRef{asset},
+			SerialNumbers: batch,
+		})
+		if err != nil {
+			return result, fmt.Errorf("batch starting at serial %d: %w", start, err)
+		}
+
+		result.Batches = append(result.Batches, BatchResult{
+			EventID:       event.EventID,
+			SerialNumbers: batch,
+		})
+	}
+
+	for i := range result.Batches {
+		batch := &result.Batches[i]
+
+		if !opts.NoWait {
+			status, err := s.waitForEvent(ctx, batch.EventID, pollInterval)
+			if err != nil {
+				return result, fmt.Errorf("event %s: %w", batch.EventID, err)
+			}
+			batch.Status = status
+		}
+
+		result.Devices = append(result.Devices, deviceResults(batch)...)
+	}
+
+	return result, nil
+}
+
+// waitForEvent polls an event until it is no longer pending.
+func (s *Assignments) waitForEvent(ctx context.Context, eventID string, pollInterval time.Duration) (*EventStatusResponse, error) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		status, _, err := s.GetEventStatusV1(ctx, eventID)
+		if err != nil {
+			return nil, err
+		}
+		if status.EventStatus != EventStatusPending {
+			return status, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// deviceResults maps a batch's event status to one result per serial number.
+func deviceResults(batch *BatchResult) []DeviceResult {
+	failures := make(map[string]EventFailure)
+	if batch.Status != nil {
+		for _, failure := range batch.Status.Failures {
+			failures[failure.SerialNumber] = failure
+		}
+	}
+
+	results := make([]DeviceResult, 0, len(batch.SerialNumbers))
+	for _, serial := range batch.SerialNumbers {
+		device := DeviceResult{SerialNumber: serial, EventID: batch.EventID}
+		failure, failed := failures[serial]
+
+		switch {
+		case batch.Status == nil:
+			device.Status = DeviceStatusPending
+		case failed:
+			device.Status = DeviceStatusFailed
+			device.ErrorNumber = failure.ErrorNumber
+			device.ErrorMessage = failure.ErrorMessage
+		case batch.Status.EventStatus == EventStatusFailed && len(batch.Status.Failures) == 0:
+			device.Status = DeviceStatusFailed
+		default:
+			device.Status = DeviceStatusSucceeded
+		}
+
+		results = append(results, device)
+	}
+
+	return results
+}
@@ -0,0 +1,188 @@
+package assignments
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-apple/vpp/client"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// setupMockClient creates a VPP transport with httpmock enabled.
+func setupMockClient(t *testing.T) *Assignments {
+	t.Helper()
+
+	transport, err := client.NewTransport("test-stoken",
+		client.WithLogger(zap.NewNop()),
+		client.WithRetryCount(0), // disable retries for tests
+	)
+	require.NoError(t, err)
+
+	httpmock.ActivateNonDefault(transport.GetHTTPClient().Client())
+	t.Cleanup(func() {
+		httpmock.DeactivateAndReset()
+	})
+
+	return NewService(transport)
+}
+
+func TestAssociate_Success(t *testing.T) {
+	svc := setupMockClient(t)
+
+	httpmock.RegisterResponder("POST", "https://vpp.itunes.apple.com/mdm/v2/assets/associate",
+		func(req *http.Request) (*http.Response, error) {
+			var body AssociateAssetsRequest
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			assert.Equal(t, "361309726", body.Assets[0].AdamID)
+			assert.Equal(t, []string{"C02ABC"}, body.SerialNumbers)
+			return httpmock.NewStringResponse(200, `{"eventId": "evt-1", "uId": "2049025000431439"}`), nil
+		})
+
+	result, resp, err := svc.AssociateV1(context.Background(), &AssociateAssetsRequest{
+		Assets:        []AssetRef{{AdamID: "361309726", PricingParam: "STDQ"}},
+		SerialNumbers: []string{"C02ABC"},
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, "evt-1", result.EventID)
+}
+
+func TestAssociate_Validation(t *testing.T) {
+	svc := setupMockClient(t)
+
+	tests := []struct {
+		name    string
+		request *AssociateAssetsRequest
+		errMsg  string
+	}{
+		{"nil request", nil, "request is required"},
+		{"no assets", &AssociateAssetsRequest{SerialNumbers: []string{"A"}}, "at least one asset"},
+		{"no targets", &AssociateAssetsRequest{Assets: []AssetRef{{AdamID: "1"}}}, "at least one serial number"},
+		{"too many serials", &AssociateAssetsRequest{
+			Assets:        []AssetRef{{AdamID: "1"}},
+			SerialNumbers: make([]string, MaxSerialNumbersPerRequest+1),
+		}, "at most"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, resp, err := svc.AssociateV1(context.Background(), tt.request)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+			assert.Nil(t, result)
+			assert.Nil(t, resp)
+		})
+	}
+}
+
+func TestGetEventStatus_EmptyEventID(t *testing.T) {
+	svc := setupMockClient(t)
+
+	result, resp, err := svc.GetEventStatusV1(context.Background(), "")
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.Nil(t, resp)
+}
+
+func TestAssignAssetToSerials_BatchesAndReportsPerDevice(t *testing.T) {
+	svc := setupMockClient(t)
+
+	var batches [][]string
+	httpmock.RegisterResponder("POST", "https://vpp.itunes.apple.com/mdm/v2/assets/associate",
+		func(req *http.Request) (*http.Response, error) {
+			var body AssociateAssetsRequest
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			batches = append(batches, body.SerialNumbers)
+			if len(batches) == 1 {
+				return httpmock.NewStringResponse(200, `{"eventId": "evt-1"}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{"eventId": "evt-2"}`), nil
+		})
+
+	polls := map[string]int{}
+	httpmock.RegisterResponder("GET", "https://vpp.itunes.apple.com/mdm/v2/status/eventStatus",
+		func(req *http.Request) (*http.Response, error) {
+			eventID := req.URL.Query().Get("eventId")
+			polls[eventID]++
+			if eventID == "evt-1" && polls[eventID] == 1 {
+				return httpmock.NewStringResponse(200, `{"eventStatus": "PENDING", "eventType": "ASSOCIATE", "numCompleted": 0, "numRequested": 2}`), nil
+			}
+			if eventID == "evt-1" {
+				return httpmock.NewStringResponse(200, `{"eventStatus": "COMPLETE", "eventType": "ASSOCIATE", "numCompleted": 2, "numRequested": 2}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{"eventStatus": "COMPLETE", "eventType": "ASSOCIATE", "numCompleted": 1, "numRequested": 1,
+				"failures": [{"adamId": "361309726", "serialNumber": "SERIAL3", "errorNumber": 9610, "errorMessage": "No licenses available"}]}`), nil
+		})
+
+	asset := AssetRef{AdamID: "361309726", PricingParam: "STDQ"}
+	result, err := svc.AssignAssetToSerialsV1(context.Background(), asset,
+		[]string{"SERIAL1", "SERIAL2", "SERIAL3"},
+		&AssignmentOptions{BatchSize: 2, PollInterval: time.Millisecond})
+
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, [][]string{{"SERIAL1", "SERIAL2"}, {"SERIAL3"}}, batches)
+	require.Len(t, result.Batches, 2)
+	assert.Equal(t, "evt-1", result.Batches[0].EventID)
+	assert.Equal(t, 2, polls["evt-1"])
+
+	require.Len(t, result.Devices, 3)
+	assert.Equal(t, DeviceStatusSucceeded, result.Devices[0].Status)
+	assert.Equal(t, DeviceStatusSucceeded, result.Devices[1].Status)
+	assert.Equal(t, DeviceStatusFailed, result.Devices[2].Status)
+	assert.Equal(t, "evt-2", result.Devices[2].EventID)
+	assert.Equal(t, 9610, result.Devices[2].ErrorNumber)
+
+	failed := result.Failed()
+	require.Len(t, failed, 1)
+	assert.Equal(t, "SERIAL3", failed[0].SerialNumber)
+}
+
+func TestRevokeAssetFromSerials_NoWait(t *testing.T) {
+	svc := setupMockClient(t)
+
+	httpmock.RegisterResponder("POST", "https://vpp.itunes.apple.com/mdm/v2/assets/disassociate",
+		httpmock.NewStringResponder(200, `{"eventId": "evt-9"}`))
+
+	result, err := svc.RevokeAssetFromSerialsV1(context.Background(),
+		AssetRef{AdamID: "361309726"}, []string{"SERIAL1"}, &AssignmentOptions{NoWait: true})
+
+	require.NoError(t, err)
+	require.Len(t, result.Devices, 1)
+	assert.Equal(t, DeviceStatusPending, result.Devices[0].Status)
+	assert.Equal(t, "evt-9", result.Devices[0].EventID)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount(), "event status should not be polled")
+}
+
+func TestAssignAssetToSerials_SubmitError(t *testing.T) {
+	svc := setupMockClient(t)
+
+	httpmock.RegisterResponder("POST", "https://vpp.itunes.apple.com/mdm/v2/assets/associate",
+		httpmock.NewStringResponder(400, `{"errorNumber": 9632, "errorMessage": "Too many recent calls"}`))
+
+	result, err := svc.AssignAssetToSerialsV1(context.Background(),
+		AssetRef{AdamID: "361309726"}, []string{"SERIAL1"}, nil)
+
+	require.Error(t, err)
+	require.NotNil(t, result)
+	assert.Empty(t, result.Batches)
+	assert.True(t, client.IsAPIError(err, 9632))
+}
+
+func TestAssignAssetToSerials_Validation(t *testing.T) {
+	svc := setupMockClient(t)
+
+	_, err := svc.AssignAssetToSerialsV1(context.Background(), AssetRef{}, []string{"A"}, nil)
+	assert.ErrorContains(t, err, "adam ID is required")
+
+	_, err = svc.AssignAssetToSerialsV1(context.Background(), AssetRef{AdamID: "1"}, nil, nil)
+	assert.ErrorContains(t, err, "at least one serial number")
+}
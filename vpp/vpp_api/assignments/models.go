@@ -0,0 +1,96 @@
+package assignments
+
+import "time"
+
+// AssetRef identifies an asset by product ID and pricing quality.
+type AssetRef struct {
+	AdamID       string `json:"adamId"`
+	PricingParam string `json:"pricingParam,omitempty"`
+}
+
+// AssociateAssetsRequest is the request body for associating or
+// disassociating assets with devices or users.
+// https://developer.apple.com/documentation/devicemanagement/associateassetsrequest
+type AssociateAssetsRequest struct {
+	Assets        []AssetRef `json:"assets"`
+	SerialNumbers []string   `json:"serialNumbers,omitempty"`
+	ClientUserIDs []string   `json:"clientUserIds,omitempty"`
+}
+
+// EventResponse is returned when an asynchronous associate or disassociate
+// event has been accepted.
+// https://developer.apple.com/documentation/devicemanagement/eventresponse
+type EventResponse struct {
+	EventID             string `json:"eventId"`
+	TokenExpirationDate string `json:"tokenExpirationDate,omitempty"`
+	UID                 string `json:"uId,omitempty"`
+}
+
+// EventStatusResponse reports the progress of an asynchronous event.
+// https://developer.apple.com/documentation/devicemanagement/eventstatusresponse
+type EventStatusResponse struct {
+	EventStatus         string         `json:"eventStatus"`
+	EventType           string         `json:"eventType,omitempty"`
+	NumCompleted        int            `json:"numCompleted"`
+	NumRequested        int            `json:"numRequested"`
+	Failures            []EventFailure `json:"failures,omitempty"`
+	TokenExpirationDate string         `json:"tokenExpirationDate,omitempty"`
+	UID                 string         `json:"uId,omitempty"`
+}
+
+// EventFailure describes a single assignment that failed within an event.
+type EventFailure struct {
+	AdamID       string `json:"adamId,omitempty"`
+	PricingParam string `json:"pricingParam,omitempty"`
+	SerialNumber string `json:"serialNumber,omitempty"`
+	ClientUserID string `json:"clientUserId,omitempty"`
+	ErrorNumber  int    `json:"errorNumber,omitempty"`
+	ErrorMessage string `json:"errorMessage,omitempty"`
+}
+
+// AssignmentOptions controls how AssignAssetToSerialsV1 and
+// RevokeAssetFromSerialsV1 submit batches and wait for their events.
+type AssignmentOptions struct {
+	// BatchSize is the number of serial numbers per request. Defaults to and
+	// is capped at MaxSerialNumbersPerRequest.
+	BatchSize int
+	// PollInterval is how often event status is polled. Defaults to DefaultPollInterval.
+	PollInterval time.Duration
+	// NoWait returns as soon as every batch has been accepted, leaving device
+	// results in DeviceStatusPending.
+	NoWait bool
+}
+
+// BatchResult records the event created for one batch of serial numbers.
+type BatchResult struct {
+	EventID       string
+	SerialNumbers []string
+	Status        *EventStatusResponse
+}
+
+// DeviceResult is the outcome of an assignment or revocation for one device.
+type DeviceResult struct {
+	SerialNumber string
+	EventID      string
+	Status       string
+	ErrorNumber  int
+	ErrorMessage string
+}
+
+// AssignmentResult is the outcome of AssignAssetToSerialsV1 or RevokeAssetFromSerialsV1.
+type AssignmentResult struct {
+	Asset   AssetRef
+	Batches []BatchResult
+	Devices []DeviceResult
+}
+
+// Failed returns the device results that did not succeed.
+func (r *AssignmentResult) Failed() []DeviceResult {
+	var failed []DeviceResult
+	for _, device := range r.Devices {
+		if device.Status == DeviceStatusFailed {
+			failed = append(failed, device)
+		}
+	}
+	return failed
+}